@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -8,7 +9,12 @@ import (
 	"socio/internal/grpc/auth"
 	"socio/internal/grpc/interceptors"
 	"socio/pkg/appmetrics"
+	"socio/pkg/hash"
 	"socio/pkg/logger"
+	"socio/pkg/mailer"
+	"socio/pkg/requestcontext"
+	customtime "socio/pkg/time"
+	"socio/usecase/ratelimit"
 
 	authpb "socio/internal/grpc/auth/proto"
 	uspb "socio/internal/grpc/user/proto"
@@ -43,6 +49,7 @@ func main() {
 	defer redisPool.Close()
 
 	sessionStorage := redisRepo.NewSession(redisPool)
+	rateLimitService := ratelimit.NewRateLimitService(redisRepo.NewRateLimiter(redisPool))
 
 	userClientConn, err := grpc.Dial(
 		os.Getenv("GRPC_USER_SERVICE_HOST")+os.Getenv("GRPC_USER_SERVICE_PORT"),
@@ -55,7 +62,27 @@ func main() {
 
 	userClient := uspb.NewUserClient(userClientConn)
 
-	manager := auth.NewAuthManager(userClient, sessionStorage)
+	passwordHasher, err := hash.NewPasswordHasher(os.Getenv("PASSWORD_HASHER"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	emailOutbox := redisRepo.NewEmailOutbox(redisPool)
+
+	manager := auth.NewAuthManager(userClient, sessionStorage, emailOutbox, passwordHasher, customtime.RealTimeProvider{})
+
+	emailMailer, err := mailer.NewMailer(os.Getenv("MAILER"), mailer.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+	}, os.Stdout)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	prodLogger, err := logger.NewZapLogger(nil)
 	if err != nil {
@@ -72,16 +99,21 @@ func main() {
 
 	logger := logger.NewLogger(prodLogger)
 
+	// the auth service sends the emails queued by all the services
+	go mailer.NewSender(emailOutbox, emailMailer).Run(context.WithValue(context.Background(), requestcontext.LoggerKey, prodLogger))
+
 	prometheus.MustRegister(
 		appmetrics.AuthTotalHits,
 		appmetrics.AuthHits,
 		appmetrics.AuthHitDuration,
+		appmetrics.RateLimitDecisions,
 	)
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			logger.UnaryLoggerInterceptor,
 			interceptors.AuthHitMetricsInterceptor,
+			interceptors.CreateRateLimitUnaryInterceptor(rateLimitService),
 			interceptors.UnaryRecoveryInterceptor,
 		),
 	)
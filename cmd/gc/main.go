@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	minioRepo "socio/internal/repository/minio"
+	pgRepo "socio/internal/repository/postgres"
+	"socio/pkg/appmetrics"
+	"socio/pkg/upload"
+	"socio/usecase/gc"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joho/godotenv"
+	"github.com/minio/minio-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	DotenvPath = "../../.env"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the orphaned objects without deleting them")
+	gracePeriod := flag.Duration("grace", gc.DefaultGracePeriod, "minimal age of an orphaned object to delete")
+	interval := flag.Duration("interval", 0, "interval between the runs, the collector runs once if 0")
+	flag.Parse()
+
+	if err := godotenv.Load(DotenvPath); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	pgConnStr := fmt.Sprintf("user=%s dbname=%s password=%s host=%s port=%s sslmode=disable", os.Getenv("PG_USER"), os.Getenv("PG_DBNAME"), os.Getenv("PG_PASSWORD"), os.Getenv("PG_HOST"), os.Getenv("PG_PORT"))
+	db, err := pgRepo.NewPool(pgConnStr)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer db.Close()
+
+	minioClient, err := minio.New(os.Getenv("MINIO_HOST"), os.Getenv("MINIO_ACCESS_KEY"), os.Getenv("MINIO_SECRET_KEY"), false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	buckets, err := newBuckets(minioClient)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	service := gc.NewGCService(pgRepo.NewReferences(db), *gracePeriod, *dryRun)
+
+	if *interval == 0 {
+		collect(service, buckets)
+		return
+	}
+
+	prometheus.MustRegister(
+		appmetrics.GCScannedObjects,
+		appmetrics.GCOrphanedObjects,
+		appmetrics.GCDeletedObjects,
+		appmetrics.GCDeletedBytes,
+		appmetrics.GCErrorsCount,
+		appmetrics.GCRunDuration,
+	)
+
+	metricsPort := os.Getenv("GC_METRICS_PORT")
+
+	r := mux.NewRouter()
+	r.Handle("/metrics", promhttp.Handler())
+	go func() {
+		err = http.ListenAndServe(metricsPort, r)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+	}()
+	fmt.Println("Metrics of garbage collector is running on port:", metricsPort)
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		collect(service, buckets)
+		<-ticker.C
+	}
+}
+
+// newBuckets opens the buckets to collect, the default avatars are set by
+// the database and are kept even if nobody uses them. The message attachments
+// bucket is opened as private so the collector never makes it public.
+func newBuckets(minioClient *minio.Client) (buckets []gc.Bucket, err error) {
+	specs := []gc.Bucket{
+		{Name: minioRepo.UserAvatarsBucket, Kind: upload.AvatarKind, Keep: []string{"default_avatar.png"}},
+		{Name: minioRepo.GroupAvatarsBucket, Kind: upload.GroupAvatarKind, Keep: []string{"default_group_avatar.png"}},
+		{Name: minioRepo.StickersBucket, Kind: upload.StickerKind},
+		{Name: minioRepo.PostAttachmentsBucket, Kind: upload.PostAttachmentKind},
+	}
+
+	for _, bucket := range specs {
+		bucket.Storage, err = minioRepo.NewStaticStorage(minioClient, bucket.Name)
+		if err != nil {
+			return
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	messageAttachmentStorage, err := minioRepo.NewPrivateStorage(minioClient, minioRepo.MessageAttachmentsBucket, "")
+	if err != nil {
+		return
+	}
+
+	buckets = append(buckets, gc.Bucket{
+		Name:    minioRepo.MessageAttachmentsBucket,
+		Kind:    upload.MessageAttachmentKind,
+		Storage: messageAttachmentStorage,
+	})
+
+	return
+}
+
+func collect(service *gc.Service, buckets []gc.Bucket) {
+	for _, bucket := range buckets {
+		start := time.Now()
+
+		report, err := service.Collect(context.Background(), bucket)
+
+		trackMetrics(report, time.Since(start), err)
+
+		fmt.Printf(
+			"bucket %s: scanned %d, orphaned %d, deleted %d (%d bytes), failed %d, dry run %t\n",
+			report.Bucket, report.Scanned, report.Orphaned, report.Deleted, report.DeletedBytes, report.Failed, report.DryRun,
+		)
+		if err != nil {
+			fmt.Printf("bucket %s: %v\n", bucket.Name, err)
+		}
+	}
+}
+
+func trackMetrics(report *gc.Report, duration time.Duration, err error) {
+	appmetrics.GCScannedObjects.WithLabelValues(report.Bucket).Add(float64(report.Scanned))
+	appmetrics.GCOrphanedObjects.WithLabelValues(report.Bucket).Add(float64(report.Orphaned))
+	appmetrics.GCDeletedObjects.WithLabelValues(report.Bucket).Add(float64(report.Deleted))
+	appmetrics.GCDeletedBytes.WithLabelValues(report.Bucket).Add(float64(report.DeletedBytes))
+	appmetrics.GCErrorsCount.WithLabelValues(report.Bucket).Add(float64(report.Failed))
+	appmetrics.GCRunDuration.WithLabelValues(report.Bucket).Set(float64(duration.Milliseconds()))
+
+	if err != nil {
+		appmetrics.GCErrorsCount.WithLabelValues(report.Bucket).Inc()
+	}
+}
@@ -11,9 +11,11 @@ import (
 	postspb "socio/internal/grpc/post/proto"
 	minioRepo "socio/internal/repository/minio"
 	pgRepo "socio/internal/repository/postgres"
+	redisRepo "socio/internal/repository/redis"
 	"socio/pkg/appmetrics"
 	"socio/pkg/logger"
 	customtime "socio/pkg/time"
+	"socio/usecase/ratelimit"
 
 	"github.com/gorilla/mux"
 	"github.com/joho/godotenv"
@@ -42,6 +44,11 @@ func main() {
 	}
 	defer db.Close()
 
+	redisPool := redisRepo.NewPool(os.Getenv("REDIS_PROTOCOL"), os.Getenv("REDIS_HOST")+":"+os.Getenv("REDIS_PORT"), os.Getenv("REDIS_PASSWORD"))
+	defer redisPool.Close()
+
+	rateLimitService := ratelimit.NewRateLimitService(redisRepo.NewRateLimiter(redisPool))
+
 	minioClient, err := minio.New(os.Getenv("MINIO_HOST"), os.Getenv("MINIO_ACCESS_KEY"), os.Getenv("MINIO_SECRET_KEY"), false)
 	if err != nil {
 		fmt.Println(err)
@@ -83,6 +90,9 @@ func main() {
 		appmetrics.PostTotalHits,
 		appmetrics.PostHits,
 		appmetrics.PostHitDuration,
+		appmetrics.ContentFilterRuleDecisions,
+		appmetrics.ContentFilterVerdicts,
+		appmetrics.RateLimitDecisions,
 	)
 
 	server := grpc.NewServer(
@@ -91,6 +101,7 @@ func main() {
 		grpc.ChainUnaryInterceptor(
 			logger.UnaryLoggerInterceptor,
 			interceptors.PostHitMetricsInterceptor,
+			interceptors.CreateRateLimitUnaryInterceptor(rateLimitService),
 			interceptors.UnaryRecoveryInterceptor,
 		),
 	)
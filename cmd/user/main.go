@@ -10,7 +10,9 @@ import (
 	uspb "socio/internal/grpc/user/proto"
 	minioRepo "socio/internal/repository/minio"
 	pgRepo "socio/internal/repository/postgres"
+	redisRepo "socio/internal/repository/redis"
 	"socio/pkg/appmetrics"
+	"socio/pkg/hash"
 	"socio/pkg/logger"
 	customtime "socio/pkg/time"
 
@@ -60,10 +62,19 @@ func main() {
 		return
 	}
 
-	userStorage := pgRepo.NewUsers(db, customtime.RealTimeProvider{})
+	passwordHasher, err := hash.NewPasswordHasher(os.Getenv("PASSWORD_HASHER"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	redisPool := redisRepo.NewPool(os.Getenv("REDIS_PROTOCOL"), os.Getenv("REDIS_HOST")+":"+os.Getenv("REDIS_PORT"), os.Getenv("REDIS_PASSWORD"))
+	defer redisPool.Close()
+
+	userStorage := pgRepo.NewUsers(db, customtime.RealTimeProvider{}, passwordHasher)
 	subsciptionsStorage := pgRepo.NewSubscriptions(db, customtime.RealTimeProvider{})
 
-	manager := user.NewUserManager(userStorage, subsciptionsStorage, avatarStorage)
+	manager := user.NewUserManager(userStorage, subsciptionsStorage, avatarStorage, redisRepo.NewEmailOutbox(redisPool))
 
 	prodLogger, err := logger.NewZapLogger(nil)
 	if err != nil {
@@ -0,0 +1,85 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS public.conversation (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL DEFAULT ''::TEXT,
+    is_group BOOLEAN NOT NULL DEFAULT FALSE,
+    dialog_user1_id BIGINT DEFAULT NULL,
+    dialog_user2_id BIGINT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (dialog_user1_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (dialog_user2_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT conversation_dialog_unique UNIQUE (dialog_user1_id, dialog_user2_id),
+    CONSTRAINT conversation_dialog_check CHECK (
+        is_group
+        OR (
+            dialog_user1_id IS NOT NULL
+            AND dialog_user2_id IS NOT NULL
+            AND dialog_user1_id <= dialog_user2_id
+        )
+    )
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.conversation
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS public.conversation_participant (
+    conversation_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'member'::TEXT,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (conversation_id, user_id),
+    FOREIGN KEY (conversation_id) REFERENCES public.conversation (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT conversation_participant_role_check CHECK (role IN ('owner', 'admin', 'member'))
+);
+
+CREATE INDEX IF NOT EXISTS conversation_participant_user_id_idx ON public.conversation_participant (user_id);
+
+ALTER TABLE public.personal_message ADD COLUMN IF NOT EXISTS conversation_id BIGINT DEFAULT NULL;
+ALTER TABLE public.personal_message ADD CONSTRAINT fk_conversation_id FOREIGN KEY (conversation_id) REFERENCES public.conversation (id) ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE public.personal_message ALTER COLUMN receiver_id DROP NOT NULL;
+ALTER TABLE public.personal_message ADD CONSTRAINT personal_message_target_check CHECK (receiver_id IS NOT NULL OR conversation_id IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS personal_message_conversation_id_idx ON public.personal_message (conversation_id, id);
+
+-- existing dialogs become two-member conversations
+INSERT INTO public.conversation (dialog_user1_id, dialog_user2_id)
+SELECT DISTINCT LEAST(sender_id, receiver_id),
+    GREATEST(sender_id, receiver_id)
+FROM public.personal_message
+ON CONFLICT DO NOTHING;
+
+INSERT INTO public.conversation_participant (conversation_id, user_id)
+SELECT id, dialog_user1_id
+FROM public.conversation
+WHERE NOT is_group
+UNION
+SELECT id, dialog_user2_id
+FROM public.conversation
+WHERE NOT is_group
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE public.personal_message DISABLE TRIGGER set_timestamp;
+
+UPDATE public.personal_message AS pm
+SET conversation_id = c.id
+FROM public.conversation AS c
+WHERE NOT c.is_group
+    AND c.dialog_user1_id = LEAST(pm.sender_id, pm.receiver_id)
+    AND c.dialog_user2_id = GREATEST(pm.sender_id, pm.receiver_id);
+
+ALTER TABLE public.personal_message ENABLE TRIGGER set_timestamp;
+---- create above / drop below ----
+DELETE FROM public.personal_message WHERE receiver_id IS NULL;
+DROP INDEX IF EXISTS personal_message_conversation_id_idx;
+ALTER TABLE public.personal_message DROP CONSTRAINT IF EXISTS personal_message_target_check;
+ALTER TABLE public.personal_message ALTER COLUMN receiver_id SET NOT NULL;
+ALTER TABLE public.personal_message DROP COLUMN IF EXISTS conversation_id;
+DROP TABLE IF EXISTS public.conversation_participant;
+DROP TRIGGER IF EXISTS set_timestamp ON public.conversation;
+DROP TABLE IF EXISTS public.conversation;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
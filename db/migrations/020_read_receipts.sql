@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+ALTER TABLE public.conversation_participant ADD COLUMN IF NOT EXISTS last_read_message_id BIGINT NOT NULL DEFAULT 0;
+
+-- everything sent before read receipts existed counts as read
+UPDATE public.conversation_participant AS cp
+SET last_read_message_id = COALESCE((
+        SELECT MAX(pm.id)
+        FROM public.personal_message AS pm
+        WHERE pm.conversation_id = cp.conversation_id
+    ), 0);
+---- create above / drop below ----
+ALTER TABLE public.conversation_participant DROP COLUMN IF EXISTS last_read_message_id;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
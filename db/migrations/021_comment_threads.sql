@@ -0,0 +1,17 @@
+-- Write your migrate up statements here
+ALTER TABLE public.comment ADD COLUMN IF NOT EXISTS parent_id BIGINT DEFAULT NULL;
+ALTER TABLE public.comment ADD COLUMN IF NOT EXISTS depth INT NOT NULL DEFAULT 0;
+
+ALTER TABLE public.comment ADD CONSTRAINT comment_parent_id_fkey
+    FOREIGN KEY (parent_id) REFERENCES public.comment (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS comment_post_id_top_level_idx ON public.comment (post_id, id) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS comment_parent_id_id_idx ON public.comment (parent_id, id);
+---- create above / drop below ----
+DROP INDEX IF EXISTS comment_parent_id_id_idx;
+DROP INDEX IF EXISTS comment_post_id_top_level_idx;
+ALTER TABLE public.comment DROP CONSTRAINT IF EXISTS comment_parent_id_fkey;
+ALTER TABLE public.comment DROP COLUMN IF EXISTS depth;
+ALTER TABLE public.comment DROP COLUMN IF EXISTS parent_id;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
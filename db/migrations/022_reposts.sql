@@ -0,0 +1,15 @@
+-- Write your migrate up statements here
+ALTER TABLE public.post ADD COLUMN IF NOT EXISTS repost_of_id BIGINT DEFAULT NULL;
+ALTER TABLE public.post ADD COLUMN IF NOT EXISTS is_repost BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE public.post ADD CONSTRAINT post_repost_of_id_fkey
+    FOREIGN KEY (repost_of_id) REFERENCES public.post (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS post_repost_of_id_idx ON public.post (repost_of_id);
+---- create above / drop below ----
+DROP INDEX IF EXISTS post_repost_of_id_idx;
+ALTER TABLE public.post DROP CONSTRAINT IF EXISTS post_repost_of_id_fkey;
+ALTER TABLE public.post DROP COLUMN IF EXISTS is_repost;
+ALTER TABLE public.post DROP COLUMN IF EXISTS repost_of_id;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,12 @@
+-- Write your migrate up statements here
+CREATE INDEX IF NOT EXISTS post_created_at_idx ON public.post (created_at);
+CREATE INDEX IF NOT EXISTS post_author_id_idx ON public.post (author_id);
+CREATE INDEX IF NOT EXISTS post_like_user_id_idx ON public.post_like (user_id);
+CREATE INDEX IF NOT EXISTS comment_post_id_idx ON public.comment (post_id);
+---- create above / drop below ----
+DROP INDEX IF EXISTS comment_post_id_idx;
+DROP INDEX IF EXISTS post_like_user_id_idx;
+DROP INDEX IF EXISTS post_author_id_idx;
+DROP INDEX IF EXISTS post_created_at_idx;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
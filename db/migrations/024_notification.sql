@@ -0,0 +1,29 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS public.notification (
+    -- id is refreshed when another event joins the unread notification, so
+    -- the list ordered by id always shows the latest activity first
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type TEXT NOT NULL,
+    target_id BIGINT NOT NULL,
+    actor_ids BIGINT[] NOT NULL DEFAULT '{}'::BIGINT[],
+    events_count BIGINT NOT NULL DEFAULT 1,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT notification_type_check CHECK (type IN ('POST_LIKE', 'COMMENT', 'SUBSCRIPTION', 'GROUP_POST'))
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.notification
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- events of the same type about the same target are grouped while unread
+CREATE UNIQUE INDEX IF NOT EXISTS notification_unread_group_idx ON public.notification (user_id, type, target_id) WHERE NOT is_read;
+CREATE INDEX IF NOT EXISTS notification_user_id_id_idx ON public.notification (user_id, id);
+---- create above / drop below ----
+DROP TABLE IF EXISTS public.notification;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
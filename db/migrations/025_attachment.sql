@@ -0,0 +1,26 @@
+-- Write your migrate up statements here
+-- attachment describes every uploaded post and message attachment, the rows
+-- are stored on upload and joined to post_attachment and message_attachment
+-- by the file name
+CREATE TABLE IF NOT EXISTS public.attachment (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    file_name TEXT UNIQUE NOT NULL,
+    kind TEXT NOT NULL,
+    mime_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    -- in milliseconds, 0 if unknown
+    duration BIGINT NOT NULL DEFAULT 0,
+    original_name TEXT NOT NULL DEFAULT '',
+    -- hex encoded SHA-256 of the stored file
+    checksum TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT attachment_kind_check CHECK (kind IN ('image', 'video', 'audio', 'file'))
+);
+
+CREATE INDEX IF NOT EXISTS attachment_checksum_idx ON public.attachment (checksum);
+---- create above / drop below ----
+DROP TABLE IF EXISTS public.attachment;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+-- identical uploads are stored once, so a file can be attached to many posts
+-- and messages, but only once to each of them
+ALTER TABLE public.post_attachment DROP CONSTRAINT IF EXISTS post_attachment_file_name_key;
+ALTER TABLE public.post_attachment ADD CONSTRAINT post_attachment_post_id_file_name_key UNIQUE (post_id, file_name);
+CREATE INDEX IF NOT EXISTS post_attachment_file_name_idx ON public.post_attachment (file_name);
+
+ALTER TABLE public.message_attachment DROP CONSTRAINT IF EXISTS message_attachment_file_name_key;
+ALTER TABLE public.message_attachment ADD CONSTRAINT message_attachment_message_id_file_name_key UNIQUE (message_id, file_name);
+CREATE INDEX IF NOT EXISTS message_attachment_file_name_idx ON public.message_attachment (file_name);
+---- create above / drop below ----
+DROP INDEX IF EXISTS public.message_attachment_file_name_idx;
+ALTER TABLE public.message_attachment DROP CONSTRAINT IF EXISTS message_attachment_message_id_file_name_key;
+ALTER TABLE public.message_attachment ADD CONSTRAINT message_attachment_file_name_key UNIQUE (file_name);
+
+DROP INDEX IF EXISTS public.post_attachment_file_name_idx;
+ALTER TABLE public.post_attachment DROP CONSTRAINT IF EXISTS post_attachment_post_id_file_name_key;
+ALTER TABLE public.post_attachment ADD CONSTRAINT post_attachment_file_name_key UNIQUE (file_name);
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
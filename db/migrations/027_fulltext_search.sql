@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+-- the content is indexed with both configurations, so a query matches the
+-- russian and the english word forms
+ALTER TABLE public.post ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('russian'::regconfig, content) || to_tsvector('english'::regconfig, content)) STORED;
+CREATE INDEX IF NOT EXISTS post_search_vector_idx ON public.post USING gin(search_vector);
+
+ALTER TABLE public.comment ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('russian'::regconfig, content) || to_tsvector('english'::regconfig, content)) STORED;
+CREATE INDEX IF NOT EXISTS comment_search_vector_idx ON public.comment USING gin(search_vector);
+---- create above / drop below ----
+DROP INDEX IF EXISTS public.comment_search_vector_idx;
+ALTER TABLE public.comment DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS public.post_search_vector_idx;
+ALTER TABLE public.post DROP COLUMN IF EXISTS search_vector;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
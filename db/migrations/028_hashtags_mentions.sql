@@ -0,0 +1,54 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS public.post_hashtag (
+    post_id BIGINT NOT NULL,
+    hashtag TEXT NOT NULL,
+    PRIMARY KEY (post_id, hashtag),
+    FOREIGN KEY (post_id) REFERENCES public.post (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS post_hashtag_hashtag_post_id_idx ON public.post_hashtag (hashtag, post_id);
+
+CREATE TABLE IF NOT EXISTS public.comment_hashtag (
+    comment_id BIGINT NOT NULL,
+    hashtag TEXT NOT NULL,
+    PRIMARY KEY (comment_id, hashtag),
+    FOREIGN KEY (comment_id) REFERENCES public.comment (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS comment_hashtag_hashtag_idx ON public.comment_hashtag (hashtag);
+
+-- is_notified keeps the mentioned user from being notified again when the
+-- content is edited and the mention stays
+CREATE TABLE IF NOT EXISTS public.post_mention (
+    post_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    is_notified BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES public.post (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS public.comment_mention (
+    comment_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    is_notified BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (comment_id, user_id),
+    FOREIGN KEY (comment_id) REFERENCES public.comment (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+ALTER TABLE public.notification DROP CONSTRAINT IF EXISTS notification_type_check;
+ALTER TABLE public.notification ADD CONSTRAINT notification_type_check
+    CHECK (type IN ('POST_LIKE', 'COMMENT', 'SUBSCRIPTION', 'GROUP_POST', 'POST_MENTION', 'COMMENT_MENTION'));
+---- create above / drop below ----
+DELETE FROM public.notification WHERE type IN ('POST_MENTION', 'COMMENT_MENTION');
+ALTER TABLE public.notification DROP CONSTRAINT IF EXISTS notification_type_check;
+ALTER TABLE public.notification ADD CONSTRAINT notification_type_check
+    CHECK (type IN ('POST_LIKE', 'COMMENT', 'SUBSCRIPTION', 'GROUP_POST'));
+
+DROP TABLE IF EXISTS public.comment_mention;
+DROP TABLE IF EXISTS public.post_mention;
+DROP TABLE IF EXISTS public.comment_hashtag;
+DROP TABLE IF EXISTS public.post_hashtag;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
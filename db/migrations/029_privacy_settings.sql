@@ -0,0 +1,81 @@
+-- Write your migrate up statements here
+-- the users without a row have every setting at EVERYONE
+CREATE TABLE IF NOT EXISTS public.user_privacy (
+    user_id BIGINT PRIMARY KEY,
+    posts TEXT NOT NULL DEFAULT 'EVERYONE',
+    messages TEXT NOT NULL DEFAULT 'EVERYONE',
+    subscriptions TEXT NOT NULL DEFAULT 'EVERYONE',
+    date_of_birth TEXT NOT NULL DEFAULT 'EVERYONE',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT user_privacy_posts_check CHECK (posts IN ('EVERYONE', 'SUBSCRIBERS', 'FRIENDS', 'ONLY_ME')),
+    CONSTRAINT user_privacy_messages_check CHECK (messages IN ('EVERYONE', 'SUBSCRIBERS', 'FRIENDS', 'ONLY_ME')),
+    CONSTRAINT user_privacy_subscriptions_check CHECK (subscriptions IN ('EVERYONE', 'SUBSCRIBERS', 'FRIENDS', 'ONLY_ME')),
+    CONSTRAINT user_privacy_date_of_birth_check CHECK (date_of_birth IN ('EVERYONE', 'SUBSCRIBERS', 'FRIENDS', 'ONLY_ME')),
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.user_privacy
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- privacy_allows tells whether the viewer passes the privacy level set by the
+-- owner: SUBSCRIBERS are the users subscribed to the owner, FRIENDS are the
+-- ones subscribed both ways, the owner always passes
+CREATE OR REPLACE FUNCTION public.privacy_allows(privacy_level TEXT, privacy_owner_id BIGINT, privacy_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT privacy_owner_id = privacy_viewer_id
+        OR privacy_level = 'EVERYONE'
+        OR (
+            privacy_level IN ('SUBSCRIBERS', 'FRIENDS')
+            AND EXISTS (
+                SELECT 1
+                FROM public.subscription AS s
+                WHERE s.subscriber_id = privacy_viewer_id
+                    AND s.subscribed_to_id = privacy_owner_id
+            )
+            AND (
+                privacy_level = 'SUBSCRIBERS'
+                OR EXISTS (
+                    SELECT 1
+                    FROM public.subscription AS s
+                    WHERE s.subscriber_id = privacy_owner_id
+                        AND s.subscribed_to_id = privacy_viewer_id
+                )
+            )
+        );
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION public.can_view_posts(posts_author_id BIGINT, posts_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT public.privacy_allows(
+        COALESCE((
+            SELECT up.posts
+            FROM public.user_privacy AS up
+            WHERE up.user_id = posts_author_id
+        ), 'EVERYONE'),
+        posts_author_id,
+        posts_viewer_id
+    );
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION public.can_send_message(message_sender_id BIGINT, message_receiver_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT public.privacy_allows(
+        COALESCE((
+            SELECT up.messages
+            FROM public.user_privacy AS up
+            WHERE up.user_id = message_receiver_id
+        ), 'EVERYONE'),
+        message_receiver_id,
+        message_sender_id
+    );
+$$ LANGUAGE sql STABLE;
+---- create above / drop below ----
+DROP FUNCTION IF EXISTS public.can_send_message(BIGINT, BIGINT);
+DROP FUNCTION IF EXISTS public.can_view_posts(BIGINT, BIGINT);
+DROP FUNCTION IF EXISTS public.privacy_allows(TEXT, BIGINT, BIGINT);
+DROP TABLE IF EXISTS public.user_privacy;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
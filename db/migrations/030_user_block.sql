@@ -0,0 +1,63 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS public.user_block (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    blocker_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT user_block_blocker_fkey FOREIGN KEY (blocker_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT user_block_blocked_fkey FOREIGN KEY (blocked_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT user_block_not_self_check CHECK (blocker_id <> blocked_id),
+    CONSTRAINT blocker_blocked_unique_together UNIQUE(blocker_id, blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_block_blocked_id_idx ON public.user_block (blocked_id);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.user_block
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- is_blocked_between tells whether one of the users blocked the other, the
+-- content of both sides is hidden from each other
+CREATE OR REPLACE FUNCTION public.is_blocked_between(first_user_id BIGINT, second_user_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1
+        FROM public.user_block AS ub
+        WHERE (ub.blocker_id = first_user_id AND ub.blocked_id = second_user_id)
+            OR (ub.blocker_id = second_user_id AND ub.blocked_id = first_user_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION public.can_view_posts(posts_author_id BIGINT, posts_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT NOT public.is_blocked_between(posts_author_id, posts_viewer_id)
+        AND public.privacy_allows(
+            COALESCE((
+                SELECT up.posts
+                FROM public.user_privacy AS up
+                WHERE up.user_id = posts_author_id
+            ), 'EVERYONE'),
+            posts_author_id,
+            posts_viewer_id
+        );
+$$ LANGUAGE sql STABLE;
+---- create above / drop below ----
+CREATE OR REPLACE FUNCTION public.can_view_posts(posts_author_id BIGINT, posts_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT public.privacy_allows(
+        COALESCE((
+            SELECT up.posts
+            FROM public.user_privacy AS up
+            WHERE up.user_id = posts_author_id
+        ), 'EVERYONE'),
+        posts_author_id,
+        posts_viewer_id
+    );
+$$ LANGUAGE sql STABLE;
+
+DROP FUNCTION IF EXISTS public.is_blocked_between(BIGINT, BIGINT);
+DROP TABLE IF EXISTS public.user_block;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,107 @@
+-- Write your migrate up statements here
+-- moderators are appointed directly in the database, they review the reports
+-- of all users and act on any content
+CREATE TABLE IF NOT EXISTS public.moderator (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT moderator_user_unique UNIQUE (user_id)
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.moderator
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS public.user_ban (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    moderator_id BIGINT DEFAULT NULL,
+    reason TEXT NOT NULL DEFAULT ''::TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (moderator_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT user_ban_user_unique UNIQUE (user_id)
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.user_ban
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- hidden content stays in the database for the audit, but is not listed
+ALTER TABLE public.post ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE public.comment ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE public.personal_message ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE public.sticker ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- report_case gathers all the reports about the same target, so the
+-- moderators see every reported target once
+CREATE TABLE IF NOT EXISTS public.report_case (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    target_type TEXT NOT NULL,
+    target_id BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN'::TEXT,
+    resolved_by BIGINT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (resolved_by) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT report_case_target_type_check CHECK (target_type IN ('POST', 'COMMENT', 'MESSAGE', 'STICKER', 'GROUP')),
+    CONSTRAINT report_case_status_check CHECK (status IN ('OPEN', 'RESOLVED', 'DISMISSED')),
+    CONSTRAINT report_case_target_unique UNIQUE (target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS report_case_open_idx ON public.report_case (id) WHERE status = 'OPEN';
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.report_case
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS public.report (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    case_id BIGINT NOT NULL,
+    reporter_id BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT ''::TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (case_id) REFERENCES public.report_case (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT report_reason_check CHECK (reason IN ('SPAM', 'HARASSMENT', 'HATE_SPEECH', 'VIOLENCE', 'NUDITY', 'OTHER')),
+    CONSTRAINT report_case_reporter_unique UNIQUE (case_id, reporter_id)
+);
+
+CREATE OR REPLACE TRIGGER set_timestamp
+BEFORE UPDATE ON public.report
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- moderation_log is append only, the entries outlive the moderators and the
+-- targets they acted on
+CREATE TABLE IF NOT EXISTS public.moderation_log (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    moderator_id BIGINT DEFAULT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id BIGINT NOT NULL,
+    reason TEXT NOT NULL DEFAULT ''::TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (moderator_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT moderation_log_action_check CHECK (action IN ('HIDE_CONTENT', 'BAN_USER', 'DELETE_GROUP', 'DISMISS_REPORTS'))
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS public.moderation_log;
+DROP TABLE IF EXISTS public.report;
+DROP TABLE IF EXISTS public.report_case;
+ALTER TABLE public.sticker DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE public.personal_message DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE public.comment DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE public.post DROP COLUMN IF EXISTS is_hidden;
+DROP TABLE IF EXISTS public.user_ban;
+DROP TABLE IF EXISTS public.moderator;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+-- the accounts created before the verification was introduced are considered
+-- verified
+ALTER TABLE public.user ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ DEFAULT NULL;
+UPDATE public.user SET email_verified_at = created_at WHERE email_verified_at IS NULL;
+
+-- only the sha256 of the token is stored, the token itself is sent to the user
+CREATE TABLE IF NOT EXISTS public.email_verification_token (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    token_hash TEXT NOT NULL,
+    email TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_id) REFERENCES public.user (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT email_verification_token_hash_unique UNIQUE (token_hash)
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS public.email_verification_token;
+ALTER TABLE public.user DROP COLUMN IF EXISTS email_verified_at;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
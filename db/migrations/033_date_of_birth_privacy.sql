@@ -0,0 +1,17 @@
+-- Write your migrate up statements here
+CREATE OR REPLACE FUNCTION public.can_view_date_of_birth(date_of_birth_owner_id BIGINT, date_of_birth_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT public.privacy_allows(
+        COALESCE((
+            SELECT up.date_of_birth
+            FROM public.user_privacy AS up
+            WHERE up.user_id = date_of_birth_owner_id
+        ), 'EVERYONE'),
+        date_of_birth_owner_id,
+        date_of_birth_viewer_id
+    );
+$$ LANGUAGE sql STABLE;
+---- create above / drop below ----
+DROP FUNCTION IF EXISTS public.can_view_date_of_birth(BIGINT, BIGINT);
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,17 @@
+-- Write your migrate up statements here
+CREATE OR REPLACE FUNCTION public.can_view_subscriptions(subscriptions_owner_id BIGINT, subscriptions_viewer_id BIGINT)
+RETURNS BOOLEAN AS $$
+    SELECT public.privacy_allows(
+        COALESCE((
+            SELECT up.subscriptions
+            FROM public.user_privacy AS up
+            WHERE up.user_id = subscriptions_owner_id
+        ), 'EVERYONE'),
+        subscriptions_owner_id,
+        subscriptions_viewer_id
+    );
+$$ LANGUAGE sql STABLE;
+---- create above / drop below ----
+DROP FUNCTION IF EXISTS public.can_view_subscriptions(BIGINT, BIGINT);
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -21,7 +21,7 @@ const docTemplate = `{
     "paths": {
         "/auth/login/": {
             "post": {
-                "description": "login user by email and password",
+                "description": "login user by email and password, the login from the account or the IP is locked for a while after too many failed attempts",
                 "consumes": [
                     "application/json"
                 ],
@@ -74,7 +74,7 @@ const docTemplate = `{
                         "headers": {
                             "Set-Cookie": {
                                 "type": "string",
-                                "description": "session_id=some_session_id; Path=/; Max-Age=36000; HttpOnly;"
+                                "description": "session_id=some_session_id; Path=/; Max-Age=604800; HttpOnly;"
                             }
                         }
                     },
@@ -90,6 +90,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -153,92 +159,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/signup/": {
+        "/auth/password-reset": {
             "post": {
-                "description": "registrate user by his data",
+                "description": "send the link resetting the password to the email, the answer is the same whether the account with the email exists or not",
                 "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "handle user's registration flow",
-                "operationId": "auth/signup",
+                "summary": "request the password reset link",
+                "operationId": "auth/password_reset",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "First name of the user",
-                        "name": "firstName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Last name of the user",
-                        "name": "lastName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
                         "description": "Email of the user",
                         "name": "email",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "minLength": 6,
-                        "type": "string",
-                        "description": "Password of the user",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "minLength": 6,
-                        "type": "string",
-                        "description": "Repeat password of the user",
-                        "name": "repeatPassword",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "date",
-                        "example": "2021-01-01",
-                        "description": "Date of birth of the user",
-                        "name": "dateOfBirth",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar of the user",
-                        "name": "avatar",
-                        "in": "formData"
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/domain.User"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -246,6 +191,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -255,32 +206,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/": {
-            "get": {
-                "description": "Serve websocket connection. You can send actions to connection following simple structure:\n\n{\n\"type\": ActionType,\n\"receiver\": uint,\n\"csrfToken\": string,\n\"payload\": interface{}\n}\n\nActionType is a string with one of following values: \"SEND_MESSAGE\", \"UPDATE_MESSAGE\", \"DELETE_MESSAGE\", \"SEND_STICKER_MESSAGE\"\n\nIf \"type\" = \"SEND_MESSAGE\", then payload should be {\"content\": string, \"attachments\": []string}\nIf \"type\" = \"UPDATE_MESSAGE\", then payload should be {\"messageId\": uint, \"content\": string, attachmentsToDelete: []string}\nIf \"type\" = \"DELETE_MESSAGE\", then payload should be {\"messageId\": uint}\nIf \"type\" = \"SEND_STICKER_MESSAGE\", then payload should be {\"stickerId\": uint}\n\nIn response clients, subscribed to corresponding channel, will get same structure back:\n{\n\"type\": ActionType,\n\"receiver\": uint,\n\"csrfToken\": string,\n\"payload\": interface{}\n}\n\n\"payload\" can be:\nPersonalMessage if \"type\" = \"SEND_MESSAGE\"\nPersonalMessage if \"type\" = \"UPDATE_MESSAGE\"\nAbsent if \"type\" = \"DELETE_MESSAGE\"\nPersonalMessage if \"type\" = \"SEND_STICKER_MESSAGE\"\n{\"error\": string} if error happened at any point of query processing\n",
+        "/auth/password-reset/confirm": {
+            "post": {
+                "description": "set the new password with the token from the link, all sessions of the user are revoked",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "serve websocket connection",
-                "operationId": "chat/serve_ws",
+                "summary": "reset user's password",
+                "operationId": "auth/password_reset_confirm",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "session_id=some_session",
-                        "name": "Cookie",
-                        "in": "header",
-                        "required": true
+                        "description": "Token from the link",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minLength": 6,
+                        "description": "New password of the user",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minLength": 6,
+                        "description": "Repeat new password of the user",
+                        "name": "repeatPassword",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -288,8 +258,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -303,9 +273,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/dialogs/": {
+        "/auth/sessions/": {
             "get": {
-                "description": "get user dialogs",
+                "description": "list active sessions of the authorized user, the current session is marked with isCurrent",
                 "consumes": [
                     "application/json"
                 ],
@@ -313,10 +283,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "get user dialogs",
-                "operationId": "chat/get_dialogs",
+                "summary": "list user's active sessions",
+                "operationId": "auth/sessions/list",
                 "parameters": [
                     {
                         "type": "string",
@@ -324,13 +294,6 @@ const docTemplate = `{
                         "name": "Cookie",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "CSRF token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -347,7 +310,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.Dialog"
+                                                "$ref": "#/definitions/domain.Session"
                                             }
                                         }
                                     }
@@ -355,24 +318,12 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -380,22 +331,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/chat/dialogs/{receiverID}/unsent-attachments/": {
-            "get": {
-                "description": "get unsent message attachments, returns array of filenames",
+            },
+            "delete": {
+                "description": "revoke all sessions of the authorized user except the current one",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "get unsent message attachments",
-                "operationId": "chat/get_unsent_message_attachments",
+                "summary": "log out everywhere",
+                "operationId": "auth/sessions/revoke_all",
                 "parameters": [
                     {
                         "type": "string",
@@ -410,42 +356,11 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the receiver",
-                        "name": "receiverID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "type": "string"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -466,28 +381,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create unsent message attachments",
+            }
+        },
+        "/auth/sessions/{sessionID}": {
+            "delete": {
+                "description": "log out the session with the given id, e.g. on a lost device",
                 "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "create unsent message attachments",
-                "operationId": "chat/create_unsent_message_attachments",
+                "summary": "revoke one of user's sessions",
+                "operationId": "auth/sessions/revoke",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Attachment file",
-                        "name": "attachment",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -503,34 +410,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the receiver",
-                        "name": "receiverID",
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "sessionID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "type": "string"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -550,53 +439,107 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
-                    }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
                 }
-            },
-            "delete": {
-                "description": "delete unsent message attachments",
+            }
+        },
+        "/auth/signup/": {
+            "post": {
+                "description": "registrate user by his data, the link confirming the email is sent to it",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "delete unsent message attachments",
-                "operationId": "chat/delete_unsent_message_attachments",
+                "summary": "handle user's registration flow",
+                "operationId": "auth/signup",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "session_id=some_session",
-                        "name": "Cookie",
-                        "in": "header",
+                        "description": "First name of the user",
+                        "name": "firstName",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "CSRF token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
+                        "description": "Last name of the user",
+                        "name": "lastName",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the receiver",
-                        "name": "receiverID",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Email of the user",
+                        "name": "email",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "minLength": 6,
+                        "type": "string",
+                        "description": "Password of the user",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "minLength": 6,
+                        "type": "string",
+                        "description": "Repeat password of the user",
+                        "name": "repeatPassword",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "example": "2021-01-01",
+                        "description": "Date of birth of the user",
+                        "name": "dateOfBirth",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar of the user",
+                        "name": "avatar",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.User"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -604,14 +547,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "413": {
+                        "description": "Request Entity Too Large",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -625,20 +574,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/dialogs/{receiverID}/unsent-attachments/{fileName}": {
-            "delete": {
-                "description": "delete unsent message attachment",
+        "/auth/verify-email": {
+            "post": {
+                "description": "confirm the email with the token from the link sent to it, the token works only once",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
+                "tags": [
+                    "auth"
+                ],
+                "summary": "confirm user's email",
+                "operationId": "auth/verify_email",
+                "parameters": [
+                    {
+                        "description": "Token from the link",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/verify-email/resend": {
+            "post": {
+                "description": "send another link confirming the email of the authorized user, the links sent before stop working",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "chat"
+                    "auth"
                 ],
-                "summary": "delete unsent message attachment",
-                "operationId": "chat/delete_unsent_message_attachment",
+                "summary": "resend the email confirmation link",
+                "operationId": "auth/verify_email_resend",
                 "parameters": [
                     {
                         "type": "string",
@@ -653,20 +640,6 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the receiver",
-                        "name": "receiverID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Name of the file",
-                        "name": "fileName",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -691,6 +664,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -700,9 +679,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/messages/": {
+        "/chat/": {
             "get": {
-                "description": "get messages by dialog with pagination",
+                "description": "Serve websocket connection. You can send actions to connection following simple structure:\n\n{\n\"type\": ActionType,\n\"receiver\": uint,\n\"conversationId\": uint,\n\"csrfToken\": string,\n\"payload\": interface{}\n}\n\nActionType is a string with one of following values: \"SEND_MESSAGE\", \"UPDATE_MESSAGE\", \"DELETE_MESSAGE\", \"SEND_STICKER_MESSAGE\", \"MARK_READ\", \"TYPING_START\", \"TYPING_STOP\",\n\"CREATE_CONVERSATION\", \"INVITE_TO_CONVERSATION\", \"KICK_FROM_CONVERSATION\", \"LEAVE_CONVERSATION\", \"RENAME_CONVERSATION\", \"SET_CONVERSATION_ROLE\"\n\nMessages are sent to a group conversation if \"conversationId\" is set, otherwise to the dialog with \"receiver\".\nAttachments can only be sent to dialogs.\n\nIf \"type\" = \"SEND_MESSAGE\", then payload should be {\"content\": string, \"attachments\": []string}\nIf \"type\" = \"UPDATE_MESSAGE\", then payload should be {\"messageId\": uint, \"content\": string, attachmentsToDelete: []string}\nIf \"type\" = \"DELETE_MESSAGE\", then payload should be {\"messageId\": uint}\nIf \"type\" = \"SEND_STICKER_MESSAGE\", then payload should be {\"stickerId\": uint}\nIf \"type\" = \"MARK_READ\", then payload should be {\"messageId\": uint}, the dialog with \"receiver\" is marked as read up to this message\nIf \"type\" = \"TYPING_START\" or \"TYPING_STOP\", then payload should be {}, the action is only relayed to the peers and never stored\nIf \"type\" = \"CREATE_CONVERSATION\", then payload should be {\"name\": string, \"participantIds\": []uint}\nIf \"type\" = \"INVITE_TO_CONVERSATION\", then payload should be {\"userIds\": []uint}\nIf \"type\" = \"KICK_FROM_CONVERSATION\", then payload should be {\"userId\": uint}\nIf \"type\" = \"LEAVE_CONVERSATION\", then payload should be {}\nIf \"type\" = \"RENAME_CONVERSATION\", then payload should be {\"name\": string}\nIf \"type\" = \"SET_CONVERSATION_ROLE\", then payload should be {\"userId\": uint, \"role\": \"owner\" | \"admin\" | \"member\"}\n\nIn response clients, subscribed to corresponding channel, will get same structure back:\n{\n\"eventId\": string,\n\"type\": ActionType,\n\"receiver\": uint,\n\"conversationId\": uint,\n\"csrfToken\": string,\n\"payload\": interface{}\n}\n\n\"payload\" can be:\nPersonalMessage if \"type\" = \"SEND_MESSAGE\"\nPersonalMessage if \"type\" = \"UPDATE_MESSAGE\"\nAbsent if \"type\" = \"DELETE_MESSAGE\"\nPersonalMessage if \"type\" = \"SEND_STICKER_MESSAGE\"\n{\"userId\": uint, \"peerId\": uint, \"lastReadMessageId\": uint} if \"type\" = \"MARK_READ\"\n{\"userId\": uint} if \"type\" = \"TYPING_START\" or \"TYPING_STOP\"\nConversation if \"type\" is one of the conversation actions\n{\"userId\": uint, \"isOnline\": bool, \"lastSeen\": string} if \"type\" = \"PRESENCE\", it is pushed when someone you share a dialog or a conversation with goes online or offline\nNotification if \"type\" = \"NOTIFICATION\", it is pushed when someone likes or comments your post, subscribes to you or posts in your group\n{\"error\": string} if error happened at any point of query processing\n\n\"eventId\" is set for every action except \"TYPING_START\", \"TYPING_STOP\" and \"PRESENCE\", event IDs grow monotonically.\nPass the last received \"eventId\" as \"lastEventId\" when reconnecting to get the missed actions first, every action is delivered to the connection once.\n",
                 "consumes": [
                     "application/json"
                 ],
@@ -712,8 +691,8 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "get messages by dialog",
-                "operationId": "chat/get_messages",
+                "summary": "serve websocket connection",
+                "operationId": "chat/serve_ws",
                 "parameters": [
                     {
                         "type": "string",
@@ -724,46 +703,14 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "CSRF token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the peer",
-                        "name": "peerId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the last message, if last messages needed, should be set to 0",
-                        "name": "lastMessageId",
+                        "description": "ID of the last received event, missed actions after it are sent first",
+                        "name": "lastEventId",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.PersonalMessage"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -777,12 +724,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -792,9 +733,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/stickers/": {
+        "/chat/conversations": {
             "get": {
-                "description": "get all stickers",
+                "description": "get dialogs and group conversations of the user, most recently active first",
                 "consumes": [
                     "application/json"
                 ],
@@ -804,8 +745,8 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "get all stickers",
-                "operationId": "chat/get_all_stickers",
+                "summary": "get user conversations",
+                "operationId": "chat/get_conversations",
                 "parameters": [
                     {
                         "type": "string",
@@ -836,7 +777,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.Sticker"
+                                                "$ref": "#/definitions/domain.Conversation"
                                             }
                                         }
                                     }
@@ -869,11 +810,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create sticker",
+            }
+        },
+        "/chat/conversations/{conversationID}": {
+            "get": {
+                "description": "get conversation with its participants, the user has to be one of them",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -881,21 +824,14 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "create sticker",
-                "operationId": "chat/create_sticker",
+                "summary": "get conversation",
+                "operationId": "chat/get_conversation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Name of the sticker",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Image of the sticker",
-                        "name": "image",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "ID of the conversation",
+                        "name": "conversationID",
+                        "in": "path",
                         "required": true
                     },
                     {
@@ -914,8 +850,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -925,7 +861,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.Sticker"
+                                            "$ref": "#/definitions/domain.Conversation"
                                         }
                                     }
                                 }
@@ -950,6 +886,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -959,9 +901,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/stickers/{authorID}": {
+        "/chat/conversations/{conversationID}/messages": {
             "get": {
-                "description": "get stickers by author ID",
+                "description": "get messages by conversation with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -971,13 +913,13 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "get stickers by author ID",
-                "operationId": "chat/get_stickers",
+                "summary": "get messages by conversation",
+                "operationId": "chat/get_conversation_messages",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the author",
-                        "name": "authorID",
+                        "description": "ID of the conversation",
+                        "name": "conversationID",
                         "in": "path",
                         "required": true
                     },
@@ -994,8 +936,20 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    }
-                ],
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last message, if last messages needed, should be set to 0",
+                        "name": "lastMessageId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of messages to return",
+                        "name": "messagesAmount",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1010,7 +964,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.Sticker"
+                                                "$ref": "#/definitions/domain.PersonalMessage"
                                             }
                                         }
                                     }
@@ -1045,9 +999,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/chat/stickers/{stickerID}": {
-            "delete": {
-                "description": "delete sticker",
+        "/chat/dialogs/": {
+            "get": {
+                "description": "get user dialogs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1057,16 +1011,9 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "delete sticker",
-                "operationId": "chat/delete_sticker",
+                "summary": "get user dialogs",
+                "operationId": "chat/get_dialogs",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID of the sticker",
-                        "name": "stickerID",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -1082,57 +1029,6 @@ const docTemplate = `{
                         "required": true
                     }
                 ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    }
-                }
-            }
-        },
-        "/csrf/": {
-            "get": {
-                "description": "Get CSRF token",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "csrf"
-                ],
-                "summary": "Get CSRF token",
-                "operationId": "csrf/get_csrf_token",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1145,7 +1041,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/rest.CSRFTokenResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.Dialog"
+                                            }
                                         }
                                     }
                                 }
@@ -1164,6 +1063,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1173,9 +1078,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/": {
-            "post": {
-                "description": "create public group",
+        "/chat/dialogs/{receiverID}/unsent-attachments/": {
+            "get": {
+                "description": "get unsent message attachments, returns array of filenames",
                 "consumes": [
                     "application/json"
                 ],
@@ -1183,10 +1088,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "create public group",
-                "operationId": "groups/create",
+                "summary": "get unsent message attachments",
+                "operationId": "chat/get_unsent_message_attachments",
                 "parameters": [
                     {
                         "type": "string",
@@ -1203,29 +1108,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Name of the group",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Description of the group",
-                        "name": "description",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "ID of the receiver",
+                        "name": "receiverID",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar of the group",
-                        "name": "avatar",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -1235,7 +1127,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.PublicGroup"
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
                                         }
                                     }
                                 }
@@ -1267,23 +1162,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/by-sub/{userID}": {
-            "get": {
-                "description": "get public groups by subscriber ID",
+            },
+            "post": {
+                "description": "create unsent message attachments",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "get public groups by subscriber ID",
-                "operationId": "groups/by-sub",
+                "summary": "create unsent message attachments",
+                "operationId": "chat/create_unsent_message_attachments",
                 "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Attachment file",
+                        "name": "attachment",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -1299,15 +1199,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
-                        "in": "path"
+                        "type": "integer",
+                        "description": "ID of the receiver",
+                        "name": "receiverID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1319,7 +1220,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.PublicGroup"
+                                                "type": "string"
                                             }
                                         }
                                     }
@@ -1345,8 +1246,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -1358,11 +1265,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/search": {
-            "get": {
-                "description": "search public groups by name",
+            },
+            "delete": {
+                "description": "delete unsent message attachments",
                 "consumes": [
                     "application/json"
                 ],
@@ -1370,10 +1275,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "search public groups by name",
-                "operationId": "groups/search",
+                "summary": "delete unsent message attachments",
+                "operationId": "chat/delete_unsent_message_attachments",
                 "parameters": [
                     {
                         "type": "string",
@@ -1390,33 +1295,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "ID of the receiver",
+                        "name": "receiverID",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/publicgroup.PublicGroupWithInfo"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
                     "401": {
@@ -1431,12 +1324,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1446,9 +1333,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}": {
-            "get": {
-                "description": "get public group by ID",
+        "/chat/dialogs/{receiverID}/unsent-attachments/{fileName}": {
+            "delete": {
+                "description": "delete unsent message attachment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1456,10 +1343,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "get public group by ID",
-                "operationId": "groups/get",
+                "summary": "delete unsent message attachment",
+                "operationId": "chat/delete_unsent_message_attachment",
                 "parameters": [
                     {
                         "type": "string",
@@ -1475,31 +1362,29 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "ID of the receiver",
+                        "name": "receiverID",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
+                        "description": "Name of the file",
+                        "name": "fileName",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/publicgroup.PublicGroupWithInfo"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
                     "401": {
@@ -1514,12 +1399,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1527,20 +1406,22 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "update public group",
+            }
+        },
+        "/chat/messages/": {
+            "get": {
+                "description": "get messages by dialog with pagination",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "update public group",
-                "operationId": "groups/update",
+                "summary": "get messages by dialog",
+                "operationId": "chat/get_messages",
                 "parameters": [
                     {
                         "type": "string",
@@ -1557,29 +1438,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "ID of the peer",
+                        "name": "peerId",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Name of the group",
-                        "name": "name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Description of the group",
-                        "name": "description",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar of the group",
-                        "name": "avatar",
-                        "in": "formData"
+                        "type": "integer",
+                        "description": "ID of the last message, if last messages needed, should be set to 0",
+                        "name": "lastMessageId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1594,7 +1463,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.PublicGroup"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PersonalMessage"
+                                            }
                                         }
                                     }
                                 }
@@ -1619,12 +1491,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1632,9 +1498,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete public group",
+            }
+        },
+        "/chat/messages/{messageID}/attachments/{fileName}": {
+            "get": {
+                "description": "get a new short-lived link to the attachment of the message, the user has to be a peer of the dialog or a participant of the conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -1642,10 +1510,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "delete public group",
-                "operationId": "groups/delete",
+                "summary": "get message attachment url",
+                "operationId": "chat/get_attachment_url",
                 "parameters": [
                     {
                         "type": "string",
@@ -1661,19 +1529,38 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "ID of the message",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
+                        "description": "Name of the file",
+                        "name": "fileName",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.AttachmentURL"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1709,9 +1596,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/admins/": {
+        "/chat/presence": {
             "get": {
-                "description": "get admins by public group ID",
+                "description": "get online status and last seen time of the users",
                 "consumes": [
                     "application/json"
                 ],
@@ -1719,10 +1606,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "get admins by public group ID",
-                "operationId": "groups/admins",
+                "summary": "get users presence",
+                "operationId": "chat/get_presences",
                 "parameters": [
                     {
                         "type": "string",
@@ -1740,9 +1627,9 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
+                        "description": "Comma separated IDs of the users, at most 100",
+                        "name": "userIds",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -1760,7 +1647,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.User"
+                                                "$ref": "#/definitions/domain.Presence"
                                             }
                                         }
                                     }
@@ -1786,12 +1673,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1799,9 +1680,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create public group admin",
+            }
+        },
+        "/chat/stickers/": {
+            "get": {
+                "description": "get all stickers",
                 "consumes": [
                     "application/json"
                 ],
@@ -1809,10 +1692,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "create public group admin",
-                "operationId": "groups/admin/create",
+                "summary": "get all stickers",
+                "operationId": "chat/get_all_stickers",
                 "parameters": [
                     {
                         "type": "string",
@@ -1827,29 +1710,28 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.Sticker"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1870,12 +1752,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1884,20 +1760,34 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "delete public group admin",
+            "post": {
+                "description": "create sticker",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "delete public group admin",
-                "operationId": "groups/admin/delete",
+                "summary": "create sticker",
+                "operationId": "chat/create_sticker",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the sticker",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image of the sticker",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -1911,20 +1801,25 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.Sticker"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1945,8 +1840,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -1960,9 +1861,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/admins/check": {
+        "/chat/stickers/{authorID}": {
             "get": {
-                "description": "check if user is admin",
+                "description": "get stickers by author ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1970,11 +1871,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "check if user is admin",
-                "operationId": "groups/admins/check",
-                "parameters": [
+                "summary": "get stickers by author ID",
+                "operationId": "chat/get_stickers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the author",
+                        "name": "authorID",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -1988,13 +1896,6 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2009,7 +1910,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/rest.CheckIfUserIsAdminRes"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.Sticker"
+                                            }
                                         }
                                     }
                                 }
@@ -2034,12 +1938,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2049,9 +1947,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/is-sub": {
-            "get": {
-                "description": "get subscription by public group ID and subscriber ID",
+        "/chat/stickers/{stickerID}": {
+            "delete": {
+                "description": "delete sticker",
                 "consumes": [
                     "application/json"
                 ],
@@ -2059,11 +1957,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "chat"
                 ],
-                "summary": "get subscription by public group ID and subscriber ID",
-                "operationId": "groups/is-sub",
+                "summary": "delete sticker",
+                "operationId": "chat/delete_sticker",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the sticker",
+                        "name": "stickerID",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -2077,33 +1982,11 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/domain.PublicGroupSubscription"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2138,9 +2021,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/posts/": {
+        "/csrf/": {
             "get": {
-                "description": "get posts of public group",
+                "description": "Get CSRF token",
                 "consumes": [
                     "application/json"
                 ],
@@ -2148,45 +2031,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
-                ],
-                "summary": "get posts of public group",
-                "operationId": "groups/posts",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "session_id=some_session",
-                        "name": "Cookie",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "CSRF token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Last post ID",
-                        "name": "lastPostId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Posts amount",
-                        "name": "postsAmount",
-                        "in": "query"
-                    }
+                    "csrf"
                 ],
+                "summary": "Get CSRF token",
+                "operationId": "csrf/get_csrf_token",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2199,10 +2047,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.Post"
-                                            }
+                                            "$ref": "#/definitions/rest.CSRFTokenResponse"
                                         }
                                     }
                                 }
@@ -2221,18 +2066,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2240,11 +2073,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/groups/": {
             "post": {
-                "description": "create post in public group",
+                "description": "create public group",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -2252,8 +2087,8 @@ const docTemplate = `{
                 "tags": [
                     "groups"
                 ],
-                "summary": "create post in public group",
-                "operationId": "groups/posts/create",
+                "summary": "create public group",
+                "operationId": "groups/create",
                 "parameters": [
                     {
                         "type": "string",
@@ -2271,22 +2106,28 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
+                        "description": "Name of the group",
+                        "name": "name",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Content of the post",
-                        "name": "content",
+                        "description": "Description of the group",
+                        "name": "description",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "file",
-                        "description": "Attachments of the post",
-                        "name": "attachments",
+                        "description": "Avatar of the group",
+                        "name": "avatar",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the completed resumable upload of the avatar",
+                        "name": "avatarUpload",
                         "in": "formData"
                     }
                 ],
@@ -2302,7 +2143,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                                            "$ref": "#/definitions/domain.PublicGroup"
                                         }
                                     }
                                 }
@@ -2327,8 +2168,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -2342,9 +2189,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/sub": {
-            "post": {
-                "description": "subscribe to public group",
+        "/groups/by-sub/{userID}": {
+            "get": {
+                "description": "get public groups by subscriber ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2354,8 +2201,8 @@ const docTemplate = `{
                 "tags": [
                     "groups"
                 ],
-                "summary": "subscribe to public group",
-                "operationId": "groups/sub",
+                "summary": "get public groups by subscriber ID",
+                "operationId": "groups/by-sub",
                 "parameters": [
                     {
                         "type": "string",
@@ -2373,17 +2220,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
-                        "required": true
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PublicGroup"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2419,9 +2280,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{groupID}/unsub": {
-            "post": {
-                "description": "unsubscribe from public group",
+        "/groups/search": {
+            "get": {
+                "description": "search public groups by name",
                 "consumes": [
                     "application/json"
                 ],
@@ -2431,8 +2292,8 @@ const docTemplate = `{
                 "tags": [
                     "groups"
                 ],
-                "summary": "unsubscribe from public group",
-                "operationId": "groups/unsub",
+                "summary": "search public groups by name",
+                "operationId": "groups/search",
                 "parameters": [
                     {
                         "type": "string",
@@ -2450,23 +2311,44 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupID",
-                        "in": "path",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last found group, if 0 - get first groups",
+                        "name": "lastGroupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of groups to get, if 0 - get 20 groups",
+                        "name": "amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/publicgroup.PublicGroupWithInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -2496,9 +2378,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/": {
+        "/groups/{groupID}": {
             "get": {
-                "description": "get user posts",
+                "description": "get public group by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2506,10 +2388,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "get user posts",
-                "operationId": "posts/get_user_posts",
+                "summary": "get public group by ID",
+                "operationId": "groups/get",
                 "parameters": [
                     {
                         "type": "string",
@@ -2526,36 +2408,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the user",
-                        "name": "userId",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "ID of the last post, if 0 - get first posts",
-                        "name": "lastPostId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Amount of posts to get, if 0 - get 20 posts",
-                        "name": "postsAmount",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.ListUserPostsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/publicgroup.PublicGroupWithInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -2570,6 +2446,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2579,7 +2461,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "update post by id",
+                "description": "update public group",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -2587,10 +2469,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "update post",
-                "operationId": "posts/update",
+                "summary": "update public group",
+                "operationId": "groups/update",
                 "parameters": [
                     {
                         "type": "string",
@@ -2607,43 +2489,40 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the post",
-                        "name": "postId",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Content of the post",
-                        "name": "content",
-                        "in": "formData",
-                        "required": true
+                        "description": "Name of the group",
+                        "name": "name",
+                        "in": "formData"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Attachments to delete",
-                        "name": "attachmentsToDelete",
+                        "type": "string",
+                        "description": "Description of the group",
+                        "name": "description",
                         "in": "formData"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "file"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Attachments of the post",
-                        "name": "attachments",
+                        "type": "file",
+                        "description": "Avatar of the group",
+                        "name": "avatar",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the completed resumable upload of the avatar",
+                        "name": "avatarUpload",
                         "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "application/json\"\t\"Attachments is always null!!!",
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -2653,10 +2532,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "object",
-                                            "additionalProperties": {
-                                                "$ref": "#/definitions/domain.Post"
-                                            }
+                                            "$ref": "#/definitions/domain.PublicGroup"
                                         }
                                     }
                                 }
@@ -2687,6 +2563,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2695,19 +2583,19 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "create post with attachments",
+            "delete": {
+                "description": "delete public group",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "create post",
-                "operationId": "posts/create",
+                "summary": "delete public group",
+                "operationId": "groups/delete",
                 "parameters": [
                     {
                         "type": "string",
@@ -2725,35 +2613,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Content of the post",
-                        "name": "content",
-                        "in": "formData",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Attachments of the post",
-                        "name": "attachments",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/domain.PostWithAuthor"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
                     "400": {
@@ -2774,6 +2644,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2781,9 +2657,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete post by id",
+            }
+        },
+        "/groups/{groupID}/admins/": {
+            "get": {
+                "description": "get admins by public group ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2791,10 +2669,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "delete post",
-                "operationId": "posts/delete",
+                "summary": "get admins by public group ID",
+                "operationId": "groups/admins",
                 "parameters": [
                     {
                         "type": "string",
@@ -2811,20 +2689,33 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the post",
-                        "name": "post_id",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.User"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2845,6 +2736,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2852,11 +2749,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/all": {
-            "get": {
-                "description": "get posts by group subscriptions and user subscriptions",
+            },
+            "post": {
+                "description": "create public group admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2864,10 +2759,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "get posts by group subscriptions and user subscriptions",
-                "operationId": "posts/get_posts_by_group_subscriptions_and_user_subscriptions",
+                "summary": "create public group admin",
+                "operationId": "groups/admin/create",
                 "parameters": [
                     {
                         "type": "string",
@@ -2884,26 +2779,27 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the last post, if 0 - get first posts",
-                        "name": "lastPostId",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Amount of posts to get, if 0 - get 20 posts",
-                        "name": "postsAmount",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
-                            }
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
                     "400": {
@@ -2937,11 +2833,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/comment": {
-            "put": {
-                "description": "update comment",
+            },
+            "delete": {
+                "description": "delete public group admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2949,10 +2843,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "update comment",
-                "operationId": "posts/update_comment",
+                "summary": "delete public group admin",
+                "operationId": "groups/admin/delete",
                 "parameters": [
                     {
                         "type": "string",
@@ -2969,29 +2863,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the comment",
-                        "name": "commentID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "Content of the comment",
-                        "name": "content",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/domain.CommentWithAuthor"
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
                     "400": {
@@ -3025,9 +2908,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create comment",
+            }
+        },
+        "/groups/{groupID}/admins/check": {
+            "get": {
+                "description": "check if user is admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -3035,10 +2920,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "create comment",
-                "operationId": "posts/create_comment",
+                "summary": "check if user is admin",
+                "operationId": "groups/admins/check",
                 "parameters": [
                     {
                         "type": "string",
@@ -3055,29 +2940,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Content of the comment",
-                        "name": "content",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "ID of the post",
-                        "name": "postID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.CommentWithAuthor"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/rest.CheckIfUserIsAdminRes"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3111,9 +2997,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete comment",
+            }
+        },
+        "/groups/{groupID}/is-sub": {
+            "get": {
+                "description": "get subscription by public group ID and subscriber ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3121,10 +3009,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "delete comment",
-                "operationId": "posts/delete_comment",
+                "summary": "get subscription by public group ID and subscriber ID",
+                "operationId": "groups/is-sub",
                 "parameters": [
                     {
                         "type": "string",
@@ -3141,20 +3029,30 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the comment",
-                        "name": "commentId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PublicGroupSubscription"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3190,9 +3088,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/comments/like": {
-            "post": {
-                "description": "like comment",
+        "/groups/{groupID}/posts/": {
+            "get": {
+                "description": "get posts of public group",
                 "consumes": [
                     "application/json"
                 ],
@@ -3200,10 +3098,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "like comment",
-                "operationId": "posts/like_comment",
+                "summary": "get posts of public group",
+                "operationId": "groups/posts",
                 "parameters": [
                     {
                         "type": "string",
@@ -3220,20 +3118,45 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the comment",
-                        "name": "commentID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last post ID",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Posts amount",
+                        "name": "postsAmount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.CommentLike"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.Post"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3267,22 +3190,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/comments/unlike": {
-            "delete": {
-                "description": "unlike comment",
+            },
+            "post": {
+                "description": "create post in public group",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "unlike comment",
-                "operationId": "posts/unlike_comment",
+                "summary": "create post in public group",
+                "operationId": "groups/posts/create",
                 "parameters": [
                     {
                         "type": "string",
@@ -3299,20 +3220,43 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the comment",
-                        "name": "commentID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content of the post",
+                        "name": "content",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Attachments of the post",
+                        "name": "attachments",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3339,6 +3283,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3348,9 +3304,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/friends": {
-            "get": {
-                "description": "get user friends posts",
+        "/groups/{groupID}/posts/repost": {
+            "post": {
+                "description": "share the post in the public group, reposting a repost shares its original",
                 "consumes": [
                     "application/json"
                 ],
@@ -3358,10 +3314,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "get user friends posts",
-                "operationId": "posts/get_user_friends_posts",
+                "summary": "repost post to public group",
+                "operationId": "groups/posts/repost",
                 "parameters": [
                     {
                         "type": "string",
@@ -3378,21 +3334,33 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the last post",
-                        "name": "lastPostId",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Amount of posts to get, if 0 - get 20 posts",
-                        "name": "postsAmount",
-                        "in": "query"
+                        "description": "ID of the reposted post",
+                        "name": "postId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Commentary of the repost",
+                        "name": "content",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -3402,10 +3370,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.PostWithAuthor"
-                                            }
+                                            "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
                                         }
                                     }
                                 }
@@ -3430,6 +3395,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3439,9 +3410,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/groups": {
-            "get": {
-                "description": "get group posts by subscriptions",
+        "/groups/{groupID}/sub": {
+            "post": {
+                "description": "subscribe to public group",
                 "consumes": [
                     "application/json"
                 ],
@@ -3449,10 +3420,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "get group posts by subscriptions",
-                "operationId": "posts/get_group_posts_by_subscriptions",
+                "summary": "subscribe to public group",
+                "operationId": "groups/sub",
                 "parameters": [
                     {
                         "type": "string",
@@ -3469,26 +3440,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the last post, if 0 - get first posts",
-                        "name": "lastPostId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Amount of posts to get, if 0 - get 20 posts",
-                        "name": "postsAmount",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
-                            }
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
                     "400": {
@@ -3524,9 +3487,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/like": {
+        "/groups/{groupID}/unsub": {
             "post": {
-                "description": "like post",
+                "description": "unsubscribe from public group",
                 "consumes": [
                     "application/json"
                 ],
@@ -3534,10 +3497,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "like post",
-                "operationId": "posts/like",
+                "summary": "unsubscribe from public group",
+                "operationId": "groups/unsub",
                 "parameters": [
                     {
                         "type": "string",
@@ -3554,20 +3517,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the post",
-                        "name": "postId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/domain.PostLike"
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
                     "400": {
@@ -3603,9 +3564,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/liked": {
-            "get": {
-                "description": "get posts that are authored by authorized user and liked by some people,\nfor every like it returns post and user that liked that post",
+        "/moderation/content/hide": {
+            "post": {
+                "description": "hide a post, a comment, a message or a sticker from everyone, the open report case of the target is resolved",
                 "consumes": [
                     "application/json"
                 ],
@@ -3613,10 +3574,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "moderation"
                 ],
-                "summary": "get liked posts",
-                "operationId": "posts/get_liked_posts",
+                "summary": "hide content",
+                "operationId": "moderation/content/hide",
                 "parameters": [
                     {
                         "type": "string",
@@ -3633,26 +3594,32 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the last like, if 0 - get first likes",
-                        "name": "lastLikeId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Amount of liked posts to get, if 0 - get 20 liked posts",
-                        "name": "postsAmount",
-                        "in": "query"
+                        "description": "Content to hide and the reason",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.HideContentInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/posts.LikeWithPostAndUser"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.ModerationLogEntry"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3688,9 +3655,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/new": {
-            "get": {
-                "description": "get new posts",
+        "/moderation/groups/{groupID}": {
+            "delete": {
+                "description": "delete the group with all its posts, the open report case of the group is resolved",
                 "consumes": [
                     "application/json"
                 ],
@@ -3698,10 +3665,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "moderation"
                 ],
-                "summary": "get new posts",
-                "operationId": "posts/get_new_posts",
+                "summary": "delete group",
+                "operationId": "moderation/groups/delete",
                 "parameters": [
                     {
                         "type": "string",
@@ -3718,26 +3685,39 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the last post, if 0 - get first posts",
-                        "name": "lastPostId",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ID of the group to delete",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Amount of posts to get, if 0 - get 20 posts",
-                        "name": "postsAmount",
-                        "in": "query"
+                        "description": "Reason of the deletion",
+                        "name": "reason",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.ModerationReasonInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.ModerationLogEntry"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3773,9 +3753,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/unlike": {
-            "delete": {
-                "description": "unlike post",
+        "/moderation/log": {
+            "get": {
+                "description": "get the actions of all the moderators, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -3783,10 +3763,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "moderation"
                 ],
-                "summary": "unlike post",
-                "operationId": "posts/unlike",
+                "summary": "get moderation log",
+                "operationId": "moderation/log",
                 "parameters": [
                     {
                         "type": "string",
@@ -3803,20 +3783,38 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "ID of the post",
-                        "name": "postId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "integer",
+                        "description": "ID of the last entry, if 0 - get latest entries",
+                        "name": "lastEntryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of entries to get, if 0 - get 20 entries, at most 100",
+                        "name": "entriesAmount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/json.JSONResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ModerationLogEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3837,12 +3835,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3852,9 +3844,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{id}": {
+        "/moderation/reports": {
             "get": {
-                "description": "get post by id",
+                "description": "get open report cases, the oldest first, every case gathers the reports about one target, the content held by the content filter has a case without reports",
                 "consumes": [
                     "application/json"
                 ],
@@ -3862,10 +3854,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "moderation"
                 ],
-                "summary": "get post by id",
-                "operationId": "posts/get_post_by_id",
+                "summary": "get moderation queue",
+                "operationId": "moderation/reports",
                 "parameters": [
                     {
                         "type": "string",
@@ -3883,10 +3875,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "ID of the post",
-                        "name": "postId",
-                        "in": "query",
-                        "required": true
+                        "description": "ID of the last case, if 0 - get first cases",
+                        "name": "lastCaseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of cases to get, if 0 - get 20 cases, at most 100",
+                        "name": "casesAmount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3901,7 +3898,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ReportCase"
+                                            }
                                         }
                                     }
                                 }
@@ -3935,9 +3935,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{postID}/comments": {
-            "get": {
-                "description": "get comments by post id",
+        "/moderation/reports/{caseID}/dismiss": {
+            "post": {
+                "description": "close the open report case without acting on the target, the content held by the content filter is shown",
                 "consumes": [
                     "application/json"
                 ],
@@ -3945,10 +3945,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "moderation"
                 ],
-                "summary": "get comments by post id",
-                "operationId": "posts/get_comments_by_post_id",
+                "summary": "dismiss report case",
+                "operationId": "moderation/reports/dismiss",
                 "parameters": [
                     {
                         "type": "string",
@@ -3965,21 +3965,39 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID of the post",
-                        "name": "postID",
+                        "type": "string",
+                        "description": "ID of the report case",
+                        "name": "caseID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason of the decision",
+                        "name": "reason",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.ModerationReasonInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.CommentWithAuthor"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.ModerationLogEntry"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -4015,20 +4033,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/profile/": {
-            "put": {
-                "description": "update user profile",
+        "/moderation/users/{userID}/ban": {
+            "post": {
+                "description": "forbid the user to log in and revoke all the sessions of the user",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "moderation"
                 ],
-                "summary": "update user profile",
-                "operationId": "profile/update",
+                "summary": "ban user",
+                "operationId": "moderation/users/ban",
                 "parameters": [
                     {
                         "type": "string",
@@ -4046,45 +4064,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "First name",
-                        "name": "firstName",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Last name",
-                        "name": "lastName",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Email",
-                        "name": "email",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Password",
-                        "name": "password",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Repeat password",
-                        "name": "repeatPassword",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Date of birth",
-                        "name": "dateOfBirth",
-                        "in": "formData"
+                        "description": "ID of the user to ban",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Avatar",
-                        "name": "avatar",
-                        "in": "formData"
+                        "description": "Reason of the ban",
+                        "name": "reason",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.ModerationReasonInput"
+                        }
                     }
                 ],
                 "responses": {
@@ -4099,7 +4091,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.User"
+                                            "$ref": "#/definitions/domain.ModerationLogEntry"
                                         }
                                     }
                                 }
@@ -4137,67 +4129,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete user profile",
-                "consumes": [
-                    "application/json"
-                ],
-                "tags": [
-                    "profile"
-                ],
-                "summary": "delete user profile",
-                "operationId": "profile/delete",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "session_id=some_session",
-                        "name": "Cookie",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "CSRF token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    }
-                }
             }
         },
-        "/profile/search": {
+        "/notifications/": {
             "get": {
-                "description": "search users by name",
+                "description": "get notifications of the user, latest activity first",
                 "consumes": [
                     "application/json"
                 ],
@@ -4205,10 +4141,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "notifications"
                 ],
-                "summary": "search users by name",
-                "operationId": "profile/search",
+                "summary": "get notifications",
+                "operationId": "notifications/get",
                 "parameters": [
                     {
                         "type": "string",
@@ -4225,11 +4161,16 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "ID of the last notification, if 0 - get first notifications",
+                        "name": "lastNotificationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of notifications to get, if 0 - get 20 notifications, at most 100",
+                        "name": "notificationsAmount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4246,7 +4187,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.User"
+                                                "$ref": "#/definitions/domain.NotificationWithActor"
                                             }
                                         }
                                     }
@@ -4272,12 +4213,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4287,9 +4222,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/profile/{userID}": {
-            "get": {
-                "description": "get user profile with subscriptions info",
+        "/notifications/read": {
+            "post": {
+                "description": "mark notifications of the user read, all of them if no IDs are given",
                 "consumes": [
                     "application/json"
                 ],
@@ -4297,10 +4232,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "notifications"
                 ],
-                "summary": "get user profile with subscriptions info",
-                "operationId": "profile/get",
+                "summary": "mark notifications read",
+                "operationId": "notifications/read",
                 "parameters": [
                     {
                         "type": "string",
@@ -4317,45 +4252,38 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID, if empty - get authorized user profile",
-                        "name": "userID",
-                        "in": "path"
+                        "description": "IDs of the notifications",
+                        "name": "notificationIds",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/user.UserWithSubsInfo"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/json.JSONResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -4369,9 +4297,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/subscriptions/": {
-            "post": {
-                "description": "subscribe to user",
+        "/notifications/unread-count": {
+            "get": {
+                "description": "get amount of unread notifications of the user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4379,20 +4307,11 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "notifications"
                 ],
-                "summary": "handle user's subscription flow",
-                "operationId": "subscriptions/subscribe",
+                "summary": "get unread notifications count",
+                "operationId": "notifications/unread_count",
                 "parameters": [
-                    {
-                        "description": "Subscribed to ID",
-                        "name": "subscribedTo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -4409,8 +4328,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -4420,7 +4339,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/domain.Subscription"
+                                            "$ref": "#/definitions/notifications.UnreadCountResponse"
                                         }
                                     }
                                 }
@@ -4452,9 +4371,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "unsubscribe from user",
+            }
+        },
+        "/posts/": {
+            "get": {
+                "description": "get user posts",
                 "consumes": [
                     "application/json"
                 ],
@@ -4462,20 +4383,11 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "handle user's unsubscription flow",
-                "operationId": "subscriptions/unsubscribe",
+                "summary": "get user posts",
+                "operationId": "posts/get_user_posts",
                 "parameters": [
-                    {
-                        "description": "User to unsubscribe from",
-                        "name": "subscribedTo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "session_id=some_session",
@@ -4489,11 +4401,33 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the user",
+                        "name": "userId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post, if 0 - get first posts",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ListUserPostsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4513,12 +4447,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4526,22 +4454,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/subscriptions/friends/": {
-            "get": {
-                "description": "get user's friends",
+            },
+            "put": {
+                "description": "update post by id",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "get user's friends",
-                "operationId": "subscriptions/friends",
+                "summary": "update post",
+                "operationId": "posts/update",
                 "parameters": [
                     {
                         "type": "string",
@@ -4556,11 +4482,55 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the post",
+                        "name": "postId",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content of the post",
+                        "name": "content",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Attachments to delete",
+                        "name": "attachmentsToDelete",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "file"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Attachments of the post",
+                        "name": "attachments",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "IDs of the completed resumable uploads to attach",
+                        "name": "uploads",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "application/json\"\t\"Attachments is always null!!!",
                         "schema": {
                             "allOf": [
                                 {
@@ -4570,7 +4540,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/subscriptions.GetFriendsResponse"
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "$ref": "#/definitions/domain.Post"
+                                            }
                                         }
                                     }
                                 }
@@ -4601,6 +4574,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4608,22 +4593,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/subscriptions/subscribers/": {
-            "get": {
-                "description": "get user's subscribers",
+            },
+            "post": {
+                "description": "create post with attachments",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "get user's subscribers",
-                "operationId": "subscriptions/subscribers",
+                "summary": "create post",
+                "operationId": "posts/create",
                 "parameters": [
                     {
                         "type": "string",
@@ -4638,11 +4621,34 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content of the post",
+                        "name": "content",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Attachments of the post",
+                        "name": "attachments",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "IDs of the completed resumable uploads to attach",
+                        "name": "uploads",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -4652,7 +4658,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/subscriptions.GetSubscribersResponse"
+                                            "$ref": "#/definitions/domain.PostWithAuthor"
                                         }
                                     }
                                 }
@@ -4677,8 +4683,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/errors.HTTPError"
                         }
@@ -4690,11 +4708,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/subscriptions/subscriptions/": {
-            "get": {
-                "description": "get user's subscriptions",
+            },
+            "delete": {
+                "description": "delete post by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -4702,10 +4718,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "get user's subscriptions",
-                "operationId": "subscriptions/subscriptions",
+                "summary": "delete post",
+                "operationId": "posts/delete",
                 "parameters": [
                     {
                         "type": "string",
@@ -4720,25 +4736,28 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "ID of the post",
+                        "name": "post_id",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/json.JSONResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/subscriptions.GetSubscriptionsResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/json.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
                         }
                     },
                     "401": {
@@ -4761,35 +4780,3715 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.Comment": {
-            "type": "object",
-            "properties": {
-                "authorId": {
-                    "type": "integer"
-                },
-                "content": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2021-01-01T00:00:00Z"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "likedBy": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "postId": {
-                    "type": "integer"
-                },
+        },
+        "/posts/all": {
+            "get": {
+                "description": "get posts by group subscriptions and user subscriptions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get posts by group subscriptions and user subscriptions",
+                "operationId": "posts/get_posts_by_group_subscriptions_and_user_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post, if 0 - get first posts",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/comment": {
+            "put": {
+                "description": "update comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "update comment",
+                "operationId": "posts/update_comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the comment",
+                        "name": "commentID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Content of the comment",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CommentWithAuthor"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "create comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "create comment",
+                "operationId": "posts/create_comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Content of the comment",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ID of the post",
+                        "name": "postID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "ID of the replied comment, 0 for a top level comment",
+                        "name": "parentID",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CommentWithAuthor"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "delete comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "delete comment",
+                "operationId": "posts/delete_comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the comment",
+                        "name": "commentId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/json.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/comments/like": {
+            "post": {
+                "description": "like comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "like comment",
+                "operationId": "posts/like_comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the comment",
+                        "name": "commentID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CommentLike"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/comments/unlike": {
+            "delete": {
+                "description": "unlike comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "unlike comment",
+                "operationId": "posts/unlike_comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the comment",
+                        "name": "commentID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/json.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/comments/{commentID}/replies": {
+            "get": {
+                "description": "get direct replies of the comment with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get comment replies",
+                "operationId": "posts/get_comment_replies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the comment",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last reply, if 0 - get first replies",
+                        "name": "lastReplyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of replies to get, if 0 - get 20 replies",
+                        "name": "repliesAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.CommentWithAuthor"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/feed": {
+            "get": {
+                "description": "get posts of group subscriptions and user subscriptions ranked by likes, comments, author affinity and freshness",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get ranked feed",
+                "operationId": "posts/get_feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page from the previous response, if empty - get first posts",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.FeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/friends": {
+            "get": {
+                "description": "get user friends posts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get user friends posts",
+                "operationId": "posts/get_user_friends_posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PostWithAuthor"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/groups": {
+            "get": {
+                "description": "get group posts by subscriptions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get group posts by subscriptions",
+                "operationId": "posts/get_group_posts_by_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post, if 0 - get first posts",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/hashtags/trending": {
+            "get": {
+                "description": "get the hashtags used in the most posts and comments created during the window",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get trending hashtags",
+                "operationId": "posts/get_trending_hashtags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time window like 1h or 168h, if empty - 24h, at most 720h",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of hashtags to get, if 0 - get 10 hashtags, at most 50",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/posts.TrendingHashtag"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/hashtags/{hashtag}/posts": {
+            "get": {
+                "description": "get the posts with the hashtag, the newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get posts by hashtag",
+                "operationId": "posts/get_posts_by_hashtag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hashtag without #, case insensitive",
+                        "name": "hashtag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post, if 0 - get first posts",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/like": {
+            "post": {
+                "description": "like post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "like post",
+                "operationId": "posts/like",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the post",
+                        "name": "postId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PostLike"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/liked": {
+            "get": {
+                "description": "get posts that are authored by authorized user and liked by some people,\nfor every like it returns post and user that liked that post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get liked posts",
+                "operationId": "posts/get_liked_posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last like, if 0 - get first likes",
+                        "name": "lastLikeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of liked posts to get, if 0 - get 20 liked posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/posts.LikeWithPostAndUser"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/new": {
+            "get": {
+                "description": "get new posts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get new posts",
+                "operationId": "posts/get_new_posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last post, if 0 - get first posts",
+                        "name": "lastPostId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of posts to get, if 0 - get 20 posts",
+                        "name": "postsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/repost": {
+            "post": {
+                "description": "share the post on the wall of the user, reposting a repost shares its original",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "repost post",
+                "operationId": "posts/repost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the reposted post",
+                        "name": "postId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Commentary of the repost",
+                        "name": "content",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PostWithAuthor"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/search": {
+            "get": {
+                "description": "full-text search over the own posts, the posts of the subscriptions and of the public groups or over their comments, the most relevant first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "search posts and comments",
+                "operationId": "posts/search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query, supports quotes, OR and -",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "What to search: posts or comments, if empty - posts",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page from the previous response, if empty - get first results",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of results to get, if 0 - get 20 results",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_rest_posts.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/unlike": {
+            "delete": {
+                "description": "unlike post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "unlike post",
+                "operationId": "posts/unlike",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "ID of the post",
+                        "name": "postId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/json.JSONResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}": {
+            "get": {
+                "description": "get post by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get post by id",
+                "operationId": "posts/get_post_by_id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the post",
+                        "name": "postId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{postID}/comments": {
+            "get": {
+                "description": "get top level comments of the post with pagination, every comment has a preview of its first replies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "get comments by post id",
+                "operationId": "posts/get_comments_by_post_id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the post",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last comment, if 0 - get first comments",
+                        "name": "lastCommentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of comments to get, if 0 - get 20 comments",
+                        "name": "commentsAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.CommentWithAuthor"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/": {
+            "put": {
+                "description": "update user profile",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "update user profile",
+                "operationId": "profile/update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First name",
+                        "name": "firstName",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last name",
+                        "name": "lastName",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email",
+                        "name": "email",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Password",
+                        "name": "password",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Repeat password",
+                        "name": "repeatPassword",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date of birth",
+                        "name": "dateOfBirth",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar",
+                        "name": "avatar",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the completed resumable upload of the avatar",
+                        "name": "avatarUpload",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.User"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "delete user profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "delete user profile",
+                "operationId": "profile/delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/privacy": {
+            "get": {
+                "description": "get privacy settings of the authorized user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "get privacy settings",
+                "operationId": "profile/get_privacy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PrivacySettings"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "update privacy settings of the authorized user, the omitted ones are kept\nlevels: EVERYONE, SUBSCRIBERS, FRIENDS, ONLY_ME",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "update privacy settings",
+                "operationId": "profile/update_privacy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Privacy settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PrivacySettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.PrivacySettings"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/search": {
+            "get": {
+                "description": "search users by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "search users by name",
+                "operationId": "profile/search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last found user, if 0 - get first users",
+                        "name": "lastUserId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of users to get, if 0 - get 20 users",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.User"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/{userID}": {
+            "get": {
+                "description": "get user profile with subscriptions info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "get user profile with subscriptions info",
+                "operationId": "profile/get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID, if empty - get authorized user profile",
+                        "name": "userID",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/user.UserWithSubsInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/": {
+            "post": {
+                "description": "report a post, a comment, a message, a sticker or a group to the moderators, every target is reported once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "report content",
+                "operationId": "reports/create",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Target and reason of the report",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.ReportInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/": {
+            "get": {
+                "description": "search users and public groups by name, posts by content and hashtags by prefix at once, each section is searched by its own service and is marked as failed if the service does not answer in time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "search users, groups, posts and hashtags",
+                "operationId": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Section to search: users, groups, posts or hashtags, if empty - search all sections",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page of the section from the previous response, requires type",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount of results to get per section, if 0 - get 5 results",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_rest_search.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/": {
+            "post": {
+                "description": "subscribe to user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "handle user's subscription flow",
+                "operationId": "subscriptions/subscribe",
+                "parameters": [
+                    {
+                        "description": "Subscribed to ID",
+                        "name": "subscribedTo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.Subscription"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "unsubscribe from user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "handle user's unsubscription flow",
+                "operationId": "subscriptions/unsubscribe",
+                "parameters": [
+                    {
+                        "description": "User to unsubscribe from",
+                        "name": "subscribedTo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/blocks": {
+            "get": {
+                "description": "get the users blocked by the authorized user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "get blocked users",
+                "operationId": "subscriptions/blocked_users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/subscriptions.GetBlockedUsersResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "block user, the subscriptions of both users to each other are removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "block user",
+                "operationId": "subscriptions/block",
+                "parameters": [
+                    {
+                        "description": "ID of the user to block",
+                        "name": "blocked",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.Block"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "unblock user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "unblock user",
+                "operationId": "subscriptions/unblock",
+                "parameters": [
+                    {
+                        "description": "ID of the user to unblock",
+                        "name": "blocked",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/friends/": {
+            "get": {
+                "description": "get user's friends",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "get user's friends",
+                "operationId": "subscriptions/friends",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID, if empty - get authorized user friends",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/subscriptions.GetFriendsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/subscribers/": {
+            "get": {
+                "description": "get user's subscribers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "get user's subscribers",
+                "operationId": "subscriptions/subscribers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID, if empty - get authorized user subscribers",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/subscriptions.GetSubscribersResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/subscriptions/": {
+            "get": {
+                "description": "get user's subscriptions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "get user's subscriptions",
+                "operationId": "subscriptions/subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID, if empty - get authorized user subscriptions",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/subscriptions.GetSubscriptionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/uploads/": {
+            "post": {
+                "description": "start the upload of a file in chunks, the Location header is the URL of the upload",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "uploads"
+                ],
+                "summary": "create resumable upload",
+                "operationId": "uploads/create",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Size of the whole file in bytes",
+                        "name": "Upload-Length",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base64 encoded filename, checksum (hex encoded SHA-256 of the file) and kind (post_attachment by default, avatar or group_avatar)",
+                        "name": "Upload-Metadata",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/domain.Upload"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/uploads/{uploadID}": {
+            "delete": {
+                "description": "terminate the upload and delete its received chunks",
+                "tags": [
+                    "uploads"
+                ],
+                "summary": "terminate resumable upload",
+                "operationId": "uploads/delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the upload",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "description": "get the offset to continue the upload from in the Upload-Offset header",
+                "tags": [
+                    "uploads"
+                ],
+                "summary": "get offset of resumable upload",
+                "operationId": "uploads/offset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the upload",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "append the chunk to the upload at Upload-Offset, after the last chunk the file is verified and stored, the response has its metadata",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "uploads"
+                ],
+                "summary": "upload chunk",
+                "operationId": "uploads/write",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "session_id=some_session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "application/offset+octet-stream",
+                        "name": "Content-Type",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset of the chunk, the current offset of the upload",
+                        "name": "Upload-Offset",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "sha256 and the base64 encoded SHA-256 of the chunk",
+                        "name": "Upload-Checksum",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the upload",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/json.JSONResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/upload.Metadata"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.Attachment": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "hex encoded SHA-256 of the stored file",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "duration": {
+                    "description": "in milliseconds",
+                    "type": "integer"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.AttachmentKind"
+                },
+                "mimeType": {
+                    "type": "string"
+                },
+                "originalName": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.AttachmentKind": {
+            "type": "string",
+            "enum": [
+                "image",
+                "video",
+                "audio",
+                "file"
+            ],
+            "x-enum-varnames": [
+                "ImageAttachment",
+                "VideoAttachment",
+                "AudioAttachment",
+                "FileAttachment"
+            ]
+        },
+        "domain.AttachmentURL": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Block": {
+            "type": "object",
+            "properties": {
+                "blockId": {
+                    "type": "integer"
+                },
+                "blocked": {
+                    "type": "integer"
+                },
+                "blocker": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "domain.Comment": {
+            "type": "object",
+            "properties": {
+                "authorId": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Entity"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "likedBy": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "parentId": {
+                    "type": "integer"
+                },
+                "postId": {
+                    "type": "integer"
+                },
+                "repliesCount": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "domain.CommentLike": {
+            "type": "object",
+            "properties": {
+                "commentId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CommentWithAuthor": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/domain.User"
+                },
+                "comment": {
+                    "$ref": "#/definitions/domain.Comment"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CommentWithAuthor"
+                    }
+                }
+            }
+        },
+        "domain.Conversation": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isGroup": {
+                    "type": "boolean"
+                },
+                "lastMessage": {
+                    "$ref": "#/definitions/domain.PersonalMessage"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ConversationParticipant"
+                    }
+                },
                 "updatedAt": {
                     "type": "string",
                     "format": "date-time",
@@ -4797,11 +8496,125 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.CommentLike": {
+        "domain.ConversationParticipant": {
             "type": "object",
             "properties": {
-                "commentId": {
+                "joinedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "role": {
+                    "$ref": "#/definitions/domain.ConversationRole"
+                },
+                "user": {
+                    "$ref": "#/definitions/domain.User"
+                }
+            }
+        },
+        "domain.ConversationRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "admin",
+                "member"
+            ],
+            "x-enum-varnames": [
+                "ConversationRoleOwner",
+                "ConversationRoleAdmin",
+                "ConversationRoleMember"
+            ]
+        },
+        "domain.Dialog": {
+            "type": "object",
+            "properties": {
+                "lastMessage": {
+                    "$ref": "#/definitions/domain.PersonalMessage"
+                },
+                "unreadCount": {
+                    "type": "integer"
+                },
+                "user1": {
+                    "$ref": "#/definitions/domain.User"
+                },
+                "user2": {
+                    "$ref": "#/definitions/domain.User"
+                }
+            }
+        },
+        "domain.Entity": {
+            "type": "object",
+            "properties": {
+                "hashtag": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/domain.EntityType"
+                },
+                "userId": {
                     "type": "integer"
+                }
+            }
+        },
+        "domain.EntityType": {
+            "type": "string",
+            "enum": [
+                "HASHTAG",
+                "MENTION"
+            ],
+            "x-enum-varnames": [
+                "HashtagEntity",
+                "MentionEntity"
+            ]
+        },
+        "domain.FoundComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/domain.CommentWithAuthor"
+                },
+                "snippet": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.FoundPost": {
+            "type": "object",
+            "properties": {
+                "post": {
+                    "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                },
+                "snippet": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ModerationAction": {
+            "type": "string",
+            "enum": [
+                "HIDE_CONTENT",
+                "BAN_USER",
+                "DELETE_GROUP",
+                "DISMISS_REPORTS"
+            ],
+            "x-enum-varnames": [
+                "HideContentModerationAction",
+                "BanUserModerationAction",
+                "DeleteGroupModerationAction",
+                "DismissReportsModerationAction"
+            ]
+        },
+        "domain.ModerationLogEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/domain.ModerationAction"
                 },
                 "createdAt": {
                     "type": "string",
@@ -4811,48 +8624,135 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
-                "userId": {
+                "moderatorId": {
                     "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "targetType": {
+                    "$ref": "#/definitions/domain.ModerationTargetType"
                 }
             }
         },
-        "domain.CommentWithAuthor": {
+        "domain.ModerationTargetType": {
+            "type": "string",
+            "enum": [
+                "POST",
+                "COMMENT",
+                "MESSAGE",
+                "STICKER",
+                "GROUP",
+                "USER"
+            ],
+            "x-enum-varnames": [
+                "PostModerationTarget",
+                "CommentModerationTarget",
+                "MessageModerationTarget",
+                "StickerModerationTarget",
+                "GroupModerationTarget",
+                "UserModerationTarget"
+            ]
+        },
+        "domain.Notification": {
             "type": "object",
             "properties": {
-                "author": {
-                    "$ref": "#/definitions/domain.User"
+                "actorsCount": {
+                    "type": "integer"
                 },
-                "comment": {
-                    "$ref": "#/definitions/domain.Comment"
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "eventsCount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isRead": {
+                    "type": "boolean"
+                },
+                "lastActorId": {
+                    "type": "integer"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/domain.NotificationType"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
-        "domain.Dialog": {
+        "domain.NotificationType": {
+            "type": "string",
+            "enum": [
+                "POST_LIKE",
+                "COMMENT",
+                "SUBSCRIPTION",
+                "GROUP_POST",
+                "POST_MENTION",
+                "COMMENT_MENTION"
+            ],
+            "x-enum-varnames": [
+                "PostLikeNotification",
+                "CommentNotification",
+                "SubscriptionNotification",
+                "GroupPostNotification",
+                "PostMentionNotification",
+                "CommentMentionNotification"
+            ]
+        },
+        "domain.NotificationWithActor": {
             "type": "object",
             "properties": {
-                "lastMessage": {
-                    "$ref": "#/definitions/domain.PersonalMessage"
-                },
-                "user1": {
+                "lastActor": {
                     "$ref": "#/definitions/domain.User"
                 },
-                "user2": {
-                    "$ref": "#/definitions/domain.User"
+                "notification": {
+                    "$ref": "#/definitions/domain.Notification"
                 }
             }
         },
         "domain.PersonalMessage": {
             "type": "object",
             "properties": {
+                "attachmentUrls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AttachmentURL"
+                    }
+                },
                 "attachments": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "attachmentsInfo": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Attachment"
+                    }
+                },
                 "content": {
                     "type": "string"
                 },
+                "conversationId": {
+                    "type": "integer"
+                },
                 "createdAt": {
                     "type": "string",
                     "format": "date-time",
@@ -4886,6 +8786,12 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "attachmentsInfo": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Attachment"
+                    }
+                },
                 "authorId": {
                     "type": "integer"
                 },
@@ -4897,18 +8803,39 @@ const docTemplate = `{
                     "format": "date-time",
                     "example": "2021-01-01T00:00:00Z"
                 },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Entity"
+                    }
+                },
                 "groupId": {
                     "type": "integer"
                 },
+                "isRepost": {
+                    "type": "boolean"
+                },
                 "likedBy": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
+                "original": {
+                    "$ref": "#/definitions/domain.Post"
+                },
+                "originalUnavailable": {
+                    "type": "boolean"
+                },
                 "postId": {
                     "type": "integer"
                 },
+                "repostOfId": {
+                    "type": "integer"
+                },
+                "sharesCount": {
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "type": "string",
                     "format": "date-time",
@@ -4960,29 +8887,199 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.PublicGroup": {
+        "domain.Presence": {
+            "type": "object",
+            "properties": {
+                "isOnline": {
+                    "type": "boolean"
+                },
+                "lastSeen": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.PrivacyLevel": {
+            "type": "string",
+            "enum": [
+                "EVERYONE",
+                "SUBSCRIBERS",
+                "FRIENDS",
+                "ONLY_ME"
+            ],
+            "x-enum-varnames": [
+                "EveryonePrivacy",
+                "SubscribersPrivacy",
+                "FriendsPrivacy",
+                "OnlyMePrivacy"
+            ]
+        },
+        "domain.PrivacySettings": {
+            "type": "object",
+            "properties": {
+                "dateOfBirth": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PrivacyLevel"
+                        }
+                    ],
+                    "example": "EVERYONE"
+                },
+                "messages": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PrivacyLevel"
+                        }
+                    ],
+                    "example": "EVERYONE"
+                },
+                "posts": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PrivacyLevel"
+                        }
+                    ],
+                    "example": "EVERYONE"
+                },
+                "subscriptions": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PrivacyLevel"
+                        }
+                    ],
+                    "example": "EVERYONE"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.PublicGroup": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subscribersCount": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "domain.PublicGroupSubscription": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "publicGroupId": {
+                    "type": "integer"
+                },
+                "subscriberId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "domain.Report": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "$ref": "#/definitions/domain.ReportReason"
+                },
+                "reporterId": {
+                    "type": "integer"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "targetType": {
+                    "$ref": "#/definitions/domain.ModerationTargetType"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "domain.ReportCase": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string"
-                },
                 "createdAt": {
                     "type": "string",
                     "format": "date-time",
                     "example": "2021-01-01T00:00:00Z"
                 },
-                "description": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ReportReason"
+                    }
                 },
-                "subscribersCount": {
+                "reportsCount": {
+                    "type": "integer"
+                },
+                "resolvedBy": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.ReportCaseStatus"
+                },
+                "targetId": {
                     "type": "integer"
                 },
+                "targetType": {
+                    "$ref": "#/definitions/domain.ModerationTargetType"
+                },
                 "updatedAt": {
                     "type": "string",
                     "format": "date-time",
@@ -4990,7 +9087,39 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.PublicGroupSubscription": {
+        "domain.ReportCaseStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "RESOLVED",
+                "DISMISSED"
+            ],
+            "x-enum-varnames": [
+                "OpenReportCase",
+                "ResolvedReportCase",
+                "DismissedReportCase"
+            ]
+        },
+        "domain.ReportReason": {
+            "type": "string",
+            "enum": [
+                "SPAM",
+                "HARASSMENT",
+                "HATE_SPEECH",
+                "VIOLENCE",
+                "NUDITY",
+                "OTHER"
+            ],
+            "x-enum-varnames": [
+                "SpamReportReason",
+                "HarassmentReportReason",
+                "HateSpeechReportReason",
+                "ViolenceReportReason",
+                "NudityReportReason",
+                "OtherReportReason"
+            ]
+        },
+        "domain.Session": {
             "type": "object",
             "properties": {
                 "createdAt": {
@@ -4998,19 +9127,33 @@ const docTemplate = `{
                     "format": "date-time",
                     "example": "2021-01-01T00:00:00Z"
                 },
+                "device": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
                 "id": {
-                    "type": "integer"
+                    "type": "string"
                 },
-                "publicGroupId": {
-                    "type": "integer"
+                "ip": {
+                    "type": "string"
                 },
-                "subscriberId": {
-                    "type": "integer"
+                "isCurrent": {
+                    "type": "boolean"
                 },
-                "updatedAt": {
+                "lastSeenAt": {
                     "type": "string",
                     "format": "date-time",
                     "example": "2021-01-01T00:00:00Z"
+                },
+                "userAgent": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
@@ -5065,6 +9208,49 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.Upload": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "hex encoded SHA-256 of the whole file",
+                    "type": "string"
+                },
+                "completed": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "originalName": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
         "domain.User": {
             "type": "object",
             "properties": {
@@ -5109,12 +9295,57 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_rest_posts.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FoundComment"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FoundPost"
+                    }
+                }
+            }
+        },
+        "internal_rest_search.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "$ref": "#/definitions/rest.GroupsSection"
+                },
+                "hashtags": {
+                    "$ref": "#/definitions/rest.HashtagsSection"
+                },
+                "posts": {
+                    "$ref": "#/definitions/rest.PostsSection"
+                },
+                "users": {
+                    "$ref": "#/definitions/rest.UsersSection"
+                }
+            }
+        },
         "json.JSONResponse": {
             "type": "object",
             "properties": {
                 "body": {}
             }
         },
+        "notifications.UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
         "posts.LikeWithPostAndUser": {
             "type": "object",
             "properties": {
@@ -5129,6 +9360,17 @@ const docTemplate = `{
                 }
             }
         },
+        "posts.TrendingHashtag": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "hashtag": {
+                    "type": "string"
+                }
+            }
+        },
         "publicgroup.PublicGroupWithInfo": {
             "type": "object",
             "properties": {
@@ -5156,6 +9398,74 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.FeedResponse": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PostWithAuthorAndGroup"
+                    }
+                }
+            }
+        },
+        "rest.GroupsSection": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/publicgroup.PublicGroupWithInfo"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "rest.HashtagsSection": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/posts.TrendingHashtag"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "rest.HideContentInput": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "targetType": {
+                    "$ref": "#/definitions/domain.ModerationTargetType"
+                }
+            }
+        },
         "rest.ListUserPostsResponse": {
             "type": "object",
             "properties": {
@@ -5170,6 +9480,82 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.ModerationReasonInput": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "rest.PostsSection": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FoundPost"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "rest.ReportInput": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/domain.ReportReason"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "targetType": {
+                    "$ref": "#/definitions/domain.ModerationTargetType"
+                }
+            }
+        },
+        "rest.UsersSection": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.User"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "subscriptions.GetBlockedUsersResponse": {
+            "type": "object",
+            "properties": {
+                "blockedUsers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.User"
+                    }
+                }
+            }
+        },
         "subscriptions.GetFriendsResponse": {
             "type": "object",
             "properties": {
@@ -5203,6 +9589,55 @@ const docTemplate = `{
                 }
             }
         },
+        "upload.Metadata": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/upload.Variant"
+                    }
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "upload.Variant": {
+            "type": "object",
+            "properties": {
+                "fileName": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
         "user.UserWithSubsInfo": {
             "type": "object",
             "properties": {
@@ -0,0 +1,39 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+type AttachmentKind string
+
+const (
+	ImageAttachment AttachmentKind = "image"
+	VideoAttachment AttachmentKind = "video"
+	AudioAttachment AttachmentKind = "audio"
+	FileAttachment  AttachmentKind = "file"
+)
+
+// Attachment describes an uploaded post or message attachment, so clients
+// can render it without fetching the object first.
+//
+//easyjson:json
+type Attachment struct {
+	FileName     string                `json:"fileName"`
+	Kind         AttachmentKind        `json:"kind"`
+	MimeType     string                `json:"mimeType"`
+	Size         int64                 `json:"size"`
+	Width        uint                  `json:"width,omitempty"`
+	Height       uint                  `json:"height,omitempty"`
+	Duration     uint                  `json:"duration,omitempty"` // in milliseconds
+	OriginalName string                `json:"originalName"`
+	Checksum     string                `json:"checksum"` // hex encoded SHA-256 of the stored file
+	CreatedAt    customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+// AttachmentURL is a short-lived link to a private attachment, the link is
+// only handed out to the users allowed to see the attachment.
+//
+//easyjson:json
+type AttachmentURL struct {
+	FileName  string                `json:"fileName"`
+	URL       string                `json:"url"`
+	ExpiresAt customtime.CustomTime `json:"expiresAt" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
@@ -0,0 +1,232 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson76362c5bDecodeSocioDomain(in *jlexer.Lexer, out *AttachmentURL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "fileName":
+			out.FileName = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson76362c5bEncodeSocioDomain(out *jwriter.Writer, in AttachmentURL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttachmentURL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson76362c5bEncodeSocioDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttachmentURL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson76362c5bEncodeSocioDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttachmentURL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson76362c5bDecodeSocioDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttachmentURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson76362c5bDecodeSocioDomain(l, v)
+}
+func easyjson76362c5bDecodeSocioDomain1(in *jlexer.Lexer, out *Attachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "fileName":
+			out.FileName = string(in.String())
+		case "kind":
+			out.Kind = AttachmentKind(in.String())
+		case "mimeType":
+			out.MimeType = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "width":
+			out.Width = uint(in.Uint())
+		case "height":
+			out.Height = uint(in.Uint())
+		case "duration":
+			out.Duration = uint(in.Uint())
+		case "originalName":
+			out.OriginalName = string(in.String())
+		case "checksum":
+			out.Checksum = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson76362c5bEncodeSocioDomain1(out *jwriter.Writer, in Attachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"mimeType\":"
+		out.RawString(prefix)
+		out.String(string(in.MimeType))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	if in.Width != 0 {
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Width))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Height))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Duration))
+	}
+	{
+		const prefix string = ",\"originalName\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalName))
+	}
+	{
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Attachment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson76362c5bEncodeSocioDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson76362c5bEncodeSocioDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Attachment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson76362c5bDecodeSocioDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson76362c5bDecodeSocioDomain1(l, v)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+// Block is the blocking of BlockedID by BlockerID, the blocked user can not
+// message, subscribe to, comment on or like the posts of the blocker.
+//
+//easyjson:json
+type Block struct {
+	ID        uint                  `json:"blockId"`
+	BlockerID uint                  `json:"blocker"`
+	BlockedID uint                  `json:"blocked"`
+	CreatedAt customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
@@ -4,13 +4,23 @@ import customtime "socio/pkg/time"
 
 //easyjson:json
 type Comment struct {
-	ID         uint                  `json:"id"`
-	Content    string                `json:"content"`
-	PostID     uint                  `json:"postId"`
-	AuthorID   uint                  `json:"authorId"`
-	LikedByIDs []uint64              `json:"likedBy"`
-	CreatedAt  customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
-	UpdatedAt  customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	ID           uint                  `json:"id"`
+	Content      string                `json:"content"`
+	PostID       uint                  `json:"postId"`
+	AuthorID     uint                  `json:"authorId"`
+	ParentID     uint                  `json:"parentId"`
+	Depth        uint                  `json:"depth"`
+	RepliesCount uint                  `json:"repliesCount"`
+	LikedByIDs   []uint64              `json:"likedBy"`
+	Entities     []*Entity             `json:"entities,omitempty"`
+	CreatedAt    customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt    customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	// Replies is a preview of the first direct replies, the rest are paged
+	// separately.
+	Replies []*Comment `json:"-"`
+	// IsHidden is set for the comments held by the content filter, they are
+	// stored hidden until a moderator reviews them.
+	IsHidden bool `json:"-"`
 }
 
 //easyjson:json
@@ -23,6 +33,7 @@ type CommentLike struct {
 
 //easyjson:json
 type CommentWithAuthor struct {
-	Comment *Comment `json:"comment"`
-	Author  *User    `json:"author"`
+	Comment *Comment             `json:"comment"`
+	Author  *User                `json:"author"`
+	Replies []*CommentWithAuthor `json:"replies,omitempty"`
 }
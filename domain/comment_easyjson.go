@@ -54,7 +54,38 @@ func easyjsonE9abebc9DecodeSocioDomain(in *jlexer.Lexer, out *CommentWithAuthor)
 				if out.Author == nil {
 					out.Author = new(User)
 				}
-				easyjsonE9abebc9DecodeSocioDomain1(in, out.Author)
+				(*out.Author).UnmarshalEasyJSON(in)
+			}
+		case "replies":
+			if in.IsNull() {
+				in.Skip()
+				out.Replies = nil
+			} else {
+				in.Delim('[')
+				if out.Replies == nil {
+					if !in.IsDelim(']') {
+						out.Replies = make([]*CommentWithAuthor, 0, 8)
+					} else {
+						out.Replies = []*CommentWithAuthor{}
+					}
+				} else {
+					out.Replies = (out.Replies)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *CommentWithAuthor
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(CommentWithAuthor)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Replies = append(out.Replies, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -85,7 +116,25 @@ func easyjsonE9abebc9EncodeSocioDomain(out *jwriter.Writer, in CommentWithAuthor
 		if in.Author == nil {
 			out.RawString("null")
 		} else {
-			easyjsonE9abebc9EncodeSocioDomain1(out, *in.Author)
+			(*in.Author).MarshalEasyJSON(out)
+		}
+	}
+	if len(in.Replies) != 0 {
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Replies {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
@@ -114,104 +163,7 @@ func (v *CommentWithAuthor) UnmarshalJSON(data []byte) error {
 func (v *CommentWithAuthor) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonE9abebc9DecodeSocioDomain(l, v)
 }
-func easyjsonE9abebc9DecodeSocioDomain1(in *jlexer.Lexer, out *User) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "userId":
-			out.ID = uint(in.Uint())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "dateOfBirth":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateOfBirth).UnmarshalJSON(data))
-			}
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonE9abebc9EncodeSocioDomain1(out *jwriter.Writer, in User) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"userId\":"
-		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
-	}
-	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	if true {
-		const prefix string = ",\"dateOfBirth\":"
-		out.RawString(prefix)
-		out.Raw((in.DateOfBirth).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	out.RawByte('}')
-}
-func easyjsonE9abebc9DecodeSocioDomain2(in *jlexer.Lexer, out *CommentLike) {
+func easyjsonE9abebc9DecodeSocioDomain1(in *jlexer.Lexer, out *CommentLike) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -250,7 +202,7 @@ func easyjsonE9abebc9DecodeSocioDomain2(in *jlexer.Lexer, out *CommentLike) {
 		in.Consumed()
 	}
 }
-func easyjsonE9abebc9EncodeSocioDomain2(out *jwriter.Writer, in CommentLike) {
+func easyjsonE9abebc9EncodeSocioDomain1(out *jwriter.Writer, in CommentLike) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -280,27 +232,27 @@ func easyjsonE9abebc9EncodeSocioDomain2(out *jwriter.Writer, in CommentLike) {
 // MarshalJSON supports json.Marshaler interface
 func (v CommentLike) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonE9abebc9EncodeSocioDomain2(&w, v)
+	easyjsonE9abebc9EncodeSocioDomain1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentLike) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE9abebc9EncodeSocioDomain2(w, v)
+	easyjsonE9abebc9EncodeSocioDomain1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentLike) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonE9abebc9DecodeSocioDomain2(&r, v)
+	easyjsonE9abebc9DecodeSocioDomain1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE9abebc9DecodeSocioDomain2(l, v)
+	easyjsonE9abebc9DecodeSocioDomain1(l, v)
 }
-func easyjsonE9abebc9DecodeSocioDomain3(in *jlexer.Lexer, out *Comment) {
+func easyjsonE9abebc9DecodeSocioDomain2(in *jlexer.Lexer, out *Comment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -327,6 +279,12 @@ func easyjsonE9abebc9DecodeSocioDomain3(in *jlexer.Lexer, out *Comment) {
 			out.PostID = uint(in.Uint())
 		case "authorId":
 			out.AuthorID = uint(in.Uint())
+		case "parentId":
+			out.ParentID = uint(in.Uint())
+		case "depth":
+			out.Depth = uint(in.Uint())
+		case "repliesCount":
+			out.RepliesCount = uint(in.Uint())
 		case "likedBy":
 			if in.IsNull() {
 				in.Skip()
@@ -343,9 +301,40 @@ func easyjsonE9abebc9DecodeSocioDomain3(in *jlexer.Lexer, out *Comment) {
 					out.LikedByIDs = (out.LikedByIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 uint64
-					v1 = uint64(in.Uint64())
-					out.LikedByIDs = append(out.LikedByIDs, v1)
+					var v4 uint64
+					v4 = uint64(in.Uint64())
+					out.LikedByIDs = append(out.LikedByIDs, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "entities":
+			if in.IsNull() {
+				in.Skip()
+				out.Entities = nil
+			} else {
+				in.Delim('[')
+				if out.Entities == nil {
+					if !in.IsDelim(']') {
+						out.Entities = make([]*Entity, 0, 8)
+					} else {
+						out.Entities = []*Entity{}
+					}
+				} else {
+					out.Entities = (out.Entities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 *Entity
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else {
+						if v5 == nil {
+							v5 = new(Entity)
+						}
+						(*v5).UnmarshalEasyJSON(in)
+					}
+					out.Entities = append(out.Entities, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -368,7 +357,7 @@ func easyjsonE9abebc9DecodeSocioDomain3(in *jlexer.Lexer, out *Comment) {
 		in.Consumed()
 	}
 }
-func easyjsonE9abebc9EncodeSocioDomain3(out *jwriter.Writer, in Comment) {
+func easyjsonE9abebc9EncodeSocioDomain2(out *jwriter.Writer, in Comment) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -392,6 +381,21 @@ func easyjsonE9abebc9EncodeSocioDomain3(out *jwriter.Writer, in Comment) {
 		out.RawString(prefix)
 		out.Uint(uint(in.AuthorID))
 	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ParentID))
+	}
+	{
+		const prefix string = ",\"depth\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Depth))
+	}
+	{
+		const prefix string = ",\"repliesCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.RepliesCount))
+	}
 	{
 		const prefix string = ",\"likedBy\":"
 		out.RawString(prefix)
@@ -399,11 +403,29 @@ func easyjsonE9abebc9EncodeSocioDomain3(out *jwriter.Writer, in Comment) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.LikedByIDs {
-				if v2 > 0 {
+			for v6, v7 := range in.LikedByIDs {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Entities) != 0 {
+		const prefix string = ",\"entities\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Entities {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint64(uint64(v3))
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
@@ -424,23 +446,23 @@ func easyjsonE9abebc9EncodeSocioDomain3(out *jwriter.Writer, in Comment) {
 // MarshalJSON supports json.Marshaler interface
 func (v Comment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonE9abebc9EncodeSocioDomain3(&w, v)
+	easyjsonE9abebc9EncodeSocioDomain2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE9abebc9EncodeSocioDomain3(w, v)
+	easyjsonE9abebc9EncodeSocioDomain2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Comment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonE9abebc9DecodeSocioDomain3(&r, v)
+	easyjsonE9abebc9DecodeSocioDomain2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE9abebc9DecodeSocioDomain3(l, v)
+	easyjsonE9abebc9DecodeSocioDomain2(l, v)
 }
@@ -0,0 +1,32 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+type ConversationRole string
+
+const (
+	ConversationRoleOwner  ConversationRole = "owner"
+	ConversationRoleAdmin  ConversationRole = "admin"
+	ConversationRoleMember ConversationRole = "member"
+)
+
+// Conversation is a chat with any number of participants. One-to-one dialogs
+// are conversations with IsGroup set to false and exactly two participants.
+//
+//easyjson:json
+type Conversation struct {
+	ID           uint                       `json:"id"`
+	Name         string                     `json:"name"`
+	IsGroup      bool                       `json:"isGroup"`
+	Participants []*ConversationParticipant `json:"participants"`
+	LastMessage  *PersonalMessage           `json:"lastMessage,omitempty"`
+	CreatedAt    customtime.CustomTime      `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt    customtime.CustomTime      `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+//easyjson:json
+type ConversationParticipant struct {
+	User     *User                 `json:"user"`
+	Role     ConversationRole      `json:"role"`
+	JoinedAt customtime.CustomTime `json:"joinedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
@@ -0,0 +1,277 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonA5648bb1DecodeSocioDomain(in *jlexer.Lexer, out *ConversationParticipant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "role":
+			out.Role = ConversationRole(in.String())
+		case "joinedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.JoinedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA5648bb1EncodeSocioDomain(out *jwriter.Writer, in ConversationParticipant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if true {
+		const prefix string = ",\"joinedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.JoinedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConversationParticipant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA5648bb1EncodeSocioDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConversationParticipant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA5648bb1EncodeSocioDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConversationParticipant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA5648bb1DecodeSocioDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConversationParticipant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA5648bb1DecodeSocioDomain(l, v)
+}
+func easyjsonA5648bb1DecodeSocioDomain1(in *jlexer.Lexer, out *Conversation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "isGroup":
+			out.IsGroup = bool(in.Bool())
+		case "participants":
+			if in.IsNull() {
+				in.Skip()
+				out.Participants = nil
+			} else {
+				in.Delim('[')
+				if out.Participants == nil {
+					if !in.IsDelim(']') {
+						out.Participants = make([]*ConversationParticipant, 0, 8)
+					} else {
+						out.Participants = []*ConversationParticipant{}
+					}
+				} else {
+					out.Participants = (out.Participants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *ConversationParticipant
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(ConversationParticipant)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Participants = append(out.Participants, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lastMessage":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessage = nil
+			} else {
+				if out.LastMessage == nil {
+					out.LastMessage = new(PersonalMessage)
+				}
+				(*out.LastMessage).UnmarshalEasyJSON(in)
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA5648bb1EncodeSocioDomain1(out *jwriter.Writer, in Conversation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"isGroup\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsGroup))
+	}
+	{
+		const prefix string = ",\"participants\":"
+		out.RawString(prefix)
+		if in.Participants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Participants {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.LastMessage != nil {
+		const prefix string = ",\"lastMessage\":"
+		out.RawString(prefix)
+		(*in.LastMessage).MarshalEasyJSON(out)
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Conversation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA5648bb1EncodeSocioDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Conversation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA5648bb1EncodeSocioDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Conversation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA5648bb1DecodeSocioDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Conversation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA5648bb1DecodeSocioDomain1(l, v)
+}
@@ -66,6 +66,8 @@ func easyjson6bf45bf4DecodeSocioDomain(in *jlexer.Lexer, out *Dialog) {
 				}
 				easyjson6bf45bf4DecodeSocioDomain2(in, out.LastMessage)
 			}
+		case "unreadCount":
+			out.UnreadCount = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -107,6 +109,11 @@ func easyjson6bf45bf4EncodeSocioDomain(out *jwriter.Writer, in Dialog) {
 			easyjson6bf45bf4EncodeSocioDomain2(out, *in.LastMessage)
 		}
 	}
+	{
+		const prefix string = ",\"unreadCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UnreadCount))
+	}
 	out.RawByte('}')
 }
 
@@ -158,6 +165,8 @@ func easyjson6bf45bf4DecodeSocioDomain2(in *jlexer.Lexer, out *PersonalMessage)
 			out.SenderID = uint(in.Uint())
 		case "receiverId":
 			out.ReceiverID = uint(in.Uint())
+		case "conversationId":
+			out.ConversationID = uint(in.Uint())
 		case "content":
 			out.Content = string(in.String())
 		case "sticker":
@@ -230,6 +239,11 @@ func easyjson6bf45bf4EncodeSocioDomain2(out *jwriter.Writer, in PersonalMessage)
 		out.RawString(prefix)
 		out.Uint(uint(in.ReceiverID))
 	}
+	{
+		const prefix string = ",\"conversationId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ConversationID))
+	}
 	{
 		const prefix string = ",\"content\":"
 		out.RawString(prefix)
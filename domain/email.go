@@ -0,0 +1,10 @@
+package domain
+
+// Email is a letter queued in the outbox until it is sent to the user.
+//
+//easyjson:json
+type Email struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
@@ -0,0 +1,32 @@
+package domain
+
+type EntityType string
+
+const (
+	HashtagEntity EntityType = "HASHTAG"
+	MentionEntity EntityType = "MENTION"
+)
+
+// Entity is a hashtag or a mention found in the content of a post or a
+// comment. Offset and Length are measured in UTF-16 code units of the content
+// as it is returned, so clients can slice the string directly.
+//
+//easyjson:json
+type Entity struct {
+	Type    EntityType `json:"type"`
+	Offset  uint       `json:"offset"`
+	Length  uint       `json:"length"`
+	Hashtag string     `json:"hashtag,omitempty"`
+	UserID  uint       `json:"userId,omitempty"`
+}
+
+// HasMentions reports whether any of the entities is a mention.
+func HasMentions(entities []*Entity) bool {
+	for _, entity := range entities {
+		if entity.Type == MentionEntity {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+type ModerationTargetType string
+
+const (
+	PostModerationTarget    ModerationTargetType = "POST"
+	CommentModerationTarget ModerationTargetType = "COMMENT"
+	MessageModerationTarget ModerationTargetType = "MESSAGE"
+	StickerModerationTarget ModerationTargetType = "STICKER"
+	GroupModerationTarget   ModerationTargetType = "GROUP"
+	UserModerationTarget    ModerationTargetType = "USER"
+)
+
+// IsReportable tells whether the users can report the targets of the type,
+// users themselves are banned by the moderators for their content.
+func (t ModerationTargetType) IsReportable() bool {
+	switch t {
+	case PostModerationTarget, CommentModerationTarget, MessageModerationTarget, StickerModerationTarget, GroupModerationTarget:
+		return true
+	}
+
+	return false
+}
+
+// IsHideable tells whether the moderators can hide the targets of the type,
+// groups are deleted instead.
+func (t ModerationTargetType) IsHideable() bool {
+	switch t {
+	case PostModerationTarget, CommentModerationTarget, MessageModerationTarget, StickerModerationTarget:
+		return true
+	}
+
+	return false
+}
+
+type ReportReason string
+
+const (
+	SpamReportReason       ReportReason = "SPAM"
+	HarassmentReportReason ReportReason = "HARASSMENT"
+	HateSpeechReportReason ReportReason = "HATE_SPEECH"
+	ViolenceReportReason   ReportReason = "VIOLENCE"
+	NudityReportReason     ReportReason = "NUDITY"
+	OtherReportReason      ReportReason = "OTHER"
+)
+
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case SpamReportReason, HarassmentReportReason, HateSpeechReportReason, ViolenceReportReason, NudityReportReason, OtherReportReason:
+		return true
+	}
+
+	return false
+}
+
+type ReportCaseStatus string
+
+const (
+	OpenReportCase      ReportCaseStatus = "OPEN"
+	ResolvedReportCase  ReportCaseStatus = "RESOLVED"
+	DismissedReportCase ReportCaseStatus = "DISMISSED"
+)
+
+type ModerationAction string
+
+const (
+	HideContentModerationAction    ModerationAction = "HIDE_CONTENT"
+	BanUserModerationAction        ModerationAction = "BAN_USER"
+	DeleteGroupModerationAction    ModerationAction = "DELETE_GROUP"
+	DismissReportsModerationAction ModerationAction = "DISMISS_REPORTS"
+)
+
+// Report is the complaint of the reporter about a post, a comment, a message,
+// a sticker or a group. Every user reports the same target once.
+//
+//easyjson:json
+type Report struct {
+	ID         uint                  `json:"id"`
+	CaseID     uint                  `json:"caseId"`
+	ReporterID uint                  `json:"reporterId"`
+	TargetType ModerationTargetType  `json:"targetType"`
+	TargetID   uint                  `json:"targetId"`
+	Reason     ReportReason          `json:"reason"`
+	Comment    string                `json:"comment"`
+	CreatedAt  customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt  customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+// ReportCase gathers all the reports about the same target, it is resolved
+// by the moderator acting on the target or dismissed.
+//
+//easyjson:json
+type ReportCase struct {
+	ID           uint                  `json:"id"`
+	TargetType   ModerationTargetType  `json:"targetType"`
+	TargetID     uint                  `json:"targetId"`
+	Status       ReportCaseStatus      `json:"status"`
+	ReportsCount uint                  `json:"reportsCount"`
+	Reasons      []ReportReason        `json:"reasons"`
+	ResolvedBy   uint                  `json:"resolvedBy,omitempty"`
+	CreatedAt    customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt    customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+// ModerationLogEntry records the action of the moderator on the target.
+//
+//easyjson:json
+type ModerationLogEntry struct {
+	ID          uint                  `json:"id"`
+	ModeratorID uint                  `json:"moderatorId"`
+	Action      ModerationAction      `json:"action"`
+	TargetType  ModerationTargetType  `json:"targetType"`
+	TargetID    uint                  `json:"targetId"`
+	Reason      string                `json:"reason"`
+	CreatedAt   customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+// UserBan forbids the user to log in, the sessions of the user are revoked
+// when the ban is issued.
+//
+//easyjson:json
+type UserBan struct {
+	ID          uint                  `json:"id"`
+	UserID      uint                  `json:"userId"`
+	ModeratorID uint                  `json:"moderatorId"`
+	Reason      string                `json:"reason"`
+	CreatedAt   customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt   customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
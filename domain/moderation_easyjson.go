@@ -0,0 +1,518 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE913b498DecodeSocioDomain(in *jlexer.Lexer, out *UserBan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "moderatorId":
+			out.ModeratorID = uint(in.Uint())
+		case "reason":
+			out.Reason = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeSocioDomain(out *jwriter.Writer, in UserBan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"moderatorId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ModeratorID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserBan) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeSocioDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserBan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeSocioDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserBan) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeSocioDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserBan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeSocioDomain(l, v)
+}
+func easyjsonE913b498DecodeSocioDomain1(in *jlexer.Lexer, out *ReportCase) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "targetType":
+			out.TargetType = ModerationTargetType(in.String())
+		case "targetId":
+			out.TargetID = uint(in.Uint())
+		case "status":
+			out.Status = ReportCaseStatus(in.String())
+		case "reportsCount":
+			out.ReportsCount = uint(in.Uint())
+		case "reasons":
+			if in.IsNull() {
+				in.Skip()
+				out.Reasons = nil
+			} else {
+				in.Delim('[')
+				if out.Reasons == nil {
+					if !in.IsDelim(']') {
+						out.Reasons = make([]ReportReason, 0, 4)
+					} else {
+						out.Reasons = []ReportReason{}
+					}
+				} else {
+					out.Reasons = (out.Reasons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ReportReason
+					v1 = ReportReason(in.String())
+					out.Reasons = append(out.Reasons, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "resolvedBy":
+			out.ResolvedBy = uint(in.Uint())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeSocioDomain1(out *jwriter.Writer, in ReportCase) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.TargetID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"reportsCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ReportsCount))
+	}
+	{
+		const prefix string = ",\"reasons\":"
+		out.RawString(prefix)
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Reasons {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ResolvedBy != 0 {
+		const prefix string = ",\"resolvedBy\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ResolvedBy))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportCase) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeSocioDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportCase) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeSocioDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportCase) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeSocioDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportCase) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeSocioDomain1(l, v)
+}
+func easyjsonE913b498DecodeSocioDomain2(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "caseId":
+			out.CaseID = uint(in.Uint())
+		case "reporterId":
+			out.ReporterID = uint(in.Uint())
+		case "targetType":
+			out.TargetType = ModerationTargetType(in.String())
+		case "targetId":
+			out.TargetID = uint(in.Uint())
+		case "reason":
+			out.Reason = ReportReason(in.String())
+		case "comment":
+			out.Comment = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeSocioDomain2(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"caseId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.CaseID))
+	}
+	{
+		const prefix string = ",\"reporterId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ReporterID))
+	}
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.TargetID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeSocioDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeSocioDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeSocioDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeSocioDomain2(l, v)
+}
+func easyjsonE913b498DecodeSocioDomain3(in *jlexer.Lexer, out *ModerationLogEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "moderatorId":
+			out.ModeratorID = uint(in.Uint())
+		case "action":
+			out.Action = ModerationAction(in.String())
+		case "targetType":
+			out.TargetType = ModerationTargetType(in.String())
+		case "targetId":
+			out.TargetID = uint(in.Uint())
+		case "reason":
+			out.Reason = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeSocioDomain3(out *jwriter.Writer, in ModerationLogEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"moderatorId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ModeratorID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.TargetID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationLogEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeSocioDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationLogEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeSocioDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationLogEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeSocioDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationLogEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeSocioDomain3(l, v)
+}
@@ -0,0 +1,39 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+type NotificationType string
+
+const (
+	PostLikeNotification       NotificationType = "POST_LIKE"
+	CommentNotification        NotificationType = "COMMENT"
+	SubscriptionNotification   NotificationType = "SUBSCRIPTION"
+	GroupPostNotification      NotificationType = "GROUP_POST"
+	PostMentionNotification    NotificationType = "POST_MENTION"
+	CommentMentionNotification NotificationType = "COMMENT_MENTION"
+)
+
+// Notification groups the unread events of one type about the same target:
+// the liked or commented post, the group that got a new post, the user for
+// subscriptions or the post or the comment the user is mentioned in.
+// LastActorID and ActorsCount make "Ivan and 5 others".
+//
+//easyjson:json
+type Notification struct {
+	ID          uint                  `json:"id"`
+	UserID      uint                  `json:"userId"`
+	Type        NotificationType      `json:"type"`
+	TargetID    uint                  `json:"targetId"`
+	LastActorID uint                  `json:"lastActorId"`
+	ActorsCount uint                  `json:"actorsCount"`
+	EventsCount uint                  `json:"eventsCount"`
+	IsRead      bool                  `json:"isRead"`
+	CreatedAt   customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt   customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
+
+//easyjson:json
+type NotificationWithActor struct {
+	Notification *Notification `json:"notification"`
+	LastActor    *User         `json:"lastActor"`
+}
@@ -0,0 +1,249 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9806e1DecodeSocioDomain(in *jlexer.Lexer, out *NotificationWithActor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notification":
+			if in.IsNull() {
+				in.Skip()
+				out.Notification = nil
+			} else {
+				if out.Notification == nil {
+					out.Notification = new(Notification)
+				}
+				(*out.Notification).UnmarshalEasyJSON(in)
+			}
+		case "lastActor":
+			if in.IsNull() {
+				in.Skip()
+				out.LastActor = nil
+			} else {
+				if out.LastActor == nil {
+					out.LastActor = new(User)
+				}
+				(*out.LastActor).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeSocioDomain(out *jwriter.Writer, in NotificationWithActor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notification\":"
+		out.RawString(prefix[1:])
+		if in.Notification == nil {
+			out.RawString("null")
+		} else {
+			(*in.Notification).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"lastActor\":"
+		out.RawString(prefix)
+		if in.LastActor == nil {
+			out.RawString("null")
+		} else {
+			(*in.LastActor).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationWithActor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeSocioDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationWithActor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeSocioDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationWithActor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeSocioDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationWithActor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeSocioDomain(l, v)
+}
+func easyjson9806e1DecodeSocioDomain1(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "type":
+			out.Type = NotificationType(in.String())
+		case "targetId":
+			out.TargetID = uint(in.Uint())
+		case "lastActorId":
+			out.LastActorID = uint(in.Uint())
+		case "actorsCount":
+			out.ActorsCount = uint(in.Uint())
+		case "eventsCount":
+			out.EventsCount = uint(in.Uint())
+		case "isRead":
+			out.IsRead = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeSocioDomain1(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"targetId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.TargetID))
+	}
+	{
+		const prefix string = ",\"lastActorId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.LastActorID))
+	}
+	{
+		const prefix string = ",\"actorsCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ActorsCount))
+	}
+	{
+		const prefix string = ",\"eventsCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.EventsCount))
+	}
+	{
+		const prefix string = ",\"isRead\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRead))
+	}
+	if true {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9806e1EncodeSocioDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeSocioDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9806e1DecodeSocioDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeSocioDomain1(l, v)
+}
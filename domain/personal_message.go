@@ -4,14 +4,20 @@ import customtime "socio/pkg/time"
 
 //easyjson:json
 type PersonalMessage struct {
-	ID          uint                  `json:"id"`
-	SenderID    uint                  `json:"senderId"`
-	ReceiverID  uint                  `json:"receiverId"`
-	Content     string                `json:"content"`
-	Sticker     *Sticker              `json:"sticker,omitempty"`
-	CreatedAt   customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
-	UpdatedAt   customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
-	Attachments []string              `json:"attachments"`
+	ID              uint                  `json:"id"`
+	SenderID        uint                  `json:"senderId"`
+	ReceiverID      uint                  `json:"receiverId"`
+	ConversationID  uint                  `json:"conversationId"`
+	Content         string                `json:"content"`
+	Sticker         *Sticker              `json:"sticker,omitempty"`
+	CreatedAt       customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt       customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	Attachments     []string              `json:"attachments"`
+	AttachmentsInfo []*Attachment         `json:"attachmentsInfo"`
+	AttachmentURLs  []*AttachmentURL      `json:"attachmentUrls,omitempty"`
+	// IsHidden is set for the messages held by the content filter, they are
+	// stored hidden until a moderator reviews them.
+	IsHidden bool `json:"-"`
 }
 
 //easyjson:json
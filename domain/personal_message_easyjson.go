@@ -122,6 +122,8 @@ func easyjsonB8f054f0DecodeSocioDomain1(in *jlexer.Lexer, out *PersonalMessage)
 			out.SenderID = uint(in.Uint())
 		case "receiverId":
 			out.ReceiverID = uint(in.Uint())
+		case "conversationId":
+			out.ConversationID = uint(in.Uint())
 		case "content":
 			out.Content = string(in.String())
 		case "sticker":
@@ -132,7 +134,7 @@ func easyjsonB8f054f0DecodeSocioDomain1(in *jlexer.Lexer, out *PersonalMessage)
 				if out.Sticker == nil {
 					out.Sticker = new(Sticker)
 				}
-				easyjsonB8f054f0DecodeSocioDomain2(in, out.Sticker)
+				(*out.Sticker).UnmarshalEasyJSON(in)
 			}
 		case "createdAt":
 			if data := in.Raw(); in.Ok() {
@@ -165,6 +167,68 @@ func easyjsonB8f054f0DecodeSocioDomain1(in *jlexer.Lexer, out *PersonalMessage)
 				}
 				in.Delim(']')
 			}
+		case "attachmentsInfo":
+			if in.IsNull() {
+				in.Skip()
+				out.AttachmentsInfo = nil
+			} else {
+				in.Delim('[')
+				if out.AttachmentsInfo == nil {
+					if !in.IsDelim(']') {
+						out.AttachmentsInfo = make([]*Attachment, 0, 8)
+					} else {
+						out.AttachmentsInfo = []*Attachment{}
+					}
+				} else {
+					out.AttachmentsInfo = (out.AttachmentsInfo)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(Attachment)
+						}
+						(*v2).UnmarshalEasyJSON(in)
+					}
+					out.AttachmentsInfo = append(out.AttachmentsInfo, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "attachmentUrls":
+			if in.IsNull() {
+				in.Skip()
+				out.AttachmentURLs = nil
+			} else {
+				in.Delim('[')
+				if out.AttachmentURLs == nil {
+					if !in.IsDelim(']') {
+						out.AttachmentURLs = make([]*AttachmentURL, 0, 8)
+					} else {
+						out.AttachmentURLs = []*AttachmentURL{}
+					}
+				} else {
+					out.AttachmentURLs = (out.AttachmentURLs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *AttachmentURL
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(AttachmentURL)
+						}
+						(*v3).UnmarshalEasyJSON(in)
+					}
+					out.AttachmentURLs = append(out.AttachmentURLs, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -194,6 +258,11 @@ func easyjsonB8f054f0EncodeSocioDomain1(out *jwriter.Writer, in PersonalMessage)
 		out.RawString(prefix)
 		out.Uint(uint(in.ReceiverID))
 	}
+	{
+		const prefix string = ",\"conversationId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ConversationID))
+	}
 	{
 		const prefix string = ",\"content\":"
 		out.RawString(prefix)
@@ -202,7 +271,7 @@ func easyjsonB8f054f0EncodeSocioDomain1(out *jwriter.Writer, in PersonalMessage)
 	if in.Sticker != nil {
 		const prefix string = ",\"sticker\":"
 		out.RawString(prefix)
-		easyjsonB8f054f0EncodeSocioDomain2(out, *in.Sticker)
+		(*in.Sticker).MarshalEasyJSON(out)
 	}
 	if true {
 		const prefix string = ",\"createdAt\":"
@@ -221,11 +290,49 @@ func easyjsonB8f054f0EncodeSocioDomain1(out *jwriter.Writer, in PersonalMessage)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Attachments {
-				if v2 > 0 {
+			for v4, v5 := range in.Attachments {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"attachmentsInfo\":"
+		out.RawString(prefix)
+		if in.AttachmentsInfo == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.AttachmentsInfo {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				if v7 == nil {
+					out.RawString("null")
+				} else {
+					(*v7).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AttachmentURLs) != 0 {
+		const prefix string = ",\"attachmentUrls\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.AttachmentURLs {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3))
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
@@ -256,88 +363,7 @@ func (v *PersonalMessage) UnmarshalJSON(data []byte) error {
 func (v *PersonalMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonB8f054f0DecodeSocioDomain1(l, v)
 }
-func easyjsonB8f054f0DecodeSocioDomain2(in *jlexer.Lexer, out *Sticker) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "name":
-			out.Name = string(in.String())
-		case "authorId":
-			out.AuthorID = uint(in.Uint())
-		case "fileName":
-			out.FileName = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonB8f054f0EncodeSocioDomain2(out *jwriter.Writer, in Sticker) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"authorId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.AuthorID))
-	}
-	{
-		const prefix string = ",\"fileName\":"
-		out.RawString(prefix)
-		out.String(string(in.FileName))
-	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	out.RawByte('}')
-}
-func easyjsonB8f054f0DecodeSocioDomain3(in *jlexer.Lexer, out *MessageAttachment) {
+func easyjsonB8f054f0DecodeSocioDomain2(in *jlexer.Lexer, out *MessageAttachment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -378,7 +404,7 @@ func easyjsonB8f054f0DecodeSocioDomain3(in *jlexer.Lexer, out *MessageAttachment
 		in.Consumed()
 	}
 }
-func easyjsonB8f054f0EncodeSocioDomain3(out *jwriter.Writer, in MessageAttachment) {
+func easyjsonB8f054f0EncodeSocioDomain2(out *jwriter.Writer, in MessageAttachment) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -413,23 +439,23 @@ func easyjsonB8f054f0EncodeSocioDomain3(out *jwriter.Writer, in MessageAttachmen
 // MarshalJSON supports json.Marshaler interface
 func (v MessageAttachment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonB8f054f0EncodeSocioDomain3(&w, v)
+	easyjsonB8f054f0EncodeSocioDomain2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageAttachment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonB8f054f0EncodeSocioDomain3(w, v)
+	easyjsonB8f054f0EncodeSocioDomain2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageAttachment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonB8f054f0DecodeSocioDomain3(&r, v)
+	easyjsonB8f054f0DecodeSocioDomain2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageAttachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonB8f054f0DecodeSocioDomain3(l, v)
+	easyjsonB8f054f0DecodeSocioDomain2(l, v)
 }
@@ -6,14 +6,24 @@ import (
 
 //easyjson:json
 type Post struct {
-	ID          uint                  `json:"postId"`
-	AuthorID    uint                  `json:"authorId"`
-	GroupID     uint                  `json:"groupId,omitempty"`
-	Content     string                `json:"content"`
-	Attachments []string              `json:"attachments"`
-	LikedByIDs  []uint64              `json:"likedBy"`
-	CreatedAt   customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
-	UpdatedAt   customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	ID                  uint                  `json:"postId"`
+	AuthorID            uint                  `json:"authorId"`
+	GroupID             uint                  `json:"groupId,omitempty"`
+	Content             string                `json:"content"`
+	Attachments         []string              `json:"attachments"`
+	AttachmentsInfo     []*Attachment         `json:"attachmentsInfo"`
+	Entities            []*Entity             `json:"entities,omitempty"`
+	LikedByIDs          []uint64              `json:"likedBy"`
+	SharesCount         uint                  `json:"sharesCount"`
+	IsRepost            bool                  `json:"isRepost,omitempty"`
+	RepostOfID          uint                  `json:"repostOfId,omitempty"`
+	Original            *Post                 `json:"original,omitempty"`
+	OriginalUnavailable bool                  `json:"originalUnavailable,omitempty"`
+	CreatedAt           customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	UpdatedAt           customtime.CustomTime `json:"updatedAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	// IsHidden is set for the posts held by the content filter, they are
+	// stored hidden until a moderator reviews them.
+	IsHidden bool `json:"-"`
 }
 
 //easyjson:json
@@ -54,7 +54,7 @@ func easyjson5a72dc82DecodeSocioDomain(in *jlexer.Lexer, out *PostWithAuthorAndG
 				if out.Author == nil {
 					out.Author = new(User)
 				}
-				easyjson5a72dc82DecodeSocioDomain1(in, out.Author)
+				(*out.Author).UnmarshalEasyJSON(in)
 			}
 		case "group":
 			if in.IsNull() {
@@ -64,7 +64,7 @@ func easyjson5a72dc82DecodeSocioDomain(in *jlexer.Lexer, out *PostWithAuthorAndG
 				if out.Group == nil {
 					out.Group = new(PublicGroup)
 				}
-				easyjson5a72dc82DecodeSocioDomain2(in, out.Group)
+				(*out.Group).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -95,7 +95,7 @@ func easyjson5a72dc82EncodeSocioDomain(out *jwriter.Writer, in PostWithAuthorAnd
 		if in.Author == nil {
 			out.RawString("null")
 		} else {
-			easyjson5a72dc82EncodeSocioDomain1(out, *in.Author)
+			(*in.Author).MarshalEasyJSON(out)
 		}
 	}
 	{
@@ -104,7 +104,7 @@ func easyjson5a72dc82EncodeSocioDomain(out *jwriter.Writer, in PostWithAuthorAnd
 		if in.Group == nil {
 			out.RawString("null")
 		} else {
-			easyjson5a72dc82EncodeSocioDomain2(out, *in.Group)
+			(*in.Group).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
@@ -133,192 +133,7 @@ func (v *PostWithAuthorAndGroup) UnmarshalJSON(data []byte) error {
 func (v *PostWithAuthorAndGroup) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson5a72dc82DecodeSocioDomain(l, v)
 }
-func easyjson5a72dc82DecodeSocioDomain2(in *jlexer.Lexer, out *PublicGroup) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "subscribersCount":
-			out.SubscribersCount = uint(in.Uint())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson5a72dc82EncodeSocioDomain2(out *jwriter.Writer, in PublicGroup) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	{
-		const prefix string = ",\"subscribersCount\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.SubscribersCount))
-	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	out.RawByte('}')
-}
-func easyjson5a72dc82DecodeSocioDomain1(in *jlexer.Lexer, out *User) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "userId":
-			out.ID = uint(in.Uint())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "dateOfBirth":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateOfBirth).UnmarshalJSON(data))
-			}
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson5a72dc82EncodeSocioDomain1(out *jwriter.Writer, in User) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"userId\":"
-		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
-	}
-	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	if true {
-		const prefix string = ",\"dateOfBirth\":"
-		out.RawString(prefix)
-		out.Raw((in.DateOfBirth).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	out.RawByte('}')
-}
-func easyjson5a72dc82DecodeSocioDomain3(in *jlexer.Lexer, out *PostWithAuthor) {
+func easyjson5a72dc82DecodeSocioDomain1(in *jlexer.Lexer, out *PostWithAuthor) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -355,7 +170,7 @@ func easyjson5a72dc82DecodeSocioDomain3(in *jlexer.Lexer, out *PostWithAuthor) {
 				if out.Author == nil {
 					out.Author = new(User)
 				}
-				easyjson5a72dc82DecodeSocioDomain1(in, out.Author)
+				(*out.Author).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -367,7 +182,7 @@ func easyjson5a72dc82DecodeSocioDomain3(in *jlexer.Lexer, out *PostWithAuthor) {
 		in.Consumed()
 	}
 }
-func easyjson5a72dc82EncodeSocioDomain3(out *jwriter.Writer, in PostWithAuthor) {
+func easyjson5a72dc82EncodeSocioDomain1(out *jwriter.Writer, in PostWithAuthor) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -386,7 +201,7 @@ func easyjson5a72dc82EncodeSocioDomain3(out *jwriter.Writer, in PostWithAuthor)
 		if in.Author == nil {
 			out.RawString("null")
 		} else {
-			easyjson5a72dc82EncodeSocioDomain1(out, *in.Author)
+			(*in.Author).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
@@ -395,27 +210,27 @@ func easyjson5a72dc82EncodeSocioDomain3(out *jwriter.Writer, in PostWithAuthor)
 // MarshalJSON supports json.Marshaler interface
 func (v PostWithAuthor) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson5a72dc82EncodeSocioDomain3(&w, v)
+	easyjson5a72dc82EncodeSocioDomain1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostWithAuthor) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson5a72dc82EncodeSocioDomain3(w, v)
+	easyjson5a72dc82EncodeSocioDomain1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostWithAuthor) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson5a72dc82DecodeSocioDomain3(&r, v)
+	easyjson5a72dc82DecodeSocioDomain1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostWithAuthor) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson5a72dc82DecodeSocioDomain3(l, v)
+	easyjson5a72dc82DecodeSocioDomain1(l, v)
 }
-func easyjson5a72dc82DecodeSocioDomain4(in *jlexer.Lexer, out *PostLike) {
+func easyjson5a72dc82DecodeSocioDomain2(in *jlexer.Lexer, out *PostLike) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -454,7 +269,7 @@ func easyjson5a72dc82DecodeSocioDomain4(in *jlexer.Lexer, out *PostLike) {
 		in.Consumed()
 	}
 }
-func easyjson5a72dc82EncodeSocioDomain4(out *jwriter.Writer, in PostLike) {
+func easyjson5a72dc82EncodeSocioDomain2(out *jwriter.Writer, in PostLike) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -484,27 +299,27 @@ func easyjson5a72dc82EncodeSocioDomain4(out *jwriter.Writer, in PostLike) {
 // MarshalJSON supports json.Marshaler interface
 func (v PostLike) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson5a72dc82EncodeSocioDomain4(&w, v)
+	easyjson5a72dc82EncodeSocioDomain2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostLike) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson5a72dc82EncodeSocioDomain4(w, v)
+	easyjson5a72dc82EncodeSocioDomain2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostLike) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson5a72dc82DecodeSocioDomain4(&r, v)
+	easyjson5a72dc82DecodeSocioDomain2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson5a72dc82DecodeSocioDomain4(l, v)
+	easyjson5a72dc82DecodeSocioDomain2(l, v)
 }
-func easyjson5a72dc82DecodeSocioDomain5(in *jlexer.Lexer, out *Post) {
+func easyjson5a72dc82DecodeSocioDomain3(in *jlexer.Lexer, out *Post) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -554,6 +369,68 @@ func easyjson5a72dc82DecodeSocioDomain5(in *jlexer.Lexer, out *Post) {
 				}
 				in.Delim(']')
 			}
+		case "attachmentsInfo":
+			if in.IsNull() {
+				in.Skip()
+				out.AttachmentsInfo = nil
+			} else {
+				in.Delim('[')
+				if out.AttachmentsInfo == nil {
+					if !in.IsDelim(']') {
+						out.AttachmentsInfo = make([]*Attachment, 0, 8)
+					} else {
+						out.AttachmentsInfo = []*Attachment{}
+					}
+				} else {
+					out.AttachmentsInfo = (out.AttachmentsInfo)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(Attachment)
+						}
+						(*v2).UnmarshalEasyJSON(in)
+					}
+					out.AttachmentsInfo = append(out.AttachmentsInfo, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "entities":
+			if in.IsNull() {
+				in.Skip()
+				out.Entities = nil
+			} else {
+				in.Delim('[')
+				if out.Entities == nil {
+					if !in.IsDelim(']') {
+						out.Entities = make([]*Entity, 0, 8)
+					} else {
+						out.Entities = []*Entity{}
+					}
+				} else {
+					out.Entities = (out.Entities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *Entity
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(Entity)
+						}
+						(*v3).UnmarshalEasyJSON(in)
+					}
+					out.Entities = append(out.Entities, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "likedBy":
 			if in.IsNull() {
 				in.Skip()
@@ -570,13 +447,31 @@ func easyjson5a72dc82DecodeSocioDomain5(in *jlexer.Lexer, out *Post) {
 					out.LikedByIDs = (out.LikedByIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v2 uint64
-					v2 = uint64(in.Uint64())
-					out.LikedByIDs = append(out.LikedByIDs, v2)
+					var v4 uint64
+					v4 = uint64(in.Uint64())
+					out.LikedByIDs = append(out.LikedByIDs, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "sharesCount":
+			out.SharesCount = uint(in.Uint())
+		case "isRepost":
+			out.IsRepost = bool(in.Bool())
+		case "repostOfId":
+			out.RepostOfID = uint(in.Uint())
+		case "original":
+			if in.IsNull() {
+				in.Skip()
+				out.Original = nil
+			} else {
+				if out.Original == nil {
+					out.Original = new(Post)
+				}
+				(*out.Original).UnmarshalEasyJSON(in)
+			}
+		case "originalUnavailable":
+			out.OriginalUnavailable = bool(in.Bool())
 		case "createdAt":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -595,7 +490,7 @@ func easyjson5a72dc82DecodeSocioDomain5(in *jlexer.Lexer, out *Post) {
 		in.Consumed()
 	}
 }
-func easyjson5a72dc82EncodeSocioDomain5(out *jwriter.Writer, in Post) {
+func easyjson5a72dc82EncodeSocioDomain3(out *jwriter.Writer, in Post) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -626,11 +521,49 @@ func easyjson5a72dc82EncodeSocioDomain5(out *jwriter.Writer, in Post) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v3, v4 := range in.Attachments {
-				if v3 > 0 {
+			for v5, v6 := range in.Attachments {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"attachmentsInfo\":"
+		out.RawString(prefix)
+		if in.AttachmentsInfo == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.AttachmentsInfo {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				if v8 == nil {
+					out.RawString("null")
+				} else {
+					(*v8).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Entities) != 0 {
+		const prefix string = ",\"entities\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.Entities {
+				if v9 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v4))
+				if v10 == nil {
+					out.RawString("null")
+				} else {
+					(*v10).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
@@ -642,15 +575,40 @@ func easyjson5a72dc82EncodeSocioDomain5(out *jwriter.Writer, in Post) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.LikedByIDs {
-				if v5 > 0 {
+			for v11, v12 := range in.LikedByIDs {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint64(uint64(v6))
+				out.Uint64(uint64(v12))
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"sharesCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.SharesCount))
+	}
+	if in.IsRepost {
+		const prefix string = ",\"isRepost\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRepost))
+	}
+	if in.RepostOfID != 0 {
+		const prefix string = ",\"repostOfId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.RepostOfID))
+	}
+	if in.Original != nil {
+		const prefix string = ",\"original\":"
+		out.RawString(prefix)
+		(*in.Original).MarshalEasyJSON(out)
+	}
+	if in.OriginalUnavailable {
+		const prefix string = ",\"originalUnavailable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OriginalUnavailable))
+	}
 	if true {
 		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
@@ -667,27 +625,27 @@ func easyjson5a72dc82EncodeSocioDomain5(out *jwriter.Writer, in Post) {
 // MarshalJSON supports json.Marshaler interface
 func (v Post) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson5a72dc82EncodeSocioDomain5(&w, v)
+	easyjson5a72dc82EncodeSocioDomain3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Post) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson5a72dc82EncodeSocioDomain5(w, v)
+	easyjson5a72dc82EncodeSocioDomain3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Post) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson5a72dc82DecodeSocioDomain5(&r, v)
+	easyjson5a72dc82DecodeSocioDomain3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Post) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson5a72dc82DecodeSocioDomain5(l, v)
+	easyjson5a72dc82DecodeSocioDomain3(l, v)
 }
-func easyjson5a72dc82DecodeSocioDomain6(in *jlexer.Lexer, out *GroupPost) {
+func easyjson5a72dc82DecodeSocioDomain4(in *jlexer.Lexer, out *GroupPost) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -730,7 +688,7 @@ func easyjson5a72dc82DecodeSocioDomain6(in *jlexer.Lexer, out *GroupPost) {
 		in.Consumed()
 	}
 }
-func easyjson5a72dc82EncodeSocioDomain6(out *jwriter.Writer, in GroupPost) {
+func easyjson5a72dc82EncodeSocioDomain4(out *jwriter.Writer, in GroupPost) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -765,23 +723,23 @@ func easyjson5a72dc82EncodeSocioDomain6(out *jwriter.Writer, in GroupPost) {
 // MarshalJSON supports json.Marshaler interface
 func (v GroupPost) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson5a72dc82EncodeSocioDomain6(&w, v)
+	easyjson5a72dc82EncodeSocioDomain4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupPost) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson5a72dc82EncodeSocioDomain6(w, v)
+	easyjson5a72dc82EncodeSocioDomain4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupPost) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson5a72dc82DecodeSocioDomain6(&r, v)
+	easyjson5a72dc82DecodeSocioDomain4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupPost) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson5a72dc82DecodeSocioDomain6(l, v)
+	easyjson5a72dc82DecodeSocioDomain4(l, v)
 }
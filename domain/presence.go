@@ -0,0 +1,10 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+//easyjson:json
+type Presence struct {
+	UserID   uint                  `json:"userId"`
+	IsOnline bool                  `json:"isOnline"`
+	LastSeen customtime.CustomTime `json:"lastSeen,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
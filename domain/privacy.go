@@ -0,0 +1,59 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+type PrivacyLevel string
+
+const (
+	EveryonePrivacy    PrivacyLevel = "EVERYONE"
+	SubscribersPrivacy PrivacyLevel = "SUBSCRIBERS"
+	FriendsPrivacy     PrivacyLevel = "FRIENDS"
+	OnlyMePrivacy      PrivacyLevel = "ONLY_ME"
+)
+
+// PrivacySettings tell who can see the posts, the subscriptions and the date
+// of birth of the user and who can message them.
+//
+//easyjson:json
+type PrivacySettings struct {
+	UserID        uint         `json:"userId"`
+	Posts         PrivacyLevel `json:"posts" example:"EVERYONE"`
+	Messages      PrivacyLevel `json:"messages" example:"EVERYONE"`
+	Subscriptions PrivacyLevel `json:"subscriptions" example:"EVERYONE"`
+	DateOfBirth   PrivacyLevel `json:"dateOfBirth" example:"EVERYONE"`
+}
+
+// DefaultPrivacySettings are the settings of the users who never changed them.
+func DefaultPrivacySettings(userID uint) *PrivacySettings {
+	return &PrivacySettings{
+		UserID:        userID,
+		Posts:         EveryonePrivacy,
+		Messages:      EveryonePrivacy,
+		Subscriptions: EveryonePrivacy,
+		DateOfBirth:   EveryonePrivacy,
+	}
+}
+
+func (l PrivacyLevel) IsValid() bool {
+	switch l {
+	case EveryonePrivacy, SubscribersPrivacy, FriendsPrivacy, OnlyMePrivacy:
+		return true
+	}
+
+	return false
+}
+
+// HideDatesOfBirth blanks the dates of birth of the users missing from
+// viewableIDs.
+func HideDatesOfBirth(users []*User, viewableIDs []uint) {
+	viewable := make(map[uint]bool, len(viewableIDs))
+	for _, userID := range viewableIDs {
+		viewable[userID] = true
+	}
+
+	for _, user := range users {
+		if !viewable[user.ID] {
+			user.DateOfBirth = customtime.CustomTime{}
+		}
+	}
+}
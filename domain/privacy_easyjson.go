@@ -0,0 +1,113 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson83ccb59aDecodeSocioDomain(in *jlexer.Lexer, out *PrivacySettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "posts":
+			out.Posts = PrivacyLevel(in.String())
+		case "messages":
+			out.Messages = PrivacyLevel(in.String())
+		case "subscriptions":
+			out.Subscriptions = PrivacyLevel(in.String())
+		case "dateOfBirth":
+			out.DateOfBirth = PrivacyLevel(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeSocioDomain(out *jwriter.Writer, in PrivacySettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"posts\":"
+		out.RawString(prefix)
+		out.String(string(in.Posts))
+	}
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		out.String(string(in.Messages))
+	}
+	{
+		const prefix string = ",\"subscriptions\":"
+		out.RawString(prefix)
+		out.String(string(in.Subscriptions))
+	}
+	{
+		const prefix string = ",\"dateOfBirth\":"
+		out.RawString(prefix)
+		out.String(string(in.DateOfBirth))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PrivacySettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeSocioDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PrivacySettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeSocioDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PrivacySettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeSocioDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PrivacySettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeSocioDomain(l, v)
+}
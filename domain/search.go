@@ -0,0 +1,19 @@
+package domain
+
+// FoundPost is a post matching the search query. Snippet is the part of the
+// content with the matched words wrapped in <mark>.
+//
+//easyjson:json
+type FoundPost struct {
+	Post    *PostWithAuthorAndGroup `json:"post"`
+	Snippet string                  `json:"snippet"`
+}
+
+// FoundComment is a comment matching the search query. Snippet is the part of
+// the content with the matched words wrapped in <mark>.
+//
+//easyjson:json
+type FoundComment struct {
+	Comment *CommentWithAuthor `json:"comment"`
+	Snippet string             `json:"snippet"`
+}
@@ -0,0 +1,17 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+//easyjson:json
+type Session struct {
+	ID         string                `json:"id"`
+	SessionID  string                `json:"-"`
+	UserID     uint                  `json:"userId"`
+	Device     string                `json:"device"`
+	IP         string                `json:"ip"`
+	UserAgent  string                `json:"userAgent"`
+	IsCurrent  bool                  `json:"isCurrent"`
+	CreatedAt  customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	LastSeenAt customtime.CustomTime `json:"lastSeenAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	ExpiresAt  customtime.CustomTime `json:"expiresAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
@@ -0,0 +1,21 @@
+package domain
+
+import customtime "socio/pkg/time"
+
+// Upload is a resumable upload, the client sends the file in chunks and
+// continues from Offset after the connection is lost.
+//
+//easyjson:json
+type Upload struct {
+	ID           string                `json:"id"`
+	UserID       uint                  `json:"userId"`
+	Kind         string                `json:"kind"`
+	FileName     string                `json:"fileName"`
+	OriginalName string                `json:"originalName"`
+	Length       int64                 `json:"length"`
+	Offset       int64                 `json:"offset"`
+	Checksum     string                `json:"checksum"` // hex encoded SHA-256 of the whole file
+	Completed    bool                  `json:"completed"`
+	CreatedAt    customtime.CustomTime `json:"createdAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+	ExpiresAt    customtime.CustomTime `json:"expiresAt,omitempty" swaggertype:"string" example:"2021-01-01T00:00:00Z" format:"date-time"`
+}
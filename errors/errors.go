@@ -42,6 +42,19 @@ var (
 	InvalidFileNameMsg      = "invalid file name"
 	InvalidSlugMsg          = "invalid slug parameters"
 	RowsAffectedMsg         = "wrong number of rows affected"
+	FileTooLargeMsg         = "file is too large"
+	TooManyFilesMsg         = "too many files"
+	UnsupportedMediaTypeMsg = "unsupported media type"
+	UploadOffsetMismatchMsg = "upload offset mismatch"
+	ChecksumMismatchMsg     = "checksum mismatch"
+	BannedContentMsg        = "content contains banned words"
+	DuplicateContentMsg     = "content duplicates recently sent content"
+	ContentTooFrequentMsg   = "content is sent too frequently"
+	TooManyRequestsMsg      = "too many requests"
+	LoginLockedMsg          = "too many failed login attempts, try again later"
+	InvalidTokenMsg         = "invalid or expired token"
+	EmailNotVerifiedMsg     = "email is not verified"
+	EmailAlreadyVerifiedMsg = "email is already verified"
 )
 
 var (
@@ -67,4 +80,17 @@ var (
 	ErrInvalidFileName      = NewCustomError(errors.New(InvalidFileNameMsg))
 	ErrInvalidSlug          = NewCustomError(errors.New(InvalidSlugMsg))
 	ErrRowsAffected         = NewCustomError(errors.New(RowsAffectedMsg))
+	ErrFileTooLarge         = NewCustomError(errors.New(FileTooLargeMsg))
+	ErrTooManyFiles         = NewCustomError(errors.New(TooManyFilesMsg))
+	ErrUnsupportedMediaType = NewCustomError(errors.New(UnsupportedMediaTypeMsg))
+	ErrUploadOffsetMismatch = NewCustomError(errors.New(UploadOffsetMismatchMsg))
+	ErrChecksumMismatch     = NewCustomError(errors.New(ChecksumMismatchMsg))
+	ErrBannedContent        = NewCustomError(errors.New(BannedContentMsg))
+	ErrDuplicateContent     = NewCustomError(errors.New(DuplicateContentMsg))
+	ErrContentTooFrequent   = NewCustomError(errors.New(ContentTooFrequentMsg))
+	ErrTooManyRequests      = NewCustomError(errors.New(TooManyRequestsMsg))
+	ErrLoginLocked          = NewCustomError(errors.New(LoginLockedMsg))
+	ErrInvalidToken         = NewCustomError(errors.New(InvalidTokenMsg))
+	ErrEmailNotVerified     = NewCustomError(errors.New(EmailNotVerifiedMsg))
+	ErrEmailAlreadyVerified = NewCustomError(errors.New(EmailAlreadyVerifiedMsg))
 )
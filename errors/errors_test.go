@@ -103,6 +103,66 @@ func TestParseGRPCError(t *testing.T) {
 			expectedMsg:    errorsCustom.InvalidDataMsg,
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:           "Parse GRPC error of too large file",
+			err:            errorsCustom.ErrFileTooLarge.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.FileTooLargeMsg,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "Parse GRPC error of too many files",
+			err:            errorsCustom.ErrTooManyFiles.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.TooManyFilesMsg,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "Parse GRPC error of too many requests",
+			err:            errorsCustom.ErrTooManyRequests.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.TooManyRequestsMsg,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "Parse GRPC error of locked login",
+			err:            errorsCustom.ErrLoginLocked.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.LoginLockedMsg,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "Parse GRPC error of not verified email",
+			err:            errorsCustom.ErrEmailNotVerified.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.EmailNotVerifiedMsg,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Parse GRPC error of unsupported media type",
+			err:            errorsCustom.ErrUnsupportedMediaType.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.UnsupportedMediaTypeMsg,
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:           "Parse GRPC error of upload offset mismatch",
+			err:            errorsCustom.ErrUploadOffsetMismatch.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.UploadOffsetMismatchMsg,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Parse GRPC error of checksum mismatch",
+			err:            errorsCustom.ErrChecksumMismatch.GRPCStatus().Err(),
+			expectedMsg:    errorsCustom.ChecksumMismatchMsg,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Parse GRPC error of exceeded deadline",
+			err:            status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+			expectedMsg:    "context deadline exceeded",
+			expectedStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:           "Parse GRPC error with unknown code",
+			err:            status.Error(codes.Canceled, "context canceled"),
+			expectedMsg:    "context canceled",
+			expectedStatus: http.StatusInternalServerError,
+		},
 		{
 			name:           "Parse GRPC error without status",
 			err:            errors.New("some error"),
@@ -30,14 +30,40 @@ var GRPCErrors = map[string]codes.Code{
 	InvalidSlugMsg:          codes.InvalidArgument,
 	RowsAffectedMsg:         codes.InvalidArgument,
 	JSONMarshallingMsg:      codes.Internal,
+	FileTooLargeMsg:         codes.ResourceExhausted,
+	TooManyFilesMsg:         codes.ResourceExhausted,
+	UnsupportedMediaTypeMsg: codes.FailedPrecondition,
+	UploadOffsetMismatchMsg: codes.Aborted,
+	ChecksumMismatchMsg:     codes.DataLoss,
+	BannedContentMsg:        codes.InvalidArgument,
+	DuplicateContentMsg:     codes.InvalidArgument,
+	ContentTooFrequentMsg:   codes.InvalidArgument,
+	TooManyRequestsMsg:      codes.ResourceExhausted,
+	LoginLockedMsg:          codes.ResourceExhausted,
+	InvalidTokenMsg:         codes.InvalidArgument,
+	EmailNotVerifiedMsg:     codes.PermissionDenied,
+	EmailAlreadyVerifiedMsg: codes.InvalidArgument,
 }
 
 var GRPCStatuses = map[codes.Code]int{
-	codes.Unauthenticated:  http.StatusUnauthorized,
-	codes.InvalidArgument:  http.StatusBadRequest,
-	codes.PermissionDenied: http.StatusForbidden,
-	codes.NotFound:         http.StatusNotFound,
-	codes.Internal:         http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.ResourceExhausted:  http.StatusRequestEntityTooLarge,
+	codes.FailedPrecondition: http.StatusUnsupportedMediaType,
+	codes.Aborted:            http.StatusConflict,
+	codes.DataLoss:           http.StatusUnprocessableEntity,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+}
+
+// GRPCMessageStatuses overrides the status of the errors sharing the code
+// with the other errors.
+var GRPCMessageStatuses = map[string]int{
+	TooManyRequestsMsg: http.StatusTooManyRequests,
+	LoginLockedMsg:     http.StatusTooManyRequests,
 }
 
 func (e *CustomError) GRPCStatus() (grpcStatus *status.Status) {
@@ -54,7 +80,16 @@ func ParseGRPCError(err error) (msg string, code int) {
 		st, ok := status.FromError(err)
 		if ok {
 			msg = st.Message()
-			code = GRPCStatuses[st.Code()]
+
+			code, ok = GRPCMessageStatuses[msg]
+			if ok {
+				return
+			}
+
+			code, ok = GRPCStatuses[st.Code()]
+			if !ok {
+				code = http.StatusInternalServerError
+			}
 		} else {
 			msg = err.Error()
 			code = http.StatusInternalServerError
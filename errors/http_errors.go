@@ -26,6 +26,19 @@ var HTTPErrors = map[error]int{
 	ErrRowsAffected:         http.StatusBadRequest,
 	ErrForbidden:            http.StatusForbidden,
 	ErrNotFound:             http.StatusNotFound,
+	ErrFileTooLarge:         http.StatusRequestEntityTooLarge,
+	ErrTooManyFiles:         http.StatusRequestEntityTooLarge,
+	ErrUnsupportedMediaType: http.StatusUnsupportedMediaType,
+	ErrUploadOffsetMismatch: http.StatusConflict,
+	ErrChecksumMismatch:     http.StatusUnprocessableEntity,
+	ErrBannedContent:        http.StatusBadRequest,
+	ErrDuplicateContent:     http.StatusBadRequest,
+	ErrContentTooFrequent:   http.StatusBadRequest,
+	ErrTooManyRequests:      http.StatusTooManyRequests,
+	ErrLoginLocked:          http.StatusTooManyRequests,
+	ErrInvalidToken:         http.StatusBadRequest,
+	ErrEmailNotVerified:     http.StatusForbidden,
+	ErrEmailAlreadyVerified: http.StatusBadRequest,
 	ErrJSONMarshalling:      http.StatusInternalServerError,
 	ErrInternal:             http.StatusInternalServerError,
 }
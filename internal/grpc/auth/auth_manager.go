@@ -2,10 +2,16 @@ package auth
 
 import (
 	"context"
+	"socio/domain"
 	"socio/errors"
 	authpb "socio/internal/grpc/auth/proto"
 	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/hash"
+	customtime "socio/pkg/time"
 	"socio/usecase/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthManager struct {
@@ -15,25 +21,31 @@ type AuthManager struct {
 	UserClient  uspb.UserClient
 }
 
-func NewAuthManager(userClient uspb.UserClient, sessionStorage auth.SessionStorage) *AuthManager {
+func NewAuthManager(userClient uspb.UserClient, sessionStorage auth.SessionStorage, emailOutbox auth.EmailOutbox, passwordHasher hash.PasswordHasher, tp customtime.TimeProvider) *AuthManager {
 	return &AuthManager{
-		AuthService: auth.NewService(sessionStorage),
+		AuthService: auth.NewService(sessionStorage, NewUserStorage(userClient), emailOutbox, passwordHasher, tp),
 		UserClient:  userClient,
 	}
 }
 
 func (a *AuthManager) Login(ctx context.Context, in *authpb.LoginRequest) (res *authpb.LoginResponse, err error) {
 	loginInput := auth.LoginInput{
-		Email:    in.GetEmail(),
-		Password: in.GetPassword(),
+		Email:     in.GetEmail(),
+		Password:  in.GetPassword(),
+		IP:        in.GetIp(),
+		UserAgent: in.GetUserAgent(),
 	}
 
 	userRes, err := a.UserClient.GetByEmail(ctx, &uspb.GetByEmailRequest{Email: loginInput.Email})
-	if err != nil {
+	if err != nil && status.Code(err) != codes.NotFound {
 		return
 	}
 
-	user := uspb.ToUser(userRes.User)
+	// the attempts with the unknown email are counted by the lockout as well
+	var user *domain.User
+	if err == nil {
+		user = uspb.ToUser(userRes.User)
+	}
 
 	sessionID, err := a.AuthService.Login(ctx, loginInput, user)
 	if err != nil {
@@ -81,3 +93,96 @@ func (a *AuthManager) ValidateSession(ctx context.Context, in *authpb.ValidateSe
 
 	return
 }
+
+func (a *AuthManager) ListSessions(ctx context.Context, in *authpb.ListSessionsRequest) (res *authpb.ListSessionsResponse, err error) {
+	userID := uint(in.GetUserId())
+	currentSessionID := in.GetCurrentSessionId()
+
+	sessions, err := a.AuthService.ListSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &authpb.ListSessionsResponse{
+		Sessions: authpb.ToSessionsResponse(sessions),
+	}
+
+	return
+}
+
+func (a *AuthManager) RevokeSession(ctx context.Context, in *authpb.RevokeSessionRequest) (res *authpb.RevokeSessionResponse, err error) {
+	userID := uint(in.GetUserId())
+	id := in.GetId()
+
+	err = a.AuthService.RevokeSession(ctx, userID, id)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &authpb.RevokeSessionResponse{}
+
+	return
+}
+
+func (a *AuthManager) RevokeAllSessions(ctx context.Context, in *authpb.RevokeAllSessionsRequest) (res *authpb.RevokeAllSessionsResponse, err error) {
+	userID := uint(in.GetUserId())
+	exceptSessionID := in.GetExceptSessionId()
+
+	err = a.AuthService.RevokeAllSessions(ctx, userID, exceptSessionID)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &authpb.RevokeAllSessionsResponse{}
+
+	return
+}
+
+func (a *AuthManager) RequestPasswordReset(ctx context.Context, in *authpb.RequestPasswordResetRequest) (res *authpb.RequestPasswordResetResponse, err error) {
+	userRes, err := a.UserClient.GetByEmail(ctx, &uspb.GetByEmailRequest{Email: in.GetEmail()})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return
+	}
+
+	// the unknown email is answered the same way as the known one
+	var user *domain.User
+	if err == nil {
+		user = uspb.ToUser(userRes.User)
+	}
+
+	err = a.AuthService.RequestPasswordReset(ctx, user)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &authpb.RequestPasswordResetResponse{}
+
+	return
+}
+
+func (a *AuthManager) ResetPassword(ctx context.Context, in *authpb.ResetPasswordRequest) (res *authpb.ResetPasswordResponse, err error) {
+	input := auth.ResetPasswordInput{
+		Token:          in.GetToken(),
+		Password:       in.GetPassword(),
+		RepeatPassword: in.GetRepeatPassword(),
+	}
+
+	err = a.AuthService.ResetPassword(ctx, input)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &authpb.ResetPasswordResponse{}
+
+	return
+}
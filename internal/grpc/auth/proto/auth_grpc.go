@@ -58,3 +58,55 @@ func ToUserResponse(user *domain.User) *UserResponse {
 		Salt:           user.Salt,
 	}
 }
+
+func ToSessionResponse(session *domain.Session) *SessionResponse {
+	return &SessionResponse{
+		Id:         session.ID,
+		UserId:     uint64(session.UserID),
+		Device:     session.Device,
+		Ip:         session.IP,
+		UserAgent:  session.UserAgent,
+		IsCurrent:  session.IsCurrent,
+		CreatedAt:  timestamppb.New(session.CreatedAt.Time),
+		LastSeenAt: timestamppb.New(session.LastSeenAt.Time),
+		ExpiresAt:  timestamppb.New(session.ExpiresAt.Time),
+	}
+}
+
+func ToSessionsResponse(sessions []*domain.Session) (res []*SessionResponse) {
+	for _, session := range sessions {
+		res = append(res, ToSessionResponse(session))
+	}
+
+	return
+}
+
+func ToSession(session *SessionResponse) *domain.Session {
+	return &domain.Session{
+		ID:        session.Id,
+		UserID:    uint(session.UserId),
+		Device:    session.Device,
+		IP:        session.Ip,
+		UserAgent: session.UserAgent,
+		IsCurrent: session.IsCurrent,
+		CreatedAt: customtime.CustomTime{
+			Time: session.CreatedAt.AsTime(),
+		},
+		LastSeenAt: customtime.CustomTime{
+			Time: session.LastSeenAt.AsTime(),
+		},
+		ExpiresAt: customtime.CustomTime{
+			Time: session.ExpiresAt.AsTime(),
+		},
+	}
+}
+
+func ToSessions(sessions []*SessionResponse) (res []*domain.Session) {
+	res = make([]*domain.Session, 0)
+
+	for _, session := range sessions {
+		res = append(res, ToSession(session))
+	}
+
+	return
+}
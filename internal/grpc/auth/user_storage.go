@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"socio/domain"
+	uspb "socio/internal/grpc/user/proto"
+)
+
+// UserStorage exposes the user service to the auth usecase. The user service
+// hashes the password itself whenever it differs from the stored one.
+type UserStorage struct {
+	UserClient uspb.UserClient
+}
+
+func NewUserStorage(userClient uspb.UserClient) *UserStorage {
+	return &UserStorage{
+		UserClient: userClient,
+	}
+}
+
+func (s *UserStorage) UpdateUser(ctx context.Context, user *domain.User, prevPassword string) (updatedUser *domain.User, err error) {
+	res, err := s.UserClient.Update(ctx, &uspb.UpdateRequest{
+		UserId:         uint64(user.ID),
+		Password:       user.Password,
+		RepeatPassword: user.Password,
+	})
+	if err != nil {
+		return
+	}
+
+	updatedUser = uspb.ToUser(res.GetUser())
+
+	return
+}
+
+func (s *UserStorage) CheckIfUserIsBanned(ctx context.Context, userID uint) (isBanned bool, err error) {
+	res, err := s.UserClient.CheckIfUserIsBanned(ctx, &uspb.CheckIfUserIsBannedRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		return
+	}
+
+	isBanned = res.GetIsBanned()
+
+	return
+}
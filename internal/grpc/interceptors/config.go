@@ -1,5 +1,10 @@
 package interceptors
 
+import (
+	"socio/usecase/ratelimit"
+	"time"
+)
+
 var (
 	PublicMethods = map[string]struct{}{
 		"/auth.Auth/Login":           {},
@@ -8,4 +13,14 @@ var (
 		"/user.User/GetByEmail":      {},
 		"/user.User/Create":          {},
 	}
+	MethodRateLimits = map[string]ratelimit.Limit{
+		"/auth.Auth/Login":         {Requests: 20, Window: time.Minute},
+		"/post.Post/CreatePost":    {Requests: 20, Window: time.Minute},
+		"/post.Post/RepostPost":    {Requests: 20, Window: time.Minute},
+		"/post.Post/LikePost":      {Requests: 120, Window: time.Minute},
+		"/post.Post/UnlikePost":    {Requests: 120, Window: time.Minute},
+		"/post.Post/CreateComment": {Requests: 60, Window: time.Minute},
+		"/post.Post/LikeComment":   {Requests: 120, Window: time.Minute},
+		"/post.Post/UnlikeComment": {Requests: 120, Window: time.Minute},
+	}
 )
@@ -0,0 +1,71 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"socio/errors"
+	"socio/usecase/ratelimit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	RetryAfterMetadataKey = "retry-after"
+)
+
+type userIDGetter interface {
+	GetUserId() uint64
+}
+
+type authorIDGetter interface {
+	GetAuthorId() uint64
+}
+
+type ipGetter interface {
+	GetIp() string
+}
+
+// CreateRateLimitUnaryInterceptor limits the calls of the methods listed in
+// MethodRateLimits. The calls are counted per user or client IP taken from
+// the request, the address of the caller is used if the request has neither.
+func CreateRateLimitUnaryInterceptor(rateLimitService *ratelimit.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limit, ok := MethodRateLimits[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		decision := rateLimitService.Allow(ctx, info.FullMethod, getRateLimitSubject(ctx, req), limit)
+		if !decision.Allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds())))))
+			return nil, errors.ErrTooManyRequests
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func getRateLimitSubject(ctx context.Context, req interface{}) string {
+	if r, ok := req.(userIDGetter); ok && r.GetUserId() != 0 {
+		return fmt.Sprintf("user:%d", r.GetUserId())
+	}
+
+	if r, ok := req.(authorIDGetter); ok && r.GetAuthorId() != 0 {
+		return fmt.Sprintf("user:%d", r.GetAuthorId())
+	}
+
+	if r, ok := req.(ipGetter); ok && r.GetIp() != "" {
+		return "ip:" + r.GetIp()
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		return "peer:" + p.Addr.String()
+	}
+
+	return "unknown"
+}
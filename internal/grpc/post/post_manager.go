@@ -9,7 +9,9 @@ import (
 	"socio/domain"
 	"socio/errors"
 	postspb "socio/internal/grpc/post/proto"
+	"socio/pkg/upload"
 	"socio/pkg/utils"
+	"time"
 
 	"socio/usecase/posts"
 
@@ -34,8 +36,9 @@ func NewPostManager(postsStorage posts.PostsStorage, attachmentStorage posts.Att
 
 func (p *PostManager) GetPostByID(ctx context.Context, in *postspb.GetPostByIDRequest) (res *postspb.GetPostByIDResponse, err error) {
 	postID := in.GetPostId()
+	viewerID := in.GetViewerId()
 
-	post, err := p.PostsService.GetPostByID(ctx, uint(postID))
+	post, err := p.PostsService.GetPostByID(ctx, uint(viewerID), uint(postID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -51,10 +54,11 @@ func (p *PostManager) GetPostByID(ctx context.Context, in *postspb.GetPostByIDRe
 
 func (p *PostManager) GetUserPosts(ctx context.Context, in *postspb.GetUserPostsRequest) (res *postspb.GetUserPostsResponse, err error) {
 	userID := in.GetUserId()
+	viewerID := in.GetViewerId()
 	lastPostID := in.GetLastPostId()
 	postsAmount := in.GetPostsAmount()
 
-	posts, err := p.PostsService.GetUserPosts(ctx, uint(userID), uint(lastPostID), uint(postsAmount))
+	posts, err := p.PostsService.GetUserPosts(ctx, uint(userID), uint(viewerID), uint(lastPostID), uint(postsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -110,6 +114,31 @@ func (p *PostManager) CreatePost(ctx context.Context, in *postspb.CreatePostRequ
 	return
 }
 
+func (p *PostManager) RepostPost(ctx context.Context, in *postspb.RepostPostRequest) (res *postspb.RepostPostResponse, err error) {
+	postID := in.GetPostId()
+	authorID := in.GetAuthorId()
+	content := in.GetContent()
+	groupID := in.GetGroupId()
+
+	post, err := p.PostsService.RepostPost(ctx, posts.RepostInput{
+		PostID:   uint(postID),
+		AuthorID: uint(authorID),
+		Content:  content,
+		GroupID:  uint(groupID),
+	})
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.RepostPostResponse{
+		Post: postspb.ToPostResponse(post),
+	}
+
+	return
+}
+
 func (p *PostManager) UpdatePost(ctx context.Context, in *postspb.UpdatePostRequest) (res *postspb.UpdatePostResponse, err error) {
 	postID := in.GetPostId()
 	content := in.GetContent()
@@ -220,21 +249,23 @@ func (p *PostManager) Upload(stream postspb.Post_UploadServer) (err error) {
 	}
 
 	fileName := ""
-	contentType := ""
+	originalName := ""
 
-	var fileSize uint64
+	var fileSize int64
 	fileSize = 0
 	defer func() {
-		if err = file.Close(); err != nil {
-			fmt.Println(err)
-			customErr := errors.NewCustomError(err)
-			err = customErr.GRPCStatus().Err()
+		if closeErr := file.Close(); closeErr != nil {
+			fmt.Println(closeErr)
+		}
+		if removeErr := os.Remove(file.Name()); removeErr != nil {
+			fmt.Println(removeErr)
 		}
 	}()
 	for {
 		req, err := stream.Recv()
 		if fileName == "" {
 			fileName = req.GetFileName()
+			originalName = req.GetOriginalName()
 		}
 		if err == io.EOF {
 			break
@@ -245,30 +276,27 @@ func (p *PostManager) Upload(stream postspb.Post_UploadServer) (err error) {
 			return err
 		}
 		chunk := req.GetChunk()
-		fileSize += uint64(len(chunk))
+		fileSize += int64(len(chunk))
+		if err = upload.CheckSize(upload.PostAttachmentKind, fileSize); err != nil {
+			customErr := errors.NewCustomError(err)
+			err = customErr.GRPCStatus().Err()
+			return err
+		}
 		if _, err = file.Write(chunk); err != nil {
 			customErr := errors.NewCustomError(err)
 			err = customErr.GRPCStatus().Err()
 			return err
 		}
-		contentType = req.GetContentType()
 	}
 
-	err = p.PostsService.UploadAttachment(fileName, file.Name(), contentType)
+	meta, err := p.PostsService.UploadAttachment(stream.Context(), fileName, originalName, file.Name())
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
 		return
 	}
 
-	if err = os.Remove(file.Name()); err != nil {
-		return
-	}
-
-	return stream.SendAndClose(&postspb.UploadResponse{
-		FileName: fileName,
-		Size:     fileSize,
-	})
+	return stream.SendAndClose(postspb.ToUploadResponse(meta))
 }
 
 func (p *PostManager) CreateGroupPost(ctx context.Context, in *postspb.CreateGroupPostRequest) (res *postspb.CreateGroupPostResponse, err error) {
@@ -311,8 +339,9 @@ func (p *PostManager) GetPostsOfGroup(ctx context.Context, in *postspb.GetPostsO
 	groupID := in.GetGroupId()
 	lastPostID := in.GetLastPostId()
 	postsAmount := in.GetPostsAmount()
+	viewerID := in.GetViewerId()
 
-	posts, err := p.PostsService.GetPostsOfGroup(ctx, uint(groupID), uint(lastPostID), uint(postsAmount))
+	posts, err := p.PostsService.GetPostsOfGroup(ctx, uint(viewerID), uint(groupID), uint(lastPostID), uint(postsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -330,10 +359,11 @@ func (p *PostManager) GetGroupPostsBySubscriptionIDs(ctx context.Context, in *po
 	subIDs := in.GetSubscriptionIds()
 	lastPostID := in.GetLastPostId()
 	postsAmount := in.GetPostsAmount()
+	viewerID := in.GetViewerId()
 
 	subIDsUint := utils.Uint64ToUintSlice(subIDs)
 
-	posts, err := p.PostsService.GetGroupPostsBySubscriptionIDs(ctx, subIDsUint, uint(lastPostID), uint(postsAmount))
+	posts, err := p.PostsService.GetGroupPostsBySubscriptionIDs(ctx, uint(viewerID), subIDsUint, uint(lastPostID), uint(postsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -352,11 +382,12 @@ func (p *PostManager) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, in
 	userSubIDs := in.GetUserSubscriptionIds()
 	lastPostID := in.GetLastPostId()
 	postsAmount := in.GetPostsAmount()
+	viewerID := in.GetViewerId()
 
 	groupSubIDsUint := utils.Uint64ToUintSlice(groupSubIDs)
 	userSubIDsUint := utils.Uint64ToUintSlice(userSubIDs)
 
-	posts, err := p.PostsService.GetPostsByGroupSubIDsAndUserSubIDs(ctx, groupSubIDsUint, userSubIDsUint, uint(lastPostID), uint(postsAmount))
+	posts, err := p.PostsService.GetPostsByGroupSubIDsAndUserSubIDs(ctx, uint(viewerID), groupSubIDsUint, userSubIDsUint, uint(lastPostID), uint(postsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -373,8 +404,9 @@ func (p *PostManager) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, in
 func (p *PostManager) GetNewPosts(ctx context.Context, in *postspb.GetNewPostsRequest) (res *postspb.GetNewPostsResponse, err error) {
 	lastPostID := in.GetLastPostId()
 	postsAmount := in.GetPostsAmount()
+	viewerID := in.GetViewerId()
 
-	posts, err := p.PostsService.GetNewPosts(ctx, uint(lastPostID), uint(postsAmount))
+	posts, err := p.PostsService.GetNewPosts(ctx, uint(viewerID), uint(lastPostID), uint(postsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -388,10 +420,36 @@ func (p *PostManager) GetNewPosts(ctx context.Context, in *postspb.GetNewPostsRe
 	return
 }
 
+func (p *PostManager) GetFeed(ctx context.Context, in *postspb.GetFeedRequest) (res *postspb.GetFeedResponse, err error) {
+	input := posts.FeedInput{
+		UserID:      uint(in.GetUserId()),
+		GroupSubIDs: utils.Uint64ToUintSlice(in.GetGroupSubscriptionIds()),
+		UserSubIDs:  utils.Uint64ToUintSlice(in.GetUserSubscriptionIds()),
+		Cursor:      in.GetCursor(),
+		PostsAmount: uint(in.GetPostsAmount()),
+	}
+
+	feedPosts, nextCursor, err := p.PostsService.GetFeed(ctx, input)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.GetFeedResponse{
+		Posts:      postspb.ToPostsResponse(feedPosts),
+		NextCursor: nextCursor,
+	}
+
+	return
+}
+
 func (p *PostManager) GetCommentsByPostID(ctx context.Context, in *postspb.GetCommentsByPostIDRequest) (res *postspb.GetCommentsByPostIDResponse, err error) {
 	postID := in.GetPostId()
+	lastCommentID := in.GetLastCommentId()
+	commentsAmount := in.GetCommentsAmount()
 
-	comments, err := p.PostsService.GetCommentsByPostID(ctx, uint(postID))
+	comments, err := p.PostsService.GetCommentsByPostID(ctx, uint(postID), uint(lastCommentID), uint(commentsAmount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -405,14 +463,35 @@ func (p *PostManager) GetCommentsByPostID(ctx context.Context, in *postspb.GetCo
 	return
 }
 
+func (p *PostManager) GetCommentReplies(ctx context.Context, in *postspb.GetCommentRepliesRequest) (res *postspb.GetCommentRepliesResponse, err error) {
+	commentID := in.GetCommentId()
+	lastReplyID := in.GetLastReplyId()
+	repliesAmount := in.GetRepliesAmount()
+
+	replies, err := p.PostsService.GetCommentReplies(ctx, uint(commentID), uint(lastReplyID), uint(repliesAmount))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.GetCommentRepliesResponse{
+		Replies: postspb.ToCommentsResponse(replies),
+	}
+
+	return
+}
+
 func (p *PostManager) CreateComment(ctx context.Context, in *postspb.CreateCommentRequest) (res *postspb.CreateCommentResponse, err error) {
 	postID := in.GetPostId()
 	authorID := in.GetAuthorId()
+	parentID := in.GetParentId()
 	content := in.GetContent()
 
 	comment, err := p.PostsService.CreateComment(ctx, &domain.Comment{
 		PostID:   uint(postID),
 		AuthorID: uint(authorID),
+		ParentID: uint(parentID),
 		Content:  content,
 	})
 	if err != nil {
@@ -509,3 +588,100 @@ func (p *PostManager) UnlikeComment(ctx context.Context, in *postspb.UnlikeComme
 
 	return
 }
+
+func (p *PostManager) Search(ctx context.Context, in *postspb.SearchRequest) (res *postspb.SearchResponse, err error) {
+	input := posts.SearchInput{
+		UserID:     uint(in.GetUserId()),
+		UserSubIDs: utils.Uint64ToUintSlice(in.GetUserSubscriptionIds()),
+		Query:      in.GetQuery(),
+		Cursor:     in.GetCursor(),
+		Amount:     uint(in.GetAmount()),
+	}
+
+	var postHits []posts.PostSearchHit
+	var commentHits []posts.CommentSearchHit
+	var nextCursor string
+	var total uint
+
+	switch in.GetTarget() {
+	case posts.SearchPostsTarget, "":
+		postHits, nextCursor, total, err = p.PostsService.SearchPosts(ctx, input)
+	case posts.SearchCommentsTarget:
+		commentHits, nextCursor, total, err = p.PostsService.SearchComments(ctx, input)
+	default:
+		err = errors.ErrInvalidData
+	}
+
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.SearchResponse{
+		Posts:      postspb.ToPostSearchResultsResponse(postHits),
+		Comments:   postspb.ToCommentSearchResultsResponse(commentHits),
+		NextCursor: nextCursor,
+		Total:      uint64(total),
+	}
+
+	return
+}
+
+func (p *PostManager) GetPostsByHashtag(ctx context.Context, in *postspb.GetPostsByHashtagRequest) (res *postspb.GetPostsByHashtagResponse, err error) {
+	hashtag := in.GetHashtag()
+	lastPostID := in.GetLastPostId()
+	postsAmount := in.GetPostsAmount()
+	viewerID := in.GetViewerId()
+
+	posts, err := p.PostsService.GetPostsByHashtag(ctx, uint(viewerID), hashtag, uint(lastPostID), uint(postsAmount))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.GetPostsByHashtagResponse{
+		Posts: postspb.ToPostsResponse(posts),
+	}
+
+	return
+}
+
+func (p *PostManager) GetTrendingHashtags(ctx context.Context, in *postspb.GetTrendingHashtagsRequest) (res *postspb.GetTrendingHashtagsResponse, err error) {
+	window := time.Duration(in.GetWindowSeconds()) * time.Second
+	amount := in.GetAmount()
+
+	hashtags, err := p.PostsService.GetTrendingHashtags(ctx, window, uint(amount))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.GetTrendingHashtagsResponse{
+		Hashtags: postspb.ToTrendingHashtagsResponse(hashtags),
+	}
+
+	return
+}
+
+func (p *PostManager) SearchHashtags(ctx context.Context, in *postspb.SearchHashtagsRequest) (res *postspb.SearchHashtagsResponse, err error) {
+	query := in.GetQuery()
+	lastHashtag := in.GetLastHashtag()
+	amount := in.GetAmount()
+
+	hashtags, total, err := p.PostsService.SearchHashtags(ctx, query, lastHashtag, uint(amount))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &postspb.SearchHashtagsResponse{
+		Hashtags: postspb.ToTrendingHashtagsResponse(hashtags),
+		Total:    uint64(total),
+	}
+
+	return
+}
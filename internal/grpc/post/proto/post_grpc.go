@@ -3,22 +3,35 @@ package post
 import (
 	"socio/domain"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"socio/usecase/posts"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ToPostResponse(post *domain.Post) *PostResponse {
-	return &PostResponse{
-		Id:          uint64(post.ID),
-		AuthorId:    uint64(post.AuthorID),
-		GroupId:     uint64(post.GroupID),
-		Content:     post.Content,
-		Attachments: post.Attachments,
-		LikedByIds:  post.LikedByIDs,
-		CreatedAt:   timestamppb.New(post.CreatedAt.Time),
-		UpdatedAt:   timestamppb.New(post.UpdatedAt.Time),
+	res := &PostResponse{
+		Id:                  uint64(post.ID),
+		AuthorId:            uint64(post.AuthorID),
+		GroupId:             uint64(post.GroupID),
+		Content:             post.Content,
+		Attachments:         post.Attachments,
+		LikedByIds:          post.LikedByIDs,
+		SharesCount:         uint64(post.SharesCount),
+		IsRepost:            post.IsRepost,
+		RepostOfId:          uint64(post.RepostOfID),
+		OriginalUnavailable: post.OriginalUnavailable,
+		AttachmentsInfo:     ToAttachmentsResponse(post.AttachmentsInfo),
+		Entities:            ToEntitiesResponse(post.Entities),
+		CreatedAt:           timestamppb.New(post.CreatedAt.Time),
+		UpdatedAt:           timestamppb.New(post.UpdatedAt.Time),
 	}
+
+	if post.Original != nil {
+		res.Original = ToPostResponse(post.Original)
+	}
+
+	return res
 }
 
 func ToPostsResponse(posts []*domain.Post) (res []*PostResponse) {
@@ -41,13 +54,19 @@ func ToPostLikeResponse(like *domain.PostLike) *PostLikeResponse {
 }
 
 func ToPost(res *PostResponse) *domain.Post {
-	return &domain.Post{
-		ID:          uint(res.Id),
-		AuthorID:    uint(res.AuthorId),
-		GroupID:     uint(res.GroupId),
-		Content:     res.Content,
-		Attachments: res.Attachments,
-		LikedByIDs:  res.LikedByIds,
+	post := &domain.Post{
+		ID:                  uint(res.Id),
+		AuthorID:            uint(res.AuthorId),
+		GroupID:             uint(res.GroupId),
+		Content:             res.Content,
+		Attachments:         res.Attachments,
+		LikedByIDs:          res.LikedByIds,
+		SharesCount:         uint(res.SharesCount),
+		IsRepost:            res.IsRepost,
+		RepostOfID:          uint(res.RepostOfId),
+		OriginalUnavailable: res.OriginalUnavailable,
+		AttachmentsInfo:     ToAttachments(res.AttachmentsInfo),
+		Entities:            ToEntities(res.Entities),
 		CreatedAt: customtime.CustomTime{
 			Time: res.CreatedAt.AsTime(),
 		},
@@ -55,6 +74,12 @@ func ToPost(res *PostResponse) *domain.Post {
 			Time: res.UpdatedAt.AsTime(),
 		},
 	}
+
+	if res.Original != nil {
+		post.Original = ToPost(res.Original)
+	}
+
+	return post
 }
 
 func ToPosts(res []*PostResponse) (posts []*domain.Post) {
@@ -67,6 +92,108 @@ func ToPosts(res []*PostResponse) (posts []*domain.Post) {
 	return
 }
 
+func ToAttachmentResponse(attachment *domain.Attachment) *Attachment {
+	return &Attachment{
+		FileName:     attachment.FileName,
+		Kind:         string(attachment.Kind),
+		MimeType:     attachment.MimeType,
+		Size:         attachment.Size,
+		Width:        uint32(attachment.Width),
+		Height:       uint32(attachment.Height),
+		Duration:     uint32(attachment.Duration),
+		OriginalName: attachment.OriginalName,
+		Checksum:     attachment.Checksum,
+		CreatedAt:    timestamppb.New(attachment.CreatedAt.Time),
+	}
+}
+
+func ToAttachmentsResponse(attachments []*domain.Attachment) (res []*Attachment) {
+	for _, attachment := range attachments {
+		res = append(res, ToAttachmentResponse(attachment))
+	}
+
+	return
+}
+
+func ToAttachment(res *Attachment) *domain.Attachment {
+	return &domain.Attachment{
+		FileName:     res.FileName,
+		Kind:         domain.AttachmentKind(res.Kind),
+		MimeType:     res.MimeType,
+		Size:         res.Size,
+		Width:        uint(res.Width),
+		Height:       uint(res.Height),
+		Duration:     uint(res.Duration),
+		OriginalName: res.OriginalName,
+		Checksum:     res.Checksum,
+		CreatedAt: customtime.CustomTime{
+			Time: res.CreatedAt.AsTime(),
+		},
+	}
+}
+
+func ToAttachments(res []*Attachment) (attachments []*domain.Attachment) {
+	for _, attachment := range res {
+		attachments = append(attachments, ToAttachment(attachment))
+	}
+
+	return
+}
+
+func ToEntitiesResponse(entities []*domain.Entity) (res []*Entity) {
+	for _, entity := range entities {
+		res = append(res, &Entity{
+			Type:    string(entity.Type),
+			Offset:  uint32(entity.Offset),
+			Length:  uint32(entity.Length),
+			Hashtag: entity.Hashtag,
+			UserId:  uint64(entity.UserID),
+		})
+	}
+
+	return
+}
+
+func ToEntities(res []*Entity) (entities []*domain.Entity) {
+	for _, entity := range res {
+		entities = append(entities, &domain.Entity{
+			Type:    domain.EntityType(entity.Type),
+			Offset:  uint(entity.Offset),
+			Length:  uint(entity.Length),
+			Hashtag: entity.Hashtag,
+			UserID:  uint(entity.UserId),
+		})
+	}
+
+	return
+}
+
+func ToTrendingHashtagsResponse(hashtags []posts.TrendingHashtag) (res []*TrendingHashtag) {
+	res = make([]*TrendingHashtag, 0, len(hashtags))
+
+	for _, hashtag := range hashtags {
+		res = append(res, &TrendingHashtag{
+			Hashtag: hashtag.Hashtag,
+			Count:   uint64(hashtag.Count),
+		})
+	}
+
+	return
+}
+
+func ToTrendingHashtags(res []*TrendingHashtag) (hashtags []posts.TrendingHashtag) {
+	hashtags = make([]posts.TrendingHashtag, 0, len(res))
+
+	for _, hashtag := range res {
+		hashtags = append(hashtags, posts.TrendingHashtag{
+			Hashtag: hashtag.Hashtag,
+			Count:   uint(hashtag.Count),
+		})
+	}
+
+	return
+}
+
 func ToPostLike(res *PostLikeResponse) *domain.PostLike {
 	return &domain.PostLike{
 		ID:     uint(res.Id),
@@ -114,13 +241,18 @@ func ToCommentResponse(comment *domain.Comment) (res *CommentResponse) {
 	}
 
 	return &CommentResponse{
-		Id:         uint64(comment.ID),
-		AuthorId:   uint64(comment.AuthorID),
-		PostId:     uint64(comment.PostID),
-		Content:    comment.Content,
-		CreatedAt:  timestamppb.New(comment.CreatedAt.Time),
-		UpdatedAt:  timestamppb.New(comment.UpdatedAt.Time),
-		LikedByIds: comment.LikedByIDs,
+		Id:           uint64(comment.ID),
+		AuthorId:     uint64(comment.AuthorID),
+		PostId:       uint64(comment.PostID),
+		ParentId:     uint64(comment.ParentID),
+		Depth:        uint64(comment.Depth),
+		RepliesCount: uint64(comment.RepliesCount),
+		Replies:      ToCommentsResponse(comment.Replies),
+		Content:      comment.Content,
+		CreatedAt:    timestamppb.New(comment.CreatedAt.Time),
+		UpdatedAt:    timestamppb.New(comment.UpdatedAt.Time),
+		LikedByIds:   comment.LikedByIDs,
+		Entities:     ToEntitiesResponse(comment.Entities),
 	}
 }
 
@@ -134,6 +266,32 @@ func ToCommentsResponse(comments []*domain.Comment) (res []*CommentResponse) {
 	return
 }
 
+func ToPostSearchResultsResponse(hits []posts.PostSearchHit) (res []*PostSearchResult) {
+	res = make([]*PostSearchResult, 0, len(hits))
+
+	for _, hit := range hits {
+		res = append(res, &PostSearchResult{
+			Post:    ToPostResponse(hit.Post),
+			Snippet: hit.Snippet,
+		})
+	}
+
+	return
+}
+
+func ToCommentSearchResultsResponse(hits []posts.CommentSearchHit) (res []*CommentSearchResult) {
+	res = make([]*CommentSearchResult, 0, len(hits))
+
+	for _, hit := range hits {
+		res = append(res, &CommentSearchResult{
+			Comment: ToCommentResponse(hit.Comment),
+			Snippet: hit.Snippet,
+		})
+	}
+
+	return
+}
+
 func ToCommentLikeResponse(like *domain.CommentLike) (res *CommentLikeResponse) {
 	if like == nil {
 		return nil
@@ -152,15 +310,25 @@ func ToComment(res *CommentResponse) *domain.Comment {
 		return nil
 	}
 
-	return &domain.Comment{
-		ID:         uint(res.Id),
-		AuthorID:   uint(res.AuthorId),
-		PostID:     uint(res.PostId),
-		Content:    res.Content,
-		CreatedAt:  customtime.CustomTime{Time: res.CreatedAt.AsTime()},
-		UpdatedAt:  customtime.CustomTime{Time: res.UpdatedAt.AsTime()},
-		LikedByIDs: res.LikedByIds,
+	comment := &domain.Comment{
+		ID:           uint(res.Id),
+		AuthorID:     uint(res.AuthorId),
+		PostID:       uint(res.PostId),
+		ParentID:     uint(res.ParentId),
+		Depth:        uint(res.Depth),
+		RepliesCount: uint(res.RepliesCount),
+		Content:      res.Content,
+		CreatedAt:    customtime.CustomTime{Time: res.CreatedAt.AsTime()},
+		UpdatedAt:    customtime.CustomTime{Time: res.UpdatedAt.AsTime()},
+		LikedByIDs:   res.LikedByIds,
+		Entities:     ToEntities(res.Entities),
+	}
+
+	if len(res.Replies) != 0 {
+		comment.Replies = ToComments(res.Replies)
 	}
+
+	return comment
 }
 
 func ToComments(res []*CommentResponse) (comments []*domain.Comment) {
@@ -217,3 +385,49 @@ func ToGroupPost(res *GroupPostResponse) (groupPost *domain.GroupPost) {
 		},
 	}
 }
+
+func ToUploadResponse(meta *upload.Metadata) (res *UploadResponse) {
+	res = &UploadResponse{
+		FileName:    meta.FileName,
+		Size:        uint64(meta.Size),
+		ContentType: meta.ContentType,
+		Width:       uint32(meta.Width),
+		Height:      uint32(meta.Height),
+		Duration:    uint32(meta.Duration),
+		Checksum:    meta.Checksum,
+	}
+
+	for _, variant := range meta.Variants {
+		res.Variants = append(res.Variants, &UploadVariant{
+			Name:     variant.Name,
+			FileName: variant.FileName,
+			Width:    uint32(variant.Width),
+			Height:   uint32(variant.Height),
+		})
+	}
+
+	return
+}
+
+func ToUploadMetadata(res *UploadResponse) (meta *upload.Metadata) {
+	meta = &upload.Metadata{
+		FileName:    res.FileName,
+		ContentType: res.ContentType,
+		Size:        int64(res.Size),
+		Width:       int(res.Width),
+		Height:      int(res.Height),
+		Duration:    uint(res.Duration),
+		Checksum:    res.Checksum,
+	}
+
+	for _, variant := range res.Variants {
+		meta.Variants = append(meta.Variants, &upload.Variant{
+			Name:     variant.Name,
+			FileName: variant.FileName,
+			Width:    int(variant.Width),
+			Height:   int(variant.Height),
+		})
+	}
+
+	return
+}
@@ -22,6 +22,7 @@ type PostClient interface {
 	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetUserPostsResponse, error)
 	GetUserFriendsPosts(ctx context.Context, in *GetUserFriendsPostsRequest, opts ...grpc.CallOption) (*GetUserFriendsPostsResponse, error)
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
+	RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error)
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
 	GetLikedPosts(ctx context.Context, in *GetLikedPostsRequest, opts ...grpc.CallOption) (*GetLikedPostsResponse, error)
@@ -34,12 +35,18 @@ type PostClient interface {
 	GetGroupPostsBySubscriptionIDs(ctx context.Context, in *GetGroupPostsBySubscriptionIDsRequest, opts ...grpc.CallOption) (*GetGroupPostsBySubscriptionIDsResponse, error)
 	GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, in *GetPostsByGroupSubIDsAndUserSubIDsRequest, opts ...grpc.CallOption) (*GetPostsByGroupSubIDsAndUserSubIDsResponse, error)
 	GetNewPosts(ctx context.Context, in *GetNewPostsRequest, opts ...grpc.CallOption) (*GetNewPostsResponse, error)
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
 	GetCommentsByPostID(ctx context.Context, in *GetCommentsByPostIDRequest, opts ...grpc.CallOption) (*GetCommentsByPostIDResponse, error)
+	GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentRepliesResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 	UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*UnlikeCommentResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
+	SearchHashtags(ctx context.Context, in *SearchHashtagsRequest, opts ...grpc.CallOption) (*SearchHashtagsResponse, error)
 }
 
 type postClient struct {
@@ -86,6 +93,15 @@ func (c *postClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts
 	return out, nil
 }
 
+func (c *postClient) RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error) {
+	out := new(RepostPostResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/RepostPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
 	out := new(UpdatePostResponse)
 	err := c.cc.Invoke(ctx, "/post.Post/UpdatePost", in, out, opts...)
@@ -219,6 +235,15 @@ func (c *postClient) GetNewPosts(ctx context.Context, in *GetNewPostsRequest, op
 	return out, nil
 }
 
+func (c *postClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/GetFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postClient) GetCommentsByPostID(ctx context.Context, in *GetCommentsByPostIDRequest, opts ...grpc.CallOption) (*GetCommentsByPostIDResponse, error) {
 	out := new(GetCommentsByPostIDResponse)
 	err := c.cc.Invoke(ctx, "/post.Post/GetCommentsByPostID", in, out, opts...)
@@ -228,6 +253,15 @@ func (c *postClient) GetCommentsByPostID(ctx context.Context, in *GetCommentsByP
 	return out, nil
 }
 
+func (c *postClient) GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentRepliesResponse, error) {
+	out := new(GetCommentRepliesResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/GetCommentReplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
 	out := new(CreateCommentResponse)
 	err := c.cc.Invoke(ctx, "/post.Post/CreateComment", in, out, opts...)
@@ -273,6 +307,42 @@ func (c *postClient) UnlikeComment(ctx context.Context, in *UnlikeCommentRequest
 	return out, nil
 }
 
+func (c *postClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error) {
+	out := new(GetPostsByHashtagResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/GetPostsByHashtag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error) {
+	out := new(GetTrendingHashtagsResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/GetTrendingHashtags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) SearchHashtags(ctx context.Context, in *SearchHashtagsRequest, opts ...grpc.CallOption) (*SearchHashtagsResponse, error) {
+	out := new(SearchHashtagsResponse)
+	err := c.cc.Invoke(ctx, "/post.Post/SearchHashtags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServer is the server API for Post service.
 // All implementations must embed UnimplementedPostServer
 // for forward compatibility
@@ -281,6 +351,7 @@ type PostServer interface {
 	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error)
 	GetUserFriendsPosts(context.Context, *GetUserFriendsPostsRequest) (*GetUserFriendsPostsResponse, error)
 	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error)
 	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
 	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
 	GetLikedPosts(context.Context, *GetLikedPostsRequest) (*GetLikedPostsResponse, error)
@@ -293,12 +364,18 @@ type PostServer interface {
 	GetGroupPostsBySubscriptionIDs(context.Context, *GetGroupPostsBySubscriptionIDsRequest) (*GetGroupPostsBySubscriptionIDsResponse, error)
 	GetPostsByGroupSubIDsAndUserSubIDs(context.Context, *GetPostsByGroupSubIDsAndUserSubIDsRequest) (*GetPostsByGroupSubIDsAndUserSubIDsResponse, error)
 	GetNewPosts(context.Context, *GetNewPostsRequest) (*GetNewPostsResponse, error)
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
 	GetCommentsByPostID(context.Context, *GetCommentsByPostIDRequest) (*GetCommentsByPostIDResponse, error)
+	GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentRepliesResponse, error)
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeCommentResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error)
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
+	SearchHashtags(context.Context, *SearchHashtagsRequest) (*SearchHashtagsResponse, error)
 	mustEmbedUnimplementedPostServer()
 }
 
@@ -318,6 +395,9 @@ func (UnimplementedPostServer) GetUserFriendsPosts(context.Context, *GetUserFrie
 func (UnimplementedPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
 }
+func (UnimplementedPostServer) RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepostPost not implemented")
+}
 func (UnimplementedPostServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
 }
@@ -354,9 +434,15 @@ func (UnimplementedPostServer) GetPostsByGroupSubIDsAndUserSubIDs(context.Contex
 func (UnimplementedPostServer) GetNewPosts(context.Context, *GetNewPostsRequest) (*GetNewPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNewPosts not implemented")
 }
+func (UnimplementedPostServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
 func (UnimplementedPostServer) GetCommentsByPostID(context.Context, *GetCommentsByPostIDRequest) (*GetCommentsByPostIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByPostID not implemented")
 }
+func (UnimplementedPostServer) GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentReplies not implemented")
+}
 func (UnimplementedPostServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
@@ -372,6 +458,18 @@ func (UnimplementedPostServer) LikeComment(context.Context, *LikeCommentRequest)
 func (UnimplementedPostServer) UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlikeComment not implemented")
 }
+func (UnimplementedPostServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedPostServer) GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByHashtag not implemented")
+}
+func (UnimplementedPostServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedPostServer) SearchHashtags(context.Context, *SearchHashtagsRequest) (*SearchHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHashtags not implemented")
+}
 func (UnimplementedPostServer) mustEmbedUnimplementedPostServer() {}
 
 // UnsafePostServer may be embedded to opt out of forward compatibility for this service.
@@ -457,6 +555,24 @@ func _Post_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Post_RepostPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).RepostPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/RepostPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).RepostPost(ctx, req.(*RepostPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Post_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePostRequest)
 	if err := dec(in); err != nil {
@@ -681,6 +797,24 @@ func _Post_GetNewPosts_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Post_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/GetFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Post_GetCommentsByPostID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentsByPostIDRequest)
 	if err := dec(in); err != nil {
@@ -699,6 +833,24 @@ func _Post_GetCommentsByPostID_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Post_GetCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).GetCommentReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/GetCommentReplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).GetCommentReplies(ctx, req.(*GetCommentRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Post_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
@@ -789,6 +941,78 @@ func _Post_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Post_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_GetPostsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByHashtagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).GetPostsByHashtag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/GetPostsByHashtag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).GetPostsByHashtag(ctx, req.(*GetPostsByHashtagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).GetTrendingHashtags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/GetTrendingHashtags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_SearchHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHashtagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).SearchHashtags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.Post/SearchHashtags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).SearchHashtags(ctx, req.(*SearchHashtagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Post_ServiceDesc is the grpc.ServiceDesc for Post service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -812,6 +1036,10 @@ var Post_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePost",
 			Handler:    _Post_CreatePost_Handler,
 		},
+		{
+			MethodName: "RepostPost",
+			Handler:    _Post_RepostPost_Handler,
+		},
 		{
 			MethodName: "UpdatePost",
 			Handler:    _Post_UpdatePost_Handler,
@@ -856,10 +1084,18 @@ var Post_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNewPosts",
 			Handler:    _Post_GetNewPosts_Handler,
 		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _Post_GetFeed_Handler,
+		},
 		{
 			MethodName: "GetCommentsByPostID",
 			Handler:    _Post_GetCommentsByPostID_Handler,
 		},
+		{
+			MethodName: "GetCommentReplies",
+			Handler:    _Post_GetCommentReplies_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _Post_CreateComment_Handler,
@@ -880,6 +1116,22 @@ var Post_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlikeComment",
 			Handler:    _Post_UnlikeComment_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Post_Search_Handler,
+		},
+		{
+			MethodName: "GetPostsByHashtag",
+			Handler:    _Post_GetPostsByHashtag_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _Post_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "SearchHashtags",
+			Handler:    _Post_SearchHashtags_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
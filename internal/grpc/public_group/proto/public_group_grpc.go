@@ -3,6 +3,7 @@ package publicgroup
 import (
 	"socio/domain"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	publicgroup "socio/usecase/public_group"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -105,3 +106,45 @@ func ToSubscription(subscription *SubscriptionResponse) *domain.PublicGroupSubsc
 		},
 	}
 }
+
+func ToUploadResponse(meta *upload.Metadata) (res *UploadResponse) {
+	res = &UploadResponse{
+		FileName:    meta.FileName,
+		Size:        uint64(meta.Size),
+		ContentType: meta.ContentType,
+		Width:       uint32(meta.Width),
+		Height:      uint32(meta.Height),
+	}
+
+	for _, variant := range meta.Variants {
+		res.Variants = append(res.Variants, &UploadVariant{
+			Name:     variant.Name,
+			FileName: variant.FileName,
+			Width:    uint32(variant.Width),
+			Height:   uint32(variant.Height),
+		})
+	}
+
+	return
+}
+
+func ToUploadMetadata(res *UploadResponse) (meta *upload.Metadata) {
+	meta = &upload.Metadata{
+		FileName:    res.FileName,
+		ContentType: res.ContentType,
+		Size:        int64(res.Size),
+		Width:       int(res.Width),
+		Height:      int(res.Height),
+	}
+
+	for _, variant := range res.Variants {
+		meta.Variants = append(meta.Variants, &upload.Variant{
+			Name:     variant.Name,
+			FileName: variant.FileName,
+			Width:    int(variant.Width),
+			Height:   int(variant.Height),
+		})
+	}
+
+	return
+}
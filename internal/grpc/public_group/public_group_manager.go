@@ -9,6 +9,7 @@ import (
 	"socio/domain"
 	"socio/errors"
 	pgpb "socio/internal/grpc/public_group/proto"
+	"socio/pkg/upload"
 	"socio/pkg/utils"
 	publicgroup "socio/usecase/public_group"
 
@@ -52,8 +53,10 @@ func (p *PublicGroupManager) GetByID(ctx context.Context, in *pgpb.GetByIDReques
 func (p *PublicGroupManager) SearchByName(ctx context.Context, in *pgpb.SearchByNameRequest) (res *pgpb.SearchByNameResponse, err error) {
 	query := in.GetQuery()
 	userID := in.GetUserId()
+	lastGroupID := in.GetLastGroupId()
+	amount := in.GetAmount()
 
-	groups, err := p.PublicGroupService.SearchByName(ctx, query, uint(userID))
+	groups, total, err := p.PublicGroupService.SearchByName(ctx, query, uint(userID), uint(lastGroupID), uint(amount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -62,6 +65,7 @@ func (p *PublicGroupManager) SearchByName(ctx context.Context, in *pgpb.SearchBy
 
 	res = &pgpb.SearchByNameResponse{
 		PublicGroups: pgpb.ToPublicGroupsWithInfoResponse(groups),
+		Total:        uint64(total),
 	}
 
 	return
@@ -209,13 +213,15 @@ func (p *PublicGroupManager) Upload(stream pgpb.PublicGroup_UploadServer) (err e
 	}
 
 	fileName := ""
-	contentType := ""
 
-	var fileSize uint64
+	var fileSize int64
 	fileSize = 0
 	defer func() {
-		if err = file.Close(); err != nil {
-			fmt.Println(err)
+		if closeErr := file.Close(); closeErr != nil {
+			fmt.Println(closeErr)
+		}
+		if removeErr := os.Remove(file.Name()); removeErr != nil {
+			fmt.Println(removeErr)
 		}
 	}()
 	for {
@@ -232,32 +238,27 @@ func (p *PublicGroupManager) Upload(stream pgpb.PublicGroup_UploadServer) (err e
 			return err
 		}
 		chunk := req.GetChunk()
-		fileSize += uint64(len(chunk))
+		fileSize += int64(len(chunk))
+		if err = upload.CheckSize(upload.GroupAvatarKind, fileSize); err != nil {
+			customErr := errors.NewCustomError(err)
+			err = customErr.GRPCStatus().Err()
+			return err
+		}
 		if _, err = file.Write(chunk); err != nil {
 			customErr := errors.NewCustomError(err)
 			err = customErr.GRPCStatus().Err()
 			return err
 		}
-		contentType = req.GetContentType()
 	}
 
-	err = p.PublicGroupService.UploadAvatar(fileName, file.Name(), contentType)
+	meta, err := p.PublicGroupService.UploadAvatar(fileName, file.Name())
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
 		return
 	}
 
-	if err = os.Remove(file.Name()); err != nil {
-		customErr := errors.NewCustomError(err)
-		err = customErr.GRPCStatus().Err()
-		return
-	}
-
-	return stream.SendAndClose(&pgpb.UploadResponse{
-		FileName: fileName,
-		Size:     fileSize,
-	})
+	return stream.SendAndClose(pgpb.ToUploadResponse(meta))
 }
 
 func (p *PublicGroupManager) GetSubscriptionIDs(ctx context.Context, in *pgpb.GetSubscriptionIDsRequest) (res *pgpb.GetSubscriptionIDsResponse, err error) {
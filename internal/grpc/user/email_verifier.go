@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+	uspb "socio/internal/grpc/user/proto"
+)
+
+// EmailVerifier exposes the email verification of the user service to the
+// usecases of the other services.
+type EmailVerifier struct {
+	UserClient uspb.UserClient
+}
+
+func NewEmailVerifier(userClient uspb.UserClient) *EmailVerifier {
+	return &EmailVerifier{
+		UserClient: userClient,
+	}
+}
+
+func (v *EmailVerifier) CheckIfEmailIsVerified(ctx context.Context, userID uint) (isVerified bool, err error) {
+	res, err := v.UserClient.CheckIfEmailIsVerified(ctx, &uspb.CheckIfEmailIsVerifiedRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		return
+	}
+
+	isVerified = res.GetIsVerified()
+
+	return
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"socio/domain"
+	customtime "socio/pkg/time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToUserBlockResponse(block *domain.Block) *UserBlockResponse {
+	return &UserBlockResponse{
+		Id:        uint64(block.ID),
+		BlockerId: uint64(block.BlockerID),
+		BlockedId: uint64(block.BlockedID),
+		CreatedAt: timestamppb.New(block.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(block.UpdatedAt.Time),
+	}
+}
+
+func ToBlock(block *UserBlockResponse) *domain.Block {
+	return &domain.Block{
+		ID:        uint(block.Id),
+		BlockerID: uint(block.BlockerId),
+		BlockedID: uint(block.BlockedId),
+		CreatedAt: customtime.CustomTime{
+			Time: block.CreatedAt.AsTime(),
+		},
+		UpdatedAt: customtime.CustomTime{
+			Time: block.UpdatedAt.AsTime(),
+		},
+	}
+}
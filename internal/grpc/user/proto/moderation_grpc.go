@@ -0,0 +1,34 @@
+package user
+
+import (
+	"socio/domain"
+	customtime "socio/pkg/time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToUserBanResponse(ban *domain.UserBan) *UserBanResponse {
+	return &UserBanResponse{
+		Id:          uint64(ban.ID),
+		UserId:      uint64(ban.UserID),
+		ModeratorId: uint64(ban.ModeratorID),
+		Reason:      ban.Reason,
+		CreatedAt:   timestamppb.New(ban.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(ban.UpdatedAt.Time),
+	}
+}
+
+func ToUserBan(ban *UserBanResponse) *domain.UserBan {
+	return &domain.UserBan{
+		ID:          uint(ban.Id),
+		UserID:      uint(ban.UserId),
+		ModeratorID: uint(ban.ModeratorId),
+		Reason:      ban.Reason,
+		CreatedAt: customtime.CustomTime{
+			Time: ban.CreatedAt.AsTime(),
+		},
+		UpdatedAt: customtime.CustomTime{
+			Time: ban.UpdatedAt.AsTime(),
+		},
+	}
+}
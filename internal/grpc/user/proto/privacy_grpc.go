@@ -0,0 +1,23 @@
+package user
+
+import "socio/domain"
+
+func ToPrivacySettingsResponse(settings *domain.PrivacySettings) *PrivacySettingsResponse {
+	return &PrivacySettingsResponse{
+		UserId:        uint64(settings.UserID),
+		Posts:         string(settings.Posts),
+		Messages:      string(settings.Messages),
+		Subscriptions: string(settings.Subscriptions),
+		DateOfBirth:   string(settings.DateOfBirth),
+	}
+}
+
+func ToPrivacySettings(settings *PrivacySettingsResponse) *domain.PrivacySettings {
+	return &domain.PrivacySettings{
+		UserID:        uint(settings.GetUserId()),
+		Posts:         domain.PrivacyLevel(settings.GetPosts()),
+		Messages:      domain.PrivacyLevel(settings.GetMessages()),
+		Subscriptions: domain.PrivacyLevel(settings.GetSubscriptions()),
+		DateOfBirth:   domain.PrivacyLevel(settings.GetDateOfBirth()),
+	}
+}
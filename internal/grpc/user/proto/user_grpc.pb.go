@@ -36,6 +36,17 @@ type UserClient interface {
 	DeletePublicGroupAdmin(ctx context.Context, in *DeletePublicGroupAdminRequest, opts ...grpc.CallOption) (*DeletePublicGroupAdminResponse, error)
 	GetAdminsByPublicGroupID(ctx context.Context, in *GetAdminsByPublicGroupIDRequest, opts ...grpc.CallOption) (*GetAdminsByPublicGroupIDResponse, error)
 	CheckIfUserIsAdmin(ctx context.Context, in *CheckIfUserIsAdminRequest, opts ...grpc.CallOption) (*CheckIfUserIsAdminResponse, error)
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error)
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error)
+	GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error)
+	CheckIfUserIsModerator(ctx context.Context, in *CheckIfUserIsModeratorRequest, opts ...grpc.CallOption) (*CheckIfUserIsModeratorResponse, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserResponse, error)
+	CheckIfUserIsBanned(ctx context.Context, in *CheckIfUserIsBannedRequest, opts ...grpc.CallOption) (*CheckIfUserIsBannedResponse, error)
+	RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	CheckIfEmailIsVerified(ctx context.Context, in *CheckIfEmailIsVerifiedRequest, opts ...grpc.CallOption) (*CheckIfEmailIsVerifiedResponse, error)
 }
 
 type userClient struct {
@@ -233,6 +244,105 @@ func (c *userClient) CheckIfUserIsAdmin(ctx context.Context, in *CheckIfUserIsAd
 	return out, nil
 }
 
+func (c *userClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error) {
+	out := new(GetPrivacySettingsResponse)
+	err := c.cc.Invoke(ctx, "/user.User/GetPrivacySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error) {
+	out := new(UpdatePrivacySettingsResponse)
+	err := c.cc.Invoke(ctx, "/user.User/UpdatePrivacySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/user.User/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error) {
+	out := new(UnblockResponse)
+	err := c.cc.Invoke(ctx, "/user.User/Unblock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error) {
+	out := new(GetBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, "/user.User/GetBlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckIfUserIsModerator(ctx context.Context, in *CheckIfUserIsModeratorRequest, opts ...grpc.CallOption) (*CheckIfUserIsModeratorResponse, error) {
+	out := new(CheckIfUserIsModeratorResponse)
+	err := c.cc.Invoke(ctx, "/user.User/CheckIfUserIsModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserResponse, error) {
+	out := new(BanUserResponse)
+	err := c.cc.Invoke(ctx, "/user.User/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckIfUserIsBanned(ctx context.Context, in *CheckIfUserIsBannedRequest, opts ...grpc.CallOption) (*CheckIfUserIsBannedResponse, error) {
+	out := new(CheckIfUserIsBannedResponse)
+	err := c.cc.Invoke(ctx, "/user.User/CheckIfUserIsBanned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error) {
+	out := new(RequestEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, "/user.User/RequestEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, "/user.User/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckIfEmailIsVerified(ctx context.Context, in *CheckIfEmailIsVerifiedRequest, opts ...grpc.CallOption) (*CheckIfEmailIsVerifiedResponse, error) {
+	out := new(CheckIfEmailIsVerifiedResponse)
+	err := c.cc.Invoke(ctx, "/user.User/CheckIfEmailIsVerified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
@@ -255,6 +365,17 @@ type UserServer interface {
 	DeletePublicGroupAdmin(context.Context, *DeletePublicGroupAdminRequest) (*DeletePublicGroupAdminResponse, error)
 	GetAdminsByPublicGroupID(context.Context, *GetAdminsByPublicGroupIDRequest) (*GetAdminsByPublicGroupIDResponse, error)
 	CheckIfUserIsAdmin(context.Context, *CheckIfUserIsAdminRequest) (*CheckIfUserIsAdminResponse, error)
+	GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*GetPrivacySettingsResponse, error)
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsResponse, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error)
+	GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error)
+	CheckIfUserIsModerator(context.Context, *CheckIfUserIsModeratorRequest) (*CheckIfUserIsModeratorResponse, error)
+	BanUser(context.Context, *BanUserRequest) (*BanUserResponse, error)
+	CheckIfUserIsBanned(context.Context, *CheckIfUserIsBannedRequest) (*CheckIfUserIsBannedResponse, error)
+	RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	CheckIfEmailIsVerified(context.Context, *CheckIfEmailIsVerifiedRequest) (*CheckIfEmailIsVerifiedResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -316,6 +437,39 @@ func (UnimplementedUserServer) GetAdminsByPublicGroupID(context.Context, *GetAdm
 func (UnimplementedUserServer) CheckIfUserIsAdmin(context.Context, *CheckIfUserIsAdminRequest) (*CheckIfUserIsAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckIfUserIsAdmin not implemented")
 }
+func (UnimplementedUserServer) GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*GetPrivacySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedUserServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedUserServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedUserServer) Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (UnimplementedUserServer) GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
+}
+func (UnimplementedUserServer) CheckIfUserIsModerator(context.Context, *CheckIfUserIsModeratorRequest) (*CheckIfUserIsModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIfUserIsModerator not implemented")
+}
+func (UnimplementedUserServer) BanUser(context.Context, *BanUserRequest) (*BanUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedUserServer) CheckIfUserIsBanned(context.Context, *CheckIfUserIsBannedRequest) (*CheckIfUserIsBannedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIfUserIsBanned not implemented")
+}
+func (UnimplementedUserServer) RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (UnimplementedUserServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserServer) CheckIfEmailIsVerified(context.Context, *CheckIfEmailIsVerifiedRequest) (*CheckIfEmailIsVerifiedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIfEmailIsVerified not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -661,6 +815,204 @@ func _User_CheckIfUserIsAdmin_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetPrivacySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/UpdatePrivacySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Unblock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Unblock(ctx, req.(*UnblockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetBlockedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetBlockedUsers(ctx, req.(*GetBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckIfUserIsModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIfUserIsModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckIfUserIsModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/CheckIfUserIsModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckIfUserIsModerator(ctx, req.(*CheckIfUserIsModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckIfUserIsBanned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIfUserIsBannedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckIfUserIsBanned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/CheckIfUserIsBanned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckIfUserIsBanned(ctx, req.(*CheckIfUserIsBannedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/RequestEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RequestEmailVerification(ctx, req.(*RequestEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckIfEmailIsVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIfEmailIsVerifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckIfEmailIsVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/CheckIfEmailIsVerified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckIfEmailIsVerified(ctx, req.(*CheckIfEmailIsVerifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -736,6 +1088,50 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckIfUserIsAdmin",
 			Handler:    _User_CheckIfUserIsAdmin_Handler,
 		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _User_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _User_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _User_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _User_Unblock_Handler,
+		},
+		{
+			MethodName: "GetBlockedUsers",
+			Handler:    _User_GetBlockedUsers_Handler,
+		},
+		{
+			MethodName: "CheckIfUserIsModerator",
+			Handler:    _User_CheckIfUserIsModerator_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _User_BanUser_Handler,
+		},
+		{
+			MethodName: "CheckIfUserIsBanned",
+			Handler:    _User_CheckIfUserIsBanned_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _User_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _User_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "CheckIfEmailIsVerified",
+			Handler:    _User_CheckIfEmailIsVerified_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -9,6 +9,7 @@ import (
 	"socio/domain"
 	"socio/errors"
 	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/upload"
 	"socio/pkg/utils"
 	"socio/usecase/subscriptions"
 	"socio/usecase/user"
@@ -27,17 +28,18 @@ type UserManager struct {
 	SubscriptionsService *subscriptions.Service
 }
 
-func NewUserManager(userStorage user.UserStorage, subscriptionsStorage subscriptions.SubscriptionsStorage, avatarStorage user.AvatarStorage) *UserManager {
+func NewUserManager(userStorage user.UserStorage, subscriptionsStorage subscriptions.SubscriptionsStorage, avatarStorage user.AvatarStorage, emailOutbox user.EmailOutbox) *UserManager {
 	return &UserManager{
-		UserService:          user.NewUserService(userStorage, avatarStorage),
+		UserService:          user.NewUserService(userStorage, avatarStorage, emailOutbox),
 		SubscriptionsService: subscriptions.NewService(subscriptionsStorage, userStorage),
 	}
 }
 
 func (u *UserManager) GetByID(ctx context.Context, in *uspb.GetByIDRequest) (res *uspb.GetByIDResponse, err error) {
 	userID := in.GetUserId()
+	viewerID := in.GetViewerId()
 
-	user, err := u.UserService.GetUserByID(ctx, uint(userID))
+	user, err := u.UserService.GetUserByID(ctx, uint(userID), uint(viewerID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -114,13 +116,15 @@ func (u *UserManager) Upload(stream uspb.User_UploadServer) (err error) {
 	}
 
 	fileName := ""
-	contentType := ""
 
-	var fileSize uint64
+	var fileSize int64
 	fileSize = 0
 	defer func() {
-		if err = file.Close(); err != nil {
-			fmt.Println(err)
+		if closeErr := file.Close(); closeErr != nil {
+			fmt.Println(closeErr)
+		}
+		if removeErr := os.Remove(file.Name()); removeErr != nil {
+			fmt.Println(removeErr)
 		}
 	}()
 	for {
@@ -137,32 +141,27 @@ func (u *UserManager) Upload(stream uspb.User_UploadServer) (err error) {
 			return err
 		}
 		chunk := req.GetChunk()
-		fileSize += uint64(len(chunk))
+		fileSize += int64(len(chunk))
+		if err = upload.CheckSize(upload.AvatarKind, fileSize); err != nil {
+			customErr := errors.NewCustomError(err)
+			err = customErr.GRPCStatus().Err()
+			return err
+		}
 		if _, err = file.Write(chunk); err != nil {
 			customErr := errors.NewCustomError(err)
 			err = customErr.GRPCStatus().Err()
 			return err
 		}
-		contentType = req.GetContentType()
 	}
 
-	err = u.UserService.UploadAvatar(fileName, file.Name(), contentType)
+	meta, err := u.UserService.UploadAvatar(fileName, file.Name())
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
 		return
 	}
 
-	if err = os.Remove(file.Name()); err != nil {
-		customErr := errors.NewCustomError(err)
-		err = customErr.GRPCStatus().Err()
-		return
-	}
-
-	return stream.SendAndClose(&uspb.UploadResponse{
-		FileName: fileName,
-		Size:     fileSize,
-	})
+	return stream.SendAndClose(uspb.ToUploadResponse(meta))
 }
 
 func (u *UserManager) Update(ctx context.Context, in *uspb.UpdateRequest) (res *uspb.UpdateResponse, err error) {
@@ -239,8 +238,9 @@ func (u *UserManager) Unsubscribe(ctx context.Context, in *uspb.UnsubscribeReque
 
 func (u *UserManager) GetSubscriptions(ctx context.Context, in *uspb.GetSubscriptionsRequest) (res *uspb.GetSubscriptionsResponse, err error) {
 	userID := in.GetUserId()
+	viewerID := in.GetViewerId()
 
-	subs, err := u.SubscriptionsService.GetSubscriptions(ctx, uint(userID))
+	subs, err := u.SubscriptionsService.GetSubscriptions(ctx, uint(userID), uint(viewerID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -256,8 +256,9 @@ func (u *UserManager) GetSubscriptions(ctx context.Context, in *uspb.GetSubscrip
 
 func (u *UserManager) GetSubscribers(ctx context.Context, in *uspb.GetSubscribersRequest) (res *uspb.GetSubscribersResponse, err error) {
 	userID := in.GetUserId()
+	viewerID := in.GetViewerId()
 
-	subs, err := u.SubscriptionsService.GetSubscribers(ctx, uint(userID))
+	subs, err := u.SubscriptionsService.GetSubscribers(ctx, uint(userID), uint(viewerID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -273,8 +274,9 @@ func (u *UserManager) GetSubscribers(ctx context.Context, in *uspb.GetSubscriber
 
 func (u *UserManager) GetFriends(ctx context.Context, in *uspb.GetFriendsRequest) (res *uspb.GetFriendsResponse, err error) {
 	userID := in.GetUserId()
+	viewerID := in.GetViewerId()
 
-	friends, err := u.SubscriptionsService.GetFriends(ctx, uint(userID))
+	friends, err := u.SubscriptionsService.GetFriends(ctx, uint(userID), uint(viewerID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -289,9 +291,12 @@ func (u *UserManager) GetFriends(ctx context.Context, in *uspb.GetFriendsRequest
 }
 
 func (u *UserManager) SearchByName(ctx context.Context, in *uspb.SearchByNameRequest) (res *uspb.SearchByNameResponse, err error) {
+	viewerID := in.GetViewerId()
 	query := in.GetQuery()
+	lastUserID := in.GetLastUserId()
+	amount := in.GetAmount()
 
-	users, err := u.UserService.SearchByName(ctx, query)
+	users, total, err := u.UserService.SearchByName(ctx, uint(viewerID), query, uint(lastUserID), uint(amount))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -300,6 +305,7 @@ func (u *UserManager) SearchByName(ctx context.Context, in *uspb.SearchByNameReq
 
 	res = &uspb.SearchByNameResponse{
 		Users: uspb.ToUsersResponse(users),
+		Total: uint64(total),
 	}
 
 	return
@@ -362,8 +368,9 @@ func (u *UserManager) DeletePublicGroupAdmin(ctx context.Context, in *uspb.Delet
 
 func (u *UserManager) GetAdminsByPublicGroupID(ctx context.Context, in *uspb.GetAdminsByPublicGroupIDRequest) (res *uspb.GetAdminsByPublicGroupIDResponse, err error) {
 	publicGroupID := in.GetPublicGroupId()
+	viewerID := in.GetViewerId()
 
-	admins, err := u.UserService.GetAdminsByPublicGroupID(ctx, uint(publicGroupID))
+	admins, err := u.UserService.GetAdminsByPublicGroupID(ctx, uint(publicGroupID), uint(viewerID))
 	if err != nil {
 		customErr := errors.NewCustomError(err)
 		err = customErr.GRPCStatus().Err()
@@ -394,3 +401,191 @@ func (u *UserManager) CheckIfUserIsAdmin(ctx context.Context, in *uspb.CheckIfUs
 
 	return
 }
+
+func (u *UserManager) GetPrivacySettings(ctx context.Context, in *uspb.GetPrivacySettingsRequest) (res *uspb.GetPrivacySettingsResponse, err error) {
+	userID := in.GetUserId()
+
+	settings, err := u.UserService.GetPrivacySettings(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.GetPrivacySettingsResponse{
+		Settings: uspb.ToPrivacySettingsResponse(settings),
+	}
+
+	return
+}
+
+func (u *UserManager) UpdatePrivacySettings(ctx context.Context, in *uspb.UpdatePrivacySettingsRequest) (res *uspb.UpdatePrivacySettingsResponse, err error) {
+	settings, err := u.UserService.UpdatePrivacySettings(ctx, uspb.ToPrivacySettings(in.GetSettings()))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.UpdatePrivacySettingsResponse{
+		Settings: uspb.ToPrivacySettingsResponse(settings),
+	}
+
+	return
+}
+
+func (u *UserManager) Block(ctx context.Context, in *uspb.BlockRequest) (res *uspb.BlockResponse, err error) {
+	blockerID := in.GetBlockerId()
+	blockedID := in.GetBlockedId()
+
+	block, err := u.SubscriptionsService.Block(ctx, &domain.Block{
+		BlockerID: uint(blockerID),
+		BlockedID: uint(blockedID),
+	})
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.BlockResponse{
+		Block: uspb.ToUserBlockResponse(block),
+	}
+
+	return
+}
+
+func (u *UserManager) Unblock(ctx context.Context, in *uspb.UnblockRequest) (res *uspb.UnblockResponse, err error) {
+	blockerID := in.GetBlockerId()
+	blockedID := in.GetBlockedId()
+
+	err = u.SubscriptionsService.Unblock(ctx, uint(blockerID), uint(blockedID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.UnblockResponse{}
+
+	return
+}
+
+func (u *UserManager) GetBlockedUsers(ctx context.Context, in *uspb.GetBlockedUsersRequest) (res *uspb.GetBlockedUsersResponse, err error) {
+	userID := in.GetUserId()
+
+	blockedUsers, err := u.SubscriptionsService.GetBlockedUsers(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.GetBlockedUsersResponse{
+		BlockedUsers: uspb.ToSubscriptionsResponse(blockedUsers),
+	}
+
+	return
+}
+
+func (u *UserManager) CheckIfUserIsModerator(ctx context.Context, in *uspb.CheckIfUserIsModeratorRequest) (res *uspb.CheckIfUserIsModeratorResponse, err error) {
+	userID := in.GetUserId()
+
+	isModerator, err := u.UserService.CheckIfUserIsModerator(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.CheckIfUserIsModeratorResponse{
+		IsModerator: isModerator,
+	}
+
+	return
+}
+
+func (u *UserManager) BanUser(ctx context.Context, in *uspb.BanUserRequest) (res *uspb.BanUserResponse, err error) {
+	ban, err := u.UserService.BanUser(ctx, &domain.UserBan{
+		UserID:      uint(in.GetUserId()),
+		ModeratorID: uint(in.GetModeratorId()),
+		Reason:      in.GetReason(),
+	})
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.BanUserResponse{
+		Ban: uspb.ToUserBanResponse(ban),
+	}
+
+	return
+}
+
+func (u *UserManager) CheckIfUserIsBanned(ctx context.Context, in *uspb.CheckIfUserIsBannedRequest) (res *uspb.CheckIfUserIsBannedResponse, err error) {
+	userID := in.GetUserId()
+
+	isBanned, err := u.UserService.CheckIfUserIsBanned(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.CheckIfUserIsBannedResponse{
+		IsBanned: isBanned,
+	}
+
+	return
+}
+
+func (u *UserManager) RequestEmailVerification(ctx context.Context, in *uspb.RequestEmailVerificationRequest) (res *uspb.RequestEmailVerificationResponse, err error) {
+	userID := in.GetUserId()
+
+	err = u.UserService.RequestEmailVerification(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.RequestEmailVerificationResponse{}
+
+	return
+}
+
+func (u *UserManager) VerifyEmail(ctx context.Context, in *uspb.VerifyEmailRequest) (res *uspb.VerifyEmailResponse, err error) {
+	token := in.GetToken()
+
+	userID, err := u.UserService.VerifyEmail(ctx, token)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.VerifyEmailResponse{
+		UserId: uint64(userID),
+	}
+
+	return
+}
+
+func (u *UserManager) CheckIfEmailIsVerified(ctx context.Context, in *uspb.CheckIfEmailIsVerifiedRequest) (res *uspb.CheckIfEmailIsVerifiedResponse, err error) {
+	userID := in.GetUserId()
+
+	isVerified, err := u.UserService.CheckIfEmailIsVerified(ctx, uint(userID))
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		err = customErr.GRPCStatus().Err()
+		return
+	}
+
+	res = &uspb.CheckIfEmailIsVerifiedResponse{
+		IsVerified: isVerified,
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package minio
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go"
+)
+
+// PrivateStorage keeps the objects that are not readable by anonymous users,
+// they are downloaded by the presigned URLs only.
+type PrivateStorage struct {
+	StaticStorage
+	publicURL *url.URL
+}
+
+// NewPrivateStorage creates the bucket without a policy and removes the public
+// read policy from the existing one. The presigned URLs point to publicURL,
+// the proxy behind it must pass the requests to MinIO as they are, since the
+// host and the path are signed. The URLs point to MinIO if publicURL is empty.
+func NewPrivateStorage(minioClient *minio.Client, bucketName string, publicURL string) (storage *PrivateStorage, err error) {
+	bucketExists, err := minioClient.BucketExists(bucketName)
+	if err != nil {
+		return
+	}
+
+	if !bucketExists {
+		err = minioClient.MakeBucket(bucketName, "ru-central1")
+		if err != nil {
+			return
+		}
+	}
+
+	// an empty policy removes the public read policy of the buckets created
+	// by NewStaticStorage
+	err = minioClient.SetBucketPolicy(bucketName, "")
+	if err != nil {
+		return
+	}
+
+	var parsedPublicURL *url.URL
+	if publicURL != "" {
+		parsedPublicURL, err = url.Parse(publicURL)
+		if err != nil {
+			return
+		}
+	}
+
+	storage = &PrivateStorage{
+		StaticStorage: StaticStorage{
+			bucketName:  bucketName,
+			MinioClient: minioClient,
+		},
+		publicURL: parsedPublicURL,
+	}
+	return
+}
+
+// PresignedURL returns the link to download the object until it expires.
+func (p *PrivateStorage) PresignedURL(fileName string, expiry time.Duration) (fileURL string, err error) {
+	presignedURL, err := p.MinioClient.PresignedGetObject(p.bucketName, fileName, expiry, url.Values{})
+	if err != nil {
+		return
+	}
+
+	if p.publicURL != nil {
+		query := presignedURL.RawQuery
+
+		presignedURL = p.publicURL.JoinPath(presignedURL.Path)
+		presignedURL.RawQuery = query
+	}
+
+	fileURL = presignedURL.String()
+	return
+}
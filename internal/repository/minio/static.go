@@ -1,6 +1,8 @@
 package minio
 
 import (
+	"socio/usecase/gc"
+
 	"github.com/minio/minio-go"
 )
 
@@ -74,3 +76,24 @@ func (a *StaticStorage) Delete(fileName string) (err error) {
 
 	return
 }
+
+// ListObjects lists every object of the bucket.
+func (a *StaticStorage) ListObjects() (objects []*gc.Object, err error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	for info := range a.MinioClient.ListObjectsV2(a.bucketName, "", true, doneCh) {
+		if info.Err != nil {
+			err = info.Err
+			return
+		}
+
+		objects = append(objects, &gc.Object{
+			Name:         info.Key,
+			Size:         info.Size,
+			LastModified: info.LastModified,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+const (
+	storeAttachmentQuery = `
+	INSERT INTO public.attachment (
+		file_name,
+		kind,
+		mime_type,
+		size,
+		width,
+		height,
+		duration,
+		original_name,
+		checksum
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING created_at;
+	`
+	getAttachmentsByFileNamesQuery = `
+	SELECT file_name,
+		kind,
+		mime_type,
+		size,
+		width,
+		height,
+		duration,
+		original_name,
+		checksum,
+		created_at
+	FROM public.attachment
+	WHERE file_name = ANY($1::text[]);
+	`
+	getPostAttachmentByChecksumQuery = `
+	SELECT a.file_name,
+		a.kind,
+		a.mime_type,
+		a.size,
+		a.width,
+		a.height,
+		a.duration,
+		a.original_name,
+		a.checksum,
+		a.created_at
+	FROM public.attachment AS a
+	WHERE a.checksum = $1
+		AND EXISTS (
+			SELECT 1
+			FROM public.post_attachment AS pa
+			WHERE pa.file_name = a.file_name
+		)
+	ORDER BY a.id
+	LIMIT 1;
+	`
+	getMessageAttachmentByChecksumQuery = `
+	SELECT a.file_name,
+		a.kind,
+		a.mime_type,
+		a.size,
+		a.width,
+		a.height,
+		a.duration,
+		a.original_name,
+		a.checksum,
+		a.created_at
+	FROM public.attachment AS a
+	WHERE a.checksum = $1
+		AND EXISTS (
+			SELECT 1
+			FROM public.message_attachment AS ma
+			WHERE ma.file_name = a.file_name
+		)
+	ORDER BY a.id
+	LIMIT 1;
+	`
+	countPostAttachmentReferencesQuery = `
+	SELECT COUNT(*)
+	FROM public.post_attachment
+	WHERE file_name = $1;
+	`
+	countMessageAttachmentReferencesQuery = `
+	SELECT COUNT(*)
+	FROM public.message_attachment
+	WHERE file_name = $1;
+	`
+)
+
+func (p *Posts) StoreAttachment(ctx context.Context, attachment *domain.Attachment) (err error) {
+	return storeAttachment(ctx, p.db, attachment)
+}
+
+func (p *Posts) GetAttachmentsByFileNames(ctx context.Context, fileNames []string) (attachments []*domain.Attachment, err error) {
+	return getAttachmentsByFileNames(ctx, p.db, fileNames)
+}
+
+// GetAttachmentByChecksum finds the attachment of a post with the same
+// content, so the identical upload can reuse the stored object.
+func (p *Posts) GetAttachmentByChecksum(ctx context.Context, checksum string) (attachment *domain.Attachment, err error) {
+	return getAttachmentByChecksum(ctx, p.db, getPostAttachmentByChecksumQuery, checksum)
+}
+
+// CountAttachmentReferences counts the posts the file is attached to.
+func (p *Posts) CountAttachmentReferences(ctx context.Context, fileName string) (count uint, err error) {
+	return countAttachmentReferences(ctx, p.db, countPostAttachmentReferencesQuery, fileName)
+}
+
+func (pm *PersonalMessages) StoreAttachment(ctx context.Context, attachment *domain.Attachment) (err error) {
+	return storeAttachment(ctx, pm.db, attachment)
+}
+
+func (pm *PersonalMessages) GetAttachmentsByFileNames(ctx context.Context, fileNames []string) (attachments []*domain.Attachment, err error) {
+	return getAttachmentsByFileNames(ctx, pm.db, fileNames)
+}
+
+// GetAttachmentByChecksum finds the attachment of a sent message with the
+// same content, so the identical upload can reuse the stored object.
+func (pm *PersonalMessages) GetAttachmentByChecksum(ctx context.Context, checksum string) (attachment *domain.Attachment, err error) {
+	return getAttachmentByChecksum(ctx, pm.db, getMessageAttachmentByChecksumQuery, checksum)
+}
+
+// CountAttachmentReferences counts the messages the file is attached to.
+func (pm *PersonalMessages) CountAttachmentReferences(ctx context.Context, fileName string) (count uint, err error) {
+	return countAttachmentReferences(ctx, pm.db, countMessageAttachmentReferencesQuery, fileName)
+}
+
+func storeAttachment(ctx context.Context, db DBPool, attachment *domain.Attachment) (err error) {
+	args := []interface{}{
+		attachment.FileName,
+		attachment.Kind,
+		attachment.MimeType,
+		attachment.Size,
+		attachment.Width,
+		attachment.Height,
+		attachment.Duration,
+		attachment.OriginalName,
+		attachment.Checksum,
+	}
+
+	contextlogger.LogSQL(ctx, storeAttachmentQuery, args...)
+
+	err = db.QueryRow(context.Background(), storeAttachmentQuery, args...).Scan(&attachment.CreatedAt.Time)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// getAttachmentsByFileNames skips the files uploaded before the attachments
+// were described.
+func getAttachmentsByFileNames(ctx context.Context, db DBPool, fileNames []string) (attachments []*domain.Attachment, err error) {
+	if len(fileNames) == 0 {
+		return
+	}
+
+	fileNamesPGArray := pq.Array(fileNames)
+
+	contextlogger.LogSQL(ctx, getAttachmentsByFileNamesQuery, fileNamesPGArray)
+
+	rows, err := db.Query(context.Background(), getAttachmentsByFileNamesQuery, fileNamesPGArray)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	attachments, err = scanAttachments(rows)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// getAttachmentByChecksum only matches the files that are attached to
+// something, the pending uploads may still be deleted by their owners.
+func getAttachmentByChecksum(ctx context.Context, db DBPool, query string, checksum string) (attachment *domain.Attachment, err error) {
+	contextlogger.LogSQL(ctx, query, checksum)
+
+	rows, err := db.Query(context.Background(), query, checksum)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	attachments, err := scanAttachments(rows)
+	if err != nil {
+		return
+	}
+
+	if len(attachments) == 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
+	attachment = attachments[0]
+	return
+}
+
+func countAttachmentReferences(ctx context.Context, db DBPool, query string, fileName string) (count uint, err error) {
+	contextlogger.LogSQL(ctx, query, fileName)
+
+	err = db.QueryRow(context.Background(), query, fileName).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func scanAttachments(rows pgx.Rows) (attachments []*domain.Attachment, err error) {
+	for rows.Next() {
+		attachment := new(domain.Attachment)
+
+		err = rows.Scan(
+			&attachment.FileName,
+			&attachment.Kind,
+			&attachment.MimeType,
+			&attachment.Size,
+			&attachment.Width,
+			&attachment.Height,
+			&attachment.Duration,
+			&attachment.OriginalName,
+			&attachment.Checksum,
+			&attachment.CreatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return
+}
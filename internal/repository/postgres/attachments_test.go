@@ -0,0 +1,298 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	attachmentColumns = []string{"file_name", "kind", "mime_type", "size", "width", "height", "duration", "original_name", "checksum", "created_at"}
+)
+
+func TestStoreAttachment(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.Attachment
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(tp.Now())
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "pic.png", domain.ImageAttachment, "image/png", int64(100), uint(640), uint(480), uint(0), "my pic.png", "abc").Return(row)
+			},
+			expected: &domain.Attachment{
+				FileName:     "pic.png",
+				Kind:         domain.ImageAttachment,
+				MimeType:     "image/png",
+				Size:         100,
+				Width:        640,
+				Height:       480,
+				OriginalName: "my pic.png",
+				Checksum:     "abc",
+				CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrInternalRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			attachment := &domain.Attachment{
+				FileName:     "pic.png",
+				Kind:         domain.ImageAttachment,
+				MimeType:     "image/png",
+				Size:         100,
+				Width:        640,
+				Height:       480,
+				OriginalName: "my pic.png",
+				Checksum:     "abc",
+			}
+
+			err := repo.StoreAttachment(context.Background(), attachment)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, attachment)
+			}
+		})
+	}
+}
+
+func TestGetAttachmentsByFileNames(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name      string
+		fileNames []string
+		mock      func(pool *pgxpoolmock.MockPgxIface)
+		expected  []*domain.Attachment
+		wantErr   bool
+	}{
+		{
+			name:      "Test OK",
+			fileNames: []string{"clip.mp4", "old.png"},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(attachmentColumns).
+					AddRow("clip.mp4", domain.VideoAttachment, "video/mp4", int64(1000), uint(0), uint(0), uint(1500), "clip.mp4", "abc", tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: []*domain.Attachment{
+				{
+					FileName:     "clip.mp4",
+					Kind:         domain.VideoAttachment,
+					MimeType:     "video/mp4",
+					Size:         1000,
+					Duration:     1500,
+					OriginalName: "clip.mp4",
+					Checksum:     "abc",
+					CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Test no file names",
+			fileNames: nil,
+			mock:      func(pool *pgxpoolmock.MockPgxIface) {},
+			expected:  nil,
+			wantErr:   false,
+		},
+		{
+			name:      "Test scan error",
+			fileNames: []string{"clip.mp4"},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Test query error",
+			fileNames: []string{"clip.mp4"},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPersonalMessages(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetAttachmentsByFileNames(context.Background(), tt.fileNames)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAttachmentsByFileNames() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetAttachmentByChecksum(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.Attachment
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(attachmentColumns).
+					AddRow("pic.png", domain.ImageAttachment, "image/png", int64(100), uint(640), uint(480), uint(0), "pic.png", "abc", tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "abc").Return(rows, nil)
+			},
+			expected: &domain.Attachment{
+				FileName:     "pic.png",
+				Kind:         domain.ImageAttachment,
+				MimeType:     "image/png",
+				Size:         100,
+				Width:        640,
+				Height:       480,
+				OriginalName: "pic.png",
+				Checksum:     "abc",
+				CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test not found",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(attachmentColumns).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "abc").Return(rows, nil)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "abc").Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetAttachmentByChecksum(context.Background(), "abc")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCountAttachmentReferences(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected uint
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(2))
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "pic.png").Return(row)
+			},
+			expected: 2,
+			wantErr:  false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "pic.png").Return(ErrInternalRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPersonalMessages(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.CountAttachmentReferences(context.Background(), "pic.png")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CountAttachmentReferences() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	storeBlockQuery = `
+	INSERT INTO public.user_block (blocker_id, blocked_id)
+	VALUES ($1, $2)
+	ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	RETURNING id,
+		blocker_id,
+		blocked_id,
+		created_at,
+		updated_at;
+	`
+	// deleteBlockedSubscriptionsQuery removes the subscriptions of the users to
+	// each other, both ways.
+	deleteBlockedSubscriptionsQuery = `
+	DELETE FROM public.subscription
+	WHERE (subscriber_id = $1 AND subscribed_to_id = $2)
+		OR (subscriber_id = $2 AND subscribed_to_id = $1);
+	`
+	deleteBlockQuery = `
+	DELETE FROM public.user_block
+	WHERE blocker_id = $1
+		AND blocked_id = $2;
+	`
+	getBlockedUsersQuery = `
+	SELECT u.id,
+		u.first_name,
+		u.last_name,
+		u.email,
+		u.avatar,
+		u.date_of_birth,
+		u.created_at,
+		u.updated_at
+	FROM public.user_block AS ub
+		JOIN public.user AS u ON u.id = ub.blocked_id
+	WHERE ub.blocker_id = $1
+	ORDER BY ub.id DESC;
+	`
+	// isBlockedQuery is shared by the repositories of the services that
+	// enforce the blocks on their own.
+	isBlockedQuery = `
+	SELECT public.is_blocked_between($1, $2);
+	`
+)
+
+// StoreBlock stores the block and removes the subscriptions of the users to
+// each other in the same transaction.
+func (s *Subscriptions) StoreBlock(ctx context.Context, block *domain.Block) (newBlock *domain.Block, err error) {
+	tx, err := s.db.BeginTx(context.Background(), pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			return
+		}
+		if err = tx.Rollback(context.Background()); err != nil && err != pgx.ErrTxClosed {
+			return
+		}
+
+		err = nil
+	}()
+
+	contextlogger.LogSQL(ctx, storeBlockQuery, block.BlockerID, block.BlockedID)
+
+	newBlock = new(domain.Block)
+
+	err = tx.QueryRow(context.Background(), storeBlockQuery,
+		block.BlockerID,
+		block.BlockedID,
+	).Scan(
+		&newBlock.ID,
+		&newBlock.BlockerID,
+		&newBlock.BlockedID,
+		&newBlock.CreatedAt.Time,
+		&newBlock.UpdatedAt.Time,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrInvalidBody
+		}
+
+		return
+	}
+
+	contextlogger.LogSQL(ctx, deleteBlockedSubscriptionsQuery, block.BlockerID, block.BlockedID)
+
+	_, err = tx.Exec(context.Background(), deleteBlockedSubscriptionsQuery, block.BlockerID, block.BlockedID)
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Subscriptions) DeleteBlock(ctx context.Context, blockerID, blockedID uint) (err error) {
+	contextlogger.LogSQL(ctx, deleteBlockQuery, blockerID, blockedID)
+
+	result, err := s.db.Exec(context.Background(), deleteBlockQuery, blockerID, blockedID)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() == 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (s *Subscriptions) GetBlockedUsers(ctx context.Context, blockerID uint) (blockedUsers []*domain.User, err error) {
+	contextlogger.LogSQL(ctx, getBlockedUsersQuery, blockerID)
+
+	rows, err := s.db.Query(context.Background(), getBlockedUsersQuery, blockerID)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	blockedUsers, err = s.serializeIntoUsers(rows)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// IsBlocked tells whether one of the users blocked the other.
+func (s *Subscriptions) IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error) {
+	contextlogger.LogSQL(ctx, isBlockedQuery, userID, peerID)
+
+	err = s.db.QueryRow(context.Background(), isBlockedQuery, userID, peerID).Scan(&blocked)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error) {
+	contextlogger.LogSQL(ctx, isBlockedQuery, userID, peerID)
+
+	err = pm.db.QueryRow(context.Background(), isBlockedQuery, userID, peerID).Scan(&blocked)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Posts) IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error) {
+	contextlogger.LogSQL(ctx, isBlockedQuery, userID, peerID)
+
+	err = p.db.QueryRow(context.Background(), isBlockedQuery, userID, peerID).Scan(&blocked)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,254 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+	"time"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreBlock(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.Block
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), tp.Now(), tp.Now())
+				pool.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(pool, nil)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(pgconn.CommandTag("DELETE 2"), nil)
+				pool.EXPECT().Commit(gomock.Any()).Return(nil)
+				pool.EXPECT().Rollback(gomock.Any()).Return(pgx.ErrTxClosed)
+			},
+			expected: &domain.Block{
+				ID:        1,
+				BlockerID: 1,
+				BlockedID: 2,
+				CreatedAt: customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test already blocked",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(pool, nil)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrInvalidBody,
+		},
+		{
+			name: "Test delete subscriptions error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), tp.Now(), tp.Now())
+				pool.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(pool, nil)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+		{
+			name: "Test begin error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewSubscriptions(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.StoreBlock(context.Background(), &domain.Block{BlockerID: 1, BlockedID: 2})
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeleteBlock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(pgconn.CommandTag("DELETE 1"), nil)
+			},
+		},
+		{
+			name: "Test not blocked",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 0"), nil)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name: "Test exec error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewSubscriptions(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.DeleteBlock(context.Background(), 1, 2)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGetBlockedUsers(t *testing.T) {
+	t.Parallel()
+
+	dateOfBirth := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.User
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"id", "first_name", "last_name", "email", "avatar", "date_of_birth", "created_at", "updated_at"}).
+					AddRow(uint(2), "Ivan", "Ivanov", "ivan@example.com", "avatar.png", dateOfBirth, tp.Now(), tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), uint(1)).Return(rows, nil)
+			},
+			expected: []*domain.User{
+				{
+					ID:          2,
+					FirstName:   "Ivan",
+					LastName:    "Ivanov",
+					Email:       "ivan@example.com",
+					Avatar:      "avatar.png",
+					DateOfBirth: customtime.CustomTime{Time: dateOfBirth},
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewSubscriptions(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetBlockedUsers(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetBlockedUsers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsBlocked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Test blocked",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(pgxpoolmock.NewRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewSubscriptions(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			got, err := repo.IsBlocked(context.Background(), 1, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsBlocked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
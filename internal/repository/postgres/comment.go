@@ -9,6 +9,7 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
 )
 
 const (
@@ -16,50 +17,172 @@ const (
 	SELECT c.id,
 		c.post_id,
 		c.author_id,
+		COALESCE(c.parent_id, 0),
+		c.depth,
 		c.content,
 		c.created_at,
 		c.updated_at,
+		(
+			SELECT COUNT(*)
+			FROM public.comment AS r
+			WHERE r.parent_id = c.id
+		) AS replies_count,
 		array_agg(cl.user_id) AS liked_by
 	FROM public.comment AS c
 	LEFT JOIN public.comment_like cl ON cl.comment_id = c.id
-	WHERE post_id = $1
-    GROUP BY c.id, 
-        c.post_id, 
-        c.author_id, 
-        c.content, 
-        c.created_at, 
-        c.updated_at;
+	WHERE c.post_id = $1
+		AND c.parent_id IS NULL
+		AND c.id > $2
+		AND NOT c.is_hidden
+	GROUP BY c.id
+	ORDER BY c.id
+	LIMIT $3;
+	`
+	getCommentsRepliesPreviewQuery = `
+	SELECT c.id,
+		c.post_id,
+		c.author_id,
+		c.parent_id,
+		c.depth,
+		c.content,
+		c.created_at,
+		c.updated_at,
+		(
+			SELECT COUNT(*)
+			FROM public.comment AS r
+			WHERE r.parent_id = c.id
+		) AS replies_count,
+		array_agg(cl.user_id) AS liked_by
+	FROM (
+			SELECT *,
+				ROW_NUMBER() OVER (
+					PARTITION BY parent_id
+					ORDER BY id
+				) AS reply_number
+			FROM public.comment
+			WHERE parent_id = ANY($1::bigint[])
+				AND NOT is_hidden
+		) AS c
+	LEFT JOIN public.comment_like cl ON cl.comment_id = c.id
+	WHERE c.reply_number <= $2
+	GROUP BY c.id,
+		c.post_id,
+		c.author_id,
+		c.parent_id,
+		c.depth,
+		c.content,
+		c.created_at,
+		c.updated_at
+	ORDER BY c.parent_id,
+		c.id;
+	`
+	getCommentRepliesQuery = `
+	SELECT c.id,
+		c.post_id,
+		c.author_id,
+		c.parent_id,
+		c.depth,
+		c.content,
+		c.created_at,
+		c.updated_at,
+		(
+			SELECT COUNT(*)
+			FROM public.comment AS r
+			WHERE r.parent_id = c.id
+		) AS replies_count,
+		array_agg(cl.user_id) AS liked_by
+	FROM public.comment AS c
+	LEFT JOIN public.comment_like cl ON cl.comment_id = c.id
+	WHERE c.parent_id = $1
+		AND c.id > $2
+		AND NOT c.is_hidden
+	GROUP BY c.id
+	ORDER BY c.id
+	LIMIT $3;
 	`
 	getCommentByIDQuery = `
 	SELECT id,
 		post_id,
 		author_id,
+		COALESCE(parent_id, 0),
+		depth,
 		content,
 		created_at,
 		updated_at
 	FROM public.comment
 	WHERE id = $1;
 	`
+	// storeCommentQuery opens the case of the comment held by the content
+	// filter in the same statement, so the comment is never visible before it
+	// is reviewed.
 	storeCommentQuery = `
-	INSERT INTO public.comment (post_id, author_id, content)
-	VALUES ($1, $2, $3)
-	RETURNING id,
+	WITH new_comment AS (
+		INSERT INTO public.comment (post_id, author_id, parent_id, depth, content, is_hidden)
+		VALUES ($1, $2, NULLIF($3::bigint, 0), $4, $5, $6)
+		RETURNING id,
+			post_id,
+			author_id,
+			parent_id,
+			depth,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'COMMENT',
+			id
+		FROM new_comment
+		WHERE is_hidden
+	)
+	SELECT id,
 		post_id,
 		author_id,
+		COALESCE(parent_id, 0),
+		depth,
 		content,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM new_comment;
 	`
 	updateCommentQuery = `
-	UPDATE public.comment
-	SET content = $1
-	WHERE id = $2
-	RETURNING id,
+	WITH updated_comment AS (
+		UPDATE public.comment
+		SET content = $1,
+			is_hidden = is_hidden OR $3
+		WHERE id = $2
+		RETURNING id,
+			post_id,
+			author_id,
+			parent_id,
+			depth,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'COMMENT',
+			id
+		FROM updated_comment
+		WHERE $3
+		ON CONFLICT (target_type, target_id) DO UPDATE
+		SET status = 'OPEN',
+			resolved_by = NULL
+	)
+	SELECT id,
 		post_id,
 		author_id,
+		COALESCE(parent_id, 0),
+		depth,
 		content,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM updated_comment;
 	`
 	deleteCommentQuery = `
 	DELETE FROM public.comment
@@ -89,15 +212,70 @@ const (
 	`
 )
 
-func (p *Posts) GetCommentsByPostID(ctx context.Context, postID uint) (comments []*domain.Comment, err error) {
-	contextlogger.LogSQL(ctx, getCommentsByPostIDQuery, postID)
+// GetCommentsByPostID returns top level comments of the post with IDs
+// greater than lastCommentID, oldest first.
+func (p *Posts) GetCommentsByPostID(ctx context.Context, postID, lastCommentID, commentsAmount uint) (comments []*domain.Comment, err error) {
+	contextlogger.LogSQL(ctx, getCommentsByPostIDQuery, postID, lastCommentID, commentsAmount)
 
-	rows, err := p.db.Query(context.Background(), getCommentsByPostIDQuery, postID)
+	rows, err := p.db.Query(context.Background(), getCommentsByPostIDQuery, postID, lastCommentID, commentsAmount)
 	if err != nil {
 		return
 	}
 	defer rows.Close()
 
+	comments, err = scanComments(rows)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCommentsRepliesPreview returns up to repliesAmount first direct replies
+// of every comment, grouped by parent and oldest first.
+func (p *Posts) GetCommentsRepliesPreview(ctx context.Context, commentIDs []uint, repliesAmount uint) (replies []*domain.Comment, err error) {
+	if len(commentIDs) == 0 {
+		return
+	}
+
+	commentIDsPGArray := pq.Array(commentIDs)
+
+	contextlogger.LogSQL(ctx, getCommentsRepliesPreviewQuery, commentIDsPGArray, repliesAmount)
+
+	rows, err := p.db.Query(context.Background(), getCommentsRepliesPreviewQuery, commentIDsPGArray, repliesAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	replies, err = scanComments(rows)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCommentReplies returns direct replies of the comment with IDs greater
+// than lastReplyID, oldest first.
+func (p *Posts) GetCommentReplies(ctx context.Context, commentID, lastReplyID, repliesAmount uint) (replies []*domain.Comment, err error) {
+	contextlogger.LogSQL(ctx, getCommentRepliesQuery, commentID, lastReplyID, repliesAmount)
+
+	rows, err := p.db.Query(context.Background(), getCommentRepliesQuery, commentID, lastReplyID, repliesAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	replies, err = scanComments(rows)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func scanComments(rows pgx.Rows) (comments []*domain.Comment, err error) {
 	var likedByIDS pgtype.Int8Array
 
 	for rows.Next() {
@@ -107,9 +285,12 @@ func (p *Posts) GetCommentsByPostID(ctx context.Context, postID uint) (comments
 			&comment.ID,
 			&comment.PostID,
 			&comment.AuthorID,
+			&comment.ParentID,
+			&comment.Depth,
 			&comment.Content,
 			&comment.CreatedAt.Time,
 			&comment.UpdatedAt.Time,
+			&comment.RepliesCount,
 			&likedByIDS,
 		)
 		if err != nil {
@@ -135,6 +316,8 @@ func (p *Posts) GetCommentByID(ctx context.Context, id uint) (comment *domain.Co
 		&comment.ID,
 		&comment.PostID,
 		&comment.AuthorID,
+		&comment.ParentID,
+		&comment.Depth,
 		&comment.Content,
 		&comment.CreatedAt.Time,
 		&comment.UpdatedAt.Time,
@@ -158,12 +341,18 @@ func (p *Posts) StoreComment(ctx context.Context, comment *domain.Comment) (newC
 		storeCommentQuery,
 		comment.PostID,
 		comment.AuthorID,
+		comment.ParentID,
+		comment.Depth,
 		comment.Content,
+		comment.IsHidden,
 	).Scan(
 		&newComment.ID,
 		&newComment.PostID,
 		&newComment.AuthorID,
+		&newComment.ParentID,
+		&newComment.Depth,
 		&newComment.Content,
+		&newComment.IsHidden,
 		&newComment.CreatedAt.Time,
 		&newComment.UpdatedAt.Time,
 	)
@@ -182,16 +371,22 @@ func (p *Posts) UpdateComment(ctx context.Context, comment *domain.Comment) (upd
 
 	updatedComment = new(domain.Comment)
 
+	contextlogger.LogSQL(ctx, updateCommentQuery, comment.Content, comment.ID, comment.IsHidden)
+
 	err = p.db.QueryRow(
 		context.Background(),
 		updateCommentQuery,
 		comment.Content,
 		comment.ID,
+		comment.IsHidden,
 	).Scan(
 		&updatedComment.ID,
 		&updatedComment.PostID,
 		&updatedComment.AuthorID,
+		&updatedComment.ParentID,
+		&updatedComment.Depth,
 		&updatedComment.Content,
+		&updatedComment.IsHidden,
 		&updatedComment.CreatedAt.Time,
 		&updatedComment.UpdatedAt.Time,
 	)
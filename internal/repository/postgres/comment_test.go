@@ -25,44 +25,203 @@ func TestGetCommentsByPostID(t *testing.T) {
 	tp := customtime.MockTimeProvider{}
 
 	tests := []struct {
-		name    string
-		postID  uint
-		want    []*domain.Comment
-		wantErr bool
-		setup   func()
+		name           string
+		postID         uint
+		lastCommentID  uint
+		commentsAmount uint
+		want           []*domain.Comment
+		wantErr        bool
+		setup          func()
 	}{
 		{
-			name:   "test case 1",
-			postID: 1,
+			name:           "test case 1",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 20,
 			want: []*domain.Comment{
 				{
-					ID:         1,
+					ID:           1,
+					PostID:       1,
+					AuthorID:     1,
+					Content:      "Test content",
+					CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+					RepliesCount: 2,
+					LikedByIDs:   []uint64{1, 2, 3},
+				},
+			},
+			wantErr: false,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"id", "post_id", "author_id", "parent_id", "depth", "content", "created_at", "updated_at", "replies_count", "liked_by_ids"}).
+					AddRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content", tp.Now(), tp.Now(), uint(2), pgtype.Int8Array{Elements: []pgtype.Int8{
+						{Int: 1, Status: pgtype.Present},
+						{Int: 2, Status: pgtype.Present},
+						{Int: 3, Status: pgtype.Present},
+					}, Status: pgtype.Present}).ToPgxRows()
+				pool.EXPECT().Query(context.Background(), gomock.Any(), uint(1), uint(0), uint(20)).Return(rows, nil)
+			},
+		},
+		{
+			name:           "test case 2",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 20,
+			want:           nil,
+			wantErr:        true,
+			setup: func() {
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			p := repository.NewPosts(pool, tp)
+
+			got, err := p.GetCommentsByPostID(context.Background(), tt.postID, tt.lastCommentID, tt.commentsAmount)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetCommentsRepliesPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name          string
+		commentIDs    []uint
+		repliesAmount uint
+		want          []*domain.Comment
+		wantErr       bool
+		setup         func()
+	}{
+		{
+			name:          "test case 1",
+			commentIDs:    []uint{1, 2},
+			repliesAmount: 3,
+			want: []*domain.Comment{
+				{
+					ID:         3,
 					PostID:     1,
-					AuthorID:   1,
-					Content:    "Test content",
+					AuthorID:   2,
+					ParentID:   1,
+					Depth:      1,
+					Content:    "Test reply",
 					CreatedAt:  customtime.CustomTime{Time: tp.Now()},
 					UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
-					LikedByIDs: []uint64{1, 2, 3},
+					LikedByIDs: nil,
 				},
 			},
 			wantErr: false,
 			setup: func() {
-				rows := pgxpoolmock.NewRows([]string{"id", "post_id", "author_id", "content", "created_at", "updated_at", "liked_by_ids"}).
-					AddRow(uint(1), uint(1), uint(1), "Test content", tp.Now(), tp.Now(), pgtype.Int8Array{Elements: []pgtype.Int8{
+				rows := pgxpoolmock.NewRows([]string{"id", "post_id", "author_id", "parent_id", "depth", "content", "created_at", "updated_at", "replies_count", "liked_by_ids"}).
+					AddRow(uint(3), uint(1), uint(2), uint(1), uint(1), "Test reply", tp.Now(), tp.Now(), uint(0), pgtype.Int8Array{Status: pgtype.Present}).ToPgxRows()
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), uint(3)).Return(rows, nil)
+			},
+		},
+		{
+			name:          "test case 2",
+			commentIDs:    nil,
+			repliesAmount: 3,
+			want:          nil,
+			wantErr:       false,
+			setup:         func() {},
+		},
+		{
+			name:          "test case 3",
+			commentIDs:    []uint{1},
+			repliesAmount: 3,
+			want:          nil,
+			wantErr:       true,
+			setup: func() {
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			p := repository.NewPosts(pool, tp)
+
+			got, err := p.GetCommentsRepliesPreview(context.Background(), tt.commentIDs, tt.repliesAmount)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetCommentReplies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name          string
+		commentID     uint
+		lastReplyID   uint
+		repliesAmount uint
+		want          []*domain.Comment
+		wantErr       bool
+		setup         func()
+	}{
+		{
+			name:          "test case 1",
+			commentID:     1,
+			lastReplyID:   3,
+			repliesAmount: 20,
+			want: []*domain.Comment{
+				{
+					ID:           4,
+					PostID:       1,
+					AuthorID:     2,
+					ParentID:     1,
+					Depth:        1,
+					Content:      "Test reply",
+					CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+					RepliesCount: 1,
+					LikedByIDs:   []uint64{1},
+				},
+			},
+			wantErr: false,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"id", "post_id", "author_id", "parent_id", "depth", "content", "created_at", "updated_at", "replies_count", "liked_by_ids"}).
+					AddRow(uint(4), uint(1), uint(2), uint(1), uint(1), "Test reply", tp.Now(), tp.Now(), uint(1), pgtype.Int8Array{Elements: []pgtype.Int8{
 						{Int: 1, Status: pgtype.Present},
-						{Int: 2, Status: pgtype.Present},
-						{Int: 3, Status: pgtype.Present},
 					}, Status: pgtype.Present}).ToPgxRows()
-				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+				pool.EXPECT().Query(context.Background(), gomock.Any(), uint(1), uint(3), uint(20)).Return(rows, nil)
 			},
 		},
 		{
-			name:    "test case 2",
-			postID:  1,
-			want:    nil,
-			wantErr: true,
+			name:          "test case 2",
+			commentID:     1,
+			lastReplyID:   0,
+			repliesAmount: 20,
+			want:          nil,
+			wantErr:       true,
 			setup: func() {
-				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
 	}
@@ -73,7 +232,7 @@ func TestGetCommentsByPostID(t *testing.T) {
 
 			p := repository.NewPosts(pool, tp)
 
-			got, err := p.GetCommentsByPostID(context.Background(), tt.postID)
+			got, err := p.GetCommentReplies(context.Background(), tt.commentID, tt.lastReplyID, tt.repliesAmount)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -112,7 +271,7 @@ func TestGetCommentByID(t *testing.T) {
 			},
 			wantErr: false,
 			setup: func() {
-				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), "Test content", tp.Now(), tp.Now())
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content", tp.Now(), tp.Now())
 				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
 			},
 		},
@@ -172,7 +331,7 @@ func TestStoreComment(t *testing.T) {
 			},
 			wantErr: false,
 			setup: func() {
-				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), "Test content", tp.Now(), tp.Now())
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content", false, tp.Now(), tp.Now())
 				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
 			},
 		},
@@ -232,8 +391,30 @@ func TestUpdateComment(t *testing.T) {
 			},
 			wantErr: false,
 			setup: func() {
-				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), "Test content 1", tp.Now(), tp.Now())
-				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row).AnyTimes()
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content 1", tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
+				updatedRow := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content 1", false, tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(updatedRow)
+			},
+		},
+		{
+			name:    "held",
+			comment: &domain.Comment{ID: 1, Content: "Test content 1", IsHidden: true},
+			want: &domain.Comment{
+				ID:        1,
+				PostID:    1,
+				AuthorID:  1,
+				Content:   "Test content 1",
+				IsHidden:  true,
+				CreatedAt: customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+			},
+			wantErr: false,
+			setup: func() {
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content 1", tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
+				updatedRow := pgxpoolmock.NewRow(uint(1), uint(1), uint(1), uint(0), uint(0), "Test content 1", true, tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), true).Return(updatedRow)
 			},
 		},
 	}
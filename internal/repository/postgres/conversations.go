@@ -0,0 +1,524 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+	"socio/pkg/utils"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	getConversationByIDQuery = `
+	SELECT id,
+		name,
+		is_group,
+		created_at,
+		updated_at
+	FROM public.conversation
+	WHERE id = $1;
+	`
+	getConversationsByUserIDQuery = `
+	SELECT c.id,
+		c.name,
+		c.is_group,
+		c.created_at,
+		c.updated_at,
+		COALESCE(lm.id, 0)
+	FROM public.conversation AS c
+		JOIN public.conversation_participant AS cp ON c.id = cp.conversation_id
+		LEFT JOIN LATERAL (
+			SELECT pm.id,
+				pm.created_at
+			FROM public.personal_message AS pm
+			WHERE pm.conversation_id = c.id
+			ORDER BY pm.id DESC
+			LIMIT 1
+		) AS lm ON TRUE
+	WHERE cp.user_id = $1
+	ORDER BY COALESCE(lm.created_at, c.created_at) DESC;
+	`
+	getConversationParticipantsQuery = `
+	SELECT u.id,
+		u.first_name,
+		u.last_name,
+		u.email,
+		u.avatar,
+		u.date_of_birth,
+		u.created_at,
+		u.updated_at,
+		cp.role,
+		cp.joined_at
+	FROM public.conversation_participant AS cp
+		JOIN public.user AS u ON cp.user_id = u.id
+	WHERE cp.conversation_id = $1
+	ORDER BY cp.joined_at,
+		u.id;
+	`
+	getConversationParticipantIDsQuery = `
+	SELECT user_id
+	FROM public.conversation_participant
+	WHERE conversation_id = $1;
+	`
+	getConversationPeerIDsQuery = `
+	SELECT DISTINCT peer.user_id
+	FROM public.conversation_participant AS cp
+		JOIN public.conversation_participant AS peer ON cp.conversation_id = peer.conversation_id
+	WHERE cp.user_id = $1
+		AND peer.user_id <> $1;
+	`
+	getConversationParticipantRoleQuery = `
+	SELECT role
+	FROM public.conversation_participant
+	WHERE conversation_id = $1
+		AND user_id = $2;
+	`
+	storeConversationQuery = `
+	INSERT INTO public.conversation (name, is_group)
+	VALUES ($1, TRUE)
+	RETURNING id,
+		name,
+		is_group,
+		created_at,
+		updated_at;
+	`
+	storeConversationParticipantQuery = `
+	INSERT INTO public.conversation_participant (conversation_id, user_id, role)
+	VALUES ($1, $2, $3)
+	ON CONFLICT DO NOTHING;
+	`
+	updateConversationNameQuery = `
+	UPDATE public.conversation
+	SET name = $2
+	WHERE id = $1
+		AND is_group;
+	`
+	updateConversationParticipantRoleQuery = `
+	UPDATE public.conversation_participant
+	SET role = $3
+	WHERE conversation_id = $1
+		AND user_id = $2;
+	`
+	deleteConversationParticipantQuery = `
+	DELETE FROM public.conversation_participant
+	WHERE conversation_id = $1
+		AND user_id = $2;
+	`
+	deleteConversationQuery = `
+	DELETE FROM public.conversation
+	WHERE id = $1;
+	`
+	getLastConversationMessageIDQuery = `
+	SELECT COALESCE(MAX(id), 0) AS last_message_id
+	FROM public.personal_message
+	WHERE conversation_id = $1;
+	`
+	getMessagesByConversationQuery = `
+	SELECT pm.id,
+		pm.sender_id,
+		COALESCE(pm.receiver_id, 0),
+		pm.conversation_id,
+		pm.content,
+		pm.created_at,
+		pm.updated_at,
+		COALESCE(pm.sticker_id, 0),
+		array_agg(DISTINCT ma.file_name) AS attachments
+	FROM public.personal_message AS pm
+	LEFT JOIN public.message_attachment AS ma ON pm.id = ma.message_id
+	WHERE pm.conversation_id = $1
+		AND pm.id < $2
+		AND NOT pm.is_hidden
+	GROUP BY pm.id,
+		pm.sender_id,
+		pm.receiver_id,
+		pm.conversation_id,
+		pm.content,
+		pm.created_at,
+		pm.updated_at,
+		pm.sticker_id
+	ORDER BY pm.created_at DESC
+	LIMIT $3;
+	`
+)
+
+func (pm *PersonalMessages) GetConversationByID(ctx context.Context, conversationID uint) (conversation *domain.Conversation, err error) {
+	contextlogger.LogSQL(ctx, getConversationByIDQuery, conversationID)
+
+	conversation = new(domain.Conversation)
+
+	err = pm.db.QueryRow(context.Background(), getConversationByIDQuery, conversationID).Scan(
+		&conversation.ID,
+		&conversation.Name,
+		&conversation.IsGroup,
+		&conversation.CreatedAt.Time,
+		&conversation.UpdatedAt.Time,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+		}
+
+		return
+	}
+
+	conversation.Participants, err = pm.GetConversationParticipants(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetConversationsByUserID(ctx context.Context, userID uint) (conversations []*domain.Conversation, err error) {
+	contextlogger.LogSQL(ctx, getConversationsByUserIDQuery, userID)
+
+	rows, err := pm.db.Query(context.Background(), getConversationsByUserIDQuery, userID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	conversations = make([]*domain.Conversation, 0)
+	lastMessageIDs := make([]uint, 0)
+
+	for rows.Next() {
+		conversation := new(domain.Conversation)
+		var lastMessageID uint
+
+		err = rows.Scan(
+			&conversation.ID,
+			&conversation.Name,
+			&conversation.IsGroup,
+			&conversation.CreatedAt.Time,
+			&conversation.UpdatedAt.Time,
+			&lastMessageID,
+		)
+		if err != nil {
+			return
+		}
+
+		conversations = append(conversations, conversation)
+		lastMessageIDs = append(lastMessageIDs, lastMessageID)
+	}
+
+	rows.Close()
+
+	for i, conversation := range conversations {
+		conversation.Participants, err = pm.GetConversationParticipants(ctx, conversation.ID)
+		if err != nil {
+			return
+		}
+
+		if lastMessageIDs[i] != 0 {
+			conversation.LastMessage, err = pm.GetMessageByID(ctx, lastMessageIDs[i])
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetConversationParticipants(ctx context.Context, conversationID uint) (participants []*domain.ConversationParticipant, err error) {
+	contextlogger.LogSQL(ctx, getConversationParticipantsQuery, conversationID)
+
+	rows, err := pm.db.Query(context.Background(), getConversationParticipantsQuery, conversationID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	participants = make([]*domain.ConversationParticipant, 0)
+
+	for rows.Next() {
+		participant := &domain.ConversationParticipant{
+			User: new(domain.User),
+		}
+
+		err = rows.Scan(
+			&participant.User.ID,
+			&participant.User.FirstName,
+			&participant.User.LastName,
+			&participant.User.Email,
+			&participant.User.Avatar,
+			&participant.User.DateOfBirth.Time,
+			&participant.User.CreatedAt.Time,
+			&participant.User.UpdatedAt.Time,
+			&participant.Role,
+			&participant.JoinedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		participants = append(participants, participant)
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetConversationParticipantIDs(ctx context.Context, conversationID uint) (userIDs []uint, err error) {
+	contextlogger.LogSQL(ctx, getConversationParticipantIDsQuery, conversationID)
+
+	rows, err := pm.db.Query(context.Background(), getConversationParticipantIDsQuery, conversationID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID uint
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			return
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return
+}
+
+// GetConversationPeerIDs returns the users that share a dialog or a group
+// conversation with the user.
+func (pm *PersonalMessages) GetConversationPeerIDs(ctx context.Context, userID uint) (peerIDs []uint, err error) {
+	contextlogger.LogSQL(ctx, getConversationPeerIDsQuery, userID)
+
+	rows, err := pm.db.Query(context.Background(), getConversationPeerIDsQuery, userID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var peerID uint
+
+		err = rows.Scan(&peerID)
+		if err != nil {
+			return
+		}
+
+		peerIDs = append(peerIDs, peerID)
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetConversationParticipantRole(ctx context.Context, conversationID, userID uint) (role domain.ConversationRole, err error) {
+	contextlogger.LogSQL(ctx, getConversationParticipantRoleQuery, conversationID, userID)
+
+	err = pm.db.QueryRow(context.Background(), getConversationParticipantRoleQuery, conversationID, userID).Scan(&role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+		}
+
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) StoreConversation(ctx context.Context, conversation *domain.Conversation) (newConversation *domain.Conversation, err error) {
+	tx, err := pm.db.BeginTx(context.Background(), pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			return
+		}
+		if err = tx.Rollback(context.Background()); err != nil && err != pgx.ErrTxClosed {
+			return
+		}
+
+		err = nil
+	}()
+
+	contextlogger.LogSQL(ctx, storeConversationQuery, conversation.Name)
+
+	newConversation = new(domain.Conversation)
+	err = tx.QueryRow(context.Background(), storeConversationQuery, conversation.Name).Scan(
+		&newConversation.ID,
+		&newConversation.Name,
+		&newConversation.IsGroup,
+		&newConversation.CreatedAt.Time,
+		&newConversation.UpdatedAt.Time,
+	)
+	if err != nil {
+		return
+	}
+
+	for _, participant := range conversation.Participants {
+		contextlogger.LogSQL(ctx, storeConversationParticipantQuery, newConversation.ID, participant.User.ID, participant.Role)
+
+		_, err = tx.Exec(context.Background(), storeConversationParticipantQuery, newConversation.ID, participant.User.ID, participant.Role)
+		if err != nil {
+			return
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) StoreConversationParticipants(ctx context.Context, conversationID uint, userIDs []uint) (err error) {
+	tx, err := pm.db.BeginTx(context.Background(), pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			return
+		}
+		if err = tx.Rollback(context.Background()); err != nil && err != pgx.ErrTxClosed {
+			return
+		}
+
+		err = nil
+	}()
+
+	for _, userID := range userIDs {
+		contextlogger.LogSQL(ctx, storeConversationParticipantQuery, conversationID, userID, domain.ConversationRoleMember)
+
+		_, err = tx.Exec(context.Background(), storeConversationParticipantQuery, conversationID, userID, domain.ConversationRoleMember)
+		if err != nil {
+			return
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) UpdateConversationName(ctx context.Context, conversationID uint, name string) (err error) {
+	contextlogger.LogSQL(ctx, updateConversationNameQuery, conversationID, name)
+
+	result, err := pm.db.Exec(context.Background(), updateConversationNameQuery, conversationID, name)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() != 1 {
+		return errors.ErrNotFound
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) UpdateConversationParticipantRole(ctx context.Context, conversationID, userID uint, role domain.ConversationRole) (err error) {
+	contextlogger.LogSQL(ctx, updateConversationParticipantRoleQuery, conversationID, userID, role)
+
+	result, err := pm.db.Exec(context.Background(), updateConversationParticipantRoleQuery, conversationID, userID, role)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() != 1 {
+		return errors.ErrNotFound
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) DeleteConversationParticipant(ctx context.Context, conversationID, userID uint) (err error) {
+	contextlogger.LogSQL(ctx, deleteConversationParticipantQuery, conversationID, userID)
+
+	result, err := pm.db.Exec(context.Background(), deleteConversationParticipantQuery, conversationID, userID)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() != 1 {
+		return errors.ErrNotFound
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) DeleteConversation(ctx context.Context, conversationID uint) (err error) {
+	contextlogger.LogSQL(ctx, deleteConversationQuery, conversationID)
+
+	result, err := pm.db.Exec(context.Background(), deleteConversationQuery, conversationID)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() != 1 {
+		return errors.ErrNotFound
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetLastConversationMessageID(ctx context.Context, conversationID uint) (lastMessageID uint, err error) {
+	contextlogger.LogSQL(ctx, getLastConversationMessageIDQuery, conversationID)
+
+	err = pm.db.QueryRow(context.Background(), getLastConversationMessageIDQuery, conversationID).Scan(&lastMessageID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (pm *PersonalMessages) GetMessagesByConversation(ctx context.Context, conversationID, lastMessageID, messagesAmount uint) (messages []*domain.PersonalMessage, err error) {
+	contextlogger.LogSQL(ctx, getMessagesByConversationQuery, conversationID, lastMessageID, messagesAmount)
+
+	rows, err := pm.db.Query(context.Background(), getMessagesByConversationQuery, conversationID, lastMessageID, messagesAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		msg := new(domain.PersonalMessage)
+		sticker := new(domain.Sticker)
+		var attachments pgtype.TextArray
+
+		err = rows.Scan(
+			&msg.ID,
+			&msg.SenderID,
+			&msg.ReceiverID,
+			&msg.ConversationID,
+			&msg.Content,
+			&msg.CreatedAt.Time,
+			&msg.UpdatedAt.Time,
+			&sticker.ID,
+			&attachments,
+		)
+		if err != nil {
+			return
+		}
+
+		msg.Attachments = utils.TextArrayIntoStringSlice(attachments)
+
+		if sticker.ID != 0 {
+			sticker, err = pm.GetStickerByID(ctx, sticker.ID)
+			if err != nil {
+				return
+			}
+
+			msg.Sticker = sticker
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return
+}
@@ -0,0 +1,460 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+var conversationParticipantColumns = []string{"id", "first_name", "last_name", "email", "avatar", "date_of_birth", "created_at", "updated_at", "role", "joined_at"}
+
+func TestGetConversationByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name           string
+		conversationID uint
+		want           *domain.Conversation
+		wantErr        error
+		setup          func()
+	}{
+		{
+			name:           "test case 1",
+			conversationID: 1,
+			want: &domain.Conversation{
+				ID:        1,
+				Name:      "Friends",
+				IsGroup:   true,
+				CreatedAt: customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+				Participants: []*domain.ConversationParticipant{
+					{
+						User: &domain.User{
+							ID:          1,
+							FirstName:   "John",
+							LastName:    "Doe",
+							Email:       "john@example.com",
+							Avatar:      "default_avatar.png",
+							DateOfBirth: customtime.CustomTime{Time: tp.Now()},
+							CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+							UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+						},
+						Role:     domain.ConversationRoleOwner,
+						JoinedAt: customtime.CustomTime{Time: tp.Now()},
+					},
+				},
+			},
+			wantErr: nil,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(
+					pgxpoolmock.NewRow(uint(1), "Friends", true, tp.Now(), tp.Now()),
+				)
+				rows := pgxpoolmock.NewRows(conversationParticipantColumns).
+					AddRow(uint(1), "John", "Doe", "john@example.com", "default_avatar.png", tp.Now(), tp.Now(), tp.Now(), domain.ConversationRoleOwner, tp.Now()).ToPgxRows()
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+		},
+		{
+			name:           "test case 2",
+			conversationID: 1,
+			want:           nil,
+			wantErr:        errors.ErrNotFound,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+		},
+		{
+			name:           "test case 3",
+			conversationID: 1,
+			want:           nil,
+			wantErr:        errors.ErrInternal,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(
+					pgxpoolmock.NewRow(uint(1), "Friends", true, tp.Now(), tp.Now()),
+				)
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.GetConversationByID(context.Background(), tt.conversationID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetConversationParticipantIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		want    []uint
+		wantErr bool
+		setup   func()
+	}{
+		{
+			name:    "test case 1",
+			want:    []uint{1, 2},
+			wantErr: false,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"user_id"}).AddRow(uint(1)).AddRow(uint(2)).ToPgxRows()
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+		},
+		{
+			name:    "test case 2",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+		{
+			name:    "test case 3",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.GetConversationParticipantIDs(context.Background(), 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetConversationPeerIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		want    []uint
+		wantErr bool
+		setup   func()
+	}{
+		{
+			name:    "test case 1",
+			want:    []uint{2, 3},
+			wantErr: false,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"user_id"}).AddRow(uint(2)).AddRow(uint(3)).ToPgxRows()
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+		},
+		{
+			name:    "test case 2",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+		{
+			name:    "test case 3",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.GetConversationPeerIDs(context.Background(), 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetConversationParticipantRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		want    domain.ConversationRole
+		wantErr error
+		setup   func()
+	}{
+		{
+			name:    "test case 1",
+			want:    domain.ConversationRoleAdmin,
+			wantErr: nil,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(domain.ConversationRoleAdmin))
+			},
+		},
+		{
+			name:    "test case 2",
+			want:    "",
+			wantErr: errors.ErrNotFound,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.GetConversationParticipantRole(context.Background(), 1, 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStoreConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	conversation := &domain.Conversation{
+		Name:    "Friends",
+		IsGroup: true,
+		Participants: []*domain.ConversationParticipant{
+			{User: &domain.User{ID: 1}, Role: domain.ConversationRoleOwner},
+			{User: &domain.User{ID: 2}, Role: domain.ConversationRoleMember},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		want    *domain.Conversation
+		wantErr bool
+		setup   func()
+	}{
+		{
+			name: "test case 1",
+			want: &domain.Conversation{
+				ID:        1,
+				Name:      "Friends",
+				IsGroup:   true,
+				CreatedAt: customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+			},
+			wantErr: false,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), "Friends", true, tp.Now(), tp.Now()))
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), uint(1), domain.ConversationRoleOwner).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), uint(2), domain.ConversationRoleMember).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+				mockDB.EXPECT().Commit(context.Background()).Return(nil)
+				mockDB.EXPECT().Rollback(context.Background()).Return(nil)
+			},
+		},
+		{
+			name:    "test case 2",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), "Friends", true, tp.Now(), tp.Now()))
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), uint(1), domain.ConversationRoleOwner).Return(nil, errors.ErrInternal)
+			},
+		},
+		{
+			name:    "test case 3",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+		},
+		{
+			name:    "test case 4",
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.StoreConversation(context.Background(), conversation)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateConversationName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		wantErr error
+		setup   func()
+	}{
+		{
+			name:    "test case 1",
+			wantErr: nil,
+			setup: func() {
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), "Family").Return(pgconn.CommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name:    "test case 2",
+			wantErr: errors.ErrNotFound,
+			setup: func() {
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), "Family").Return(pgconn.CommandTag("UPDATE 0"), nil)
+			},
+		},
+		{
+			name:    "test case 3",
+			wantErr: errors.ErrInternal,
+			setup: func() {
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), "Family").Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			err := pm.UpdateConversationName(context.Background(), 1, "Family")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteConversationParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		wantErr error
+		setup   func()
+	}{
+		{
+			name:    "test case 1",
+			wantErr: nil,
+			setup: func() {
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), uint(2)).Return(pgconn.CommandTag("DELETE 1"), nil)
+			},
+		},
+		{
+			name:    "test case 2",
+			wantErr: errors.ErrNotFound,
+			setup: func() {
+				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), uint(1), uint(2)).Return(pgconn.CommandTag("DELETE 0"), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			err := pm.DeleteConversationParticipant(context.Background(), 1, 2)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// storeEmailVerificationTokenQuery replaces the previous tokens of the user,
+	// only the last sent link verifies the email.
+	storeEmailVerificationTokenQuery = `
+	WITH deleted AS (
+		DELETE FROM public.email_verification_token
+		WHERE user_id = $1
+	)
+	INSERT INTO public.email_verification_token (user_id, email, token_hash, expires_at)
+	VALUES ($1, $2, $3, $4);
+	`
+	// verifyEmailQuery consumes the token even if it is expired, the token does
+	// not verify the email if the user has changed it since the token was sent.
+	verifyEmailQuery = `
+	WITH token AS (
+		DELETE FROM public.email_verification_token
+		WHERE token_hash = $1
+		RETURNING user_id,
+			email,
+			expires_at
+	)
+	UPDATE public.user
+	SET email_verified_at = $2
+	FROM token
+	WHERE public.user.id = token.user_id
+		AND public.user.email = token.email
+		AND token.expires_at > $2
+	RETURNING public.user.id;
+	`
+	checkIfEmailIsVerifiedQuery = `
+	SELECT email_verified_at IS NOT NULL
+	FROM public.user
+	WHERE id = $1;
+	`
+)
+
+func (s *Users) StoreEmailVerificationToken(ctx context.Context, userID uint, email string, tokenHash string, expiresAt time.Time) (err error) {
+	contextlogger.LogSQL(ctx, storeEmailVerificationTokenQuery, userID, email, expiresAt)
+
+	result, err := s.db.Exec(context.Background(), storeEmailVerificationTokenQuery, userID, email, tokenHash, expiresAt)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() != 1 {
+		err = errors.ErrRowsAffected
+		return
+	}
+
+	return
+}
+
+func (s *Users) VerifyEmail(ctx context.Context, tokenHash string, now time.Time) (userID uint, err error) {
+	contextlogger.LogSQL(ctx, verifyEmailQuery, now)
+
+	err = s.db.QueryRow(context.Background(), verifyEmailQuery, tokenHash, now).Scan(&userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrInvalidToken
+			return
+		}
+
+		return
+	}
+
+	return
+}
+
+func (s *Users) CheckIfEmailIsVerified(ctx context.Context, userID uint) (isVerified bool, err error) {
+	contextlogger.LogSQL(ctx, checkIfEmailIsVerifiedQuery, userID)
+
+	err = s.db.QueryRow(context.Background(), checkIfEmailIsVerifiedQuery, userID).Scan(&isVerified)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+			return
+		}
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,166 @@
+package repository_test
+
+import (
+	"context"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+	"time"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreEmailVerificationToken(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), "user@mail.ru", "hash", expiresAt).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+			},
+		},
+		{
+			name: "Test rows affected",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("INSERT 0 0"), nil)
+			},
+			wantErr: errors.ErrRowsAffected,
+		},
+		{
+			name: "Test exec error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
+
+			tt.mock(pool)
+
+			err := users.StoreEmailVerificationToken(context.Background(), 1, "user@mail.ru", "hash", expiresAt)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	now := customtime.MockTimeProvider{}.Now()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected uint
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "hash", now).Return(pgxpoolmock.NewRow(uint(1)))
+			},
+			expected: 1,
+		},
+		{
+			name: "Test invalid token",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrInvalidToken,
+		},
+		{
+			name: "Test internal error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrInternalRow{})
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
+
+			tt.mock(pool)
+
+			got, err := users.VerifyEmail(context.Background(), "hash", now)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCheckIfEmailIsVerified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  error
+	}{
+		{
+			name: "Test verified",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(pgxpoolmock.NewRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "Test not verified",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(pgxpoolmock.NewRow(false))
+			},
+			expected: false,
+		},
+		{
+			name: "Test not found",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
+
+			tt.mock(pool)
+
+			got, err := users.CheckIfEmailIsVerified(context.Background(), 1)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
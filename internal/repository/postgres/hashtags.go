@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"socio/pkg/utils"
+	"socio/usecase/posts"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/lib/pq"
+)
+
+// The store queries replace the hashtags and the mentions of the post or the
+// comment in one statement. Only the existing users are mentioned, and the
+// mentions kept by the update keep is_notified, so nobody is notified twice.
+const (
+	storePostEntitiesQuery = `
+	WITH deleted_hashtag AS (
+		DELETE FROM public.post_hashtag
+		WHERE post_id = $1
+			AND hashtag <> ALL($2::text[])
+	),
+	inserted_hashtag AS (
+		INSERT INTO public.post_hashtag (post_id, hashtag)
+		SELECT $1,
+			h.hashtag
+		FROM unnest($2::text[]) AS h(hashtag)
+		ON CONFLICT DO NOTHING
+	),
+	deleted_mention AS (
+		DELETE FROM public.post_mention
+		WHERE post_id = $1
+			AND user_id <> ALL($3::bigint[])
+	)
+	INSERT INTO public.post_mention (post_id, user_id)
+	SELECT $1,
+		u.id
+	FROM public.user AS u
+	WHERE u.id = ANY($3::bigint[])
+	ON CONFLICT DO NOTHING;
+	`
+	storeCommentEntitiesQuery = `
+	WITH deleted_hashtag AS (
+		DELETE FROM public.comment_hashtag
+		WHERE comment_id = $1
+			AND hashtag <> ALL($2::text[])
+	),
+	inserted_hashtag AS (
+		INSERT INTO public.comment_hashtag (comment_id, hashtag)
+		SELECT $1,
+			h.hashtag
+		FROM unnest($2::text[]) AS h(hashtag)
+		ON CONFLICT DO NOTHING
+	),
+	deleted_mention AS (
+		DELETE FROM public.comment_mention
+		WHERE comment_id = $1
+			AND user_id <> ALL($3::bigint[])
+	)
+	INSERT INTO public.comment_mention (comment_id, user_id)
+	SELECT $1,
+		u.id
+	FROM public.user AS u
+	WHERE u.id = ANY($3::bigint[])
+	ON CONFLICT DO NOTHING;
+	`
+	getPostMentionsQuery = `
+	SELECT post_id,
+		user_id
+	FROM public.post_mention
+	WHERE post_id = ANY($1::bigint[]);
+	`
+	getCommentMentionsQuery = `
+	SELECT comment_id,
+		user_id
+	FROM public.comment_mention
+	WHERE comment_id = ANY($1::bigint[]);
+	`
+	getPostsByHashtagQuery = `
+	SELECT p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
+		array_agg(DISTINCT pa.file_name) AS attachments,
+		array_agg(DISTINCT pl.user_id) AS liked_by_users,
+		COALESCE(pgp.public_group_id, 0) AS group_id
+	FROM public.post_hashtag AS ph
+	JOIN public.post AS p ON p.id = ph.post_id
+	LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
+	LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
+	LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+	WHERE ph.hashtag = $1
+		AND ($2 = 0 OR p.id < $2)
+		AND NOT p.is_hidden
+		AND (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $4))
+		AND NOT public.is_blocked_between(p.author_id, $4)
+	GROUP BY p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		pgp.public_group_id
+	ORDER BY p.id DESC
+	LIMIT $3;
+	`
+	// a hashtag is counted once per post or comment using it
+	getTrendingHashtagsQuery = `
+	SELECT tagged.hashtag,
+		COUNT(*) AS uses_count
+	FROM (
+		SELECT ph.hashtag
+		FROM public.post_hashtag AS ph
+		JOIN public.post AS p ON p.id = ph.post_id
+		WHERE p.created_at >= $1
+		UNION ALL
+		SELECT ch.hashtag
+		FROM public.comment_hashtag AS ch
+		JOIN public.comment AS c ON c.id = ch.comment_id
+		WHERE c.created_at >= $1
+	) AS tagged
+	GROUP BY tagged.hashtag
+	ORDER BY uses_count DESC, tagged.hashtag
+	LIMIT $2;
+	`
+	// starts_with is used instead of LIKE, as "_" is a wildcard of LIKE and
+	// is allowed in hashtags
+	searchHashtagsQuery = `
+	SELECT tagged.hashtag,
+		COUNT(*) AS uses_count
+	FROM (
+		SELECT ph.hashtag
+		FROM public.post_hashtag AS ph
+		WHERE starts_with(ph.hashtag, $1)
+			AND ph.hashtag > $2
+		UNION ALL
+		SELECT ch.hashtag
+		FROM public.comment_hashtag AS ch
+		WHERE starts_with(ch.hashtag, $1)
+			AND ch.hashtag > $2
+	) AS tagged
+	GROUP BY tagged.hashtag
+	ORDER BY tagged.hashtag
+	LIMIT $3;
+	`
+	countSearchHashtagsQuery = `
+	SELECT COUNT(*)
+	FROM (
+		SELECT ph.hashtag
+		FROM public.post_hashtag AS ph
+		WHERE starts_with(ph.hashtag, $1)
+		UNION
+		SELECT ch.hashtag
+		FROM public.comment_hashtag AS ch
+		WHERE starts_with(ch.hashtag, $1)
+	) AS tagged;
+	`
+)
+
+func (p *Posts) StorePostEntities(ctx context.Context, postID uint, hashtags []string, mentionedUserIDs []uint) (err error) {
+	hashtagsPGArr := pq.Array(hashtags)
+	userIDsPGArr := pq.Array(mentionedUserIDs)
+
+	contextlogger.LogSQL(ctx, storePostEntitiesQuery, postID, hashtagsPGArr, userIDsPGArr)
+
+	_, err = p.db.Exec(context.Background(), storePostEntitiesQuery, postID, hashtagsPGArr, userIDsPGArr)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Posts) StoreCommentEntities(ctx context.Context, commentID uint, hashtags []string, mentionedUserIDs []uint) (err error) {
+	hashtagsPGArr := pq.Array(hashtags)
+	userIDsPGArr := pq.Array(mentionedUserIDs)
+
+	contextlogger.LogSQL(ctx, storeCommentEntitiesQuery, commentID, hashtagsPGArr, userIDsPGArr)
+
+	_, err = p.db.Exec(context.Background(), storeCommentEntitiesQuery, commentID, hashtagsPGArr, userIDsPGArr)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Posts) GetPostMentions(ctx context.Context, postIDs []uint) (mentions []posts.Mention, err error) {
+	mentions, err = p.getMentions(ctx, getPostMentionsQuery, postIDs)
+	return
+}
+
+func (p *Posts) GetCommentMentions(ctx context.Context, commentIDs []uint) (mentions []posts.Mention, err error) {
+	mentions, err = p.getMentions(ctx, getCommentMentionsQuery, commentIDs)
+	return
+}
+
+func (p *Posts) getMentions(ctx context.Context, query string, targetIDs []uint) (mentions []posts.Mention, err error) {
+	targetIDsPGArr := pq.Array(targetIDs)
+
+	contextlogger.LogSQL(ctx, query, targetIDsPGArr)
+
+	rows, err := p.db.Query(context.Background(), query, targetIDsPGArr)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var mention posts.Mention
+
+		err = rows.Scan(&mention.TargetID, &mention.UserID)
+		if err != nil {
+			return
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return
+}
+
+func (p *Posts) GetPostsByHashtag(ctx context.Context, viewerID uint, hashtag string, lastPostID, postsAmount uint) (hashtagPosts []*domain.Post, err error) {
+	contextlogger.LogSQL(ctx, getPostsByHashtagQuery, hashtag, lastPostID, postsAmount, viewerID)
+
+	rows, err := p.db.Query(context.Background(), getPostsByHashtagQuery, hashtag, lastPostID, postsAmount, viewerID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := new(domain.Post)
+
+		var attachments pgtype.TextArray
+		var likedByUsers pgtype.Int8Array
+
+		err = rows.Scan(
+			&post.ID,
+			&post.AuthorID,
+			&post.Content,
+			&post.CreatedAt.Time,
+			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
+			&attachments,
+			&likedByUsers,
+			&post.GroupID,
+		)
+		if err != nil {
+			return
+		}
+
+		post.Attachments = utils.TextArrayIntoStringSlice(attachments)
+		post.LikedByIDs = utils.Int8ArrayIntoUintSlice(likedByUsers)
+
+		hashtagPosts = append(hashtagPosts, post)
+	}
+
+	return
+}
+
+func (p *Posts) GetTrendingHashtags(ctx context.Context, since time.Time, limit uint) (hashtags []posts.TrendingHashtag, err error) {
+	contextlogger.LogSQL(ctx, getTrendingHashtagsQuery, since, limit)
+
+	rows, err := p.db.Query(context.Background(), getTrendingHashtagsQuery, since, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hashtag posts.TrendingHashtag
+
+		err = rows.Scan(&hashtag.Hashtag, &hashtag.Count)
+		if err != nil {
+			return
+		}
+
+		hashtags = append(hashtags, hashtag)
+	}
+
+	return
+}
+
+// SearchHashtags returns up to limit hashtags starting with the prefix after
+// lastHashtag in alphabetical order, each with the amount of its uses.
+func (p *Posts) SearchHashtags(ctx context.Context, prefix, lastHashtag string, limit uint) (hashtags []posts.TrendingHashtag, err error) {
+	contextlogger.LogSQL(ctx, searchHashtagsQuery, prefix, lastHashtag, limit)
+
+	rows, err := p.db.Query(context.Background(), searchHashtagsQuery, prefix, lastHashtag, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hashtag posts.TrendingHashtag
+
+		err = rows.Scan(&hashtag.Hashtag, &hashtag.Count)
+		if err != nil {
+			return
+		}
+
+		hashtags = append(hashtags, hashtag)
+	}
+
+	return
+}
+
+func (p *Posts) CountSearchHashtags(ctx context.Context, prefix string) (count uint, err error) {
+	contextlogger.LogSQL(ctx, countSearchHashtagsQuery, prefix)
+
+	err = p.db.QueryRow(context.Background(), countSearchHashtagsQuery, prefix).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
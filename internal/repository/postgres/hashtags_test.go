@@ -0,0 +1,411 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorePostEntities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test exec error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.StorePostEntities(context.Background(), 1, []string{"go"}, []uint{2})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StorePostEntities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStoreCommentEntities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(5), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("INSERT 0 0"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test exec error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.StoreCommentEntities(context.Background(), 5, []string{}, []uint{})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreCommentEntities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPostMentions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []posts.Mention
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"post_id", "user_id"}).
+					AddRow(uint(1), uint(2)).
+					AddRow(uint(1), uint(3)).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: []posts.Mention{{TargetID: 1, UserID: 2}, {TargetID: 1, UserID: 3}},
+			wantErr:  false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			got, err := repo.GetPostMentions(context.Background(), []uint{1})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPostMentions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetCommentMentions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+	rows := pgxpoolmock.NewRows([]string{"comment_id", "user_id"}).AddRow(uint(5), uint(2)).ToPgxRows()
+	pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+
+	got, err := repo.GetCommentMentions(context.Background(), []uint{5})
+	assert.NoError(t, err)
+	assert.Equal(t, []posts.Mention{{TargetID: 5, UserID: 2}}, got)
+}
+
+func TestGetPostsByHashtag(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+	columns := []string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_users", "group_id"}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.Post
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(columns).
+					AddRow(uint(1), uint(2), "#go", tp.Now(), tp.Now(), uint(0), false, uint(0), pgtype.TextArray{}, pgtype.Int8Array{}, uint(0)).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "go", uint(0), uint(20), uint(1)).Return(rows, nil)
+			},
+			expected: []*domain.Post{
+				{
+					ID:        1,
+					AuthorID:  2,
+					Content:   "#go",
+					CreatedAt: customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetPostsByHashtag(context.Background(), 1, "go", 0, 20)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPostsByHashtag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetTrendingHashtags(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []posts.TrendingHashtag
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"hashtag", "uses_count"}).
+					AddRow("go", uint(3)).
+					AddRow("rust", uint(1)).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), tp.Now(), uint(10)).Return(rows, nil)
+			},
+			expected: []posts.TrendingHashtag{{Hashtag: "go", Count: 3}, {Hashtag: "rust", Count: 1}},
+			wantErr:  false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetTrendingHashtags(context.Background(), tp.Now(), 10)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTrendingHashtags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSearchHashtags(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []posts.TrendingHashtag
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"hashtag", "uses_count"}).
+					AddRow("go", uint(3)).
+					AddRow("golang", uint(1)).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "go", "", uint(10)).Return(rows, nil)
+			},
+			expected: []posts.TrendingHashtag{{Hashtag: "go", Count: 3}, {Hashtag: "golang", Count: 1}},
+			wantErr:  false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.SearchHashtags(context.Background(), "go", "", 10)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SearchHashtags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCountSearchHashtags(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "go").Return(pgxpoolmock.NewRow(uint(2)))
+
+	count, err := repo.CountSearchHashtags(context.Background(), "go")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), count)
+
+	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "go").Return(ErrInternalRow{})
+
+	_, err = repo.CountSearchHashtags(context.Background(), "go")
+	assert.Equal(t, errors.ErrInternal, err)
+}
@@ -0,0 +1,448 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+	customtime "socio/pkg/time"
+	"socio/pkg/utils"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// checkReportTargetQuery tells whether the target can be reported by the
+	// user: it exists and is not hidden yet, messages are reported only by
+	// the other members of the dialog or of the conversation.
+	checkReportTargetQuery = `
+	SELECT CASE $1::text
+		WHEN 'POST' THEN EXISTS (
+			SELECT 1
+			FROM public.post
+			WHERE id = $2
+				AND NOT is_hidden
+		)
+		WHEN 'COMMENT' THEN EXISTS (
+			SELECT 1
+			FROM public.comment
+			WHERE id = $2
+				AND NOT is_hidden
+		)
+		WHEN 'MESSAGE' THEN EXISTS (
+			SELECT 1
+			FROM public.personal_message AS pm
+				LEFT JOIN public.conversation_participant AS cp ON cp.conversation_id = pm.conversation_id
+				AND cp.user_id = $3
+			WHERE pm.id = $2
+				AND NOT pm.is_hidden
+				AND pm.sender_id <> $3
+				AND (
+					pm.receiver_id = $3
+					OR cp.user_id IS NOT NULL
+				)
+		)
+		WHEN 'STICKER' THEN EXISTS (
+			SELECT 1
+			FROM public.sticker
+			WHERE id = $2
+				AND NOT is_hidden
+		)
+		WHEN 'GROUP' THEN EXISTS (
+			SELECT 1
+			FROM public.public_group
+			WHERE id = $2
+		)
+		ELSE FALSE
+	END;
+	`
+	// storeReportQuery joins the report to the case of the target, the case
+	// is opened again if it was dismissed and a new user reports the target.
+	storeReportQuery = `
+	WITH report_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		VALUES ($1, $2)
+		ON CONFLICT (target_type, target_id) DO UPDATE
+		SET status = CASE
+				WHEN EXISTS (
+					SELECT 1
+					FROM public.report AS r
+					WHERE r.case_id = report_case.id
+						AND r.reporter_id = $3
+				) THEN report_case.status
+				ELSE 'OPEN'
+			END
+		RETURNING id,
+			target_type,
+			target_id
+	)
+	INSERT INTO public.report (case_id, reporter_id, reason, comment)
+	SELECT rc.id,
+		$3,
+		$4,
+		$5
+	FROM report_case AS rc
+	ON CONFLICT (case_id, reporter_id) DO NOTHING
+	RETURNING id,
+		case_id,
+		reporter_id,
+		reason,
+		comment,
+		created_at,
+		updated_at;
+	`
+	getOpenReportCasesQuery = `
+	SELECT rc.id,
+		rc.target_type,
+		rc.target_id,
+		rc.status,
+		COUNT(r.id) AS reports_count,
+		array_remove(array_agg(DISTINCT r.reason), NULL) AS reasons,
+		COALESCE(rc.resolved_by, 0),
+		rc.created_at,
+		rc.updated_at
+	FROM public.report_case AS rc
+		LEFT JOIN public.report AS r ON r.case_id = rc.id
+	WHERE rc.status = 'OPEN'
+		AND rc.id > $1
+	GROUP BY rc.id
+	ORDER BY rc.id
+	LIMIT $2;
+	`
+	resolveReportCasesQuery = `
+	UPDATE public.report_case
+	SET status = 'RESOLVED',
+		resolved_by = $3
+	WHERE target_type = $1
+		AND target_id = $2
+		AND status = 'OPEN';
+	`
+	dismissReportCaseQuery = `
+	UPDATE public.report_case
+	SET status = 'DISMISSED',
+		resolved_by = $2
+	WHERE id = $1
+		AND status = 'OPEN'
+	RETURNING id,
+		target_type,
+		target_id,
+		status,
+		COALESCE(resolved_by, 0),
+		created_at,
+		updated_at;
+	`
+	hidePostQuery = `
+	UPDATE public.post
+	SET is_hidden = TRUE
+	WHERE id = $1;
+	`
+	hideCommentQuery = `
+	UPDATE public.comment
+	SET is_hidden = TRUE
+	WHERE id = $1;
+	`
+	hideMessageQuery = `
+	UPDATE public.personal_message
+	SET is_hidden = TRUE
+	WHERE id = $1;
+	`
+	hideStickerQuery = `
+	UPDATE public.sticker
+	SET is_hidden = TRUE
+	WHERE id = $1;
+	`
+	showPostQuery = `
+	UPDATE public.post
+	SET is_hidden = FALSE
+	WHERE id = $1;
+	`
+	showCommentQuery = `
+	UPDATE public.comment
+	SET is_hidden = FALSE
+	WHERE id = $1;
+	`
+	showMessageQuery = `
+	UPDATE public.personal_message
+	SET is_hidden = FALSE
+	WHERE id = $1;
+	`
+	showStickerQuery = `
+	UPDATE public.sticker
+	SET is_hidden = FALSE
+	WHERE id = $1;
+	`
+	storeModerationLogEntryQuery = `
+	INSERT INTO public.moderation_log (moderator_id, action, target_type, target_id, reason)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id,
+		COALESCE(moderator_id, 0),
+		action,
+		target_type,
+		target_id,
+		reason,
+		created_at;
+	`
+	getModerationLogQuery = `
+	SELECT id,
+		COALESCE(moderator_id, 0),
+		action,
+		target_type,
+		target_id,
+		reason,
+		created_at
+	FROM public.moderation_log
+	WHERE $1 = 0 OR id < $1
+	ORDER BY id DESC
+	LIMIT $2;
+	`
+)
+
+var hideContentQueries = map[domain.ModerationTargetType]string{
+	domain.PostModerationTarget:    hidePostQuery,
+	domain.CommentModerationTarget: hideCommentQuery,
+	domain.MessageModerationTarget: hideMessageQuery,
+	domain.StickerModerationTarget: hideStickerQuery,
+}
+
+var showContentQueries = map[domain.ModerationTargetType]string{
+	domain.PostModerationTarget:    showPostQuery,
+	domain.CommentModerationTarget: showCommentQuery,
+	domain.MessageModerationTarget: showMessageQuery,
+	domain.StickerModerationTarget: showStickerQuery,
+}
+
+type Moderation struct {
+	db DBPool
+	TP customtime.TimeProvider
+}
+
+func NewModeration(db DBPool, tp customtime.TimeProvider) *Moderation {
+	return &Moderation{
+		db: db,
+		TP: tp,
+	}
+}
+
+func (m *Moderation) CheckReportTarget(ctx context.Context, reporterID uint, targetType domain.ModerationTargetType, targetID uint) (exists bool, err error) {
+	contextlogger.LogSQL(ctx, checkReportTargetQuery, targetType, targetID, reporterID)
+
+	err = m.db.QueryRow(context.Background(), checkReportTargetQuery, targetType, targetID, reporterID).Scan(&exists)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// StoreReport returns errors.ErrInvalidBody if the reporter has already
+// reported the target.
+func (m *Moderation) StoreReport(ctx context.Context, report *domain.Report) (newReport *domain.Report, err error) {
+	contextlogger.LogSQL(ctx, storeReportQuery, report.TargetType, report.TargetID, report.ReporterID, report.Reason, report.Comment)
+
+	newReport = &domain.Report{
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+	}
+
+	err = m.db.QueryRow(context.Background(), storeReportQuery,
+		report.TargetType,
+		report.TargetID,
+		report.ReporterID,
+		report.Reason,
+		report.Comment,
+	).Scan(
+		&newReport.ID,
+		&newReport.CaseID,
+		&newReport.ReporterID,
+		&newReport.Reason,
+		&newReport.Comment,
+		&newReport.CreatedAt.Time,
+		&newReport.UpdatedAt.Time,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrInvalidBody
+		}
+
+		return
+	}
+
+	return
+}
+
+func (m *Moderation) GetOpenReportCases(ctx context.Context, lastCaseID, casesAmount uint) (cases []*domain.ReportCase, err error) {
+	contextlogger.LogSQL(ctx, getOpenReportCasesQuery, lastCaseID, casesAmount)
+
+	rows, err := m.db.Query(context.Background(), getOpenReportCasesQuery, lastCaseID, casesAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		reportCase := new(domain.ReportCase)
+
+		var reasons pgtype.TextArray
+
+		err = rows.Scan(
+			&reportCase.ID,
+			&reportCase.TargetType,
+			&reportCase.TargetID,
+			&reportCase.Status,
+			&reportCase.ReportsCount,
+			&reasons,
+			&reportCase.ResolvedBy,
+			&reportCase.CreatedAt.Time,
+			&reportCase.UpdatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		for _, reason := range utils.TextArrayIntoStringSlice(reasons) {
+			reportCase.Reasons = append(reportCase.Reasons, domain.ReportReason(reason))
+		}
+
+		cases = append(cases, reportCase)
+	}
+
+	return
+}
+
+// ResolveReportCases closes the open case of the target the moderator acted
+// on, if there is one.
+func (m *Moderation) ResolveReportCases(ctx context.Context, targetType domain.ModerationTargetType, targetID, moderatorID uint) (err error) {
+	contextlogger.LogSQL(ctx, resolveReportCasesQuery, targetType, targetID, moderatorID)
+
+	_, err = m.db.Exec(context.Background(), resolveReportCasesQuery, targetType, targetID, moderatorID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (m *Moderation) DismissReportCase(ctx context.Context, caseID, moderatorID uint) (reportCase *domain.ReportCase, err error) {
+	contextlogger.LogSQL(ctx, dismissReportCaseQuery, caseID, moderatorID)
+
+	reportCase = new(domain.ReportCase)
+
+	err = m.db.QueryRow(context.Background(), dismissReportCaseQuery, caseID, moderatorID).Scan(
+		&reportCase.ID,
+		&reportCase.TargetType,
+		&reportCase.TargetID,
+		&reportCase.Status,
+		&reportCase.ResolvedBy,
+		&reportCase.CreatedAt.Time,
+		&reportCase.UpdatedAt.Time,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+		}
+
+		return
+	}
+
+	return
+}
+
+func (m *Moderation) HideContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) (err error) {
+	query, ok := hideContentQueries[targetType]
+	if !ok {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	contextlogger.LogSQL(ctx, query, targetID)
+
+	result, err := m.db.Exec(context.Background(), query, targetID)
+	if err != nil {
+		return
+	}
+
+	if result.RowsAffected() == 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
+	return
+}
+
+// ShowContent makes the content visible again, the content held by the
+// content filter is shown once its case is dismissed.
+func (m *Moderation) ShowContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) (err error) {
+	query, ok := showContentQueries[targetType]
+	if !ok {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	contextlogger.LogSQL(ctx, query, targetID)
+
+	_, err = m.db.Exec(context.Background(), query, targetID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (m *Moderation) StoreModerationLogEntry(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error) {
+	contextlogger.LogSQL(ctx, storeModerationLogEntryQuery, entry.ModeratorID, entry.Action, entry.TargetType, entry.TargetID, entry.Reason)
+
+	newEntry = new(domain.ModerationLogEntry)
+
+	err = m.db.QueryRow(context.Background(), storeModerationLogEntryQuery,
+		entry.ModeratorID,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		entry.Reason,
+	).Scan(
+		&newEntry.ID,
+		&newEntry.ModeratorID,
+		&newEntry.Action,
+		&newEntry.TargetType,
+		&newEntry.TargetID,
+		&newEntry.Reason,
+		&newEntry.CreatedAt.Time,
+	)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (m *Moderation) GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) (entries []*domain.ModerationLogEntry, err error) {
+	contextlogger.LogSQL(ctx, getModerationLogQuery, lastEntryID, entriesAmount)
+
+	rows, err := m.db.Query(context.Background(), getModerationLogQuery, lastEntryID, entriesAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := new(domain.ModerationLogEntry)
+
+		err = rows.Scan(
+			&entry.ID,
+			&entry.ModeratorID,
+			&entry.Action,
+			&entry.TargetType,
+			&entry.TargetID,
+			&entry.Reason,
+			&entry.CreatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return
+}
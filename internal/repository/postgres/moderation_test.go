@@ -0,0 +1,552 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	reportCaseColumns         = []string{"id", "target_type", "target_id", "status", "reports_count", "reasons", "resolved_by", "created_at", "updated_at"}
+	moderationLogEntryColumns = []string{"id", "moderator_id", "action", "target_type", "target_id", "reason", "created_at"}
+)
+
+func TestCheckReportTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Test exists",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), domain.MessageModerationTarget, uint(2), uint(1)).Return(pgxpoolmock.NewRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "Test not exists",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), domain.MessageModerationTarget, uint(2), uint(1)).Return(pgxpoolmock.NewRow(false))
+			},
+			expected: false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			got, err := repo.CheckReportTarget(context.Background(), 1, domain.MessageModerationTarget, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckReportTarget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestStoreReport(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.Report
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), uint(3), uint(1), domain.SpamReportReason, "ads", tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), domain.PostModerationTarget, uint(2), uint(1), domain.SpamReportReason, "ads").Return(row)
+			},
+			expected: &domain.Report{
+				ID:         1,
+				CaseID:     3,
+				ReporterID: 1,
+				TargetType: domain.PostModerationTarget,
+				TargetID:   2,
+				Reason:     domain.SpamReportReason,
+				Comment:    "ads",
+				CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test already reported",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrInvalidBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.StoreReport(context.Background(), &domain.Report{
+				ReporterID: 1,
+				TargetType: domain.PostModerationTarget,
+				TargetID:   2,
+				Reason:     domain.SpamReportReason,
+				Comment:    "ads",
+			})
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetOpenReportCases(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	reasons := pgtype.TextArray{
+		Elements: []pgtype.Text{
+			{String: "HARASSMENT", Status: pgtype.Present},
+			{String: "SPAM", Status: pgtype.Present},
+		},
+		Status: pgtype.Present,
+	}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.ReportCase
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(reportCaseColumns).
+					AddRow(uint(3), domain.CommentModerationTarget, uint(7), domain.OpenReportCase, uint(2), reasons, uint(0), tp.Now(), tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), uint(0), uint(20)).Return(rows, nil)
+			},
+			expected: []*domain.ReportCase{
+				{
+					ID:           3,
+					TargetType:   domain.CommentModerationTarget,
+					TargetID:     7,
+					Status:       domain.OpenReportCase,
+					ReportsCount: 2,
+					Reasons:      []domain.ReportReason{domain.HarassmentReportReason, domain.SpamReportReason},
+					CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetOpenReportCases(context.Background(), 0, 20)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetOpenReportCases() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveReportCases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), domain.PostModerationTarget, uint(2), uint(1)).Return(pgconn.CommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name: "Test no open case",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("UPDATE 0"), nil)
+			},
+		},
+		{
+			name: "Test exec error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.ResolveReportCases(context.Background(), domain.PostModerationTarget, 2, 1)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDismissReportCase(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.ReportCase
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(3), domain.GroupModerationTarget, uint(4), domain.DismissedReportCase, uint(1), tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(3), uint(1)).Return(row)
+			},
+			expected: &domain.ReportCase{
+				ID:         3,
+				TargetType: domain.GroupModerationTarget,
+				TargetID:   4,
+				Status:     domain.DismissedReportCase,
+				ResolvedBy: 1,
+				CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test not open",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.DismissReportCase(context.Background(), 3, 1)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHideContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		targetType domain.ModerationTargetType
+		mock       func(pool *pgxpoolmock.MockPgxIface)
+		wantErr    error
+	}{
+		{
+			name:       "Test OK",
+			targetType: domain.StickerModerationTarget,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(2)).Return(pgconn.CommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name:       "Test not found",
+			targetType: domain.PostModerationTarget,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(2)).Return(pgconn.CommandTag("UPDATE 0"), nil)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name:       "Test group can not be hidden",
+			targetType: domain.GroupModerationTarget,
+			mock:       func(pool *pgxpoolmock.MockPgxIface) {},
+			wantErr:    errors.ErrInvalidData,
+		},
+		{
+			name:       "Test exec error",
+			targetType: domain.CommentModerationTarget,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.HideContent(context.Background(), tt.targetType, 2)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestShowContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		targetType domain.ModerationTargetType
+		mock       func(pool *pgxpoolmock.MockPgxIface)
+		wantErr    error
+	}{
+		{
+			name:       "Test OK",
+			targetType: domain.MessageModerationTarget,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(2)).Return(pgconn.CommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name:       "Test group can not be shown",
+			targetType: domain.GroupModerationTarget,
+			mock:       func(pool *pgxpoolmock.MockPgxIface) {},
+			wantErr:    errors.ErrInvalidData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			err := repo.ShowContent(context.Background(), tt.targetType, 2)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestStoreModerationLogEntry(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.ModerationLogEntry
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(5), uint(1), domain.BanUserModerationAction, domain.UserModerationTarget, uint(2), "spam", tp.Now())
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), domain.BanUserModerationAction, domain.UserModerationTarget, uint(2), "spam").Return(row)
+			},
+			expected: &domain.ModerationLogEntry{
+				ID:          5,
+				ModeratorID: 1,
+				Action:      domain.BanUserModerationAction,
+				TargetType:  domain.UserModerationTarget,
+				TargetID:    2,
+				Reason:      "spam",
+				CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.StoreModerationLogEntry(context.Background(), &domain.ModerationLogEntry{
+				ModeratorID: 1,
+				Action:      domain.BanUserModerationAction,
+				TargetType:  domain.UserModerationTarget,
+				TargetID:    2,
+				Reason:      "spam",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreModerationLogEntry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetModerationLog(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.ModerationLogEntry
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(moderationLogEntryColumns).
+					AddRow(uint(6), uint(1), domain.DeleteGroupModerationAction, domain.GroupModerationTarget, uint(4), "", tp.Now()).
+					AddRow(uint(5), uint(0), domain.HideContentModerationAction, domain.PostModerationTarget, uint(2), "spam", tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), uint(0), uint(20)).Return(rows, nil)
+			},
+			expected: []*domain.ModerationLogEntry{
+				{
+					ID:          6,
+					ModeratorID: 1,
+					Action:      domain.DeleteGroupModerationAction,
+					TargetType:  domain.GroupModerationTarget,
+					TargetID:    4,
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+				{
+					ID:         5,
+					Action:     domain.HideContentModerationAction,
+					TargetType: domain.PostModerationTarget,
+					TargetID:   2,
+					Reason:     "spam",
+					CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewModeration(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetModerationLog(context.Background(), 0, 20)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetModerationLog() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
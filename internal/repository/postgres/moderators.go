@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+)
+
+const (
+	checkIfUserIsModeratorQuery = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM public.moderator
+		WHERE user_id = $1
+	);
+	`
+	// storeUserBanQuery replaces the reason and the moderator of the ban if
+	// the user is already banned.
+	storeUserBanQuery = `
+	INSERT INTO public.user_ban (user_id, moderator_id, reason)
+	VALUES ($1, NULLIF($2::bigint, 0), $3)
+	ON CONFLICT (user_id) DO UPDATE
+	SET moderator_id = EXCLUDED.moderator_id,
+		reason = EXCLUDED.reason
+	RETURNING id,
+		user_id,
+		COALESCE(moderator_id, 0),
+		reason,
+		created_at,
+		updated_at;
+	`
+	checkIfUserIsBannedQuery = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM public.user_ban
+		WHERE user_id = $1
+	);
+	`
+)
+
+func (s *Users) CheckIfUserIsModerator(ctx context.Context, userID uint) (isModerator bool, err error) {
+	contextlogger.LogSQL(ctx, checkIfUserIsModeratorQuery, userID)
+
+	err = s.db.QueryRow(context.Background(), checkIfUserIsModeratorQuery, userID).Scan(&isModerator)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Users) StoreUserBan(ctx context.Context, ban *domain.UserBan) (newBan *domain.UserBan, err error) {
+	contextlogger.LogSQL(ctx, storeUserBanQuery, ban.UserID, ban.ModeratorID, ban.Reason)
+
+	newBan = new(domain.UserBan)
+
+	err = s.db.QueryRow(context.Background(), storeUserBanQuery,
+		ban.UserID,
+		ban.ModeratorID,
+		ban.Reason,
+	).Scan(
+		&newBan.ID,
+		&newBan.UserID,
+		&newBan.ModeratorID,
+		&newBan.Reason,
+		&newBan.CreatedAt.Time,
+		&newBan.UpdatedAt.Time,
+	)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Users) CheckIfUserIsBanned(ctx context.Context, userID uint) (isBanned bool, err error) {
+	contextlogger.LogSQL(ctx, checkIfUserIsBannedQuery, userID)
+
+	err = s.db.QueryRow(context.Background(), checkIfUserIsBannedQuery, userID).Scan(&isBanned)
+	if err != nil {
+		return
+	}
+
+	return
+}
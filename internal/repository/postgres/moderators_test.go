@@ -0,0 +1,173 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckIfUserIsModerator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Test moderator",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(pgxpoolmock.NewRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "Test not moderator",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(pgxpoolmock.NewRow(false))
+			},
+			expected: false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
+
+			tt.mock(pool)
+
+			got, err := users.CheckIfUserIsModerator(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckIfUserIsModerator() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestStoreUserBan(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.UserBan
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), uint(2), uint(1), "spam", tp.Now(), tp.Now())
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(2), uint(1), "spam").Return(row)
+			},
+			expected: &domain.UserBan{
+				ID:          1,
+				UserID:      2,
+				ModeratorID: 1,
+				Reason:      "spam",
+				CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+			},
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, tp, nil)
+
+			tt.mock(pool)
+
+			got, err := users.StoreUserBan(context.Background(), &domain.UserBan{UserID: 2, ModeratorID: 1, Reason: "spam"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreUserBan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckIfUserIsBanned(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Test banned",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(2)).Return(pgxpoolmock.NewRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
+
+			tt.mock(pool)
+
+			got, err := users.CheckIfUserIsBanned(context.Background(), 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckIfUserIsBanned() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
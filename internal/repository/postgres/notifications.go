@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	customtime "socio/pkg/time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// storeNotificationsQuery picks the receivers by the type of the event and
+	// joins the event to their unread notification about the same target, the
+	// actor is moved to the end of actor_ids, so the last one is the latest.
+	// The mentioned users are marked notified, so they are picked only once.
+	storeNotificationsQuery = `
+	WITH notified_post_mention AS (
+		UPDATE public.post_mention
+		SET is_notified = TRUE
+		WHERE $1::text = 'POST_MENTION'
+			AND post_id = $3
+			AND NOT is_notified
+		RETURNING user_id
+	),
+	notified_comment_mention AS (
+		UPDATE public.comment_mention
+		SET is_notified = TRUE
+		WHERE $1::text = 'COMMENT_MENTION'
+			AND comment_id = $3
+			AND NOT is_notified
+		RETURNING user_id
+	),
+	receiver AS (
+		SELECT p.author_id AS user_id
+		FROM public.post AS p
+		WHERE $1::text IN ('POST_LIKE', 'COMMENT')
+			AND p.id = $3
+		UNION
+		SELECT u.id AS user_id
+		FROM public.user AS u
+		WHERE $1::text = 'SUBSCRIPTION'
+			AND u.id = $3
+		UNION
+		SELECT pgs.subscriber_id AS user_id
+		FROM public.public_group_subscription AS pgs
+		WHERE $1::text = 'GROUP_POST'
+			AND pgs.public_group_id = $3
+		UNION
+		SELECT pm.user_id
+		FROM notified_post_mention AS pm
+		UNION
+		SELECT cm.user_id
+		FROM notified_comment_mention AS cm
+	)
+	INSERT INTO public.notification (user_id, type, target_id, actor_ids)
+	SELECT r.user_id,
+		$1::text,
+		$3,
+		ARRAY[$2::bigint]
+	FROM receiver AS r
+	WHERE r.user_id <> $2
+	ON CONFLICT (user_id, type, target_id) WHERE NOT is_read DO UPDATE
+	SET id = nextval(pg_get_serial_sequence('public.notification', 'id')),
+		actor_ids = array_append(array_remove(notification.actor_ids, $2::bigint), $2::bigint),
+		events_count = notification.events_count + 1
+	RETURNING id,
+		user_id,
+		type,
+		target_id,
+		COALESCE(actor_ids[cardinality(actor_ids)], 0),
+		cardinality(actor_ids),
+		events_count,
+		is_read,
+		created_at,
+		updated_at;
+	`
+	getNotificationsQuery = `
+	SELECT id,
+		user_id,
+		type,
+		target_id,
+		COALESCE(actor_ids[cardinality(actor_ids)], 0),
+		cardinality(actor_ids),
+		events_count,
+		is_read,
+		created_at,
+		updated_at
+	FROM public.notification
+	WHERE user_id = $1
+		AND ($2 = 0 OR id < $2)
+	ORDER BY id DESC
+	LIMIT $3;
+	`
+	getUnreadNotificationsCountQuery = `
+	SELECT COUNT(*)
+	FROM public.notification
+	WHERE user_id = $1
+		AND NOT is_read;
+	`
+	markNotificationsReadQuery = `
+	UPDATE public.notification
+	SET is_read = TRUE
+	WHERE user_id = $1
+		AND id = ANY($2::bigint[])
+		AND NOT is_read;
+	`
+	markAllNotificationsReadQuery = `
+	UPDATE public.notification
+	SET is_read = TRUE
+	WHERE user_id = $1
+		AND NOT is_read;
+	`
+)
+
+type Notifications struct {
+	db DBPool
+	TP customtime.TimeProvider
+}
+
+func NewNotifications(db DBPool, tp customtime.TimeProvider) *Notifications {
+	return &Notifications{
+		db: db,
+		TP: tp,
+	}
+}
+
+func (n *Notifications) StoreNotifications(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error) {
+	contextlogger.LogSQL(ctx, storeNotificationsQuery, notificationType, actorID, targetID)
+
+	rows, err := n.db.Query(context.Background(), storeNotificationsQuery, notificationType, actorID, targetID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		notification := new(domain.Notification)
+
+		err = rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.TargetID,
+			&notification.LastActorID,
+			&notification.ActorsCount,
+			&notification.EventsCount,
+			&notification.IsRead,
+			&notification.CreatedAt.Time,
+			&notification.UpdatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return
+}
+
+func (n *Notifications) GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) (notifications []*domain.Notification, err error) {
+	contextlogger.LogSQL(ctx, getNotificationsQuery, userID, lastNotificationID, notificationsAmount)
+
+	rows, err := n.db.Query(context.Background(), getNotificationsQuery, userID, lastNotificationID, notificationsAmount)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		notification := new(domain.Notification)
+
+		err = rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.TargetID,
+			&notification.LastActorID,
+			&notification.ActorsCount,
+			&notification.EventsCount,
+			&notification.IsRead,
+			&notification.CreatedAt.Time,
+			&notification.UpdatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return
+}
+
+func (n *Notifications) GetUnreadNotificationsCount(ctx context.Context, userID uint) (count uint, err error) {
+	contextlogger.LogSQL(ctx, getUnreadNotificationsCountQuery, userID)
+
+	err = n.db.QueryRow(context.Background(), getUnreadNotificationsCountQuery, userID).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (n *Notifications) MarkNotificationsRead(ctx context.Context, userID uint, notificationIDs []uint) (err error) {
+	notificationIDsPGArr := pq.Array(notificationIDs)
+
+	contextlogger.LogSQL(ctx, markNotificationsReadQuery, userID, notificationIDsPGArr)
+
+	_, err = n.db.Exec(context.Background(), markNotificationsReadQuery, userID, notificationIDsPGArr)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (n *Notifications) MarkAllNotificationsRead(ctx context.Context, userID uint) (err error) {
+	contextlogger.LogSQL(ctx, markAllNotificationsReadQuery, userID)
+
+	_, err = n.db.Exec(context.Background(), markAllNotificationsReadQuery, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
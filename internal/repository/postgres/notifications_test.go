@@ -0,0 +1,333 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	notificationColumns = []string{"id", "user_id", "type", "target_id", "last_actor_id", "actors_count", "events_count", "is_read", "created_at", "updated_at"}
+)
+
+func TestStoreNotifications(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.Notification
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(notificationColumns).
+					AddRow(uint(3), uint(2), domain.GroupPostNotification, uint(1), uint(5), uint(2), uint(3), false, tp.Now(), tp.Now()).
+					AddRow(uint(4), uint(3), domain.GroupPostNotification, uint(1), uint(5), uint(1), uint(1), false, tp.Now(), tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), domain.GroupPostNotification, uint(5), uint(1)).Return(rows, nil)
+			},
+			expected: []*domain.Notification{
+				{
+					ID:          3,
+					UserID:      2,
+					Type:        domain.GroupPostNotification,
+					TargetID:    1,
+					LastActorID: 5,
+					ActorsCount: 2,
+					EventsCount: 3,
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+				{
+					ID:          4,
+					UserID:      3,
+					Type:        domain.GroupPostNotification,
+					TargetID:    1,
+					LastActorID: 5,
+					ActorsCount: 1,
+					EventsCount: 1,
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewNotifications(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.StoreNotifications(context.Background(), domain.GroupPostNotification, 5, 1)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreNotifications() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetNotifications(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.Notification
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(notificationColumns).
+					AddRow(uint(7), uint(1), domain.PostLikeNotification, uint(2), uint(3), uint(6), uint(6), true, tp.Now(), tp.Now()).
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), uint(1), uint(10), uint(20)).Return(rows, nil)
+			},
+			expected: []*domain.Notification{
+				{
+					ID:          7,
+					UserID:      1,
+					Type:        domain.PostLikeNotification,
+					TargetID:    2,
+					LastActorID: 3,
+					ActorsCount: 6,
+					EventsCount: 6,
+					IsRead:      true,
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewNotifications(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetNotifications(context.Background(), 1, 10, 20)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNotifications() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetUnreadNotificationsCount(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected uint
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(4))
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(row)
+			},
+			expected: 4,
+			wantErr:  false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(ErrInternalRow{})
+			},
+			expected: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewNotifications(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetUnreadNotificationsCount(context.Background(), 1)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUnreadNotificationsCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), gomock.Any()).Return(pgconn.CommandTag("UPDATE 2"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewNotifications(pool, tp)
+
+			tt.mock(pool)
+
+			err := repo.MarkNotificationsRead(context.Background(), 1, []uint{2, 3})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarkNotificationsRead() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarkAllNotificationsRead(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1)).Return(pgconn.CommandTag("UPDATE 5"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewNotifications(pool, tp)
+
+			tt.mock(pool)
+
+			err := repo.MarkAllNotificationsRead(context.Background(), 1)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarkAllNotificationsRead() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -49,6 +49,7 @@ var (
 		"updated_at",
 		"sticker_id",
 		"attachments",
+		"unread_count",
 	}
 )
 
@@ -71,13 +72,14 @@ func TestGetMessageByID(t *testing.T) {
 			name:  "test case 1",
 			msgID: 1,
 			want: &domain.PersonalMessage{
-				ID:          1,
-				SenderID:    1,
-				ReceiverID:  2,
-				Content:     "Test content",
-				CreatedAt:   customtime.CustomTime{Time: tp.Now()},
-				UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
-				Attachments: []string{"attachment1", "attachment2"},
+				ID:             1,
+				SenderID:       1,
+				ReceiverID:     2,
+				ConversationID: 1,
+				Content:        "Test content",
+				CreatedAt:      customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:      customtime.CustomTime{Time: tp.Now()},
+				Attachments:    []string{"attachment1", "attachment2"},
 				Sticker: &domain.Sticker{
 					ID:        1,
 					AuthorID:  1,
@@ -89,7 +91,7 @@ func TestGetMessageByID(t *testing.T) {
 			},
 			wantErr: false,
 			setup: func() {
-				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now(), uint(1), pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present})
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", tp.Now(), tp.Now(), uint(1), pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present}, false)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
 				stickerRow := pgxpoolmock.NewRow(uint(1), uint(1), "Test sticker", "sticker.jpg", tp.Now(), tp.Now())
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(stickerRow)
@@ -110,11 +112,30 @@ func TestGetMessageByID(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 			setup: func() {
-				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now(), uint(1), pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present})
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", tp.Now(), tp.Now(), uint(1), pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present}, false)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
+		{
+			name:  "test case 4 - held",
+			msgID: 1,
+			want: &domain.PersonalMessage{
+				ID:          1,
+				SenderID:    1,
+				ReceiverID:  2,
+				Content:     "Test content",
+				CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				Attachments: []string{"attachment1"},
+				IsHidden:    true,
+			},
+			wantErr: false,
+			setup: func() {
+				row := pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(0), "Test content", tp.Now(), tp.Now(), uint(0), pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}}, Status: pgtype.Present}, true)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(row)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -358,6 +379,7 @@ func TestGetDialogsByUserID(t *testing.T) {
 							UpdatedAt: customtime.CustomTime{Time: tp.Now()},
 						},
 					},
+					UnreadCount: 3,
 				},
 			},
 			wantErr: false,
@@ -386,7 +408,8 @@ func TestGetDialogsByUserID(t *testing.T) {
 					tp.Now(),
 					tp.Now(),
 					uint(1),
-					pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present}).ToPgxRows()
+					pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present},
+					uint(3)).ToPgxRows()
 				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
 				stickerRow := pgxpoolmock.NewRow(uint(1), uint(1), "Test sticker", "sticker.jpg", tp.Now(), tp.Now())
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(stickerRow)
@@ -422,7 +445,8 @@ func TestGetDialogsByUserID(t *testing.T) {
 					tp.Now(),
 					tp.Now(),
 					uint(1),
-					pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present}).ToPgxRows()
+					pgtype.TextArray{Elements: []pgtype.Text{{String: "attachment1", Status: pgtype.Present}, {String: "attachment2", Status: pgtype.Present}}, Status: pgtype.Present},
+					uint(3)).ToPgxRows()
 				mockDB.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any()).Return(rows, nil)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
@@ -489,18 +513,19 @@ func TestStoreMessage(t *testing.T) {
 				Attachments: []string{"attachment1", "attachment2"},
 			},
 			want: &domain.PersonalMessage{
-				ID:          1,
-				SenderID:    1,
-				ReceiverID:  2,
-				Content:     "Test content",
-				CreatedAt:   customtime.CustomTime{Time: tp.Now()},
-				UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
-				Attachments: []string{"attachment1", "attachment2"},
+				ID:             1,
+				SenderID:       1,
+				ReceiverID:     2,
+				ConversationID: 1,
+				Content:        "Test content",
+				CreatedAt:      customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:      customtime.CustomTime{Time: tp.Now()},
+				Attachments:    []string{"attachment1", "attachment2"},
 			},
 			wantErr: false,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -519,7 +544,7 @@ func TestStoreMessage(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -538,7 +563,7 @@ func TestStoreMessage(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(errors.ErrInternal)
@@ -556,7 +581,7 @@ func TestStoreMessage(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
@@ -572,7 +597,7 @@ func TestStoreMessage(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
 		{
@@ -646,7 +671,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr: false,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -667,7 +692,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -688,7 +713,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(errors.ErrInternal)
@@ -708,7 +733,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Exec(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
@@ -727,7 +752,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "Updated content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
@@ -745,7 +770,7 @@ func TestUpdateMessage(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
 		{
@@ -764,6 +789,30 @@ func TestUpdateMessage(t *testing.T) {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
+		{
+			name: "test case 8 - held",
+			msg: &domain.PersonalMessage{
+				ID:       1,
+				Content:  "https://a.com https://b.com",
+				IsHidden: true,
+			},
+			want: &domain.PersonalMessage{
+				ID:         1,
+				SenderID:   1,
+				ReceiverID: 2,
+				Content:    "https://a.com https://b.com",
+				IsHidden:   true,
+				CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+			},
+			wantErr: false,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), "https://a.com https://b.com", uint(1), true).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(2), "https://a.com https://b.com", true, tp.Now(), tp.Now()))
+				mockDB.EXPECT().Commit(context.Background()).Return(nil)
+				mockDB.EXPECT().Rollback(context.Background()).Return(nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -849,3 +898,72 @@ func TestDeleteMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateLastReadMessageID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name      string
+		userID    uint
+		peerID    uint
+		messageID uint
+		want      uint
+		wantErr   error
+		setup     func()
+	}{
+		{
+			name:      "test case 1",
+			userID:    1,
+			peerID:    2,
+			messageID: 5,
+			want:      5,
+			wantErr:   nil,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), uint(1), uint(2), uint(5)).Return(pgxpoolmock.NewRow(uint(5)))
+			},
+		},
+		{
+			name:      "test case 2 - already read further",
+			userID:    1,
+			peerID:    2,
+			messageID: 3,
+			want:      5,
+			wantErr:   nil,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), uint(1), uint(2), uint(3)).Return(pgxpoolmock.NewRow(uint(5)))
+			},
+		},
+		{
+			name:      "test case 3 - message not in dialog",
+			userID:    1,
+			peerID:    2,
+			messageID: 7,
+			want:      0,
+			wantErr:   errors.ErrNotFound,
+			setup: func() {
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), uint(1), uint(2), uint(7)).Return(ErrRow{})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			pm := repository.NewPersonalMessages(mockDB, tp)
+
+			got, err := pm.UpdateLastReadMessageID(context.Background(), tt.userID, tt.peerID, tt.messageID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
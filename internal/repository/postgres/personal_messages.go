@@ -16,22 +16,26 @@ const (
 	getMessageByIdQuery = `
 	SELECT pm.id,
 		pm.sender_id,
-		pm.receiver_id,
+		COALESCE(pm.receiver_id, 0),
+		COALESCE(pm.conversation_id, 0),
 		pm.content,
 		pm.created_at,
 		pm.updated_at,
 		COALESCE(pm.sticker_id, 0),
-		array_agg(DISTINCT ma.file_name) AS attachments
+		array_agg(DISTINCT ma.file_name) AS attachments,
+		pm.is_hidden
 	FROM public.personal_message AS pm
 	LEFT JOIN public.message_attachment AS ma ON pm.id = ma.message_id
 	WHERE pm.id = $1
 	GROUP BY pm.id,
 		pm.sender_id,
 		pm.receiver_id,
+		pm.conversation_id,
 		pm.content,
 		pm.created_at,
 		pm.updated_at,
-		pm.sticker_id;
+		pm.sticker_id,
+		pm.is_hidden;
 	`
 	getMessagesByDialogQuery = `
 	SELECT pm.id,
@@ -55,6 +59,7 @@ const (
 			)
 		)
 		AND pm.id < $3
+		AND NOT pm.is_hidden
 	GROUP BY pm.id,
 		pm.sender_id,
 		pm.receiver_id,
@@ -79,6 +84,9 @@ const (
 			)
 		);
 	`
+	canSendMessageQuery = `
+	SELECT public.can_send_message($1, $2);
+	`
 	getDialogsByUserIDQuery = `
 	SELECT u1.id,
 		u1.first_name,
@@ -103,7 +111,16 @@ const (
 		pm1.created_at,
 		pm1.updated_at,
 		COALESCE(pm1.sticker_id, 0),
-		array_agg(DISTINCT ma.file_name) AS attachments
+		array_agg(DISTINCT ma.file_name) AS attachments,
+		(
+			SELECT COUNT(*)
+			FROM public.conversation_participant AS cp
+				JOIN public.personal_message AS pm3 ON cp.conversation_id = pm3.conversation_id
+			WHERE cp.conversation_id = pm1.conversation_id
+				AND cp.user_id = $1
+				AND pm3.sender_id <> $1
+				AND pm3.id > cp.last_read_message_id
+		) AS unread_count
 	FROM public.user AS u1
 		JOIN public.personal_message AS pm1 ON u1.id = pm1.sender_id
 		JOIN public.user AS u2 ON pm1.receiver_id = u2.id
@@ -146,18 +163,99 @@ const (
 		pm1.content,
 		pm1.created_at,
 		pm1.updated_at,
-		pm1.sticker_id
+		pm1.sticker_id,
+		pm1.conversation_id
 	ORDER BY pm1.created_at DESC;
 	`
 	storePersonalMessageQuery = `
-	INSERT INTO public.personal_message (sender_id, receiver_id, content)
-	VALUES ($1, $2, $3)
-	RETURNING id,
+	WITH dialog AS (
+		INSERT INTO public.conversation (dialog_user1_id, dialog_user2_id)
+		VALUES (LEAST($1::BIGINT, $2::BIGINT), GREATEST($1::BIGINT, $2::BIGINT))
+		ON CONFLICT (dialog_user1_id, dialog_user2_id) DO UPDATE
+		SET updated_at = now()
+		RETURNING id
+	),
+	dialog_participant AS (
+		INSERT INTO public.conversation_participant (conversation_id, user_id)
+		SELECT DISTINCT dialog.id,
+			participant.id
+		FROM dialog,
+			unnest(ARRAY [$1, $2]::BIGINT []) AS participant(id)
+		ON CONFLICT DO NOTHING
+	),
+	new_message AS (
+		INSERT INTO public.personal_message (sender_id, receiver_id, conversation_id, content, is_hidden)
+		SELECT $1,
+			$2,
+			dialog.id,
+			$3,
+			$4
+		FROM dialog
+		RETURNING id,
+			sender_id,
+			receiver_id,
+			conversation_id,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'MESSAGE',
+			id
+		FROM new_message
+		WHERE is_hidden
+	)
+	SELECT id,
 		sender_id,
 		receiver_id,
+		conversation_id,
+		content,
+		is_hidden,
+		created_at,
+		updated_at
+	FROM new_message;
+	`
+	storeConversationMessageQuery = `
+	WITH new_message AS (
+		INSERT INTO public.personal_message (sender_id, receiver_id, conversation_id, content, is_hidden)
+		SELECT $1,
+			CASE
+				WHEN c.is_group THEN NULL
+				WHEN c.dialog_user1_id = $1 THEN c.dialog_user2_id
+				ELSE c.dialog_user1_id
+			END,
+			c.id,
+			$3,
+			$4
+		FROM public.conversation AS c
+		WHERE c.id = $2
+		RETURNING id,
+			sender_id,
+			receiver_id,
+			conversation_id,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'MESSAGE',
+			id
+		FROM new_message
+		WHERE is_hidden
+	)
+	SELECT id,
+		sender_id,
+		COALESCE(receiver_id, 0),
+		conversation_id,
 		content,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM new_message;
 	`
 	storeMessageAttachmentQuery = `
 	INSERT INTO public.message_attachment (message_id, file_name)
@@ -166,18 +264,58 @@ const (
 	`
 	deleteMessageAttachmentQuery = `
 	DELETE FROM public.message_attachment
-	WHERE file_name = $1;
+	WHERE message_id = $1
+		AND file_name = $2;
+	`
+	updateLastReadMessageIDQuery = `
+	UPDATE public.conversation_participant AS cp
+	SET last_read_message_id = GREATEST(cp.last_read_message_id, $3)
+	FROM public.conversation AS c
+	WHERE cp.conversation_id = c.id
+		AND cp.user_id = $1
+		AND NOT c.is_group
+		AND c.dialog_user1_id = LEAST($1::BIGINT, $2::BIGINT)
+		AND c.dialog_user2_id = GREATEST($1::BIGINT, $2::BIGINT)
+		AND EXISTS (
+			SELECT 1
+			FROM public.personal_message AS pm
+			WHERE pm.id = $3
+				AND pm.conversation_id = c.id
+		)
+	RETURNING cp.last_read_message_id;
 	`
 	updatePersonalMessageQuery = `
-	UPDATE public.personal_message
-	SET content = $1
-	WHERE id = $2
-	RETURNING id,
+	WITH updated_message AS (
+		UPDATE public.personal_message
+		SET content = $1,
+			is_hidden = is_hidden OR $3
+		WHERE id = $2
+		RETURNING id,
+			sender_id,
+			receiver_id,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'MESSAGE',
+			id
+		FROM updated_message
+		WHERE $3
+		ON CONFLICT (target_type, target_id) DO UPDATE
+		SET status = 'OPEN',
+			resolved_by = NULL
+	)
+	SELECT id,
 		sender_id,
-		receiver_id,
+		COALESCE(receiver_id, 0),
 		content,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM updated_message;
 	`
 	deletePersonalMessageQuery = `
 	DELETE FROM public.personal_message
@@ -208,11 +346,13 @@ func (pm *PersonalMessages) GetMessageByID(ctx context.Context, msgID uint) (msg
 		&msg.ID,
 		&msg.SenderID,
 		&msg.ReceiverID,
+		&msg.ConversationID,
 		&msg.Content,
 		&msg.CreatedAt.Time,
 		&msg.UpdatedAt.Time,
 		&sticker.ID,
 		&attachments,
+		&msg.IsHidden,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -247,6 +387,17 @@ func (pm *PersonalMessages) GetLastMessageID(ctx context.Context, senderID, rece
 	return
 }
 
+func (pm *PersonalMessages) CanSendMessage(ctx context.Context, senderID, receiverID uint) (allowed bool, err error) {
+	contextlogger.LogSQL(ctx, canSendMessageQuery, senderID, receiverID)
+
+	err = pm.db.QueryRow(context.Background(), canSendMessageQuery, senderID, receiverID).Scan(&allowed)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 func (pm *PersonalMessages) GetMessagesByDialog(ctx context.Context, senderID, receiverID, lastMessageID, messagesAmount uint) (messages []*domain.PersonalMessage, err error) {
 	contextlogger.LogSQL(ctx, getMessagesByDialogQuery, senderID, receiverID, lastMessageID, messagesAmount)
 
@@ -336,6 +487,7 @@ func (pm *PersonalMessages) GetDialogsByUserID(ctx context.Context, userID uint)
 			&lastMessage.UpdatedAt.Time,
 			&sticker.ID,
 			&attachments,
+			&dialog.UnreadCount,
 		)
 		if err != nil {
 			return
@@ -362,6 +514,24 @@ func (pm *PersonalMessages) GetDialogsByUserID(ctx context.Context, userID uint)
 	return
 }
 
+// UpdateLastReadMessageID marks the dialog with peerID as read by userID up to
+// messageID. The read position never moves backwards, the resulting position
+// is returned.
+func (pm *PersonalMessages) UpdateLastReadMessageID(ctx context.Context, userID, peerID, messageID uint) (lastReadMessageID uint, err error) {
+	contextlogger.LogSQL(ctx, updateLastReadMessageIDQuery, userID, peerID, messageID)
+
+	err = pm.db.QueryRow(context.Background(), updateLastReadMessageIDQuery, userID, peerID, messageID).Scan(&lastReadMessageID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+		}
+
+		return
+	}
+
+	return
+}
+
 func (pm *PersonalMessages) StoreMessage(ctx context.Context, msg *domain.PersonalMessage) (newMsg *domain.PersonalMessage, err error) {
 	tx, err := pm.db.BeginTx(context.Background(), pgx.TxOptions{})
 
@@ -380,18 +550,26 @@ func (pm *PersonalMessages) StoreMessage(ctx context.Context, msg *domain.Person
 		err = nil
 	}()
 
-	contextlogger.LogSQL(ctx, storePersonalMessageQuery, msg.SenderID, msg.ReceiverID, msg.Content)
+	query, target := storePersonalMessageQuery, msg.ReceiverID
+	if msg.ConversationID != 0 {
+		query, target = storeConversationMessageQuery, msg.ConversationID
+	}
+
+	contextlogger.LogSQL(ctx, query, msg.SenderID, target, msg.Content, msg.IsHidden)
 
 	newMsg = new(domain.PersonalMessage)
-	err = pm.db.QueryRow(context.Background(), storePersonalMessageQuery,
+	err = pm.db.QueryRow(context.Background(), query,
 		msg.SenderID,
-		msg.ReceiverID,
+		target,
 		msg.Content,
+		msg.IsHidden,
 	).Scan(
 		&newMsg.ID,
 		&newMsg.SenderID,
 		&newMsg.ReceiverID,
+		&newMsg.ConversationID,
 		&newMsg.Content,
+		&newMsg.IsHidden,
 		&newMsg.CreatedAt.Time,
 		&newMsg.UpdatedAt.Time,
 	)
@@ -437,14 +615,15 @@ func (pm *PersonalMessages) UpdateMessage(ctx context.Context, msg *domain.Perso
 		err = nil
 	}()
 
-	contextlogger.LogSQL(ctx, updatePersonalMessageQuery, msg.Content, msg.ID)
+	contextlogger.LogSQL(ctx, updatePersonalMessageQuery, msg.Content, msg.ID, msg.IsHidden)
 
 	updatedMsg = new(domain.PersonalMessage)
-	err = pm.db.QueryRow(context.Background(), updatePersonalMessageQuery, msg.Content, msg.ID).Scan(
+	err = pm.db.QueryRow(context.Background(), updatePersonalMessageQuery, msg.Content, msg.ID, msg.IsHidden).Scan(
 		&updatedMsg.ID,
 		&updatedMsg.SenderID,
 		&updatedMsg.ReceiverID,
 		&updatedMsg.Content,
+		&updatedMsg.IsHidden,
 		&updatedMsg.CreatedAt.Time,
 		&updatedMsg.UpdatedAt.Time,
 	)
@@ -465,9 +644,9 @@ func (pm *PersonalMessages) UpdateMessage(ctx context.Context, msg *domain.Perso
 	}
 
 	for _, attach := range attachmentsToDelete {
-		contextlogger.LogSQL(ctx, deleteMessageAttachmentQuery, attach)
+		contextlogger.LogSQL(ctx, deleteMessageAttachmentQuery, msg.ID, attach)
 
-		_, err = tx.Exec(context.Background(), deleteMessageAttachmentQuery, attach)
+		_, err = tx.Exec(context.Background(), deleteMessageAttachmentQuery, msg.ID, attach)
 		if err != nil {
 			return
 		}
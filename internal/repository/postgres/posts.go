@@ -8,6 +8,7 @@ import (
 	customtime "socio/pkg/time"
 	"socio/pkg/utils"
 	"socio/usecase/posts"
+	"time"
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
@@ -21,8 +22,16 @@ const (
         p.content,
         p.created_at,
         p.updated_at,
+        COALESCE(p.repost_of_id, 0) AS repost_of_id,
+        p.is_repost,
+        (
+        	SELECT COUNT(*)
+        	FROM public.post AS rp
+        	WHERE rp.repost_of_id = p.id
+        ) AS shares_count,
         array_agg(DISTINCT pa.file_name) AS attachments,
-        array_agg(DISTINCT pl.user_id) AS liked_by_users
+        array_agg(DISTINCT pl.user_id) AS liked_by_users,
+        p.is_hidden
     FROM public.post AS p
         LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
         LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
@@ -46,6 +55,13 @@ const (
         p.content,
         p.created_at,
         p.updated_at,
+        COALESCE(p.repost_of_id, 0) AS repost_of_id,
+        p.is_repost,
+        (
+        	SELECT COUNT(*)
+        	FROM public.post AS rp
+        	WHERE rp.repost_of_id = p.id
+        ) AS shares_count,
         array_agg(DISTINCT pa.file_name) AS attachments,
         array_agg(DISTINCT pl.user_id) AS liked_by_users
     FROM public.post AS p
@@ -55,6 +71,8 @@ const (
     WHERE p.author_id = $1
         AND p.id < $2
 		AND pgp.post_id IS NULL
+		AND NOT p.is_hidden
+		AND public.can_view_posts(p.author_id, $4)
     GROUP BY p.id,
         p.author_id,
         p.content,
@@ -75,6 +93,13 @@ const (
         p.content,
         p.created_at,
         p.updated_at,
+        COALESCE(p.repost_of_id, 0) AS repost_of_id,
+        p.is_repost,
+        (
+        	SELECT COUNT(*)
+        	FROM public.post AS rp
+        	WHERE rp.repost_of_id = p.id
+        ) AS shares_count,
         array_agg(DISTINCT pa.file_name) AS attachments,
         array_agg(DISTINCT pl.user_id) AS liked_by_users
     FROM public.post AS p
@@ -85,6 +110,8 @@ const (
     WHERE s.subscriber_id = $1
         AND p.id < $2
 		AND pgp.post_id IS NULL
+		AND NOT p.is_hidden
+		AND public.can_view_posts(p.author_id, $1)
     GROUP BY p.id,
         p.content,
         p.created_at,
@@ -92,14 +119,37 @@ const (
     ORDER BY p.created_at DESC
     LIMIT $3;
 	`
+	// StorePostQuery opens the case of the post held by the content filter in
+	// the same statement, so the post is never visible before it is reviewed.
 	StorePostQuery = `
-	INSERT INTO public.post (author_id, content)
-	VALUES ($1, $2)
-	RETURNING id,
+	WITH new_post AS (
+		INSERT INTO public.post (author_id, content, repost_of_id, is_repost, is_hidden)
+		VALUES ($1, $2, NULLIF($3::bigint, 0), $4, $5)
+		RETURNING id,
+			author_id,
+			content,
+			repost_of_id,
+			is_repost,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'POST',
+			id
+		FROM new_post
+		WHERE is_hidden
+	)
+	SELECT id,
 		author_id,
 		content,
+		COALESCE(repost_of_id, 0),
+		is_repost,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM new_post;
 	`
 	StorePostAttachmentQuery = `
 	INSERT INTO public.post_attachment (post_id, file_name)
@@ -108,17 +158,39 @@ const (
 	`
 	DeletePostAttachmentQuery = `
 	DELETE FROM public.post_attachment
-	WHERE file_name = $1;
+	WHERE post_id = $1
+		AND file_name = $2;
 	`
 	UpdatePostQuery = `
-	UPDATE public.post
-	SET content = $1
-	WHERE id = $2
-	RETURNING id,
+	WITH updated_post AS (
+		UPDATE public.post
+		SET content = $1,
+			is_hidden = is_hidden OR $3
+		WHERE id = $2
+		RETURNING id,
+			author_id,
+			content,
+			is_hidden,
+			created_at,
+			updated_at
+	),
+	held_case AS (
+		INSERT INTO public.report_case (target_type, target_id)
+		SELECT 'POST',
+			id
+		FROM updated_post
+		WHERE $3
+		ON CONFLICT (target_type, target_id) DO UPDATE
+		SET status = 'OPEN',
+			resolved_by = NULL
+	)
+	SELECT id,
 		author_id,
 		content,
+		is_hidden,
 		created_at,
-		updated_at;
+		updated_at
+	FROM updated_post;
 	`
 	SelectAttachmentsQuery = `
 	SELECT array_agg(file_name) AS attachments
@@ -161,6 +233,13 @@ const (
 		p.content,
 		p.created_at as post_created_at,
 		p.updated_at as post_updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
 		array_agg(DISTINCT pa.file_name) AS attachments,
 		array_agg(DISTINCT pl1.user_id) AS liked_by_users
 	FROM public.post_like AS pl
@@ -169,6 +248,7 @@ const (
 	LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
 	WHERE p.author_id = $1
 		AND pl.id < $2
+		AND NOT p.is_hidden
 	GROUP BY pl.id, 
         p.id,
 		pl.post_id,
@@ -221,6 +301,13 @@ const (
 		p.content,
 		p.created_at,
 		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
 		array_agg(DISTINCT pa.file_name) AS attachments,
 		array_agg(DISTINCT pl.user_id) AS liked_by_users
 		FROM public.post AS p
@@ -229,6 +316,8 @@ const (
 		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
 		WHERE pgp.public_group_id = $1
 			AND p.id < $2
+			AND NOT p.is_hidden
+			AND NOT public.is_blocked_between(p.author_id, $4)
 		GROUP BY p.id,
 			p.author_id,
 			p.content,
@@ -243,6 +332,13 @@ const (
 		p.content,
 		p.created_at,
 		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
 		array_agg(DISTINCT pa.file_name) AS attachments,
 		array_agg(DISTINCT pl.user_id) AS liked_by_users,
 		pgp.public_group_id
@@ -252,6 +348,8 @@ const (
 		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
 		WHERE pgp.public_group_id = ANY($1::bigint[])
 			AND p.id < $2
+			AND NOT p.is_hidden
+			AND NOT public.is_blocked_between(p.author_id, $4)
 			GROUP BY p.id,
 			p.author_id,
 			p.content,
@@ -272,6 +370,13 @@ const (
 		p.content,
 		p.created_at,
 		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
 		array_agg(DISTINCT pa.file_name) AS attachments,
 		array_agg(DISTINCT pl.user_id) AS liked_by_users,
 		COALESCE(pgp.public_group_id, 0)
@@ -281,6 +386,9 @@ const (
 		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
 		WHERE (pgp.public_group_id = ANY($1::bigint[]) OR p.author_id = ANY($2::bigint[]))
 			AND p.id < $3
+			AND NOT p.is_hidden
+			AND (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $5))
+			AND NOT public.is_blocked_between(p.author_id, $5)
 			GROUP BY p.id,
 			p.author_id,
 			p.content,
@@ -300,12 +408,50 @@ const (
 	SELECT COALESCE(MAX(id), 0) AS last_post_id
 	FROM public.post;
 	`
+	GetPostsByIDsQuery = `
+	SELECT p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
+		array_agg(DISTINCT pa.file_name) AS attachments,
+		array_agg(DISTINCT pl.user_id) AS liked_by_users,
+		COALESCE(pgp.public_group_id, 0) AS group_id
+		FROM public.post AS p
+		LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
+		LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
+		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+		WHERE p.id = ANY($1::bigint[])
+			AND (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $2))
+			AND NOT public.is_blocked_between(p.author_id, $2)
+			AND (NOT p.is_hidden OR p.author_id = $2)
+		GROUP BY p.id,
+			p.author_id,
+			p.content,
+			p.created_at,
+			p.updated_at,
+			pgp.public_group_id;
+	`
 	GetNewPostsQuery = `
 	SELECT p.id,
 		p.author_id,
 		p.content,
 		p.created_at,
 		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
 		array_agg(DISTINCT pa.file_name) AS attachments,
 		array_agg(DISTINCT pl.user_id) AS liked_by_users,
 		COALESCE(pgp.public_group_id, 0) AS group_id
@@ -314,6 +460,9 @@ const (
 		LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
 		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
 		WHERE p.id < $1
+			AND NOT p.is_hidden
+			AND (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $3))
+			AND NOT public.is_blocked_between(p.author_id, $3)
 		GROUP BY p.id,
 			p.author_id,
 			p.content,
@@ -323,6 +472,74 @@ const (
 			ORDER BY p.created_at DESC
 			LIMIT $2;
 	`
+	GetFeedCandidatesQuery = `
+	SELECT p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
+		array_agg(DISTINCT pa.file_name) AS attachments,
+		array_agg(DISTINCT pl.user_id) AS liked_by_users,
+		COALESCE(pgp.public_group_id, 0) AS group_id,
+		(
+			SELECT COUNT(*)
+			FROM public.post_like AS spl
+			WHERE spl.post_id = p.id
+				AND spl.created_at <= $4
+		) AS likes_count,
+		(
+			SELECT COUNT(*)
+			FROM public.comment AS c
+			WHERE c.post_id = p.id
+				AND c.created_at <= $4
+		) AS comments_count
+		FROM public.post AS p
+		LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
+		LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
+		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+		WHERE (pgp.public_group_id = ANY($1::bigint[]) OR p.author_id = ANY($2::bigint[]))
+			AND p.created_at > $3
+			AND p.created_at <= $4
+			AND NOT p.is_hidden
+			AND (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $6))
+			AND NOT public.is_blocked_between(p.author_id, $6)
+		GROUP BY p.id,
+			p.author_id,
+			p.content,
+			p.created_at,
+			p.updated_at,
+			pgp.public_group_id
+			ORDER BY p.created_at DESC
+			LIMIT $5;
+	`
+	GetAuthorAffinitiesQuery = `
+	SELECT a.author_id,
+		(
+			SELECT COUNT(*)
+			FROM public.personal_message AS pm
+			JOIN public.conversation AS c ON pm.conversation_id = c.id
+			WHERE NOT c.is_group
+				AND c.dialog_user1_id = LEAST($1::bigint, a.author_id)
+				AND c.dialog_user2_id = GREATEST($1::bigint, a.author_id)
+				AND pm.created_at <= $3
+		) AS messages_count,
+		(
+			SELECT COUNT(*)
+			FROM public.post_like AS pl
+			JOIN public.post AS p ON pl.post_id = p.id
+			WHERE pl.user_id = $1
+				AND p.author_id = a.author_id
+				AND pl.created_at <= $3
+		) AS likes_count
+		FROM unnest($2::bigint[]) AS a(author_id);
+	`
 )
 
 type Posts struct {
@@ -351,8 +568,12 @@ func (p *Posts) GetPostByID(ctx context.Context, postID uint) (post *domain.Post
 		&post.Content,
 		&post.CreatedAt.Time,
 		&post.UpdatedAt.Time,
+		&post.RepostOfID,
+		&post.IsRepost,
+		&post.SharesCount,
 		&attachments,
 		&likedByUsers,
+		&post.IsHidden,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -368,7 +589,57 @@ func (p *Posts) GetPostByID(ctx context.Context, postID uint) (post *domain.Post
 	return
 }
 
-func (p *Posts) GetUserPosts(ctx context.Context, userID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
+// GetPostsByIDs returns the posts with the given IDs, missing posts and the
+// posts hidden from the viewer by the privacy settings, blocks or the content
+// filter are skipped.
+func (p *Posts) GetPostsByIDs(ctx context.Context, viewerID uint, postIDs []uint) (posts []*domain.Post, err error) {
+	if len(postIDs) == 0 {
+		return
+	}
+
+	postIDsPGArray := pq.Array(postIDs)
+
+	contextlogger.LogSQL(ctx, GetPostsByIDsQuery, postIDsPGArray, viewerID)
+
+	rows, err := p.db.Query(context.Background(), GetPostsByIDsQuery, postIDsPGArray, viewerID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := new(domain.Post)
+
+		var attachments pgtype.TextArray
+		var likedByUsers pgtype.Int8Array
+
+		err = rows.Scan(
+			&post.ID,
+			&post.AuthorID,
+			&post.Content,
+			&post.CreatedAt.Time,
+			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
+			&attachments,
+			&likedByUsers,
+			&post.GroupID,
+		)
+		if err != nil {
+			return
+		}
+
+		post.Attachments = utils.TextArrayIntoStringSlice(attachments)
+		post.LikedByIDs = utils.Int8ArrayIntoUintSlice(likedByUsers)
+
+		posts = append(posts, post)
+	}
+
+	return
+}
+
+func (p *Posts) GetUserPosts(ctx context.Context, userID, viewerID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
 	if lastPostID == 0 {
 		contextlogger.LogSQL(ctx, GetLastUserPostIDQuery, userID)
 
@@ -380,9 +651,9 @@ func (p *Posts) GetUserPosts(ctx context.Context, userID uint, lastPostID uint,
 		lastPostID += 1
 	}
 
-	contextlogger.LogSQL(ctx, GetUserPostsQuery, userID, lastPostID, postsAmount)
+	contextlogger.LogSQL(ctx, GetUserPostsQuery, userID, lastPostID, postsAmount, viewerID)
 
-	rows, err := p.db.Query(context.Background(), GetUserPostsQuery, userID, lastPostID, postsAmount)
+	rows, err := p.db.Query(context.Background(), GetUserPostsQuery, userID, lastPostID, postsAmount, viewerID)
 	if err != nil {
 		return
 	}
@@ -400,6 +671,9 @@ func (p *Posts) GetUserPosts(ctx context.Context, userID uint, lastPostID uint,
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 		)
@@ -449,6 +723,9 @@ func (p *Posts) GetUserFriendsPosts(ctx context.Context, userID uint, lastPostID
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 		)
@@ -485,12 +762,15 @@ func (p *Posts) StorePost(ctx context.Context, post *domain.Post) (newPost *doma
 		err = nil
 	}()
 
-	contextlogger.LogSQL(ctx, StorePostQuery, post.AuthorID, post.Content)
+	contextlogger.LogSQL(ctx, StorePostQuery, post.AuthorID, post.Content, post.RepostOfID, post.IsRepost, post.IsHidden)
 
-	err = tx.QueryRow(context.Background(), StorePostQuery, post.AuthorID, post.Content).Scan(
+	err = tx.QueryRow(context.Background(), StorePostQuery, post.AuthorID, post.Content, post.RepostOfID, post.IsRepost, post.IsHidden).Scan(
 		&newPost.ID,
 		&newPost.AuthorID,
 		&newPost.Content,
+		&newPost.RepostOfID,
+		&newPost.IsRepost,
+		&newPost.IsHidden,
 		&newPost.CreatedAt.Time,
 		&newPost.UpdatedAt.Time,
 	)
@@ -509,6 +789,25 @@ func (p *Posts) StorePost(ctx context.Context, post *domain.Post) (newPost *doma
 		newPost.Attachments = append(newPost.Attachments, attachment)
 	}
 
+	if post.GroupID != 0 {
+		contextlogger.LogSQL(ctx, StoreGroupPostQuery, newPost.ID, post.GroupID)
+
+		groupPost := new(domain.GroupPost)
+
+		err = tx.QueryRow(context.Background(), StoreGroupPostQuery, newPost.ID, post.GroupID).Scan(
+			&groupPost.ID,
+			&groupPost.PostID,
+			&groupPost.GroupID,
+			&groupPost.CreatedAt.Time,
+			&groupPost.UpdatedAt.Time,
+		)
+		if err != nil {
+			return
+		}
+
+		newPost.GroupID = groupPost.GroupID
+	}
+
 	err = tx.Commit(context.Background())
 	if err != nil {
 		return
@@ -579,12 +878,13 @@ func (p *Posts) UpdatePost(ctx context.Context, post *domain.Post, attachmentsTo
 		err = nil
 	}()
 
-	contextlogger.LogSQL(ctx, UpdatePostQuery, post.Content, post.ID)
+	contextlogger.LogSQL(ctx, UpdatePostQuery, post.Content, post.ID, post.IsHidden)
 
-	err = p.db.QueryRow(context.Background(), UpdatePostQuery, post.Content, post.ID).Scan(
+	err = p.db.QueryRow(context.Background(), UpdatePostQuery, post.Content, post.ID, post.IsHidden).Scan(
 		&updatedPost.ID,
 		&updatedPost.AuthorID,
 		&updatedPost.Content,
+		&updatedPost.IsHidden,
 		&updatedPost.CreatedAt.Time,
 		&updatedPost.UpdatedAt.Time,
 	)
@@ -604,9 +904,9 @@ func (p *Posts) UpdatePost(ctx context.Context, post *domain.Post, attachmentsTo
 	}
 
 	for _, attachment := range attachmentsToDelete {
-		contextlogger.LogSQL(ctx, DeletePostAttachmentQuery, attachment)
+		contextlogger.LogSQL(ctx, DeletePostAttachmentQuery, post.ID, attachment)
 
-		_, err = tx.Exec(context.Background(), DeletePostAttachmentQuery, attachment)
+		_, err = tx.Exec(context.Background(), DeletePostAttachmentQuery, post.ID, attachment)
 		if err != nil {
 			return
 		}
@@ -687,6 +987,9 @@ func (p *Posts) GetLikedPosts(ctx context.Context, userID uint, lastLikeID uint,
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 		)
@@ -762,7 +1065,7 @@ func (p *Posts) DeletePostLike(ctx context.Context, likeData *domain.PostLike) (
 	return
 }
 
-func (p *Posts) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (p *Posts) GetPostsOfGroup(ctx context.Context, viewerID, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if lastPostID == 0 {
 		contextlogger.LogSQL(ctx, GetLastPostOfGroupIDQuery, groupID)
 
@@ -774,9 +1077,9 @@ func (p *Posts) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsA
 		lastPostID += 1
 	}
 
-	contextlogger.LogSQL(ctx, GetPostsOfGroupQuery, groupID, lastPostID, postsAmount)
+	contextlogger.LogSQL(ctx, GetPostsOfGroupQuery, groupID, lastPostID, postsAmount, viewerID)
 
-	rows, err := p.db.Query(context.Background(), GetPostsOfGroupQuery, groupID, lastPostID, postsAmount)
+	rows, err := p.db.Query(context.Background(), GetPostsOfGroupQuery, groupID, lastPostID, postsAmount, viewerID)
 	if err != nil {
 		return
 	}
@@ -794,6 +1097,9 @@ func (p *Posts) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsA
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 		)
@@ -810,7 +1116,7 @@ func (p *Posts) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsA
 	return
 }
 
-func (p *Posts) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (p *Posts) GetGroupPostsBySubscriptionIDs(ctx context.Context, viewerID uint, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if len(subIDs) == 0 {
 		subIDs = append(subIDs, 0)
 	}
@@ -828,9 +1134,9 @@ func (p *Posts) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uin
 		lastPostID += 1
 	}
 
-	contextlogger.LogSQL(ctx, GetGroupPostsBySubscriptionIDsQuery, subIDsPGArray, lastPostID, postsAmount)
+	contextlogger.LogSQL(ctx, GetGroupPostsBySubscriptionIDsQuery, subIDsPGArray, lastPostID, postsAmount, viewerID)
 
-	rows, err := p.db.Query(context.Background(), GetGroupPostsBySubscriptionIDsQuery, subIDsPGArray, lastPostID, postsAmount)
+	rows, err := p.db.Query(context.Background(), GetGroupPostsBySubscriptionIDsQuery, subIDsPGArray, lastPostID, postsAmount, viewerID)
 	if err != nil {
 		return
 	}
@@ -848,6 +1154,9 @@ func (p *Posts) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uin
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 			&post.GroupID,
@@ -865,7 +1174,7 @@ func (p *Posts) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uin
 	return
 }
 
-func (p *Posts) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSubIDs, userSubIDs []uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
+func (p *Posts) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
 	if len(groupSubIDs) == 0 {
 		groupSubIDs = append(groupSubIDs, 0)
 	}
@@ -888,9 +1197,9 @@ func (p *Posts) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSub
 		lastPostID += 1
 	}
 
-	contextlogger.LogSQL(ctx, GetPostsByGroupSubIDsAndUserSubIDsQuery, groupSubIDsPGArr, userSubIDsPGArr, lastPostID, postsAmount)
+	contextlogger.LogSQL(ctx, GetPostsByGroupSubIDsAndUserSubIDsQuery, groupSubIDsPGArr, userSubIDsPGArr, lastPostID, postsAmount, viewerID)
 
-	rows, err := p.db.Query(context.Background(), GetPostsByGroupSubIDsAndUserSubIDsQuery, groupSubIDsPGArr, userSubIDsPGArr, lastPostID, postsAmount)
+	rows, err := p.db.Query(context.Background(), GetPostsByGroupSubIDsAndUserSubIDsQuery, groupSubIDsPGArr, userSubIDsPGArr, lastPostID, postsAmount, viewerID)
 	if err != nil {
 		return
 	}
@@ -908,6 +1217,9 @@ func (p *Posts) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSub
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 			&post.GroupID,
@@ -925,7 +1237,7 @@ func (p *Posts) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSub
 	return
 }
 
-func (p *Posts) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (p *Posts) GetNewPosts(ctx context.Context, viewerID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if lastPostID == 0 {
 		contextlogger.LogSQL(ctx, GetLastPostIDQuery)
 
@@ -937,9 +1249,9 @@ func (p *Posts) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (
 		lastPostID += 1
 	}
 
-	contextlogger.LogSQL(ctx, GetNewPostsQuery, lastPostID, postsAmount)
+	contextlogger.LogSQL(ctx, GetNewPostsQuery, lastPostID, postsAmount, viewerID)
 
-	rows, err := p.db.Query(context.Background(), GetNewPostsQuery, lastPostID, postsAmount)
+	rows, err := p.db.Query(context.Background(), GetNewPostsQuery, lastPostID, postsAmount, viewerID)
 	if err != nil {
 		return
 	}
@@ -957,6 +1269,9 @@ func (p *Posts) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (
 			&post.Content,
 			&post.CreatedAt.Time,
 			&post.UpdatedAt.Time,
+			&post.RepostOfID,
+			&post.IsRepost,
+			&post.SharesCount,
 			&attachments,
 			&likedByUsers,
 			&post.GroupID,
@@ -973,3 +1288,92 @@ func (p *Posts) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (
 
 	return
 }
+
+func (p *Posts) GetFeedCandidates(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, createdAfter, createdBefore time.Time, limit uint) (candidates []posts.FeedCandidate, err error) {
+	if len(groupSubIDs) == 0 {
+		groupSubIDs = append(groupSubIDs, 0)
+	}
+
+	if len(userSubIDs) == 0 {
+		userSubIDs = append(userSubIDs, 0)
+	}
+
+	groupSubIDsPGArr := pq.Array(groupSubIDs)
+	userSubIDsPGArr := pq.Array(userSubIDs)
+
+	contextlogger.LogSQL(ctx, GetFeedCandidatesQuery, groupSubIDsPGArr, userSubIDsPGArr, createdAfter, createdBefore, limit, viewerID)
+
+	rows, err := p.db.Query(context.Background(), GetFeedCandidatesQuery, groupSubIDsPGArr, userSubIDsPGArr, createdAfter, createdBefore, limit, viewerID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		candidate := posts.FeedCandidate{
+			Post: new(domain.Post),
+		}
+
+		var attachments pgtype.TextArray
+		var likedByUsers pgtype.Int8Array
+
+		err = rows.Scan(
+			&candidate.Post.ID,
+			&candidate.Post.AuthorID,
+			&candidate.Post.Content,
+			&candidate.Post.CreatedAt.Time,
+			&candidate.Post.UpdatedAt.Time,
+			&candidate.Post.RepostOfID,
+			&candidate.Post.IsRepost,
+			&candidate.Post.SharesCount,
+			&attachments,
+			&likedByUsers,
+			&candidate.Post.GroupID,
+			&candidate.LikesCount,
+			&candidate.CommentsCount,
+		)
+		if err != nil {
+			return
+		}
+
+		candidate.Post.Attachments = utils.TextArrayIntoStringSlice(attachments)
+		candidate.Post.LikedByIDs = utils.Int8ArrayIntoUintSlice(likedByUsers)
+
+		candidates = append(candidates, candidate)
+	}
+
+	return
+}
+
+func (p *Posts) GetAuthorAffinities(ctx context.Context, userID uint, authorIDs []uint, before time.Time) (affinities []posts.AuthorAffinity, err error) {
+	if len(authorIDs) == 0 {
+		return
+	}
+
+	authorIDsPGArr := pq.Array(authorIDs)
+
+	contextlogger.LogSQL(ctx, GetAuthorAffinitiesQuery, userID, authorIDsPGArr, before)
+
+	rows, err := p.db.Query(context.Background(), GetAuthorAffinitiesQuery, userID, authorIDsPGArr, before)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var affinity posts.AuthorAffinity
+
+		err = rows.Scan(
+			&affinity.AuthorID,
+			&affinity.MessagesCount,
+			&affinity.LikesCount,
+		)
+		if err != nil {
+			return
+		}
+
+		affinities = append(affinities, affinity)
+	}
+
+	return
+}
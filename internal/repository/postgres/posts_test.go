@@ -7,6 +7,7 @@ import (
 	repository "socio/internal/repository/postgres"
 	customtime "socio/pkg/time"
 	"socio/usecase/posts"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,7 +20,7 @@ import (
 )
 
 var (
-	postColumns = []string{"id", "author_id", "content", "created_at", "updated_at", "attacments", "liked_by"}
+	postColumns = []string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attacments", "liked_by"}
 )
 
 type ErrRow struct{}
@@ -34,6 +35,19 @@ func (r ErrInternalRow) Scan(...interface{}) error {
 	return errors.ErrInternal
 }
 
+// hiddenPostsFilter matches the queries showing the posts held by the content
+// filter to their author only.
+type hiddenPostsFilter struct{}
+
+func (hiddenPostsFilter) Matches(x interface{}) bool {
+	query, ok := x.(string)
+	return ok && strings.Contains(query, "(NOT p.is_hidden OR p.author_id = $2)")
+}
+
+func (hiddenPostsFilter) String() string {
+	return "query filtering the hidden posts"
+}
+
 func TestGetPostByID(t *testing.T) {
 	t.Parallel()
 
@@ -64,8 +78,12 @@ func TestGetPostByID(t *testing.T) {
 					"content",
 					timeProv.Now(),
 					timeProv.Now(),
+					uint(0),
+					false,
+					uint(0),
 					arr,
 					likedBy,
+					false,
 				)
 
 				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(row)
@@ -73,6 +91,31 @@ func TestGetPostByID(t *testing.T) {
 			expected: &domain.Post{ID: 1},
 			err:      nil,
 		},
+		{
+			name:   "Test hidden post",
+			postID: 1,
+			mock: func(pool *pgxpoolmock.MockPgxIface, postID uint) {
+				timeProv := customtime.MockTimeProvider{}
+
+				row := pgxpoolmock.NewRow(
+					uint(1),
+					uint(2),
+					"content",
+					timeProv.Now(),
+					timeProv.Now(),
+					uint(0),
+					false,
+					uint(0),
+					pgtype.TextArray{},
+					pgtype.Int8Array{},
+					true,
+				)
+
+				pool.EXPECT().QueryRow(gomock.Any(), repository.GetPostByIDQuery, postID).Return(row)
+			},
+			expected: &domain.Post{ID: 1, IsHidden: true},
+			err:      nil,
+		},
 		{
 			name:   "Test err",
 			postID: 1,
@@ -104,6 +147,10 @@ func TestGetPostByID(t *testing.T) {
 			if post.ID != tt.expected.ID {
 				t.Errorf("unexpected post id: %d", post.ID)
 			}
+
+			if post.IsHidden != tt.expected.IsHidden {
+				t.Errorf("unexpected post hidden flag: %v", post.IsHidden)
+			}
 		})
 	}
 }
@@ -144,9 +191,9 @@ func TestGetUserPosts(t *testing.T) {
 				// Mock for Query
 				rows := pgxpoolmock.NewRows(postColumns)
 				for i := 0; i < int(postsAmount); i++ {
-					rows.AddRow(uint(1), userID, "content", tp.Now(), tp.Now(), arr, likedBy)
+					rows.AddRow(uint(1), userID, "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy)
 				}
-				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
+				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
 			},
 			expected: []*domain.Post{
 				{
@@ -187,7 +234,7 @@ func TestGetUserPosts(t *testing.T) {
 			mock: func(pool *pgxpoolmock.MockPgxIface, userID uint, lastPostID uint, postsAmount uint) {
 				// Mock for QueryRow
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastUserPostIDQuery, userID).Return(pgxpoolmock.NewRow(lastPostID))
-				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows)
+				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows)
 			},
 			expected: []*domain.Post{},
 			err:      pgx.ErrNoRows,
@@ -203,7 +250,7 @@ func TestGetUserPosts(t *testing.T) {
 
 				// Mock for Query
 				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
-				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+				pool.EXPECT().Query(gomock.Any(), repository.GetUserPostsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
 			},
 			expected: []*domain.Post{},
 			err:      pgx.ErrNoRows,
@@ -221,7 +268,7 @@ func TestGetUserPosts(t *testing.T) {
 
 			tt.mock(pool, tt.userID, tt.lastPostID, tt.postsAmount)
 
-			_, err := repo.GetUserPosts(context.Background(), tt.userID, tt.lastPostID, tt.postsAmount)
+			_, err := repo.GetUserPosts(context.Background(), tt.userID, tt.userID, tt.lastPostID, tt.postsAmount)
 
 			if (tt.err != nil) != (err != nil) {
 				t.Errorf("expected error, got nil")
@@ -266,6 +313,9 @@ func TestGetUserFriendsPosts(t *testing.T) {
 					"content",
 					timeProv.Now(),
 					timeProv.Now(),
+					uint(0),
+					false,
+					uint(0),
 					arr,
 					likedBy,
 				).ToPgxRows()
@@ -368,7 +418,7 @@ func TestStorePost(t *testing.T) {
 			wantErr: false,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(0), false, false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -386,7 +436,7 @@ func TestStorePost(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(0), false, false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
@@ -404,7 +454,7 @@ func TestStorePost(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(0), false, false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
 				mockDB.EXPECT().Commit(context.Background()).Return(errors.ErrInternal)
@@ -421,7 +471,7 @@ func TestStorePost(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(0), false, false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
@@ -436,7 +486,7 @@ func TestStorePost(t *testing.T) {
 			wantErr: true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
 		{
@@ -452,6 +502,51 @@ func TestStorePost(t *testing.T) {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
+		{
+			name: "test case 7",
+			post: &domain.Post{
+				AuthorID:   1,
+				Content:    "Test content",
+				RepostOfID: 2,
+				IsRepost:   true,
+				GroupID:    3,
+			},
+			want: &domain.Post{
+				ID:         1,
+				AuthorID:   1,
+				Content:    "Test content",
+				RepostOfID: 2,
+				IsRepost:   true,
+				GroupID:    3,
+				CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+			},
+			wantErr: false,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(2), true, false, tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.StoreGroupPostQuery, uint(1), uint(3)).Return(pgxpoolmock.NewRow(uint(1), uint(1), uint(3), tp.Now(), tp.Now()))
+				mockDB.EXPECT().Commit(context.Background()).Return(nil)
+				mockDB.EXPECT().Rollback(context.Background()).Return(nil)
+			},
+		},
+		{
+			name: "test case 8",
+			post: &domain.Post{
+				AuthorID:   1,
+				Content:    "Test content",
+				RepostOfID: 2,
+				IsRepost:   true,
+				GroupID:    3,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
+				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", uint(2), true, false, tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.StoreGroupPostQuery, uint(1), uint(3)).Return(ErrRow{})
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -637,10 +732,10 @@ func TestUpdatePost(t *testing.T) {
 			wantErr: false,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
-				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
+				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
 				mockDB.EXPECT().Rollback(context.Background()).Return(nil)
 			},
@@ -658,10 +753,10 @@ func TestUpdatePost(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
-				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
+				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(nil)
 				mockDB.EXPECT().Rollback(context.Background()).Return(errors.ErrInternal)
 			},
@@ -679,10 +774,10 @@ func TestUpdatePost(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
-				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
+				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgconn.CommandTag("DELETE 1"), nil)
 				mockDB.EXPECT().Commit(context.Background()).Return(errors.ErrInternal)
 			},
 		},
@@ -699,10 +794,10 @@ func TestUpdatePost(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment1"))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow("attachment2"))
-				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any()).Return(nil, errors.ErrInternal)
+				mockDB.EXPECT().Exec(context.Background(), repository.DeletePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
 		{
@@ -718,7 +813,7 @@ func TestUpdatePost(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", tp.Now(), tp.Now()))
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(pgxpoolmock.NewRow(uint(1), uint(1), "Test content", false, tp.Now(), tp.Now()))
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StorePostAttachmentQuery, gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
@@ -735,7 +830,7 @@ func TestUpdatePost(t *testing.T) {
 			wantErr:             true,
 			setup: func() {
 				mockDB.EXPECT().BeginTx(context.Background(), gomock.Any()).Return(mockDB, nil)
-				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any()).Return(ErrRow{})
+				mockDB.EXPECT().QueryRow(context.Background(), repository.UpdatePostQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
 			},
 		},
 		{
@@ -918,8 +1013,8 @@ func TestGetLikedPosts(t *testing.T) {
 				}
 
 				// Mock the GetLikedPosts query
-				rows := pgxpoolmock.NewRows([]string{"like_id", "post_id", "user_id", "created_at", "author_id", "content", "created_at", "updated_at", "attachments", "liked_by_ids"}).AddRow(
-					uint(1), uint(1), uint(1), tp.Now(), uint(1), "content", tp.Now(), tp.Now(), arr, likedBy).ToPgxRows()
+				rows := pgxpoolmock.NewRows([]string{"like_id", "post_id", "user_id", "created_at", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids"}).AddRow(
+					uint(1), uint(1), uint(1), tp.Now(), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy).ToPgxRows()
 				pool.EXPECT().Query(gomock.Any(), repository.GetLikedPosts, gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
 			},
 			expected: []posts.LikeWithPost{
@@ -1259,9 +1354,9 @@ func TestGetPostsOfGroup(t *testing.T) {
 				}
 
 				// Mock the GetPostsOfGroupQuery
-				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "attachments", "liked_by_ids"})
-				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), arr, likedBy)
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids"})
+				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy)
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows.ToPgxRows(),
 					nil,
 				)
@@ -1305,7 +1400,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 			mock: func(pool *pgxpoolmock.MockPgxIface, groupID uint, lastPostID uint, postsAmount uint) {
 				// Mock the GetLastPostOfGroupIDQuery
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastPostOfGroupIDQuery, gomock.Any()).Return(pgxpoolmock.NewRow(uint(1)))
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil,
 					errors.ErrInternal,
 				)
@@ -1322,7 +1417,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastPostOfGroupIDQuery, gomock.Any()).Return(pgxpoolmock.NewRow(uint(1)))
 				rows := pgxpoolmock.NewRows([]string{"err"})
 				rows.AddRow(ErrRow{})
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsOfGroupQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows.ToPgxRows(),
 					nil,
 				)
@@ -1343,7 +1438,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 
 			tt.mock(pool, tt.groupID, tt.lastPostID, tt.postsAmount)
 
-			got, err := repo.GetPostsOfGroup(context.Background(), tt.groupID, tt.lastPostID, tt.postsAmount)
+			got, err := repo.GetPostsOfGroup(context.Background(), 1, tt.groupID, tt.lastPostID, tt.postsAmount)
 
 			if tt.err != (err != nil) {
 				t.Errorf("unexpected error: %v", err)
@@ -1393,9 +1488,9 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 					Status:   pgtype.Present,
 				}
 				// Mock the GetGroupPostsBySubscriptionIDsQuery
-				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "attachments", "liked_by_ids", "group_id"})
-				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), arr, likedBy, uint(1))
-				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "group_id"})
+				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy, uint(1))
+				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows.ToPgxRows(),
 					nil,
 				)
@@ -1428,7 +1523,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 					pgxpoolmock.NewRow(uint(1)),
 				)
 				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
-				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows,
 					nil,
 				)
@@ -1445,7 +1540,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastGroupPostBySubscriptionIDsQuery, gomock.Any()).Return(
 					pgxpoolmock.NewRow(uint(1)),
 				)
-				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetGroupPostsBySubscriptionIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal,
 				)
 			},
@@ -1478,7 +1573,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 
 			tt.mock(pool, tt.subIDs, tt.lastPostID, tt.postsAmount)
 
-			got, err := repo.GetGroupPostsBySubscriptionIDs(context.Background(), tt.subIDs, tt.lastPostID, tt.postsAmount)
+			got, err := repo.GetGroupPostsBySubscriptionIDs(context.Background(), 1, tt.subIDs, tt.lastPostID, tt.postsAmount)
 
 			if tt.err != (err != nil) {
 				t.Errorf("unexpected error: %v", err)
@@ -1531,9 +1626,9 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 				}
 
 				// Mock the GetPostsByGroupSubIDsAndUserSubIDsQuery
-				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "attachments", "liked_by_ids", "public_group_id"})
-				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), arr, likedBy, uint(1))
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "public_group_id"})
+				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy, uint(1))
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows.ToPgxRows(),
 					nil,
 				)
@@ -1569,7 +1664,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(
 					ErrRow{},
 				).ToPgxRows()
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					rows,
 					nil,
 				)
@@ -1587,7 +1682,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastPostByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any()).Return(
 					pgxpoolmock.NewRow(uint(1)),
 				)
-				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByGroupSubIDsAndUserSubIDsQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal,
 				)
 			},
@@ -1621,7 +1716,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 
 			tt.mock(pool, tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
 
-			got, err := repo.GetPostsByGroupSubIDsAndUserSubIDs(context.Background(), tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
+			got, err := repo.GetPostsByGroupSubIDsAndUserSubIDs(context.Background(), 1, tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
 
 			if tt.err != (err != nil) {
 				t.Errorf("unexpected error: %v", err)
@@ -1668,9 +1763,9 @@ func TestGetNewPosts(t *testing.T) {
 				}
 
 				// Mock the GetNewPostsQuery
-				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "attachments", "liked_by_ids", "group_id"})
-				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), arr, likedBy, uint(1))
-				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "group_id"})
+				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy, uint(1))
+				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
 			},
 			expected: []*domain.Post{
 				{
@@ -1697,7 +1792,7 @@ func TestGetNewPosts(t *testing.T) {
 			mock: func(pool *pgxpoolmock.MockPgxIface, lastPostID, postsAmount uint) {
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastPostIDQuery).Return(pgxpoolmock.NewRow(uint(1)))
 				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
-				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any()).Return(rows, nil)
+				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
 			},
 			expected: nil,
 			err:      true,
@@ -1708,7 +1803,7 @@ func TestGetNewPosts(t *testing.T) {
 			postsAmount: 10,
 			mock: func(pool *pgxpoolmock.MockPgxIface, lastPostID, postsAmount uint) {
 				pool.EXPECT().QueryRow(gomock.Any(), repository.GetLastPostIDQuery).Return(pgxpoolmock.NewRow(uint(1)))
-				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+				pool.EXPECT().Query(gomock.Any(), repository.GetNewPostsQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 			expected: nil,
 			err:      true,
@@ -1736,7 +1831,7 @@ func TestGetNewPosts(t *testing.T) {
 
 			tt.mock(pool, tt.lastPostID, tt.postsAmount)
 
-			got, err := repo.GetNewPosts(context.Background(), tt.lastPostID, tt.postsAmount)
+			got, err := repo.GetNewPosts(context.Background(), 1, tt.lastPostID, tt.postsAmount)
 
 			if tt.err != (err != nil) {
 				t.Errorf("unexpected error: %v", err)
@@ -1750,3 +1845,277 @@ func TestGetNewPosts(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPostsByIDs(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name     string
+		postIDs  []uint
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []*domain.Post
+		err      bool
+	}{
+		{
+			name:    "Test OK",
+			postIDs: []uint{1, 2},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				arr := pgtype.TextArray{
+					Elements: []pgtype.Text{{String: "file1", Status: pgtype.Present}},
+				}
+
+				likedBy := pgtype.Int8Array{
+					Elements: []pgtype.Int8{{Int: 1, Status: pgtype.Present}},
+				}
+
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "group_id"})
+				rows.AddRow(uint(1), uint(1), "content", tp.Now(), tp.Now(), uint(0), false, uint(2), arr, likedBy, uint(3))
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByIDsQuery, gomock.Any(), uint(1)).Return(rows.ToPgxRows(), nil)
+			},
+			expected: []*domain.Post{
+				{
+					ID:          1,
+					AuthorID:    1,
+					GroupID:     3,
+					Content:     "content",
+					Attachments: []string{"file1"},
+					LikedByIDs:  []uint64{1},
+					SharesCount: 2,
+					CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			err: false,
+		},
+		{
+			name:    "Test hidden posts filtered",
+			postIDs: []uint{1},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "group_id"})
+				pool.EXPECT().Query(gomock.Any(), hiddenPostsFilter{}, gomock.Any(), uint(1)).Return(rows.ToPgxRows(), nil)
+			},
+			expected: nil,
+			err:      false,
+		},
+		{
+			name:     "Test no IDs",
+			postIDs:  nil,
+			mock:     func(pool *pgxpoolmock.MockPgxIface) {},
+			expected: nil,
+			err:      false,
+		},
+		{
+			name:    "Test scan error",
+			postIDs: []uint{1},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByIDsQuery, gomock.Any(), uint(1)).Return(rows, nil)
+			},
+			expected: nil,
+			err:      true,
+		},
+		{
+			name:    "Test query error",
+			postIDs: []uint{1},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), repository.GetPostsByIDsQuery, gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+			expected: nil,
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetPostsByIDs(context.Background(), 1, tt.postIDs)
+
+			if tt.err != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !tt.err {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetFeedCandidates(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name        string
+		groupSubIDs []uint
+		userSubIDs  []uint
+		mock        func(pool *pgxpoolmock.MockPgxIface)
+		expected    []posts.FeedCandidate
+		err         bool
+	}{
+		{
+			name:        "Test OK",
+			groupSubIDs: []uint{3},
+			userSubIDs:  nil,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				arr := pgtype.TextArray{}
+
+				likedBy := pgtype.Int8Array{
+					Elements: []pgtype.Int8{{Int: 1, Status: pgtype.Present}, {Int: 2, Status: pgtype.Present}},
+				}
+
+				rows := pgxpoolmock.NewRows([]string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_ids", "group_id", "likes_count", "comments_count"})
+				rows.AddRow(uint(1), uint(2), "content", tp.Now(), tp.Now(), uint(0), false, uint(0), arr, likedBy, uint(3), uint(1), uint(4))
+				pool.EXPECT().Query(gomock.Any(), repository.GetFeedCandidatesQuery, gomock.Any(), gomock.Any(), tp.Now().Add(-time.Hour), tp.Now(), uint(10), uint(1)).Return(rows.ToPgxRows(), nil)
+			},
+			expected: []posts.FeedCandidate{
+				{
+					Post: &domain.Post{
+						ID:          1,
+						AuthorID:    2,
+						GroupID:     3,
+						Content:     "content",
+						Attachments: nil,
+						LikedByIDs:  []uint64{1, 2},
+						CreatedAt:   customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt:   customtime.CustomTime{Time: tp.Now()},
+					},
+					LikesCount:    1,
+					CommentsCount: 4,
+				},
+			},
+			err: false,
+		},
+		{
+			name:        "Test scan error",
+			groupSubIDs: nil,
+			userSubIDs:  []uint{1},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), repository.GetFeedCandidatesQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: nil,
+			err:      true,
+		},
+		{
+			name:        "Test query error",
+			groupSubIDs: nil,
+			userSubIDs:  nil,
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), repository.GetFeedCandidatesQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			expected: nil,
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetFeedCandidates(context.Background(), 1, tt.groupSubIDs, tt.userSubIDs, tp.Now().Add(-time.Hour), tp.Now(), 10)
+
+			if tt.err != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !tt.err {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetAuthorAffinities(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name      string
+		authorIDs []uint
+		mock      func(pool *pgxpoolmock.MockPgxIface)
+		expected  []posts.AuthorAffinity
+		err       bool
+	}{
+		{
+			name:      "Test OK",
+			authorIDs: []uint{2, 3},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"author_id", "messages_count", "likes_count"})
+				rows.AddRow(uint(2), uint(10), uint(1))
+				rows.AddRow(uint(3), uint(0), uint(0))
+				pool.EXPECT().Query(gomock.Any(), repository.GetAuthorAffinitiesQuery, uint(1), gomock.Any(), tp.Now()).Return(rows.ToPgxRows(), nil)
+			},
+			expected: []posts.AuthorAffinity{
+				{AuthorID: 2, MessagesCount: 10, LikesCount: 1},
+				{AuthorID: 3},
+			},
+			err: false,
+		},
+		{
+			name:      "Test no authors",
+			authorIDs: nil,
+			mock:      func(pool *pgxpoolmock.MockPgxIface) {},
+			expected:  nil,
+			err:       false,
+		},
+		{
+			name:      "Test scan error",
+			authorIDs: []uint{2},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), repository.GetAuthorAffinitiesQuery, uint(1), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: nil,
+			err:      true,
+		},
+		{
+			name:      "Test query error",
+			authorIDs: []uint{2},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), repository.GetAuthorAffinitiesQuery, uint(1), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			expected: nil,
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.GetAuthorAffinities(context.Background(), 1, tt.authorIDs, tp.Now())
+
+			if tt.err != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !tt.err {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+const (
+	getPrivacySettingsQuery = `
+	SELECT u.id,
+		COALESCE(up.posts, 'EVERYONE'),
+		COALESCE(up.messages, 'EVERYONE'),
+		COALESCE(up.subscriptions, 'EVERYONE'),
+		COALESCE(up.date_of_birth, 'EVERYONE')
+	FROM public.user AS u
+		LEFT JOIN public.user_privacy AS up ON up.user_id = u.id
+	WHERE u.id = $1;
+	`
+	storePrivacySettingsQuery = `
+	INSERT INTO public.user_privacy (user_id, posts, messages, subscriptions, date_of_birth)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id) DO UPDATE
+	SET posts = EXCLUDED.posts,
+		messages = EXCLUDED.messages,
+		subscriptions = EXCLUDED.subscriptions,
+		date_of_birth = EXCLUDED.date_of_birth
+	RETURNING user_id,
+		posts,
+		messages,
+		subscriptions,
+		date_of_birth;
+	`
+	// canViewPostQuery lets everyone see the group posts, except for the users
+	// blocked by or blocking the author. Posts held by the content filter are
+	// only visible to their author.
+	canViewPostQuery = `
+	SELECT (pgp.post_id IS NOT NULL OR public.can_view_posts(p.author_id, $2))
+		AND NOT public.is_blocked_between(p.author_id, $2)
+		AND (NOT p.is_hidden OR p.author_id = $2)
+	FROM public.post AS p
+		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+	WHERE p.id = $1;
+	`
+	getDateOfBirthViewableIDsQuery = `
+	SELECT u.id
+	FROM unnest($1::bigint[]) AS u(id)
+	WHERE public.can_view_date_of_birth(u.id, $2);
+	`
+	canViewSubscriptionsQuery = `
+	SELECT public.can_view_subscriptions(u.id, $2)
+	FROM public.user AS u
+	WHERE u.id = $1;
+	`
+)
+
+func (s *Users) GetPrivacySettings(ctx context.Context, userID uint) (settings *domain.PrivacySettings, err error) {
+	contextlogger.LogSQL(ctx, getPrivacySettingsQuery, userID)
+
+	settings = &domain.PrivacySettings{}
+
+	err = s.db.QueryRow(context.Background(), getPrivacySettingsQuery, userID).Scan(
+		&settings.UserID,
+		&settings.Posts,
+		&settings.Messages,
+		&settings.Subscriptions,
+		&settings.DateOfBirth,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+			return
+		}
+
+		return
+	}
+
+	return
+}
+
+func (s *Users) StorePrivacySettings(ctx context.Context, settings *domain.PrivacySettings) (newSettings *domain.PrivacySettings, err error) {
+	contextlogger.LogSQL(ctx, storePrivacySettingsQuery, settings.UserID, settings.Posts, settings.Messages, settings.Subscriptions, settings.DateOfBirth)
+
+	newSettings = &domain.PrivacySettings{}
+
+	err = s.db.QueryRow(context.Background(), storePrivacySettingsQuery,
+		settings.UserID,
+		settings.Posts,
+		settings.Messages,
+		settings.Subscriptions,
+		settings.DateOfBirth,
+	).Scan(
+		&newSettings.UserID,
+		&newSettings.Posts,
+		&newSettings.Messages,
+		&newSettings.Subscriptions,
+		&newSettings.DateOfBirth,
+	)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetDateOfBirthViewableIDs returns the IDs of the users whose privacy settings
+// let the viewer see their date of birth.
+func (s *Users) GetDateOfBirthViewableIDs(ctx context.Context, viewerID uint, userIDs []uint) (viewableIDs []uint, err error) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	userIDsPGArray := pq.Array(userIDs)
+
+	contextlogger.LogSQL(ctx, getDateOfBirthViewableIDsQuery, userIDsPGArray, viewerID)
+
+	rows, err := s.db.Query(context.Background(), getDateOfBirthViewableIDsQuery, userIDsPGArray, viewerID)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID uint
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			return
+		}
+
+		viewableIDs = append(viewableIDs, userID)
+	}
+
+	return
+}
+
+// CanViewSubscriptions tells whether the privacy settings of the user let the
+// viewer see their subscriptions, subscribers and friends.
+func (s *Users) CanViewSubscriptions(ctx context.Context, userID, viewerID uint) (allowed bool, err error) {
+	contextlogger.LogSQL(ctx, canViewSubscriptionsQuery, userID, viewerID)
+
+	err = s.db.QueryRow(context.Background(), canViewSubscriptionsQuery, userID, viewerID).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+			return
+		}
+
+		return
+	}
+
+	return
+}
+
+func (p *Posts) CanViewPost(ctx context.Context, postID, viewerID uint) (allowed bool, err error) {
+	contextlogger.LogSQL(ctx, canViewPostQuery, postID, viewerID)
+
+	err = p.db.QueryRow(context.Background(), canViewPostQuery, postID, viewerID).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			err = errors.ErrNotFound
+			return
+		}
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,289 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	"socio/pkg/hash"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestGetPrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected *domain.PrivacySettings
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), domain.FriendsPrivacy, domain.EveryonePrivacy, domain.OnlyMePrivacy, domain.SubscribersPrivacy)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1)).Return(row)
+			},
+			expected: &domain.PrivacySettings{
+				UserID:        1,
+				Posts:         domain.FriendsPrivacy,
+				Messages:      domain.EveryonePrivacy,
+				Subscriptions: domain.OnlyMePrivacy,
+				DateOfBirth:   domain.SubscribersPrivacy,
+			},
+		},
+		{
+			name: "Test user not found",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
+
+			tt.mock(pool)
+
+			got, err := repo.GetPrivacySettings(context.Background(), 1)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStorePrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	settings := &domain.PrivacySettings{
+		UserID:        1,
+		Posts:         domain.SubscribersPrivacy,
+		Messages:      domain.FriendsPrivacy,
+		Subscriptions: domain.EveryonePrivacy,
+		DateOfBirth:   domain.OnlyMePrivacy,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(pool *pgxpoolmock.MockPgxIface)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(uint(1), domain.SubscribersPrivacy, domain.FriendsPrivacy, domain.EveryonePrivacy, domain.OnlyMePrivacy)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), domain.SubscribersPrivacy, domain.FriendsPrivacy, domain.EveryonePrivacy, domain.OnlyMePrivacy).Return(row)
+			},
+		},
+		{
+			name: "Test error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
+
+			tt.mock(pool)
+
+			got, err := repo.StorePrivacySettings(context.Background(), settings)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, settings, got)
+			}
+		})
+	}
+}
+
+func TestCanViewPost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(true)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+			},
+			expected: true,
+		},
+		{
+			name: "Test hidden post",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(false)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+			},
+			expected: false,
+		},
+		{
+			name: "Test post held by the content filter",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(false)
+				pool.EXPECT().QueryRow(gomock.Any(), hiddenPostsFilter{}, uint(1), uint(2)).Return(row)
+			},
+			expected: false,
+		},
+		{
+			name: "Test post not found",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool)
+
+			got, err := repo.CanViewPost(context.Background(), 1, 2)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetDateOfBirthViewableIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		userIDs  []uint
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []uint
+		wantErr  error
+	}{
+		{
+			name:    "Test OK",
+			userIDs: []uint{2, 3},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"id"})
+				rows.AddRow(uint(3))
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), uint(1)).Return(rows.ToPgxRows(), nil)
+			},
+			expected: []uint{3},
+		},
+		{
+			name:     "Test no users",
+			userIDs:  nil,
+			mock:     func(pool *pgxpoolmock.MockPgxIface) {},
+			expected: nil,
+		},
+		{
+			name:    "Test query error",
+			userIDs: []uint{2},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
+
+			tt.mock(pool)
+
+			got, err := repo.GetDateOfBirthViewableIDs(context.Background(), 1, tt.userIDs)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCanViewSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected bool
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(true)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+			},
+			expected: true,
+		},
+		{
+			name: "Test hidden subscriptions",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				row := pgxpoolmock.NewRow(false)
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), uint(1), uint(2)).Return(row)
+			},
+			expected: false,
+		},
+		{
+			name: "Test user not found",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrRow{})
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
+
+			tt.mock(pool)
+
+			got, err := repo.CanViewSubscriptions(context.Background(), 1, 2)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
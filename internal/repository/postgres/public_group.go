@@ -32,7 +32,15 @@ const (
 	FROM public.public_group pg
 	LEFT JOIN public.public_group_subscription pgs ON pg.id = pgs.public_group_id
 	WHERE pg.name ILIKE '%' || $1 || '%'
-	GROUP BY pg.id, pg.name, pg.description, pg.avatar, pg.created_at, pg.updated_at;
+		AND pg.id > $3
+	GROUP BY pg.id, pg.name, pg.description, pg.avatar, pg.created_at, pg.updated_at
+	ORDER BY pg.id
+	LIMIT $4;
+	`
+	countPublicGroupsByNameQuery = `
+	SELECT COUNT(*)
+	FROM public.public_group pg
+	WHERE pg.name ILIKE '%' || $1 || '%';
 	`
 	storePublicGroupQuery = `
 	INSERT INTO public.public_group (name, description, avatar)
@@ -135,14 +143,16 @@ func (p *PublicGroup) GetPublicGroupByID(ctx context.Context, groupID uint, user
 	return
 }
 
-func (p *PublicGroup) SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID uint) (publicGroups []*publicgroup.PublicGroupWithInfo, err error) {
-	contextlogger.LogSQL(ctx, searchPublicGroupsByNameWithInfoQuery, query, userID)
+func (p *PublicGroup) SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID uint, lastGroupID uint, limit uint) (publicGroups []*publicgroup.PublicGroupWithInfo, err error) {
+	contextlogger.LogSQL(ctx, searchPublicGroupsByNameWithInfoQuery, query, userID, lastGroupID, limit)
 
 	rows, err := p.db.Query(
 		context.Background(),
 		searchPublicGroupsByNameWithInfoQuery,
 		query,
 		userID,
+		lastGroupID,
+		limit,
 	)
 	if err != nil {
 		return
@@ -174,6 +184,17 @@ func (p *PublicGroup) SearchPublicGroupsByNameWithInfo(ctx context.Context, quer
 	return
 }
 
+func (p *PublicGroup) CountPublicGroupsByName(ctx context.Context, query string) (count uint, err error) {
+	contextlogger.LogSQL(ctx, countPublicGroupsByNameQuery, query)
+
+	err = p.db.QueryRow(context.Background(), countPublicGroupsByNameQuery, query).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 func (p *PublicGroup) StorePublicGroup(ctx context.Context, publicGroup *domain.PublicGroup) (newGroup *domain.PublicGroup, err error) {
 	contextlogger.LogSQL(ctx, storePublicGroupQuery, publicGroup.Name, publicGroup.Description, publicGroup.Avatar)
 
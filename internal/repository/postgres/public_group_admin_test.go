@@ -72,7 +72,7 @@ func TestStorePublicGroupAdmin(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, customtime.MockTimeProvider{})
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
 
 			tt.mock(pool, tt.input)
 
@@ -168,7 +168,7 @@ func TestDeletePublicGroupAdmin(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, customtime.MockTimeProvider{})
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
 
 			tt.mock(pool, tt.input)
 
@@ -254,7 +254,7 @@ func TestGetAdminsByPublicGroupID(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, customtime.MockTimeProvider{})
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
 
 			tt.mock(pool, tt.groupID)
 
@@ -318,7 +318,7 @@ func TestCheckIfUserIsAdmin(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, customtime.MockTimeProvider{})
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, nil)
 
 			tt.mock(pool, tt.publicGroupID, tt.userID)
 
@@ -118,7 +118,7 @@ func TestSearchPublicGroupsByNameWithInfo(t *testing.T) {
 				// Mock the searchPublicGroupsByNameWithInfoQuery
 				rows := pgxpoolmock.NewRows([]string{"id", "name", "description", "avatar", "created_at", "updated_at", "subscribers_count", "is_subscribed"})
 				rows.AddRow(uint(1), "Group", "Description", "avatar", tp.Now(), tp.Now(), uint(10), true)
-				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
+				pool.EXPECT().Query(context.Background(), gomock.Any(), query, userID, uint(0), uint(21)).Return(rows.ToPgxRows(), nil)
 			},
 			expected: []*publicgroup.PublicGroupWithInfo{
 				{
@@ -142,7 +142,7 @@ func TestSearchPublicGroupsByNameWithInfo(t *testing.T) {
 			userID: 1,
 			mock: func(pool *pgxpoolmock.MockPgxIface, query string, userID uint) {
 				// Mock the searchPublicGroupsByNameWithInfoQuery
-				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows)
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows)
 			},
 			expected: nil,
 			err:      true,
@@ -155,7 +155,7 @@ func TestSearchPublicGroupsByNameWithInfo(t *testing.T) {
 				// Mock the searchPublicGroupsByNameWithInfoQuery
 				rows := pgxpoolmock.NewRows([]string{"err"})
 				rows.AddRow(ErrRow{})
-				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
+				pool.EXPECT().Query(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
 			},
 			expected: nil,
 			err:      true,
@@ -173,7 +173,7 @@ func TestSearchPublicGroupsByNameWithInfo(t *testing.T) {
 
 			tt.mock(pool, tt.query, tt.userID)
 
-			result, err := publicGroup.SearchPublicGroupsByNameWithInfo(context.Background(), tt.query, tt.userID)
+			result, err := publicGroup.SearchPublicGroupsByNameWithInfo(context.Background(), tt.query, tt.userID, 0, 21)
 
 			if (err != nil) != tt.err {
 				t.Errorf("unexpected error: got %v, want %v", err, tt.err)
@@ -187,6 +187,55 @@ func TestSearchPublicGroupsByNameWithInfo(t *testing.T) {
 	}
 }
 
+func TestCountPublicGroupsByName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    string
+		mock     func(pool *pgxpoolmock.MockPgxIface, query string)
+		expected uint
+		err      error
+	}{
+		{
+			name:  "Test OK",
+			query: "Group",
+			mock: func(pool *pgxpoolmock.MockPgxIface, query string) {
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), query).Return(pgxpoolmock.NewRow(uint(2)))
+			},
+			expected: 2,
+			err:      nil,
+		},
+		{
+			name:  "Test ErrInternal",
+			query: "Group",
+			mock: func(pool *pgxpoolmock.MockPgxIface, query string) {
+				pool.EXPECT().QueryRow(context.Background(), gomock.Any(), query).Return(ErrInternalRow{})
+			},
+			expected: 0,
+			err:      errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			publicGroup := repository.NewPublicGroup(pool, customtime.MockTimeProvider{})
+
+			tt.mock(pool, tt.query)
+
+			count, err := publicGroup.CountPublicGroupsByName(context.Background(), tt.query)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, count)
+		})
+	}
+}
+
 func TestStorePublicGroup(t *testing.T) {
 	t.Parallel()
 
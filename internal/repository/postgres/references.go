@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+
+	"github.com/lib/pq"
+)
+
+const (
+	getUserAvatarsQuery = `
+	SELECT avatar
+	FROM public.user;
+	`
+	getGroupAvatarsQuery = `
+	SELECT avatar
+	FROM public.public_group;
+	`
+	getStickerFileNamesQuery = `
+	SELECT file_name
+	FROM public.sticker;
+	`
+	getPostAttachmentFileNamesQuery = `
+	SELECT file_name
+	FROM public.post_attachment;
+	`
+	getMessageAttachmentFileNamesQuery = `
+	SELECT file_name
+	FROM public.message_attachment;
+	`
+	deleteAttachmentsQuery = `
+	DELETE FROM public.attachment
+	WHERE file_name = ANY($1::text[]);
+	`
+)
+
+// referencesQueries are the queries of the files each bucket holds, the
+// bucket names are the ones of the minio repository.
+var referencesQueries = map[string]string{
+	"user-avatars":        getUserAvatarsQuery,
+	"group-avatars":       getGroupAvatarsQuery,
+	"stickers":            getStickerFileNamesQuery,
+	"post-attachments":    getPostAttachmentFileNamesQuery,
+	"message-attachments": getMessageAttachmentFileNamesQuery,
+}
+
+type References struct {
+	db DBPool
+}
+
+func NewReferences(db DBPool) *References {
+	return &References{
+		db: db,
+	}
+}
+
+func (r *References) GetReferencedFileNames(ctx context.Context, bucketName string) (fileNames []string, err error) {
+	query, ok := referencesQueries[bucketName]
+	if !ok {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	contextlogger.LogSQL(ctx, query)
+
+	rows, err := r.db.Query(context.Background(), query)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fileName string
+
+		err = rows.Scan(&fileName)
+		if err != nil {
+			return
+		}
+
+		fileNames = append(fileNames, fileName)
+	}
+
+	// a partial list would make the referenced files look orphaned
+	err = rows.Err()
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteAttachments deletes the descriptions of the deleted files.
+func (r *References) DeleteAttachments(ctx context.Context, fileNames []string) (err error) {
+	if len(fileNames) == 0 {
+		return
+	}
+
+	fileNamesPGArray := pq.Array(fileNames)
+
+	contextlogger.LogSQL(ctx, deleteAttachmentsQuery, fileNamesPGArray)
+
+	_, err = r.db.Exec(context.Background(), deleteAttachmentsQuery, fileNamesPGArray)
+	if err != nil {
+		return
+	}
+
+	return
+}
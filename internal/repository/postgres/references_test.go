@@ -0,0 +1,140 @@
+package repository_test
+
+import (
+	"context"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReferencedFileNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		bucketName string
+		mock       func(pool *pgxpoolmock.MockPgxIface)
+		expected   []string
+		wantErr    bool
+	}{
+		{
+			name:       "Test OK",
+			bucketName: "post-attachments",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"file_name"}).
+					AddRow("pic.png").
+					AddRow("clip.mp4").
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			expected: []string{"pic.png", "clip.mp4"},
+			wantErr:  false,
+		},
+		{
+			name:       "Test unknown bucket",
+			bucketName: "unknown",
+			mock:       func(pool *pgxpoolmock.MockPgxIface) {},
+			wantErr:    true,
+		},
+		{
+			name:       "Test scan error",
+			bucketName: "user-avatars",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Test query error",
+			bucketName: "stickers",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewReferences(pool)
+
+			tt.mock(pool)
+
+			got, err := repo.GetReferencedFileNames(context.Background(), tt.bucketName)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReferencedFileNames() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeleteAttachments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fileNames []string
+		mock      func(pool *pgxpoolmock.MockPgxIface)
+		wantErr   bool
+	}{
+		{
+			name:      "Test OK",
+			fileNames: []string{"pic.png"},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				tag := pgconn.CommandTag("DELETE 1")
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Test no file names",
+			fileNames: nil,
+			mock:      func(pool *pgxpoolmock.MockPgxIface) {},
+			wantErr:   false,
+		},
+		{
+			name:      "Test error",
+			fileNames: []string{"pic.png"},
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewReferences(pool)
+
+			tt.mock(pool)
+
+			err := repo.DeleteAttachments(context.Background(), tt.fileNames)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteAttachments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
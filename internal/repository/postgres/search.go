@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"socio/pkg/utils"
+	"socio/usecase/posts"
+
+	"github.com/jackc/pgtype"
+	"github.com/lib/pq"
+)
+
+// The queries match the search vectors with the query parsed by both
+// configurations the vectors are built with. The visible posts are the wall
+// posts of the authors in $2 whose privacy settings let the viewer see them and
+// the posts of all public groups. The snippets
+// are built for the found page only, as ts_headline parses the whole content.
+// The count queries match the same way and count every hit regardless of the
+// page.
+const (
+	searchPostsQuery = `
+	WITH search AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	),
+	found AS (
+		SELECT p.id,
+			ts_rank(p.search_vector, s.query)::float8 AS rank
+		FROM public.post AS p
+		CROSS JOIN search AS s
+		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+		WHERE p.search_vector @@ s.query
+			AND NOT p.is_hidden
+			AND (pgp.post_id IS NOT NULL OR (p.author_id = ANY($2::bigint[]) AND public.can_view_posts(p.author_id, $6)))
+			AND NOT public.is_blocked_between(p.author_id, $6)
+	),
+	page AS (
+		SELECT id,
+			rank
+		FROM found
+		WHERE $4 = 0 OR (rank, id) < ($3::float8, $4)
+		ORDER BY rank DESC, id DESC
+		LIMIT $5
+	)
+	SELECT p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		COALESCE(p.repost_of_id, 0) AS repost_of_id,
+		p.is_repost,
+		(
+			SELECT COUNT(*)
+			FROM public.post AS rp
+			WHERE rp.repost_of_id = p.id
+		) AS shares_count,
+		array_agg(DISTINCT pa.file_name) AS attachments,
+		array_agg(DISTINCT pl.user_id) AS liked_by_users,
+		COALESCE(pgp.public_group_id, 0) AS group_id,
+		page.rank,
+		ts_headline('russian', p.content, s.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet
+	FROM page
+	JOIN public.post AS p ON p.id = page.id
+	CROSS JOIN search AS s
+	LEFT JOIN public.post_attachment AS pa ON p.id = pa.post_id
+	LEFT JOIN public.post_like AS pl ON p.id = pl.post_id
+	LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+	GROUP BY p.id,
+		p.author_id,
+		p.content,
+		p.created_at,
+		p.updated_at,
+		pgp.public_group_id,
+		page.rank,
+		s.query
+	ORDER BY page.rank DESC, p.id DESC;
+	`
+	searchCommentsQuery = `
+	WITH search AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	),
+	found AS (
+		SELECT c.id,
+			ts_rank(c.search_vector, s.query)::float8 AS rank
+		FROM public.comment AS c
+		CROSS JOIN search AS s
+		JOIN public.post AS p ON p.id = c.post_id
+		LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+		WHERE c.search_vector @@ s.query
+			AND NOT c.is_hidden
+			AND NOT p.is_hidden
+			AND (pgp.post_id IS NOT NULL OR (p.author_id = ANY($2::bigint[]) AND public.can_view_posts(p.author_id, $6)))
+			AND NOT public.is_blocked_between(p.author_id, $6)
+			AND NOT public.is_blocked_between(c.author_id, $6)
+	),
+	page AS (
+		SELECT id,
+			rank
+		FROM found
+		WHERE $4 = 0 OR (rank, id) < ($3::float8, $4)
+		ORDER BY rank DESC, id DESC
+		LIMIT $5
+	)
+	SELECT c.id,
+		c.post_id,
+		c.author_id,
+		COALESCE(c.parent_id, 0),
+		c.depth,
+		c.content,
+		c.created_at,
+		c.updated_at,
+		(
+			SELECT COUNT(*)
+			FROM public.comment AS r
+			WHERE r.parent_id = c.id
+		) AS replies_count,
+		array_agg(cl.user_id) AS liked_by,
+		page.rank,
+		ts_headline('russian', c.content, s.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet
+	FROM page
+	JOIN public.comment AS c ON c.id = page.id
+	CROSS JOIN search AS s
+	LEFT JOIN public.comment_like AS cl ON cl.comment_id = c.id
+	GROUP BY c.id,
+		page.rank,
+		s.query
+	ORDER BY page.rank DESC, c.id DESC;
+	`
+	countSearchPostsQuery = `
+	WITH search AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	)
+	SELECT COUNT(*)
+	FROM public.post AS p
+	CROSS JOIN search AS s
+	LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+	WHERE p.search_vector @@ s.query
+		AND NOT p.is_hidden
+		AND (pgp.post_id IS NOT NULL OR (p.author_id = ANY($2::bigint[]) AND public.can_view_posts(p.author_id, $3)))
+		AND NOT public.is_blocked_between(p.author_id, $3);
+	`
+	countSearchCommentsQuery = `
+	WITH search AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	)
+	SELECT COUNT(*)
+	FROM public.comment AS c
+	CROSS JOIN search AS s
+	JOIN public.post AS p ON p.id = c.post_id
+	LEFT JOIN public.public_group_post AS pgp ON p.id = pgp.post_id
+	WHERE c.search_vector @@ s.query
+		AND NOT c.is_hidden
+		AND NOT p.is_hidden
+		AND (pgp.post_id IS NOT NULL OR (p.author_id = ANY($2::bigint[]) AND public.can_view_posts(p.author_id, $3)))
+		AND NOT public.is_blocked_between(p.author_id, $3)
+		AND NOT public.is_blocked_between(c.author_id, $3);
+	`
+)
+
+// SearchPosts returns up to limit posts matching the query after the cursor,
+// the most relevant first.
+func (p *Posts) SearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor posts.SearchCursor, limit uint) (hits []posts.PostSearchHit, err error) {
+	authorIDsPGArr := pq.Array(authorIDs)
+
+	contextlogger.LogSQL(ctx, searchPostsQuery, query, authorIDsPGArr, cursor.Rank, cursor.ID, limit, viewerID)
+
+	rows, err := p.db.Query(context.Background(), searchPostsQuery, query, authorIDsPGArr, cursor.Rank, cursor.ID, limit, viewerID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		hit := posts.PostSearchHit{
+			Post: new(domain.Post),
+		}
+
+		var attachments pgtype.TextArray
+		var likedByUsers pgtype.Int8Array
+
+		err = rows.Scan(
+			&hit.Post.ID,
+			&hit.Post.AuthorID,
+			&hit.Post.Content,
+			&hit.Post.CreatedAt.Time,
+			&hit.Post.UpdatedAt.Time,
+			&hit.Post.RepostOfID,
+			&hit.Post.IsRepost,
+			&hit.Post.SharesCount,
+			&attachments,
+			&likedByUsers,
+			&hit.Post.GroupID,
+			&hit.Rank,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return
+		}
+
+		hit.Post.Attachments = utils.TextArrayIntoStringSlice(attachments)
+		hit.Post.LikedByIDs = utils.Int8ArrayIntoUintSlice(likedByUsers)
+
+		hits = append(hits, hit)
+	}
+
+	return
+}
+
+// SearchComments returns up to limit comments of the visible posts matching
+// the query after the cursor, the most relevant first.
+func (p *Posts) SearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor posts.SearchCursor, limit uint) (hits []posts.CommentSearchHit, err error) {
+	authorIDsPGArr := pq.Array(authorIDs)
+
+	contextlogger.LogSQL(ctx, searchCommentsQuery, query, authorIDsPGArr, cursor.Rank, cursor.ID, limit, viewerID)
+
+	rows, err := p.db.Query(context.Background(), searchCommentsQuery, query, authorIDsPGArr, cursor.Rank, cursor.ID, limit, viewerID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		hit := posts.CommentSearchHit{
+			Comment: new(domain.Comment),
+		}
+
+		var likedBy pgtype.Int8Array
+
+		err = rows.Scan(
+			&hit.Comment.ID,
+			&hit.Comment.PostID,
+			&hit.Comment.AuthorID,
+			&hit.Comment.ParentID,
+			&hit.Comment.Depth,
+			&hit.Comment.Content,
+			&hit.Comment.CreatedAt.Time,
+			&hit.Comment.UpdatedAt.Time,
+			&hit.Comment.RepliesCount,
+			&likedBy,
+			&hit.Rank,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return
+		}
+
+		hit.Comment.LikedByIDs = utils.Int8ArrayIntoUintSlice(likedBy)
+
+		hits = append(hits, hit)
+	}
+
+	return
+}
+
+// CountSearchPosts returns the amount of the posts matching the query.
+func (p *Posts) CountSearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint) (count uint, err error) {
+	count, err = p.countSearchHits(ctx, countSearchPostsQuery, viewerID, query, authorIDs)
+	return
+}
+
+// CountSearchComments returns the amount of the comments of the visible posts
+// matching the query.
+func (p *Posts) CountSearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint) (count uint, err error) {
+	count, err = p.countSearchHits(ctx, countSearchCommentsQuery, viewerID, query, authorIDs)
+	return
+}
+
+func (p *Posts) countSearchHits(ctx context.Context, countQuery string, viewerID uint, query string, authorIDs []uint) (count uint, err error) {
+	authorIDsPGArr := pq.Array(authorIDs)
+
+	contextlogger.LogSQL(ctx, countQuery, query, authorIDsPGArr, viewerID)
+
+	err = p.db.QueryRow(context.Background(), countQuery, query, authorIDsPGArr, viewerID).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
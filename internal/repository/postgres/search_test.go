@@ -0,0 +1,195 @@
+package repository_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	repository "socio/internal/repository/postgres"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	"testing"
+
+	"github.com/chrisyxlee/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchPosts(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+	columns := []string{"id", "author_id", "content", "created_at", "updated_at", "repost_of_id", "is_repost", "shares_count", "attachments", "liked_by_users", "group_id", "rank", "snippet"}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []posts.PostSearchHit
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(columns).
+					AddRow(uint(1), uint(2), "hello world", tp.Now(), tp.Now(), uint(0), false, uint(0), pgtype.TextArray{}, pgtype.Int8Array{}, uint(3), 0.5, "<mark>hello</mark> world").
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "hello", gomock.Any(), 0.0, uint(0), uint(21), uint(1)).Return(rows, nil)
+			},
+			expected: []posts.PostSearchHit{
+				{
+					Post: &domain.Post{
+						ID:        1,
+						AuthorID:  2,
+						GroupID:   3,
+						Content:   "hello world",
+						CreatedAt: customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+					},
+					Snippet: "<mark>hello</mark> world",
+					Rank:    0.5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.SearchPosts(context.Background(), 1, "hello", []uint{1}, posts.SearchCursor{}, 21)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SearchPosts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSearchComments(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+	columns := []string{"id", "post_id", "author_id", "parent_id", "depth", "content", "created_at", "updated_at", "replies_count", "liked_by", "rank", "snippet"}
+
+	tests := []struct {
+		name     string
+		mock     func(pool *pgxpoolmock.MockPgxIface)
+		expected []posts.CommentSearchHit
+		wantErr  bool
+	}{
+		{
+			name: "Test OK",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows(columns).
+					AddRow(uint(5), uint(1), uint(2), uint(0), uint(0), "hello world", tp.Now(), tp.Now(), uint(1), pgtype.Int8Array{}, 0.25, "<mark>hello</mark> world").
+					ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), "hello", gomock.Any(), 0.5, uint(7), uint(21), uint(1)).Return(rows, nil)
+			},
+			expected: []posts.CommentSearchHit{
+				{
+					Comment: &domain.Comment{
+						ID:           5,
+						PostID:       1,
+						AuthorID:     2,
+						Content:      "hello world",
+						RepliesCount: 1,
+						CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+					},
+					Snippet: "<mark>hello</mark> world",
+					Rank:    0.25,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test scan error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				rows := pgxpoolmock.NewRows([]string{"err"}).AddRow(ErrRow{}).ToPgxRows()
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(rows, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test query error",
+			mock: func(pool *pgxpoolmock.MockPgxIface) {
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			repo := repository.NewPosts(pool, tp)
+
+			tt.mock(pool)
+
+			got, err := repo.SearchComments(context.Background(), 1, "hello", []uint{1}, posts.SearchCursor{Rank: 0.5, ID: 7}, 21)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SearchComments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCountSearchPosts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+	repo := repository.NewPosts(pool, customtime.MockTimeProvider{})
+
+	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "hello", gomock.Any(), uint(1)).Return(pgxpoolmock.NewRow(uint(4)))
+
+	count, err := repo.CountSearchPosts(context.Background(), 1, "hello", []uint{1})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), count)
+
+	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), "hello", gomock.Any(), uint(1)).Return(ErrInternalRow{})
+
+	_, err = repo.CountSearchComments(context.Background(), 1, "hello", []uint{1})
+	assert.Equal(t, errors.ErrInternal, err)
+}
@@ -28,7 +28,8 @@ const (
 		created_at,
 		updated_at
 	FROM public.sticker
-	WHERE author_id = $1;
+	WHERE author_id = $1
+		AND NOT is_hidden;
 	`
 	GetAllStickersQuery = `
 	SELECT id,
@@ -37,7 +38,8 @@ const (
 		file_name,
 		created_at,
 		updated_at
-	FROM public.sticker;
+	FROM public.sticker
+	WHERE NOT is_hidden;
 	`
 	StoreStickerQuery = `
 	INSERT INTO public.sticker (author_id, name, file_name)
@@ -54,15 +56,55 @@ const (
 	WHERE id = $1;
 	`
 	StoreStickerMessageQuery = `
-	INSERT INTO public.personal_message (sender_id, receiver_id, sticker_id)
-	VALUES ($1, $2, $3)
+	WITH dialog AS (
+		INSERT INTO public.conversation (dialog_user1_id, dialog_user2_id)
+		VALUES (LEAST($1::BIGINT, $2::BIGINT), GREATEST($1::BIGINT, $2::BIGINT))
+		ON CONFLICT (dialog_user1_id, dialog_user2_id) DO UPDATE
+		SET updated_at = now()
+		RETURNING id
+	),
+	dialog_participant AS (
+		INSERT INTO public.conversation_participant (conversation_id, user_id)
+		SELECT DISTINCT dialog.id,
+			participant.id
+		FROM dialog,
+			unnest(ARRAY [$1, $2]::BIGINT []) AS participant(id)
+		ON CONFLICT DO NOTHING
+	)
+	INSERT INTO public.personal_message (sender_id, receiver_id, conversation_id, sticker_id)
+	SELECT $1,
+		$2,
+		dialog.id,
+		$3
+	FROM dialog
 	RETURNING id,
 		sender_id,
 		receiver_id,
+		conversation_id,
 		sticker_id,
 		created_at, 
 		updated_at;
 	`
+	StoreConversationStickerMessageQuery = `
+	INSERT INTO public.personal_message (sender_id, receiver_id, conversation_id, sticker_id)
+	SELECT $1,
+		CASE
+			WHEN c.is_group THEN NULL
+			WHEN c.dialog_user1_id = $1 THEN c.dialog_user2_id
+			ELSE c.dialog_user1_id
+		END,
+		c.id,
+		$3
+	FROM public.conversation AS c
+	WHERE c.id = $2
+	RETURNING id,
+		sender_id,
+		COALESCE(receiver_id, 0),
+		conversation_id,
+		sticker_id,
+		created_at,
+		updated_at;
+	`
 )
 
 func (pm *PersonalMessages) GetStickerByID(ctx context.Context, stickerID uint) (sticker *domain.Sticker, err error) {
@@ -185,15 +227,24 @@ func (pm *PersonalMessages) DeleteSticker(ctx context.Context, stickerID uint) (
 }
 
 func (pm *PersonalMessages) StoreStickerMessage(ctx context.Context, senderID, receiverID, stickerID uint) (newStickerMessage *domain.PersonalMessage, err error) {
+	return pm.storeStickerMessage(ctx, StoreStickerMessageQuery, senderID, receiverID, stickerID)
+}
+
+func (pm *PersonalMessages) StoreConversationStickerMessage(ctx context.Context, senderID, conversationID, stickerID uint) (newStickerMessage *domain.PersonalMessage, err error) {
+	return pm.storeStickerMessage(ctx, StoreConversationStickerMessageQuery, senderID, conversationID, stickerID)
+}
+
+func (pm *PersonalMessages) storeStickerMessage(ctx context.Context, query string, senderID, targetID, stickerID uint) (newStickerMessage *domain.PersonalMessage, err error) {
 	newStickerMessage = new(domain.PersonalMessage)
 	sticker := new(domain.Sticker)
 
-	contextlogger.LogSQL(ctx, StoreStickerMessageQuery, senderID, receiverID, stickerID)
+	contextlogger.LogSQL(ctx, query, senderID, targetID, stickerID)
 
-	err = pm.db.QueryRow(context.Background(), StoreStickerMessageQuery, senderID, receiverID, stickerID).Scan(
+	err = pm.db.QueryRow(context.Background(), query, senderID, targetID, stickerID).Scan(
 		&newStickerMessage.ID,
 		&newStickerMessage.SenderID,
 		&newStickerMessage.ReceiverID,
+		&newStickerMessage.ConversationID,
 		&sticker.ID,
 		&newStickerMessage.CreatedAt.Time,
 		&newStickerMessage.UpdatedAt.Time,
@@ -319,9 +319,10 @@ func TestStoreStickerMessage(t *testing.T) {
 			receiverID: 2,
 			stickerID:  3,
 			want: &domain.PersonalMessage{
-				ID:         1,
-				SenderID:   1,
-				ReceiverID: 2,
+				ID:             1,
+				SenderID:       1,
+				ReceiverID:     2,
+				ConversationID: 1,
 				Sticker: &domain.Sticker{
 					ID:        3,
 					AuthorID:  1,
@@ -336,7 +337,7 @@ func TestStoreStickerMessage(t *testing.T) {
 			wantErr: false,
 			setup: func() {
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StoreStickerMessageQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
-					pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(3), tp.Now(), tp.Now()),
+					pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), uint(3), tp.Now(), tp.Now()),
 				)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(
 					pgxpoolmock.NewRow(uint(3), uint(1), "sticker3", "sticker3.png", tp.Now(), tp.Now()),
@@ -352,7 +353,7 @@ func TestStoreStickerMessage(t *testing.T) {
 			wantErr:    true,
 			setup: func() {
 				mockDB.EXPECT().QueryRow(context.Background(), repository.StoreStickerMessageQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(
-					pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(3), tp.Now(), tp.Now()),
+					pgxpoolmock.NewRow(uint(1), uint(1), uint(2), uint(1), uint(3), tp.Now(), tp.Now()),
 				)
 				mockDB.EXPECT().QueryRow(context.Background(), gomock.Any(), gomock.Any()).Return(
 					ErrRow{},
@@ -9,7 +9,6 @@ import (
 
 	customtime "socio/pkg/time"
 
-	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
 	_ "github.com/lib/pq"
 )
@@ -105,7 +104,8 @@ const (
 		hashed_password = $5,
 		salt = $6,
 		avatar = $7,
-		date_of_birth = $8
+		date_of_birth = $8,
+		email_verified_at = CASE WHEN email = $4 THEN email_verified_at ELSE NULL END
 	WHERE id = $1
 	RETURNING id,
 		first_name,
@@ -141,7 +141,17 @@ const (
 		created_at,
 		updated_at
 	FROM public.user
-	WHERE full_name ILIKE '%' || $1 || '%';
+	WHERE full_name ILIKE '%' || $1 || '%'
+		AND id > $2
+		AND NOT public.is_blocked_between(id, $4)
+	ORDER BY id
+	LIMIT $3;
+	`
+	countUsersByNameQuery = `
+	SELECT COUNT(*)
+	FROM public.user
+	WHERE full_name ILIKE '%' || $1 || '%'
+		AND NOT public.is_blocked_between(id, $2);
 	`
 	getSubscriptionIDsQuery = `
 	SELECT subscribed_to_id
@@ -151,14 +161,16 @@ const (
 )
 
 type Users struct {
-	db DBPool
-	TP customtime.TimeProvider
+	db     DBPool
+	TP     customtime.TimeProvider
+	Hasher hash.PasswordHasher
 }
 
-func NewUsers(db DBPool, tp customtime.TimeProvider) *Users {
+func NewUsers(db DBPool, tp customtime.TimeProvider, hasher hash.PasswordHasher) *Users {
 	return &Users{
-		db: db,
-		TP: tp,
+		db:     db,
+		TP:     tp,
+		Hasher: hasher,
 	}
 }
 
@@ -252,9 +264,11 @@ func (s *Users) GetUserByEmail(ctx context.Context, email string) (user *domain.
 }
 
 func (s *Users) StoreUser(ctx context.Context, user *domain.User) (err error) {
-	salt := uuid.NewString()
-	user.Password = hash.HashPassword(user.Password, []byte(salt))
-	user.Salt = salt
+	user.Password, err = s.Hasher.Hash(user.Password)
+	if err != nil {
+		return
+	}
+	user.Salt = ""
 
 	contextlogger.LogSQL(ctx, storeUserQuery,
 		user.FirstName,
@@ -293,9 +307,11 @@ func (s *Users) UpdateUser(ctx context.Context, user *domain.User, prevPassword
 	updatedUser = &domain.User{}
 
 	if user.Password != prevPassword {
-		salt := uuid.NewString()
-		user.Password = hash.HashPassword(user.Password, []byte(salt))
-		user.Salt = salt
+		user.Password, err = s.Hasher.Hash(user.Password)
+		if err != nil {
+			return
+		}
+		user.Salt = ""
 	}
 
 	contextlogger.LogSQL(ctx, updateUserQuery,
@@ -383,10 +399,10 @@ func (s *Users) DeleteUser(ctx context.Context, userID uint) (err error) {
 	return
 }
 
-func (s *Users) SearchByName(ctx context.Context, query string) (users []*domain.User, err error) {
-	contextlogger.LogSQL(ctx, getUsersByNameQuery, query)
+func (s *Users) SearchByName(ctx context.Context, viewerID uint, query string, lastUserID uint, limit uint) (users []*domain.User, err error) {
+	contextlogger.LogSQL(ctx, getUsersByNameQuery, query, lastUserID, limit, viewerID)
 
-	rows, err := s.db.Query(context.Background(), getUsersByNameQuery, query)
+	rows, err := s.db.Query(context.Background(), getUsersByNameQuery, query, lastUserID, limit, viewerID)
 	if err != nil {
 		return
 	}
@@ -418,6 +434,17 @@ func (s *Users) SearchByName(ctx context.Context, query string) (users []*domain
 	return
 }
 
+func (s *Users) CountByName(ctx context.Context, viewerID uint, query string) (count uint, err error) {
+	contextlogger.LogSQL(ctx, countUsersByNameQuery, query, viewerID)
+
+	err = s.db.QueryRow(context.Background(), countUsersByNameQuery, query, viewerID).Scan(&count)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 func (s *Users) GetSubscriptionIDs(ctx context.Context, userID uint) (subscribedToIDs []uint, err error) {
 	contextlogger.LogSQL(ctx, getSubscriptionIDsQuery, userID)
 
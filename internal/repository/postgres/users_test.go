@@ -6,12 +6,14 @@ import (
 	"socio/domain"
 	"socio/errors"
 	repository "socio/internal/repository/postgres"
+	"socio/pkg/hash"
 	customtime "socio/pkg/time"
 	"testing"
 
 	"github.com/chrisyxlee/pgxpoolmock"
 	"github.com/golang/mock/gomock"
 	"github.com/jackc/pgconn"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestGetUserByID(t *testing.T) {
@@ -38,7 +40,7 @@ func TestGetUserByID(t *testing.T) {
 
 	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(row)
 
-	repo := repository.NewUsers(pool, timeProv)
+	repo := repository.NewUsers(pool, timeProv, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	user, err := repo.GetUserByID(context.Background(), 1)
 	if err != nil {
@@ -76,7 +78,7 @@ func TestGetUserByIDWithSubsInfo(t *testing.T) {
 
 	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(row)
 
-	repo := repository.NewUsers(pool, timeProv)
+	repo := repository.NewUsers(pool, timeProv, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	user, isSubscribedTo, isSubscriber, err := repo.GetUserByIDWithSubsInfo(context.Background(), 1, 2)
 	if err != nil {
@@ -120,7 +122,7 @@ func TestGetUserByEmail(t *testing.T) {
 
 	pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).Return(row)
 
-	repo := repository.NewUsers(pool, timeProv)
+	repo := repository.NewUsers(pool, timeProv, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	user, err := repo.GetUserByEmail(context.Background(), "email")
 	if err != nil {
@@ -164,7 +166,7 @@ func TestStoreUser(t *testing.T) {
 		gomock.Any(),
 	).Return(row)
 
-	repo := repository.NewUsers(pool, timeProv)
+	repo := repository.NewUsers(pool, timeProv, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	err := repo.StoreUser(context.Background(), &domain.User{
 		FirstName: "first_name",
@@ -216,7 +218,7 @@ func TestUpdateUser(t *testing.T) {
 		gomock.Any(),
 	).Return(row)
 
-	repo := repository.NewUsers(pool, timeProv)
+	repo := repository.NewUsers(pool, timeProv, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	user, err := repo.UpdateUser(context.Background(), &domain.User{
 		ID:        1,
@@ -254,7 +256,7 @@ func TestDeleteUser(t *testing.T) {
 	pool.EXPECT().Rollback(gomock.Any()).Return(nil)
 	pool.EXPECT().Commit(gomock.Any()).Return(nil)
 
-	repo := repository.NewUsers(pool, customtime.MockTimeProvider{})
+	repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	err := repo.DeleteUser(context.Background(), 1)
 	if err != nil {
@@ -272,7 +274,7 @@ func TestDeleteUserErr(t *testing.T) {
 	pool.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(pool, nil)
 	pool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound).AnyTimes()
 
-	repo := repository.NewUsers(pool, customtime.MockTimeProvider{})
+	repo := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
 
 	err := repo.DeleteUser(context.Background(), 1)
 	if err != errors.ErrNotFound {
@@ -299,7 +301,7 @@ func TestSearchByName(t *testing.T) {
 				// Mock the getUsersByNameQuery
 				rows := pgxpoolmock.NewRows([]string{"id", "first_name", "last_name", "email", "password", "salt", "avatar", "date_of_birth", "created_at", "updated_at"})
 				rows.AddRow(uint(1), "John", "Doe", "john.doe@example.com", "password", "salt", "avatar", tp.Now(), tp.Now(), tp.Now())
-				pool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(rows.ToPgxRows(), nil)
+				pool.EXPECT().Query(gomock.Any(), gomock.Any(), query, uint(0), uint(21), uint(1)).Return(rows.ToPgxRows(), nil)
 			},
 			expected: []*domain.User{
 				{
@@ -332,11 +334,11 @@ func TestSearchByName(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, tp)
+			users := repository.NewUsers(pool, tp, hash.NewBcryptHasher(bcrypt.MinCost))
 
 			tt.mock(pool, tt.query)
 
-			_, err := users.SearchByName(context.Background(), tt.query)
+			_, err := users.SearchByName(context.Background(), 1, tt.query, 0, 21)
 
 			if err != tt.err {
 				t.Errorf("unexpected error: %v", err)
@@ -345,6 +347,60 @@ func TestSearchByName(t *testing.T) {
 	}
 }
 
+func TestCountByName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    string
+		mock     func(pool *pgxpoolmock.MockPgxIface, query string)
+		expected uint
+		err      error
+	}{
+		{
+			name:  "Test OK",
+			query: "John",
+			mock: func(pool *pgxpoolmock.MockPgxIface, query string) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), query, uint(1)).Return(pgxpoolmock.NewRow(uint(3)))
+			},
+			expected: 3,
+			err:      nil,
+		},
+		{
+			name:  "Test ErrInternal",
+			query: "John",
+			mock: func(pool *pgxpoolmock.MockPgxIface, query string) {
+				pool.EXPECT().QueryRow(gomock.Any(), gomock.Any(), query, uint(1)).Return(ErrInternalRow{})
+			},
+			expected: 0,
+			err:      errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pool := pgxpoolmock.NewMockPgxIface(ctrl)
+
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
+
+			tt.mock(pool, tt.query)
+
+			count, err := users.CountByName(context.Background(), 1, tt.query)
+
+			if err != tt.err {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if count != tt.expected {
+				t.Errorf("unexpected count: %v", count)
+			}
+		})
+	}
+}
+
 func TestGetSubscriptionIDs(t *testing.T) {
 	t.Parallel()
 
@@ -390,7 +446,7 @@ func TestGetSubscriptionIDs(t *testing.T) {
 
 			pool := pgxpoolmock.NewMockPgxIface(ctrl)
 
-			users := repository.NewUsers(pool, customtime.MockTimeProvider{})
+			users := repository.NewUsers(pool, customtime.MockTimeProvider{}, hash.NewBcryptHasher(bcrypt.MinCost))
 
 			tt.mock(pool, tt.userID)
 
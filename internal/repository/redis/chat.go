@@ -1,16 +1,38 @@
 package repository
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"socio/pkg/contextlogger"
-	"socio/pkg/requestcontext"
 	"socio/usecase/chat"
+	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
+const (
+	chatEventsKeyPrefix = "chat_events:"
+	chatEventsMaxLen    = 1000
+	chatEventsTTL       = 7 * 24 * time.Hour
+	chatEventsField     = "action"
+)
+
+// writeActionScript appends the action to the event stream of the receiver
+// and publishes it with the new event ID in one step, so live subscribers
+// always get the events in the stream order.
+var writeActionScript = redis.NewScript(1, `
+local id = redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[1], '*', ARGV[2], ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+redis.call('PUBLISH', ARGV[5], id .. ' ' .. ARGV[3])
+return id
+`)
+
+func getChatEventsKey(userID uint) string {
+	return chatEventsKeyPrefix + fmt.Sprint(userID)
+}
+
 type ChatPubSub struct {
 	pool *redis.Pool
 }
@@ -21,6 +43,9 @@ func NewChatPubSub(pool *redis.Pool) (chatPubSub *ChatPubSub) {
 	}
 }
 
+// ReadActions delivers the actions published to the user. Every message is
+// the event ID followed by a space and the action, the event ID is empty for
+// ephemeral actions.
 func (c *ChatPubSub) ReadActions(ctx context.Context, userID uint, ch chan *chat.Action) (err error) {
 	conn := c.pool.Get()
 	defer func() {
@@ -42,11 +67,14 @@ func (c *ChatPubSub) ReadActions(ctx context.Context, userID uint, ch chan *chat
 	for {
 		switch v := psc.Receive().(type) {
 		case redis.Message:
+			eventID, data, _ := strings.Cut(string(v.Data), " ")
+
 			action := new(chat.Action)
-			err = json.NewDecoder(bytes.NewReader(v.Data)).Decode(action)
+			err = json.NewDecoder(strings.NewReader(data)).Decode(action)
 			if err != nil {
 				return
 			}
+			action.EventID = eventID
 
 			contextlogger.LogRedisAction(ctx, "RECEIVE", action.Receiver, action)
 
@@ -55,7 +83,75 @@ func (c *ChatPubSub) ReadActions(ctx context.Context, userID uint, ch chan *chat
 	}
 }
 
-func (c *ChatPubSub) WriteAction(ctx context.Context, action *chat.Action) (err error) {
+// ReadActionsAfter returns the logged actions of the user with event IDs
+// greater than lastEventID, oldest first.
+func (c *ChatPubSub) ReadActionsAfter(ctx context.Context, userID uint, lastEventID string) (actions []*chat.Action, err error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	contextlogger.LogRedisAction(ctx, "XREAD", getChatEventsKey(userID), lastEventID)
+
+	reply, err := redis.Values(conn.Do("XREAD", "COUNT", chatEventsMaxLen, "STREAMS", getChatEventsKey(userID), lastEventID))
+	if err == redis.ErrNil {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	for _, streamData := range reply {
+		stream, err := redis.Values(streamData, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(stream) != 2 {
+			continue
+		}
+
+		entries, err := redis.Values(stream[1], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entryData := range entries {
+			entry, err := redis.Values(entryData, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(entry) != 2 {
+				continue
+			}
+
+			eventID, err := redis.String(entry[0], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			fields, err := redis.StringMap(entry[1], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			action := new(chat.Action)
+			err = json.NewDecoder(strings.NewReader(fields[chatEventsField])).Decode(action)
+			if err != nil {
+				return nil, err
+			}
+			action.EventID = eventID
+
+			actions = append(actions, action)
+		}
+	}
+
+	return
+}
+
+// WriteAction logs the action to the event stream of every receiver and
+// publishes it to their channels.
+func (c *ChatPubSub) WriteAction(ctx context.Context, action *chat.Action, receivers []uint) (err error) {
 	conn := c.pool.Get()
 	defer func() {
 		err = conn.Close()
@@ -69,25 +165,59 @@ func (c *ChatPubSub) WriteAction(ctx context.Context, action *chat.Action) (err
 		return
 	}
 
-	contextlogger.LogRedisAction(ctx, "PUBLISH", action.Receiver, action)
+	published := make(map[uint]struct{}, len(receivers))
 
-	_, err = conn.Do("PUBLISH", action.Receiver, data)
-	if err != nil {
-		return
+	for _, receiver := range receivers {
+		if _, ok := published[receiver]; ok {
+			continue
+		}
+
+		contextlogger.LogRedisAction(ctx, "XADD", getChatEventsKey(receiver), action)
+
+		_, err = writeActionScript.Do(conn, getChatEventsKey(receiver), chatEventsMaxLen, chatEventsField, data, int(chatEventsTTL.Seconds()), receiver)
+		if err != nil {
+			return
+		}
+
+		published[receiver] = struct{}{}
 	}
 
-	senderID, err := requestcontext.GetUserID(ctx)
+	return
+}
+
+// PublishAction publishes the action to the channel of every receiver
+// without logging it, so it is lost for disconnected clients.
+func (c *ChatPubSub) PublishAction(ctx context.Context, action *chat.Action, receivers []uint) (err error) {
+	conn := c.pool.Get()
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			return
+		}
+	}()
+
+	data, err := json.Marshal(action)
 	if err != nil {
 		return
 	}
 
-	if senderID != action.Receiver {
-		contextlogger.LogRedisAction(ctx, "PUBLISH", senderID, action)
+	message := append([]byte{' '}, data...)
+
+	published := make(map[uint]struct{}, len(receivers))
 
-		_, err = conn.Do("PUBLISH", senderID, data)
+	for _, receiver := range receivers {
+		if _, ok := published[receiver]; ok {
+			continue
+		}
+
+		contextlogger.LogRedisAction(ctx, "PUBLISH", receiver, action)
+
+		_, err = conn.Do("PUBLISH", receiver, message)
 		if err != nil {
 			return
 		}
+
+		published[receiver] = struct{}{}
 	}
 
 	return
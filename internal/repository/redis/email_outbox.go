@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	emailOutboxKey = "email_outbox"
+)
+
+// EmailOutbox queues the emails in the redis list, they are sent by the mailer
+// in the order they were queued.
+type EmailOutbox struct {
+	pool Pool
+}
+
+func NewEmailOutbox(pool *redis.Pool) (o *EmailOutbox) {
+	return &EmailOutbox{
+		pool: pool,
+	}
+}
+
+func (o *EmailOutbox) Push(ctx context.Context, email *domain.Email) (err error) {
+	c := o.pool.Get()
+	defer c.Close()
+
+	data, err := easyjson.Marshal(email)
+	if err != nil {
+		return
+	}
+
+	contextlogger.LogRedisAction(ctx, "RPUSH", emailOutboxKey, email.To)
+
+	_, err = c.Do("RPUSH", emailOutboxKey, data)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pop waits up to the timeout for the next email, the email is nil if the
+// outbox stays empty.
+func (o *EmailOutbox) Pop(ctx context.Context, timeout time.Duration) (email *domain.Email, err error) {
+	c := o.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "BLPOP", emailOutboxKey, timeout)
+
+	values, err := redis.ByteSlices(c.Do("BLPOP", emailOutboxKey, timeout.Seconds()))
+	if err == redis.ErrNil {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	email = new(domain.Email)
+
+	err = easyjson.Unmarshal(values[1], email)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
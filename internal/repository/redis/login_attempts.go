@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	loginAttemptsKeyPrefix = "login_attempts:"
+)
+
+// incrLoginFailuresScript counts the failed attempt and returns the amount of
+// the failures within the window started by the first of them.
+var incrLoginFailuresScript = redis.NewScript(1, `
+local failures = redis.call('HINCRBY', KEYS[1], 'failures', 1)
+if failures == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return failures
+`)
+
+func getLoginAttemptsKey(key string) string {
+	return loginAttemptsKeyPrefix + key
+}
+
+func (s *Session) GetLoginLockedUntil(ctx context.Context, keys ...string) (lockedUntil time.Time, err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	for _, key := range keys {
+		contextlogger.LogRedisAction(ctx, "HGET", getLoginAttemptsKey(key), nil)
+
+		var unixTime int64
+
+		unixTime, err = redis.Int64(c.Do("HGET", getLoginAttemptsKey(key), "locked_until"))
+		if err == redis.ErrNil {
+			err = nil
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		if keyLockedUntil := time.Unix(unixTime, 0); keyLockedUntil.After(lockedUntil) {
+			lockedUntil = keyLockedUntil
+		}
+	}
+
+	return
+}
+
+func (s *Session) IncrLoginFailures(ctx context.Context, key string, window time.Duration) (failures uint, err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "EVALSHA", getLoginAttemptsKey(key), window)
+
+	count, err := redis.Uint64(incrLoginFailuresScript.Do(c, getLoginAttemptsKey(key), window.Milliseconds()))
+	if err != nil {
+		return
+	}
+
+	failures = uint(count)
+
+	return
+}
+
+func (s *Session) LockLogin(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "HSET", getLoginAttemptsKey(key), lockedUntil)
+
+	if err = c.Send("MULTI"); err != nil {
+		return
+	}
+
+	if err = c.Send("HSET", getLoginAttemptsKey(key), "locked_until", lockedUntil.Unix()); err != nil {
+		return
+	}
+
+	if err = c.Send("PEXPIRE", getLoginAttemptsKey(key), ttl.Milliseconds(), "GT"); err != nil {
+		return
+	}
+
+	_, err = c.Do("EXEC")
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Session) ResetLoginFailures(ctx context.Context, key string) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "DEL", getLoginAttemptsKey(key), nil)
+
+	_, err = c.Do("DEL", getLoginAttemptsKey(key))
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	passwordResetKeyPrefix     = "password_reset:"
+	userPasswordResetKeyPrefix = "user_password_reset:"
+)
+
+// storePasswordResetTokenScript replaces the previous token of the user, only
+// the last sent link resets the password.
+var storePasswordResetTokenScript = redis.NewScript(2, `
+local prev = redis.call('GET', KEYS[2])
+if prev then
+	redis.call('DEL', ARGV[3] .. prev)
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+redis.call('SET', KEYS[2], ARGV[4], 'PX', ARGV[2])
+return 1
+`)
+
+func getPasswordResetKey(tokenHash string) string {
+	return passwordResetKeyPrefix + tokenHash
+}
+
+func getUserPasswordResetKey(userID uint) string {
+	return userPasswordResetKeyPrefix + fmt.Sprint(userID)
+}
+
+func (s *Session) StorePasswordResetToken(ctx context.Context, tokenHash string, userID uint, ttl time.Duration) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "EVALSHA", getUserPasswordResetKey(userID), ttl)
+
+	_, err = storePasswordResetTokenScript.Do(c,
+		getPasswordResetKey(tokenHash),
+		getUserPasswordResetKey(userID),
+		userID,
+		ttl.Milliseconds(),
+		passwordResetKeyPrefix,
+		tokenHash,
+	)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// TakePasswordResetToken consumes the token, so the link resets the password
+// only once.
+func (s *Session) TakePasswordResetToken(ctx context.Context, tokenHash string) (userID uint, err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "GETDEL", passwordResetKeyPrefix, nil)
+
+	id, err := redis.Uint64(c.Do("GETDEL", getPasswordResetKey(tokenHash)))
+	if err == redis.ErrNil {
+		err = errors.ErrInvalidToken
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	userID = uint(id)
+
+	return
+}
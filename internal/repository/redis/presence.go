@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	presenceKeyPrefix = "presence:"
+	lastSeenKeyPrefix = "last_seen:"
+)
+
+// touchPresenceScript refreshes the heartbeat key and the last seen time and
+// returns 1 if the user was already online.
+var touchPresenceScript = redis.NewScript(2, `
+local online = redis.call('EXISTS', KEYS[1])
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+redis.call('SET', KEYS[2], ARGV[1])
+return online
+`)
+
+func getPresenceKey(userID uint) string {
+	return presenceKeyPrefix + fmt.Sprint(userID)
+}
+
+func getLastSeenKey(userID uint) string {
+	return lastSeenKeyPrefix + fmt.Sprint(userID)
+}
+
+type Presence struct {
+	pool *redis.Pool
+}
+
+func NewPresence(pool *redis.Pool) (p *Presence) {
+	return &Presence{
+		pool: pool,
+	}
+}
+
+func (p *Presence) Touch(ctx context.Context, userID uint, seenAt time.Time, ttl time.Duration) (wasOnline bool, err error) {
+	c := p.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "EVALSHA", getPresenceKey(userID), seenAt)
+
+	online, err := redis.Int(touchPresenceScript.Do(c, getPresenceKey(userID), getLastSeenKey(userID), seenAt.Unix(), ttl.Milliseconds()))
+	if err != nil {
+		return
+	}
+
+	wasOnline = online == 1
+	return
+}
+
+func (p *Presence) SetOffline(ctx context.Context, userID uint, seenAt time.Time) (err error) {
+	c := p.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "DEL", getPresenceKey(userID), seenAt)
+
+	if err = c.Send("MULTI"); err != nil {
+		return
+	}
+
+	if err = c.Send("DEL", getPresenceKey(userID)); err != nil {
+		return
+	}
+
+	if err = c.Send("SET", getLastSeenKey(userID), seenAt.Unix()); err != nil {
+		return
+	}
+
+	_, err = c.Do("EXEC")
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Presence) GetPresences(ctx context.Context, userIDs []uint) (presences []*domain.Presence, err error) {
+	presences = make([]*domain.Presence, 0, len(userIDs))
+	if len(userIDs) == 0 {
+		return
+	}
+
+	c := p.pool.Get()
+	defer c.Close()
+
+	presenceKeys := redis.Args{}
+	lastSeenKeys := redis.Args{}
+	for _, userID := range userIDs {
+		presenceKeys = presenceKeys.Add(getPresenceKey(userID))
+		lastSeenKeys = lastSeenKeys.Add(getLastSeenKey(userID))
+	}
+
+	contextlogger.LogRedisAction(ctx, "MGET", presenceKeyPrefix, userIDs)
+
+	online, err := redis.Values(c.Do("MGET", presenceKeys...))
+	if err != nil {
+		return
+	}
+
+	contextlogger.LogRedisAction(ctx, "MGET", lastSeenKeyPrefix, userIDs)
+
+	lastSeen, err := redis.Int64s(c.Do("MGET", lastSeenKeys...))
+	if err != nil {
+		return
+	}
+
+	for i, userID := range userIDs {
+		presence := &domain.Presence{
+			UserID:   userID,
+			IsOnline: online[i] != nil,
+		}
+
+		if lastSeen[i] != 0 {
+			presence.LastSeen.Time = time.Unix(lastSeen[i], 0)
+		}
+
+		presences = append(presences, presence)
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+const (
+	rateLimitKeyPrefix = "rate_limit:"
+)
+
+// slidingWindowScript keeps the request times of the window in the sorted set,
+// drops the ones left the window and records the request if there is room for
+// it. It returns 1 if the request is allowed, otherwise 0 and the amount of
+// milliseconds until the oldest request leaves the window.
+var slidingWindowScript = redis.NewScript(1, `
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+if redis.call('ZCARD', KEYS[1]) < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], window)
+	return {1, 0}
+end
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+return {0, tonumber(oldest[2]) + window - now}
+`)
+
+func getRateLimitKey(key string) string {
+	return rateLimitKeyPrefix + key
+}
+
+type RateLimiter struct {
+	pool Pool
+}
+
+func NewRateLimiter(pool *redis.Pool) (r *RateLimiter) {
+	return &RateLimiter{
+		pool: pool,
+	}
+}
+
+func (r *RateLimiter) Allow(ctx context.Context, key string, limit uint, window time.Duration, now time.Time) (allowed bool, retryAfter time.Duration, err error) {
+	c := r.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "EVALSHA", getRateLimitKey(key), now)
+
+	res, err := redis.Int64s(slidingWindowScript.Do(c, getRateLimitKey(key), now.UnixMilli(), window.Milliseconds(), limit, uuid.NewString()))
+	if err != nil {
+		return
+	}
+
+	allowed = res[0] == 1
+	retryAfter = time.Duration(res[1]) * time.Millisecond
+
+	return
+}
@@ -2,17 +2,85 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"socio/domain"
 	"socio/errors"
 	"socio/pkg/contextlogger"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
 )
 
+const (
+	sessionKeyPrefix      = "session:"
+	userSessionsKeyPrefix = "user_sessions:"
+)
+
+// refreshSessionScript updates the session only if it still exists, so a
+// concurrent logout can not be undone by a request that was already in flight.
+var refreshSessionScript = redis.NewScript(1, `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'last_seen_at', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+func getSessionKey(sessionID string) string {
+	return sessionKeyPrefix + sessionID
+}
+
+func getUserSessionsKey(userID uint) string {
+	return userSessionsKeyPrefix + fmt.Sprint(userID)
+}
+
 type Pool interface {
 	Get() redis.Conn
 }
 
+type sessionHash struct {
+	ID         string `redis:"id"`
+	UserID     uint64 `redis:"user_id"`
+	Device     string `redis:"device"`
+	IP         string `redis:"ip"`
+	UserAgent  string `redis:"user_agent"`
+	CreatedAt  int64  `redis:"created_at"`
+	LastSeenAt int64  `redis:"last_seen_at"`
+	ExpiresAt  int64  `redis:"expires_at"`
+}
+
+func toSessionHash(session *domain.Session) *sessionHash {
+	return &sessionHash{
+		ID:         session.ID,
+		UserID:     uint64(session.UserID),
+		Device:     session.Device,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt.Unix(),
+		LastSeenAt: session.LastSeenAt.Unix(),
+		ExpiresAt:  session.ExpiresAt.Unix(),
+	}
+}
+
+func (h *sessionHash) toSession(sessionID string) *domain.Session {
+	session := &domain.Session{
+		ID:        h.ID,
+		SessionID: sessionID,
+		UserID:    uint(h.UserID),
+		Device:    h.Device,
+		IP:        h.IP,
+		UserAgent: h.UserAgent,
+	}
+
+	session.CreatedAt.Time = time.Unix(h.CreatedAt, 0)
+	session.LastSeenAt.Time = time.Unix(h.LastSeenAt, 0)
+	session.ExpiresAt.Time = time.Unix(h.ExpiresAt, 0)
+
+	return session
+}
+
 type Session struct {
 	pool Pool
 }
@@ -23,19 +91,78 @@ func NewSession(pool *redis.Pool) (s *Session) {
 	}
 }
 
-func (s *Session) CreateSession(ctx context.Context, userID uint) (sessionID string, err error) {
+func (s *Session) CreateSession(ctx context.Context, session *domain.Session, ttl time.Duration) (sessionID string, err error) {
 	c := s.pool.Get()
 	defer c.Close()
 
 	sessionID = uuid.NewString()
+	session.SessionID = sessionID
+	session.ID = uuid.NewString()
+
+	sessionKey := getSessionKey(sessionID)
+	userSessionsKey := getUserSessionsKey(session.UserID)
+
+	contextlogger.LogRedisAction(ctx, "HSET", "SESSION_ID", session.UserID)
+
+	if err = c.Send("MULTI"); err != nil {
+		return
+	}
+
+	if err = c.Send("HSET", redis.Args{}.Add(sessionKey).AddFlat(toSessionHash(session))...); err != nil {
+		return
+	}
+
+	if err = c.Send("PEXPIRE", sessionKey, ttl.Milliseconds()); err != nil {
+		return
+	}
+
+	if err = c.Send("HSET", userSessionsKey, session.ID, sessionID); err != nil {
+		return
+	}
+
+	// every session of the user expires before the newest one does
+	if err = c.Send("EXPIREAT", userSessionsKey, session.ExpiresAt.Unix()); err != nil {
+		return
+	}
+
+	_, err = c.Do("EXEC")
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Session) GetSession(ctx context.Context, sessionID string) (session *domain.Session, err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	contextlogger.LogRedisAction(ctx, "HGETALL", "SESSION_ID", nil)
+
+	session, err = getSession(c, sessionID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Session) RefreshSession(ctx context.Context, sessionID string, lastSeenAt time.Time, ttl time.Duration) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
 
-	contextlogger.LogRedisAction(ctx, "SET", "SESSION_ID", userID)
+	contextlogger.LogRedisAction(ctx, "EVALSHA", "SESSION_ID", lastSeenAt)
 
-	_, err = c.Do("SET", sessionID, userID)
+	refreshed, err := redis.Int(refreshSessionScript.Do(c, getSessionKey(sessionID), lastSeenAt.Unix(), ttl.Milliseconds()))
 	if err != nil {
 		return
 	}
 
+	if refreshed == 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
 	return
 }
 
@@ -45,33 +172,175 @@ func (s *Session) DeleteSession(ctx context.Context, sessionID string) (err erro
 
 	contextlogger.LogRedisAction(ctx, "DEL", "SESSION_ID", nil)
 
-	_, err = c.Do("DEL", sessionID)
+	session, err := getSession(c, sessionID)
+	if err == errors.ErrNotFound {
+		_, err = c.Do("DEL", getSessionKey(sessionID))
+		if err != nil {
+			err = errors.ErrNotFound
+		}
+
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	err = deleteSessions(c, session.UserID, map[string]string{session.ID: sessionID})
 	if err != nil {
 		err = errors.ErrNotFound
+		return
 	}
 
 	return
 }
 
-func (s *Session) GetUserIDBySession(ctx context.Context, sessionID string) (userID uint, err error) {
+func (s *Session) GetSessionsByUserID(ctx context.Context, userID uint) (sessions []*domain.Session, err error) {
 	c := s.pool.Get()
 	defer c.Close()
 
-	contextlogger.LogRedisAction(ctx, "GET", "SESSION_ID", nil)
+	userSessionsKey := getUserSessionsKey(userID)
+
+	contextlogger.LogRedisAction(ctx, "HGETALL", userSessionsKey, nil)
 
-	result, err := c.Do("GET", sessionID)
+	sessionIDs, err := redis.StringMap(c.Do("HGETALL", userSessionsKey))
 	if err != nil {
-		err = errors.ErrUnauthorized
 		return
 	}
 
-	userIDData, err := redis.Uint64(result, err)
+	sessions = make([]*domain.Session, 0, len(sessionIDs))
+	expiredIDs := make([]string, 0)
+
+	for id, sessionID := range sessionIDs {
+		session, getErr := getSession(c, sessionID)
+		if getErr == errors.ErrNotFound {
+			expiredIDs = append(expiredIDs, id)
+			continue
+		}
+		if getErr != nil {
+			err = getErr
+			return
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	if len(expiredIDs) > 0 {
+		contextlogger.LogRedisAction(ctx, "HDEL", userSessionsKey, expiredIDs)
+
+		_, err = c.Do("HDEL", redis.Args{}.Add(userSessionsKey).AddFlat(expiredIDs)...)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Session) DeleteSessionByID(ctx context.Context, userID uint, id string) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	userSessionsKey := getUserSessionsKey(userID)
+
+	contextlogger.LogRedisAction(ctx, "HGET", userSessionsKey, id)
+
+	sessionID, err := redis.String(c.Do("HGET", userSessionsKey, id))
+	if err == redis.ErrNil {
+		err = errors.ErrNotFound
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	contextlogger.LogRedisAction(ctx, "DEL", "SESSION_ID", nil)
+
+	err = deleteSessions(c, userID, map[string]string{id: sessionID})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Session) DeleteSessionsByUserID(ctx context.Context, userID uint, exceptSessionID string) (err error) {
+	c := s.pool.Get()
+	defer c.Close()
+
+	userSessionsKey := getUserSessionsKey(userID)
+
+	contextlogger.LogRedisAction(ctx, "HGETALL", userSessionsKey, nil)
+
+	sessionIDs, err := redis.StringMap(c.Do("HGETALL", userSessionsKey))
 	if err != nil {
-		err = errors.ErrUnauthorized
 		return
 	}
 
-	userID = uint(userIDData)
+	for id, sessionID := range sessionIDs {
+		if sessionID == exceptSessionID {
+			delete(sessionIDs, id)
+		}
+	}
+
+	if len(sessionIDs) == 0 {
+		return
+	}
+
+	contextlogger.LogRedisAction(ctx, "DEL", userSessionsKey, len(sessionIDs))
+
+	err = deleteSessions(c, userID, sessionIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func getSession(c redis.Conn, sessionID string) (session *domain.Session, err error) {
+	values, err := redis.Values(c.Do("HGETALL", getSessionKey(sessionID)))
+	if err != nil {
+		return
+	}
+
+	if len(values) == 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
+	hash := new(sessionHash)
+	err = redis.ScanStruct(values, hash)
+	if err != nil {
+		return
+	}
+
+	session = hash.toSession(sessionID)
+
+	return
+}
+
+// deleteSessions removes sessions and their entries in the user index,
+// sessionIDs maps public session IDs to session IDs.
+func deleteSessions(c redis.Conn, userID uint, sessionIDs map[string]string) (err error) {
+	userSessionsKey := getUserSessionsKey(userID)
+
+	if err = c.Send("MULTI"); err != nil {
+		return
+	}
+
+	for id, sessionID := range sessionIDs {
+		if err = c.Send("DEL", getSessionKey(sessionID)); err != nil {
+			return
+		}
+
+		if err = c.Send("HDEL", userSessionsKey, id); err != nil {
+			return
+		}
+	}
+
+	_, err = c.Do("EXEC")
+	if err != nil {
+		return
+	}
 
 	return
 }
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/contextlogger"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	uploadKeyPrefix      = "upload:"
+	uploadLockKeyPrefix  = "upload_lock:"
+	userUploadsKeyPrefix = "user_uploads:"
+)
+
+// openUploadScript drops the expired uploads of the user and adds the new
+// one if the user has less than the limit open. The uploads are scored by
+// their expiration time.
+var openUploadScript = redis.NewScript(1, `
+local now = redis.call('TIME')
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now[1] * 1000 + math.floor(now[2] / 1000))
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+return 1
+`)
+
+func getUploadKey(uploadID string) string {
+	return uploadKeyPrefix + uploadID
+}
+
+func getUploadLockKey(uploadID string) string {
+	return uploadLockKeyPrefix + uploadID
+}
+
+func getUserUploadsKey(userID uint) string {
+	return userUploadsKeyPrefix + fmt.Sprint(userID)
+}
+
+type Uploads struct {
+	pool Pool
+}
+
+func NewUploads(pool *redis.Pool) (u *Uploads) {
+	return &Uploads{
+		pool: pool,
+	}
+}
+
+func (u *Uploads) StoreUpload(ctx context.Context, upload *domain.Upload, ttl time.Duration) (err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	data, err := easyjson.Marshal(upload)
+	if err != nil {
+		return
+	}
+
+	uploadKey := getUploadKey(upload.ID)
+
+	contextlogger.LogRedisAction(ctx, "SET", uploadKey, upload.Offset)
+
+	_, err = c.Do("SET", uploadKey, data, "PX", ttl.Milliseconds())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (u *Uploads) GetUpload(ctx context.Context, uploadID string) (upload *domain.Upload, err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	uploadKey := getUploadKey(uploadID)
+
+	contextlogger.LogRedisAction(ctx, "GET", uploadKey, nil)
+
+	data, err := redis.Bytes(c.Do("GET", uploadKey))
+	if err == redis.ErrNil {
+		err = errors.ErrNotFound
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	upload = new(domain.Upload)
+
+	err = easyjson.Unmarshal(data, upload)
+	if err != nil {
+		upload = nil
+		return
+	}
+
+	return
+}
+
+func (u *Uploads) DeleteUpload(ctx context.Context, uploadID string) (err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	uploadKey := getUploadKey(uploadID)
+
+	contextlogger.LogRedisAction(ctx, "DEL", uploadKey, nil)
+
+	_, err = c.Do("DEL", uploadKey)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// LockUpload lets only one request write the chunks of the upload at a time,
+// the lock expires after ttl if its holder never unlocks it.
+func (u *Uploads) LockUpload(ctx context.Context, uploadID string, ttl time.Duration) (locked bool, err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	lockKey := getUploadLockKey(uploadID)
+
+	contextlogger.LogRedisAction(ctx, "SET", lockKey, "NX")
+
+	_, err = redis.String(c.Do("SET", lockKey, 1, "NX", "PX", ttl.Milliseconds()))
+	if err == redis.ErrNil {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	locked = true
+	return
+}
+
+func (u *Uploads) UnlockUpload(ctx context.Context, uploadID string) (err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	lockKey := getUploadLockKey(uploadID)
+
+	contextlogger.LogRedisAction(ctx, "DEL", lockKey, nil)
+
+	_, err = c.Do("DEL", lockKey)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// OpenUpload counts the upload among the open uploads of the user until it
+// expires or is closed, opened is false if the user already has limit open.
+func (u *Uploads) OpenUpload(ctx context.Context, userID uint, uploadID string, expiresAt time.Time, limit int) (opened bool, err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	userUploadsKey := getUserUploadsKey(userID)
+
+	contextlogger.LogRedisAction(ctx, "EVALSHA", userUploadsKey, uploadID)
+
+	opened, err = redis.Bool(openUploadScript.Do(c,
+		userUploadsKey,
+		expiresAt.UnixMilli(),
+		uploadID,
+		limit,
+	))
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (u *Uploads) CloseUpload(ctx context.Context, userID uint, uploadID string) (err error) {
+	c := u.pool.Get()
+	defer c.Close()
+
+	userUploadsKey := getUserUploadsKey(userID)
+
+	contextlogger.LogRedisAction(ctx, "ZREM", userUploadsKey, uploadID)
+
+	_, err = c.Do("ZREM", userUploadsKey, uploadID)
+	if err != nil {
+		return
+	}
+
+	return
+}
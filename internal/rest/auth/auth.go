@@ -7,8 +7,10 @@ import (
 	authpb "socio/internal/grpc/auth/proto"
 	uspb "socio/internal/grpc/user/proto"
 	"socio/internal/rest/uploaders"
+	"socio/pkg/clientip"
 	"socio/pkg/json"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"socio/usecase/auth"
 	"socio/usecase/user"
 	"strings"
@@ -35,7 +37,7 @@ func newSessionCookie(sessionID string) *http.Cookie {
 	return &http.Cookie{
 		Name:     "session_id",
 		Value:    sessionID,
-		MaxAge:   10 * 60 * 60,
+		MaxAge:   int(auth.DefaultSessionAbsoluteTTL.Seconds()),
 		HttpOnly: true,
 		Secure:   true,
 		Path:     "/",
@@ -55,7 +57,7 @@ func clearSessionCookie(cookie *http.Cookie) {
 // HandleRegistration godoc
 //
 //	@Summary		handle user's registration flow
-//	@Description	registrate user by his data
+//	@Description	registrate user by his data, the link confirming the email is sent to it
 //	@Tags			auth
 //	@license.name	Apache 2.0
 //	@ID				auth/signup
@@ -72,13 +74,16 @@ func clearSessionCookie(cookie *http.Cookie) {
 //	@Produce		json
 //	@Success		201	{object}	json.JSONResponse{body=domain.User}
 //	@Failure		400	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
-//	@Header			200	{string}	Set-Cookie	"session_id=some_session_id; Path=/; Max-Age=36000; HttpOnly;"
+//	@Header			200	{string}	Set-Cookie	"session_id=some_session_id; Path=/; Max-Age=604800; HttpOnly;"
 //	@Router			/auth/signup/ [post]
 func (api *AuthHandler) HandleRegistration(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(4 * 1024 * 1024)
+	err := upload.ParseMultipartForm(w, r, upload.AvatarKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -95,12 +100,16 @@ func (api *AuthHandler) HandleRegistration(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	regInput.Avatar, err = uploaders.UploadAvatar(r, api.UserClient, avatarFH)
+	avatar, err := uploaders.UploadAvatar(r, api.UserClient, avatarFH)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, err)
+		json.ServeGRPCStatus(r.Context(), w, err)
 		return
 	}
 
+	if avatar != nil {
+		regInput.Avatar = avatar.FileName
+	}
+
 	_, err = api.UserClient.Create(r.Context(), uspb.ToCreateRequest(&regInput))
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -108,8 +117,10 @@ func (api *AuthHandler) HandleRegistration(w http.ResponseWriter, r *http.Reques
 	}
 
 	res, err := api.AuthClient.Login(r.Context(), &authpb.LoginRequest{
-		Email:    regInput.Email,
-		Password: regInput.Password,
+		Email:     regInput.Email,
+		Password:  regInput.Password,
+		Ip:        clientip.FromRequest(r),
+		UserAgent: r.UserAgent(),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -127,7 +138,7 @@ func (api *AuthHandler) HandleRegistration(w http.ResponseWriter, r *http.Reques
 // HandleLogin godoc
 //
 //	@Summary		handle user's login
-//	@Description	login user by email and password
+//	@Description	login user by email and password, the login from the account or the IP is locked for a while after too many failed attempts
 //	@Tags			auth
 //	@license.name	Apache 2.0
 //	@ID				auth/login
@@ -139,9 +150,10 @@ func (api *AuthHandler) HandleRegistration(w http.ResponseWriter, r *http.Reques
 //	@Success		200	{object}	json.JSONResponse{body=domain.User}
 //	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //
-//	@Header			200	{string}	Set-Cookie	"session_id=some_session_id; Path=/; Max-Age=36000; HttpOnly;"
+//	@Header			200	{string}	Set-Cookie	"session_id=some_session_id; Path=/; Max-Age=604800; HttpOnly;"
 //
 //	@Router			/auth/login/ [post]
 func (api *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
@@ -160,8 +172,10 @@ func (api *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := api.AuthClient.Login(r.Context(), &authpb.LoginRequest{
-		Email:    loginInput.Email,
-		Password: loginInput.Password,
+		Email:     loginInput.Email,
+		Password:  loginInput.Password,
+		Ip:        clientip.FromRequest(r),
+		UserAgent: r.UserAgent(),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -78,6 +78,32 @@ func TestHandleLogin(t *testing.T) {
 	}
 }
 
+func TestHandleLoginLockoutIP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+
+	body, _ := json.Marshal(&auth.LoginInput{Email: "test@example.com", Password: "password"})
+	req, _ := http.NewRequest("POST", "/auth/login", bytes.NewBuffer(body))
+	req.Header.Set("X-Forwarded-For", "1.2.3.4, 10.0.0.1")
+	req.Header.Set("X-Real-IP", "10.0.0.1")
+	rr := httptest.NewRecorder()
+
+	// the forged X-Forwarded-For hop must not reset the lockout of the IP
+	mockAuthClient.EXPECT().Login(gomock.Any(), &authpb.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password",
+		Ip:       "10.0.0.1",
+	}).Return(nil, errors.ErrInvalidLoginData.GRPCStatus().Err())
+
+	handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+	handler.HandleLogin(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
 func TestHandleLogout(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
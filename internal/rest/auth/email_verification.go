@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"net/http"
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"socio/usecase/user"
+
+	"github.com/mailru/easyjson"
+)
+
+// HandleVerifyEmail godoc
+//
+//	@Summary		confirm user's email
+//	@Description	confirm the email with the token from the link sent to it, the token works only once
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/verify_email
+//	@Accept			json
+//
+//	@Param			token	body	string	true	"Token from the link"
+//
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/verify-email [post]
+func (api *AuthHandler) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input := new(user.VerifyEmailInput)
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	_, err = api.UserClient.VerifyEmail(r.Context(), &uspb.VerifyEmailRequest{
+		Token: input.Token,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleResendEmailVerification godoc
+//
+//	@Summary		resend the email confirmation link
+//	@Description	send another link confirming the email of the authorized user, the links sent before stop working
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/verify_email_resend
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/verify-email/resend [post]
+func (api *AuthHandler) HandleResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	_, err = api.UserClient.RequestEmailVerification(r.Context(), &uspb.RequestEmailVerificationRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,125 @@
+package rest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	rest "socio/internal/rest/auth"
+	user_mocks "socio/mocks/grpc/user_grpc"
+	"socio/pkg/requestcontext"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleVerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		mock           func(userClient *user_mocks.MockUserClient)
+	}{
+		{
+			name:           "Successful verification",
+			body:           `{"token":"some_token"}`,
+			expectedStatus: http.StatusNoContent,
+			mock: func(userClient *user_mocks.MockUserClient) {
+				userClient.EXPECT().VerifyEmail(gomock.Any(), &uspb.VerifyEmailRequest{
+					Token: "some_token",
+				}).Return(&uspb.VerifyEmailResponse{UserId: 1}, nil)
+			},
+		},
+		{
+			name:           "invalid json",
+			body:           `{"token":`,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *user_mocks.MockUserClient) {},
+		},
+		{
+			name:           "invalid token",
+			body:           `{"token":"some_token"}`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *user_mocks.MockUserClient) {
+				userClient.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidToken.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/auth/verify-email", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+
+			mockUserClient := user_mocks.NewMockUserClient(ctrl)
+			tt.mock(mockUserClient)
+
+			handler := rest.NewAuthHandler(nil, mockUserClient, nil)
+
+			handler.HandleVerifyEmail(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleResendEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectedStatus int
+		mock           func(userClient *user_mocks.MockUserClient)
+	}{
+		{
+			name:           "Successful resend",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusNoContent,
+			mock: func(userClient *user_mocks.MockUserClient) {
+				userClient.EXPECT().RequestEmailVerification(gomock.Any(), &uspb.RequestEmailVerificationRequest{
+					UserId: 1,
+				}).Return(&uspb.RequestEmailVerificationResponse{}, nil)
+			},
+		},
+		{
+			name:           "no user",
+			ctx:            context.Background(),
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *user_mocks.MockUserClient) {},
+		},
+		{
+			name:           "already verified",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *user_mocks.MockUserClient) {
+				userClient.EXPECT().RequestEmailVerification(gomock.Any(), gomock.Any()).Return(nil, errors.ErrEmailAlreadyVerified.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/auth/verify-email/resend", nil)
+			req = req.WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			mockUserClient := user_mocks.NewMockUserClient(ctrl)
+			tt.mock(mockUserClient)
+
+			handler := rest.NewAuthHandler(nil, mockUserClient, nil)
+
+			handler.HandleResendEmailVerification(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"net/http"
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	"socio/pkg/json"
+	"socio/usecase/auth"
+	"strings"
+
+	"github.com/mailru/easyjson"
+)
+
+// HandleRequestPasswordReset godoc
+//
+//	@Summary		request the password reset link
+//	@Description	send the link resetting the password to the email, the answer is the same whether the account with the email exists or not
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/password_reset
+//	@Accept			json
+//
+//	@Param			email	body	string	true	"Email of the user"
+//
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/password-reset [post]
+func (api *AuthHandler) HandleRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input := new(auth.RequestPasswordResetInput)
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	_, err = api.AuthClient.RequestPasswordReset(r.Context(), &authpb.RequestPasswordResetRequest{
+		Email: strings.TrimSpace(input.Email),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleResetPassword godoc
+//
+//	@Summary		reset user's password
+//	@Description	set the new password with the token from the link, all sessions of the user are revoked
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/password_reset_confirm
+//	@Accept			json
+//
+//	@Param			token			body	string	true	"Token from the link"
+//	@Param			password		body	string	true	"New password of the user"			minLength(6)
+//	@Param			repeatPassword	body	string	true	"Repeat new password of the user"	minLength(6)
+//
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/password-reset/confirm [post]
+func (api *AuthHandler) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		return
+	}
+
+	defer r.Body.Close()
+
+	input := new(auth.ResetPasswordInput)
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	_, err = api.AuthClient.ResetPassword(r.Context(), &authpb.ResetPasswordRequest{
+		Token:          input.Token,
+		Password:       input.Password,
+		RepeatPassword: input.RepeatPassword,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,124 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	rest "socio/internal/rest/auth"
+	auth_mocks "socio/mocks/grpc/auth_grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleRequestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		mock           func(authClient *auth_mocks.MockAuthClient)
+	}{
+		{
+			name:           "Successful request",
+			body:           `{"email":" test@example.com "}`,
+			expectedStatus: http.StatusNoContent,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RequestPasswordReset(gomock.Any(), &authpb.RequestPasswordResetRequest{
+					Email: "test@example.com",
+				}).Return(&authpb.RequestPasswordResetResponse{}, nil)
+			},
+		},
+		{
+			name:           "invalid json",
+			body:           `{"email":`,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(authClient *auth_mocks.MockAuthClient) {},
+		},
+		{
+			name:           "err",
+			body:           `{"email":"test@example.com"}`,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RequestPasswordReset(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/auth/password-reset", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+
+			mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+			tt.mock(mockAuthClient)
+
+			handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+			handler.HandleRequestPasswordReset(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		mock           func(authClient *auth_mocks.MockAuthClient)
+	}{
+		{
+			name:           "Successful reset",
+			body:           `{"token":"some_token","password":"password","repeatPassword":"password"}`,
+			expectedStatus: http.StatusNoContent,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().ResetPassword(gomock.Any(), &authpb.ResetPasswordRequest{
+					Token:          "some_token",
+					Password:       "password",
+					RepeatPassword: "password",
+				}).Return(&authpb.ResetPasswordResponse{}, nil)
+			},
+		},
+		{
+			name:           "invalid json",
+			body:           `{"token":`,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(authClient *auth_mocks.MockAuthClient) {},
+		},
+		{
+			name:           "invalid token",
+			body:           `{"token":"some_token","password":"password","repeatPassword":"password"}`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().ResetPassword(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidToken.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/auth/password-reset/confirm", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+
+			mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+			tt.mock(mockAuthClient)
+
+			handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+			handler.HandleResetPassword(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
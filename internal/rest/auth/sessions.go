@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+
+	"github.com/gorilla/mux"
+)
+
+// HandleListSessions godoc
+//
+//	@Summary		list user's active sessions
+//	@Description	list active sessions of the authorized user, the current session is marked with isCurrent
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/sessions/list
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.Session}
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/sessions/ [get]
+func (api *AuthHandler) HandleListSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	sessionID, err := requestcontext.GetSessionID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	res, err := api.AuthClient.ListSessions(r.Context(), &authpb.ListSessionsRequest{
+		UserId:           uint64(userID),
+		CurrentSessionId: sessionID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, map[string][]*domain.Session{"sessions": authpb.ToSessions(res.Sessions)}, http.StatusOK)
+}
+
+// HandleRevokeSession godoc
+//
+//	@Summary		revoke one of user's sessions
+//	@Description	log out the session with the given id, e.g. on a lost device
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/sessions/revoke
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			sessionID		path	string	true	"Session ID"
+//
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/sessions/{sessionID} [delete]
+func (api *AuthHandler) HandleRevokeSession(w http.ResponseWriter, r *http.Request) {
+	id, ok := mux.Vars(r)["sessionID"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	_, err = api.AuthClient.RevokeSession(r.Context(), &authpb.RevokeSessionRequest{
+		UserId: uint64(userID),
+		Id:     id,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRevokeAllSessions godoc
+//
+//	@Summary		log out everywhere
+//	@Description	revoke all sessions of the authorized user except the current one
+//	@Tags			auth
+//	@license.name	Apache 2.0
+//	@ID				auth/sessions/revoke_all
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Success		204
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/auth/sessions/ [delete]
+func (api *AuthHandler) HandleRevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	sessionID, err := requestcontext.GetSessionID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	_, err = api.AuthClient.RevokeAllSessions(r.Context(), &authpb.RevokeAllSessionsRequest{
+		UserId:          uint64(userID),
+		ExceptSessionId: sessionID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,205 @@
+package rest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	rest "socio/internal/rest/auth"
+	auth_mocks "socio/mocks/grpc/auth_grpc"
+	"socio/pkg/requestcontext"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newSessionContext(userID uint, sessionID string) context.Context {
+	ctx := context.WithValue(context.Background(), requestcontext.UserIDKey, userID)
+	return context.WithValue(ctx, requestcontext.SessionIDKey, sessionID)
+}
+
+func TestHandleListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectedStatus int
+		mock           func(authClient *auth_mocks.MockAuthClient)
+	}{
+		{
+			name:           "Successful list sessions",
+			ctx:            newSessionContext(1, "some_session_id"),
+			expectedStatus: http.StatusOK,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().ListSessions(gomock.Any(), &authpb.ListSessionsRequest{
+					UserId:           1,
+					CurrentSessionId: "some_session_id",
+				}).Return(&authpb.ListSessionsResponse{
+					Sessions: []*authpb.SessionResponse{
+						{
+							Id:         "1",
+							UserId:     1,
+							IsCurrent:  true,
+							CreatedAt:  timestamppb.Now(),
+							LastSeenAt: timestamppb.Now(),
+							ExpiresAt:  timestamppb.Now(),
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "no user",
+			ctx:            context.Background(),
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(authClient *auth_mocks.MockAuthClient) {},
+		},
+		{
+			name:           "err",
+			ctx:            newSessionContext(1, "some_session_id"),
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().ListSessions(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/auth/sessions/", nil)
+			req = req.WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+			tt.mock(mockAuthClient)
+
+			handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+			handler.HandleListSessions(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleRevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		vars           map[string]string
+		expectedStatus int
+		mock           func(authClient *auth_mocks.MockAuthClient)
+	}{
+		{
+			name:           "Successful revoke session",
+			ctx:            newSessionContext(1, "some_session_id"),
+			vars:           map[string]string{"sessionID": "1"},
+			expectedStatus: http.StatusNoContent,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RevokeSession(gomock.Any(), &authpb.RevokeSessionRequest{
+					UserId: 1,
+					Id:     "1",
+				}).Return(&authpb.RevokeSessionResponse{}, nil)
+			},
+		},
+		{
+			name:           "no session id",
+			ctx:            newSessionContext(1, "some_session_id"),
+			vars:           map[string]string{},
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(authClient *auth_mocks.MockAuthClient) {},
+		},
+		{
+			name:           "not found",
+			ctx:            newSessionContext(1, "some_session_id"),
+			vars:           map[string]string{"sessionID": "1"},
+			expectedStatus: http.StatusNotFound,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RevokeSession(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", "/auth/sessions/1", nil)
+			req = req.WithContext(tt.ctx)
+			req = mux.SetURLVars(req, tt.vars)
+			rr := httptest.NewRecorder()
+
+			mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+			tt.mock(mockAuthClient)
+
+			handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+			handler.HandleRevokeSession(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleRevokeAllSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectedStatus int
+		mock           func(authClient *auth_mocks.MockAuthClient)
+	}{
+		{
+			name:           "Successful revoke all sessions",
+			ctx:            newSessionContext(1, "some_session_id"),
+			expectedStatus: http.StatusNoContent,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RevokeAllSessions(gomock.Any(), &authpb.RevokeAllSessionsRequest{
+					UserId:          1,
+					ExceptSessionId: "some_session_id",
+				}).Return(&authpb.RevokeAllSessionsResponse{}, nil)
+			},
+		},
+		{
+			name:           "no session",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(authClient *auth_mocks.MockAuthClient) {},
+		},
+		{
+			name:           "err",
+			ctx:            newSessionContext(1, "some_session_id"),
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(authClient *auth_mocks.MockAuthClient) {
+				authClient.EXPECT().RevokeAllSessions(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", "/auth/sessions/", nil)
+			req = req.WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			mockAuthClient := auth_mocks.NewMockAuthClient(ctrl)
+			tt.mock(mockAuthClient)
+
+			handler := rest.NewAuthHandler(mockAuthClient, nil, nil)
+
+			handler.HandleRevokeAllSessions(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"mime/multipart"
 	"net/http"
+	"slices"
 	"socio/domain"
 	"socio/errors"
 	"socio/internal/rest/middleware"
 	"socio/pkg/json"
 	"socio/pkg/requestcontext"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"socio/usecase/chat"
 	"socio/usecase/csrf"
 	"strconv"
@@ -33,11 +35,24 @@ const (
 	PeerIDQueryParam         = "peerId"
 	LastMessageIDQueryParam  = "lastMessageId"
 	MessagesAmountQueryParam = "messagesAmount"
+	LastEventIDQueryParam    = "lastEventId"
 )
 
 type ChatServer struct {
-	Service ChatService
-	wsConns *sync.Map
+	Service        ChatService
+	AttachmentURLs AttachmentURLService
+	wsConns        *sync.Map
+
+	// replayedEventIDs keeps the last event ID replayed to a connection until
+	// the live actions catch up with it.
+	replayedEventIDs *sync.Map
+}
+
+// AttachmentURLService signs the links to the private message attachments
+// for the users allowed to see them.
+type AttachmentURLService interface {
+	GetAttachmentURL(ctx context.Context, userID, messageID uint, fileName string) (attachmentURL *domain.AttachmentURL, err error)
+	SignMessages(ctx context.Context, userID uint, messages []*domain.PersonalMessage) (err error)
 }
 
 type ChatService interface {
@@ -48,11 +63,18 @@ type ChatService interface {
 	DeleteUnsentMessageAttachments(ctx context.Context, attach *domain.UnsentMessageAttachment) (err error)
 	GetAllStickers(ctx context.Context) (stickers []*domain.Sticker, err error)
 	GetClient(ctx context.Context, userID uint) (c *chat.Client, err error)
+	GetConversation(ctx context.Context, conversationID uint, userID uint) (conversation *domain.Conversation, err error)
+	GetConversationsByUserID(ctx context.Context, userID uint) (conversations []*domain.Conversation, err error)
 	GetDialogsByUserID(ctx context.Context, userID uint) (dialogs []*domain.Dialog, err error)
+	GetMessagesByConversation(ctx context.Context, userID uint, conversationID uint, lastMessageID uint, messagesAmount uint) (messages []*domain.PersonalMessage, err error)
 	GetMessagesByDialog(ctx context.Context, userID uint, peerID uint, lastMessageID uint, messagesAmount uint) (messages []*domain.PersonalMessage, err error)
+	GetMissedActions(ctx context.Context, userID uint, lastEventID string) (actions []*chat.Action, err error)
 	GetStickersByAuthorID(ctx context.Context, authorID uint) (stickers []*domain.Sticker, err error)
+	GetPresences(ctx context.Context, userIDs []uint) (presences []*domain.Presence, err error)
 	GetUnsentMessageAttachments(ctx context.Context, attach *domain.UnsentMessageAttachment) (fileNames []string, err error)
+	Heartbeat(ctx context.Context, userID uint) (err error)
 	Register(ctx context.Context, userID uint) (c *chat.Client, err error)
+	SetOffline(ctx context.Context, userID uint) (err error)
 	Unregister(userID uint) (err error)
 }
 
@@ -62,10 +84,12 @@ var upgrader = &websocket.Upgrader{
 	CheckOrigin:     middleware.CheckOrigin,
 }
 
-func NewChatServer(service ChatService) (chatServer *ChatServer) {
+func NewChatServer(service ChatService, attachmentURLs AttachmentURLService) (chatServer *ChatServer) {
 	return &ChatServer{
-		Service: service,
-		wsConns: &sync.Map{},
+		Service:          service,
+		AttachmentURLs:   attachmentURLs,
+		wsConns:          &sync.Map{},
+		replayedEventIDs: &sync.Map{},
 	}
 }
 
@@ -116,6 +140,17 @@ func (c *ChatServer) HandleGetDialogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	lastMessages := make([]*domain.PersonalMessage, 0, len(dialogs))
+	for _, dialog := range dialogs {
+		lastMessages = append(lastMessages, dialog.LastMessage)
+	}
+
+	err = c.AttachmentURLs.SignMessages(r.Context(), userID, lastMessages)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	json.ServeJSONBody(r.Context(), w, dialogs, http.StatusOK)
 }
 
@@ -190,6 +225,12 @@ func (c *ChatServer) HandleGetMessagesByDialog(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	err = c.AttachmentURLs.SignMessages(r.Context(), userID, messages)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	if messages == nil {
 		messages = make([]*domain.PersonalMessage, 0)
 	}
@@ -197,6 +238,62 @@ func (c *ChatServer) HandleGetMessagesByDialog(w http.ResponseWriter, r *http.Re
 	json.ServeJSONBody(r.Context(), w, messages, http.StatusOK)
 }
 
+// HandleGetAttachmentURL godoc
+//
+//	@Summary		get message attachment url
+//	@Description	get a new short-lived link to the attachment of the message, the user has to be a peer of the dialog or a participant of the conversation
+//	@Tags			chat
+//	@license.name	Apache 2.0
+//	@ID				chat/get_attachment_url
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			messageID	path	uint	true	"ID of the message"
+//	@Param			fileName	path	string	true	"Name of the file"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.AttachmentURL}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/chat/messages/{messageID}/attachments/{fileName} [get]
+func (c *ChatServer) HandleGetAttachmentURL(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	messageIDData, ok := mux.Vars(r)["messageID"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	messageID, err := strconv.ParseUint(messageIDData, 0, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	fileName, ok := mux.Vars(r)["fileName"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	attachmentURL, err := c.AttachmentURLs.GetAttachmentURL(r.Context(), userID, uint(messageID), fileName)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, attachmentURL, http.StatusOK)
+}
+
 // ServeWS godoc
 //
 //		@Summary		serve websocket connection
@@ -205,21 +302,36 @@ func (c *ChatServer) HandleGetMessagesByDialog(w http.ResponseWriter, r *http.Re
 //		@Description	{
 //		@Description	"type": ActionType,
 //		@Description	"receiver": uint,
+//		@Description	"conversationId": uint,
 //		@Description	"csrfToken": string,
 //		@Description	"payload": interface{}
 //		@Description	}
 //		@Description
-//		@Description	ActionType is a string with one of following values: "SEND_MESSAGE", "UPDATE_MESSAGE", "DELETE_MESSAGE", "SEND_STICKER_MESSAGE"
+//		@Description	ActionType is a string with one of following values: "SEND_MESSAGE", "UPDATE_MESSAGE", "DELETE_MESSAGE", "SEND_STICKER_MESSAGE", "MARK_READ", "TYPING_START", "TYPING_STOP",
+//		@Description	"CREATE_CONVERSATION", "INVITE_TO_CONVERSATION", "KICK_FROM_CONVERSATION", "LEAVE_CONVERSATION", "RENAME_CONVERSATION", "SET_CONVERSATION_ROLE"
+//		@Description
+//		@Description	Messages are sent to a group conversation if "conversationId" is set, otherwise to the dialog with "receiver".
+//		@Description	Attachments can only be sent to dialogs.
 //		@Description
 //		@Description	If "type" = "SEND_MESSAGE", then payload should be {"content": string, "attachments": []string}
 //		@Description	If "type" = "UPDATE_MESSAGE", then payload should be {"messageId": uint, "content": string, attachmentsToDelete: []string}
 //		@Description	If "type" = "DELETE_MESSAGE", then payload should be {"messageId": uint}
 //		@Description	If "type" = "SEND_STICKER_MESSAGE", then payload should be {"stickerId": uint}
+//		@Description	If "type" = "MARK_READ", then payload should be {"messageId": uint}, the dialog with "receiver" is marked as read up to this message
+//		@Description	If "type" = "TYPING_START" or "TYPING_STOP", then payload should be {}, the action is only relayed to the peers and never stored
+//		@Description	If "type" = "CREATE_CONVERSATION", then payload should be {"name": string, "participantIds": []uint}
+//		@Description	If "type" = "INVITE_TO_CONVERSATION", then payload should be {"userIds": []uint}
+//		@Description	If "type" = "KICK_FROM_CONVERSATION", then payload should be {"userId": uint}
+//		@Description	If "type" = "LEAVE_CONVERSATION", then payload should be {}
+//		@Description	If "type" = "RENAME_CONVERSATION", then payload should be {"name": string}
+//		@Description	If "type" = "SET_CONVERSATION_ROLE", then payload should be {"userId": uint, "role": "owner" | "admin" | "member"}
 //		@Description
 //		@Description	In response clients, subscribed to corresponding channel, will get same structure back:
 //		@Description	{
+//		@Description	"eventId": string,
 //		@Description	"type": ActionType,
 //		@Description	"receiver": uint,
+//		@Description	"conversationId": uint,
 //	 	@Description	 "csrfToken": string,
 //		@Description	"payload": interface{}
 //		@Description	}
@@ -229,15 +341,24 @@ func (c *ChatServer) HandleGetMessagesByDialog(w http.ResponseWriter, r *http.Re
 //		@Description	PersonalMessage if "type" = "UPDATE_MESSAGE"
 //		@Description	Absent if "type" = "DELETE_MESSAGE"
 //		@Description	PersonalMessage if "type" = "SEND_STICKER_MESSAGE"
+//		@Description	{"userId": uint, "peerId": uint, "lastReadMessageId": uint} if "type" = "MARK_READ"
+//		@Description	{"userId": uint} if "type" = "TYPING_START" or "TYPING_STOP"
+//		@Description	Conversation if "type" is one of the conversation actions
+//		@Description	{"userId": uint, "isOnline": bool, "lastSeen": string} if "type" = "PRESENCE", it is pushed when someone you share a dialog or a conversation with goes online or offline
+//		@Description	Notification if "type" = "NOTIFICATION", it is pushed when someone likes or comments your post, subscribes to you or posts in your group
 //		@Description	{"error": string} if error happened at any point of query processing
 //		@Description
+//		@Description	"eventId" is set for every action except "TYPING_START", "TYPING_STOP" and "PRESENCE", event IDs grow monotonically.
+//		@Description	Pass the last received "eventId" as "lastEventId" when reconnecting to get the missed actions first, every action is delivered to the connection once.
+//		@Description
 //
 //		@Tags			chat
 //		@license.name	Apache 2.0
 //		@ID				chat/serve_ws
 //		@Accept			json
 //
-//		@Param			Cookie	header	string	true	"session_id=some_session"
+//		@Param			Cookie		header	string	true	"session_id=some_session"
+//		@Param			lastEventId	query	string	false	"ID of the last received event, missed actions after it are sent first"
 //
 //		@Produce		json
 //		@Success		200
@@ -264,6 +385,14 @@ func (c *ChatServer) ServeWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the connection is added only after the missed actions are replayed to it
+	client.ConnsMu.Lock()
+
+	lastEventID := r.URL.Query().Get(LastEventIDQueryParam)
+	if lastEventID != "" {
+		c.replayMissedActions(r.Context(), client, conn, lastEventID)
+	}
+
 	conns, ok := c.wsConns.Load(userID)
 	if !ok {
 		conns = make([]*websocket.Conn, 0, 1)
@@ -276,10 +405,85 @@ func (c *ChatServer) ServeWS(w http.ResponseWriter, r *http.Request) {
 		c.wsConns.Store(userID, conns)
 	}
 
+	client.ConnsMu.Unlock()
+
 	go c.listenRead(r.Context(), conn, client)
 }
 
+// replayMissedActions sends the actions the user missed while disconnected to
+// the new connection only, it is called with client.ConnsMu held before the
+// connection is added to the user connections. The replayed actions may still
+// be waiting in client.Send, listenWrite skips them for this connection.
+func (c *ChatServer) replayMissedActions(ctx context.Context, client *chat.Client, conn *websocket.Conn, lastEventID string) {
+	actions, err := c.Service.GetMissedActions(ctx, client.UserID, lastEventID)
+	if err != nil {
+		return
+	}
+
+	if len(actions) == 0 {
+		return
+	}
+
+	messages := make([][]byte, 0, len(actions))
+	for _, action := range actions {
+		messageData, err := easyjson.Marshal(action)
+		if err != nil {
+			return
+		}
+
+		messages = append(messages, messageData)
+	}
+
+	c.replayedEventIDs.Store(conn, actions[len(actions)-1].EventID)
+
+	c.sendMessages(ctx, client, conn, messages)
+}
+
+// skipReplayed drops the actions the connection already got from
+// replayMissedActions. The live actions come in the event order, so the first
+// one after the replayed ID ends the skipping.
+func (c *ChatServer) skipReplayed(conn *websocket.Conn, actions []*chat.Action, messages [][]byte) (fresh [][]byte) {
+	untypedLastEventID, ok := c.replayedEventIDs.Load(conn)
+	if !ok {
+		fresh = messages
+		return
+	}
+
+	lastEventID, _ := untypedLastEventID.(string)
+
+	fresh = make([][]byte, 0, len(messages))
+	for i, action := range actions {
+		if action.EventID == "" {
+			fresh = append(fresh, messages[i])
+			continue
+		}
+
+		if !chat.EventIDAfter(action.EventID, lastEventID) {
+			continue
+		}
+
+		c.replayedEventIDs.Delete(conn)
+		fresh = append(fresh, messages[i:]...)
+		return
+	}
+
+	return
+}
+
 func (c *ChatServer) listenRead(ctx context.Context, conn *websocket.Conn, client *chat.Client) {
+	defer func() {
+		_, ok := c.getWSConns(client.UserID)
+		if ok {
+			return
+		}
+
+		// the last connection of the user is closed
+		err := c.Service.SetOffline(ctx, client.UserID)
+		if err != nil {
+			return
+		}
+	}()
+
 	defer func() {
 		err := conn.Close()
 		if err != nil {
@@ -287,18 +491,28 @@ func (c *ChatServer) listenRead(ctx context.Context, conn *websocket.Conn, clien
 		}
 	}()
 
+	defer func() {
+		err := c.cleanupConn(client.UserID, conn)
+		if err != nil {
+			return
+		}
+	}()
+
 	conn.SetReadLimit(maxMessageSize)
 	err := conn.SetReadDeadline(time.Now().Add(pongWait))
 	if err != nil {
 		return
 	}
 
+	c.heartbeat(ctx, client.UserID)
+
 	conn.SetPongHandler(func(string) error {
 		err := conn.SetReadDeadline(time.Now().Add(pongWait))
 		if err != nil {
 			return err
 		}
 
+		c.heartbeat(ctx, client.UserID)
 		return nil
 	})
 
@@ -337,6 +551,15 @@ func (c *ChatServer) listenRead(ctx context.Context, conn *websocket.Conn, clien
 	}
 }
 
+// heartbeat refreshes the user presence, presence is best effort so a failed
+// heartbeat never closes the connection.
+func (c *ChatServer) heartbeat(ctx context.Context, userID uint) {
+	err := c.Service.Heartbeat(ctx, userID)
+	if err != nil {
+		return
+	}
+}
+
 func (c *ChatServer) cleanupConn(userID uint, conn *websocket.Conn) (err error) {
 	conns, ok := c.getWSConns(userID)
 	if !ok {
@@ -356,7 +579,10 @@ func (c *ChatServer) cleanupConn(userID uint, conn *websocket.Conn) (err error)
 		c.wsConns.Store(userID, conns)
 	}
 
-	err = conn.WriteMessage(websocket.CloseMessage, []byte{})
+	c.replayedEventIDs.Delete(conn)
+
+	// control messages may be written concurrently with listenWrite
+	err = conn.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(writeWait))
 	if err != nil {
 		err = conn.Close()
 		if err != nil {
@@ -448,19 +674,18 @@ func (c *ChatServer) listenWrite(ctx context.Context, client *chat.Client) {
 
 	for {
 		select {
-		case message := <-client.Send:
-			messages := make([][]byte, 0, len(client.Send)+1)
-
-			messageData, err := easyjson.Marshal(message)
-			if err != nil {
-				return
-			}
-
-			messages = append(messages, messageData)
+		case action := <-client.Send:
+			actions := make([]*chat.Action, 0, len(client.Send)+1)
+			actions = append(actions, action)
 
 			n := len(client.Send)
 			for i := 0; i < n; i++ {
-				messageData, err = easyjson.Marshal(<-client.Send)
+				actions = append(actions, <-client.Send)
+			}
+
+			messages := make([][]byte, 0, len(actions))
+			for _, action := range actions {
+				messageData, err := easyjson.Marshal(action)
 				if err != nil {
 					return
 				}
@@ -468,13 +693,24 @@ func (c *ChatServer) listenWrite(ctx context.Context, client *chat.Client) {
 				messages = append(messages, messageData)
 			}
 
+			// the connections are written here only, one after another, the
+			// write deadline bounds the wait for a slow one
+			client.ConnsMu.Lock()
+
 			conns, ok := c.getWSConns(client.UserID)
-			if !ok {
-				return
+			for _, conn := range slices.Clone(conns) {
+				fresh := c.skipReplayed(conn, actions, messages)
+				if len(fresh) == 0 {
+					continue
+				}
+
+				c.sendMessages(ctx, client, conn, fresh)
 			}
 
-			for _, conn := range conns {
-				go c.sendMessages(ctx, client, conn, messages)
+			client.ConnsMu.Unlock()
+
+			if !ok {
+				return
 			}
 
 		case <-ticker.C:
@@ -597,12 +833,14 @@ func (c *ChatServer) HandleGetAllStickers(w http.ResponseWriter, r *http.Request
 //	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/chat/stickers/ [post]
 func (c *ChatServer) HandleCreateSticker(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(1000 << 20)
+	err := upload.ParseMultipartForm(w, r, upload.StickerKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -801,12 +1039,14 @@ func (c *ChatServer) HandleDeleteUnsentMessageAttachments(w http.ResponseWriter,
 //	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/chat/dialogs/{receiverID}/unsent-attachments/ [post]
 func (c *ChatServer) HandleCreateUnsentMessageAttachments(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(1000 << 20)
+	err := upload.ParseMultipartForm(w, r, upload.MessageAttachmentKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 	senderID, err := requestcontext.GetUserID(r.Context())
@@ -22,6 +22,7 @@ func TestHandleGetDialogs(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mock_rest.NewMockChatService(ctrl)
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
 
 	tests := []struct {
 		name        string
@@ -55,6 +56,7 @@ func TestHandleGetDialogs(t *testing.T) {
 						},
 					},
 				}, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), []*domain.PersonalMessage{{ID: 1}}).Return(nil)
 			},
 		},
 		{
@@ -98,7 +100,7 @@ func TestHandleGetDialogs(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, mockAttachmentURLs)
 
 			req, err := http.NewRequest("GET", "/dialogs", nil)
 			if err != nil {
@@ -126,6 +128,7 @@ func TestHandleGetMessagesByDialog(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mock_rest.NewMockChatService(ctrl)
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
 
 	tests := []struct {
 		name         string
@@ -147,6 +150,7 @@ func TestHandleGetMessagesByDialog(t *testing.T) {
 			ctx:     context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			setup: func() {
 				mockService.EXPECT().GetMessagesByDialog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -212,13 +216,24 @@ func TestHandleGetMessagesByDialog(t *testing.T) {
 				mockService.EXPECT().GetMessagesByDialog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
+		{
+			name:         "test case 8 - signing error",
+			query:        "/dialogs?peerId=2&lastMessageId=&messagesAmount=",
+			wantMessages: nil,
+			wantErr:      errors.ErrInternal,
+			ctx:          context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			setup: func() {
+				mockService.EXPECT().GetMessagesByDialog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.PersonalMessage{{ID: 1, Attachments: []string{"pic.png"}}}, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(errors.ErrInternal)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, mockAttachmentURLs)
 
 			req, err := http.NewRequest("GET", tt.query, nil)
 			if err != nil {
@@ -332,7 +347,7 @@ func TestHandleGetStickersByAuthorID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("GET", "/{authorID}/stickers/", nil)
 			if err != nil {
@@ -409,7 +424,7 @@ func TestHandleGetAllStickers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("GET", "/stickers", nil)
 			if err != nil {
@@ -513,7 +528,7 @@ func TestHandleDeleteSticker(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("DELETE", fmt.Sprintf("/stickers/%d", tt.stickerID), nil)
 			if err != nil {
@@ -621,7 +636,7 @@ func TestHandleGetUnsentMessageAttachments(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("GET", fmt.Sprintf("/users/%d/unsent-attachments", tt.receiverID), nil)
 			if err != nil {
@@ -729,7 +744,7 @@ func TestHandleDeleteUnsentMessageAttachments(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("DELETE", fmt.Sprintf("/users/%d/unsent-attachments", tt.receiverID), nil)
 			if err != nil {
@@ -872,7 +887,7 @@ func TestHandleDeleteUnsentMessageAttachment(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			c := rest.NewChatServer(mockService)
+			c := rest.NewChatServer(mockService, nil)
 
 			req, err := http.NewRequest("DELETE", fmt.Sprintf("/users/%d/unsent-attachments/%s", tt.receiverID, tt.fileName), nil)
 			if err != nil {
@@ -896,3 +911,102 @@ func TestHandleDeleteUnsentMessageAttachment(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetAttachmentURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		muxVars  map[string]string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name: "test case 1 - successful retrieval",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars: map[string]string{
+				"messageID": fmt.Sprintf("%d", 1),
+				"fileName":  "pic.png",
+			},
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockAttachmentURLs.EXPECT().GetAttachmentURL(gomock.Any(), uint(1), uint(1), "pic.png").Return(&domain.AttachmentURL{
+					FileName: "pic.png",
+					URL:      "http://localhost/message-attachments/pic.png?X-Amz-Signature=sig",
+				}, nil)
+			},
+		},
+		{
+			name: "test case 2 - no user in context",
+			ctx:  context.Background(),
+			muxVars: map[string]string{
+				"messageID": fmt.Sprintf("%d", 1),
+				"fileName":  "pic.png",
+			},
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name: "test case 3 - invalid message id",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars: map[string]string{
+				"messageID": "abc",
+				"fileName":  "pic.png",
+			},
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name: "test case 4 - not a participant",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars: map[string]string{
+				"messageID": fmt.Sprintf("%d", 1),
+				"fileName":  "pic.png",
+			},
+			wantCode: http.StatusForbidden,
+			setup: func() {
+				mockAttachmentURLs.EXPECT().GetAttachmentURL(gomock.Any(), uint(1), uint(1), "pic.png").Return(nil, errors.ErrForbidden)
+			},
+		},
+		{
+			name: "test case 5 - attachment not found",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars: map[string]string{
+				"messageID": fmt.Sprintf("%d", 1),
+				"fileName":  "pic.png",
+			},
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockAttachmentURLs.EXPECT().GetAttachmentURL(gomock.Any(), uint(1), uint(1), "pic.png").Return(nil, errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			c := rest.NewChatServer(nil, mockAttachmentURLs)
+
+			req, err := http.NewRequest("GET", "/messages/1/attachments/pic.png", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(tt.ctx)
+
+			req = mux.SetURLVars(req, tt.muxVars)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(c.HandleGetAttachmentURL)
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// HandleGetConversations godoc
+//
+//	@Summary		get user conversations
+//	@Description	get dialogs and group conversations of the user, most recently active first
+//	@Tags			chat
+//	@license.name	Apache 2.0
+//	@ID				chat/get_conversations
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.Conversation}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/chat/conversations [get]
+func (c *ChatServer) HandleGetConversations(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	conversations, err := c.Service.GetConversationsByUserID(r.Context(), userID)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	lastMessages := make([]*domain.PersonalMessage, 0, len(conversations))
+	for _, conversation := range conversations {
+		lastMessages = append(lastMessages, conversation.LastMessage)
+	}
+
+	err = c.AttachmentURLs.SignMessages(r.Context(), userID, lastMessages)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, conversations, http.StatusOK)
+}
+
+// HandleGetConversation godoc
+//
+//	@Summary		get conversation
+//	@Description	get conversation with its participants, the user has to be one of them
+//	@Tags			chat
+//	@license.name	Apache 2.0
+//	@ID				chat/get_conversation
+//	@Accept			json
+//
+//	@Param			conversationID	path	uint	true	"ID of the conversation"
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.Conversation}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/chat/conversations/{conversationID} [get]
+func (c *ChatServer) HandleGetConversation(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	conversationIDData, ok := mux.Vars(r)["conversationID"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	conversationID, err := strconv.ParseUint(conversationIDData, 0, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	conversation, err := c.Service.GetConversation(r.Context(), uint(conversationID), userID)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	err = c.AttachmentURLs.SignMessages(r.Context(), userID, []*domain.PersonalMessage{conversation.LastMessage})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, conversation, http.StatusOK)
+}
+
+// HandleGetMessagesByConversation godoc
+//
+//	@Summary		get messages by conversation
+//	@Description	get messages by conversation with pagination
+//	@Tags			chat
+//	@license.name	Apache 2.0
+//	@ID				chat/get_conversation_messages
+//	@Accept			json
+//
+//	@Param			conversationID	path	uint	true	"ID of the conversation"
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			lastMessageId	query	uint	false	"ID of the last message, if last messages needed, should be set to 0"
+//	@Param			messagesAmount	query	uint	false	"Amount of messages to return"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.PersonalMessage}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/chat/conversations/{conversationID}/messages [get]
+func (c *ChatServer) HandleGetMessagesByConversation(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	conversationIDData, ok := mux.Vars(r)["conversationID"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	conversationID, err := strconv.ParseUint(conversationIDData, 0, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	lastMessageIDData := r.URL.Query().Get(LastMessageIDQueryParam)
+	var lastMessageID uint64
+	if lastMessageIDData != "" {
+		lastMessageID, err = strconv.ParseUint(lastMessageIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	messagesAmountData := r.URL.Query().Get(MessagesAmountQueryParam)
+	var messagesAmount uint64
+	if messagesAmountData != "" {
+		messagesAmount, err = strconv.ParseUint(messagesAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	messages, err := c.Service.GetMessagesByConversation(r.Context(), userID, uint(conversationID), uint(lastMessageID), uint(messagesAmount))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	err = c.AttachmentURLs.SignMessages(r.Context(), userID, messages)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	if messages == nil {
+		messages = make([]*domain.PersonalMessage, 0)
+	}
+
+	json.ServeJSONBody(r.Context(), w, messages, http.StatusOK)
+}
@@ -0,0 +1,254 @@
+package rest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"socio/domain"
+	"socio/errors"
+	rest "socio/internal/rest/chat"
+	mock_rest "socio/mocks/rest/chat"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleGetConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockChatService(ctrl)
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+		setup   func()
+	}{
+		{
+			name:    "test case 1 - successful retrieval",
+			ctx:     context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			wantErr: nil,
+			setup: func() {
+				mockService.EXPECT().GetConversationsByUserID(gomock.Any(), uint(1)).Return([]*domain.Conversation{
+					{
+						ID:      1,
+						Name:    "Friends",
+						IsGroup: true,
+					},
+				}, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "test case 2 - no user in context",
+			ctx:     context.Background(),
+			wantErr: errors.ErrInternal,
+			setup:   func() {},
+		},
+		{
+			name:    "test case 3 - service error",
+			ctx:     context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			wantErr: errors.ErrInternal,
+			setup: func() {
+				mockService.EXPECT().GetConversationsByUserID(gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			c := rest.NewChatServer(mockService, mockAttachmentURLs)
+
+			req, err := http.NewRequest("GET", "/conversations", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(c.HandleGetConversations)
+
+			handler.ServeHTTP(rr, req)
+
+			if tt.wantErr != nil {
+				assert.NotEqual(t, http.StatusOK, rr.Code)
+			} else {
+				assert.Equal(t, http.StatusOK, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandleGetConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockChatService(ctrl)
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		muxVars  map[string]string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetConversation(gomock.Any(), uint(1), uint(1)).Return(&domain.Conversation{
+					ID:      1,
+					Name:    "Friends",
+					IsGroup: true,
+				}, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "test case 2 - not a participant",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusForbidden,
+			setup: func() {
+				mockService.EXPECT().GetConversation(gomock.Any(), uint(1), uint(1)).Return(nil, errors.ErrForbidden)
+			},
+		},
+		{
+			name:     "test case 3 - invalid id",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{"conversationID": "abc"},
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - missing id",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{},
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			c := rest.NewChatServer(mockService, mockAttachmentURLs)
+
+			req, err := http.NewRequest("GET", "/conversations/1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(tt.ctx)
+
+			req = mux.SetURLVars(req, tt.muxVars)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(c.HandleGetConversation)
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetMessagesByConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockChatService(ctrl)
+	mockAttachmentURLs := mock_rest.NewMockAttachmentURLService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		query    string
+		muxVars  map[string]string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:    "?lastMessageId=10&messagesAmount=5",
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetMessagesByConversation(gomock.Any(), uint(1), uint(1), uint(10), uint(5)).Return([]*domain.PersonalMessage{
+					{
+						ID:             9,
+						SenderID:       2,
+						ConversationID: 1,
+						Content:        "Hello",
+					},
+				}, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "test case 2 - defaults",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetMessagesByConversation(gomock.Any(), uint(1), uint(1), uint(0), uint(0)).Return(nil, nil)
+				mockAttachmentURLs.EXPECT().SignMessages(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "test case 3 - invalid last message id",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:    "?lastMessageId=abc",
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - not a participant",
+			ctx:      context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			muxVars:  map[string]string{"conversationID": fmt.Sprintf("%d", 1)},
+			wantCode: http.StatusForbidden,
+			setup: func() {
+				mockService.EXPECT().GetMessagesByConversation(gomock.Any(), uint(1), uint(1), uint(0), uint(0)).Return(nil, errors.ErrForbidden)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			c := rest.NewChatServer(mockService, mockAttachmentURLs)
+
+			req, err := http.NewRequest("GET", "/conversations/1/messages"+tt.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(tt.ctx)
+
+			req = mux.SetURLVars(req, tt.muxVars)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(c.HandleGetMessagesByConversation)
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
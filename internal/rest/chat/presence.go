@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"net/http"
+	"socio/errors"
+	"socio/pkg/json"
+	"strconv"
+	"strings"
+)
+
+const (
+	UserIDsQueryParam = "userIds"
+)
+
+// HandleGetPresences godoc
+//
+//	@Summary		get users presence
+//	@Description	get online status and last seen time of the users
+//	@Tags			chat
+//	@license.name	Apache 2.0
+//	@ID				chat/get_presences
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			userIds			query	string	true	"Comma separated IDs of the users, at most 100"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.Presence}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/chat/presence [get]
+func (c *ChatServer) HandleGetPresences(w http.ResponseWriter, r *http.Request) {
+	userIDsData := r.URL.Query().Get(UserIDsQueryParam)
+	if userIDsData == "" {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	userIDs := make([]uint, 0)
+	for _, userIDData := range strings.Split(userIDsData, ",") {
+		userID, err := strconv.ParseUint(strings.TrimSpace(userIDData), 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+
+		userIDs = append(userIDs, uint(userID))
+	}
+
+	presences, err := c.Service.GetPresences(r.Context(), userIDs)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, presences, http.StatusOK)
+}
@@ -0,0 +1,84 @@
+package rest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"socio/domain"
+	"socio/errors"
+	rest "socio/internal/rest/chat"
+	mock_rest "socio/mocks/rest/chat"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleGetPresences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockChatService(ctrl)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			query:    "?userIds=1,2",
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetPresences(gomock.Any(), []uint{1, 2}).Return([]*domain.Presence{
+					{UserID: 1, IsOnline: true},
+					{UserID: 2, IsOnline: false},
+				}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - no user IDs",
+			query:    "",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - invalid user ID",
+			query:    "?userIds=1,abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - service error",
+			query:    "?userIds=1",
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().GetPresences(gomock.Any(), []uint{1}).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			c := rest.NewChatServer(mockService, nil)
+
+			req, err := http.NewRequest("GET", "/presence"+tt.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)))
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(c.HandleGetPresences)
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+)
+
+// CreateCheckEmailVerifiedMiddleware forbids the users who have not confirmed
+// their email yet to create or update anything, they still can read and delete
+// their own content.
+func CreateCheckEmailVerifiedMiddleware(userClient uspb.UserClient) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost && r.Method != http.MethodPut && r.Method != http.MethodPatch {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			userID, err := requestcontext.GetUserID(ctx)
+			if err != nil {
+				json.ServeJSONError(r.Context(), w, errors.ErrUnauthorized)
+				return
+			}
+
+			isVerified, err := userClient.CheckIfEmailIsVerified(ctx, &uspb.CheckIfEmailIsVerifiedRequest{
+				UserId: uint64(userID),
+			})
+			if err != nil {
+				json.ServeGRPCStatus(r.Context(), w, err)
+				return
+			}
+
+			if !isVerified.IsVerified {
+				json.ServeJSONError(r.Context(), w, errors.ErrEmailNotVerified)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
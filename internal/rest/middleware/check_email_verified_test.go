@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	user_mock "socio/mocks/grpc/user_grpc"
+	"socio/pkg/requestcontext"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCheckEmailVerifiedMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		ctx            context.Context
+		mock           func(userClient *user_mock.MockUserClient)
+		expectedStatus int
+	}{
+		{
+			name:   "Verified",
+			method: http.MethodPost,
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfEmailIsVerified(gomock.Any(), &uspb.CheckIfEmailIsVerifiedRequest{UserId: 1}).Return(&uspb.CheckIfEmailIsVerifiedResponse{IsVerified: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Not verified",
+			method: http.MethodPost,
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfEmailIsVerified(gomock.Any(), &uspb.CheckIfEmailIsVerifiedRequest{UserId: 1}).Return(&uspb.CheckIfEmailIsVerifiedResponse{IsVerified: false}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Not verified reads",
+			method:         http.MethodGet,
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock:           func(userClient *user_mock.MockUserClient) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not verified deletes",
+			method:         http.MethodDelete,
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock:           func(userClient *user_mock.MockUserClient) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "User service error",
+			method: http.MethodPut,
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfEmailIsVerified(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "No user in context",
+			method:         http.MethodPatch,
+			ctx:            context.Background(),
+			mock:           func(userClient *user_mock.MockUserClient) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userClient := user_mock.NewMockUserClient(ctrl)
+			tt.mock(userClient)
+
+			r := httptest.NewRequest(tt.method, "/", nil).WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			CreateCheckEmailVerifiedMiddleware(userClient)(next).ServeHTTP(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
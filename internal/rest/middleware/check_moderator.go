@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+)
+
+func CreateCheckModeratorMiddleware(userClient uspb.UserClient) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID, err := requestcontext.GetUserID(ctx)
+			if err != nil {
+				json.ServeJSONError(r.Context(), w, errors.ErrUnauthorized)
+				return
+			}
+
+			isModerator, err := userClient.CheckIfUserIsModerator(ctx, &uspb.CheckIfUserIsModeratorRequest{
+				UserId: uint64(userID),
+			})
+			if err != nil {
+				json.ServeJSONError(r.Context(), w, errors.ErrForbidden)
+				return
+			}
+
+			if !isModerator.IsModerator {
+				json.ServeJSONError(r.Context(), w, errors.ErrForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	user_mock "socio/mocks/grpc/user_grpc"
+	"socio/pkg/requestcontext"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCheckModeratorMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mock           func(userClient *user_mock.MockUserClient)
+		expectedStatus int
+	}{
+		{
+			name: "Moderator",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfUserIsModerator(gomock.Any(), &uspb.CheckIfUserIsModeratorRequest{UserId: 1}).Return(&uspb.CheckIfUserIsModeratorResponse{IsModerator: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not moderator",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfUserIsModerator(gomock.Any(), &uspb.CheckIfUserIsModeratorRequest{UserId: 1}).Return(&uspb.CheckIfUserIsModeratorResponse{IsModerator: false}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "User service error",
+			ctx:  context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(userClient *user_mock.MockUserClient) {
+				userClient.EXPECT().CheckIfUserIsModerator(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "No user in context",
+			ctx:            context.Background(),
+			mock:           func(userClient *user_mock.MockUserClient) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userClient := user_mock.NewMockUserClient(ctrl)
+			tt.mock(userClient)
+
+			r := httptest.NewRequest("GET", "/", nil).WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			CreateCheckModeratorMiddleware(userClient)(next).ServeHTTP(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"socio/usecase/ratelimit"
+	"time"
+)
 
 var (
 	ALLOWED_HEADERS = []string{
@@ -8,6 +12,18 @@ var (
 		"Accept-Language",
 		"Content-Type",
 		"X-CSRF-Token",
+		"Tus-Resumable",
+		"Upload-Offset",
+		"Upload-Length",
+		"Upload-Metadata",
+		"Upload-Checksum",
+	}
+	EXPOSED_HEADERS = []string{
+		"Location",
+		"Tus-Resumable",
+		"Upload-Offset",
+		"Upload-Length",
+		"Retry-After",
 	}
 	ALLOWED_ORIGINS = []string{
 		"http://localhost",
@@ -23,11 +39,28 @@ var (
 	}
 	ALLOWED_METHODS = []string{
 		http.MethodGet,
+		http.MethodHead,
 		http.MethodPost,
 		http.MethodPut,
+		http.MethodPatch,
 		http.MethodDelete,
 		http.MethodOptions,
 	}
+	RATE_LIMITS = map[string]ratelimit.Limit{
+		"auth/login":                  {Requests: 10, Window: time.Minute},
+		"auth/signup":                 {Requests: 5, Window: 10 * time.Minute},
+		"auth/verify_email":           {Requests: 10, Window: time.Minute},
+		"auth/verify_email_resend":    {Requests: 3, Window: 10 * time.Minute},
+		"auth/password_reset":         {Requests: 5, Window: 10 * time.Minute},
+		"auth/password_reset_confirm": {Requests: 10, Window: time.Minute},
+		"posts/create":                {Requests: 10, Window: time.Minute},
+		"posts/repost":                {Requests: 10, Window: time.Minute},
+		"posts/like":                  {Requests: 60, Window: time.Minute},
+		"posts/unlike":                {Requests: 60, Window: time.Minute},
+		"posts/create_comment":        {Requests: 30, Window: time.Minute},
+		"posts/like_comment":          {Requests: 60, Window: time.Minute},
+		"posts/unlike_comment":        {Requests: 60, Window: time.Minute},
+	}
 )
 
 func CheckOrigin(r *http.Request) bool {
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"socio/errors"
+	"socio/pkg/clientip"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"socio/usecase/ratelimit"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	RetryAfterHeader = "Retry-After"
+)
+
+// CreateRateLimitMiddleware limits the requests to the routes named in
+// RATE_LIMITS. The requests of the authorized users are counted per user,
+// the other ones per IP, so the middleware is used after the auth middleware
+// on the routers that have it.
+func CreateRateLimitMiddleware(rateLimitService *ratelimit.Service) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := mux.CurrentRoute(r)
+			if route == nil || r.Method == http.MethodOptions {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			limit, ok := RATE_LIMITS[route.GetName()]
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			decision := rateLimitService.Allow(r.Context(), route.GetName(), getRateLimitSubject(r), limit)
+			if !decision.Allowed {
+				w.Header().Set(RetryAfterHeader, strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+				json.ServeJSONError(r.Context(), w, errors.ErrTooManyRequests)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+func getRateLimitSubject(r *http.Request) string {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		return "ip:" + clientip.FromRequest(r)
+	}
+
+	return fmt.Sprintf("user:%d", userID)
+}
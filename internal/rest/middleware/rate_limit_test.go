@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_ratelimit "socio/mocks/usecase/ratelimit"
+	"socio/pkg/requestcontext"
+	customtime "socio/pkg/time"
+	"socio/usecase/ratelimit"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRateLimitMiddleware(t *testing.T) {
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		ctx                context.Context
+		headers            map[string]string
+		mock               func(storage *mock_ratelimit.MockRateLimitStorage)
+		expectedStatus     int
+		expectedRetryAfter string
+	}{
+		{
+			name:    "Allowed by IP",
+			method:  http.MethodPost,
+			path:    "/auth/login",
+			ctx:     context.Background(),
+			headers: map[string]string{"X-Forwarded-For": "10.0.0.9, 10.0.0.2", "X-Real-IP": "10.0.0.1"},
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), "auth/login:ip:10.0.0.1", uint(10), time.Minute, customtime.MockTimeProvider{}.Now()).Return(true, time.Duration(0), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Limited by user",
+			method: http.MethodPost,
+			path:   "/posts/like",
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), "posts/like:user:1", uint(60), time.Minute, customtime.MockTimeProvider{}.Now()).Return(false, 14200*time.Millisecond, nil)
+			},
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "15",
+		},
+		{
+			name:   "Storage error",
+			method: http.MethodPost,
+			path:   "/posts/like",
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, time.Duration(0), context.DeadlineExceeded)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Route without limit",
+			method:         http.MethodGet,
+			path:           "/posts/",
+			ctx:            context.Background(),
+			mock:           func(storage *mock_ratelimit.MockRateLimitStorage) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Preflight request",
+			method:         http.MethodOptions,
+			path:           "/auth/login",
+			ctx:            context.Background(),
+			mock:           func(storage *mock_ratelimit.MockRateLimitStorage) {},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_ratelimit.NewMockRateLimitStorage(ctrl)
+			tt.mock(storage)
+
+			rateLimitService := ratelimit.NewRateLimitService(storage)
+			rateLimitService.TimeProvider = customtime.MockTimeProvider{}
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			router := mux.NewRouter()
+			router.Handle("/auth/login", next).Methods("POST", "OPTIONS").Name("auth/login")
+			router.Handle("/posts/like", next).Methods("POST", "OPTIONS").Name("posts/like")
+			router.Handle("/posts/", next).Methods("GET", "OPTIONS")
+			router.Use(CreateRateLimitMiddleware(rateLimitService))
+
+			r := httptest.NewRequest(tt.method, tt.path, nil).WithContext(tt.ctx)
+			for key, value := range tt.headers {
+				r.Header.Set(key, value)
+			}
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedRetryAfter, rr.Header().Get(RetryAfterHeader))
+		})
+	}
+}
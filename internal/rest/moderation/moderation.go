@@ -0,0 +1,475 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	LastCaseIDQueryParam    = "lastCaseId"
+	CasesAmountQueryParam   = "casesAmount"
+	LastEntryIDQueryParam   = "lastEntryId"
+	EntriesAmountQueryParam = "entriesAmount"
+)
+
+type ModerationService interface {
+	Report(ctx context.Context, report *domain.Report) (newReport *domain.Report, err error)
+	GetReportCases(ctx context.Context, lastCaseID, casesAmount uint) (cases []*domain.ReportCase, err error)
+	HideContent(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error)
+	RecordAction(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error)
+	DismissReportCase(ctx context.Context, moderatorID, caseID uint, reason string) (newEntry *domain.ModerationLogEntry, err error)
+	GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) (entries []*domain.ModerationLogEntry, err error)
+}
+
+type ModerationHandler struct {
+	Service           ModerationService
+	UserClient        uspb.UserClient
+	AuthClient        authpb.AuthClient
+	PublicGroupClient pgpb.PublicGroupClient
+}
+
+//easyjson:json
+type ReportInput struct {
+	TargetType domain.ModerationTargetType `json:"targetType"`
+	TargetID   uint                        `json:"targetId"`
+	Reason     domain.ReportReason         `json:"reason"`
+	Comment    string                      `json:"comment"`
+}
+
+//easyjson:json
+type HideContentInput struct {
+	TargetType domain.ModerationTargetType `json:"targetType"`
+	TargetID   uint                        `json:"targetId"`
+	Reason     string                      `json:"reason"`
+}
+
+//easyjson:json
+type ModerationReasonInput struct {
+	Reason string `json:"reason"`
+}
+
+func NewModerationHandler(service ModerationService, userClient uspb.UserClient, authClient authpb.AuthClient, publicGroupClient pgpb.PublicGroupClient) (handler *ModerationHandler) {
+	handler = &ModerationHandler{
+		Service:           service,
+		UserClient:        userClient,
+		AuthClient:        authClient,
+		PublicGroupClient: publicGroupClient,
+	}
+	return
+}
+
+// HandleReport godoc
+//
+//	@Summary		report content
+//	@Description	report a post, a comment, a message, a sticker or a group to the moderators, every target is reported once
+//	@Tags			reports
+//	@license.name	Apache 2.0
+//	@ID				reports/create
+//	@Accept			json
+//
+//	@Param			Cookie			header	string				true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string				true	"CSRF token"
+//	@Param			report			body	rest.ReportInput	true	"Target and reason of the report"
+//
+//	@Produce		json
+//	@Success		201	{object}	json.JSONResponse{body=domain.Report}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/reports/ [post]
+func (h *ModerationHandler) HandleReport(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(ReportInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	report, err := h.Service.Report(r.Context(), &domain.Report{
+		ReporterID: userID,
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Reason:     input.Reason,
+		Comment:    input.Comment,
+	})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, report, http.StatusCreated)
+}
+
+// HandleGetReportCases godoc
+//
+//	@Summary		get moderation queue
+//	@Description	get open report cases, the oldest first, every case gathers the reports about one target, the content held by the content filter has a case without reports
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/reports
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			lastCaseId		query	uint	false	"ID of the last case, if 0 - get first cases"
+//	@Param			casesAmount		query	uint	false	"Amount of cases to get, if 0 - get 20 cases, at most 100"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.ReportCase}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/reports [get]
+func (h *ModerationHandler) HandleGetReportCases(w http.ResponseWriter, r *http.Request) {
+	var lastCaseID, casesAmount uint64
+	var err error
+
+	if lastCaseIDData := r.URL.Query().Get(LastCaseIDQueryParam); lastCaseIDData != "" {
+		lastCaseID, err = strconv.ParseUint(lastCaseIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	if casesAmountData := r.URL.Query().Get(CasesAmountQueryParam); casesAmountData != "" {
+		casesAmount, err = strconv.ParseUint(casesAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	cases, err := h.Service.GetReportCases(r.Context(), uint(lastCaseID), uint(casesAmount))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, cases, http.StatusOK)
+}
+
+// HandleDismissReportCase godoc
+//
+//	@Summary		dismiss report case
+//	@Description	close the open report case without acting on the target, the content held by the content filter is shown
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/reports/dismiss
+//	@Accept			json
+//
+//	@Param			Cookie			header	string						true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string						true	"CSRF token"
+//	@Param			caseID			path	string						true	"ID of the report case"
+//	@Param			reason			body	rest.ModerationReasonInput	true	"Reason of the decision"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.ModerationLogEntry}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/reports/{caseID}/dismiss [post]
+func (h *ModerationHandler) HandleDismissReportCase(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	caseID, err := strconv.ParseUint(mux.Vars(r)["caseID"], 10, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	moderatorID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(ModerationReasonInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	entry, err := h.Service.DismissReportCase(r.Context(), moderatorID, uint(caseID), input.Reason)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, entry, http.StatusOK)
+}
+
+// HandleHideContent godoc
+//
+//	@Summary		hide content
+//	@Description	hide a post, a comment, a message or a sticker from everyone, the open report case of the target is resolved
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/content/hide
+//	@Accept			json
+//
+//	@Param			Cookie			header	string					true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string					true	"CSRF token"
+//	@Param			content			body	rest.HideContentInput	true	"Content to hide and the reason"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.ModerationLogEntry}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/content/hide [post]
+func (h *ModerationHandler) HandleHideContent(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	moderatorID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(HideContentInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	entry, err := h.Service.HideContent(r.Context(), &domain.ModerationLogEntry{
+		ModeratorID: moderatorID,
+		TargetType:  input.TargetType,
+		TargetID:    input.TargetID,
+		Reason:      input.Reason,
+	})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, entry, http.StatusOK)
+}
+
+// HandleBanUser godoc
+//
+//	@Summary		ban user
+//	@Description	forbid the user to log in and revoke all the sessions of the user
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/users/ban
+//	@Accept			json
+//
+//	@Param			Cookie			header	string						true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string						true	"CSRF token"
+//	@Param			userID			path	string						true	"ID of the user to ban"
+//	@Param			reason			body	rest.ModerationReasonInput	true	"Reason of the ban"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.ModerationLogEntry}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/users/{userID}/ban [post]
+func (h *ModerationHandler) HandleBanUser(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	userID, err := strconv.ParseUint(mux.Vars(r)["userID"], 10, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	moderatorID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(ModerationReasonInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	_, err = h.UserClient.BanUser(r.Context(), &uspb.BanUserRequest{
+		UserId:      userID,
+		ModeratorId: uint64(moderatorID),
+		Reason:      input.Reason,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	_, err = h.AuthClient.RevokeAllSessions(r.Context(), &authpb.RevokeAllSessionsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	entry, err := h.Service.RecordAction(r.Context(), &domain.ModerationLogEntry{
+		ModeratorID: moderatorID,
+		Action:      domain.BanUserModerationAction,
+		TargetType:  domain.UserModerationTarget,
+		TargetID:    uint(userID),
+		Reason:      input.Reason,
+	})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, entry, http.StatusOK)
+}
+
+// HandleDeleteGroup godoc
+//
+//	@Summary		delete group
+//	@Description	delete the group with all its posts, the open report case of the group is resolved
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/groups/delete
+//	@Accept			json
+//
+//	@Param			Cookie			header	string						true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string						true	"CSRF token"
+//	@Param			groupID			path	string						true	"ID of the group to delete"
+//	@Param			reason			body	rest.ModerationReasonInput	true	"Reason of the deletion"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.ModerationLogEntry}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/groups/{groupID} [delete]
+func (h *ModerationHandler) HandleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	groupID, err := strconv.ParseUint(mux.Vars(r)["groupID"], 10, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	moderatorID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(ModerationReasonInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	_, err = h.PublicGroupClient.Delete(r.Context(), &pgpb.DeleteRequest{
+		Id: groupID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	entry, err := h.Service.RecordAction(r.Context(), &domain.ModerationLogEntry{
+		ModeratorID: moderatorID,
+		Action:      domain.DeleteGroupModerationAction,
+		TargetType:  domain.GroupModerationTarget,
+		TargetID:    uint(groupID),
+		Reason:      input.Reason,
+	})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, entry, http.StatusOK)
+}
+
+// HandleGetModerationLog godoc
+//
+//	@Summary		get moderation log
+//	@Description	get the actions of all the moderators, the latest first
+//	@Tags			moderation
+//	@license.name	Apache 2.0
+//	@ID				moderation/log
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			lastEntryId		query	uint	false	"ID of the last entry, if 0 - get latest entries"
+//	@Param			entriesAmount	query	uint	false	"Amount of entries to get, if 0 - get 20 entries, at most 100"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.ModerationLogEntry}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/moderation/log [get]
+func (h *ModerationHandler) HandleGetModerationLog(w http.ResponseWriter, r *http.Request) {
+	var lastEntryID, entriesAmount uint64
+	var err error
+
+	if lastEntryIDData := r.URL.Query().Get(LastEntryIDQueryParam); lastEntryIDData != "" {
+		lastEntryID, err = strconv.ParseUint(lastEntryIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	if entriesAmountData := r.URL.Query().Get(EntriesAmountQueryParam); entriesAmountData != "" {
+		entriesAmount, err = strconv.ParseUint(entriesAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	entries, err := h.Service.GetModerationLog(r.Context(), uint(lastEntryID), uint(entriesAmount))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, entries, http.StatusOK)
+}
@@ -0,0 +1,465 @@
+package rest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"socio/domain"
+	"socio/errors"
+	authpb "socio/internal/grpc/auth/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	rest "socio/internal/rest/moderation"
+	mock_auth "socio/mocks/grpc/auth_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/moderation"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validCtx = context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1))
+)
+
+func TestHandleReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - report post",
+			ctx:      validCtx,
+			body:     `{"targetType":"POST","targetId":2,"reason":"SPAM","comment":"ads"}`,
+			wantCode: http.StatusCreated,
+			setup: func() {
+				mockService.EXPECT().Report(gomock.Any(), &domain.Report{
+					ReporterID: 1,
+					TargetType: domain.PostModerationTarget,
+					TargetID:   2,
+					Reason:     domain.SpamReportReason,
+					Comment:    "ads",
+				}).Return(&domain.Report{ID: 1, CaseID: 1}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - no user ID in context",
+			ctx:      context.Background(),
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - invalid json",
+			ctx:      validCtx,
+			body:     `{"targetType"`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - target not found",
+			ctx:      validCtx,
+			body:     `{"targetType":"MESSAGE","targetId":2,"reason":"HARASSMENT"}`,
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockService.EXPECT().Report(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("POST", "/reports/", bytes.NewBufferString(tt.body))
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleReport(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetReportCases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			query:    "?lastCaseId=10&casesAmount=5",
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetReportCases(gomock.Any(), uint(10), uint(5)).Return([]*domain.ReportCase{
+					{ID: 11, TargetType: domain.PostModerationTarget, TargetID: 2, Status: domain.OpenReportCase},
+				}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid last case ID",
+			query:    "?lastCaseId=abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - service error",
+			query:    "",
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().GetReportCases(gomock.Any(), uint(0), uint(0)).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("GET", "/moderation/reports"+tt.query, nil)
+			req = req.WithContext(validCtx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleGetReportCases(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleDismissReportCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+
+	tests := []struct {
+		name     string
+		caseID   string
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - dismiss case",
+			caseID:   "3",
+			body:     `{"reason":"fine"}`,
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().DismissReportCase(gomock.Any(), uint(1), uint(3), "fine").Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid case ID",
+			caseID:   "abc",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - case not open",
+			caseID:   "3",
+			body:     `{}`,
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockService.EXPECT().DismissReportCase(gomock.Any(), uint(1), uint(3), "").Return(nil, errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("POST", "/moderation/reports/"+tt.caseID+"/dismiss", bytes.NewBufferString(tt.body))
+			req = req.WithContext(validCtx)
+			req = mux.SetURLVars(req, map[string]string{"caseID": tt.caseID})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleDismissReportCase(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleHideContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - hide comment",
+			body:     `{"targetType":"COMMENT","targetId":2,"reason":"spam"}`,
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().HideContent(gomock.Any(), &domain.ModerationLogEntry{
+					ModeratorID: 1,
+					TargetType:  domain.CommentModerationTarget,
+					TargetID:    2,
+					Reason:      "spam",
+				}).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid json",
+			body:     `{"targetType"`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - group is not hideable",
+			body:     `{"targetType":"GROUP","targetId":2}`,
+			wantCode: http.StatusBadRequest,
+			setup: func() {
+				mockService.EXPECT().HideContent(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("POST", "/moderation/content/hide", bytes.NewBufferString(tt.body))
+			req = req.WithContext(validCtx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleHideContent(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleBanUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+	mockUserClient := mock_user.NewMockUserClient(ctrl)
+	mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+
+	tests := []struct {
+		name     string
+		userID   string
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - ban user",
+			userID:   "2",
+			body:     `{"reason":"spam"}`,
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockUserClient.EXPECT().BanUser(gomock.Any(), &uspb.BanUserRequest{UserId: 2, ModeratorId: 1, Reason: "spam"}).Return(&uspb.BanUserResponse{}, nil)
+				mockAuthClient.EXPECT().RevokeAllSessions(gomock.Any(), &authpb.RevokeAllSessionsRequest{UserId: 2}).Return(&authpb.RevokeAllSessionsResponse{}, nil)
+				mockService.EXPECT().RecordAction(gomock.Any(), &domain.ModerationLogEntry{
+					ModeratorID: 1,
+					Action:      domain.BanUserModerationAction,
+					TargetType:  domain.UserModerationTarget,
+					TargetID:    2,
+					Reason:      "spam",
+				}).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid user ID",
+			userID:   "abc",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - moderators are not banned",
+			userID:   "2",
+			body:     `{}`,
+			wantCode: http.StatusForbidden,
+			setup: func() {
+				mockUserClient.EXPECT().BanUser(gomock.Any(), gomock.Any()).Return(nil, errors.ErrForbidden.GRPCStatus().Err())
+			},
+		},
+		{
+			name:     "test case 4 - sessions are not revoked",
+			userID:   "2",
+			body:     `{}`,
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockUserClient.EXPECT().BanUser(gomock.Any(), gomock.Any()).Return(&uspb.BanUserResponse{}, nil)
+				mockAuthClient.EXPECT().RevokeAllSessions(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, mockUserClient, mockAuthClient, nil)
+
+			req := httptest.NewRequest("POST", "/moderation/users/"+tt.userID+"/ban", bytes.NewBufferString(tt.body))
+			req = req.WithContext(validCtx)
+			req = mux.SetURLVars(req, map[string]string{"userID": tt.userID})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleBanUser(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleDeleteGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+	mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+
+	tests := []struct {
+		name     string
+		groupID  string
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - delete group",
+			groupID:  "2",
+			body:     `{"reason":"scam"}`,
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockPublicGroupClient.EXPECT().Delete(gomock.Any(), &pgpb.DeleteRequest{Id: 2}).Return(&pgpb.DeleteResponse{}, nil)
+				mockService.EXPECT().RecordAction(gomock.Any(), &domain.ModerationLogEntry{
+					ModeratorID: 1,
+					Action:      domain.DeleteGroupModerationAction,
+					TargetType:  domain.GroupModerationTarget,
+					TargetID:    2,
+					Reason:      "scam",
+				}).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid group ID",
+			groupID:  "abc",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - group not found",
+			groupID:  "2",
+			body:     `{}`,
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockPublicGroupClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, mockPublicGroupClient)
+
+			req := httptest.NewRequest("DELETE", "/moderation/groups/"+tt.groupID, bytes.NewBufferString(tt.body))
+			req = req.WithContext(validCtx)
+			req = mux.SetURLVars(req, map[string]string{"groupID": tt.groupID})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleDeleteGroup(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetModerationLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockModerationService(ctrl)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			query:    "?lastEntryId=10&entriesAmount=5",
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetModerationLog(gomock.Any(), uint(10), uint(5)).Return([]*domain.ModerationLogEntry{{ID: 9}}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - invalid entries amount",
+			query:    "?entriesAmount=-1",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewModerationHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("GET", "/moderation/log"+tt.query, nil)
+			req = req.WithContext(validCtx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleGetModerationLog(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"socio/usecase/notifications"
+	"strconv"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	LastNotificationIDQueryParam  = "lastNotificationId"
+	NotificationsAmountQueryParam = "notificationsAmount"
+)
+
+type NotificationsService interface {
+	GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) (notifications []*domain.Notification, err error)
+	GetUnreadCount(ctx context.Context, userID uint) (count uint, err error)
+	MarkRead(ctx context.Context, userID uint, notificationIDs []uint) (err error)
+}
+
+type NotificationsHandler struct {
+	Service    NotificationsService
+	UserClient uspb.UserClient
+}
+
+func NewNotificationsHandler(service NotificationsService, userClient uspb.UserClient) (handler *NotificationsHandler) {
+	handler = &NotificationsHandler{
+		Service:    service,
+		UserClient: userClient,
+	}
+	return
+}
+
+// HandleGetNotifications godoc
+//
+//	@Summary		get notifications
+//	@Description	get notifications of the user, latest activity first
+//	@Tags			notifications
+//	@license.name	Apache 2.0
+//	@ID				notifications/get
+//	@Accept			json
+//
+//	@Param			Cookie					header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token			header	string	true	"CSRF token"
+//	@Param			lastNotificationId		query	uint	false	"ID of the last notification, if 0 - get first notifications"
+//	@Param			notificationsAmount		query	uint	false	"Amount of notifications to get, if 0 - get 20 notifications, at most 100"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.NotificationWithActor}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/notifications/ [get]
+func (h *NotificationsHandler) HandleGetNotifications(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	lastNotificationIDData := r.URL.Query().Get(LastNotificationIDQueryParam)
+	var lastNotificationID uint64
+
+	if lastNotificationIDData != "" {
+		lastNotificationID, err = strconv.ParseUint(lastNotificationIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	notificationsAmountData := r.URL.Query().Get(NotificationsAmountQueryParam)
+	var notificationsAmount uint64
+
+	if notificationsAmountData != "" {
+		notificationsAmount, err = strconv.ParseUint(notificationsAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	userNotifications, err := h.Service.GetNotifications(r.Context(), userID, uint(lastNotificationID), uint(notificationsAmount))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	actorsByID := make(map[uint]*domain.User)
+	res := make([]*domain.NotificationWithActor, 0, len(userNotifications))
+	for _, notification := range userNotifications {
+		notificationWithActor := &domain.NotificationWithActor{
+			Notification: notification,
+		}
+
+		if notification.LastActorID != 0 {
+			actor, ok := actorsByID[notification.LastActorID]
+			if !ok {
+				actorData, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
+					UserId:   uint64(notification.LastActorID),
+					ViewerId: uint64(userID),
+				})
+				if err != nil {
+					json.ServeGRPCStatus(r.Context(), w, err)
+					return
+				}
+
+				actor = uspb.ToUser(actorData.User)
+				actorsByID[notification.LastActorID] = actor
+			}
+
+			notificationWithActor.LastActor = actor
+		}
+
+		res = append(res, notificationWithActor)
+	}
+
+	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
+}
+
+// HandleGetUnreadCount godoc
+//
+//	@Summary		get unread notifications count
+//	@Description	get amount of unread notifications of the user
+//	@Tags			notifications
+//	@license.name	Apache 2.0
+//	@ID				notifications/unread_count
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=notifications.UnreadCountResponse}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/notifications/unread-count [get]
+func (h *NotificationsHandler) HandleGetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	count, err := h.Service.GetUnreadCount(r.Context(), userID)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, notifications.UnreadCountResponse{Count: count}, http.StatusOK)
+}
+
+// HandleMarkRead godoc
+//
+//	@Summary		mark notifications read
+//	@Description	mark notifications of the user read, all of them if no IDs are given
+//	@Tags			notifications
+//	@license.name	Apache 2.0
+//	@ID				notifications/read
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			notificationIds	body	[]uint	false	"IDs of the notifications"
+//
+//	@Produce		json
+//	@Success		204	{object}	json.JSONResponse
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/notifications/read [post]
+func (h *NotificationsHandler) HandleMarkRead(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := new(notifications.MarkReadInput)
+
+	err = easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	err = h.Service.MarkRead(r.Context(), userID, input.NotificationIDs)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
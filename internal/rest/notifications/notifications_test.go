@@ -0,0 +1,247 @@
+package rest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"socio/domain"
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	rest "socio/internal/rest/notifications"
+	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/notifications"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validCtx = context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1))
+)
+
+func TestHandleGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockNotificationsService(ctrl)
+	mockUserClient := mock_user.NewMockUserClient(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		query    string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			ctx:      validCtx,
+			query:    "?lastNotificationId=10&notificationsAmount=5",
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockService.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(10), uint(5)).Return([]*domain.Notification{
+					{ID: 9, UserID: 1, Type: domain.PostLikeNotification, TargetID: 3, LastActorID: 2},
+					{ID: 8, UserID: 1, Type: domain.CommentNotification, TargetID: 3, LastActorID: 2},
+				}, nil)
+				mockUserClient.EXPECT().GetByID(gomock.Any(), &uspb.GetByIDRequest{UserId: 2, ViewerId: 1}).Return(&uspb.GetByIDResponse{
+					User: &uspb.UserResponse{Id: 2},
+				}, nil).Times(1)
+			},
+		},
+		{
+			name:     "test case 2 - no user ID in context",
+			ctx:      context.Background(),
+			query:    "",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - invalid last notification ID",
+			ctx:      validCtx,
+			query:    "?lastNotificationId=abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - invalid notifications amount",
+			ctx:      validCtx,
+			query:    "?notificationsAmount=-1",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 5 - service error",
+			ctx:      validCtx,
+			query:    "",
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(0), uint(0)).Return(nil, errors.ErrInternal)
+			},
+		},
+		{
+			name:     "test case 6 - user client error",
+			ctx:      validCtx,
+			query:    "",
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(0), uint(0)).Return([]*domain.Notification{
+					{ID: 9, UserID: 1, Type: domain.SubscriptionNotification, TargetID: 1, LastActorID: 2},
+				}, nil)
+				mockUserClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewNotificationsHandler(mockService, mockUserClient)
+
+			req := httptest.NewRequest("GET", "/notifications/"+tt.query, nil)
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleGetNotifications(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockNotificationsService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode int
+		wantBody string
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			ctx:      validCtx,
+			wantCode: http.StatusOK,
+			wantBody: `{"body":{"count":3}}`,
+			setup: func() {
+				mockService.EXPECT().GetUnreadCount(gomock.Any(), uint(1)).Return(uint(3), nil)
+			},
+		},
+		{
+			name:     "test case 2 - no user ID in context",
+			ctx:      context.Background(),
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - service error",
+			ctx:      validCtx,
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().GetUnreadCount(gomock.Any(), uint(1)).Return(uint(0), errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewNotificationsHandler(mockService, nil)
+
+			req := httptest.NewRequest("GET", "/notifications/unread-count", nil)
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleGetUnreadCount(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandleMarkRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_rest.NewMockNotificationsService(ctrl)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		body     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - mark some",
+			ctx:      validCtx,
+			body:     `{"notificationIds":[2,3]}`,
+			wantCode: http.StatusNoContent,
+			setup: func() {
+				mockService.EXPECT().MarkRead(gomock.Any(), uint(1), []uint{2, 3}).Return(nil)
+			},
+		},
+		{
+			name:     "test case 2 - mark all",
+			ctx:      validCtx,
+			body:     `{}`,
+			wantCode: http.StatusNoContent,
+			setup: func() {
+				mockService.EXPECT().MarkRead(gomock.Any(), uint(1), nil).Return(nil)
+			},
+		},
+		{
+			name:     "test case 3 - no user ID in context",
+			ctx:      context.Background(),
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - invalid json",
+			ctx:      validCtx,
+			body:     `{"notificationIds"`,
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 5 - service error",
+			ctx:      validCtx,
+			body:     `{"notificationIds":[2]}`,
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockService.EXPECT().MarkRead(gomock.Any(), uint(1), []uint{2}).Return(errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := rest.NewNotificationsHandler(mockService, nil)
+
+			req := httptest.NewRequest("POST", "/notifications/read", bytes.NewBufferString(tt.body))
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleMarkRead(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	postspb "socio/internal/grpc/post/proto"
+	"socio/pkg/contextlogger"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	TrendingWindowQueryParam = "window"
+	TrendingAmountQueryParam = "amount"
+)
+
+// HandleGetPostsByHashtag godoc
+//
+//	@Summary		get posts by hashtag
+//	@Description	get the posts with the hashtag, the newest first
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/get_posts_by_hashtag
+//	@Accept			json
+//
+//	@Param			Cookie		header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			hashtag		path	string	true	"Hashtag without #, case insensitive"
+//	@Param			lastPostId	query	uint	false	"ID of the last post, if 0 - get first posts"
+//	@Param			postsAmount	query	uint	false	"Amount of posts to get, if 0 - get 20 posts"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]domain.PostWithAuthorAndGroup}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/hashtags/{hashtag}/posts [get]
+func (h *PostsHandler) HandleGetPostsByHashtag(w http.ResponseWriter, r *http.Request) {
+	hashtag := mux.Vars(r)["hashtag"]
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	lastPostIDData := r.URL.Query().Get(LastPostIDQueryParam)
+	var lastPostID uint64
+
+	if lastPostIDData != "" {
+		lastPostID, err = strconv.ParseUint(lastPostIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	postsAmountData := r.URL.Query().Get(PostsAmountQueryParam)
+	var postsAmount uint64
+
+	if postsAmountData != "" {
+		postsAmount, err = strconv.ParseUint(postsAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	postsRes, err := h.PostsClient.GetPostsByHashtag(r.Context(), &postspb.GetPostsByHashtagRequest{
+		ViewerId:    uint64(userID),
+		Hashtag:     hashtag,
+		LastPostId:  lastPostID,
+		PostsAmount: postsAmount,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	posts := postspb.ToPosts(postsRes.GetPosts())
+	res := make([]*domain.PostWithAuthorAndGroup, 0, len(posts))
+
+	authors := make(map[uint]*domain.User)
+	groups := make(map[uint]*domain.PublicGroup)
+	for _, post := range posts {
+		postWithAuthorAndGroup, err := h.getPostWithAuthorAndGroup(r.Context(), userID, post, authors, groups)
+		if err != nil {
+			json.ServeGRPCStatus(r.Context(), w, err)
+			return
+		}
+
+		res = append(res, postWithAuthorAndGroup)
+	}
+
+	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
+}
+
+// HandleGetTrendingHashtags godoc
+//
+//	@Summary		get trending hashtags
+//	@Description	get the hashtags used in the most posts and comments created during the window
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/get_trending_hashtags
+//	@Accept			json
+//
+//	@Param			Cookie		header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			window		query	string	false	"Time window like 1h or 168h, if empty - 24h, at most 720h"
+//	@Param			amount		query	uint	false	"Amount of hashtags to get, if 0 - get 10 hashtags, at most 50"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=[]posts.TrendingHashtag}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/hashtags/trending [get]
+func (h *PostsHandler) HandleGetTrendingHashtags(w http.ResponseWriter, r *http.Request) {
+	windowData := r.URL.Query().Get(TrendingWindowQueryParam)
+	var window time.Duration
+	var err error
+
+	if windowData != "" {
+		window, err = time.ParseDuration(windowData)
+		if err != nil || window <= 0 {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	amountData := r.URL.Query().Get(TrendingAmountQueryParam)
+	var amount uint64
+
+	if amountData != "" {
+		amount, err = strconv.ParseUint(amountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	hashtagsRes, err := h.PostsClient.GetTrendingHashtags(r.Context(), &postspb.GetTrendingHashtagsRequest{
+		WindowSeconds: int64(window / time.Second),
+		Amount:        amount,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, postspb.ToTrendingHashtags(hashtagsRes.GetHashtags()), http.StatusOK)
+}
+
+// notifyMentioned notifies the users mentioned in the post or in the comment
+// with the targetID, the ones notified before are skipped by the notifier.
+func (h *PostsHandler) notifyMentioned(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint, entities []*domain.Entity) {
+	if !domain.HasMentions(entities) {
+		return
+	}
+
+	_, err := h.Notifier.Notify(ctx, notificationType, actorID, targetID)
+	if err != nil {
+		contextlogger.LogErr(ctx, err)
+	}
+}
@@ -0,0 +1,201 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"socio/errors"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	mock_posts "socio/mocks/grpc/post_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleGetPostsByHashtag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hashtag        string
+		query          string
+		expectedStatus int
+		mock           func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful get posts by hashtag",
+			ctx:            validCtx,
+			hashtag:        "golang",
+			query:          "?lastPostId=10&postsAmount=2",
+			expectedStatus: http.StatusOK,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().GetPostsByHashtag(gomock.Any(), &postpb.GetPostsByHashtagRequest{
+					ViewerId:    1,
+					Hashtag:     "golang",
+					LastPostId:  10,
+					PostsAmount: 2,
+				}).Return(&postpb.GetPostsByHashtagResponse{
+					Posts: []*postpb.PostResponse{
+						{Id: 2, AuthorId: 1, GroupId: 1},
+						{Id: 1, AuthorId: 1},
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{User: &uspb.UserResponse{Id: 1}}, nil)
+				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&pgpb.GetByIDResponse{
+					PublicGroup: &pgpb.PublicGroupWithInfoResponse{PublicGroup: &pgpb.PublicGroupResponse{Id: 1}},
+				}, nil)
+			},
+		},
+		{
+			name:           "err invalid last post id",
+			ctx:            validCtx,
+			hashtag:        "golang",
+			query:          "?lastPostId=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "err invalid posts amount",
+			ctx:            validCtx,
+			hashtag:        "golang",
+			query:          "?postsAmount=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "err invalid hashtag",
+			ctx:            validCtx,
+			hashtag:        "go-lang",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().GetPostsByHashtag(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal author",
+			ctx:            validCtx,
+			hashtag:        "golang",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().GetPostsByHashtag(gomock.Any(), gomock.Any()).Return(&postpb.GetPostsByHashtagResponse{
+					Posts: []*postpb.PostResponse{{Id: 1, AuthorId: 1}},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/hashtags/"+tt.hashtag+"/posts"+tt.query, nil)
+			r = r.WithContext(tt.ctx)
+			r = mux.SetURLVars(r, map[string]string{"hashtag": tt.hashtag})
+
+			rr := httptest.NewRecorder()
+
+			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockPostsClient, mockPublicGroupClient, mockUserClient)
+
+			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient, nil, nil)
+
+			h.HandleGetPostsByHashtag(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetTrendingHashtags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedBody   string
+		mock           func(postsClient *mock_posts.MockPostClient)
+	}{
+		{
+			name:           "Successful get trending hashtags",
+			query:          "?window=1h&amount=2",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"body":[{"hashtag":"golang","count":3}]}`,
+			mock: func(postsClient *mock_posts.MockPostClient) {
+				postsClient.EXPECT().GetTrendingHashtags(gomock.Any(), &postpb.GetTrendingHashtagsRequest{
+					WindowSeconds: 3600,
+					Amount:        2,
+				}).Return(&postpb.GetTrendingHashtagsResponse{
+					Hashtags: []*postpb.TrendingHashtag{{Hashtag: "golang", Count: 3}},
+				}, nil)
+			},
+		},
+		{
+			name:           "Successful get trending hashtags with defaults",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"body":[]}`,
+			mock: func(postsClient *mock_posts.MockPostClient) {
+				postsClient.EXPECT().GetTrendingHashtags(gomock.Any(), &postpb.GetTrendingHashtagsRequest{}).Return(&postpb.GetTrendingHashtagsResponse{}, nil)
+			},
+		},
+		{
+			name:           "err invalid window",
+			query:          "?window=day",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(postsClient *mock_posts.MockPostClient) {},
+		},
+		{
+			name:           "err negative window",
+			query:          "?window=-1h",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(postsClient *mock_posts.MockPostClient) {},
+		},
+		{
+			name:           "err invalid amount",
+			query:          "?amount=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(postsClient *mock_posts.MockPostClient) {},
+		},
+		{
+			name:           "err internal",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient) {
+				postsClient.EXPECT().GetTrendingHashtags(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/hashtags/trending"+tt.query, nil)
+			r = r.WithContext(validCtx)
+
+			rr := httptest.NewRecorder()
+
+			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+			tt.mock(mockPostsClient)
+
+			h := NewPostsHandler(mockPostsClient, nil, nil, nil, nil)
+
+			h.HandleGetTrendingHashtags(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package rest
 
 import (
+	"context"
 	"net/http"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contextlogger"
 	"socio/pkg/json"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 	"socio/usecase/posts"
 	"strconv"
 	"strings"
@@ -24,7 +27,17 @@ const (
 	LastPostIDQueryParam  = "lastPostId"
 	PostsAmountQueryParam = "postsAmount"
 	LastLikeIDQueryParam  = "lastLikeId"
+	FeedCursorQueryParam  = "cursor"
 	BatchSize             = 1 << 23
+
+	SearchQueryParam       = "query"
+	SearchTypeQueryParam   = "type"
+	SearchAmountQueryParam = "amount"
+
+	LastCommentIDQueryParam  = "lastCommentId"
+	CommentsAmountQueryParam = "commentsAmount"
+	LastReplyIDQueryParam    = "lastReplyId"
+	RepliesAmountQueryParam  = "repliesAmount"
 )
 
 //easyjson:json
@@ -43,10 +56,24 @@ type ListUserPostsResponse struct {
 	Author *domain.User   `json:"author"`
 }
 
+//easyjson:json
+type FeedResponse struct {
+	Posts      []*domain.PostWithAuthorAndGroup `json:"posts"`
+	NextCursor string                           `json:"nextCursor,omitempty"`
+}
+
+//easyjson:json
+type SearchResponse struct {
+	Posts      []*domain.FoundPost    `json:"posts,omitempty"`
+	Comments   []*domain.FoundComment `json:"comments,omitempty"`
+	NextCursor string                 `json:"nextCursor,omitempty"`
+}
+
 //easyjson:json
 type CreateCommentInput struct {
-	PostID  uint   `json:"postId"`
-	Content string `json:"content"`
+	PostID   uint   `json:"postId"`
+	ParentID uint   `json:"parentId"`
+	Content  string `json:"content"`
 }
 
 //easyjson:json
@@ -70,17 +97,32 @@ type UnlikeCommentInput struct {
 	CommentID uint `json:"commentId"`
 }
 
+// Notifier records and pushes notifications about the user activity, failing
+// to notify does not fail the request.
+type Notifier interface {
+	Notify(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error)
+}
+
+// Uploads resolves the completed resumable uploads to the stored files.
+type Uploads interface {
+	GetUploadedFileNames(ctx context.Context, userID uint, kind upload.Kind, uploadIDs []string) (fileNames []string, err error)
+}
+
 type PostsHandler struct {
 	PostsClient       postspb.PostClient
 	UserClient        uspb.UserClient
 	PublicGroupClient pgpb.PublicGroupClient
+	Notifier          Notifier
+	Uploads           Uploads
 }
 
-func NewPostsHandler(postsClient postspb.PostClient, userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient) (handler *PostsHandler) {
+func NewPostsHandler(postsClient postspb.PostClient, userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient, notifier Notifier, uploads Uploads) (handler *PostsHandler) {
 	handler = &PostsHandler{
 		PostsClient:       postsClient,
 		UserClient:        userClient,
 		PublicGroupClient: publicGroupClient,
+		Notifier:          notifier,
+		Uploads:           uploads,
 	}
 	return
 }
@@ -126,7 +168,8 @@ func (h *PostsHandler) HandleGetPostByID(w http.ResponseWriter, r *http.Request)
 	}
 
 	postData, err := h.PostsClient.GetPostByID(r.Context(), &postspb.GetPostByIDRequest{
-		PostId: uint64(postIDData),
+		PostId:   uint64(postIDData),
+		ViewerId: uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -136,7 +179,8 @@ func (h *PostsHandler) HandleGetPostByID(w http.ResponseWriter, r *http.Request)
 	post := postspb.ToPost(postData.GetPost())
 
 	authorData, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-		UserId: uint64(post.AuthorID),
+		UserId:   uint64(post.AuthorID),
+		ViewerId: uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -213,6 +257,12 @@ func (h *PostsHandler) HandleGetUserPosts(w http.ResponseWriter, r *http.Request
 
 	input.UserID = uint(userID)
 
+	viewerID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	lastPostIDData := r.URL.Query().Get(LastPostIDQueryParam)
 	var lastPostID uint64
 
@@ -245,6 +295,7 @@ func (h *PostsHandler) HandleGetUserPosts(w http.ResponseWriter, r *http.Request
 
 	posts, err := h.PostsClient.GetUserPosts(r.Context(), &postspb.GetUserPostsRequest{
 		UserId:      uint64(input.UserID),
+		ViewerId:    uint64(viewerID),
 		LastPostId:  uint64(input.LastPostID),
 		PostsAmount: uint64(input.PostsAmount),
 	})
@@ -254,7 +305,8 @@ func (h *PostsHandler) HandleGetUserPosts(w http.ResponseWriter, r *http.Request
 	}
 
 	author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-		UserId: uint64(input.UserID),
+		UserId:   uint64(input.UserID),
+		ViewerId: uint64(viewerID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -344,7 +396,8 @@ func (h *PostsHandler) HandleGetUserFriendsPosts(w http.ResponseWriter, r *http.
 	postsWithAuthors := make([]*domain.PostWithAuthor, 0, len(posts.Posts))
 	for _, post := range posts.Posts {
 		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: post.AuthorId,
+			UserId:   post.AuthorId,
+			ViewerId: uint64(userID),
 		})
 		if err != nil {
 			json.ServeGRPCStatus(r.Context(), w, err)
@@ -373,18 +426,22 @@ func (h *PostsHandler) HandleGetUserFriendsPosts(w http.ResponseWriter, r *http.
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
 //	@Param			content		formData	string	true	"Content of the post"
 //	@Param			attachments	formData	file	false	"Attachments of the post"
+//	@Param			uploads	formData	[]string	false	"IDs of the completed resumable uploads to attach"
 //
 //	@Produce		json
 //	@Success		201	{object}	json.JSONResponse{body=domain.PostWithAuthor}
 //	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/ [post]
 func (h *PostsHandler) HandleCreatePost(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(1000 << 20)
+	err := upload.ParseMultipartForm(w, r, upload.PostAttachmentKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -400,15 +457,23 @@ func (h *PostsHandler) HandleCreatePost(w http.ResponseWriter, r *http.Request)
 
 	for _, fh := range r.MultipartForm.File {
 		for _, f := range fh {
-			fileName, err := uploaders.UploadPostAttachment(r, h.PostsClient, f)
+			attachment, err := uploaders.UploadPostAttachment(r, h.PostsClient, f)
 			if err != nil {
-				json.ServeJSONError(r.Context(), w, err)
+				json.ServeGRPCStatus(r.Context(), w, err)
 				return
 			}
-			postInput.Attachments = append(postInput.Attachments, fileName)
+			postInput.Attachments = append(postInput.Attachments, attachment.FileName)
 		}
 	}
 
+	uploadedFileNames, err := h.getUploadedFileNames(r, postInput.AuthorID)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	postInput.Attachments = append(postInput.Attachments, uploadedFileNames...)
+
 	postData, err := h.PostsClient.CreatePost(r.Context(), &postspb.CreatePostRequest{
 		AuthorId:    uint64(postInput.AuthorID),
 		Content:     postInput.Content,
@@ -421,8 +486,82 @@ func (h *PostsHandler) HandleCreatePost(w http.ResponseWriter, r *http.Request)
 
 	post := postspb.ToPost(postData.Post)
 
+	h.notifyMentioned(r.Context(), domain.PostMentionNotification, post.AuthorID, post.ID, post.Entities)
+
 	author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-		UserId: uint64(post.AuthorID),
+		UserId:   uint64(post.AuthorID),
+		ViewerId: uint64(postInput.AuthorID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	postWithAuthor := &domain.PostWithAuthor{
+		Post:   post,
+		Author: uspb.ToUser(author.User),
+	}
+
+	json.ServeJSONBody(r.Context(), w, postWithAuthor, http.StatusCreated)
+}
+
+// HandleRepostPost godoc
+//
+//	@Summary		repost post
+//	@Description	share the post on the wall of the user, reposting a repost shares its original
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/repost
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			postId	body	uint	true	"ID of the reposted post"
+//	@Param			content	body	string	false	"Commentary of the repost"
+//
+//	@Produce		json
+//	@Success		201	{object}	json.JSONResponse{body=domain.PostWithAuthor}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/repost [post]
+func (h *PostsHandler) HandleRepostPost(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var repostInput posts.RepostInput
+
+	err := easyjson.UnmarshalFromReader(r.Body, &repostInput)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	repostInput.AuthorID, err = requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	postData, err := h.PostsClient.RepostPost(r.Context(), &postspb.RepostPostRequest{
+		PostId:   uint64(repostInput.PostID),
+		AuthorId: uint64(repostInput.AuthorID),
+		Content:  strings.TrimSpace(repostInput.Content),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	post := postspb.ToPost(postData.Post)
+
+	h.notifyMentioned(r.Context(), domain.PostMentionNotification, post.AuthorID, post.ID, post.Entities)
+
+	author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
+		UserId:   uint64(post.AuthorID),
+		ViewerId: uint64(repostInput.AuthorID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -452,6 +591,7 @@ func (h *PostsHandler) HandleCreatePost(w http.ResponseWriter, r *http.Request)
 //	@Param			content	formData	string	true	"Content of the post"
 //	@Param			attachmentsToDelete	formData	[]string	false	"Attachments to delete"
 //	@Param			attachments	formData	[]file	false	"Attachments of the post"
+//	@Param			uploads	formData	[]string	false	"IDs of the completed resumable uploads to attach"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=map[string]domain.Post}	"application/json"	"Attachments is always null!!!"
@@ -459,12 +599,14 @@ func (h *PostsHandler) HandleCreatePost(w http.ResponseWriter, r *http.Request)
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/ [put]
 func (h *PostsHandler) HandleUpdatePost(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(1000 << 20)
+	err := upload.ParseMultipartForm(w, r, upload.PostAttachmentKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -487,15 +629,23 @@ func (h *PostsHandler) HandleUpdatePost(w http.ResponseWriter, r *http.Request)
 	input.AttachmentsToDelete = r.PostForm["attachmentsToDelete"]
 
 	for _, f := range r.MultipartForm.File["attachments"] {
-		fileName, err := uploaders.UploadPostAttachment(r, h.PostsClient, f)
+		attachment, err := uploaders.UploadPostAttachment(r, h.PostsClient, f)
 		if err != nil {
-			json.ServeJSONError(r.Context(), w, err)
+			json.ServeGRPCStatus(r.Context(), w, err)
 			return
 		}
 
-		input.AttachmentsToAdd = append(input.AttachmentsToAdd, fileName)
+		input.AttachmentsToAdd = append(input.AttachmentsToAdd, attachment.FileName)
+	}
+
+	uploadedFileNames, err := h.getUploadedFileNames(r, userID)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
 	}
 
+	input.AttachmentsToAdd = append(input.AttachmentsToAdd, uploadedFileNames...)
+
 	updatedPost, err := h.PostsClient.UpdatePost(r.Context(), &postspb.UpdatePostRequest{
 		PostId:              uint64(input.PostID),
 		Content:             input.Content,
@@ -508,11 +658,36 @@ func (h *PostsHandler) HandleUpdatePost(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	post := postspb.ToPost(updatedPost.Post)
+
+	h.notifyMentioned(r.Context(), domain.PostMentionNotification, userID, post.ID, post.Entities)
+
 	json.ServeJSONBody(r.Context(), w, map[string]*domain.Post{
-		"post": postspb.ToPost(updatedPost.Post),
+		"post": post,
 	}, http.StatusOK)
 }
 
+// getUploadedFileNames claims the resumable uploads listed in the uploads
+// form field, the files are already stored by the post service.
+func (h *PostsHandler) getUploadedFileNames(r *http.Request, userID uint) (fileNames []string, err error) {
+	uploadIDs := r.MultipartForm.Value["uploads"]
+	if len(uploadIDs) == 0 {
+		return
+	}
+
+	if h.Uploads == nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	fileNames, err = h.Uploads.GetUploadedFileNames(r.Context(), userID, upload.PostAttachmentKind, uploadIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 // HandleDeletePost godoc
 //
 //	@Summary		delete post
@@ -636,7 +811,8 @@ func (h *PostsHandler) HandleGetLikedPosts(w http.ResponseWriter, r *http.Reques
 		var likedPostWithUser posts.LikeWithPostAndUser
 
 		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: uint64(post.Like.UserID),
+			UserId:   uint64(post.Like.UserID),
+			ViewerId: uint64(authorizedUserID),
 		})
 		if err != nil {
 			json.ServeGRPCStatus(r.Context(), w, err)
@@ -672,6 +848,7 @@ func (h *PostsHandler) HandleGetLikedPosts(w http.ResponseWriter, r *http.Reques
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/like [post]
 func (h *PostsHandler) HandleLikePost(w http.ResponseWriter, r *http.Request) {
@@ -700,6 +877,11 @@ func (h *PostsHandler) HandleLikePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	_, err = h.Notifier.Notify(r.Context(), domain.PostLikeNotification, userID, input.PostID)
+	if err != nil {
+		contextlogger.LogErr(r.Context(), err)
+	}
+
 	json.ServeJSONBody(r.Context(), w, postspb.ToPostLike(res.Like), http.StatusCreated)
 }
 
@@ -722,6 +904,7 @@ func (h *PostsHandler) HandleLikePost(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/unlike [delete]
 func (h *PostsHandler) HandleUnlikePost(w http.ResponseWriter, r *http.Request) {
@@ -827,6 +1010,7 @@ func (h *PostsHandler) HandleGetGroupPostsBySubscriptions(w http.ResponseWriter,
 		SubscriptionIds: subIDs,
 		LastPostId:      lastPostID,
 		PostsAmount:     postsAmount,
+		ViewerId:        uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -838,7 +1022,8 @@ func (h *PostsHandler) HandleGetGroupPostsBySubscriptions(w http.ResponseWriter,
 	for _, post := range posts {
 		postWithAuthorAndGroup := new(domain.PostWithAuthorAndGroup)
 		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: uint64(post.AuthorID),
+			UserId:   uint64(post.AuthorID),
+			ViewerId: uint64(userID),
 		})
 		if err != nil {
 			json.ServeGRPCStatus(r.Context(), w, err)
@@ -936,6 +1121,7 @@ func (h *PostsHandler) HandleGetPostsByGroupSubIDsAndUserSubIDs(w http.ResponseW
 	userSubIDs := userSubIDsRes.GetSubscriptionIds()
 
 	postsRes, err := h.PostsClient.GetPostsByGroupSubIDsAndUserSubIDs(r.Context(), &postspb.GetPostsByGroupSubIDsAndUserSubIDsRequest{
+		ViewerId:             uint64(userID),
 		GroupSubscriptionIds: groupSubIDs,
 		UserSubscriptionIds:  userSubIDs,
 		LastPostId:           lastPostID,
@@ -951,7 +1137,8 @@ func (h *PostsHandler) HandleGetPostsByGroupSubIDsAndUserSubIDs(w http.ResponseW
 	for _, post := range posts {
 		postWithAuthorAndGroup := new(domain.PostWithAuthorAndGroup)
 		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: uint64(post.AuthorID),
+			UserId:   uint64(post.AuthorID),
+			ViewerId: uint64(userID),
 		})
 		if err != nil {
 			json.ServeGRPCStatus(r.Context(), w, err)
@@ -970,6 +1157,267 @@ func (h *PostsHandler) HandleGetPostsByGroupSubIDsAndUserSubIDs(w http.ResponseW
 	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
 }
 
+// HandleGetFeed godoc
+//
+//	@Summary		get ranked feed
+//	@Description	get posts of group subscriptions and user subscriptions ranked by likes, comments, author affinity and freshness
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/get_feed
+//	@Accept			json
+//
+//	@Param			Cookie		header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			cursor		query	string	false	"Cursor of the next page from the previous response, if empty - get first posts"
+//	@Param			postsAmount	query	uint	false	"Amount of posts to get, if 0 - get 20 posts"
+//
+//	@Produce		json
+//	@Success		200	{object}	FeedResponse
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/feed [get]
+func (h *PostsHandler) HandleGetFeed(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	cursor := r.URL.Query().Get(FeedCursorQueryParam)
+
+	postsAmountData := r.URL.Query().Get(PostsAmountQueryParam)
+	var postsAmount uint64
+
+	if postsAmountData == "" {
+		postsAmount = 0
+	} else {
+		postsAmount, err = strconv.ParseUint(postsAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	groupSubs, err := h.PublicGroupClient.GetBySubscriberID(r.Context(), &pgpb.GetBySubscriberIDRequest{
+		SubscriberId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	groupSubIDs := make([]uint64, 0, len(groupSubs.GetPublicGroups()))
+	groupsByID := map[uint64]*domain.PublicGroup{}
+	for _, sub := range groupSubs.GetPublicGroups() {
+		groupSubIDs = append(groupSubIDs, sub.Id)
+		groupsByID[sub.Id] = pgpb.ToPublicGroup(sub)
+	}
+
+	userSubIDsRes, err := h.UserClient.GetSubscriptionIDs(r.Context(), &uspb.GetSubscriptionIDsRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	feedRes, err := h.PostsClient.GetFeed(r.Context(), &postspb.GetFeedRequest{
+		UserId:               uint64(userID),
+		GroupSubscriptionIds: groupSubIDs,
+		UserSubscriptionIds:  userSubIDsRes.GetSubscriptionIds(),
+		Cursor:               cursor,
+		PostsAmount:          postsAmount,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	posts := postspb.ToPosts(feedRes.GetPosts())
+	res := &FeedResponse{
+		Posts:      make([]*domain.PostWithAuthorAndGroup, 0, len(posts)),
+		NextCursor: feedRes.GetNextCursor(),
+	}
+	for _, post := range posts {
+		postWithAuthorAndGroup := new(domain.PostWithAuthorAndGroup)
+		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
+			UserId:   uint64(post.AuthorID),
+			ViewerId: uint64(userID),
+		})
+		if err != nil {
+			json.ServeGRPCStatus(r.Context(), w, err)
+			return
+		}
+
+		postWithAuthorAndGroup.Author = uspb.ToUser(author.User)
+		if post.GroupID != 0 {
+			postWithAuthorAndGroup.Group = groupsByID[uint64(post.GroupID)]
+		}
+		postWithAuthorAndGroup.Post = post
+
+		res.Posts = append(res.Posts, postWithAuthorAndGroup)
+	}
+
+	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
+}
+
+// HandleSearch godoc
+//
+//	@Summary		search posts and comments
+//	@Description	full-text search over the own posts, the posts of the subscriptions and of the public groups or over their comments, the most relevant first
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/search
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			query			query	string	true	"Search query, supports quotes, OR and -"
+//	@Param			type			query	string	false	"What to search: posts or comments, if empty - posts"
+//	@Param			cursor			query	string	false	"Cursor of the next page from the previous response, if empty - get first results"
+//	@Param			amount			query	uint	false	"Amount of results to get, if 0 - get 20 results"
+//
+//	@Produce		json
+//	@Success		200	{object}	SearchResponse
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/search [get]
+func (h *PostsHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	query := r.URL.Query().Get(SearchQueryParam)
+	if query == "" {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	amountData := r.URL.Query().Get(SearchAmountQueryParam)
+	var amount uint64
+
+	if amountData != "" {
+		amount, err = strconv.ParseUint(amountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	userSubIDsRes, err := h.UserClient.GetSubscriptionIDs(r.Context(), &uspb.GetSubscriptionIDsRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	searchRes, err := h.PostsClient.Search(r.Context(), &postspb.SearchRequest{
+		UserId:              uint64(userID),
+		UserSubscriptionIds: userSubIDsRes.GetSubscriptionIds(),
+		Query:               query,
+		Target:              r.URL.Query().Get(SearchTypeQueryParam),
+		Cursor:              r.URL.Query().Get(FeedCursorQueryParam),
+		Amount:              amount,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	res := &SearchResponse{
+		Posts:      make([]*domain.FoundPost, 0, len(searchRes.GetPosts())),
+		Comments:   make([]*domain.FoundComment, 0, len(searchRes.GetComments())),
+		NextCursor: searchRes.GetNextCursor(),
+	}
+
+	authors := make(map[uint]*domain.User)
+	groups := make(map[uint]*domain.PublicGroup)
+
+	for _, found := range searchRes.GetPosts() {
+		post := postspb.ToPost(found.GetPost())
+
+		postWithAuthorAndGroup, err := h.getPostWithAuthorAndGroup(r.Context(), userID, post, authors, groups)
+		if err != nil {
+			json.ServeGRPCStatus(r.Context(), w, err)
+			return
+		}
+
+		res.Posts = append(res.Posts, &domain.FoundPost{
+			Post:    postWithAuthorAndGroup,
+			Snippet: found.GetSnippet(),
+		})
+	}
+
+	for _, found := range searchRes.GetComments() {
+		commentsWithAuthors, err := h.getCommentsWithAuthors(r.Context(), userID, []*domain.Comment{postspb.ToComment(found.GetComment())}, authors)
+		if err != nil {
+			json.ServeGRPCStatus(r.Context(), w, err)
+			return
+		}
+
+		res.Comments = append(res.Comments, &domain.FoundComment{
+			Comment: commentsWithAuthors[0],
+			Snippet: found.GetSnippet(),
+		})
+	}
+
+	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
+}
+
+// getPostWithAuthorAndGroup fetches the author and the group of the post,
+// the fetched ones are cached in authors and groups.
+func (h *PostsHandler) getPostWithAuthorAndGroup(ctx context.Context, viewerID uint, post *domain.Post, authors map[uint]*domain.User, groups map[uint]*domain.PublicGroup) (postWithAuthorAndGroup *domain.PostWithAuthorAndGroup, err error) {
+	author, ok := authors[post.AuthorID]
+	if !ok {
+		var authorData *uspb.GetByIDResponse
+
+		authorData, err = h.UserClient.GetByID(ctx, &uspb.GetByIDRequest{
+			UserId:   uint64(post.AuthorID),
+			ViewerId: uint64(viewerID),
+		})
+		if err != nil {
+			return
+		}
+
+		author = uspb.ToUser(authorData.GetUser())
+		authors[post.AuthorID] = author
+	}
+
+	postWithAuthorAndGroup = &domain.PostWithAuthorAndGroup{
+		Post:   post,
+		Author: author,
+	}
+
+	if post.GroupID == 0 {
+		return
+	}
+
+	group, ok := groups[post.GroupID]
+	if !ok {
+		var groupData *pgpb.GetByIDResponse
+
+		groupData, err = h.PublicGroupClient.GetByID(ctx, &pgpb.GetByIDRequest{
+			Id: uint64(post.GroupID),
+		})
+		if err != nil {
+			return
+		}
+
+		group = pgpb.ToPublicGroup(groupData.GetPublicGroup().GetPublicGroup())
+		groups[post.GroupID] = group
+	}
+
+	postWithAuthorAndGroup.Group = group
+	return
+}
+
 // HandleGetNewPosts godoc
 //
 //	@Summary		get new posts
@@ -993,9 +1441,14 @@ func (h *PostsHandler) HandleGetPostsByGroupSubIDsAndUserSubIDs(w http.ResponseW
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/new [get]
 func (h *PostsHandler) HandleGetNewPosts(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	lastPostIDData := r.URL.Query().Get(LastPostIDQueryParam)
 	var lastPostID uint64
-	var err error
 
 	if lastPostIDData == "" {
 		lastPostID = 0
@@ -1021,6 +1474,7 @@ func (h *PostsHandler) HandleGetNewPosts(w http.ResponseWriter, r *http.Request)
 	}
 
 	postsRes, err := h.PostsClient.GetNewPosts(r.Context(), &postspb.GetNewPostsRequest{
+		ViewerId:    uint64(userID),
 		LastPostId:  lastPostID,
 		PostsAmount: postsAmount,
 	})
@@ -1034,7 +1488,8 @@ func (h *PostsHandler) HandleGetNewPosts(w http.ResponseWriter, r *http.Request)
 	for _, post := range posts {
 		postWithAuthorAndGroup := new(domain.PostWithAuthorAndGroup)
 		author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: uint64(post.AuthorID),
+			UserId:   uint64(post.AuthorID),
+			ViewerId: uint64(userID),
 		})
 		if err != nil {
 			json.ServeGRPCStatus(r.Context(), w, err)
@@ -1061,18 +1516,20 @@ func (h *PostsHandler) HandleGetNewPosts(w http.ResponseWriter, r *http.Request)
 	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
 }
 
-// HandleGetPostByID godoc
+// HandleGetCommentsByPostID godoc
 //
 //	@Summary		get comments by post id
-//	@Description	get comments by post id
+//	@Description	get top level comments of the post with pagination, every comment has a preview of its first replies
 //	@Tags			posts
 //	@license.name	Apache 2.0
 //	@ID				posts/get_comments_by_post_id
 //	@Accept			json
 //
-//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			Cookie			header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
-//	@Param			postID	path	uint	true	"ID of the post"
+//	@Param			postID			path	uint	true	"ID of the post"
+//	@Param			lastCommentId	query	uint	false	"ID of the last comment, if 0 - get first comments"
+//	@Param			commentsAmount	query	uint	false	"Amount of comments to get, if 0 - get 20 comments"
 //
 //	@Produce		json
 //	@Success		200	{object}	[]domain.CommentWithAuthor
@@ -1083,6 +1540,12 @@ func (h *PostsHandler) HandleGetNewPosts(w http.ResponseWriter, r *http.Request)
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/{postID}/comments [get]
 func (h *PostsHandler) HandleGetCommentsByPostID(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrUnauthorized)
+		return
+	}
+
 	postID, ok := mux.Vars(r)["postID"]
 	if !ok {
 		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
@@ -1095,32 +1558,159 @@ func (h *PostsHandler) HandleGetCommentsByPostID(w http.ResponseWriter, r *http.
 		return
 	}
 
+	lastCommentIDData := r.URL.Query().Get(LastCommentIDQueryParam)
+	var lastCommentID uint64
+	if lastCommentIDData != "" {
+		lastCommentID, err = strconv.ParseUint(lastCommentIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	commentsAmountData := r.URL.Query().Get(CommentsAmountQueryParam)
+	var commentsAmount uint64
+	if commentsAmountData != "" {
+		commentsAmount, err = strconv.ParseUint(commentsAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
 	comments, err := h.PostsClient.GetCommentsByPostID(r.Context(), &postspb.GetCommentsByPostIDRequest{
-		PostId: uint64(postIDData),
+		PostId:         uint64(postIDData),
+		LastCommentId:  lastCommentID,
+		CommentsAmount: commentsAmount,
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
 		return
 	}
 
-	commentsWithAuthors := make([]*domain.CommentWithAuthor, 0, len(comments.Comments))
+	commentsWithAuthors, err := h.getCommentsWithAuthors(r.Context(), userID, postspb.ToComments(comments.GetComments()), make(map[uint]*domain.User))
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
 
-	for _, comment := range postspb.ToComments(comments.GetComments()) {
-		commentAuthorData, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-			UserId: uint64(comment.AuthorID),
-		})
+	json.ServeJSONBody(r.Context(), w, commentsWithAuthors, http.StatusOK)
+}
+
+// HandleGetCommentReplies godoc
+//
+//	@Summary		get comment replies
+//	@Description	get direct replies of the comment with pagination
+//	@Tags			posts
+//	@license.name	Apache 2.0
+//	@ID				posts/get_comment_replies
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			commentID		path	uint	true	"ID of the comment"
+//	@Param			lastReplyId		query	uint	false	"ID of the last reply, if 0 - get first replies"
+//	@Param			repliesAmount	query	uint	false	"Amount of replies to get, if 0 - get 20 replies"
+//
+//	@Produce		json
+//	@Success		200	{object}	[]domain.CommentWithAuthor
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/posts/comments/{commentID}/replies [get]
+func (h *PostsHandler) HandleGetCommentReplies(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrUnauthorized)
+		return
+	}
+
+	commentIDData, ok := mux.Vars(r)["commentID"]
+	if !ok {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	commentID, err := strconv.ParseUint(commentIDData, 0, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	lastReplyIDData := r.URL.Query().Get(LastReplyIDQueryParam)
+	var lastReplyID uint64
+	if lastReplyIDData != "" {
+		lastReplyID, err = strconv.ParseUint(lastReplyIDData, 0, 0)
 		if err != nil {
-			json.ServeGRPCStatus(r.Context(), w, err)
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
 			return
 		}
+	}
+
+	repliesAmountData := r.URL.Query().Get(RepliesAmountQueryParam)
+	var repliesAmount uint64
+	if repliesAmountData != "" {
+		repliesAmount, err = strconv.ParseUint(repliesAmountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	replies, err := h.PostsClient.GetCommentReplies(r.Context(), &postspb.GetCommentRepliesRequest{
+		CommentId:     commentID,
+		LastReplyId:   lastReplyID,
+		RepliesAmount: repliesAmount,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	repliesWithAuthors, err := h.getCommentsWithAuthors(r.Context(), userID, postspb.ToComments(replies.GetReplies()), make(map[uint]*domain.User))
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, repliesWithAuthors, http.StatusOK)
+}
+
+// getCommentsWithAuthors attaches authors to the comments and their replies
+// preview, authors are cached so every user is fetched once per request.
+func (h *PostsHandler) getCommentsWithAuthors(ctx context.Context, viewerID uint, comments []*domain.Comment, authors map[uint]*domain.User) (commentsWithAuthors []*domain.CommentWithAuthor, err error) {
+	commentsWithAuthors = make([]*domain.CommentWithAuthor, 0, len(comments))
+
+	for _, comment := range comments {
+		author, ok := authors[comment.AuthorID]
+		if !ok {
+			authorData, err := h.UserClient.GetByID(ctx, &uspb.GetByIDRequest{
+				UserId:   uint64(comment.AuthorID),
+				ViewerId: uint64(viewerID),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			author = uspb.ToUser(authorData.GetUser())
+			authors[comment.AuthorID] = author
+		}
+
+		replies, err := h.getCommentsWithAuthors(ctx, viewerID, comment.Replies, authors)
+		if err != nil {
+			return nil, err
+		}
 
 		commentsWithAuthors = append(commentsWithAuthors, &domain.CommentWithAuthor{
 			Comment: comment,
-			Author:  uspb.ToUser(commentAuthorData.GetUser()),
+			Author:  author,
+			Replies: replies,
 		})
 	}
 
-	json.ServeJSONBody(r.Context(), w, commentsWithAuthors, http.StatusOK)
+	return
 }
 
 // HandleCreateComment godoc
@@ -1136,6 +1726,7 @@ func (h *PostsHandler) HandleGetCommentsByPostID(w http.ResponseWriter, r *http.
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
 //	@Param			content	body	string	true	"Content of the comment"
 //	@Param			postID	body	uint	true	"ID of the post"
+//	@Param			parentID	body	uint	false	"ID of the replied comment, 0 for a top level comment"
 //
 //	@Produce		json
 //	@Success		201	{object}	domain.CommentWithAuthor
@@ -1143,6 +1734,7 @@ func (h *PostsHandler) HandleGetCommentsByPostID(w http.ResponseWriter, r *http.
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/comment [post]
 func (h *PostsHandler) HandleCreateComment(w http.ResponseWriter, r *http.Request) {
@@ -1164,6 +1756,7 @@ func (h *PostsHandler) HandleCreateComment(w http.ResponseWriter, r *http.Reques
 		AuthorId: uint64(userID),
 		Content:  commentInput.Content,
 		PostId:   uint64(commentInput.PostID),
+		ParentId: uint64(commentInput.ParentID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -1172,8 +1765,16 @@ func (h *PostsHandler) HandleCreateComment(w http.ResponseWriter, r *http.Reques
 
 	comment := postspb.ToComment(commentData.Comment)
 
+	_, err = h.Notifier.Notify(r.Context(), domain.CommentNotification, userID, comment.PostID)
+	if err != nil {
+		contextlogger.LogErr(r.Context(), err)
+	}
+
+	h.notifyMentioned(r.Context(), domain.CommentMentionNotification, userID, comment.ID, comment.Entities)
+
 	author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-		UserId: uint64(comment.AuthorID),
+		UserId:   uint64(comment.AuthorID),
+		ViewerId: uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -1237,8 +1838,11 @@ func (h *PostsHandler) HandleUpdateComment(w http.ResponseWriter, r *http.Reques
 
 	comment := postspb.ToComment(commentData.Comment)
 
+	h.notifyMentioned(r.Context(), domain.CommentMentionNotification, userID, comment.ID, comment.Entities)
+
 	author, err := h.UserClient.GetByID(r.Context(), &uspb.GetByIDRequest{
-		UserId: uint64(comment.AuthorID),
+		UserId:   uint64(comment.AuthorID),
+		ViewerId: uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -1320,6 +1924,7 @@ func (h *PostsHandler) HandleDeleteComment(w http.ResponseWriter, r *http.Reques
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/comments/like [post]
 func (h *PostsHandler) HandleLikeComment(w http.ResponseWriter, r *http.Request) {
@@ -1368,6 +1973,7 @@ func (h *PostsHandler) HandleLikeComment(w http.ResponseWriter, r *http.Request)
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/posts/comments/unlike [delete]
 func (h *PostsHandler) HandleUnlikeComment(w http.ResponseWriter, r *http.Request) {
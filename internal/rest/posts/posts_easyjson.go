@@ -223,7 +223,7 @@ func (v *UnlikeCommentInput) UnmarshalJSON(data []byte) error {
 func (v *UnlikeCommentInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonDc9e8747DecodeSocioInternalRestPosts2(l, v)
 }
-func easyjsonDc9e8747DecodeSocioInternalRestPosts3(in *jlexer.Lexer, out *ListUserPostsResponse) {
+func easyjsonDc9e8747DecodeSocioInternalRestPosts3(in *jlexer.Lexer, out *SearchResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -250,21 +250,21 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts3(in *jlexer.Lexer, out *ListUs
 				in.Delim('[')
 				if out.Posts == nil {
 					if !in.IsDelim(']') {
-						out.Posts = make([]*domain.Post, 0, 8)
+						out.Posts = make([]*domain.FoundPost, 0, 8)
 					} else {
-						out.Posts = []*domain.Post{}
+						out.Posts = []*domain.FoundPost{}
 					}
 				} else {
 					out.Posts = (out.Posts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 *domain.Post
+					var v1 *domain.FoundPost
 					if in.IsNull() {
 						in.Skip()
 						v1 = nil
 					} else {
 						if v1 == nil {
-							v1 = new(domain.Post)
+							v1 = new(domain.FoundPost)
 						}
 						(*v1).UnmarshalEasyJSON(in)
 					}
@@ -273,6 +273,181 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts3(in *jlexer.Lexer, out *ListUs
 				}
 				in.Delim(']')
 			}
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]*domain.FoundComment, 0, 8)
+					} else {
+						out.Comments = []*domain.FoundComment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *domain.FoundComment
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(domain.FoundComment)
+						}
+						(*v2).UnmarshalEasyJSON(in)
+					}
+					out.Comments = append(out.Comments, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDc9e8747EncodeSocioInternalRestPosts3(out *jwriter.Writer, in SearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Posts) != 0 {
+		const prefix string = ",\"posts\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Posts {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				if v4 == nil {
+					out.RawString("null")
+				} else {
+					(*v4).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Comments) != 0 {
+		const prefix string = ",\"comments\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Comments {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDc9e8747EncodeSocioInternalRestPosts3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDc9e8747EncodeSocioInternalRestPosts3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDc9e8747DecodeSocioInternalRestPosts3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDc9e8747DecodeSocioInternalRestPosts3(l, v)
+}
+func easyjsonDc9e8747DecodeSocioInternalRestPosts4(in *jlexer.Lexer, out *ListUserPostsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts":
+			if in.IsNull() {
+				in.Skip()
+				out.Posts = nil
+			} else {
+				in.Delim('[')
+				if out.Posts == nil {
+					if !in.IsDelim(']') {
+						out.Posts = make([]*domain.Post, 0, 8)
+					} else {
+						out.Posts = []*domain.Post{}
+					}
+				} else {
+					out.Posts = (out.Posts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *domain.Post
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(domain.Post)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Posts = append(out.Posts, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "author":
 			if in.IsNull() {
 				in.Skip()
@@ -293,7 +468,7 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts3(in *jlexer.Lexer, out *ListUs
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioInternalRestPosts3(out *jwriter.Writer, in ListUserPostsResponse) {
+func easyjsonDc9e8747EncodeSocioInternalRestPosts4(out *jwriter.Writer, in ListUserPostsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -304,14 +479,14 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts3(out *jwriter.Writer, in ListU
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Posts {
-				if v2 > 0 {
+			for v8, v9 := range in.Posts {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				if v3 == nil {
+				if v9 == nil {
 					out.RawString("null")
 				} else {
-					(*v3).MarshalEasyJSON(out)
+					(*v9).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -332,27 +507,27 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts3(out *jwriter.Writer, in ListU
 // MarshalJSON supports json.Marshaler interface
 func (v ListUserPostsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioInternalRestPosts3(&w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListUserPostsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioInternalRestPosts3(w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListUserPostsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioInternalRestPosts3(&r, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListUserPostsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioInternalRestPosts3(l, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts4(l, v)
 }
-func easyjsonDc9e8747DecodeSocioInternalRestPosts4(in *jlexer.Lexer, out *LikePostInput) {
+func easyjsonDc9e8747DecodeSocioInternalRestPosts5(in *jlexer.Lexer, out *LikePostInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -383,7 +558,7 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts4(in *jlexer.Lexer, out *LikePo
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioInternalRestPosts4(out *jwriter.Writer, in LikePostInput) {
+func easyjsonDc9e8747EncodeSocioInternalRestPosts5(out *jwriter.Writer, in LikePostInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -398,27 +573,27 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts4(out *jwriter.Writer, in LikeP
 // MarshalJSON supports json.Marshaler interface
 func (v LikePostInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioInternalRestPosts4(&w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LikePostInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioInternalRestPosts4(w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LikePostInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioInternalRestPosts4(&r, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LikePostInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioInternalRestPosts4(l, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts5(l, v)
 }
-func easyjsonDc9e8747DecodeSocioInternalRestPosts5(in *jlexer.Lexer, out *LikeCommentInput) {
+func easyjsonDc9e8747DecodeSocioInternalRestPosts6(in *jlexer.Lexer, out *LikeCommentInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -449,7 +624,7 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts5(in *jlexer.Lexer, out *LikeCo
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioInternalRestPosts5(out *jwriter.Writer, in LikeCommentInput) {
+func easyjsonDc9e8747EncodeSocioInternalRestPosts6(out *jwriter.Writer, in LikeCommentInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -464,27 +639,144 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts5(out *jwriter.Writer, in LikeC
 // MarshalJSON supports json.Marshaler interface
 func (v LikeCommentInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioInternalRestPosts5(&w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LikeCommentInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioInternalRestPosts5(w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LikeCommentInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioInternalRestPosts5(&r, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LikeCommentInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioInternalRestPosts5(l, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts6(l, v)
 }
-func easyjsonDc9e8747DecodeSocioInternalRestPosts6(in *jlexer.Lexer, out *DeleteCommentInput) {
+func easyjsonDc9e8747DecodeSocioInternalRestPosts7(in *jlexer.Lexer, out *FeedResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts":
+			if in.IsNull() {
+				in.Skip()
+				out.Posts = nil
+			} else {
+				in.Delim('[')
+				if out.Posts == nil {
+					if !in.IsDelim(']') {
+						out.Posts = make([]*domain.PostWithAuthorAndGroup, 0, 8)
+					} else {
+						out.Posts = []*domain.PostWithAuthorAndGroup{}
+					}
+				} else {
+					out.Posts = (out.Posts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 *domain.PostWithAuthorAndGroup
+					if in.IsNull() {
+						in.Skip()
+						v10 = nil
+					} else {
+						if v10 == nil {
+							v10 = new(domain.PostWithAuthorAndGroup)
+						}
+						(*v10).UnmarshalEasyJSON(in)
+					}
+					out.Posts = append(out.Posts, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDc9e8747EncodeSocioInternalRestPosts7(out *jwriter.Writer, in FeedResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"posts\":"
+		out.RawString(prefix[1:])
+		if in.Posts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Posts {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				if v12 == nil {
+					out.RawString("null")
+				} else {
+					(*v12).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeedResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDc9e8747EncodeSocioInternalRestPosts7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeedResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDc9e8747EncodeSocioInternalRestPosts7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeedResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDc9e8747DecodeSocioInternalRestPosts7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeedResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDc9e8747DecodeSocioInternalRestPosts7(l, v)
+}
+func easyjsonDc9e8747DecodeSocioInternalRestPosts8(in *jlexer.Lexer, out *DeleteCommentInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -515,7 +807,7 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts6(in *jlexer.Lexer, out *Delete
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioInternalRestPosts6(out *jwriter.Writer, in DeleteCommentInput) {
+func easyjsonDc9e8747EncodeSocioInternalRestPosts8(out *jwriter.Writer, in DeleteCommentInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -530,27 +822,27 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts6(out *jwriter.Writer, in Delet
 // MarshalJSON supports json.Marshaler interface
 func (v DeleteCommentInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioInternalRestPosts6(&w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeleteCommentInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioInternalRestPosts6(w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DeleteCommentInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioInternalRestPosts6(&r, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DeleteCommentInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioInternalRestPosts6(l, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts8(l, v)
 }
-func easyjsonDc9e8747DecodeSocioInternalRestPosts7(in *jlexer.Lexer, out *CreateCommentInput) {
+func easyjsonDc9e8747DecodeSocioInternalRestPosts9(in *jlexer.Lexer, out *CreateCommentInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -571,6 +863,8 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts7(in *jlexer.Lexer, out *Create
 		switch key {
 		case "postId":
 			out.PostID = uint(in.Uint())
+		case "parentId":
+			out.ParentID = uint(in.Uint())
 		case "content":
 			out.Content = string(in.String())
 		default:
@@ -583,7 +877,7 @@ func easyjsonDc9e8747DecodeSocioInternalRestPosts7(in *jlexer.Lexer, out *Create
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioInternalRestPosts7(out *jwriter.Writer, in CreateCommentInput) {
+func easyjsonDc9e8747EncodeSocioInternalRestPosts9(out *jwriter.Writer, in CreateCommentInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -592,6 +886,11 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts7(out *jwriter.Writer, in Creat
 		out.RawString(prefix[1:])
 		out.Uint(uint(in.PostID))
 	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ParentID))
+	}
 	{
 		const prefix string = ",\"content\":"
 		out.RawString(prefix)
@@ -603,23 +902,23 @@ func easyjsonDc9e8747EncodeSocioInternalRestPosts7(out *jwriter.Writer, in Creat
 // MarshalJSON supports json.Marshaler interface
 func (v CreateCommentInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioInternalRestPosts7(&w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateCommentInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioInternalRestPosts7(w, v)
+	easyjsonDc9e8747EncodeSocioInternalRestPosts9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateCommentInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioInternalRestPosts7(&r, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateCommentInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioInternalRestPosts7(l, v)
+	easyjsonDc9e8747DecodeSocioInternalRestPosts9(l, v)
 }
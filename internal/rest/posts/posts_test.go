@@ -15,7 +15,9 @@ import (
 	mock_posts "socio/mocks/grpc/post_grpc"
 	mock_public_group "socio/mocks/grpc/public_group_grpc"
 	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/posts"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -120,7 +122,7 @@ func TestHandleGetPostByID(t *testing.T) {
 				tt.prepare(f)
 			}
 
-			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient)
+			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient, nil, nil)
 
 			rr := httptest.NewRecorder()
 			router := mux.NewRouter()
@@ -214,12 +216,12 @@ func TestHandleGetUserPosts(t *testing.T) {
 				tt.prepare(f)
 			}
 
-			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient)
+			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient, nil, nil)
 
 			rr := httptest.NewRecorder()
 			handler := http.HandlerFunc(h.HandleGetUserPosts)
 
-			handler.ServeHTTP(rr, tt.request)
+			handler.ServeHTTP(rr, tt.request.WithContext(validCtx))
 
 			if status := rr.Code; status != tt.expectedStatus {
 				t.Errorf("handler returned wrong status code: got %v want %v", status, tt.expectedStatus)
@@ -339,7 +341,7 @@ func TestHandleGetUserFriendsPosts(t *testing.T) {
 				tt.prepare(f)
 			}
 
-			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient)
+			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient, nil, nil)
 
 			rr := httptest.NewRecorder()
 			handler := http.HandlerFunc(h.HandleGetUserFriendsPosts)
@@ -404,7 +406,7 @@ func TestHandleDeletePost(t *testing.T) {
 				tt.prepare(f)
 			}
 
-			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient)
+			h := NewPostsHandler(f.PostsClient, f.UserClient, f.PublicGroupClient, nil, nil)
 
 			rr := httptest.NewRecorder()
 			handler := http.HandlerFunc(h.HandleDeletePost)
@@ -427,9 +429,11 @@ func TestHandleCreatePost(t *testing.T) {
 		ctx            context.Context
 		userID         int64
 		content        string
+		uploads        []string
 		mockError      error
 		expectedStatus int
 		mock           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, publicGroupClient *mock_public_group.MockPublicGroupClient)
+		mockUploads    func(uploads *mock_rest.MockUploads)
 	}{
 		{
 			name:           "Successful post creation",
@@ -486,6 +490,44 @@ func TestHandleCreatePost(t *testing.T) {
 				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
 			},
 		},
+		{
+			name:           "Successful post creation with uploads",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			content:        "Test content",
+			uploads:        []string{"upload"},
+			expectedStatus: http.StatusCreated,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, publicGroupClient *mock_public_group.MockPublicGroupClient) {
+				postsClient.EXPECT().CreatePost(gomock.Any(), &postpb.CreatePostRequest{
+					AuthorId:    1,
+					Content:     "Test content",
+					Attachments: []string{"pic.png"},
+				}).Return(&postpb.CreatePostResponse{
+					Post: &postpb.PostResponse{
+						Id: 1,
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{
+					User: &uspb.UserResponse{
+						Id: 1,
+					},
+				}, nil)
+			},
+			mockUploads: func(uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.PostAttachmentKind, []string{"upload"}).Return([]string{"pic.png"}, nil)
+			},
+		},
+		{
+			name:           "upload is not completed",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			content:        "Test content",
+			uploads:        []string{"upload"},
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, publicGroupClient *mock_public_group.MockPublicGroupClient) {
+			},
+			mockUploads: func(uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.PostAttachmentKind, []string{"upload"}).Return(nil, errors.ErrInvalidData)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -493,6 +535,9 @@ func TestHandleCreatePost(t *testing.T) {
 			body := &bytes.Buffer{}
 			writer := multipart.NewWriter(body)
 			_ = writer.WriteField("content", tt.content)
+			for _, uploadID := range tt.uploads {
+				_ = writer.WriteField("uploads", uploadID)
+			}
 			_ = writer.Close()
 
 			r := httptest.NewRequest("POST", "/", body)
@@ -505,9 +550,14 @@ func TestHandleCreatePost(t *testing.T) {
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
 			publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
 
+			mockUploads := mock_rest.NewMockUploads(ctrl)
+
 			tt.mock(mockPostsClient, mockUserClient, publicGroupClient)
+			if tt.mockUploads != nil {
+				tt.mockUploads(mockUploads)
+			}
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, publicGroupClient)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, publicGroupClient, nil, mockUploads)
 
 			h.HandleCreatePost(rr, r)
 
@@ -516,6 +566,103 @@ func TestHandleCreatePost(t *testing.T) {
 	}
 }
 
+func TestHandleRepostPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           string
+		expectedStatus int
+		mock           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful repost",
+			ctx:            validCtx,
+			body:           `{"postId": 1, "content": " Look "}`,
+			expectedStatus: http.StatusCreated,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().RepostPost(gomock.Any(), &postpb.RepostPostRequest{
+					PostId:   1,
+					AuthorId: 1,
+					Content:  "Look",
+				}).Return(&postpb.RepostPostResponse{
+					Post: &postpb.PostResponse{
+						Id:         2,
+						AuthorId:   1,
+						IsRepost:   true,
+						RepostOfId: 1,
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{
+					User: &uspb.UserResponse{
+						Id: 1,
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "invalid json",
+			ctx:            validCtx,
+			body:           `{"postId": `,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {},
+		},
+		{
+			name:           "no user id in context",
+			ctx:            context.Background(),
+			body:           `{"postId": 1}`,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {},
+		},
+		{
+			name:           "post not found",
+			ctx:            validCtx,
+			body:           `{"postId": 1}`,
+			expectedStatus: http.StatusNotFound,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().RepostPost(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal user",
+			ctx:            validCtx,
+			body:           `{"postId": 1}`,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+				postsClient.EXPECT().RepostPost(gomock.Any(), gomock.Any()).Return(&postpb.RepostPostResponse{
+					Post: &postpb.PostResponse{
+						Id:       2,
+						AuthorId: 1,
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/posts/repost", bytes.NewBufferString(tt.body))
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+
+			tt.mock(mockPostsClient, mockUserClient)
+
+			h := NewPostsHandler(mockPostsClient, mockUserClient, nil, nil, nil)
+
+			h.HandleRepostPost(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
 func TestHandleGetLikedPosts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -638,7 +785,7 @@ func TestHandleGetLikedPosts(t *testing.T) {
 
 			tt.mock(mockPostsClient, mockUserClient, publicGroupClient)
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, publicGroupClient)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, publicGroupClient, nil, nil)
 
 			h.HandleGetLikedPosts(rr, r)
 
@@ -658,7 +805,7 @@ func TestHandleLikePost(t *testing.T) {
 		postID         uint64
 		mockError      error
 		expectedStatus int
-		mock           func(postsClient *mock_posts.MockPostClient)
+		mock           func(postsClient *mock_posts.MockPostClient, notifier *mock_rest.MockNotifier)
 	}{
 		{
 			name:           "Successful post like",
@@ -667,10 +814,25 @@ func TestHandleLikePost(t *testing.T) {
 			postID:         1,
 			mockError:      nil,
 			expectedStatus: http.StatusCreated,
-			mock: func(postsClient *mock_posts.MockPostClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postsClient.EXPECT().LikePost(gomock.Any(), gomock.Any()).Return(&postpb.LikePostResponse{
 					Like: &postpb.PostLikeResponse{},
 				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.PostLikeNotification, uint(1), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:           "notification error does not fail like",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			userID:         1,
+			postID:         1,
+			mockError:      nil,
+			expectedStatus: http.StatusCreated,
+			mock: func(postsClient *mock_posts.MockPostClient, notifier *mock_rest.MockNotifier) {
+				postsClient.EXPECT().LikePost(gomock.Any(), gomock.Any()).Return(&postpb.LikePostResponse{
+					Like: &postpb.PostLikeResponse{},
+				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.PostLikeNotification, uint(1), uint(1)).Return(nil, errors.ErrInternal)
 			},
 		},
 		{
@@ -680,7 +842,7 @@ func TestHandleLikePost(t *testing.T) {
 			postID:         1,
 			mockError:      nil,
 			expectedStatus: http.StatusBadRequest,
-			mock: func(postsClient *mock_posts.MockPostClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -691,7 +853,7 @@ func TestHandleLikePost(t *testing.T) {
 			postID:         1,
 			mockError:      nil,
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(postsClient *mock_posts.MockPostClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postsClient.EXPECT().LikePost(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -711,9 +873,10 @@ func TestHandleLikePost(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
-			tt.mock(mockPostsClient)
+			mockNotifier := mock_rest.NewMockNotifier(ctrl)
+			tt.mock(mockPostsClient, mockNotifier)
 
-			h := NewPostsHandler(mockPostsClient, nil, nil)
+			h := NewPostsHandler(mockPostsClient, nil, nil, mockNotifier, nil)
 
 			h.HandleLikePost(rr, r)
 
@@ -788,7 +951,7 @@ func TestHandleUnlikePost(t *testing.T) {
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
 			tt.mock(mockPostsClient)
 
-			h := NewPostsHandler(mockPostsClient, nil, nil)
+			h := NewPostsHandler(mockPostsClient, nil, nil, nil, nil)
 
 			h.HandleUnlikePost(rr, r)
 
@@ -984,7 +1147,7 @@ func TestHandleGetGroupPostsBySubscriptions(t *testing.T) {
 			publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
 			tt.mock(mockPostsClient, userClient, publicGroupClient)
 
-			h := NewPostsHandler(mockPostsClient, userClient, publicGroupClient)
+			h := NewPostsHandler(mockPostsClient, userClient, publicGroupClient, nil, nil)
 
 			h.HandleGetGroupPostsBySubscriptions(rr, r)
 
@@ -1194,7 +1357,7 @@ func TestHandleGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
 			tt.mock(mockPostsClient, mockPublicGroupClient, mockUserClient)
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient, nil, nil)
 
 			h.HandleGetPostsByGroupSubIDsAndUserSubIDs(rr, r)
 
@@ -1203,6 +1366,149 @@ func TestHandleGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 	}
 }
 
+func TestHandleGetFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		cursor         string
+		postsAmount    string
+		expectedStatus int
+		mock           func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful get feed",
+			ctx:            validCtx,
+			cursor:         "abc",
+			postsAmount:    "10",
+			expectedStatus: http.StatusOK,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				publicGroupClient.EXPECT().GetBySubscriberID(gomock.Any(), gomock.Any()).Return(
+					&pgpb.GetBySubscriberIDResponse{
+						PublicGroups: []*pgpb.PublicGroupResponse{
+							{
+								Id: 1,
+							},
+						},
+					},
+					nil,
+				)
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(
+					&uspb.GetSubscriptionIDsResponse{
+						SubscriptionIds: []uint64{2},
+					}, nil,
+				)
+				postsClient.EXPECT().GetFeed(gomock.Any(), &postpb.GetFeedRequest{
+					UserId:               1,
+					GroupSubscriptionIds: []uint64{1},
+					UserSubscriptionIds:  []uint64{2},
+					Cursor:               "abc",
+					PostsAmount:          10,
+				}).Return(
+					&postpb.GetFeedResponse{
+						Posts: []*postpb.PostResponse{
+							{
+								Id:      1,
+								GroupId: 1,
+							},
+						},
+						NextCursor: "def",
+					}, nil,
+				)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
+					&uspb.GetByIDResponse{
+						User: &uspb.UserResponse{
+							Id: 1,
+						},
+					}, nil,
+				)
+			},
+		},
+		{
+			name:           "no user id in context",
+			ctx:            context.Background(),
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "invalid posts amount",
+			ctx:            validCtx,
+			postsAmount:    "asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "invalid cursor",
+			ctx:            validCtx,
+			cursor:         "asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				publicGroupClient.EXPECT().GetBySubscriberID(gomock.Any(), gomock.Any()).Return(&pgpb.GetBySubscriberIDResponse{}, nil)
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().GetFeed(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal group subscriptions",
+			ctx:            validCtx,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				publicGroupClient.EXPECT().GetBySubscriberID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal user subscriptions",
+			ctx:            validCtx,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				publicGroupClient.EXPECT().GetBySubscriberID(gomock.Any(), gomock.Any()).Return(&pgpb.GetBySubscriberIDResponse{}, nil)
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal author",
+			ctx:            validCtx,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				publicGroupClient.EXPECT().GetBySubscriberID(gomock.Any(), gomock.Any()).Return(&pgpb.GetBySubscriberIDResponse{}, nil)
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().GetFeed(gomock.Any(), gomock.Any()).Return(&postpb.GetFeedResponse{
+					Posts: []*postpb.PostResponse{
+						{
+							Id: 1,
+						},
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/feed?cursor="+tt.cursor+"&postsAmount="+tt.postsAmount, nil)
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockPostsClient, mockPublicGroupClient, mockUserClient)
+
+			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient, nil, nil)
+
+			h.HandleGetFeed(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
 func TestHandleGetNewPosts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1218,7 +1524,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 	}{
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "",
 			postsAmount:    "",
 			mockError:      nil,
@@ -1255,7 +1561,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 		},
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "10",
 			postsAmount:    "10",
 			mockError:      nil,
@@ -1291,8 +1597,19 @@ func TestHandleGetNewPosts(t *testing.T) {
 			},
 		},
 		{
-			name:           "Successful get new posts",
+			name:           "No user in context",
 			ctx:            context.Background(),
+			lastPostID:     "",
+			postsAmount:    "",
+			mockError:      nil,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+
+			},
+		},
+		{
+			name:           "Successful get new posts",
+			ctx:            validCtx,
 			lastPostID:     "asd",
 			postsAmount:    "10",
 			mockError:      nil,
@@ -1303,7 +1620,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 		},
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "10",
 			postsAmount:    "asd",
 			mockError:      nil,
@@ -1314,7 +1631,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 		},
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "",
 			postsAmount:    "",
 			mockError:      nil,
@@ -1328,7 +1645,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 		},
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "",
 			postsAmount:    "",
 			mockError:      nil,
@@ -1352,7 +1669,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 		},
 		{
 			name:           "Successful get new posts",
-			ctx:            context.Background(),
+			ctx:            validCtx,
 			lastPostID:     "",
 			postsAmount:    "",
 			mockError:      nil,
@@ -1394,7 +1711,7 @@ func TestHandleGetNewPosts(t *testing.T) {
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
 			tt.mock(mockPostsClient, mockPublicGroupClient, mockUserClient)
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient, nil, nil)
 
 			h.HandleGetNewPosts(rr, r)
 
@@ -1433,7 +1750,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 				},
 			},
 			wantErr: nil,
-			ctx:     context.Background(),
+			ctx:     validCtx,
 			setup: func() {
 				mockPostsClient.EXPECT().GetCommentsByPostID(gomock.Any(), gomock.Any()).Return(&postpb.GetCommentsByPostIDResponse{
 					Comments: []*postpb.CommentResponse{
@@ -1457,7 +1774,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 			postID:       1,
 			wantComments: nil,
 			wantErr:      errors.ErrInvalidData,
-			ctx:          context.Background(),
+			ctx:          validCtx,
 			setup: func() {
 
 			},
@@ -1468,7 +1785,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 			postID:       1,
 			wantComments: nil,
 			wantErr:      errors.ErrInvalidData,
-			ctx:          context.Background(),
+			ctx:          validCtx,
 			setup: func() {
 
 			},
@@ -1481,7 +1798,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 			postID:       1,
 			wantComments: nil,
 			wantErr:      errors.ErrInvalidData,
-			ctx:          context.Background(),
+			ctx:          validCtx,
 			setup: func() {
 				mockPostsClient.EXPECT().GetCommentsByPostID(gomock.Any(), gomock.Any()).Return(&postpb.GetCommentsByPostIDResponse{
 					Comments: []*postpb.CommentResponse{
@@ -1501,7 +1818,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 			postID:       1,
 			wantComments: nil,
 			wantErr:      errors.ErrInvalidData,
-			ctx:          context.Background(),
+			ctx:          validCtx,
 			setup: func() {
 				mockPostsClient.EXPECT().GetCommentsByPostID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
 			},
@@ -1515,7 +1832,7 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, nil)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, nil, nil, nil)
 
 			req, err := http.NewRequest("GET", "/{postID}/comments/", nil)
 			if err != nil {
@@ -1540,6 +1857,135 @@ func TestHandleGetCommentsByPostID(t *testing.T) {
 	}
 }
 
+func TestHandleGetCommentReplies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+	mockUserClient := mock_user.NewMockUserClient(ctrl)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		setup    func()
+		muxVars  map[string]string
+	}{
+		{
+			name:     "test case 1 - successful retrieval",
+			query:    "?lastReplyId=3&repliesAmount=10",
+			wantCode: http.StatusOK,
+			setup: func() {
+				mockPostsClient.EXPECT().GetCommentReplies(gomock.Any(), &postpb.GetCommentRepliesRequest{
+					CommentId:     1,
+					LastReplyId:   3,
+					RepliesAmount: 10,
+				}).Return(&postpb.GetCommentRepliesResponse{
+					Replies: []*postpb.CommentResponse{
+						{
+							Id:       4,
+							AuthorId: 2,
+							ParentId: 1,
+						},
+						{
+							Id:       5,
+							AuthorId: 2,
+							ParentId: 1,
+						},
+					},
+				}, nil)
+				mockUserClient.EXPECT().GetByID(gomock.Any(), &uspb.GetByIDRequest{UserId: 2, ViewerId: 1}).Return(&uspb.GetByIDResponse{
+					User: &uspb.UserResponse{
+						Id: 2,
+					},
+				}, nil).Times(1)
+			},
+			muxVars: map[string]string{
+				"commentID": "1",
+			},
+		},
+		{
+			name:     "test case 2",
+			query:    "",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+			muxVars:  map[string]string{},
+		},
+		{
+			name:     "test case 3",
+			query:    "?lastReplyId=abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+			muxVars: map[string]string{
+				"commentID": "1",
+			},
+		},
+		{
+			name:     "test case 4",
+			query:    "?repliesAmount=abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+			muxVars: map[string]string{
+				"commentID": "1",
+			},
+		},
+		{
+			name:     "test case 5",
+			query:    "",
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockPostsClient.EXPECT().GetCommentReplies(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+			muxVars: map[string]string{
+				"commentID": "1",
+			},
+		},
+		{
+			name:     "test case 6",
+			query:    "",
+			wantCode: http.StatusInternalServerError,
+			setup: func() {
+				mockPostsClient.EXPECT().GetCommentReplies(gomock.Any(), gomock.Any()).Return(&postpb.GetCommentRepliesResponse{
+					Replies: []*postpb.CommentResponse{
+						{
+							Id:       4,
+							AuthorId: 2,
+						},
+					},
+				}, nil)
+				mockUserClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+			muxVars: map[string]string{
+				"commentID": "1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := NewPostsHandler(mockPostsClient, mockUserClient, nil, nil, nil)
+
+			req, err := http.NewRequest("GET", "/comments/{commentID}/replies"+tt.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req = req.WithContext(validCtx)
+
+			req = mux.SetURLVars(req, tt.muxVars)
+
+			rr := httptest.NewRecorder()
+			handler := http.HandlerFunc(h.HandleGetCommentReplies)
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
 func TestHandleCreateComment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1551,7 +1997,7 @@ func TestHandleCreateComment(t *testing.T) {
 		commentInput   CreateCommentInput
 		mockError      error
 		expectedStatus int
-		mock           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient)
+		mock           func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier)
 	}{
 		{
 			name:   "Successful comment creation",
@@ -1563,13 +2009,39 @@ func TestHandleCreateComment(t *testing.T) {
 			},
 			mockError:      nil,
 			expectedStatus: http.StatusCreated,
-			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{
 					User: &uspb.UserResponse{},
 				}, nil)
 				postsClient.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(&postpb.CreateCommentResponse{
-					Comment: &postpb.CommentResponse{},
+					Comment: &postpb.CommentResponse{PostId: 1},
 				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.CommentNotification, uint(1), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:   "Successful comment creation with mention",
+			ctx:    context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			userID: 1,
+			commentInput: CreateCommentInput{
+				PostID:  1,
+				Content: "@id2 text",
+			},
+			mockError:      nil,
+			expectedStatus: http.StatusCreated,
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{
+					User: &uspb.UserResponse{},
+				}, nil)
+				postsClient.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(&postpb.CreateCommentResponse{
+					Comment: &postpb.CommentResponse{
+						Id:       3,
+						PostId:   1,
+						Entities: []*postpb.Entity{{Type: string(domain.MentionEntity), Length: 4, UserId: 2}},
+					},
+				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.CommentNotification, uint(1), uint(1)).Return(nil, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.CommentMentionNotification, uint(1), uint(3)).Return(nil, errors.ErrInternal)
 			},
 		},
 		{
@@ -1582,7 +2054,7 @@ func TestHandleCreateComment(t *testing.T) {
 			},
 			mockError:      errors.ErrInvalidData,
 			expectedStatus: http.StatusBadRequest,
-			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -1596,10 +2068,11 @@ func TestHandleCreateComment(t *testing.T) {
 			},
 			mockError:      errors.ErrInternal,
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 				postsClient.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(&postpb.CreateCommentResponse{
-					Comment: &postpb.CommentResponse{},
+					Comment: &postpb.CommentResponse{PostId: 1},
 				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.CommentNotification, uint(1), uint(1)).Return(nil, nil)
 				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -1615,7 +2088,7 @@ func TestHandleCreateComment(t *testing.T) {
 			},
 			mockError:      errors.ErrInternal,
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient) {
+			mock: func(postsClient *mock_posts.MockPostClient, userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 				postsClient.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -1633,9 +2106,10 @@ func TestHandleCreateComment(t *testing.T) {
 
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
-			tt.mock(mockPostsClient, mockUserClient)
+			mockNotifier := mock_rest.NewMockNotifier(ctrl)
+			tt.mock(mockPostsClient, mockUserClient, mockNotifier)
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, nil)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, nil, mockNotifier, nil)
 
 			h.HandleCreateComment(rr, r)
 
@@ -1734,7 +2208,7 @@ func TestHandleUpdateComment(t *testing.T) {
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
 			tt.mock(mockPostsClient, mockUserClient)
 
-			h := NewPostsHandler(mockPostsClient, mockUserClient, nil)
+			h := NewPostsHandler(mockPostsClient, mockUserClient, nil, nil, nil)
 
 			h.HandleUpdateComment(rr, r)
 
@@ -1805,7 +2279,7 @@ func TestHandleDeleteComment(t *testing.T) {
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
 			tt.mock(mockPostsClient)
 
-			h := NewPostsHandler(mockPostsClient, nil, nil)
+			h := NewPostsHandler(mockPostsClient, nil, nil, nil, nil)
 
 			h.HandleDeleteComment(rr, r)
 
@@ -1879,7 +2353,7 @@ func TestHandleLikeComment(t *testing.T) {
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
 			tt.mock(mockPostsClient)
 
-			h := NewPostsHandler(mockPostsClient, nil, nil)
+			h := NewPostsHandler(mockPostsClient, nil, nil, nil, nil)
 
 			h.HandleLikeComment(rr, r)
 
@@ -1950,7 +2424,7 @@ func TestHandleUnlikeComment(t *testing.T) {
 			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
 			tt.mock(mockPostsClient)
 
-			h := NewPostsHandler(mockPostsClient, nil, nil)
+			h := NewPostsHandler(mockPostsClient, nil, nil, nil, nil)
 
 			h.HandleUnlikeComment(rr, r)
 
@@ -1958,3 +2432,191 @@ func TestHandleUnlikeComment(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleSearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		expectedStatus int
+		mock           func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful search posts",
+			ctx:            validCtx,
+			query:          "?query=go&cursor=abc&amount=2",
+			expectedStatus: http.StatusOK,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(
+					&uspb.GetSubscriptionIDsResponse{
+						SubscriptionIds: []uint64{2},
+					}, nil,
+				)
+				postsClient.EXPECT().Search(gomock.Any(), &postpb.SearchRequest{
+					UserId:              1,
+					UserSubscriptionIds: []uint64{2},
+					Query:               "go",
+					Cursor:              "abc",
+					Amount:              2,
+				}).Return(
+					&postpb.SearchResponse{
+						Posts: []*postpb.PostSearchResult{
+							{
+								Post:    &postpb.PostResponse{Id: 1, AuthorId: 2, GroupId: 1},
+								Snippet: "<mark>go</mark>",
+							},
+							{
+								Post:    &postpb.PostResponse{Id: 2, AuthorId: 2, GroupId: 1},
+								Snippet: "<mark>go</mark>",
+							},
+						},
+						NextCursor: "def",
+					}, nil,
+				)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
+					&uspb.GetByIDResponse{
+						User: &uspb.UserResponse{
+							Id: 2,
+						},
+					}, nil,
+				).Times(1)
+				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
+					&pgpb.GetByIDResponse{
+						PublicGroup: &pgpb.PublicGroupWithInfoResponse{
+							PublicGroup: &pgpb.PublicGroupResponse{
+								Id: 1,
+							},
+						},
+					}, nil,
+				).Times(1)
+			},
+		},
+		{
+			name:           "Successful search comments",
+			ctx:            validCtx,
+			query:          "?query=go&type=comments",
+			expectedStatus: http.StatusOK,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(
+					&postpb.SearchResponse{
+						Comments: []*postpb.CommentSearchResult{
+							{
+								Comment: &postpb.CommentResponse{Id: 1, AuthorId: 2},
+								Snippet: "<mark>go</mark>",
+							},
+						},
+					}, nil,
+				)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
+					&uspb.GetByIDResponse{
+						User: &uspb.UserResponse{
+							Id: 2,
+						},
+					}, nil,
+				)
+			},
+		},
+		{
+			name:           "no user id in context",
+			ctx:            context.Background(),
+			query:          "?query=go",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "empty query",
+			ctx:            validCtx,
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "invalid amount",
+			ctx:            validCtx,
+			query:          "?query=go&amount=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "invalid type",
+			ctx:            validCtx,
+			query:          "?query=go&type=users",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal user subscriptions",
+			ctx:            validCtx,
+			query:          "?query=go",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal author",
+			ctx:            validCtx,
+			query:          "?query=go",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&postpb.SearchResponse{
+					Posts: []*postpb.PostSearchResult{
+						{
+							Post: &postpb.PostResponse{Id: 1},
+						},
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err internal group",
+			ctx:            validCtx,
+			query:          "?query=go",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(postsClient *mock_posts.MockPostClient, publicGroupClient *mock_public_group.MockPublicGroupClient, userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&postpb.SearchResponse{
+					Posts: []*postpb.PostSearchResult{
+						{
+							Post: &postpb.PostResponse{Id: 1, GroupId: 1},
+						},
+					},
+				}, nil)
+				userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{User: &uspb.UserResponse{}}, nil)
+				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/search"+tt.query, nil)
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockPostsClient := mock_posts.NewMockPostClient(ctrl)
+			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockPostsClient, mockPublicGroupClient, mockUserClient)
+
+			h := NewPostsHandler(mockPostsClient, mockUserClient, mockPublicGroupClient, nil, nil)
+
+			h.HandleSearch(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+
+	uspb "socio/internal/grpc/user/proto"
+
+	"github.com/mailru/easyjson"
+)
+
+// HandleGetPrivacySettings godoc
+//
+//	@Summary		get privacy settings
+//	@Description	get privacy settings of the authorized user
+//	@Tags			profile
+//	@license.name	Apache 2.0
+//	@ID				profile/get_privacy
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.PrivacySettings}
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/profile/privacy [get]
+func (h *ProfileHandler) HandleGetPrivacySettings(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	settings, err := h.UserClient.GetPrivacySettings(r.Context(), &uspb.GetPrivacySettingsRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, uspb.ToPrivacySettings(settings.GetSettings()), http.StatusOK)
+}
+
+// HandleUpdatePrivacySettings godoc
+//
+//	@Summary		update privacy settings
+//	@Description	update privacy settings of the authorized user, the omitted ones are kept
+//	@Description	levels: EVERYONE, SUBSCRIBERS, FRIENDS, ONLY_ME
+//	@Tags			profile
+//	@license.name	Apache 2.0
+//	@ID				profile/update_privacy
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			settings	body	domain.PrivacySettings	true	"Privacy settings"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=domain.PrivacySettings}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/profile/privacy [put]
+func (h *ProfileHandler) HandleUpdatePrivacySettings(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	input := new(domain.PrivacySettings)
+
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input.UserID = userID
+
+	settings, err := h.UserClient.UpdatePrivacySettings(r.Context(), &uspb.UpdatePrivacySettingsRequest{
+		Settings: uspb.ToPrivacySettingsResponse(input),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, uspb.ToPrivacySettings(settings.GetSettings()), http.StatusOK)
+}
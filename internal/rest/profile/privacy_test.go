@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"socio/errors"
+	uspb "socio/internal/grpc/user/proto"
+	mock_user "socio/mocks/grpc/user_grpc"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleGetPrivacySettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful get privacy settings",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetPrivacySettings(gomock.Any(), &uspb.GetPrivacySettingsRequest{UserId: 1}).Return(&uspb.GetPrivacySettingsResponse{
+					Settings: &uspb.PrivacySettingsResponse{UserId: 1, Posts: "EVERYONE", Messages: "EVERYONE", Subscriptions: "EVERYONE", DateOfBirth: "EVERYONE"},
+				}, nil)
+			},
+		},
+		{
+			name:           "Unauthorized",
+			ctx:            context.Background(),
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *mock_user.MockUserClient) {},
+		},
+		{
+			name:           "Internal error",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetPrivacySettings(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(userClient)
+
+			h := NewProfileHandler(userClient, nil)
+
+			r := httptest.NewRequest("GET", "/profile/privacy", nil).WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			h.HandleGetPrivacySettings(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleUpdatePrivacySettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           string
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful update privacy settings",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           `{"userId":5,"posts":"FRIENDS"}`,
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().UpdatePrivacySettings(gomock.Any(), &uspb.UpdatePrivacySettingsRequest{
+					Settings: &uspb.PrivacySettingsResponse{UserId: 1, Posts: "FRIENDS"},
+				}).Return(&uspb.UpdatePrivacySettingsResponse{
+					Settings: &uspb.PrivacySettingsResponse{UserId: 1, Posts: "FRIENDS", Messages: "EVERYONE", Subscriptions: "EVERYONE", DateOfBirth: "EVERYONE"},
+				}, nil)
+			},
+		},
+		{
+			name:           "Invalid body",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           `{"posts":`,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *mock_user.MockUserClient) {},
+		},
+		{
+			name:           "Invalid level",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           `{"posts":"NOBODY"}`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().UpdatePrivacySettings(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(userClient)
+
+			h := NewProfileHandler(userClient, nil)
+
+			r := httptest.NewRequest("PUT", "/profile/privacy", bytes.NewBufferString(tt.body)).WithContext(tt.ctx)
+			rr := httptest.NewRecorder()
+
+			h.HandleUpdatePrivacySettings(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
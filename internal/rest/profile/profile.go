@@ -1,10 +1,12 @@
 package rest
 
 import (
+	"context"
 	"net/http"
 	"socio/errors"
 	"socio/pkg/json"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 	"socio/usecase/user"
 	"strconv"
 	"strings"
@@ -15,13 +17,20 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// Uploads resolves the completed resumable uploads to the stored files.
+type Uploads interface {
+	GetUploadedFileNames(ctx context.Context, userID uint, kind upload.Kind, uploadIDs []string) (fileNames []string, err error)
+}
+
 type ProfileHandler struct {
 	UserClient uspb.UserClient
+	Uploads    Uploads
 }
 
-func NewProfileHandler(userClient uspb.UserClient) (h *ProfileHandler) {
+func NewProfileHandler(userClient uspb.UserClient, uploads Uploads) (h *ProfileHandler) {
 	return &ProfileHandler{
 		UserClient: userClient,
+		Uploads:    uploads,
 	}
 }
 
@@ -97,6 +106,7 @@ func (h *ProfileHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request
 //	@Param			repeatPassword	formData	string	false	"Repeat password"
 //	@Param			dateOfBirth		formData	string	false	"Date of birth"
 //	@Param			avatar			formData	file	false	"Avatar"
+//	@Param			avatarUpload	formData	string	false	"ID of the completed resumable upload of the avatar"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=domain.User}
@@ -104,12 +114,14 @@ func (h *ProfileHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/profile/ [put]
 func (h *ProfileHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(4 * 1024 * 1024)
+	err := upload.ParseMultipartForm(w, r, upload.AvatarKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -134,13 +146,19 @@ func (h *ProfileHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Requ
 	}
 
 	if avatarFH != nil {
-		avatarFileName, err := uploaders.UploadAvatar(r, h.UserClient, avatarFH)
+		avatar, err := uploaders.UploadAvatar(r, h.UserClient, avatarFH)
 		if err != nil {
-			json.ServeJSONError(r.Context(), w, err)
+			json.ServeGRPCStatus(r.Context(), w, err)
 			return
 		}
 
-		input.Avatar = avatarFileName
+		input.Avatar = avatar.FileName
+	} else {
+		input.Avatar, err = h.getUploadedAvatar(r, userID)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, err)
+			return
+		}
 	}
 
 	var grpcInput = uspb.ToUpdateRequest(&input)
@@ -154,6 +172,28 @@ func (h *ProfileHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Requ
 	json.ServeJSONBody(r.Context(), w, uspb.ToUser(updatedUser.User), http.StatusOK)
 }
 
+// getUploadedAvatar claims the resumable upload listed in the avatarUpload
+// form field, the avatar is already stored by the user service.
+func (h *ProfileHandler) getUploadedAvatar(r *http.Request, userID uint) (fileName string, err error) {
+	uploadID := r.PostFormValue("avatarUpload")
+	if uploadID == "" {
+		return
+	}
+
+	if h.Uploads == nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	fileNames, err := h.Uploads.GetUploadedFileNames(r.Context(), userID, upload.AvatarKind, []string{uploadID})
+	if err != nil {
+		return
+	}
+
+	fileName = fileNames[0]
+	return
+}
+
 // HandleDeleteProfile godoc
 //
 //	@Summary		delete user profile
@@ -202,6 +242,8 @@ func (h *ProfileHandler) HandleDeleteProfile(w http.ResponseWriter, r *http.Requ
 //	@Param			Cookie	header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
 //	@Param			query	query	string	true	"Search query"
+//	@Param			lastUserId	query	uint	false	"ID of the last found user, if 0 - get first users"
+//	@Param			amount	query	uint	false	"Amount of users to get, if 0 - get 20 users"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=[]domain.User}
@@ -212,14 +254,41 @@ func (h *ProfileHandler) HandleDeleteProfile(w http.ResponseWriter, r *http.Requ
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/profile/search [get]
 func (h *ProfileHandler) HandleSearchByName(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	query := r.URL.Query().Get("query")
 	if query == "" {
 		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
 		return
 	}
 
+	var lastUserID, amount uint64
+
+	if lastUserIDData := r.URL.Query().Get("lastUserId"); lastUserIDData != "" {
+		lastUserID, err = strconv.ParseUint(lastUserIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	if amountData := r.URL.Query().Get("amount"); amountData != "" {
+		amount, err = strconv.ParseUint(amountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
 	users, err := h.UserClient.SearchByName(r.Context(), &uspb.SearchByNameRequest{
-		Query: query,
+		ViewerId:   uint64(userID),
+		Query:      query,
+		LastUserId: lastUserID,
+		Amount:     amount,
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
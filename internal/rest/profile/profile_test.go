@@ -9,7 +9,9 @@ import (
 	"socio/errors"
 	uspb "socio/internal/grpc/user/proto"
 	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/profile"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -105,7 +107,7 @@ func TestHandleGetProfile(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewProfileHandler(mockUserClient)
+			h := NewProfileHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleGetProfile(rr, r)
@@ -187,7 +189,7 @@ func TestHandleUpdateProfile(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewProfileHandler(mockUserClient)
+			h := NewProfileHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleUpdateProfile(rr, r)
@@ -251,7 +253,7 @@ func TestHandleDeleteProfile(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewProfileHandler(mockUserClient)
+			h := NewProfileHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleDeleteProfile(rr, r)
@@ -284,6 +286,20 @@ func TestHandleSearchByName(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name:           "Successful search by name with last user id",
+			query:          "John&lastUserId=5&amount=10",
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().SearchByName(gomock.Any(), &uspb.SearchByNameRequest{
+					ViewerId:   1,
+					Query:      "John",
+					LastUserId: 5,
+					Amount:     10,
+				}).Return(&uspb.SearchByNameResponse{}, nil)
+			},
+		},
 		{
 			name:           "Empty query",
 			query:          "",
@@ -291,6 +307,13 @@ func TestHandleSearchByName(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			mock:           func(userClient *mock_user.MockUserClient) {},
 		},
+		{
+			name:           "Invalid last user id",
+			query:          "John&lastUserId=asd",
+			mockError:      errors.ErrInvalidData,
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *mock_user.MockUserClient) {},
+		},
 		{
 			name:           "err",
 			query:          "John",
@@ -311,6 +334,7 @@ func TestHandleSearchByName(t *testing.T) {
 			r = mux.SetURLVars(r, map[string]string{
 				"query": tt.query,
 			})
+			r = r.WithContext(context.WithValue(r.Context(), requestcontext.UserIDKey, uint(1)))
 
 			// Set up the response recorder
 			rr := httptest.NewRecorder()
@@ -319,7 +343,7 @@ func TestHandleSearchByName(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewProfileHandler(mockUserClient)
+			h := NewProfileHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleSearchByName(rr, r)
@@ -329,3 +353,59 @@ func TestHandleSearchByName(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUpdateProfileAvatarUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient, uploads *mock_rest.MockUploads)
+	}{
+		{
+			name:           "Successful update with uploaded avatar",
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient, uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.AvatarKind, []string{"upload"}).Return([]string{"avatar.png"}, nil)
+				userClient.EXPECT().Update(gomock.Any(), &uspb.UpdateRequest{UserId: 1, Avatar: "avatar.png"}).Return(
+					&uspb.UpdateResponse{
+						User: &uspb.UserResponse{},
+					}, nil,
+				)
+			},
+		},
+		{
+			name:           "Upload not completed",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *mock_user.MockUserClient, uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.AvatarKind, []string{"upload"}).Return(nil, errors.ErrInvalidData)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			_ = writer.WriteField("avatarUpload", "upload")
+			_ = writer.Close()
+
+			r := httptest.NewRequest("PUT", "/", body)
+			r.Header.Set("Content-Type", writer.FormDataContentType())
+			r = r.WithContext(context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)))
+
+			rr := httptest.NewRecorder()
+
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			mockUploads := mock_rest.NewMockUploads(ctrl)
+			tt.mock(mockUserClient, mockUploads)
+
+			h := NewProfileHandler(mockUserClient, mockUploads)
+
+			h.HandleUpdateProfile(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"net/http"
 	"socio/domain"
 	"socio/errors"
@@ -8,8 +9,10 @@ import (
 	pgpb "socio/internal/grpc/public_group/proto"
 	uspb "socio/internal/grpc/user/proto"
 	"socio/internal/rest/uploaders"
+	"socio/pkg/contextlogger"
 	"socio/pkg/json"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 	"socio/usecase/posts"
 	"strconv"
 	"strings"
@@ -33,17 +36,32 @@ type CheckIfUserIsAdminRes struct {
 	IsAdmin bool `json:"isAdmin"`
 }
 
+// Notifier records and pushes notifications about the user activity, failing
+// to notify does not fail the request.
+type Notifier interface {
+	Notify(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error)
+}
+
+// Uploads resolves the completed resumable uploads to the stored files.
+type Uploads interface {
+	GetUploadedFileNames(ctx context.Context, userID uint, kind upload.Kind, uploadIDs []string) (fileNames []string, err error)
+}
+
 type PublicGroupHandler struct {
 	PublicGroupClient pgpb.PublicGroupClient
 	PostClient        postpb.PostClient
 	UserClient        uspb.UserClient
+	Notifier          Notifier
+	Uploads           Uploads
 }
 
-func NewPublicGroupHandler(publicGroupClient pgpb.PublicGroupClient, postClient postpb.PostClient, userClient uspb.UserClient) (h *PublicGroupHandler) {
+func NewPublicGroupHandler(publicGroupClient pgpb.PublicGroupClient, postClient postpb.PostClient, userClient uspb.UserClient, notifier Notifier, uploads Uploads) (h *PublicGroupHandler) {
 	return &PublicGroupHandler{
 		PublicGroupClient: publicGroupClient,
 		PostClient:        postClient,
 		UserClient:        userClient,
+		Notifier:          notifier,
+		Uploads:           uploads,
 	}
 }
 
@@ -113,6 +131,8 @@ func (h *PublicGroupHandler) HandleGetByID(w http.ResponseWriter, r *http.Reques
 //	@Param			Cookie	header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
 //	@Param			query	query	string	true	"Search query"
+//	@Param			lastGroupId	query	uint	false	"ID of the last found group, if 0 - get first groups"
+//	@Param			amount	query	uint	false	"Amount of groups to get, if 0 - get 20 groups"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=[]publicgroup.PublicGroupWithInfo}
@@ -134,9 +154,29 @@ func (h *PublicGroupHandler) HandleSearchByName(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	var lastGroupID, amount uint64
+
+	if lastGroupIDData := r.URL.Query().Get("lastGroupId"); lastGroupIDData != "" {
+		lastGroupID, err = strconv.ParseUint(lastGroupIDData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	if amountData := r.URL.Query().Get("amount"); amountData != "" {
+		amount, err = strconv.ParseUint(amountData, 0, 0)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
 	res, err := h.PublicGroupClient.SearchByName(r.Context(), &pgpb.SearchByNameRequest{
-		Query:  query,
-		UserId: uint64(authorizedUserID),
+		Query:       query,
+		UserId:      uint64(authorizedUserID),
+		LastGroupId: lastGroupID,
+		Amount:      amount,
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -160,18 +200,21 @@ func (h *PublicGroupHandler) HandleSearchByName(w http.ResponseWriter, r *http.R
 //	@Param			name	formData	string	true	"Name of the group"
 //	@Param			description	formData	string	true	"Description of the group"
 //	@Param			avatar	formData	file	false	"Avatar of the group"
+//	@Param			avatarUpload	formData	string	false	"ID of the completed resumable upload of the avatar"
 //
 //	@Produce		json
 //	@Success		201	{object}	json.JSONResponse{body=domain.PublicGroup}
 //	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/groups/ [post]
 func (h *PublicGroupHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(4 * 1024 * 1024)
+	err := upload.ParseMultipartForm(w, r, upload.GroupAvatarKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -191,13 +234,19 @@ func (h *PublicGroupHandler) HandleCreate(w http.ResponseWriter, r *http.Request
 	}
 
 	if avatarFH != nil {
-		avatarFileName, err := uploaders.UploadPublicGroupAvatar(r, h.PublicGroupClient, avatarFH)
+		avatar, err := uploaders.UploadPublicGroupAvatar(r, h.PublicGroupClient, avatarFH)
 		if err != nil {
-			json.ServeJSONError(r.Context(), w, err)
+			json.ServeGRPCStatus(r.Context(), w, err)
 			return
 		}
 
-		input.Avatar = avatarFileName
+		input.Avatar = avatar.FileName
+	} else {
+		input.Avatar, err = h.getUploadedAvatar(r, userID)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, err)
+			return
+		}
 	}
 
 	res, err := h.PublicGroupClient.Create(r.Context(), &input)
@@ -233,6 +282,7 @@ func (h *PublicGroupHandler) HandleCreate(w http.ResponseWriter, r *http.Request
 //	@Param			name	formData	string	false	"Name of the group"
 //	@Param			description	formData	string	false	"Description of the group"
 //	@Param			avatar	formData	file	false	"Avatar of the group"
+//	@Param			avatarUpload	formData	string	false	"ID of the completed resumable upload of the avatar"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=domain.PublicGroup}
@@ -240,12 +290,14 @@ func (h *PublicGroupHandler) HandleCreate(w http.ResponseWriter, r *http.Request
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/groups/{groupID} [put]
 func (h *PublicGroupHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseMultipartForm(4 * 1024 * 1024)
+	err := upload.ParseMultipartForm(w, r, upload.GroupAvatarKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -261,6 +313,12 @@ func (h *PublicGroupHandler) HandleUpdate(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	var input pgpb.UpdateRequest
 	input.Id = groupID
 	input.Name = strings.TrimSpace(r.PostFormValue("name"))
@@ -272,13 +330,19 @@ func (h *PublicGroupHandler) HandleUpdate(w http.ResponseWriter, r *http.Request
 	}
 
 	if avatarFH != nil {
-		avatarFileName, err := uploaders.UploadPublicGroupAvatar(r, h.PublicGroupClient, avatarFH)
+		avatar, err := uploaders.UploadPublicGroupAvatar(r, h.PublicGroupClient, avatarFH)
 		if err != nil {
-			json.ServeJSONError(r.Context(), w, err)
+			json.ServeGRPCStatus(r.Context(), w, err)
 			return
 		}
 
-		input.Avatar = avatarFileName
+		input.Avatar = avatar.FileName
+	} else {
+		input.Avatar, err = h.getUploadedAvatar(r, userID)
+		if err != nil {
+			json.ServeJSONError(r.Context(), w, err)
+			return
+		}
 	}
 
 	res, err := h.PublicGroupClient.Update(r.Context(), &input)
@@ -290,6 +354,28 @@ func (h *PublicGroupHandler) HandleUpdate(w http.ResponseWriter, r *http.Request
 	json.ServeJSONBody(r.Context(), w, pgpb.ToPublicGroup(res.GetPublicGroup()), http.StatusOK)
 }
 
+// getUploadedAvatar claims the resumable upload listed in the avatarUpload
+// form field, the avatar is already stored by the public group service.
+func (h *PublicGroupHandler) getUploadedAvatar(r *http.Request, userID uint) (fileName string, err error) {
+	uploadID := r.PostFormValue("avatarUpload")
+	if uploadID == "" {
+		return
+	}
+
+	if h.Uploads == nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	fileNames, err := h.Uploads.GetUploadedFileNames(r.Context(), userID, upload.GroupAvatarKind, []string{uploadID})
+	if err != nil {
+		return
+	}
+
+	fileName = fileNames[0]
+	return
+}
+
 // HandleDelete godoc
 //
 //	@Summary		delete public group
@@ -567,6 +653,8 @@ func (h *PublicGroupHandler) HandleUnsubscribe(w http.ResponseWriter, r *http.Re
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
 //	@Failure		404	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/groups/{groupID}/posts/ [post]
 func (h *PublicGroupHandler) HandleCreateGroupPost(w http.ResponseWriter, r *http.Request) {
@@ -582,9 +670,9 @@ func (h *PublicGroupHandler) HandleCreateGroupPost(w http.ResponseWriter, r *htt
 		return
 	}
 
-	err = r.ParseMultipartForm(1000 << 20)
+	err = upload.ParseMultipartForm(w, r, upload.PostAttachmentKind)
 	if err != nil {
-		json.ServeJSONError(r.Context(), w, errors.ErrInvalidBody)
+		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
@@ -600,12 +688,12 @@ func (h *PublicGroupHandler) HandleCreateGroupPost(w http.ResponseWriter, r *htt
 
 	for _, fh := range r.MultipartForm.File {
 		for _, f := range fh {
-			fileName, err := uploaders.UploadPostAttachment(r, h.PostClient, f)
+			attachment, err := uploaders.UploadPostAttachment(r, h.PostClient, f)
 			if err != nil {
-				json.ServeJSONError(r.Context(), w, err)
+				json.ServeGRPCStatus(r.Context(), w, err)
 				return
 			}
-			postInput.Attachments = append(postInput.Attachments, fileName)
+			postInput.Attachments = append(postInput.Attachments, attachment.FileName)
 		}
 	}
 
@@ -630,6 +718,113 @@ func (h *PublicGroupHandler) HandleCreateGroupPost(w http.ResponseWriter, r *htt
 		return
 	}
 
+	_, err = h.Notifier.Notify(r.Context(), domain.GroupPostNotification, post.AuthorID, uint(groupID))
+	if err != nil {
+		contextlogger.LogErr(r.Context(), err)
+	}
+
+	if domain.HasMentions(post.Entities) {
+		_, err = h.Notifier.Notify(r.Context(), domain.PostMentionNotification, post.AuthorID, post.ID)
+		if err != nil {
+			contextlogger.LogErr(r.Context(), err)
+		}
+	}
+
+	group, err := h.PublicGroupClient.GetByID(r.Context(), &pgpb.GetByIDRequest{
+		Id: groupID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	postWithGroup := &domain.PostWithAuthorAndGroup{
+		Post:  post,
+		Group: pgpb.ToPublicGroup(group.GetPublicGroup().PublicGroup),
+	}
+
+	json.ServeJSONBody(r.Context(), w, postWithGroup, http.StatusCreated)
+}
+
+// HandleRepostToGroup godoc
+//
+//	@Summary		repost post to public group
+//	@Description	share the post in the public group, reposting a repost shares its original
+//	@Tags			groups
+//	@license.name	Apache 2.0
+//	@ID				groups/posts/repost
+//	@Accept			json
+//
+//	@Param			Cookie	header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			groupID	path	string	true	"Group ID"
+//	@Param			postId	body	uint	true	"ID of the reposted post"
+//	@Param			content	body	string	false	"Commentary of the repost"
+//
+//	@Produce		json
+//	@Success		201	{object}	json.JSONResponse{body=domain.PostWithAuthorAndGroup}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/groups/{groupID}/posts/repost [post]
+func (h *PublicGroupHandler) HandleRepostToGroup(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	groupIDData := mux.Vars(r)["groupID"]
+	if len(groupIDData) == 0 {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	groupID, err := strconv.ParseUint(groupIDData, 10, 0)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
+		return
+	}
+
+	var repostInput posts.RepostInput
+
+	err = easyjson.UnmarshalFromReader(r.Body, &repostInput)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	repostInput.AuthorID, err = requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	postData, err := h.PostClient.RepostPost(r.Context(), &postpb.RepostPostRequest{
+		PostId:   uint64(repostInput.PostID),
+		AuthorId: uint64(repostInput.AuthorID),
+		Content:  strings.TrimSpace(repostInput.Content),
+		GroupId:  groupID,
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	post := postpb.ToPost(postData.Post)
+
+	_, err = h.Notifier.Notify(r.Context(), domain.GroupPostNotification, post.AuthorID, uint(groupID))
+	if err != nil {
+		contextlogger.LogErr(r.Context(), err)
+	}
+
+	if domain.HasMentions(post.Entities) {
+		_, err = h.Notifier.Notify(r.Context(), domain.PostMentionNotification, post.AuthorID, post.ID)
+		if err != nil {
+			contextlogger.LogErr(r.Context(), err)
+		}
+	}
+
+	post.GroupID = uint(groupID)
+
 	group, err := h.PublicGroupClient.GetByID(r.Context(), &pgpb.GetByIDRequest{
 		Id: groupID,
 	})
@@ -670,6 +865,12 @@ func (h *PublicGroupHandler) HandleCreateGroupPost(w http.ResponseWriter, r *htt
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/groups/{groupID}/posts/ [get]
 func (h *PublicGroupHandler) HandleGetGroupPosts(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
 	groupIDData := mux.Vars(r)["groupID"]
 	if len(groupIDData) == 0 {
 		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
@@ -706,6 +907,7 @@ func (h *PublicGroupHandler) HandleGetGroupPosts(w http.ResponseWriter, r *http.
 		GroupId:     groupID,
 		LastPostId:  lastPostID,
 		PostsAmount: postsAmount,
+		ViewerId:    uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -849,6 +1051,12 @@ func (h *PublicGroupHandler) HandleDeletePublicGroupAdmin(w http.ResponseWriter,
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/groups/{groupID}/admins/ [get]
 func (h *PublicGroupHandler) HandleGetAdminsByPublicGroupID(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrUnauthorized)
+		return
+	}
+
 	groupIDData := mux.Vars(r)["groupID"]
 	if len(groupIDData) == 0 {
 		json.ServeJSONError(r.Context(), w, errors.ErrInvalidSlug)
@@ -863,6 +1071,7 @@ func (h *PublicGroupHandler) HandleGetAdminsByPublicGroupID(w http.ResponseWrite
 
 	res, err := h.UserClient.GetAdminsByPublicGroupID(r.Context(), &uspb.GetAdminsByPublicGroupIDRequest{
 		PublicGroupId: groupID,
+		ViewerId:      uint64(userID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"socio/domain"
 	"socio/errors"
 	postpb "socio/internal/grpc/post/proto"
 	pgpb "socio/internal/grpc/public_group/proto"
@@ -16,7 +17,9 @@ import (
 	mock_post "socio/mocks/grpc/post_grpc"
 	mock_public_group "socio/mocks/grpc/public_group_grpc"
 	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/public_group"
 	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -104,7 +107,7 @@ func TestHandleGetByID(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleGetByID(rr, r)
@@ -135,6 +138,28 @@ func TestHandleSearchByName(t *testing.T) {
 				publicGroupClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).Return(&pgpb.SearchByNameResponse{}, nil)
 			},
 		},
+		{
+			name:           "Successful search by name with last group id",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:          "test&lastGroupId=5&amount=10",
+			expectedStatus: http.StatusOK,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient) {
+				publicGroupClient.EXPECT().SearchByName(gomock.Any(), &pgpb.SearchByNameRequest{
+					Query:       "test",
+					UserId:      1,
+					LastGroupId: 5,
+					Amount:      10,
+				}).Return(&pgpb.SearchByNameResponse{}, nil)
+			},
+		},
+		{
+			name:           "invalid amount",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:          "test&amount=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient) {
+			},
+		},
 		{
 			name:           "Successful search by name",
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
@@ -177,7 +202,7 @@ func TestHandleSearchByName(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleSearchByName(rr, r)
@@ -271,7 +296,7 @@ func TestHandleCreate(t *testing.T) {
 			tt.mock(mockPublicGroupClient, mockUserClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, mockUserClient)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, mockUserClient, nil, nil)
 
 			// Call the handler
 			h.HandleCreate(rr, r)
@@ -335,6 +360,15 @@ func TestHandleUpdate(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:           "Unauthorized update public group",
+			ctx:            context.Background(),
+			groupID:        "1",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient) {
+
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -359,7 +393,7 @@ func TestHandleUpdate(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleUpdate(rr, r)
@@ -370,6 +404,64 @@ func TestHandleUpdate(t *testing.T) {
 	}
 }
 
+func TestHandleUpdateAvatarUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mock           func(publicGroupClient *mock_public_group.MockPublicGroupClient, uploads *mock_rest.MockUploads)
+	}{
+		{
+			name:           "Successful update with uploaded avatar",
+			expectedStatus: http.StatusOK,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.GroupAvatarKind, []string{"upload"}).Return([]string{"avatar.png"}, nil)
+				publicGroupClient.EXPECT().Update(gomock.Any(), &pgpb.UpdateRequest{Id: 1, Avatar: "avatar.png"}).Return(&pgpb.UpdateResponse{
+					PublicGroup: &pgpb.PublicGroupResponse{
+						Id: 1,
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Upload of other kind",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, uploads *mock_rest.MockUploads) {
+				uploads.EXPECT().GetUploadedFileNames(gomock.Any(), uint(1), upload.GroupAvatarKind, []string{"upload"}).Return(nil, errors.ErrInvalidData)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			_ = writer.WriteField("avatarUpload", "upload")
+			_ = writer.Close()
+			r := httptest.NewRequest("PUT", "/groups/1", body)
+			r.Header.Set("Content-Type", writer.FormDataContentType())
+			r = r.WithContext(context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)))
+			r = mux.SetURLVars(r, map[string]string{
+				"groupID": "1",
+			})
+
+			rr := httptest.NewRecorder()
+
+			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+			mockUploads := mock_rest.NewMockUploads(ctrl)
+			tt.mock(mockPublicGroupClient, mockUploads)
+
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, mockUploads)
+
+			h.HandleUpdate(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
 func TestHandleDelete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -439,7 +531,7 @@ func TestHandleDelete(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleDelete(rr, r)
@@ -534,7 +626,7 @@ func TestHandleGetSubscriptionByPublicGroupIDAndSubscriberID(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleGetSubscriptionByPublicGroupIDAndSubscriberID(rr, r)
@@ -637,7 +729,7 @@ func TestHandleGetBySubscriberID(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleGetBySubscriberID(rr, r)
@@ -732,7 +824,7 @@ func TestHandleSubscribe(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleSubscribe(rr, r)
@@ -818,7 +910,7 @@ func TestHandleUnsubscribe(t *testing.T) {
 			tt.mock(mockPublicGroupClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, nil, nil, nil, nil)
 
 			// Call the handler
 			h.HandleUnsubscribe(rr, r)
@@ -838,14 +930,14 @@ func TestHandleCreateGroupPost(t *testing.T) {
 		ctx            context.Context
 		groupID        string
 		expectedStatus int
-		mock           func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient)
+		mock           func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier)
 	}{
 		{
 			name:           "Successful create group post",
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "1",
 			expectedStatus: http.StatusCreated,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postClient.EXPECT().CreatePost(gomock.Any(), gomock.Any()).Return(&postpb.CreatePostResponse{
 					Post: &postpb.PostResponse{
 						Id:       1,
@@ -853,6 +945,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 					},
 				}, nil)
 				postClient.EXPECT().CreateGroupPost(gomock.Any(), gomock.Any()).Return(&postpb.CreateGroupPostResponse{}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.GroupPostNotification, uint(1), uint(1)).Return(nil, nil)
 				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&pgpb.GetByIDResponse{
 					PublicGroup: &pgpb.PublicGroupWithInfoResponse{
 						PublicGroup: &pgpb.PublicGroupResponse{
@@ -867,7 +960,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "",
 			expectedStatus: http.StatusBadRequest,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -876,7 +969,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "asd",
 			expectedStatus: http.StatusBadRequest,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -885,7 +978,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.Background(),
 			groupID:        "1",
 			expectedStatus: http.StatusBadRequest,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -894,7 +987,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "1",
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postClient.EXPECT().CreatePost(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -905,7 +998,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "1",
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postClient.EXPECT().CreatePost(gomock.Any(), gomock.Any()).Return(&postpb.CreatePostResponse{
 					Post: &postpb.PostResponse{
 						Id:       1,
@@ -923,7 +1016,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
 			groupID:        "1",
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient) {
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
 				postClient.EXPECT().CreatePost(gomock.Any(), gomock.Any()).Return(&postpb.CreatePostResponse{
 					Post: &postpb.PostResponse{
 						Id:       1,
@@ -931,6 +1024,7 @@ func TestHandleCreateGroupPost(t *testing.T) {
 					},
 				}, nil)
 				postClient.EXPECT().CreateGroupPost(gomock.Any(), gomock.Any()).Return(&postpb.CreateGroupPostResponse{}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.GroupPostNotification, uint(1), uint(1)).Return(nil, nil)
 				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -962,10 +1056,11 @@ func TestHandleCreateGroupPost(t *testing.T) {
 
 			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
 			mockPostClient := mock_post.NewMockPostClient(ctrl)
-			tt.mock(mockPublicGroupClient, mockPostClient)
+			mockNotifier := mock_rest.NewMockNotifier(ctrl)
+			tt.mock(mockPublicGroupClient, mockPostClient, mockNotifier)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(mockPublicGroupClient, mockPostClient, nil)
+			h := NewPublicGroupHandler(mockPublicGroupClient, mockPostClient, nil, mockNotifier, nil)
 
 			// Call the handler
 			h.HandleCreateGroupPost(rr, r)
@@ -976,6 +1071,126 @@ func TestHandleCreateGroupPost(t *testing.T) {
 	}
 }
 
+func TestHandleRepostToGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		groupID        string
+		body           string
+		expectedStatus int
+		mock           func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier)
+	}{
+		{
+			name:           "Successful repost to group",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			groupID:        "1",
+			body:           `{"postId": 3}`,
+			expectedStatus: http.StatusCreated,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+				postClient.EXPECT().RepostPost(gomock.Any(), &postpb.RepostPostRequest{
+					PostId:   3,
+					AuthorId: 1,
+					GroupId:  1,
+				}).Return(&postpb.RepostPostResponse{
+					Post: &postpb.PostResponse{
+						Id:         4,
+						AuthorId:   1,
+						IsRepost:   true,
+						RepostOfId: 3,
+						GroupId:    1,
+					},
+				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.GroupPostNotification, uint(1), uint(1)).Return(nil, nil)
+				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&pgpb.GetByIDResponse{
+					PublicGroup: &pgpb.PublicGroupWithInfoResponse{
+						PublicGroup: &pgpb.PublicGroupResponse{
+							Id: 1,
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "invalid group id",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			groupID:        "asd",
+			body:           `{"postId": 3}`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+			},
+		},
+		{
+			name:           "invalid json",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			groupID:        "1",
+			body:           `{"postId"`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+			},
+		},
+		{
+			name:           "no user id in context",
+			ctx:            context.Background(),
+			groupID:        "1",
+			body:           `{"postId": 3}`,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+			},
+		},
+		{
+			name:           "post not found",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			groupID:        "1",
+			body:           `{"postId": 3}`,
+			expectedStatus: http.StatusNotFound,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+				postClient.EXPECT().RepostPost(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "err get group",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			groupID:        "1",
+			body:           `{"postId": 3}`,
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(publicGroupClient *mock_public_group.MockPublicGroupClient, postClient *mock_post.MockPostClient, notifier *mock_rest.MockNotifier) {
+				postClient.EXPECT().RepostPost(gomock.Any(), gomock.Any()).Return(&postpb.RepostPostResponse{
+					Post: &postpb.PostResponse{
+						Id:       4,
+						AuthorId: 1,
+					},
+				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.GroupPostNotification, uint(1), uint(1)).Return(nil, nil)
+				publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/groups/"+tt.groupID+"/posts/repost", bytes.NewBufferString(tt.body))
+			r = r.WithContext(tt.ctx)
+			r = mux.SetURLVars(r, map[string]string{"groupID": tt.groupID})
+
+			rr := httptest.NewRecorder()
+
+			mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+			mockPostClient := mock_post.NewMockPostClient(ctrl)
+			mockNotifier := mock_rest.NewMockNotifier(ctrl)
+			tt.mock(mockPublicGroupClient, mockPostClient, mockNotifier)
+
+			h := NewPublicGroupHandler(mockPublicGroupClient, mockPostClient, nil, mockNotifier, nil)
+
+			h.HandleRepostToGroup(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
 func TestHandleGetGroupPosts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1084,7 +1299,7 @@ func TestHandleGetGroupPosts(t *testing.T) {
 			tt.mock(mockPostClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(nil, mockPostClient, nil)
+			h := NewPublicGroupHandler(nil, mockPostClient, nil, nil, nil)
 
 			// Call the handler
 			h.HandleGetGroupPosts(rr, r)
@@ -1168,7 +1383,7 @@ func TestHandleCreatePublicGroupAdmin(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(nil, nil, mockUserClient)
+			h := NewPublicGroupHandler(nil, nil, mockUserClient, nil, nil)
 
 			// Call the handler
 			h.HandleCreatePublicGroupAdmin(rr, r)
@@ -1252,7 +1467,7 @@ func TestHandleDeletePublicGroupAdmin(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(nil, nil, mockUserClient)
+			h := NewPublicGroupHandler(nil, nil, mockUserClient, nil, nil)
 
 			// Call the handler
 			h.HandleDeletePublicGroupAdmin(rr, r)
@@ -1331,7 +1546,7 @@ func TestHandleGetAdminsByPublicGroupID(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(nil, nil, mockUserClient)
+			h := NewPublicGroupHandler(nil, nil, mockUserClient, nil, nil)
 
 			// Call the handler
 			h.HandleGetAdminsByPublicGroupID(rr, r)
@@ -1417,7 +1632,7 @@ func TestHandleCheckIfUserIsAdmin(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewPublicGroupHandler(nil, nil, mockUserClient)
+			h := NewPublicGroupHandler(nil, nil, mockUserClient, nil, nil)
 
 			// Call the handler
 			h.HandleCheckIfUserIsAdmin(rr, r)
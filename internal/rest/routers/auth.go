@@ -4,17 +4,38 @@ import (
 	authpb "socio/internal/grpc/auth/proto"
 	uspb "socio/internal/grpc/user/proto"
 	rest "socio/internal/rest/auth"
+	"socio/internal/rest/middleware"
 	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+	"socio/usecase/ratelimit"
 
 	"github.com/gorilla/mux"
 )
 
-func MountAuthRouter(rootRouter *mux.Router, authClient authpb.AuthClient, userClient uspb.UserClient) {
+func MountAuthRouter(rootRouter *mux.Router, authClient authpb.AuthClient, userClient uspb.UserClient, rateLimitService *ratelimit.Service) {
 	r := rootRouter.PathPrefix("/auth").Subrouter()
 
 	h := rest.NewAuthHandler(authClient, userClient, &customtime.RealTimeProvider{})
 
-	r.HandleFunc("/login", h.HandleLogin).Methods("POST", "OPTIONS")
-	r.HandleFunc("/signup", h.HandleRegistration).Methods("POST", "OPTIONS")
+	r.HandleFunc("/login", h.HandleLogin).Methods("POST", "OPTIONS").Name("auth/login")
+	r.HandleFunc("/signup", h.HandleRegistration).Methods("POST", "OPTIONS").Name("auth/signup")
 	r.HandleFunc("/logout", h.HandleLogout).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/verify-email", h.HandleVerifyEmail).Methods("POST", "OPTIONS").Name("auth/verify_email")
+	r.HandleFunc("/password-reset", h.HandleRequestPasswordReset).Methods("POST", "OPTIONS").Name("auth/password_reset")
+	r.HandleFunc("/password-reset/confirm", h.HandleResetPassword).Methods("POST", "OPTIONS").Name("auth/password_reset_confirm")
+	r.Use(middleware.CreateRateLimitMiddleware(rateLimitService))
+
+	sessionsRouter := r.PathPrefix("/sessions").Subrouter()
+
+	sessionsRouter.HandleFunc("/", h.HandleListSessions).Methods("GET", "OPTIONS")
+	sessionsRouter.HandleFunc("/", h.HandleRevokeAllSessions).Methods("DELETE", "OPTIONS")
+	sessionsRouter.HandleFunc("/{sessionID}", h.HandleRevokeSession).Methods("DELETE", "OPTIONS")
+	sessionsRouter.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	sessionsRouter.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+
+	emailVerificationRouter := r.PathPrefix("/verify-email").Subrouter()
+
+	emailVerificationRouter.HandleFunc("/resend", h.HandleResendEmailVerification).Methods("POST", "OPTIONS").Name("auth/verify_email_resend")
+	emailVerificationRouter.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	emailVerificationRouter.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
 }
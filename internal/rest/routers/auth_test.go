@@ -5,7 +5,10 @@ import (
 	"net/http/httptest"
 	mock_auth "socio/mocks/grpc/auth_grpc"
 	mock_user "socio/mocks/grpc/user_grpc"
+	mock_ratelimit "socio/mocks/usecase/ratelimit"
+	"socio/usecase/ratelimit"
 	"testing"
+	"time"
 
 	"socio/internal/rest/routers"
 
@@ -20,9 +23,11 @@ func TestMountAuthRouter(t *testing.T) {
 
 	userClient := mock_user.NewMockUserClient(ctrl)
 	authClient := mock_auth.NewMockAuthClient(ctrl)
+	rateLimitStorage := mock_ratelimit.NewMockRateLimitStorage(ctrl)
+	rateLimitStorage.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, time.Duration(0), nil).AnyTimes()
 
 	router := mux.NewRouter()
-	routers.MountAuthRouter(router, authClient, userClient)
+	routers.MountAuthRouter(router, authClient, userClient, ratelimit.NewRateLimitService(rateLimitStorage))
 
 	// Test if the routes are correctly mounted
 	testCases := []struct {
@@ -35,6 +40,13 @@ func TestMountAuthRouter(t *testing.T) {
 		{"OPTIONS", "/auth/signup"},
 		{"DELETE", "/auth/logout"},
 		{"OPTIONS", "/auth/logout"},
+		{"GET", "/auth/sessions/"},
+		{"DELETE", "/auth/sessions/"},
+		{"DELETE", "/auth/sessions/some_id"},
+		{"POST", "/auth/verify-email"},
+		{"POST", "/auth/verify-email/resend"},
+		{"POST", "/auth/password-reset"},
+		{"POST", "/auth/password-reset/confirm"},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,8 @@ package routers
 
 import (
 	authpb "socio/internal/grpc/auth/proto"
+	grpcuser "socio/internal/grpc/user"
+	uspb "socio/internal/grpc/user/proto"
 	rest "socio/internal/rest/chat"
 	"socio/internal/rest/middleware"
 	customtime "socio/pkg/time"
@@ -11,8 +13,11 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func MountChatRouter(rootRouter *mux.Router, pubSubRepo chat.PubSubRepository, unsentMessageAttachmentsStorage chat.UnsentMessageAttachmentsStorage, messagesRepo chat.PersonalMessagesRepository, authManager authpb.AuthClient, stickerStorage chat.StickerStorage, messageAttachmentStorage chat.MessageAttachmentStorage) {
-	h := rest.NewChatServer(chat.NewChatService(pubSubRepo, unsentMessageAttachmentsStorage, messagesRepo, stickerStorage, messageAttachmentStorage))
+func MountChatRouter(rootRouter *mux.Router, pubSubRepo chat.PubSubRepository, unsentMessageAttachmentsStorage chat.UnsentMessageAttachmentsStorage, messagesRepo chat.PersonalMessagesRepository, authManager authpb.AuthClient, stickerStorage chat.StickerStorage, messageAttachmentStorage chat.MessageAttachmentStorage, presenceStorage chat.PresenceStorage, rateLimiter chat.RateLimiter, userClient uspb.UserClient) {
+	chatService := chat.NewChatService(pubSubRepo, unsentMessageAttachmentsStorage, messagesRepo, stickerStorage, messageAttachmentStorage, presenceStorage)
+	chatService.RateLimiter = rateLimiter
+	chatService.EmailVerifier = grpcuser.NewEmailVerifier(userClient)
+	h := rest.NewChatServer(chatService, chatService.AttachmentURLs)
 
 	csrfFreeRouter := rootRouter.PathPrefix("/chat/ws").Subrouter()
 	csrfFreeRouter.HandleFunc("/", h.ServeWS).Methods("GET", "OPTIONS")
@@ -21,13 +26,19 @@ func MountChatRouter(rootRouter *mux.Router, pubSubRepo chat.PubSubRepository, u
 	csrfRequiredRouter := rootRouter.PathPrefix("/chat").Subrouter()
 	csrfRequiredRouter.Use(middleware.CreateCheckIsAuthorizedMiddleware(authManager))
 	csrfRequiredRouter.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	csrfRequiredRouter.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
 
 	csrfRequiredRouter.HandleFunc("/dialogs", h.HandleGetDialogs).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/dialogs/{receiverID:[0-9]+}/unsent-attachments/", h.HandleGetUnsentMessageAttachments).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/dialogs/{receiverID:[0-9]+}/unsent-attachments/", h.HandleCreateUnsentMessageAttachments).Methods("POST", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/dialogs/{receiverID:[0-9]+}/unsent-attachments/", h.HandleDeleteUnsentMessageAttachments).Methods("DELETE", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/dialogs/{receiverID:[0-9]+}/unsent-attachments/{fileName}", h.HandleDeleteUnsentMessageAttachment).Methods("DELETE", "OPTIONS")
+	csrfRequiredRouter.HandleFunc("/conversations", h.HandleGetConversations).Methods("GET", "OPTIONS")
+	csrfRequiredRouter.HandleFunc("/conversations/{conversationID:[0-9]+}", h.HandleGetConversation).Methods("GET", "OPTIONS")
+	csrfRequiredRouter.HandleFunc("/conversations/{conversationID:[0-9]+}/messages", h.HandleGetMessagesByConversation).Methods("GET", "OPTIONS")
+	csrfRequiredRouter.HandleFunc("/presence", h.HandleGetPresences).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/messages", h.HandleGetMessagesByDialog).Methods("GET", "OPTIONS")
+	csrfRequiredRouter.HandleFunc("/messages/{messageID:[0-9]+}/attachments/{fileName}", h.HandleGetAttachmentURL).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/stickers/", h.HandleGetAllStickers).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/stickers/{authorID:[0-9]+}", h.HandleGetStickersByAuthorID).Methods("GET", "OPTIONS")
 	csrfRequiredRouter.HandleFunc("/stickers/", h.HandleCreateSticker).Methods("POST", "OPTIONS")
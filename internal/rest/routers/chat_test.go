@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"socio/internal/rest/routers"
 	mock_auth "socio/mocks/grpc/auth_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
 	mock_chat "socio/mocks/usecase/chat"
 	"testing"
 
@@ -21,9 +22,11 @@ func TestMountChatRouter(t *testing.T) {
 	messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
 	authClient := mock_auth.NewMockAuthClient(ctrl)
 	minioRepo := mock_chat.NewMockStickerStorage(ctrl)
+	presenceStorage := mock_chat.NewMockPresenceStorage(ctrl)
+	userClient := mock_user.NewMockUserClient(ctrl)
 
 	router := mux.NewRouter()
-	routers.MountChatRouter(router, pubSubRepo, nil, messagesRepo, authClient, minioRepo, nil)
+	routers.MountChatRouter(router, pubSubRepo, nil, messagesRepo, authClient, minioRepo, nil, presenceStorage, nil, userClient)
 
 	// Test if the routes are correctly mounted
 	testCases := []struct {
@@ -36,6 +39,16 @@ func TestMountChatRouter(t *testing.T) {
 		{"OPTIONS", "/chat/dialogs"},
 		{"GET", "/chat/messages"},
 		{"OPTIONS", "/chat/messages"},
+		{"GET", "/chat/messages/1/attachments/pic.png"},
+		{"OPTIONS", "/chat/messages/1/attachments/pic.png"},
+		{"GET", "/chat/conversations"},
+		{"OPTIONS", "/chat/conversations"},
+		{"GET", "/chat/conversations/1"},
+		{"OPTIONS", "/chat/conversations/1"},
+		{"GET", "/chat/conversations/1/messages"},
+		{"OPTIONS", "/chat/conversations/1/messages"},
+		{"GET", "/chat/presence"},
+		{"OPTIONS", "/chat/presence"},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,76 @@
+package routers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authpb "socio/internal/grpc/auth/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/internal/rest/middleware"
+	"socio/internal/rest/routers"
+	mock_auth "socio/mocks/grpc/auth_grpc"
+	mock_posts "socio/mocks/grpc/post_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	mock_uploaders "socio/mocks/rest/uploaders"
+	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutersRequireVerifiedEmail(t *testing.T) {
+	t.Setenv("CSRF_SECRET", "secret")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadsService := mock_uploaders.NewMockUploadsService(ctrl)
+	postsClient := mock_posts.NewMockPostClient(ctrl)
+	userClient := mock_user.NewMockUserClient(ctrl)
+	publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+	authClient := mock_auth.NewMockAuthClient(ctrl)
+
+	router := mux.NewRouter()
+	routers.MountUploadsRouter(router, uploadsService, postsClient, userClient, publicGroupClient, authClient)
+	routers.MountReportsRouter(router, nil, userClient, authClient)
+	routers.MountProfileRouter(router, userClient, nil, authClient)
+
+	token, err := csrf.NewCSRFService(customtime.RealTimeProvider{}).Create("session", 1, customtime.RealTimeProvider{}.Now().Add(time.Hour).Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		method string
+		path   string
+	}{
+		{"POST", "/uploads/"},
+		{"PATCH", "/uploads/upload"},
+		{"POST", "/reports/"},
+		{"PUT", "/profile/"},
+		{"PUT", "/profile/privacy"},
+	}
+
+	for _, tc := range testCases {
+		authClient.EXPECT().ValidateSession(gomock.Any(), &authpb.ValidateSessionRequest{SessionId: "session"}).Return(&authpb.ValidateSessionResponse{UserId: 1}, nil)
+		userClient.EXPECT().CheckIfEmailIsVerified(gomock.Any(), &uspb.CheckIfEmailIsVerifiedRequest{UserId: 1}).Return(&uspb.CheckIfEmailIsVerifiedResponse{IsVerified: false}, nil)
+
+		req, err := http.NewRequest(tc.method, tc.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+		req.Header.Set(middleware.CSRFHeader, token)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code, "Route %s %s is open to the unverified users", tc.method, tc.path)
+	}
+}
@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"socio/internal/rest/middleware"
+	rest "socio/internal/rest/moderation"
+	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+
+	authpb "socio/internal/grpc/auth/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+
+	"github.com/gorilla/mux"
+)
+
+func MountReportsRouter(rootRouter *mux.Router, moderationService rest.ModerationService, userClient uspb.UserClient, authClient authpb.AuthClient) {
+	r := rootRouter.PathPrefix("/reports").Subrouter()
+	h := rest.NewModerationHandler(moderationService, nil, authClient, nil)
+
+	r.HandleFunc("/", h.HandleReport).Methods("POST", "OPTIONS")
+	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	r.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
+}
+
+func MountModerationRouter(rootRouter *mux.Router, moderationService rest.ModerationService, userClient uspb.UserClient, authClient authpb.AuthClient, publicGroupClient pgpb.PublicGroupClient) {
+	r := rootRouter.PathPrefix("/moderation").Subrouter()
+	h := rest.NewModerationHandler(moderationService, userClient, authClient, publicGroupClient)
+
+	r.HandleFunc("/reports", h.HandleGetReportCases).Methods("GET", "OPTIONS")
+	r.HandleFunc("/reports/{caseID}/dismiss", h.HandleDismissReportCase).Methods("POST", "OPTIONS")
+	r.HandleFunc("/content/hide", h.HandleHideContent).Methods("POST", "OPTIONS")
+	r.HandleFunc("/users/{userID}/ban", h.HandleBanUser).Methods("POST", "OPTIONS")
+	r.HandleFunc("/groups/{groupID}", h.HandleDeleteGroup).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/log", h.HandleGetModerationLog).Methods("GET", "OPTIONS")
+	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	r.Use(middleware.CreateCheckModeratorMiddleware(userClient))
+}
@@ -0,0 +1,63 @@
+package routers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	routers "socio/internal/rest/routers"
+	mock_auth "socio/mocks/grpc/auth_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountModerationRouter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userClient := mock_user.NewMockUserClient(ctrl)
+	authClient := mock_auth.NewMockAuthClient(ctrl)
+	publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+
+	router := mux.NewRouter()
+	routers.MountReportsRouter(router, nil, userClient, authClient)
+	routers.MountModerationRouter(router, nil, userClient, authClient, publicGroupClient)
+
+	// Test if the routes are correctly mounted
+	testCases := []struct {
+		method string
+		path   string
+	}{
+		{"POST", "/reports/"},
+		{"OPTIONS", "/reports/"},
+		{"GET", "/moderation/reports"},
+		{"OPTIONS", "/moderation/reports"},
+		{"POST", "/moderation/reports/1/dismiss"},
+		{"OPTIONS", "/moderation/reports/1/dismiss"},
+		{"POST", "/moderation/content/hide"},
+		{"OPTIONS", "/moderation/content/hide"},
+		{"POST", "/moderation/users/1/ban"},
+		{"OPTIONS", "/moderation/users/1/ban"},
+		{"DELETE", "/moderation/groups/1"},
+		{"OPTIONS", "/moderation/groups/1"},
+		{"GET", "/moderation/log"},
+		{"OPTIONS", "/moderation/log"},
+	}
+
+	for _, tc := range testCases {
+		req, err := http.NewRequest(tc.method, tc.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		// We're just checking if the routes are mounted, so a 404 status code means the route is not mounted
+		assert.NotEqual(t, http.StatusNotFound, rr.Code, "Route %s not mounted", tc.path)
+	}
+}
@@ -0,0 +1,24 @@
+package routers
+
+import (
+	"socio/internal/rest/middleware"
+	rest "socio/internal/rest/notifications"
+	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+
+	authpb "socio/internal/grpc/auth/proto"
+	uspb "socio/internal/grpc/user/proto"
+
+	"github.com/gorilla/mux"
+)
+
+func MountNotificationsRouter(rootRouter *mux.Router, notificationsService rest.NotificationsService, userClient uspb.UserClient, authClient authpb.AuthClient) {
+	r := rootRouter.PathPrefix("/notifications").Subrouter()
+	h := rest.NewNotificationsHandler(notificationsService, userClient)
+
+	r.HandleFunc("/", h.HandleGetNotifications).Methods("GET", "OPTIONS")
+	r.HandleFunc("/unread-count", h.HandleGetUnreadCount).Methods("GET", "OPTIONS")
+	r.HandleFunc("/read", h.HandleMarkRead).Methods("POST", "OPTIONS")
+	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+}
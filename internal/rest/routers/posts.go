@@ -9,14 +9,15 @@ import (
 	rest "socio/internal/rest/posts"
 	customtime "socio/pkg/time"
 	"socio/usecase/csrf"
+	"socio/usecase/ratelimit"
 
 	"github.com/gorilla/mux"
 )
 
-func MountPostsRouter(rootRouter *mux.Router, postsClient post.PostClient, userClient user.UserClient, publicGroupClient pgpb.PublicGroupClient, authManager authpb.AuthClient) {
+func MountPostsRouter(rootRouter *mux.Router, postsClient post.PostClient, userClient user.UserClient, publicGroupClient pgpb.PublicGroupClient, notifier rest.Notifier, uploads rest.Uploads, authManager authpb.AuthClient, rateLimitService *ratelimit.Service) {
 	r := rootRouter.PathPrefix("/posts").Subrouter()
 
-	h := rest.NewPostsHandler(postsClient, userClient, publicGroupClient)
+	h := rest.NewPostsHandler(postsClient, userClient, publicGroupClient, notifier, uploads)
 
 	r.HandleFunc("/{postID:[0-9]+}", h.HandleGetPostByID).Methods("GET", "OPTIONS")
 	r.HandleFunc("/", h.HandleGetUserPosts).Methods("GET", "OPTIONS")
@@ -24,19 +25,27 @@ func MountPostsRouter(rootRouter *mux.Router, postsClient post.PostClient, userC
 	r.HandleFunc("/groups", h.HandleGetGroupPostsBySubscriptions).Methods("GET", "OPTIONS")
 	r.HandleFunc("/all", h.HandleGetPostsByGroupSubIDsAndUserSubIDs).Methods("GET", "OPTIONS")
 	r.HandleFunc("/new", h.HandleGetNewPosts).Methods("GET", "OPTIONS")
-	r.HandleFunc("/", h.HandleCreatePost).Methods("POST", "OPTIONS")
+	r.HandleFunc("/feed", h.HandleGetFeed).Methods("GET", "OPTIONS")
+	r.HandleFunc("/search", h.HandleSearch).Methods("GET", "OPTIONS")
+	r.HandleFunc("/hashtags/trending", h.HandleGetTrendingHashtags).Methods("GET", "OPTIONS")
+	r.HandleFunc("/hashtags/{hashtag}/posts", h.HandleGetPostsByHashtag).Methods("GET", "OPTIONS")
+	r.HandleFunc("/", h.HandleCreatePost).Methods("POST", "OPTIONS").Name("posts/create")
+	r.HandleFunc("/repost", h.HandleRepostPost).Methods("POST", "OPTIONS").Name("posts/repost")
 	r.HandleFunc("/", h.HandleUpdatePost).Methods("PUT", "OPTIONS")
 	r.HandleFunc("/", h.HandleDeletePost).Methods("DELETE", "OPTIONS")
 	r.HandleFunc("/liked", h.HandleGetLikedPosts).Methods("GET", "OPTIONS")
-	r.HandleFunc("/like", h.HandleLikePost).Methods("POST", "OPTIONS")
-	r.HandleFunc("/unlike", h.HandleUnlikePost).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/like", h.HandleLikePost).Methods("POST", "OPTIONS").Name("posts/like")
+	r.HandleFunc("/unlike", h.HandleUnlikePost).Methods("DELETE", "OPTIONS").Name("posts/unlike")
 
 	r.HandleFunc("/{postID:[0-9]+}/comments", h.HandleGetCommentsByPostID).Methods("GET", "OPTIONS")
-	r.HandleFunc("/comments", h.HandleCreateComment).Methods("POST", "OPTIONS")
+	r.HandleFunc("/comments/{commentID:[0-9]+}/replies", h.HandleGetCommentReplies).Methods("GET", "OPTIONS")
+	r.HandleFunc("/comments", h.HandleCreateComment).Methods("POST", "OPTIONS").Name("posts/create_comment")
 	r.HandleFunc("/comments", h.HandleUpdateComment).Methods("PUT", "OPTIONS")
 	r.HandleFunc("/comments", h.HandleDeleteComment).Methods("DELETE", "OPTIONS")
-	r.HandleFunc("/comments/like", h.HandleLikeComment).Methods("POST", "OPTIONS")
-	r.HandleFunc("/comments/unlike", h.HandleUnlikeComment).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/comments/like", h.HandleLikeComment).Methods("POST", "OPTIONS").Name("posts/like_comment")
+	r.HandleFunc("/comments/unlike", h.HandleUnlikeComment).Methods("DELETE", "OPTIONS").Name("posts/unlike_comment")
 	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authManager))
 	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	r.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
+	r.Use(middleware.CreateRateLimitMiddleware(rateLimitService))
 }
@@ -26,7 +26,7 @@ func TestMountPostsRouter(t *testing.T) {
 	publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
 
 	router := mux.NewRouter()
-	routers.MountPostsRouter(router, postsClient, userClient, publicGroupClient, authClient)
+	routers.MountPostsRouter(router, postsClient, userClient, publicGroupClient, nil, nil, authClient, nil)
 
 	// Test if the routes are correctly mounted
 	testCases := []struct {
@@ -37,6 +37,12 @@ func TestMountPostsRouter(t *testing.T) {
 		{"OPTIONS", "/posts/"},
 		{"GET", "/posts/friends"},
 		{"OPTIONS", "/posts/friends"},
+		{"GET", "/posts/search"},
+		{"OPTIONS", "/posts/search"},
+		{"GET", "/posts/hashtags/trending"},
+		{"OPTIONS", "/posts/hashtags/trending"},
+		{"GET", "/posts/hashtags/golang/posts"},
+		{"OPTIONS", "/posts/hashtags/golang/posts"},
 		{"POST", "/posts/"},
 		{"OPTIONS", "/posts/"},
 		{"PUT", "/posts/"},
@@ -11,16 +11,19 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func MountProfileRouter(rootRouter *mux.Router, userClient uspb.UserClient, authClient authpb.AuthClient) {
+func MountProfileRouter(rootRouter *mux.Router, userClient uspb.UserClient, uploads rest.Uploads, authClient authpb.AuthClient) {
 	r := rootRouter.PathPrefix("/profile").Subrouter()
 
-	h := rest.NewProfileHandler(userClient)
+	h := rest.NewProfileHandler(userClient, uploads)
 
 	r.HandleFunc("/search", h.HandleSearchByName).Methods("GET", "OPTIONS")
+	r.HandleFunc("/privacy", h.HandleGetPrivacySettings).Methods("GET", "OPTIONS")
+	r.HandleFunc("/privacy", h.HandleUpdatePrivacySettings).Methods("PUT", "OPTIONS")
 	r.HandleFunc("/{userID:[0-9]+}", h.HandleGetProfile).Methods("GET", "OPTIONS")
 	r.HandleFunc("/", h.HandleGetProfile).Methods("GET", "OPTIONS")
 	r.HandleFunc("/", h.HandleUpdateProfile).Methods("PUT", "OPTIONS")
 	r.HandleFunc("/", h.HandleDeleteProfile).Methods("DELETE", "OPTIONS")
 	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
 	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	r.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
 }
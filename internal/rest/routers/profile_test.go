@@ -25,7 +25,7 @@ func TestMountProfileRouter(t *testing.T) {
 	router := mux.NewRouter()
 
 	// Mount the routes
-	routers.MountProfileRouter(router, mockUserClient, mockAuthManager)
+	routers.MountProfileRouter(router, mockUserClient, nil, mockAuthManager)
 
 	// Define the routes to test
 	routes := []struct {
@@ -33,6 +33,8 @@ func TestMountProfileRouter(t *testing.T) {
 		path   string
 	}{
 		{"GET", "/profile/search"},
+		{"GET", "/profile/privacy"},
+		{"PUT", "/profile/privacy"},
 		{"GET", "/profile/"},
 		{"PUT", "/profile/"},
 		{"DELETE", "/profile/"},
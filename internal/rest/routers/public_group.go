@@ -13,10 +13,10 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func MountPublicGroupRouter(rootRouter *mux.Router, groupClient pgpb.PublicGroupClient, postClient postpb.PostClient, userClient uspb.UserClient, authManager authpb.AuthClient) {
+func MountPublicGroupRouter(rootRouter *mux.Router, groupClient pgpb.PublicGroupClient, postClient postpb.PostClient, userClient uspb.UserClient, notifier rest.Notifier, uploads rest.Uploads, authManager authpb.AuthClient) {
 	publicRouter := rootRouter.PathPrefix("/groups").Subrouter()
 
-	h := rest.NewPublicGroupHandler(groupClient, postClient, userClient)
+	h := rest.NewPublicGroupHandler(groupClient, postClient, userClient, notifier, uploads)
 
 	publicRouter.HandleFunc("/search", h.HandleSearchByName).Methods("GET", "OPTIONS")
 	publicRouter.HandleFunc("/{groupID:[0-9]+}", h.HandleGetByID).Methods("GET", "OPTIONS")
@@ -28,6 +28,7 @@ func MountPublicGroupRouter(rootRouter *mux.Router, groupClient pgpb.PublicGroup
 	publicRouter.HandleFunc("/{groupID:[0-9]+}/posts/", h.HandleGetGroupPosts).Methods("GET", "OPTIONS")
 	publicRouter.Use(middleware.CreateCheckIsAuthorizedMiddleware(authManager))
 	publicRouter.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	publicRouter.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
 
 	adminRouter := rootRouter.PathPrefix("/groups").Subrouter()
 
@@ -38,7 +39,9 @@ func MountPublicGroupRouter(rootRouter *mux.Router, groupClient pgpb.PublicGroup
 	adminRouter.HandleFunc("/{groupID:[0-9]+}", h.HandleUpdate).Methods("PUT", "OPTIONS")
 	adminRouter.HandleFunc("/{groupID:[0-9]+}", h.HandleDelete).Methods("DELETE", "OPTIONS")
 	adminRouter.HandleFunc("/{groupID:[0-9]+}/posts/", h.HandleCreateGroupPost).Methods("POST", "OPTIONS")
+	adminRouter.HandleFunc("/{groupID:[0-9]+}/posts/repost", h.HandleRepostToGroup).Methods("POST", "OPTIONS")
 	adminRouter.Use(middleware.CreateCheckIsAuthorizedMiddleware(authManager))
 	adminRouter.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
 	adminRouter.Use(middleware.CreateCheckPublicGroupAdminMiddleware(userClient))
+	adminRouter.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
 }
@@ -29,7 +29,7 @@ func TestMountPublicGroupRouter(t *testing.T) {
 	router := mux.NewRouter()
 
 	// Mount the routes
-	routers.MountPublicGroupRouter(router, mockGroupClient, mockPostClient, mockUserClient, mockAuthManager)
+	routers.MountPublicGroupRouter(router, mockGroupClient, mockPostClient, mockUserClient, nil, nil, mockAuthManager)
 
 	// Define the routes to test
 	routes := []struct {
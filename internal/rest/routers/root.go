@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	authpb "socio/internal/grpc/auth/proto"
 	postpb "socio/internal/grpc/post/proto"
 	pgpb "socio/internal/grpc/public_group/proto"
@@ -15,6 +16,11 @@ import (
 	"socio/pkg/appmetrics"
 	"socio/pkg/logger"
 	customtime "socio/pkg/time"
+	"socio/usecase/moderation"
+	"socio/usecase/notifications"
+	"socio/usecase/ratelimit"
+	"socio/usecase/uploads"
+	"time"
 
 	"github.com/minio/minio-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,6 +40,9 @@ var (
 	PgPasswordEnv = "PG_PASSWORD"
 	PgHostEnv     = "PG_HOST"
 	PgPortEnv     = "PG_PORT"
+	UploadsDirEnv = "UPLOADS_DIR"
+
+	MinioPublicURLEnv = "MINIO_PUBLIC_URL"
 )
 
 func MountRootRouter(router *mux.Router) (err error) {
@@ -70,7 +79,7 @@ func MountRootRouter(router *mux.Router) (err error) {
 		return
 	}
 
-	messageAttachmentStorage, err := minioRepo.NewStaticStorage(minioClient, minioRepo.MessageAttachmentsBucket)
+	messageAttachmentStorage, err := minioRepo.NewPrivateStorage(minioClient, minioRepo.MessageAttachmentsBucket, os.Getenv(MinioPublicURLEnv))
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -81,6 +90,35 @@ func MountRootRouter(router *mux.Router) (err error) {
 
 	chatPubSubRepository := redisRepo.NewChatPubSub(redisPool)
 	unsentMessageAttachmentsStorage := redisRepo.NewUnsentMessageAttachments(redisPool)
+	presenceStorage := redisRepo.NewPresence(redisPool)
+
+	notificationsService := notifications.NewNotificationsService(pgRepo.NewNotifications(db, customtime.RealTimeProvider{}), chatPubSubRepository)
+	moderationService := moderation.NewModerationService(pgRepo.NewModeration(db, customtime.RealTimeProvider{}))
+	rateLimitService := ratelimit.NewRateLimitService(redisRepo.NewRateLimiter(redisPool))
+
+	uploadsDir := os.Getenv(UploadsDirEnv)
+	if uploadsDir == "" {
+		uploadsDir = filepath.Join(os.TempDir(), "uploads")
+	}
+
+	if err = os.MkdirAll(uploadsDir, 0o700); err != nil {
+		return
+	}
+
+	uploadsService := uploads.NewUploadsService(redisRepo.NewUploads(redisPool), uploadsDir)
+
+	go func() {
+		ticker := time.NewTicker(uploads.SweepInterval)
+		defer ticker.Stop()
+
+		for {
+			_, err := uploadsService.SweepExpiredFiles()
+			if err != nil {
+				fmt.Println(err)
+			}
+			<-ticker.C
+		}
+	}()
 
 	userClientConn, err := grpc.Dial(
 		os.Getenv("GRPC_USER_SERVICE_HOST")+os.Getenv("GRPC_USER_SERVICE_PORT"),
@@ -138,13 +176,18 @@ func MountRootRouter(router *mux.Router) (err error) {
 
 	publicGroupClient := pgpb.NewPublicGroupClient(publicGroupClientConn)
 
-	MountAuthRouter(rootRouter, authClient, userClient)
+	MountAuthRouter(rootRouter, authClient, userClient, rateLimitService)
 	MountCSRFRouter(rootRouter, authClient)
-	MountChatRouter(rootRouter, chatPubSubRepository, unsentMessageAttachmentsStorage, personalMessageStorage, authClient, stickerStorage, messageAttachmentStorage)
-	MountProfileRouter(rootRouter, userClient, authClient)
-	MountPostsRouter(rootRouter, postClient, userClient, publicGroupClient, authClient)
-	MountSubscriptionsRouter(rootRouter, userClient, authClient)
-	MountPublicGroupRouter(rootRouter, publicGroupClient, postClient, userClient, authClient)
+	MountChatRouter(rootRouter, chatPubSubRepository, unsentMessageAttachmentsStorage, personalMessageStorage, authClient, stickerStorage, messageAttachmentStorage, presenceStorage, rateLimitService, userClient)
+	MountProfileRouter(rootRouter, userClient, uploadsService, authClient)
+	MountPostsRouter(rootRouter, postClient, userClient, publicGroupClient, notificationsService, uploadsService, authClient, rateLimitService)
+	MountUploadsRouter(rootRouter, uploadsService, postClient, userClient, publicGroupClient, authClient)
+	MountSubscriptionsRouter(rootRouter, userClient, notificationsService, authClient)
+	MountPublicGroupRouter(rootRouter, publicGroupClient, postClient, userClient, notificationsService, uploadsService, authClient)
+	MountSearchRouter(rootRouter, userClient, publicGroupClient, postClient, authClient)
+	MountNotificationsRouter(rootRouter, notificationsService, userClient, authClient)
+	MountReportsRouter(rootRouter, moderationService, userClient, authClient)
+	MountModerationRouter(rootRouter, moderationService, userClient, authClient, publicGroupClient)
 	MountMetricsRouter(rootRouter)
 
 	prodLogger, err := logger.NewZapLogger(nil)
@@ -168,6 +211,9 @@ func MountRootRouter(router *mux.Router) (err error) {
 		appmetrics.AppHitDuration,
 		appmetrics.AppExternalSystemsHitDuration,
 		appmetrics.AppExternalSystemsErrorsCount,
+		appmetrics.ContentFilterRuleDecisions,
+		appmetrics.ContentFilterVerdicts,
+		appmetrics.RateLimitDecisions,
 	)
 
 	rootRouter.Use(logger.LoggerMiddleware)
@@ -179,6 +225,7 @@ func MountRootRouter(router *mux.Router) (err error) {
 		AllowedOrigins:   middleware.ALLOWED_ORIGINS,
 		AllowedMethods:   middleware.ALLOWED_METHODS,
 		AllowedHeaders:   middleware.ALLOWED_HEADERS,
+		ExposedHeaders:   middleware.EXPOSED_HEADERS,
 		AllowCredentials: true,
 	}).Handler(rootRouter)
 
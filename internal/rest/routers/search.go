@@ -0,0 +1,24 @@
+package routers
+
+import (
+	authpb "socio/internal/grpc/auth/proto"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/internal/rest/middleware"
+	rest "socio/internal/rest/search"
+	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+
+	"github.com/gorilla/mux"
+)
+
+func MountSearchRouter(rootRouter *mux.Router, userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient, postClient postpb.PostClient, authClient authpb.AuthClient) {
+	r := rootRouter.PathPrefix("/search").Subrouter()
+
+	h := rest.NewSearchHandler(userClient, publicGroupClient, postClient)
+
+	r.HandleFunc("/", h.HandleSearch).Methods("GET", "OPTIONS")
+	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
+	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"net/http"
 	"socio/internal/rest/middleware"
 	rest "socio/internal/rest/subscriptions"
 	customtime "socio/pkg/time"
@@ -12,15 +13,18 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func MountSubscriptionsRouter(rootRouter *mux.Router, userClient uspb.UserClient, authClient authpb.AuthClient) {
+func MountSubscriptionsRouter(rootRouter *mux.Router, userClient uspb.UserClient, notifier rest.Notifier, authClient authpb.AuthClient) {
 	r := rootRouter.PathPrefix("/subscriptions").Subrouter()
-	h := rest.NewSubscriptionsHandler(userClient)
+	h := rest.NewSubscriptionsHandler(userClient, notifier)
 
-	r.HandleFunc("/", h.HandleSubscription).Methods("POST", "OPTIONS")
+	r.Handle("/", middleware.CreateCheckEmailVerifiedMiddleware(userClient)(http.HandlerFunc(h.HandleSubscription))).Methods("POST", "OPTIONS")
 	r.HandleFunc("/", h.HandleUnsubscription).Methods("DELETE", "OPTIONS")
 	r.HandleFunc("/subscribers", h.HandleGetSubscribers).Methods("GET", "OPTIONS")
 	r.HandleFunc("/subscriptions", h.HandleGetSubscriptions).Methods("GET", "OPTIONS")
 	r.HandleFunc("/friends", h.HandleGetFriends).Methods("GET", "OPTIONS")
+	r.HandleFunc("/blocks", h.HandleBlock).Methods("POST", "OPTIONS")
+	r.HandleFunc("/blocks", h.HandleUnblock).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/blocks", h.HandleGetBlockedUsers).Methods("GET", "OPTIONS")
 	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authClient))
 	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
 }
@@ -22,7 +22,7 @@ func TestMountSubscriptionsRouter(t *testing.T) {
 	authClient := mock_auth.NewMockAuthClient(ctrl)
 
 	router := mux.NewRouter()
-	routers.MountSubscriptionsRouter(router, userClient, authClient)
+	routers.MountSubscriptionsRouter(router, userClient, nil, authClient)
 
 	// Test if the routes are correctly mounted
 	testCases := []struct {
@@ -39,6 +39,10 @@ func TestMountSubscriptionsRouter(t *testing.T) {
 		{"OPTIONS", "/subscriptions/subscriptions"},
 		{"GET", "/subscriptions/friends"},
 		{"OPTIONS", "/subscriptions/friends"},
+		{"POST", "/subscriptions/blocks"},
+		{"DELETE", "/subscriptions/blocks"},
+		{"GET", "/subscriptions/blocks"},
+		{"OPTIONS", "/subscriptions/blocks"},
 	}
 
 	for _, tc := range testCases {
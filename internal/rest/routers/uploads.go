@@ -0,0 +1,28 @@
+package routers
+
+import (
+	authpb "socio/internal/grpc/auth/proto"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/internal/rest/middleware"
+	"socio/internal/rest/uploaders"
+	customtime "socio/pkg/time"
+	"socio/usecase/csrf"
+
+	"github.com/gorilla/mux"
+)
+
+func MountUploadsRouter(rootRouter *mux.Router, uploadsService uploaders.UploadsService, postsClient postpb.PostClient, userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient, authManager authpb.AuthClient) {
+	r := rootRouter.PathPrefix("/uploads").Subrouter()
+
+	h := uploaders.NewResumableUploadsHandler(uploadsService, postsClient, userClient, publicGroupClient)
+
+	r.HandleFunc("/", h.HandleCreateUpload).Methods("POST", "OPTIONS")
+	r.HandleFunc("/{"+uploaders.UploadIDVar+"}", h.HandleGetUploadOffset).Methods("HEAD", "OPTIONS")
+	r.HandleFunc("/{"+uploaders.UploadIDVar+"}", h.HandleWriteChunk).Methods("PATCH", "OPTIONS")
+	r.HandleFunc("/{"+uploaders.UploadIDVar+"}", h.HandleDeleteUpload).Methods("DELETE", "OPTIONS")
+	r.Use(middleware.CreateCheckIsAuthorizedMiddleware(authManager))
+	r.Use(middleware.CreateCSRFMiddleware(csrf.NewCSRFService(customtime.RealTimeProvider{})))
+	r.Use(middleware.CreateCheckEmailVerifiedMiddleware(userClient))
+}
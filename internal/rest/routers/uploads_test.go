@@ -0,0 +1,58 @@
+package routers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	routers "socio/internal/rest/routers"
+	mock_auth "socio/mocks/grpc/auth_grpc"
+	mock_posts "socio/mocks/grpc/post_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	mock_uploaders "socio/mocks/rest/uploaders"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountUploadsRouter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadsService := mock_uploaders.NewMockUploadsService(ctrl)
+	postsClient := mock_posts.NewMockPostClient(ctrl)
+	userClient := mock_user.NewMockUserClient(ctrl)
+	publicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+	authClient := mock_auth.NewMockAuthClient(ctrl)
+
+	router := mux.NewRouter()
+	routers.MountUploadsRouter(router, uploadsService, postsClient, userClient, publicGroupClient, authClient)
+
+	// Test if the routes are correctly mounted
+	testCases := []struct {
+		method string
+		path   string
+	}{
+		{"POST", "/uploads/"},
+		{"OPTIONS", "/uploads/"},
+		{"HEAD", "/uploads/upload"},
+		{"PATCH", "/uploads/upload"},
+		{"DELETE", "/uploads/upload"},
+		{"OPTIONS", "/uploads/upload"},
+	}
+
+	for _, tc := range testCases {
+		req, err := http.NewRequest(tc.method, tc.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		// We're just checking if the routes are mounted, so a 404 status code means the route is not mounted
+		assert.NotEqual(t, http.StatusNotFound, rr.Code, "Route %s not mounted", tc.path)
+	}
+}
@@ -0,0 +1,547 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/appmetrics"
+	"socio/pkg/contextlogger"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	publicgroup "socio/usecase/public_group"
+	"strconv"
+	"sync"
+	"time"
+
+	postspb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+)
+
+const (
+	QueryParam       = "query"
+	TypeQueryParam   = "type"
+	CursorQueryParam = "cursor"
+	AmountQueryParam = "amount"
+
+	UsersSearchType    = "users"
+	GroupsSearchType   = "groups"
+	PostsSearchType    = "posts"
+	HashtagsSearchType = "hashtags"
+
+	DefaultSectionAmount = uint64(5)
+	MaxSectionAmount     = uint64(50)
+
+	DefaultUsersTimeout    = 1 * time.Second
+	DefaultGroupsTimeout   = 1 * time.Second
+	DefaultPostsTimeout    = 2 * time.Second
+	DefaultHashtagsTimeout = 1 * time.Second
+)
+
+// UsersSection is the page of the users found by name, Count is the amount of
+// all the users found. Failed is set when the user service did not answer in
+// time, the other sections are still served.
+//
+//easyjson:json
+type UsersSection struct {
+	Items      []*domain.User `json:"items"`
+	Count      int            `json:"count"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	Failed     bool           `json:"failed,omitempty"`
+}
+
+// GroupsSection is the page of the public groups found by name.
+//
+//easyjson:json
+type GroupsSection struct {
+	Items      []*publicgroup.PublicGroupWithInfo `json:"items"`
+	Count      int                                `json:"count"`
+	NextCursor string                             `json:"nextCursor,omitempty"`
+	Failed     bool                               `json:"failed,omitempty"`
+}
+
+// PostsSection is the page of the posts found by the full-text search. The
+// posts whose author or group could not be fetched are left out of the page.
+//
+//easyjson:json
+type PostsSection struct {
+	Items      []*domain.FoundPost `json:"items"`
+	Count      int                 `json:"count"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+	Failed     bool                `json:"failed,omitempty"`
+}
+
+// HashtagsSection is the page of the hashtags starting with the query in
+// alphabetical order, each with the amount of its uses.
+//
+//easyjson:json
+type HashtagsSection struct {
+	Items      []posts.TrendingHashtag `json:"items"`
+	Count      int                     `json:"count"`
+	NextCursor string                  `json:"nextCursor,omitempty"`
+	Failed     bool                    `json:"failed,omitempty"`
+}
+
+// SearchResponse holds a section per searched type, the sections which were
+// not requested are omitted.
+//
+//easyjson:json
+type SearchResponse struct {
+	Users    *UsersSection    `json:"users,omitempty"`
+	Groups   *GroupsSection   `json:"groups,omitempty"`
+	Posts    *PostsSection    `json:"posts,omitempty"`
+	Hashtags *HashtagsSection `json:"hashtags,omitempty"`
+}
+
+type SearchHandler struct {
+	UserClient        uspb.UserClient
+	PublicGroupClient pgpb.PublicGroupClient
+	PostsClient       postspb.PostClient
+	UsersTimeout      time.Duration
+	GroupsTimeout     time.Duration
+	PostsTimeout      time.Duration
+	HashtagsTimeout   time.Duration
+}
+
+func NewSearchHandler(userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient, postsClient postspb.PostClient) (handler *SearchHandler) {
+	handler = &SearchHandler{
+		UserClient:        userClient,
+		PublicGroupClient: publicGroupClient,
+		PostsClient:       postsClient,
+		UsersTimeout:      DefaultUsersTimeout,
+		GroupsTimeout:     DefaultGroupsTimeout,
+		PostsTimeout:      DefaultPostsTimeout,
+		HashtagsTimeout:   DefaultHashtagsTimeout,
+	}
+
+	return
+}
+
+// searchInput is the parsed request, the cursor belongs to the only requested
+// section, lastID is the cursor of the users or the groups section. The cursor
+// of the hashtags section is the last hashtag of the previous page.
+type searchInput struct {
+	userID uint
+	query  string
+	cursor string
+	lastID uint64
+	amount uint64
+}
+
+// HandleSearch godoc
+//
+//	@Summary		search users, groups, posts and hashtags
+//	@Description	search users and public groups by name, posts by content and hashtags by prefix at once, each section is searched by its own service and is marked as failed if the service does not answer in time
+//	@Tags			search
+//	@license.name	Apache 2.0
+//	@ID				search
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			query			query	string	true	"Search query"
+//	@Param			type			query	string	false	"Section to search: users, groups, posts or hashtags, if empty - search all sections"
+//	@Param			cursor			query	string	false	"Cursor of the next page of the section from the previous response, requires type"
+//	@Param			amount			query	uint	false	"Amount of results to get per section, if 0 - get 5 results"
+//
+//	@Produce		json
+//	@Success		200	{object}	SearchResponse
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/search/ [get]
+func (h *SearchHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	input := searchInput{
+		userID: userID,
+		query:  r.URL.Query().Get(QueryParam),
+		cursor: r.URL.Query().Get(CursorQueryParam),
+		amount: DefaultSectionAmount,
+	}
+
+	if input.query == "" {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	if amountData := r.URL.Query().Get(AmountQueryParam); amountData != "" {
+		input.amount, err = strconv.ParseUint(amountData, 0, 0)
+		if err != nil || input.amount == 0 {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+	}
+
+	if input.amount > MaxSectionAmount {
+		input.amount = MaxSectionAmount
+	}
+
+	res := &SearchResponse{}
+
+	switch r.URL.Query().Get(TypeQueryParam) {
+	case "":
+		if input.cursor != "" {
+			json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+			return
+		}
+
+		res.Users, res.Groups, res.Posts, res.Hashtags = &UsersSection{}, &GroupsSection{}, &PostsSection{}, &HashtagsSection{}
+	case UsersSearchType:
+		res.Users = &UsersSection{}
+		input.lastID, err = parseIDCursor(input.cursor)
+	case GroupsSearchType:
+		res.Groups = &GroupsSection{}
+		input.lastID, err = parseIDCursor(input.cursor)
+	case PostsSearchType:
+		res.Posts = &PostsSection{}
+	case HashtagsSearchType:
+		res.Hashtags = &HashtagsSection{}
+	default:
+		err = errors.ErrInvalidData
+	}
+
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	var lastErr error
+	failed, requested := 0, 0
+	mu := &sync.Mutex{}
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		contextlogger.LogErr(r.Context(), err)
+
+		failed++
+		lastErr = err
+	}
+
+	wg := &sync.WaitGroup{}
+
+	if res.Users != nil {
+		requested++
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := h.searchUsers(r.Context(), input, res.Users); err != nil {
+				*res.Users = UsersSection{Items: []*domain.User{}, Failed: true}
+				fail(err)
+			}
+		}()
+	}
+
+	if res.Groups != nil {
+		requested++
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := h.searchGroups(r.Context(), input, res.Groups); err != nil {
+				*res.Groups = GroupsSection{Items: []*publicgroup.PublicGroupWithInfo{}, Failed: true}
+				fail(err)
+			}
+		}()
+	}
+
+	if res.Posts != nil {
+		requested++
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := h.searchPosts(r.Context(), input, res.Posts); err != nil {
+				*res.Posts = PostsSection{Items: []*domain.FoundPost{}, Failed: true}
+				fail(err)
+			}
+		}()
+	}
+
+	if res.Hashtags != nil {
+		requested++
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := h.searchHashtags(r.Context(), input, res.Hashtags); err != nil {
+				*res.Hashtags = HashtagsSection{Items: []posts.TrendingHashtag{}, Failed: true}
+				fail(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if failed == requested {
+		json.ServeGRPCStatus(r.Context(), w, lastErr)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, res, http.StatusOK)
+}
+
+// searchUsers fills the section with the users found by name. One extra user
+// is requested to know whether there is a next page, the cursor is the ID of
+// the last user of the page.
+func (h *SearchHandler) searchUsers(ctx context.Context, input searchInput, section *UsersSection) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, h.UsersTimeout)
+	defer cancel()
+
+	startTime := customtime.CustomTime{Time: time.Now()}
+	defer func() {
+		appmetrics.TrackAppExternalServiceMetrics("user", startTime, err)
+	}()
+
+	usersRes, err := h.UserClient.SearchByName(ctx, &uspb.SearchByNameRequest{
+		ViewerId:   uint64(input.userID),
+		Query:      input.query,
+		LastUserId: input.lastID,
+		Amount:     input.amount + 1,
+	})
+	if err != nil {
+		return
+	}
+
+	section.Items = uspb.ToUsers(usersRes.GetUsers())
+	if uint64(len(section.Items)) > input.amount {
+		section.Items = section.Items[:input.amount]
+		section.NextCursor = strconv.FormatUint(uint64(section.Items[len(section.Items)-1].ID), 10)
+	}
+
+	if section.Items == nil {
+		section.Items = []*domain.User{}
+	}
+
+	section.Count = int(usersRes.GetTotal())
+	return
+}
+
+// searchGroups fills the section with the public groups found by name the
+// same way searchUsers does.
+func (h *SearchHandler) searchGroups(ctx context.Context, input searchInput, section *GroupsSection) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, h.GroupsTimeout)
+	defer cancel()
+
+	startTime := customtime.CustomTime{Time: time.Now()}
+	defer func() {
+		appmetrics.TrackAppExternalServiceMetrics("public_group", startTime, err)
+	}()
+
+	groupsRes, err := h.PublicGroupClient.SearchByName(ctx, &pgpb.SearchByNameRequest{
+		Query:       input.query,
+		UserId:      uint64(input.userID),
+		LastGroupId: input.lastID,
+		Amount:      input.amount + 1,
+	})
+	if err != nil {
+		return
+	}
+
+	section.Items = pgpb.ToPublicGroupsWithInfo(groupsRes.GetPublicGroups())
+	if uint64(len(section.Items)) > input.amount {
+		section.Items = section.Items[:input.amount]
+		section.NextCursor = strconv.FormatUint(uint64(section.Items[len(section.Items)-1].PublicGroup.ID), 10)
+	}
+
+	if section.Items == nil {
+		section.Items = []*publicgroup.PublicGroupWithInfo{}
+	}
+
+	section.Count = int(groupsRes.GetTotal())
+	return
+}
+
+// searchPosts fills the section with the posts visible to the user found by
+// content, the authors and the groups of the posts are fetched concurrently
+// within the same deadline.
+func (h *SearchHandler) searchPosts(ctx context.Context, input searchInput, section *PostsSection) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, h.PostsTimeout)
+	defer cancel()
+
+	startTime := customtime.CustomTime{Time: time.Now()}
+	defer func() {
+		appmetrics.TrackAppExternalServiceMetrics("post", startTime, err)
+	}()
+
+	userSubIDsRes, err := h.UserClient.GetSubscriptionIDs(ctx, &uspb.GetSubscriptionIDsRequest{
+		UserId: uint64(input.userID),
+	})
+	if err != nil {
+		return
+	}
+
+	searchRes, err := h.PostsClient.Search(ctx, &postspb.SearchRequest{
+		UserId:              uint64(input.userID),
+		UserSubscriptionIds: userSubIDsRes.GetSubscriptionIds(),
+		Query:               input.query,
+		Cursor:              input.cursor,
+		Amount:              input.amount,
+	})
+	if err != nil {
+		return
+	}
+
+	found := make([]*domain.Post, 0, len(searchRes.GetPosts()))
+	for _, hit := range searchRes.GetPosts() {
+		found = append(found, postspb.ToPost(hit.GetPost()))
+	}
+
+	authors, groups := h.getAuthorsAndGroups(ctx, input.userID, found)
+
+	section.Items = make([]*domain.FoundPost, 0, len(found))
+	section.NextCursor = searchRes.GetNextCursor()
+	section.Count = int(searchRes.GetTotal())
+
+	for i, post := range found {
+		author, ok := authors[post.AuthorID]
+		if !ok {
+			continue
+		}
+
+		group, ok := groups[post.GroupID]
+		if post.GroupID != 0 && !ok {
+			continue
+		}
+
+		section.Items = append(section.Items, &domain.FoundPost{
+			Post: &domain.PostWithAuthorAndGroup{
+				Post:   post,
+				Author: author,
+				Group:  group,
+			},
+			Snippet: searchRes.GetPosts()[i].GetSnippet(),
+		})
+	}
+
+	return
+}
+
+// getAuthorsAndGroups fetches the distinct authors and groups of the posts at
+// once, the ones which could not be fetched are logged and left out.
+func (h *SearchHandler) getAuthorsAndGroups(ctx context.Context, viewerID uint, found []*domain.Post) (authors map[uint]*domain.User, groups map[uint]*domain.PublicGroup) {
+	authors = make(map[uint]*domain.User)
+	groups = make(map[uint]*domain.PublicGroup)
+
+	authorIDs := make(map[uint]struct{})
+	groupIDs := make(map[uint]struct{})
+
+	for _, post := range found {
+		authorIDs[post.AuthorID] = struct{}{}
+
+		if post.GroupID != 0 {
+			groupIDs[post.GroupID] = struct{}{}
+		}
+	}
+
+	mu := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+
+	for authorID := range authorIDs {
+		wg.Add(1)
+
+		go func(authorID uint) {
+			defer wg.Done()
+
+			authorData, err := h.UserClient.GetByID(ctx, &uspb.GetByIDRequest{
+				UserId:   uint64(authorID),
+				ViewerId: uint64(viewerID),
+			})
+			if err != nil {
+				contextlogger.LogErr(ctx, err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			authors[authorID] = uspb.ToUser(authorData.GetUser())
+		}(authorID)
+	}
+
+	for groupID := range groupIDs {
+		wg.Add(1)
+
+		go func(groupID uint) {
+			defer wg.Done()
+
+			groupData, err := h.PublicGroupClient.GetByID(ctx, &pgpb.GetByIDRequest{
+				Id: uint64(groupID),
+			})
+			if err != nil {
+				contextlogger.LogErr(ctx, err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			groups[groupID] = pgpb.ToPublicGroup(groupData.GetPublicGroup().GetPublicGroup())
+		}(groupID)
+	}
+
+	wg.Wait()
+
+	return
+}
+
+// searchHashtags fills the section with the hashtags starting with the query
+// the same way searchUsers does, the cursor is the last hashtag of the page.
+func (h *SearchHandler) searchHashtags(ctx context.Context, input searchInput, section *HashtagsSection) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, h.HashtagsTimeout)
+	defer cancel()
+
+	startTime := customtime.CustomTime{Time: time.Now()}
+	defer func() {
+		appmetrics.TrackAppExternalServiceMetrics("post", startTime, err)
+	}()
+
+	hashtagsRes, err := h.PostsClient.SearchHashtags(ctx, &postspb.SearchHashtagsRequest{
+		Query:       input.query,
+		LastHashtag: input.cursor,
+		Amount:      input.amount + 1,
+	})
+	if err != nil {
+		return
+	}
+
+	section.Items = postspb.ToTrendingHashtags(hashtagsRes.GetHashtags())
+	if uint64(len(section.Items)) > input.amount {
+		section.Items = section.Items[:input.amount]
+		section.NextCursor = section.Items[len(section.Items)-1].Hashtag
+	}
+
+	section.Count = int(hashtagsRes.GetTotal())
+	return
+}
+
+// parseIDCursor parses the cursor of the users and the groups sections, an
+// empty cursor points before the first result.
+func parseIDCursor(cursor string) (lastID uint64, err error) {
+	if cursor == "" {
+		return
+	}
+
+	lastID, err = strconv.ParseUint(cursor, 10, 64)
+	if err != nil || lastID == 0 {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
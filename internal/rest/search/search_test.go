@@ -0,0 +1,337 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"socio/errors"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	mock_posts "socio/mocks/grpc/post_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	"socio/pkg/requestcontext"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var validCtx = context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1))
+
+// sectionBody is the part of a section checked by the tests.
+type sectionBody struct {
+	Items      []json.RawMessage `json:"items"`
+	Count      int               `json:"count"`
+	NextCursor string            `json:"nextCursor"`
+	Failed     bool              `json:"failed"`
+}
+
+type searchMocks struct {
+	userClient        *mock_user.MockUserClient
+	publicGroupClient *mock_public_group.MockPublicGroupClient
+	postsClient       *mock_posts.MockPostClient
+}
+
+func TestHandleSearch(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		expectedStatus int
+		mock           func(m searchMocks)
+		check          func(t *testing.T, sections map[string]*sectionBody)
+	}{
+		{
+			name:           "all sections",
+			ctx:            validCtx,
+			query:          "?query=go&amount=1",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().SearchByName(gomock.Any(), &uspb.SearchByNameRequest{
+					ViewerId: 1,
+					Query:    "go",
+					Amount:   2,
+				}).Return(&uspb.SearchByNameResponse{
+					Users: []*uspb.UserResponse{{Id: 3}, {Id: 4}},
+					Total: 7,
+				}, nil)
+				m.publicGroupClient.EXPECT().SearchByName(gomock.Any(), &pgpb.SearchByNameRequest{
+					Query:  "go",
+					UserId: 1,
+					Amount: 2,
+				}).Return(&pgpb.SearchByNameResponse{
+					PublicGroups: []*pgpb.PublicGroupWithInfoResponse{
+						{PublicGroup: &pgpb.PublicGroupResponse{Id: 1}},
+					},
+					Total: 1,
+				}, nil)
+				m.userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{SubscriptionIds: []uint64{2}}, nil)
+				m.postsClient.EXPECT().Search(gomock.Any(), &postpb.SearchRequest{
+					UserId:              1,
+					UserSubscriptionIds: []uint64{2},
+					Query:               "go",
+					Amount:              1,
+				}).Return(&postpb.SearchResponse{
+					Posts: []*postpb.PostSearchResult{
+						{Post: &postpb.PostResponse{Id: 1, AuthorId: 2, GroupId: 1}, Snippet: "<mark>go</mark>"},
+					},
+					NextCursor: "next",
+					Total:      4,
+				}, nil)
+				m.userClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&uspb.GetByIDResponse{User: &uspb.UserResponse{Id: 2}}, nil)
+				m.publicGroupClient.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&pgpb.GetByIDResponse{
+					PublicGroup: &pgpb.PublicGroupWithInfoResponse{PublicGroup: &pgpb.PublicGroupResponse{Id: 1}},
+				}, nil)
+				m.postsClient.EXPECT().SearchHashtags(gomock.Any(), &postpb.SearchHashtagsRequest{
+					Query:  "go",
+					Amount: 2,
+				}).Return(&postpb.SearchHashtagsResponse{
+					Hashtags: []*postpb.TrendingHashtag{{Hashtag: "go", Count: 3}, {Hashtag: "golang", Count: 1}},
+					Total:    2,
+				}, nil)
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.Equal(t, 7, sections[UsersSearchType].Count)
+				assert.Len(t, sections[UsersSearchType].Items, 1)
+				assert.Equal(t, "3", sections[UsersSearchType].NextCursor)
+				assert.Equal(t, 1, sections[GroupsSearchType].Count)
+				assert.Empty(t, sections[GroupsSearchType].NextCursor)
+				assert.Equal(t, 4, sections[PostsSearchType].Count)
+				assert.Contains(t, string(sections[PostsSearchType].Items[0]), `"userId":2`)
+				assert.Equal(t, "next", sections[PostsSearchType].NextCursor)
+				assert.Equal(t, 2, sections[HashtagsSearchType].Count)
+				assert.Len(t, sections[HashtagsSearchType].Items, 1)
+				assert.Equal(t, "go", sections[HashtagsSearchType].NextCursor)
+			},
+		},
+		{
+			name:           "posts whose author or group is not fetched are skipped",
+			ctx:            validCtx,
+			query:          "?query=go&type=posts",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				m.postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&postpb.SearchResponse{
+					Posts: []*postpb.PostSearchResult{
+						{Post: &postpb.PostResponse{Id: 3, AuthorId: 2}},
+						{Post: &postpb.PostResponse{Id: 2, AuthorId: 5}},
+						{Post: &postpb.PostResponse{Id: 1, AuthorId: 2, GroupId: 4}},
+					},
+					Total: 3,
+				}, nil)
+				m.userClient.EXPECT().GetByID(gomock.Any(), &uspb.GetByIDRequest{UserId: 2, ViewerId: 1}).Return(&uspb.GetByIDResponse{User: &uspb.UserResponse{Id: 2}}, nil)
+				m.userClient.EXPECT().GetByID(gomock.Any(), &uspb.GetByIDRequest{UserId: 5, ViewerId: 1}).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+				m.publicGroupClient.EXPECT().GetByID(gomock.Any(), &pgpb.GetByIDRequest{Id: 4}).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.False(t, sections[PostsSearchType].Failed)
+				assert.Equal(t, 3, sections[PostsSearchType].Count)
+				assert.Len(t, sections[PostsSearchType].Items, 1)
+				assert.Contains(t, string(sections[PostsSearchType].Items[0]), `"postId":3`)
+			},
+		},
+		{
+			name:           "hashtags section with cursor",
+			ctx:            validCtx,
+			query:          "?query=%23go&type=hashtags&cursor=go",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.postsClient.EXPECT().SearchHashtags(gomock.Any(), &postpb.SearchHashtagsRequest{
+					Query:       "#go",
+					LastHashtag: "go",
+					Amount:      DefaultSectionAmount + 1,
+				}).Return(&postpb.SearchHashtagsResponse{
+					Hashtags: []*postpb.TrendingHashtag{{Hashtag: "golang", Count: 1}},
+					Total:    2,
+				}, nil)
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.Len(t, sections, 1)
+				assert.Equal(t, 2, sections[HashtagsSearchType].Count)
+				assert.Len(t, sections[HashtagsSearchType].Items, 1)
+				assert.Empty(t, sections[HashtagsSearchType].NextCursor)
+			},
+		},
+		{
+			name:           "failed backend degrades its section",
+			ctx:            validCtx,
+			query:          "?query=go",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+				m.publicGroupClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).Return(&pgpb.SearchByNameResponse{}, nil)
+				m.userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				m.postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+				m.postsClient.EXPECT().SearchHashtags(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.True(t, sections[HashtagsSearchType].Failed)
+				assert.NotNil(t, sections[HashtagsSearchType].Items)
+				assert.True(t, sections[UsersSearchType].Failed)
+				assert.NotNil(t, sections[UsersSearchType].Items)
+				assert.False(t, sections[GroupsSearchType].Failed)
+				assert.Equal(t, 0, sections[GroupsSearchType].Count)
+				assert.True(t, sections[PostsSearchType].Failed)
+			},
+		},
+		{
+			name:           "slow backend is cut by its deadline",
+			ctx:            validCtx,
+			query:          "?query=go&type=users",
+			expectedStatus: http.StatusGatewayTimeout,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in *uspb.SearchByNameRequest, opts ...grpc.CallOption) (*uspb.SearchByNameResponse, error) {
+						<-ctx.Done()
+						return nil, status.FromContextError(ctx.Err()).Err()
+					},
+				)
+			},
+		},
+		{
+			name:           "all backends failed",
+			ctx:            validCtx,
+			query:          "?query=go",
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+				m.publicGroupClient.EXPECT().SearchByName(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+				m.userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+				m.postsClient.EXPECT().SearchHashtags(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "users section with cursor",
+			ctx:            validCtx,
+			query:          "?query=go&type=users&cursor=3",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().SearchByName(gomock.Any(), &uspb.SearchByNameRequest{
+					ViewerId:   1,
+					Query:      "go",
+					LastUserId: 3,
+					Amount:     DefaultSectionAmount + 1,
+				}).Return(&uspb.SearchByNameResponse{}, nil)
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.Len(t, sections, 1)
+				assert.NotNil(t, sections[UsersSearchType])
+			},
+		},
+		{
+			name:           "groups section with cursor",
+			ctx:            validCtx,
+			query:          "?query=go&type=groups&cursor=3&amount=100",
+			expectedStatus: http.StatusOK,
+			mock: func(m searchMocks) {
+				m.publicGroupClient.EXPECT().SearchByName(gomock.Any(), &pgpb.SearchByNameRequest{
+					Query:       "go",
+					UserId:      1,
+					LastGroupId: 3,
+					Amount:      MaxSectionAmount + 1,
+				}).Return(&pgpb.SearchByNameResponse{}, nil)
+			},
+			check: func(t *testing.T, sections map[string]*sectionBody) {
+				assert.Len(t, sections, 1)
+				assert.NotNil(t, sections[GroupsSearchType])
+			},
+		},
+		{
+			name:           "posts section with invalid cursor",
+			ctx:            validCtx,
+			query:          "?query=go&type=posts&cursor=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock: func(m searchMocks) {
+				m.userClient.EXPECT().GetSubscriptionIDs(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionIDsResponse{}, nil)
+				m.postsClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidData.GRPCStatus().Err())
+			},
+		},
+		{
+			name:           "users section with invalid cursor",
+			ctx:            validCtx,
+			query:          "?query=go&type=users&cursor=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+		{
+			name:           "cursor without type",
+			ctx:            validCtx,
+			query:          "?query=go&cursor=3",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+		{
+			name:           "invalid type",
+			ctx:            validCtx,
+			query:          "?query=go&type=messages",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+		{
+			name:           "invalid amount",
+			ctx:            validCtx,
+			query:          "?query=go&amount=asd",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+		{
+			name:           "empty query",
+			ctx:            validCtx,
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+		{
+			name:           "no user id in context",
+			ctx:            context.Background(),
+			query:          "?query=go",
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(m searchMocks) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := searchMocks{
+				userClient:        mock_user.NewMockUserClient(ctrl),
+				publicGroupClient: mock_public_group.NewMockPublicGroupClient(ctrl),
+				postsClient:       mock_posts.NewMockPostClient(ctrl),
+			}
+			tt.mock(m)
+
+			h := NewSearchHandler(m.userClient, m.publicGroupClient, m.postsClient)
+			h.UsersTimeout = 10 * time.Millisecond
+
+			r := httptest.NewRequest("GET", "/search/"+tt.query, nil)
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleSearch(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.check == nil {
+				return
+			}
+
+			body := struct {
+				Body map[string]*sectionBody `json:"body"`
+			}{}
+
+			err := json.Unmarshal(rr.Body.Bytes(), &body)
+			assert.NoError(t, err)
+
+			tt.check(t, body.Body)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package rest
+
+import (
+	"net/http"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+
+	uspb "socio/internal/grpc/user/proto"
+
+	easyjson "github.com/mailru/easyjson"
+)
+
+//easyjson:json
+type BlockInput struct {
+	BlockedID uint `json:"blocked"`
+}
+
+// HandleBlock godoc
+//
+//	@Summary		block user
+//	@Description	block user, the subscriptions of both users to each other are removed
+//	@Tags			subscriptions
+//	@license.name	Apache 2.0
+//	@ID				subscriptions/block
+//	@Accept			json
+//
+//	@Param			blocked			body	int		true	"ID of the user to block"
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		201	{object}	json.JSONResponse{body=domain.Block}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/subscriptions/blocks [post]
+func (api *SubscriptionsHandler) HandleBlock(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	input := new(BlockInput)
+
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	block, err := api.UserService.Block(r.Context(), &uspb.BlockRequest{
+		BlockerId: uint64(userID),
+		BlockedId: uint64(input.BlockedID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, map[string]*domain.Block{
+		"block": uspb.ToBlock(block.GetBlock()),
+	}, http.StatusCreated)
+}
+
+// HandleUnblock godoc
+//
+//	@Summary		unblock user
+//	@Description	unblock user
+//	@Tags			subscriptions
+//	@license.name	Apache 2.0
+//	@ID				subscriptions/unblock
+//	@Accept			json
+//
+//	@Param			blocked			body	int		true	"ID of the user to unblock"
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/subscriptions/blocks [delete]
+func (api *SubscriptionsHandler) HandleUnblock(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	input := new(BlockInput)
+
+	err := easyjson.UnmarshalFromReader(r.Body, input)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrJSONUnmarshalling)
+		return
+	}
+
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	_, err = api.UserService.Unblock(r.Context(), &uspb.UnblockRequest{
+		BlockerId: uint64(userID),
+		BlockedId: uint64(input.BlockedID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetBlockedUsers godoc
+//
+//	@Summary		get blocked users
+//	@Description	get the users blocked by the authorized user
+//	@Tags			subscriptions
+//	@license.name	Apache 2.0
+//	@ID				subscriptions/blocked_users
+//	@Accept			json
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=subscriptions.GetBlockedUsersResponse}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/subscriptions/blocks [get]
+func (api *SubscriptionsHandler) HandleGetBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	blockedUsers, err := api.UserService.GetBlockedUsers(r.Context(), &uspb.GetBlockedUsersRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, map[string][]*domain.User{
+		"blockedUsers": uspb.ToSubscriptions(blockedUsers.GetBlockedUsers()),
+	}, http.StatusOK)
+}
@@ -0,0 +1,85 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package rest
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB09c4b0aDecodeSocioInternalRestSubscriptions(in *jlexer.Lexer, out *BlockInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocked":
+			out.BlockedID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB09c4b0aEncodeSocioInternalRestSubscriptions(out *jwriter.Writer, in BlockInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocked\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.BlockedID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BlockInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB09c4b0aEncodeSocioInternalRestSubscriptions(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BlockInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB09c4b0aEncodeSocioInternalRestSubscriptions(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BlockInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB09c4b0aDecodeSocioInternalRestSubscriptions(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BlockInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB09c4b0aDecodeSocioInternalRestSubscriptions(l, v)
+}
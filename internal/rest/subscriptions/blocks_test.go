@@ -0,0 +1,184 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"socio/errors"
+	"socio/pkg/requestcontext"
+	"testing"
+
+	uspb "socio/internal/grpc/user/proto"
+	mock_user "socio/mocks/grpc/user_grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           *BlockInput
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful block",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           &BlockInput{BlockedID: 2},
+			expectedStatus: http.StatusCreated,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().Block(gomock.Any(), &uspb.BlockRequest{BlockerId: 1, BlockedId: 2}).Return(&uspb.BlockResponse{
+					Block: &uspb.UserBlockResponse{
+						Id:        1,
+						BlockerId: 1,
+						BlockedId: 2,
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "No user in context",
+			ctx:            context.Background(),
+			body:           &BlockInput{BlockedID: 2},
+			expectedStatus: http.StatusBadRequest,
+			mock:           func(userClient *mock_user.MockUserClient) {},
+		},
+		{
+			name:           "Already blocked",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           &BlockInput{BlockedID: 2},
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().Block(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidBody.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			r := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
+			r.Header.Set("Content-Type", "application/json")
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockUserClient)
+
+			h := NewSubscriptionsHandler(mockUserClient, nil)
+
+			h.HandleBlock(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleUnblock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           *BlockInput
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful unblock",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           &BlockInput{BlockedID: 2},
+			expectedStatus: http.StatusNoContent,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().Unblock(gomock.Any(), &uspb.UnblockRequest{BlockerId: 1, BlockedId: 2}).Return(&uspb.UnblockResponse{}, nil)
+			},
+		},
+		{
+			name:           "Not blocked",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			body:           &BlockInput{BlockedID: 2},
+			expectedStatus: http.StatusNotFound,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().Unblock(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			r := httptest.NewRequest("DELETE", "/", bytes.NewBuffer(body))
+			r.Header.Set("Content-Type", "application/json")
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockUserClient)
+
+			h := NewSubscriptionsHandler(mockUserClient, nil)
+
+			h.HandleUnblock(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleGetBlockedUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectedStatus int
+		mock           func(userClient *mock_user.MockUserClient)
+	}{
+		{
+			name:           "Successful get blocked users",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetBlockedUsers(gomock.Any(), &uspb.GetBlockedUsersRequest{UserId: 1}).Return(&uspb.GetBlockedUsersResponse{
+					BlockedUsers: []*uspb.UserResponse{{Id: 2}},
+				}, nil)
+			},
+		},
+		{
+			name:           "Internal error",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			expectedStatus: http.StatusInternalServerError,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetBlockedUsers(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r = r.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			mockUserClient := mock_user.NewMockUserClient(ctrl)
+			tt.mock(mockUserClient)
+
+			h := NewSubscriptionsHandler(mockUserClient, nil)
+
+			h.HandleGetBlockedUsers(rr, r)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
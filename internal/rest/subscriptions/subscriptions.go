@@ -1,29 +1,42 @@
 package rest
 
 import (
+	"context"
 	"net/http"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contextlogger"
 	"socio/pkg/json"
 	"socio/pkg/requestcontext"
+	"strconv"
 
 	uspb "socio/internal/grpc/user/proto"
 
 	easyjson "github.com/mailru/easyjson"
 )
 
+const UserIDQueryParam = "userId"
+
 //easyjson:json
 type SubscriptionInput struct {
 	SubscribedToID uint `json:"subscribedTo"`
 }
 
+// Notifier records and pushes notifications about the user activity, failing
+// to notify does not fail the request.
+type Notifier interface {
+	Notify(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error)
+}
+
 type SubscriptionsHandler struct {
 	UserService uspb.UserClient
+	Notifier    Notifier
 }
 
-func NewSubscriptionsHandler(userService uspb.UserClient) (handler *SubscriptionsHandler) {
+func NewSubscriptionsHandler(userService uspb.UserClient, notifier Notifier) (handler *SubscriptionsHandler) {
 	handler = &SubscriptionsHandler{
 		UserService: userService,
+		Notifier:    notifier,
 	}
 	return
 }
@@ -74,6 +87,11 @@ func (api *SubscriptionsHandler) HandleSubscription(w http.ResponseWriter, r *ht
 		return
 	}
 
+	_, err = api.Notifier.Notify(r.Context(), domain.SubscriptionNotification, userID, input.SubscribedToID)
+	if err != nil {
+		contextlogger.LogErr(r.Context(), err)
+	}
+
 	json.ServeJSONBody(r.Context(), w, map[string]*domain.Subscription{
 		"subscription": uspb.ToSubscription(subscription.Subscription),
 	}, http.StatusCreated)
@@ -141,22 +159,32 @@ func (api *SubscriptionsHandler) HandleUnsubscription(w http.ResponseWriter, r *
 //
 //	@Param			Cookie	header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			userId	query	uint	false	"User ID, if empty - get authorized user subscriptions"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=subscriptions.GetSubscriptionsResponse}
+//	@Failure		400	{object}	errors.HTTPError
 //	@Failure		401	{object}	errors.HTTPError
 //	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/subscriptions/subscriptions/ [get]
 func (api *SubscriptionsHandler) HandleGetSubscriptions(w http.ResponseWriter, r *http.Request) {
-	userID, err := requestcontext.GetUserID(r.Context())
+	viewerID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	userID, err := targetUserID(r, viewerID)
 	if err != nil {
 		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
 	subscriptions, err := api.UserService.GetSubscriptions(r.Context(), &uspb.GetSubscriptionsRequest{
-		UserId: uint64(userID),
+		UserId:   uint64(userID),
+		ViewerId: uint64(viewerID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -179,6 +207,7 @@ func (api *SubscriptionsHandler) HandleGetSubscriptions(w http.ResponseWriter, r
 //
 //	@Param			Cookie	header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			userId	query	uint	false	"User ID, if empty - get authorized user subscribers"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=subscriptions.GetSubscribersResponse}
@@ -189,14 +218,21 @@ func (api *SubscriptionsHandler) HandleGetSubscriptions(w http.ResponseWriter, r
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/subscriptions/subscribers/ [get]
 func (api *SubscriptionsHandler) HandleGetSubscribers(w http.ResponseWriter, r *http.Request) {
-	userID, err := requestcontext.GetUserID(r.Context())
+	viewerID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	userID, err := targetUserID(r, viewerID)
 	if err != nil {
 		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
 	subscribers, err := api.UserService.GetSubscribers(r.Context(), &uspb.GetSubscribersRequest{
-		UserId: uint64(userID),
+		UserId:   uint64(userID),
+		ViewerId: uint64(viewerID),
 	})
 	if err != nil {
 		json.ServeGRPCStatus(r.Context(), w, err)
@@ -219,6 +255,7 @@ func (api *SubscriptionsHandler) HandleGetSubscribers(w http.ResponseWriter, r *
 //
 //	@Param			Cookie	header	string	true	"session_id=some_session"
 //	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			userId	query	uint	false	"User ID, if empty - get authorized user friends"
 //
 //	@Produce		json
 //	@Success		200	{object}	json.JSONResponse{body=subscriptions.GetFriendsResponse}
@@ -229,14 +266,21 @@ func (api *SubscriptionsHandler) HandleGetSubscribers(w http.ResponseWriter, r *
 //	@Failure		500	{object}	errors.HTTPError
 //	@Router			/subscriptions/friends/ [get]
 func (api *SubscriptionsHandler) HandleGetFriends(w http.ResponseWriter, r *http.Request) {
-	userID, err := requestcontext.GetUserID(r.Context())
+	viewerID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	userID, err := targetUserID(r, viewerID)
 	if err != nil {
 		json.ServeJSONError(r.Context(), w, err)
 		return
 	}
 
 	friends, err := api.UserService.GetFriends(r.Context(), &uspb.GetFriendsRequest{
-		UserId: uint64(userID),
+		UserId:   uint64(userID),
+		ViewerId: uint64(viewerID),
 	})
 	if err != nil {
 		json.ServeJSONError(r.Context(), w, err)
@@ -247,3 +291,23 @@ func (api *SubscriptionsHandler) HandleGetFriends(w http.ResponseWriter, r *http
 		"friends": uspb.ToSubscriptions(friends.Friends),
 	}, http.StatusOK)
 }
+
+// targetUserID returns the user from the userId query parameter or the viewer
+// when it is empty.
+func targetUserID(r *http.Request, viewerID uint) (userID uint, err error) {
+	userIDData := r.URL.Query().Get(UserIDQueryParam)
+	if userIDData == "" {
+		userID = viewerID
+		return
+	}
+
+	parsedUserID, err := strconv.ParseUint(userIDData, 10, 0)
+	if err != nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	userID = uint(parsedUserID)
+
+	return
+}
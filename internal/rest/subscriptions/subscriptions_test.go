@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"socio/domain"
 	"socio/errors"
 	"socio/pkg/requestcontext"
 	"testing"
 
 	uspb "socio/internal/grpc/user/proto"
 	mock_user "socio/mocks/grpc/user_grpc"
+	mock_rest "socio/mocks/rest/subscriptions"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -27,7 +29,7 @@ func TestHandleSubscription(t *testing.T) {
 		body           *SubscriptionInput
 		mockError      error
 		expectedStatus int
-		mock           func(userClient *mock_user.MockUserClient)
+		mock           func(userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier)
 	}{
 		{
 			name:           "Successful subscription",
@@ -35,13 +37,14 @@ func TestHandleSubscription(t *testing.T) {
 			body:           &SubscriptionInput{SubscribedToID: 2},
 			mockError:      nil,
 			expectedStatus: http.StatusCreated,
-			mock: func(userClient *mock_user.MockUserClient) {
+			mock: func(userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 				userClient.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(&uspb.SubscribeResponse{
 					Subscription: &uspb.SubscriptionResponse{
 						SubscriberId:   1,
 						SubscribedToId: 2,
 					},
 				}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), domain.SubscriptionNotification, uint(1), uint(2)).Return(nil, nil)
 			},
 		},
 		{
@@ -50,7 +53,7 @@ func TestHandleSubscription(t *testing.T) {
 			body:           &SubscriptionInput{SubscribedToID: 2},
 			mockError:      nil,
 			expectedStatus: http.StatusBadRequest,
-			mock: func(userClient *mock_user.MockUserClient) {
+			mock: func(userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 
 			},
 		},
@@ -60,7 +63,7 @@ func TestHandleSubscription(t *testing.T) {
 			body:           &SubscriptionInput{SubscribedToID: 2},
 			mockError:      nil,
 			expectedStatus: http.StatusInternalServerError,
-			mock: func(userClient *mock_user.MockUserClient) {
+			mock: func(userClient *mock_user.MockUserClient, notifier *mock_rest.MockNotifier) {
 				userClient.EXPECT().Subscribe(gomock.Any(), gomock.Any()).Return(
 					nil, errors.ErrInternal.GRPCStatus().Err(),
 				)
@@ -80,10 +83,11 @@ func TestHandleSubscription(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			mockUserClient := mock_user.NewMockUserClient(ctrl)
-			tt.mock(mockUserClient)
+			mockNotifier := mock_rest.NewMockNotifier(ctrl)
+			tt.mock(mockUserClient, mockNotifier)
 
 			// Set up the handler
-			h := NewSubscriptionsHandler(mockUserClient)
+			h := NewSubscriptionsHandler(mockUserClient, mockNotifier)
 
 			// Call the handler
 			h.HandleSubscription(rr, r)
@@ -154,7 +158,7 @@ func TestHandleUnsubscription(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewSubscriptionsHandler(mockUserClient)
+			h := NewSubscriptionsHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleUnsubscription(rr, r)
@@ -172,6 +176,7 @@ func TestHandleGetSubscriptions(t *testing.T) {
 	tests := []struct {
 		name           string
 		ctx            context.Context
+		query          string
 		mockError      error
 		expectedStatus int
 		mock           func(userClient *mock_user.MockUserClient)
@@ -185,6 +190,37 @@ func TestHandleGetSubscriptions(t *testing.T) {
 				userClient.EXPECT().GetSubscriptions(gomock.Any(), gomock.Any()).Return(&uspb.GetSubscriptionsResponse{}, nil)
 			},
 		},
+		{
+			name:           "Successful get subscriptions of other user",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:          "?userId=2",
+			mockError:      nil,
+			expectedStatus: http.StatusOK,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptions(gomock.Any(), &uspb.GetSubscriptionsRequest{UserId: 2, ViewerId: 1}).Return(&uspb.GetSubscriptionsResponse{}, nil)
+			},
+		},
+		{
+			name:           "Invalid user id",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:          "?userId=abc",
+			mockError:      nil,
+			expectedStatus: http.StatusBadRequest,
+			mock: func(userClient *mock_user.MockUserClient) {
+			},
+		},
+		{
+			name:           "Hidden by privacy settings",
+			ctx:            context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1)),
+			query:          "?userId=2",
+			mockError:      nil,
+			expectedStatus: http.StatusForbidden,
+			mock: func(userClient *mock_user.MockUserClient) {
+				userClient.EXPECT().GetSubscriptions(gomock.Any(), gomock.Any()).Return(
+					nil, errors.ErrForbidden.GRPCStatus().Err(),
+				)
+			},
+		},
 		{
 			name:           "Successful get subscriptions",
 			ctx:            context.Background(),
@@ -209,7 +245,7 @@ func TestHandleGetSubscriptions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Set up the request
-			r := httptest.NewRequest("GET", "/", nil)
+			r := httptest.NewRequest("GET", "/"+tt.query, nil)
 			r = r.WithContext(tt.ctx)
 
 			// Set up the response recorder
@@ -219,7 +255,7 @@ func TestHandleGetSubscriptions(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewSubscriptionsHandler(mockUserClient)
+			h := NewSubscriptionsHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleGetSubscriptions(rr, r)
@@ -284,7 +320,7 @@ func TestHandleGetSubscribers(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewSubscriptionsHandler(mockUserClient)
+			h := NewSubscriptionsHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleGetSubscribers(rr, r)
@@ -349,7 +385,7 @@ func TestHandleGetFriends(t *testing.T) {
 			tt.mock(mockUserClient)
 
 			// Set up the handler
-			h := NewSubscriptionsHandler(mockUserClient)
+			h := NewSubscriptionsHandler(mockUserClient, nil)
 
 			// Call the handler
 			h.HandleGetFriends(rr, r)
@@ -0,0 +1,382 @@
+package uploaders
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"socio/domain"
+	"socio/errors"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/pkg/json"
+	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
+	"socio/usecase/uploads"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// The resumable uploads follow the core of the tus protocol, see
+// https://tus.io/protocols/resumable-upload, with the SHA-256 checksums.
+const (
+	TusResumableHeader   = "Tus-Resumable"
+	TusVersion           = "1.0.0"
+	UploadOffsetHeader   = "Upload-Offset"
+	UploadLengthHeader   = "Upload-Length"
+	UploadMetadataHeader = "Upload-Metadata"
+	UploadChecksumHeader = "Upload-Checksum"
+	OffsetContentType    = "application/offset+octet-stream"
+
+	UploadIDVar = "uploadID"
+)
+
+type UploadsService interface {
+	CreateUpload(ctx context.Context, userID uint, input uploads.UploadInput) (newUpload *domain.Upload, err error)
+	GetUpload(ctx context.Context, userID uint, uploadID string) (userUpload *domain.Upload, err error)
+	WriteChunk(ctx context.Context, userID uint, uploadID string, offset int64, r io.Reader, chunkChecksum []byte) (userUpload *domain.Upload, err error)
+	OpenFile(userUpload *domain.Upload) (file *os.File, err error)
+	CompleteUpload(ctx context.Context, userUpload *domain.Upload, fileName string) (err error)
+	DeleteUpload(ctx context.Context, userID uint, uploadID string) (err error)
+}
+
+type ResumableUploadsHandler struct {
+	Service           UploadsService
+	PostsClient       postpb.PostClient
+	UserClient        uspb.UserClient
+	PublicGroupClient pgpb.PublicGroupClient
+}
+
+func NewResumableUploadsHandler(service UploadsService, postsClient postpb.PostClient, userClient uspb.UserClient, publicGroupClient pgpb.PublicGroupClient) (handler *ResumableUploadsHandler) {
+	handler = &ResumableUploadsHandler{
+		Service:           service,
+		PostsClient:       postsClient,
+		UserClient:        userClient,
+		PublicGroupClient: publicGroupClient,
+	}
+	return
+}
+
+// parseUploadMetadata parses the comma separated pairs of keys and base64
+// encoded values, the value may be omitted.
+func parseUploadMetadata(header string) (metadata map[string]string, err error) {
+	metadata = make(map[string]string)
+
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, encodedValue, _ := strings.Cut(pair, " ")
+
+		var value []byte
+
+		value, err = base64.StdEncoding.DecodeString(encodedValue)
+		if err != nil {
+			metadata = nil
+			err = errors.ErrInvalidData
+			return
+		}
+
+		metadata[key] = string(value)
+	}
+
+	return
+}
+
+// parseUploadChecksum parses the "sha256 <base64 encoded sum>" header, the
+// chunk is not verified if the header is empty.
+func parseUploadChecksum(header string) (sum []byte, err error) {
+	if header == "" {
+		return
+	}
+
+	algorithm, encodedSum, _ := strings.Cut(header, " ")
+	if algorithm != "sha256" {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	sum, err = base64.StdEncoding.DecodeString(encodedSum)
+	if err != nil {
+		sum = nil
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
+
+func setOffsetHeaders(w http.ResponseWriter, userUpload *domain.Upload) {
+	w.Header().Set(TusResumableHeader, TusVersion)
+	w.Header().Set(UploadOffsetHeader, strconv.FormatInt(userUpload.Offset, 10))
+	w.Header().Set(UploadLengthHeader, strconv.FormatInt(userUpload.Length, 10))
+}
+
+// HandleCreateUpload godoc
+//
+//	@Summary		create resumable upload
+//	@Description	start the upload of a file in chunks, the Location header is the URL of the upload
+//	@Tags			uploads
+//	@license.name	Apache 2.0
+//	@ID				uploads/create
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			Upload-Length	header	int		true	"Size of the whole file in bytes"
+//	@Param			Upload-Metadata	header	string	true	"Base64 encoded filename, checksum (hex encoded SHA-256 of the file) and kind (post_attachment by default, avatar or group_avatar)"
+//
+//	@Produce		json
+//	@Success		201	{object}	json.JSONResponse{body=domain.Upload}
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		429	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/uploads/ [post]
+func (h *ResumableUploadsHandler) HandleCreateUpload(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	length, err := strconv.ParseInt(r.Header.Get(UploadLengthHeader), 10, 64)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	metadata, err := parseUploadMetadata(r.Header.Get(UploadMetadataHeader))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	kind := upload.Kind(metadata["kind"])
+	if kind == "" {
+		kind = upload.PostAttachmentKind
+	}
+
+	newUpload, err := h.Service.CreateUpload(r.Context(), userID, uploads.UploadInput{
+		Kind:         kind,
+		OriginalName: metadata["filename"],
+		Length:       length,
+		Checksum:     strings.ToLower(metadata["checksum"]),
+	})
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	setOffsetHeaders(w, newUpload)
+	w.Header().Set("Location", path.Join(r.URL.Path, newUpload.ID))
+
+	json.ServeJSONBody(r.Context(), w, newUpload, http.StatusCreated)
+}
+
+// HandleGetUploadOffset godoc
+//
+//	@Summary		get offset of resumable upload
+//	@Description	get the offset to continue the upload from in the Upload-Offset header
+//	@Tags			uploads
+//	@license.name	Apache 2.0
+//	@ID				uploads/offset
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			uploadID		path	string	true	"ID of the upload"
+//
+//	@Success		200
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/uploads/{uploadID} [head]
+func (h *ResumableUploadsHandler) HandleGetUploadOffset(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	userUpload, err := h.Service.GetUpload(r.Context(), userID, mux.Vars(r)[UploadIDVar])
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	setOffsetHeaders(w, userUpload)
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleWriteChunk godoc
+//
+//	@Summary		upload chunk
+//	@Description	append the chunk to the upload at Upload-Offset, after the last chunk the file is verified and stored, the response has its metadata
+//	@Tags			uploads
+//	@license.name	Apache 2.0
+//	@ID				uploads/write
+//	@Accept			octet-stream
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			Content-Type	header	string	true	"application/offset+octet-stream"
+//	@Param			Upload-Offset	header	int		true	"Offset of the chunk, the current offset of the upload"
+//	@Param			Upload-Checksum	header	string	false	"sha256 and the base64 encoded SHA-256 of the chunk"
+//	@Param			uploadID		path	string	true	"ID of the upload"
+//
+//	@Produce		json
+//	@Success		200	{object}	json.JSONResponse{body=upload.Metadata}
+//	@Success		204
+//	@Failure		400	{object}	errors.HTTPError
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		409	{object}	errors.HTTPError
+//	@Failure		413	{object}	errors.HTTPError
+//	@Failure		415	{object}	errors.HTTPError
+//	@Failure		422	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/uploads/{uploadID} [patch]
+func (h *ResumableUploadsHandler) HandleWriteChunk(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	if r.Header.Get("Content-Type") != OffsetContentType {
+		json.ServeJSONError(r.Context(), w, errors.ErrUnsupportedMediaType)
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get(UploadOffsetHeader), 10, 64)
+	if err != nil || offset < 0 {
+		json.ServeJSONError(r.Context(), w, errors.ErrInvalidData)
+		return
+	}
+
+	chunkChecksum, err := parseUploadChecksum(r.Header.Get(UploadChecksumHeader))
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	userUpload, err := h.Service.WriteChunk(r.Context(), userID, mux.Vars(r)[UploadIDVar], offset, r.Body, chunkChecksum)
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	setOffsetHeaders(w, userUpload)
+
+	if userUpload.Offset < userUpload.Length {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	meta, err := h.storeUpload(r.Context(), userUpload)
+	if err != nil {
+		json.ServeGRPCStatus(r.Context(), w, err)
+		return
+	}
+
+	json.ServeJSONBody(r.Context(), w, meta, http.StatusOK)
+}
+
+// storeUpload sends the received file to the service that stores the files of
+// its kind. The stored file name differs from the one of the upload if the
+// same file was already stored.
+func (h *ResumableUploadsHandler) storeUpload(ctx context.Context, userUpload *domain.Upload) (*upload.Metadata, error) {
+	kind := upload.Kind(userUpload.Kind)
+
+	file, err := h.Service.OpenFile(userUpload)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
+	}
+	defer file.Close()
+
+	contentType, err := upload.DetectContentType(file)
+	if err == nil {
+		err = upload.CheckContentType(kind, contentType)
+	}
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
+	}
+
+	var meta *upload.Metadata
+
+	switch kind {
+	case upload.PostAttachmentKind:
+		meta, err = StreamPostAttachment(ctx, h.PostsClient, file, userUpload.FileName, contentType, userUpload.OriginalName)
+	case upload.AvatarKind:
+		meta, err = StreamAvatar(ctx, h.UserClient, file, userUpload.FileName, contentType)
+	case upload.GroupAvatarKind:
+		meta, err = StreamPublicGroupAvatar(ctx, h.PublicGroupClient, file, userUpload.FileName, contentType)
+	default:
+		customErr := errors.NewCustomError(errors.ErrInvalidData)
+		err = customErr.GRPCStatus().Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.Service.CompleteUpload(ctx, userUpload, meta.FileName)
+	if err != nil {
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
+	}
+
+	return meta, nil
+}
+
+// HandleDeleteUpload godoc
+//
+//	@Summary		terminate resumable upload
+//	@Description	terminate the upload and delete its received chunks
+//	@Tags			uploads
+//	@license.name	Apache 2.0
+//	@ID				uploads/delete
+//
+//	@Param			Cookie			header	string	true	"session_id=some_session"
+//	@Param			X-CSRF-Token	header	string	true	"CSRF token"
+//	@Param			uploadID		path	string	true	"ID of the upload"
+//
+//	@Success		204
+//	@Failure		401	{object}	errors.HTTPError
+//	@Failure		403	{object}	errors.HTTPError
+//	@Failure		404	{object}	errors.HTTPError
+//	@Failure		500	{object}	errors.HTTPError
+//	@Router			/uploads/{uploadID} [delete]
+func (h *ResumableUploadsHandler) HandleDeleteUpload(w http.ResponseWriter, r *http.Request) {
+	userID, err := requestcontext.GetUserID(r.Context())
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	err = h.Service.DeleteUpload(r.Context(), userID, mux.Vars(r)[UploadIDVar])
+	if err != nil {
+		json.ServeJSONError(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set(TusResumableHeader, TusVersion)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,411 @@
+package uploaders_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"socio/domain"
+	"socio/errors"
+	postpb "socio/internal/grpc/post/proto"
+	pgpb "socio/internal/grpc/public_group/proto"
+	uspb "socio/internal/grpc/user/proto"
+	"socio/internal/rest/uploaders"
+	mock_post "socio/mocks/grpc/post_grpc"
+	mock_public_group "socio/mocks/grpc/public_group_grpc"
+	mock_user "socio/mocks/grpc/user_grpc"
+	mock_uploaders "socio/mocks/rest/uploaders"
+	"socio/pkg/requestcontext"
+	"socio/pkg/upload"
+	"socio/usecase/uploads"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validCtx = context.WithValue(context.Background(), requestcontext.UserIDKey, uint(1))
+	checksum = hex.EncodeToString(make([]byte, sha256.Size))
+)
+
+func b64(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func TestHandleCreateUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_uploaders.NewMockUploadsService(ctrl)
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		length       string
+		metadata     string
+		wantCode     int
+		wantLocation string
+		setup        func()
+	}{
+		{
+			name:         "test case 1 - successful creation",
+			ctx:          validCtx,
+			length:       "100",
+			metadata:     "filename " + b64("pic.png") + ",checksum " + b64(checksum),
+			wantCode:     http.StatusCreated,
+			wantLocation: "/uploads/upload",
+			setup: func() {
+				mockService.EXPECT().CreateUpload(gomock.Any(), uint(1), uploads.UploadInput{
+					Kind:         upload.PostAttachmentKind,
+					OriginalName: "pic.png",
+					Length:       100,
+					Checksum:     checksum,
+				}).Return(&domain.Upload{ID: "upload", Length: 100}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - no user ID in context",
+			ctx:      context.Background(),
+			length:   "100",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 3 - invalid length",
+			ctx:      validCtx,
+			length:   "abc",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 4 - invalid metadata",
+			ctx:      validCtx,
+			length:   "100",
+			metadata: "filename !!!",
+			wantCode: http.StatusBadRequest,
+			setup:    func() {},
+		},
+		{
+			name:     "test case 5 - too large file",
+			ctx:      validCtx,
+			length:   "100",
+			metadata: "kind " + b64("post_attachment") + ",checksum " + b64(checksum),
+			wantCode: http.StatusRequestEntityTooLarge,
+			setup: func() {
+				mockService.EXPECT().CreateUpload(gomock.Any(), uint(1), gomock.Any()).Return(nil, errors.ErrFileTooLarge)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := uploaders.NewResumableUploadsHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("POST", "/uploads/", nil)
+			req.Header.Set(uploaders.UploadLengthHeader, tt.length)
+			req.Header.Set(uploaders.UploadMetadataHeader, tt.metadata)
+			req = req.WithContext(tt.ctx)
+
+			rr := httptest.NewRecorder()
+
+			h.HandleCreateUpload(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Equal(t, tt.wantLocation, rr.Header().Get("Location"))
+		})
+	}
+}
+
+func TestHandleGetUploadOffset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_uploaders.NewMockUploadsService(ctrl)
+
+	tests := []struct {
+		name       string
+		wantCode   int
+		wantOffset string
+		setup      func()
+	}{
+		{
+			name:       "test case 1 - successful retrieval",
+			wantCode:   http.StatusOK,
+			wantOffset: "40",
+			setup: func() {
+				mockService.EXPECT().GetUpload(gomock.Any(), uint(1), "upload").Return(&domain.Upload{ID: "upload", Offset: 40, Length: 100}, nil)
+			},
+		},
+		{
+			name:     "test case 2 - not found",
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockService.EXPECT().GetUpload(gomock.Any(), uint(1), "upload").Return(nil, errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := uploaders.NewResumableUploadsHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("HEAD", "/uploads/upload", nil)
+			req = mux.SetURLVars(req.WithContext(validCtx), map[string]string{uploaders.UploadIDVar: "upload"})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleGetUploadOffset(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Equal(t, tt.wantOffset, rr.Header().Get(uploaders.UploadOffsetHeader))
+		})
+	}
+}
+
+func TestHandleWriteChunk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_uploaders.NewMockUploadsService(ctrl)
+	mockPostsClient := mock_post.NewMockPostClient(ctrl)
+	mockStream := mock_post.NewMockPost_UploadClient(ctrl)
+	mockUserClient := mock_user.NewMockUserClient(ctrl)
+	mockUserStream := mock_user.NewMockUser_UploadClient(ctrl)
+	mockPublicGroupClient := mock_public_group.NewMockPublicGroupClient(ctrl)
+	mockPublicGroupStream := mock_public_group.NewMockPublicGroup_UploadClient(ctrl)
+
+	img := new(bytes.Buffer)
+	if err := png.Encode(img, image.NewRGBA(image.Rect(0, 0, 2, 2))); err != nil {
+		t.Fatal(err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "upload")
+	if err := os.WriteFile(filePath, img.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	chunkSum := sha256.Sum256([]byte("chunk"))
+
+	tests := []struct {
+		name          string
+		contentType   string
+		offset        string
+		chunkChecksum string
+		wantCode      int
+		wantOffset    string
+		setup         func()
+	}{
+		{
+			name:          "test case 1 - chunk written",
+			contentType:   uploaders.OffsetContentType,
+			offset:        "0",
+			chunkChecksum: "sha256 " + base64.StdEncoding.EncodeToString(chunkSum[:]),
+			wantCode:      http.StatusNoContent,
+			wantOffset:    "5",
+			setup: func() {
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(0), gomock.Any(), chunkSum[:]).Return(&domain.Upload{ID: "upload", Offset: 5, Length: 100}, nil)
+			},
+		},
+		{
+			name:        "test case 2 - last chunk stores the file",
+			contentType: uploaders.OffsetContentType,
+			offset:      "95",
+			wantCode:    http.StatusOK,
+			wantOffset:  "100",
+			setup: func() {
+				userUpload := &domain.Upload{ID: "upload", Kind: string(upload.PostAttachmentKind), FileName: "pic_uuid.png", OriginalName: "pic.png", Offset: 100, Length: 100}
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(95), gomock.Any(), nil).Return(userUpload, nil)
+				mockService.EXPECT().OpenFile(userUpload).DoAndReturn(func(*domain.Upload) (*os.File, error) {
+					return os.Open(filePath)
+				})
+				mockPostsClient.EXPECT().Upload(gomock.Any()).Return(mockStream, nil)
+				mockStream.EXPECT().Send(&postpb.UploadRequest{
+					FileName:     "pic_uuid.png",
+					Chunk:        img.Bytes(),
+					ContentType:  "image/png",
+					OriginalName: "pic.png",
+				}).Return(nil)
+				mockStream.EXPECT().CloseAndRecv().Return(&postpb.UploadResponse{FileName: "stored.png"}, nil)
+				mockService.EXPECT().CompleteUpload(gomock.Any(), userUpload, "stored.png").Return(nil)
+			},
+		},
+		{
+			name:        "test case 3 - last chunk stores the avatar",
+			contentType: uploaders.OffsetContentType,
+			offset:      "95",
+			wantCode:    http.StatusOK,
+			wantOffset:  "100",
+			setup: func() {
+				userUpload := &domain.Upload{ID: "upload", Kind: string(upload.AvatarKind), FileName: "pic_uuid.png", OriginalName: "pic.png", Offset: 100, Length: 100}
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(95), gomock.Any(), nil).Return(userUpload, nil)
+				mockService.EXPECT().OpenFile(userUpload).DoAndReturn(func(*domain.Upload) (*os.File, error) {
+					return os.Open(filePath)
+				})
+				mockUserClient.EXPECT().Upload(gomock.Any()).Return(mockUserStream, nil)
+				mockUserStream.EXPECT().Send(&uspb.UploadRequest{
+					FileName:    "pic_uuid.png",
+					Chunk:       img.Bytes(),
+					ContentType: "image/png",
+				}).Return(nil)
+				mockUserStream.EXPECT().CloseAndRecv().Return(&uspb.UploadResponse{FileName: "avatar.png"}, nil)
+				mockService.EXPECT().CompleteUpload(gomock.Any(), userUpload, "avatar.png").Return(nil)
+			},
+		},
+		{
+			name:        "test case 4 - last chunk stores the group avatar",
+			contentType: uploaders.OffsetContentType,
+			offset:      "95",
+			wantCode:    http.StatusOK,
+			wantOffset:  "100",
+			setup: func() {
+				userUpload := &domain.Upload{ID: "upload", Kind: string(upload.GroupAvatarKind), FileName: "pic_uuid.png", OriginalName: "pic.png", Offset: 100, Length: 100}
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(95), gomock.Any(), nil).Return(userUpload, nil)
+				mockService.EXPECT().OpenFile(userUpload).DoAndReturn(func(*domain.Upload) (*os.File, error) {
+					return os.Open(filePath)
+				})
+				mockPublicGroupClient.EXPECT().Upload(gomock.Any()).Return(mockPublicGroupStream, nil)
+				mockPublicGroupStream.EXPECT().Send(&pgpb.UploadRequest{
+					FileName:    "pic_uuid.png",
+					Chunk:       img.Bytes(),
+					ContentType: "image/png",
+				}).Return(nil)
+				mockPublicGroupStream.EXPECT().CloseAndRecv().Return(&pgpb.UploadResponse{FileName: "group_avatar.png"}, nil)
+				mockService.EXPECT().CompleteUpload(gomock.Any(), userUpload, "group_avatar.png").Return(nil)
+			},
+		},
+		{
+			name:        "test case 5 - wrong content type",
+			contentType: "application/octet-stream",
+			offset:      "0",
+			wantCode:    http.StatusUnsupportedMediaType,
+			setup:       func() {},
+		},
+		{
+			name:        "test case 6 - invalid offset",
+			contentType: uploaders.OffsetContentType,
+			offset:      "-1",
+			wantCode:    http.StatusBadRequest,
+			setup:       func() {},
+		},
+		{
+			name:          "test case 7 - unsupported checksum algorithm",
+			contentType:   uploaders.OffsetContentType,
+			offset:        "0",
+			chunkChecksum: "md5 " + base64.StdEncoding.EncodeToString([]byte("sum")),
+			wantCode:      http.StatusBadRequest,
+			setup:         func() {},
+		},
+		{
+			name:        "test case 8 - offset mismatch",
+			contentType: uploaders.OffsetContentType,
+			offset:      "10",
+			wantCode:    http.StatusConflict,
+			setup: func() {
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(10), gomock.Any(), nil).Return(nil, errors.ErrUploadOffsetMismatch)
+			},
+		},
+		{
+			name:        "test case 9 - checksum mismatch",
+			contentType: uploaders.OffsetContentType,
+			offset:      "95",
+			wantCode:    http.StatusUnprocessableEntity,
+			setup: func() {
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(95), gomock.Any(), nil).Return(nil, errors.ErrChecksumMismatch)
+			},
+		},
+		{
+			name:        "test case 10 - post service error",
+			contentType: uploaders.OffsetContentType,
+			offset:      "95",
+			wantCode:    http.StatusInternalServerError,
+			wantOffset:  "100",
+			setup: func() {
+				userUpload := &domain.Upload{ID: "upload", Kind: string(upload.PostAttachmentKind), FileName: "pic_uuid.png", Offset: 100, Length: 100}
+				mockService.EXPECT().WriteChunk(gomock.Any(), uint(1), "upload", int64(95), gomock.Any(), nil).Return(userUpload, nil)
+				mockService.EXPECT().OpenFile(userUpload).DoAndReturn(func(*domain.Upload) (*os.File, error) {
+					return os.Open(filePath)
+				})
+				mockPostsClient.EXPECT().Upload(gomock.Any()).Return(nil, errors.ErrInternal.GRPCStatus().Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := uploaders.NewResumableUploadsHandler(mockService, mockPostsClient, mockUserClient, mockPublicGroupClient)
+
+			req := httptest.NewRequest("PATCH", "/uploads/upload", bytes.NewReader([]byte("chunk")))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set(uploaders.UploadOffsetHeader, tt.offset)
+			req.Header.Set(uploaders.UploadChecksumHeader, tt.chunkChecksum)
+			req = mux.SetURLVars(req.WithContext(validCtx), map[string]string{uploaders.UploadIDVar: "upload"})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleWriteChunk(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Equal(t, tt.wantOffset, rr.Header().Get(uploaders.UploadOffsetHeader))
+		})
+	}
+}
+
+func TestHandleDeleteUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_uploaders.NewMockUploadsService(ctrl)
+
+	tests := []struct {
+		name     string
+		wantCode int
+		setup    func()
+	}{
+		{
+			name:     "test case 1 - successful deletion",
+			wantCode: http.StatusNoContent,
+			setup: func() {
+				mockService.EXPECT().DeleteUpload(gomock.Any(), uint(1), "upload").Return(nil)
+			},
+		},
+		{
+			name:     "test case 2 - not found",
+			wantCode: http.StatusNotFound,
+			setup: func() {
+				mockService.EXPECT().DeleteUpload(gomock.Any(), uint(1), "upload").Return(errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			h := uploaders.NewResumableUploadsHandler(mockService, nil, nil, nil)
+
+			req := httptest.NewRequest("DELETE", "/uploads/upload", nil)
+			req = mux.SetURLVars(req.WithContext(validCtx), map[string]string{uploaders.UploadIDVar: "upload"})
+
+			rr := httptest.NewRecorder()
+
+			h.HandleDeleteUpload(rr, req)
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
@@ -1,48 +1,62 @@
 package uploaders
 
 import (
+	"context"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"socio/errors"
 
 	uspb "socio/internal/grpc/user/proto"
 	"socio/pkg/static"
+	"socio/pkg/upload"
 )
 
 const (
 	BatchSize = 1 << 23
 )
 
-func UploadAvatar(r *http.Request, userClient uspb.UserClient, avatarFH *multipart.FileHeader) (string, error) {
+func UploadAvatar(r *http.Request, userClient uspb.UserClient, avatarFH *multipart.FileHeader) (*upload.Metadata, error) {
 	if avatarFH == nil {
-		return "", nil
+		return nil, nil
 	}
 
 	fileName := static.GetUniqueFileName(avatarFH.Filename)
-	contentType := avatarFH.Header.Get("Content-Type")
 
-	stream, err := userClient.Upload(r.Context())
+	contentType, err := upload.CheckFileHeader(upload.AvatarKind, avatarFH)
 	if err != nil {
-		return "", err
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
 	}
 
 	file, err := avatarFH.Open()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer file.Close()
 
+	return StreamAvatar(r.Context(), userClient, file, fileName, contentType)
+}
+
+// StreamAvatar sends the avatar read from src to the user service in chunks of
+// BatchSize.
+func StreamAvatar(ctx context.Context, userClient uspb.UserClient, src io.Reader, fileName string, contentType string) (*upload.Metadata, error) {
+	stream, err := userClient.Upload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	buf := make([]byte, BatchSize)
 	batchNumber := 1
 
 	for {
-		num, err := file.Read(buf)
+		num, err := src.Read(buf)
 		if err == io.EOF {
 			break
 		}
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		chunk := buf[:num]
@@ -54,15 +68,15 @@ func UploadAvatar(r *http.Request, userClient uspb.UserClient, avatarFH *multipa
 		})
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		batchNumber += 1
 	}
 
 	res, err := stream.CloseAndRecv()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return res.FileName, nil
+	return uspb.ToUploadMetadata(res), nil
 }
@@ -1,44 +1,58 @@
 package uploaders
 
 import (
+	"context"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"socio/errors"
 
 	pgpb "socio/internal/grpc/public_group/proto"
 	"socio/pkg/static"
+	"socio/pkg/upload"
 )
 
-func UploadPublicGroupAvatar(r *http.Request, publicGroupClient pgpb.PublicGroupClient, avatarFH *multipart.FileHeader) (string, error) {
+func UploadPublicGroupAvatar(r *http.Request, publicGroupClient pgpb.PublicGroupClient, avatarFH *multipart.FileHeader) (*upload.Metadata, error) {
 	if avatarFH == nil {
-		return "", nil
+		return nil, nil
 	}
 
 	fileName := static.GetUniqueFileName(avatarFH.Filename)
-	contentType := avatarFH.Header.Get("Content-Type")
 
-	stream, err := publicGroupClient.Upload(r.Context())
+	contentType, err := upload.CheckFileHeader(upload.GroupAvatarKind, avatarFH)
 	if err != nil {
-		return "", err
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
 	}
 
 	file, err := avatarFH.Open()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer file.Close()
 
+	return StreamPublicGroupAvatar(r.Context(), publicGroupClient, file, fileName, contentType)
+}
+
+// StreamPublicGroupAvatar sends the avatar read from src to the public group
+// service in chunks of BatchSize.
+func StreamPublicGroupAvatar(ctx context.Context, publicGroupClient pgpb.PublicGroupClient, src io.Reader, fileName string, contentType string) (*upload.Metadata, error) {
+	stream, err := publicGroupClient.Upload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	buf := make([]byte, BatchSize)
 	batchNumber := 1
 
 	for {
-		num, err := file.Read(buf)
+		num, err := src.Read(buf)
 		if err == io.EOF {
 			break
 		}
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		chunk := buf[:num]
@@ -50,15 +64,15 @@ func UploadPublicGroupAvatar(r *http.Request, publicGroupClient pgpb.PublicGroup
 		})
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		batchNumber += 1
 	}
 
 	res, err := stream.CloseAndRecv()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return res.FileName, nil
+	return pgpb.ToUploadMetadata(res), nil
 }
@@ -1,63 +1,78 @@
 package uploaders
 
 import (
+	"context"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"socio/errors"
 	postpb "socio/internal/grpc/post/proto"
 	"socio/pkg/static"
+	"socio/pkg/upload"
 )
 
-func UploadPostAttachment(r *http.Request, postClient postpb.PostClient, fh *multipart.FileHeader) (string, error) {
+func UploadPostAttachment(r *http.Request, postClient postpb.PostClient, fh *multipart.FileHeader) (*upload.Metadata, error) {
 	if fh == nil {
-		return "", errors.ErrInvalidData
+		customErr := errors.NewCustomError(errors.ErrInvalidData)
+		return nil, customErr.GRPCStatus().Err()
 	}
 	fileName := static.GetUniqueFileName(fh.Filename)
-	contentType := fh.Header.Get("Content-Type")
 
-	stream, err := postClient.Upload(r.Context())
+	contentType, err := upload.CheckFileHeader(upload.PostAttachmentKind, fh)
 	if err != nil {
-		return "", err
+		customErr := errors.NewCustomError(err)
+		return nil, customErr.GRPCStatus().Err()
 	}
 
 	file, err := fh.Open()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer file.Close()
 
+	return StreamPostAttachment(r.Context(), postClient, file, fileName, contentType, fh.Filename)
+}
+
+// StreamPostAttachment sends the file read from src to the post service in
+// chunks of BatchSize.
+func StreamPostAttachment(ctx context.Context, postClient postpb.PostClient, src io.Reader, fileName string, contentType string, originalName string) (*upload.Metadata, error) {
+	stream, err := postClient.Upload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	buf := make([]byte, BatchSize)
 	batchNumber := 1
 
 	for {
-		num, err := file.Read(buf)
+		num, err := src.Read(buf)
 		if err == io.EOF {
 			break
 		}
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		chunk := buf[:num]
 
 		err = stream.Send(&postpb.UploadRequest{
-			FileName:    fileName,
-			Chunk:       chunk,
-			ContentType: contentType,
+			FileName:     fileName,
+			Chunk:        chunk,
+			ContentType:  contentType,
+			OriginalName: originalName,
 		})
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		batchNumber += 1
 	}
 
 	res, err := stream.CloseAndRecv()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return res.FileName, nil
+	return postpb.ToUploadMetadata(res), nil
 }
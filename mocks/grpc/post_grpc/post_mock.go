@@ -137,6 +137,26 @@ func (mr *MockPostClientMockRecorder) DeletePost(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostClient)(nil).DeletePost), varargs...)
 }
 
+// GetCommentReplies mocks base method.
+func (m *MockPostClient) GetCommentReplies(ctx context.Context, in *post.GetCommentRepliesRequest, opts ...grpc.CallOption) (*post.GetCommentRepliesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommentReplies", varargs...)
+	ret0, _ := ret[0].(*post.GetCommentRepliesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentReplies indicates an expected call of GetCommentReplies.
+func (mr *MockPostClientMockRecorder) GetCommentReplies(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReplies", reflect.TypeOf((*MockPostClient)(nil).GetCommentReplies), varargs...)
+}
+
 // GetCommentsByPostID mocks base method.
 func (m *MockPostClient) GetCommentsByPostID(ctx context.Context, in *post.GetCommentsByPostIDRequest, opts ...grpc.CallOption) (*post.GetCommentsByPostIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +177,26 @@ func (mr *MockPostClientMockRecorder) GetCommentsByPostID(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPostID", reflect.TypeOf((*MockPostClient)(nil).GetCommentsByPostID), varargs...)
 }
 
+// GetFeed mocks base method.
+func (m *MockPostClient) GetFeed(ctx context.Context, in *post.GetFeedRequest, opts ...grpc.CallOption) (*post.GetFeedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFeed", varargs...)
+	ret0, _ := ret[0].(*post.GetFeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockPostClientMockRecorder) GetFeed(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockPostClient)(nil).GetFeed), varargs...)
+}
+
 // GetGroupPostByPostID mocks base method.
 func (m *MockPostClient) GetGroupPostByPostID(ctx context.Context, in *post.GetGroupPostByPostIDRequest, opts ...grpc.CallOption) (*post.GetGroupPostByPostIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +317,26 @@ func (mr *MockPostClientMockRecorder) GetPostsByGroupSubIDsAndUserSubIDs(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByGroupSubIDsAndUserSubIDs", reflect.TypeOf((*MockPostClient)(nil).GetPostsByGroupSubIDsAndUserSubIDs), varargs...)
 }
 
+// GetPostsByHashtag mocks base method.
+func (m *MockPostClient) GetPostsByHashtag(ctx context.Context, in *post.GetPostsByHashtagRequest, opts ...grpc.CallOption) (*post.GetPostsByHashtagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPostsByHashtag", varargs...)
+	ret0, _ := ret[0].(*post.GetPostsByHashtagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByHashtag indicates an expected call of GetPostsByHashtag.
+func (mr *MockPostClientMockRecorder) GetPostsByHashtag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByHashtag", reflect.TypeOf((*MockPostClient)(nil).GetPostsByHashtag), varargs...)
+}
+
 // GetPostsOfGroup mocks base method.
 func (m *MockPostClient) GetPostsOfGroup(ctx context.Context, in *post.GetPostsOfGroupRequest, opts ...grpc.CallOption) (*post.GetPostsOfGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -297,6 +357,26 @@ func (mr *MockPostClientMockRecorder) GetPostsOfGroup(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsOfGroup", reflect.TypeOf((*MockPostClient)(nil).GetPostsOfGroup), varargs...)
 }
 
+// GetTrendingHashtags mocks base method.
+func (m *MockPostClient) GetTrendingHashtags(ctx context.Context, in *post.GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*post.GetTrendingHashtagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrendingHashtags", varargs...)
+	ret0, _ := ret[0].(*post.GetTrendingHashtagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingHashtags indicates an expected call of GetTrendingHashtags.
+func (mr *MockPostClientMockRecorder) GetTrendingHashtags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingHashtags", reflect.TypeOf((*MockPostClient)(nil).GetTrendingHashtags), varargs...)
+}
+
 // GetUserFriendsPosts mocks base method.
 func (m *MockPostClient) GetUserFriendsPosts(ctx context.Context, in *post.GetUserFriendsPostsRequest, opts ...grpc.CallOption) (*post.GetUserFriendsPostsResponse, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +457,66 @@ func (mr *MockPostClientMockRecorder) LikePost(ctx, in interface{}, opts ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikePost", reflect.TypeOf((*MockPostClient)(nil).LikePost), varargs...)
 }
 
+// RepostPost mocks base method.
+func (m *MockPostClient) RepostPost(ctx context.Context, in *post.RepostPostRequest, opts ...grpc.CallOption) (*post.RepostPostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RepostPost", varargs...)
+	ret0, _ := ret[0].(*post.RepostPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepostPost indicates an expected call of RepostPost.
+func (mr *MockPostClientMockRecorder) RepostPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepostPost", reflect.TypeOf((*MockPostClient)(nil).RepostPost), varargs...)
+}
+
+// Search mocks base method.
+func (m *MockPostClient) Search(ctx context.Context, in *post.SearchRequest, opts ...grpc.CallOption) (*post.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*post.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockPostClientMockRecorder) Search(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockPostClient)(nil).Search), varargs...)
+}
+
+// SearchHashtags mocks base method.
+func (m *MockPostClient) SearchHashtags(ctx context.Context, in *post.SearchHashtagsRequest, opts ...grpc.CallOption) (*post.SearchHashtagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchHashtags", varargs...)
+	ret0, _ := ret[0].(*post.SearchHashtagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHashtags indicates an expected call of SearchHashtags.
+func (mr *MockPostClientMockRecorder) SearchHashtags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHashtags", reflect.TypeOf((*MockPostClient)(nil).SearchHashtags), varargs...)
+}
+
 // UnlikeComment mocks base method.
 func (m *MockPostClient) UnlikeComment(ctx context.Context, in *post.UnlikeCommentRequest, opts ...grpc.CallOption) (*post.UnlikeCommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -712,6 +852,21 @@ func (mr *MockPostServerMockRecorder) DeletePost(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostServer)(nil).DeletePost), arg0, arg1)
 }
 
+// GetCommentReplies mocks base method.
+func (m *MockPostServer) GetCommentReplies(arg0 context.Context, arg1 *post.GetCommentRepliesRequest) (*post.GetCommentRepliesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentReplies", arg0, arg1)
+	ret0, _ := ret[0].(*post.GetCommentRepliesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentReplies indicates an expected call of GetCommentReplies.
+func (mr *MockPostServerMockRecorder) GetCommentReplies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReplies", reflect.TypeOf((*MockPostServer)(nil).GetCommentReplies), arg0, arg1)
+}
+
 // GetCommentsByPostID mocks base method.
 func (m *MockPostServer) GetCommentsByPostID(arg0 context.Context, arg1 *post.GetCommentsByPostIDRequest) (*post.GetCommentsByPostIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -727,6 +882,21 @@ func (mr *MockPostServerMockRecorder) GetCommentsByPostID(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPostID", reflect.TypeOf((*MockPostServer)(nil).GetCommentsByPostID), arg0, arg1)
 }
 
+// GetFeed mocks base method.
+func (m *MockPostServer) GetFeed(arg0 context.Context, arg1 *post.GetFeedRequest) (*post.GetFeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", arg0, arg1)
+	ret0, _ := ret[0].(*post.GetFeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockPostServerMockRecorder) GetFeed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockPostServer)(nil).GetFeed), arg0, arg1)
+}
+
 // GetGroupPostByPostID mocks base method.
 func (m *MockPostServer) GetGroupPostByPostID(arg0 context.Context, arg1 *post.GetGroupPostByPostIDRequest) (*post.GetGroupPostByPostIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -817,6 +987,21 @@ func (mr *MockPostServerMockRecorder) GetPostsByGroupSubIDsAndUserSubIDs(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByGroupSubIDsAndUserSubIDs", reflect.TypeOf((*MockPostServer)(nil).GetPostsByGroupSubIDsAndUserSubIDs), arg0, arg1)
 }
 
+// GetPostsByHashtag mocks base method.
+func (m *MockPostServer) GetPostsByHashtag(arg0 context.Context, arg1 *post.GetPostsByHashtagRequest) (*post.GetPostsByHashtagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByHashtag", arg0, arg1)
+	ret0, _ := ret[0].(*post.GetPostsByHashtagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByHashtag indicates an expected call of GetPostsByHashtag.
+func (mr *MockPostServerMockRecorder) GetPostsByHashtag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByHashtag", reflect.TypeOf((*MockPostServer)(nil).GetPostsByHashtag), arg0, arg1)
+}
+
 // GetPostsOfGroup mocks base method.
 func (m *MockPostServer) GetPostsOfGroup(arg0 context.Context, arg1 *post.GetPostsOfGroupRequest) (*post.GetPostsOfGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -832,6 +1017,21 @@ func (mr *MockPostServerMockRecorder) GetPostsOfGroup(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsOfGroup", reflect.TypeOf((*MockPostServer)(nil).GetPostsOfGroup), arg0, arg1)
 }
 
+// GetTrendingHashtags mocks base method.
+func (m *MockPostServer) GetTrendingHashtags(arg0 context.Context, arg1 *post.GetTrendingHashtagsRequest) (*post.GetTrendingHashtagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingHashtags", arg0, arg1)
+	ret0, _ := ret[0].(*post.GetTrendingHashtagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingHashtags indicates an expected call of GetTrendingHashtags.
+func (mr *MockPostServerMockRecorder) GetTrendingHashtags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingHashtags", reflect.TypeOf((*MockPostServer)(nil).GetTrendingHashtags), arg0, arg1)
+}
+
 // GetUserFriendsPosts mocks base method.
 func (m *MockPostServer) GetUserFriendsPosts(arg0 context.Context, arg1 *post.GetUserFriendsPostsRequest) (*post.GetUserFriendsPostsResponse, error) {
 	m.ctrl.T.Helper()
@@ -892,6 +1092,51 @@ func (mr *MockPostServerMockRecorder) LikePost(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikePost", reflect.TypeOf((*MockPostServer)(nil).LikePost), arg0, arg1)
 }
 
+// RepostPost mocks base method.
+func (m *MockPostServer) RepostPost(arg0 context.Context, arg1 *post.RepostPostRequest) (*post.RepostPostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepostPost", arg0, arg1)
+	ret0, _ := ret[0].(*post.RepostPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepostPost indicates an expected call of RepostPost.
+func (mr *MockPostServerMockRecorder) RepostPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepostPost", reflect.TypeOf((*MockPostServer)(nil).RepostPost), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockPostServer) Search(arg0 context.Context, arg1 *post.SearchRequest) (*post.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*post.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockPostServerMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockPostServer)(nil).Search), arg0, arg1)
+}
+
+// SearchHashtags mocks base method.
+func (m *MockPostServer) SearchHashtags(arg0 context.Context, arg1 *post.SearchHashtagsRequest) (*post.SearchHashtagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchHashtags", arg0, arg1)
+	ret0, _ := ret[0].(*post.SearchHashtagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHashtags indicates an expected call of SearchHashtags.
+func (mr *MockPostServerMockRecorder) SearchHashtags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHashtags", reflect.TypeOf((*MockPostServer)(nil).SearchHashtags), arg0, arg1)
+}
+
 // UnlikeComment mocks base method.
 func (m *MockPostServer) UnlikeComment(arg0 context.Context, arg1 *post.UnlikeCommentRequest) (*post.UnlikeCommentResponse, error) {
 	m.ctrl.T.Helper()
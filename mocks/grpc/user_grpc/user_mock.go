@@ -37,6 +37,66 @@ func (m *MockUserClient) EXPECT() *MockUserClientMockRecorder {
 	return m.recorder
 }
 
+// BanUser mocks base method.
+func (m *MockUserClient) BanUser(ctx context.Context, in *user.BanUserRequest, opts ...grpc.CallOption) (*user.BanUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BanUser", varargs...)
+	ret0, _ := ret[0].(*user.BanUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockUserClientMockRecorder) BanUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockUserClient)(nil).BanUser), varargs...)
+}
+
+// Block mocks base method.
+func (m *MockUserClient) Block(ctx context.Context, in *user.BlockRequest, opts ...grpc.CallOption) (*user.BlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Block", varargs...)
+	ret0, _ := ret[0].(*user.BlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockUserClientMockRecorder) Block(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockUserClient)(nil).Block), varargs...)
+}
+
+// CheckIfEmailIsVerified mocks base method.
+func (m *MockUserClient) CheckIfEmailIsVerified(ctx context.Context, in *user.CheckIfEmailIsVerifiedRequest, opts ...grpc.CallOption) (*user.CheckIfEmailIsVerifiedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckIfEmailIsVerified", varargs...)
+	ret0, _ := ret[0].(*user.CheckIfEmailIsVerifiedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfEmailIsVerified indicates an expected call of CheckIfEmailIsVerified.
+func (mr *MockUserClientMockRecorder) CheckIfEmailIsVerified(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfEmailIsVerified", reflect.TypeOf((*MockUserClient)(nil).CheckIfEmailIsVerified), varargs...)
+}
+
 // CheckIfUserIsAdmin mocks base method.
 func (m *MockUserClient) CheckIfUserIsAdmin(ctx context.Context, in *user.CheckIfUserIsAdminRequest, opts ...grpc.CallOption) (*user.CheckIfUserIsAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +117,46 @@ func (mr *MockUserClientMockRecorder) CheckIfUserIsAdmin(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsAdmin", reflect.TypeOf((*MockUserClient)(nil).CheckIfUserIsAdmin), varargs...)
 }
 
+// CheckIfUserIsBanned mocks base method.
+func (m *MockUserClient) CheckIfUserIsBanned(ctx context.Context, in *user.CheckIfUserIsBannedRequest, opts ...grpc.CallOption) (*user.CheckIfUserIsBannedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckIfUserIsBanned", varargs...)
+	ret0, _ := ret[0].(*user.CheckIfUserIsBannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsBanned indicates an expected call of CheckIfUserIsBanned.
+func (mr *MockUserClientMockRecorder) CheckIfUserIsBanned(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsBanned", reflect.TypeOf((*MockUserClient)(nil).CheckIfUserIsBanned), varargs...)
+}
+
+// CheckIfUserIsModerator mocks base method.
+func (m *MockUserClient) CheckIfUserIsModerator(ctx context.Context, in *user.CheckIfUserIsModeratorRequest, opts ...grpc.CallOption) (*user.CheckIfUserIsModeratorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckIfUserIsModerator", varargs...)
+	ret0, _ := ret[0].(*user.CheckIfUserIsModeratorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsModerator indicates an expected call of CheckIfUserIsModerator.
+func (mr *MockUserClientMockRecorder) CheckIfUserIsModerator(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsModerator", reflect.TypeOf((*MockUserClient)(nil).CheckIfUserIsModerator), varargs...)
+}
+
 // Create mocks base method.
 func (m *MockUserClient) Create(ctx context.Context, in *user.CreateRequest, opts ...grpc.CallOption) (*user.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +257,26 @@ func (mr *MockUserClientMockRecorder) GetAdminsByPublicGroupID(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminsByPublicGroupID", reflect.TypeOf((*MockUserClient)(nil).GetAdminsByPublicGroupID), varargs...)
 }
 
+// GetBlockedUsers mocks base method.
+func (m *MockUserClient) GetBlockedUsers(ctx context.Context, in *user.GetBlockedUsersRequest, opts ...grpc.CallOption) (*user.GetBlockedUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockedUsers", varargs...)
+	ret0, _ := ret[0].(*user.GetBlockedUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockUserClientMockRecorder) GetBlockedUsers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockUserClient)(nil).GetBlockedUsers), varargs...)
+}
+
 // GetByEmail mocks base method.
 func (m *MockUserClient) GetByEmail(ctx context.Context, in *user.GetByEmailRequest, opts ...grpc.CallOption) (*user.GetByEmailResponse, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +357,26 @@ func (mr *MockUserClientMockRecorder) GetFriends(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockUserClient)(nil).GetFriends), varargs...)
 }
 
+// GetPrivacySettings mocks base method.
+func (m *MockUserClient) GetPrivacySettings(ctx context.Context, in *user.GetPrivacySettingsRequest, opts ...grpc.CallOption) (*user.GetPrivacySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPrivacySettings", varargs...)
+	ret0, _ := ret[0].(*user.GetPrivacySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockUserClientMockRecorder) GetPrivacySettings(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockUserClient)(nil).GetPrivacySettings), varargs...)
+}
+
 // GetSubscribers mocks base method.
 func (m *MockUserClient) GetSubscribers(ctx context.Context, in *user.GetSubscribersRequest, opts ...grpc.CallOption) (*user.GetSubscribersResponse, error) {
 	m.ctrl.T.Helper()
@@ -297,6 +437,26 @@ func (mr *MockUserClientMockRecorder) GetSubscriptions(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockUserClient)(nil).GetSubscriptions), varargs...)
 }
 
+// RequestEmailVerification mocks base method.
+func (m *MockUserClient) RequestEmailVerification(ctx context.Context, in *user.RequestEmailVerificationRequest, opts ...grpc.CallOption) (*user.RequestEmailVerificationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestEmailVerification", varargs...)
+	ret0, _ := ret[0].(*user.RequestEmailVerificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestEmailVerification indicates an expected call of RequestEmailVerification.
+func (mr *MockUserClientMockRecorder) RequestEmailVerification(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailVerification", reflect.TypeOf((*MockUserClient)(nil).RequestEmailVerification), varargs...)
+}
+
 // SearchByName mocks base method.
 func (m *MockUserClient) SearchByName(ctx context.Context, in *user.SearchByNameRequest, opts ...grpc.CallOption) (*user.SearchByNameResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +497,26 @@ func (mr *MockUserClientMockRecorder) Subscribe(ctx, in interface{}, opts ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserClient)(nil).Subscribe), varargs...)
 }
 
+// Unblock mocks base method.
+func (m *MockUserClient) Unblock(ctx context.Context, in *user.UnblockRequest, opts ...grpc.CallOption) (*user.UnblockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unblock", varargs...)
+	ret0, _ := ret[0].(*user.UnblockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockUserClientMockRecorder) Unblock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockUserClient)(nil).Unblock), varargs...)
+}
+
 // Unsubscribe mocks base method.
 func (m *MockUserClient) Unsubscribe(ctx context.Context, in *user.UnsubscribeRequest, opts ...grpc.CallOption) (*user.UnsubscribeResponse, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +557,26 @@ func (mr *MockUserClientMockRecorder) Update(ctx, in interface{}, opts ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserClient)(nil).Update), varargs...)
 }
 
+// UpdatePrivacySettings mocks base method.
+func (m *MockUserClient) UpdatePrivacySettings(ctx context.Context, in *user.UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*user.UpdatePrivacySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePrivacySettings", varargs...)
+	ret0, _ := ret[0].(*user.UpdatePrivacySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePrivacySettings indicates an expected call of UpdatePrivacySettings.
+func (mr *MockUserClientMockRecorder) UpdatePrivacySettings(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySettings", reflect.TypeOf((*MockUserClient)(nil).UpdatePrivacySettings), varargs...)
+}
+
 // Upload mocks base method.
 func (m *MockUserClient) Upload(ctx context.Context, opts ...grpc.CallOption) (user.User_UploadClient, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +597,26 @@ func (mr *MockUserClientMockRecorder) Upload(ctx interface{}, opts ...interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUserClient)(nil).Upload), varargs...)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUserClient) VerifyEmail(ctx context.Context, in *user.VerifyEmailRequest, opts ...grpc.CallOption) (*user.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(*user.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserClientMockRecorder) VerifyEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserClient)(nil).VerifyEmail), varargs...)
+}
+
 // MockUser_UploadClient is a mock of User_UploadClient interface.
 type MockUser_UploadClient struct {
 	ctrl     *gomock.Controller
@@ -557,6 +777,51 @@ func (m *MockUserServer) EXPECT() *MockUserServerMockRecorder {
 	return m.recorder
 }
 
+// BanUser mocks base method.
+func (m *MockUserServer) BanUser(arg0 context.Context, arg1 *user.BanUserRequest) (*user.BanUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", arg0, arg1)
+	ret0, _ := ret[0].(*user.BanUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockUserServerMockRecorder) BanUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockUserServer)(nil).BanUser), arg0, arg1)
+}
+
+// Block mocks base method.
+func (m *MockUserServer) Block(arg0 context.Context, arg1 *user.BlockRequest) (*user.BlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", arg0, arg1)
+	ret0, _ := ret[0].(*user.BlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockUserServerMockRecorder) Block(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockUserServer)(nil).Block), arg0, arg1)
+}
+
+// CheckIfEmailIsVerified mocks base method.
+func (m *MockUserServer) CheckIfEmailIsVerified(arg0 context.Context, arg1 *user.CheckIfEmailIsVerifiedRequest) (*user.CheckIfEmailIsVerifiedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfEmailIsVerified", arg0, arg1)
+	ret0, _ := ret[0].(*user.CheckIfEmailIsVerifiedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfEmailIsVerified indicates an expected call of CheckIfEmailIsVerified.
+func (mr *MockUserServerMockRecorder) CheckIfEmailIsVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfEmailIsVerified", reflect.TypeOf((*MockUserServer)(nil).CheckIfEmailIsVerified), arg0, arg1)
+}
+
 // CheckIfUserIsAdmin mocks base method.
 func (m *MockUserServer) CheckIfUserIsAdmin(arg0 context.Context, arg1 *user.CheckIfUserIsAdminRequest) (*user.CheckIfUserIsAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -572,6 +837,36 @@ func (mr *MockUserServerMockRecorder) CheckIfUserIsAdmin(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsAdmin", reflect.TypeOf((*MockUserServer)(nil).CheckIfUserIsAdmin), arg0, arg1)
 }
 
+// CheckIfUserIsBanned mocks base method.
+func (m *MockUserServer) CheckIfUserIsBanned(arg0 context.Context, arg1 *user.CheckIfUserIsBannedRequest) (*user.CheckIfUserIsBannedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfUserIsBanned", arg0, arg1)
+	ret0, _ := ret[0].(*user.CheckIfUserIsBannedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsBanned indicates an expected call of CheckIfUserIsBanned.
+func (mr *MockUserServerMockRecorder) CheckIfUserIsBanned(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsBanned", reflect.TypeOf((*MockUserServer)(nil).CheckIfUserIsBanned), arg0, arg1)
+}
+
+// CheckIfUserIsModerator mocks base method.
+func (m *MockUserServer) CheckIfUserIsModerator(arg0 context.Context, arg1 *user.CheckIfUserIsModeratorRequest) (*user.CheckIfUserIsModeratorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfUserIsModerator", arg0, arg1)
+	ret0, _ := ret[0].(*user.CheckIfUserIsModeratorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsModerator indicates an expected call of CheckIfUserIsModerator.
+func (mr *MockUserServerMockRecorder) CheckIfUserIsModerator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsModerator", reflect.TypeOf((*MockUserServer)(nil).CheckIfUserIsModerator), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockUserServer) Create(arg0 context.Context, arg1 *user.CreateRequest) (*user.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -647,6 +942,21 @@ func (mr *MockUserServerMockRecorder) GetAdminsByPublicGroupID(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminsByPublicGroupID", reflect.TypeOf((*MockUserServer)(nil).GetAdminsByPublicGroupID), arg0, arg1)
 }
 
+// GetBlockedUsers mocks base method.
+func (m *MockUserServer) GetBlockedUsers(arg0 context.Context, arg1 *user.GetBlockedUsersRequest) (*user.GetBlockedUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", arg0, arg1)
+	ret0, _ := ret[0].(*user.GetBlockedUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockUserServerMockRecorder) GetBlockedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockUserServer)(nil).GetBlockedUsers), arg0, arg1)
+}
+
 // GetByEmail mocks base method.
 func (m *MockUserServer) GetByEmail(arg0 context.Context, arg1 *user.GetByEmailRequest) (*user.GetByEmailResponse, error) {
 	m.ctrl.T.Helper()
@@ -707,6 +1017,21 @@ func (mr *MockUserServerMockRecorder) GetFriends(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockUserServer)(nil).GetFriends), arg0, arg1)
 }
 
+// GetPrivacySettings mocks base method.
+func (m *MockUserServer) GetPrivacySettings(arg0 context.Context, arg1 *user.GetPrivacySettingsRequest) (*user.GetPrivacySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", arg0, arg1)
+	ret0, _ := ret[0].(*user.GetPrivacySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockUserServerMockRecorder) GetPrivacySettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockUserServer)(nil).GetPrivacySettings), arg0, arg1)
+}
+
 // GetSubscribers mocks base method.
 func (m *MockUserServer) GetSubscribers(arg0 context.Context, arg1 *user.GetSubscribersRequest) (*user.GetSubscribersResponse, error) {
 	m.ctrl.T.Helper()
@@ -752,6 +1077,21 @@ func (mr *MockUserServerMockRecorder) GetSubscriptions(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockUserServer)(nil).GetSubscriptions), arg0, arg1)
 }
 
+// RequestEmailVerification mocks base method.
+func (m *MockUserServer) RequestEmailVerification(arg0 context.Context, arg1 *user.RequestEmailVerificationRequest) (*user.RequestEmailVerificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailVerification", arg0, arg1)
+	ret0, _ := ret[0].(*user.RequestEmailVerificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestEmailVerification indicates an expected call of RequestEmailVerification.
+func (mr *MockUserServerMockRecorder) RequestEmailVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailVerification", reflect.TypeOf((*MockUserServer)(nil).RequestEmailVerification), arg0, arg1)
+}
+
 // SearchByName mocks base method.
 func (m *MockUserServer) SearchByName(arg0 context.Context, arg1 *user.SearchByNameRequest) (*user.SearchByNameResponse, error) {
 	m.ctrl.T.Helper()
@@ -782,6 +1122,21 @@ func (mr *MockUserServerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserServer)(nil).Subscribe), arg0, arg1)
 }
 
+// Unblock mocks base method.
+func (m *MockUserServer) Unblock(arg0 context.Context, arg1 *user.UnblockRequest) (*user.UnblockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", arg0, arg1)
+	ret0, _ := ret[0].(*user.UnblockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockUserServerMockRecorder) Unblock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockUserServer)(nil).Unblock), arg0, arg1)
+}
+
 // Unsubscribe mocks base method.
 func (m *MockUserServer) Unsubscribe(arg0 context.Context, arg1 *user.UnsubscribeRequest) (*user.UnsubscribeResponse, error) {
 	m.ctrl.T.Helper()
@@ -812,6 +1167,21 @@ func (mr *MockUserServerMockRecorder) Update(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserServer)(nil).Update), arg0, arg1)
 }
 
+// UpdatePrivacySettings mocks base method.
+func (m *MockUserServer) UpdatePrivacySettings(arg0 context.Context, arg1 *user.UpdatePrivacySettingsRequest) (*user.UpdatePrivacySettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacySettings", arg0, arg1)
+	ret0, _ := ret[0].(*user.UpdatePrivacySettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePrivacySettings indicates an expected call of UpdatePrivacySettings.
+func (mr *MockUserServerMockRecorder) UpdatePrivacySettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySettings", reflect.TypeOf((*MockUserServer)(nil).UpdatePrivacySettings), arg0, arg1)
+}
+
 // Upload mocks base method.
 func (m *MockUserServer) Upload(arg0 user.User_UploadServer) error {
 	m.ctrl.T.Helper()
@@ -826,6 +1196,21 @@ func (mr *MockUserServerMockRecorder) Upload(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUserServer)(nil).Upload), arg0)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUserServer) VerifyEmail(arg0 context.Context, arg1 *user.VerifyEmailRequest) (*user.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(*user.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserServerMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserServer)(nil).VerifyEmail), arg0, arg1)
+}
+
 // mustEmbedUnimplementedUserServer mocks base method.
 func (m *MockUserServer) mustEmbedUnimplementedUserServer() {
 	m.ctrl.T.Helper()
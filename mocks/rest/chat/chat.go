@@ -14,6 +14,58 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockAttachmentURLService is a mock of AttachmentURLService interface.
+type MockAttachmentURLService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentURLServiceMockRecorder
+}
+
+// MockAttachmentURLServiceMockRecorder is the mock recorder for MockAttachmentURLService.
+type MockAttachmentURLServiceMockRecorder struct {
+	mock *MockAttachmentURLService
+}
+
+// NewMockAttachmentURLService creates a new mock instance.
+func NewMockAttachmentURLService(ctrl *gomock.Controller) *MockAttachmentURLService {
+	mock := &MockAttachmentURLService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentURLServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentURLService) EXPECT() *MockAttachmentURLServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAttachmentURL mocks base method.
+func (m *MockAttachmentURLService) GetAttachmentURL(ctx context.Context, userID, messageID uint, fileName string) (*domain.AttachmentURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentURL", ctx, userID, messageID, fileName)
+	ret0, _ := ret[0].(*domain.AttachmentURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentURL indicates an expected call of GetAttachmentURL.
+func (mr *MockAttachmentURLServiceMockRecorder) GetAttachmentURL(ctx, userID, messageID, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentURL", reflect.TypeOf((*MockAttachmentURLService)(nil).GetAttachmentURL), ctx, userID, messageID, fileName)
+}
+
+// SignMessages mocks base method.
+func (m *MockAttachmentURLService) SignMessages(ctx context.Context, userID uint, messages []*domain.PersonalMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessages", ctx, userID, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignMessages indicates an expected call of SignMessages.
+func (mr *MockAttachmentURLServiceMockRecorder) SignMessages(ctx, userID, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessages", reflect.TypeOf((*MockAttachmentURLService)(nil).SignMessages), ctx, userID, messages)
+}
+
 // MockChatService is a mock of ChatService interface.
 type MockChatService struct {
 	ctrl     *gomock.Controller
@@ -139,6 +191,36 @@ func (mr *MockChatServiceMockRecorder) GetClient(ctx, userID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockChatService)(nil).GetClient), ctx, userID)
 }
 
+// GetConversation mocks base method.
+func (m *MockChatService) GetConversation(ctx context.Context, conversationID, userID uint) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversation", ctx, conversationID, userID)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversation indicates an expected call of GetConversation.
+func (mr *MockChatServiceMockRecorder) GetConversation(ctx, conversationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversation", reflect.TypeOf((*MockChatService)(nil).GetConversation), ctx, conversationID, userID)
+}
+
+// GetConversationsByUserID mocks base method.
+func (m *MockChatService) GetConversationsByUserID(ctx context.Context, userID uint) ([]*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationsByUserID indicates an expected call of GetConversationsByUserID.
+func (mr *MockChatServiceMockRecorder) GetConversationsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationsByUserID", reflect.TypeOf((*MockChatService)(nil).GetConversationsByUserID), ctx, userID)
+}
+
 // GetDialogsByUserID mocks base method.
 func (m *MockChatService) GetDialogsByUserID(ctx context.Context, userID uint) ([]*domain.Dialog, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +236,21 @@ func (mr *MockChatServiceMockRecorder) GetDialogsByUserID(ctx, userID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDialogsByUserID", reflect.TypeOf((*MockChatService)(nil).GetDialogsByUserID), ctx, userID)
 }
 
+// GetMessagesByConversation mocks base method.
+func (m *MockChatService) GetMessagesByConversation(ctx context.Context, userID, conversationID, lastMessageID, messagesAmount uint) ([]*domain.PersonalMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByConversation", ctx, userID, conversationID, lastMessageID, messagesAmount)
+	ret0, _ := ret[0].([]*domain.PersonalMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByConversation indicates an expected call of GetMessagesByConversation.
+func (mr *MockChatServiceMockRecorder) GetMessagesByConversation(ctx, userID, conversationID, lastMessageID, messagesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByConversation", reflect.TypeOf((*MockChatService)(nil).GetMessagesByConversation), ctx, userID, conversationID, lastMessageID, messagesAmount)
+}
+
 // GetMessagesByDialog mocks base method.
 func (m *MockChatService) GetMessagesByDialog(ctx context.Context, userID, peerID, lastMessageID, messagesAmount uint) ([]*domain.PersonalMessage, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +266,36 @@ func (mr *MockChatServiceMockRecorder) GetMessagesByDialog(ctx, userID, peerID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByDialog", reflect.TypeOf((*MockChatService)(nil).GetMessagesByDialog), ctx, userID, peerID, lastMessageID, messagesAmount)
 }
 
+// GetMissedActions mocks base method.
+func (m *MockChatService) GetMissedActions(ctx context.Context, userID uint, lastEventID string) ([]*chat.Action, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMissedActions", ctx, userID, lastEventID)
+	ret0, _ := ret[0].([]*chat.Action)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMissedActions indicates an expected call of GetMissedActions.
+func (mr *MockChatServiceMockRecorder) GetMissedActions(ctx, userID, lastEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMissedActions", reflect.TypeOf((*MockChatService)(nil).GetMissedActions), ctx, userID, lastEventID)
+}
+
+// GetPresences mocks base method.
+func (m *MockChatService) GetPresences(ctx context.Context, userIDs []uint) ([]*domain.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresences", ctx, userIDs)
+	ret0, _ := ret[0].([]*domain.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresences indicates an expected call of GetPresences.
+func (mr *MockChatServiceMockRecorder) GetPresences(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresences", reflect.TypeOf((*MockChatService)(nil).GetPresences), ctx, userIDs)
+}
+
 // GetStickersByAuthorID mocks base method.
 func (m *MockChatService) GetStickersByAuthorID(ctx context.Context, authorID uint) ([]*domain.Sticker, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +326,20 @@ func (mr *MockChatServiceMockRecorder) GetUnsentMessageAttachments(ctx, attach i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsentMessageAttachments", reflect.TypeOf((*MockChatService)(nil).GetUnsentMessageAttachments), ctx, attach)
 }
 
+// Heartbeat mocks base method.
+func (m *MockChatService) Heartbeat(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockChatServiceMockRecorder) Heartbeat(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockChatService)(nil).Heartbeat), ctx, userID)
+}
+
 // Register mocks base method.
 func (m *MockChatService) Register(ctx context.Context, userID uint) (*chat.Client, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +355,20 @@ func (mr *MockChatServiceMockRecorder) Register(ctx, userID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockChatService)(nil).Register), ctx, userID)
 }
 
+// SetOffline mocks base method.
+func (m *MockChatService) SetOffline(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOffline", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOffline indicates an expected call of SetOffline.
+func (mr *MockChatServiceMockRecorder) SetOffline(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOffline", reflect.TypeOf((*MockChatService)(nil).SetOffline), ctx, userID)
+}
+
 // Unregister mocks base method.
 func (m *MockChatService) Unregister(userID uint) error {
 	m.ctrl.T.Helper()
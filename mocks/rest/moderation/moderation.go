@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/rest/moderation/moderation.go
+
+// Package mock_rest is a generated GoMock package.
+package mock_rest
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModerationService is a mock of ModerationService interface.
+type MockModerationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationServiceMockRecorder
+}
+
+// MockModerationServiceMockRecorder is the mock recorder for MockModerationService.
+type MockModerationServiceMockRecorder struct {
+	mock *MockModerationService
+}
+
+// NewMockModerationService creates a new mock instance.
+func NewMockModerationService(ctrl *gomock.Controller) *MockModerationService {
+	mock := &MockModerationService{ctrl: ctrl}
+	mock.recorder = &MockModerationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationService) EXPECT() *MockModerationServiceMockRecorder {
+	return m.recorder
+}
+
+// DismissReportCase mocks base method.
+func (m *MockModerationService) DismissReportCase(ctx context.Context, moderatorID, caseID uint, reason string) (*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissReportCase", ctx, moderatorID, caseID, reason)
+	ret0, _ := ret[0].(*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DismissReportCase indicates an expected call of DismissReportCase.
+func (mr *MockModerationServiceMockRecorder) DismissReportCase(ctx, moderatorID, caseID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissReportCase", reflect.TypeOf((*MockModerationService)(nil).DismissReportCase), ctx, moderatorID, caseID, reason)
+}
+
+// GetModerationLog mocks base method.
+func (m *MockModerationService) GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) ([]*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationLog", ctx, lastEntryID, entriesAmount)
+	ret0, _ := ret[0].([]*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationLog indicates an expected call of GetModerationLog.
+func (mr *MockModerationServiceMockRecorder) GetModerationLog(ctx, lastEntryID, entriesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationLog", reflect.TypeOf((*MockModerationService)(nil).GetModerationLog), ctx, lastEntryID, entriesAmount)
+}
+
+// GetReportCases mocks base method.
+func (m *MockModerationService) GetReportCases(ctx context.Context, lastCaseID, casesAmount uint) ([]*domain.ReportCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportCases", ctx, lastCaseID, casesAmount)
+	ret0, _ := ret[0].([]*domain.ReportCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportCases indicates an expected call of GetReportCases.
+func (mr *MockModerationServiceMockRecorder) GetReportCases(ctx, lastCaseID, casesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportCases", reflect.TypeOf((*MockModerationService)(nil).GetReportCases), ctx, lastCaseID, casesAmount)
+}
+
+// HideContent mocks base method.
+func (m *MockModerationService) HideContent(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideContent", ctx, entry)
+	ret0, _ := ret[0].(*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideContent indicates an expected call of HideContent.
+func (mr *MockModerationServiceMockRecorder) HideContent(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideContent", reflect.TypeOf((*MockModerationService)(nil).HideContent), ctx, entry)
+}
+
+// RecordAction mocks base method.
+func (m *MockModerationService) RecordAction(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAction", ctx, entry)
+	ret0, _ := ret[0].(*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordAction indicates an expected call of RecordAction.
+func (mr *MockModerationServiceMockRecorder) RecordAction(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAction", reflect.TypeOf((*MockModerationService)(nil).RecordAction), ctx, entry)
+}
+
+// Report mocks base method.
+func (m *MockModerationService) Report(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, report)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockModerationServiceMockRecorder) Report(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockModerationService)(nil).Report), ctx, report)
+}
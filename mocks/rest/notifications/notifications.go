@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/rest/notifications/notifications.go
+
+// Package mock_rest is a generated GoMock package.
+package mock_rest
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationsService is a mock of NotificationsService interface.
+type MockNotificationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationsServiceMockRecorder
+}
+
+// MockNotificationsServiceMockRecorder is the mock recorder for MockNotificationsService.
+type MockNotificationsServiceMockRecorder struct {
+	mock *MockNotificationsService
+}
+
+// NewMockNotificationsService creates a new mock instance.
+func NewMockNotificationsService(ctrl *gomock.Controller) *MockNotificationsService {
+	mock := &MockNotificationsService{ctrl: ctrl}
+	mock.recorder = &MockNotificationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationsService) EXPECT() *MockNotificationsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationsService) GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, lastNotificationID, notificationsAmount)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationsServiceMockRecorder) GetNotifications(ctx, userID, lastNotificationID, notificationsAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationsService)(nil).GetNotifications), ctx, userID, lastNotificationID, notificationsAmount)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationsService) GetUnreadCount(ctx context.Context, userID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationsServiceMockRecorder) GetUnreadCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationsService)(nil).GetUnreadCount), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationsService) MarkRead(ctx context.Context, userID uint, notificationIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, notificationIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationsServiceMockRecorder) MarkRead(ctx, userID, notificationIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationsService)(nil).MarkRead), ctx, userID, notificationIDs)
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/rest/posts/posts.go
+
+// Package mock_rest is a generated GoMock package.
+package mock_rest
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+	upload "socio/pkg/upload"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notificationType, actorID, targetID)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, notificationType, actorID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notificationType, actorID, targetID)
+}
+
+// MockUploads is a mock of Uploads interface.
+type MockUploads struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadsMockRecorder
+}
+
+// MockUploadsMockRecorder is the mock recorder for MockUploads.
+type MockUploadsMockRecorder struct {
+	mock *MockUploads
+}
+
+// NewMockUploads creates a new mock instance.
+func NewMockUploads(ctrl *gomock.Controller) *MockUploads {
+	mock := &MockUploads{ctrl: ctrl}
+	mock.recorder = &MockUploadsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploads) EXPECT() *MockUploadsMockRecorder {
+	return m.recorder
+}
+
+// GetUploadedFileNames mocks base method.
+func (m *MockUploads) GetUploadedFileNames(ctx context.Context, userID uint, kind upload.Kind, uploadIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadedFileNames", ctx, userID, kind, uploadIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadedFileNames indicates an expected call of GetUploadedFileNames.
+func (mr *MockUploadsMockRecorder) GetUploadedFileNames(ctx, userID, kind, uploadIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadedFileNames", reflect.TypeOf((*MockUploads)(nil).GetUploadedFileNames), ctx, userID, kind, uploadIDs)
+}
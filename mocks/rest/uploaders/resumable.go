@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/rest/uploaders/resumable.go
+
+// Package mock_uploaders is a generated GoMock package.
+package mock_uploaders
+
+import (
+	context "context"
+	io "io"
+	os "os"
+	reflect "reflect"
+	domain "socio/domain"
+	uploads "socio/usecase/uploads"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadsService is a mock of UploadsService interface.
+type MockUploadsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadsServiceMockRecorder
+}
+
+// MockUploadsServiceMockRecorder is the mock recorder for MockUploadsService.
+type MockUploadsServiceMockRecorder struct {
+	mock *MockUploadsService
+}
+
+// NewMockUploadsService creates a new mock instance.
+func NewMockUploadsService(ctrl *gomock.Controller) *MockUploadsService {
+	mock := &MockUploadsService{ctrl: ctrl}
+	mock.recorder = &MockUploadsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadsService) EXPECT() *MockUploadsServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteUpload mocks base method.
+func (m *MockUploadsService) CompleteUpload(ctx context.Context, userUpload *domain.Upload, fileName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, userUpload, fileName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockUploadsServiceMockRecorder) CompleteUpload(ctx, userUpload, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUploadsService)(nil).CompleteUpload), ctx, userUpload, fileName)
+}
+
+// CreateUpload mocks base method.
+func (m *MockUploadsService) CreateUpload(ctx context.Context, userID uint, input uploads.UploadInput) (*domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", ctx, userID, input)
+	ret0, _ := ret[0].(*domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockUploadsServiceMockRecorder) CreateUpload(ctx, userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*MockUploadsService)(nil).CreateUpload), ctx, userID, input)
+}
+
+// DeleteUpload mocks base method.
+func (m *MockUploadsService) DeleteUpload(ctx context.Context, userID uint, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", ctx, userID, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *MockUploadsServiceMockRecorder) DeleteUpload(ctx, userID, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*MockUploadsService)(nil).DeleteUpload), ctx, userID, uploadID)
+}
+
+// GetUpload mocks base method.
+func (m *MockUploadsService) GetUpload(ctx context.Context, userID uint, uploadID string) (*domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, userID, uploadID)
+	ret0, _ := ret[0].(*domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockUploadsServiceMockRecorder) GetUpload(ctx, userID, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*MockUploadsService)(nil).GetUpload), ctx, userID, uploadID)
+}
+
+// OpenFile mocks base method.
+func (m *MockUploadsService) OpenFile(userUpload *domain.Upload) (*os.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFile", userUpload)
+	ret0, _ := ret[0].(*os.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFile indicates an expected call of OpenFile.
+func (mr *MockUploadsServiceMockRecorder) OpenFile(userUpload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFile", reflect.TypeOf((*MockUploadsService)(nil).OpenFile), userUpload)
+}
+
+// WriteChunk mocks base method.
+func (m *MockUploadsService) WriteChunk(ctx context.Context, userID uint, uploadID string, offset int64, r io.Reader, chunkChecksum []byte) (*domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteChunk", ctx, userID, uploadID, offset, r, chunkChecksum)
+	ret0, _ := ret[0].(*domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteChunk indicates an expected call of WriteChunk.
+func (mr *MockUploadsServiceMockRecorder) WriteChunk(ctx, userID, uploadID, offset, r, chunkChecksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteChunk", reflect.TypeOf((*MockUploadsService)(nil).WriteChunk), ctx, userID, uploadID, offset, r, chunkChecksum)
+}
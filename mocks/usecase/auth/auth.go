@@ -7,6 +7,8 @@ package mock_auth
 import (
 	context "context"
 	reflect "reflect"
+	domain "socio/domain"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,18 +37,18 @@ func (m *MockSessionStorage) EXPECT() *MockSessionStorageMockRecorder {
 }
 
 // CreateSession mocks base method.
-func (m *MockSessionStorage) CreateSession(ctx context.Context, userID uint) (string, error) {
+func (m *MockSessionStorage) CreateSession(ctx context.Context, session *domain.Session, ttl time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session, ttl)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockSessionStorageMockRecorder) CreateSession(ctx, userID interface{}) *gomock.Call {
+func (mr *MockSessionStorageMockRecorder) CreateSession(ctx, session, ttl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionStorage)(nil).CreateSession), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionStorage)(nil).CreateSession), ctx, session, ttl)
 }
 
 // DeleteSession mocks base method.
@@ -63,17 +65,256 @@ func (mr *MockSessionStorageMockRecorder) DeleteSession(ctx, sessionID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionStorage)(nil).DeleteSession), ctx, sessionID)
 }
 
-// GetUserIDBySession mocks base method.
-func (m *MockSessionStorage) GetUserIDBySession(ctx context.Context, sessionID string) (uint, error) {
+// DeleteSessionByID mocks base method.
+func (m *MockSessionStorage) DeleteSessionByID(ctx context.Context, userID uint, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserIDBySession", ctx, sessionID)
+	ret := m.ctrl.Call(m, "DeleteSessionByID", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionByID indicates an expected call of DeleteSessionByID.
+func (mr *MockSessionStorageMockRecorder) DeleteSessionByID(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionByID", reflect.TypeOf((*MockSessionStorage)(nil).DeleteSessionByID), ctx, userID, id)
+}
+
+// DeleteSessionsByUserID mocks base method.
+func (m *MockSessionStorage) DeleteSessionsByUserID(ctx context.Context, userID uint, exceptSessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByUserID", ctx, userID, exceptSessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionsByUserID indicates an expected call of DeleteSessionsByUserID.
+func (mr *MockSessionStorageMockRecorder) DeleteSessionsByUserID(ctx, userID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByUserID", reflect.TypeOf((*MockSessionStorage)(nil).DeleteSessionsByUserID), ctx, userID, exceptSessionID)
+}
+
+// GetLoginLockedUntil mocks base method.
+func (m *MockSessionStorage) GetLoginLockedUntil(ctx context.Context, keys ...string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoginLockedUntil", varargs...)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginLockedUntil indicates an expected call of GetLoginLockedUntil.
+func (mr *MockSessionStorageMockRecorder) GetLoginLockedUntil(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginLockedUntil", reflect.TypeOf((*MockSessionStorage)(nil).GetLoginLockedUntil), varargs...)
+}
+
+// GetSession mocks base method.
+func (m *MockSessionStorage) GetSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockSessionStorageMockRecorder) GetSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionStorage)(nil).GetSession), ctx, sessionID)
+}
+
+// GetSessionsByUserID mocks base method.
+func (m *MockSessionStorage) GetSessionsByUserID(ctx context.Context, userID uint) ([]*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsByUserID indicates an expected call of GetSessionsByUserID.
+func (mr *MockSessionStorageMockRecorder) GetSessionsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsByUserID", reflect.TypeOf((*MockSessionStorage)(nil).GetSessionsByUserID), ctx, userID)
+}
+
+// IncrLoginFailures mocks base method.
+func (m *MockSessionStorage) IncrLoginFailures(ctx context.Context, key string, window time.Duration) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrLoginFailures", ctx, key, window)
 	ret0, _ := ret[0].(uint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserIDBySession indicates an expected call of GetUserIDBySession.
-func (mr *MockSessionStorageMockRecorder) GetUserIDBySession(ctx, sessionID interface{}) *gomock.Call {
+// IncrLoginFailures indicates an expected call of IncrLoginFailures.
+func (mr *MockSessionStorageMockRecorder) IncrLoginFailures(ctx, key, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrLoginFailures", reflect.TypeOf((*MockSessionStorage)(nil).IncrLoginFailures), ctx, key, window)
+}
+
+// LockLogin mocks base method.
+func (m *MockSessionStorage) LockLogin(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", ctx, key, lockedUntil, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockSessionStorageMockRecorder) LockLogin(ctx, key, lockedUntil, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockSessionStorage)(nil).LockLogin), ctx, key, lockedUntil, ttl)
+}
+
+// RefreshSession mocks base method.
+func (m *MockSessionStorage) RefreshSession(ctx context.Context, sessionID string, lastSeenAt time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSession", ctx, sessionID, lastSeenAt, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSession indicates an expected call of RefreshSession.
+func (mr *MockSessionStorageMockRecorder) RefreshSession(ctx, sessionID, lastSeenAt, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSession", reflect.TypeOf((*MockSessionStorage)(nil).RefreshSession), ctx, sessionID, lastSeenAt, ttl)
+}
+
+// ResetLoginFailures mocks base method.
+func (m *MockSessionStorage) ResetLoginFailures(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginFailures", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginFailures indicates an expected call of ResetLoginFailures.
+func (mr *MockSessionStorageMockRecorder) ResetLoginFailures(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginFailures", reflect.TypeOf((*MockSessionStorage)(nil).ResetLoginFailures), ctx, key)
+}
+
+// StorePasswordResetToken mocks base method.
+func (m *MockSessionStorage) StorePasswordResetToken(ctx context.Context, tokenHash string, userID uint, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePasswordResetToken", ctx, tokenHash, userID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePasswordResetToken indicates an expected call of StorePasswordResetToken.
+func (mr *MockSessionStorageMockRecorder) StorePasswordResetToken(ctx, tokenHash, userID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePasswordResetToken", reflect.TypeOf((*MockSessionStorage)(nil).StorePasswordResetToken), ctx, tokenHash, userID, ttl)
+}
+
+// TakePasswordResetToken mocks base method.
+func (m *MockSessionStorage) TakePasswordResetToken(ctx context.Context, tokenHash string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakePasswordResetToken", ctx, tokenHash)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakePasswordResetToken indicates an expected call of TakePasswordResetToken.
+func (mr *MockSessionStorageMockRecorder) TakePasswordResetToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakePasswordResetToken", reflect.TypeOf((*MockSessionStorage)(nil).TakePasswordResetToken), ctx, tokenHash)
+}
+
+// MockUserStorage is a mock of UserStorage interface.
+type MockUserStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStorageMockRecorder
+}
+
+// MockUserStorageMockRecorder is the mock recorder for MockUserStorage.
+type MockUserStorageMockRecorder struct {
+	mock *MockUserStorage
+}
+
+// NewMockUserStorage creates a new mock instance.
+func NewMockUserStorage(ctrl *gomock.Controller) *MockUserStorage {
+	mock := &MockUserStorage{ctrl: ctrl}
+	mock.recorder = &MockUserStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
+	return m.recorder
+}
+
+// CheckIfUserIsBanned mocks base method.
+func (m *MockUserStorage) CheckIfUserIsBanned(ctx context.Context, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfUserIsBanned", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsBanned indicates an expected call of CheckIfUserIsBanned.
+func (mr *MockUserStorageMockRecorder) CheckIfUserIsBanned(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsBanned", reflect.TypeOf((*MockUserStorage)(nil).CheckIfUserIsBanned), ctx, userID)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserStorage) UpdateUser(ctx context.Context, user *domain.User, prevPassword string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, user, prevPassword)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserStorageMockRecorder) UpdateUser(ctx, user, prevPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserStorage)(nil).UpdateUser), ctx, user, prevPassword)
+}
+
+// MockEmailOutbox is a mock of EmailOutbox interface.
+type MockEmailOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailOutboxMockRecorder
+}
+
+// MockEmailOutboxMockRecorder is the mock recorder for MockEmailOutbox.
+type MockEmailOutboxMockRecorder struct {
+	mock *MockEmailOutbox
+}
+
+// NewMockEmailOutbox creates a new mock instance.
+func NewMockEmailOutbox(ctrl *gomock.Controller) *MockEmailOutbox {
+	mock := &MockEmailOutbox{ctrl: ctrl}
+	mock.recorder = &MockEmailOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailOutbox) EXPECT() *MockEmailOutboxMockRecorder {
+	return m.recorder
+}
+
+// Push mocks base method.
+func (m *MockEmailOutbox) Push(ctx context.Context, email *domain.Email) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockEmailOutboxMockRecorder) Push(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDBySession", reflect.TypeOf((*MockSessionStorage)(nil).GetUserIDBySession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockEmailOutbox)(nil).Push), ctx, email)
 }
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/chat/attachment_urls.go
+
+// Package mock_chat is a generated GoMock package.
+package mock_chat
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAttachmentURLSigner is a mock of AttachmentURLSigner interface.
+type MockAttachmentURLSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentURLSignerMockRecorder
+}
+
+// MockAttachmentURLSignerMockRecorder is the mock recorder for MockAttachmentURLSigner.
+type MockAttachmentURLSignerMockRecorder struct {
+	mock *MockAttachmentURLSigner
+}
+
+// NewMockAttachmentURLSigner creates a new mock instance.
+func NewMockAttachmentURLSigner(ctrl *gomock.Controller) *MockAttachmentURLSigner {
+	mock := &MockAttachmentURLSigner{ctrl: ctrl}
+	mock.recorder = &MockAttachmentURLSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentURLSigner) EXPECT() *MockAttachmentURLSignerMockRecorder {
+	return m.recorder
+}
+
+// PresignedURL mocks base method.
+func (m *MockAttachmentURLSigner) PresignedURL(fileName string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedURL", fileName, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedURL indicates an expected call of PresignedURL.
+func (mr *MockAttachmentURLSignerMockRecorder) PresignedURL(fileName, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedURL", reflect.TypeOf((*MockAttachmentURLSigner)(nil).PresignedURL), fileName, expiry)
+}
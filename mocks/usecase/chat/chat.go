@@ -5,11 +5,88 @@
 package mock_chat
 
 import (
+	context "context"
 	reflect "reflect"
+	ratelimit "socio/usecase/ratelimit"
 
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockRateLimiter is a mock of RateLimiter interface.
+type MockRateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterMockRecorder
+}
+
+// MockRateLimiterMockRecorder is the mock recorder for MockRateLimiter.
+type MockRateLimiterMockRecorder struct {
+	mock *MockRateLimiter
+}
+
+// NewMockRateLimiter creates a new mock instance.
+func NewMockRateLimiter(ctrl *gomock.Controller) *MockRateLimiter {
+	mock := &MockRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiter) EXPECT() *MockRateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimiter) Allow(ctx context.Context, scope, subject string, limit ratelimit.Limit) ratelimit.Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, scope, subject, limit)
+	ret0, _ := ret[0].(ratelimit.Decision)
+	return ret0
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterMockRecorder) Allow(ctx, scope, subject, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiter)(nil).Allow), ctx, scope, subject, limit)
+}
+
+// MockEmailVerifier is a mock of EmailVerifier interface.
+type MockEmailVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerifierMockRecorder
+}
+
+// MockEmailVerifierMockRecorder is the mock recorder for MockEmailVerifier.
+type MockEmailVerifierMockRecorder struct {
+	mock *MockEmailVerifier
+}
+
+// NewMockEmailVerifier creates a new mock instance.
+func NewMockEmailVerifier(ctrl *gomock.Controller) *MockEmailVerifier {
+	mock := &MockEmailVerifier{ctrl: ctrl}
+	mock.recorder = &MockEmailVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerifier) EXPECT() *MockEmailVerifierMockRecorder {
+	return m.recorder
+}
+
+// CheckIfEmailIsVerified mocks base method.
+func (m *MockEmailVerifier) CheckIfEmailIsVerified(ctx context.Context, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfEmailIsVerified", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfEmailIsVerified indicates an expected call of CheckIfEmailIsVerified.
+func (mr *MockEmailVerifierMockRecorder) CheckIfEmailIsVerified(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfEmailIsVerified", reflect.TypeOf((*MockEmailVerifier)(nil).CheckIfEmailIsVerified), ctx, userID)
+}
+
 // MockStickerStorage is a mock of StickerStorage interface.
 type MockStickerStorage struct {
 	ctrl     *gomock.Controller
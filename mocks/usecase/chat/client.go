@@ -36,6 +36,64 @@ func (m *MockPersonalMessagesRepository) EXPECT() *MockPersonalMessagesRepositor
 	return m.recorder
 }
 
+// CanSendMessage mocks base method.
+func (m *MockPersonalMessagesRepository) CanSendMessage(ctx context.Context, senderID, receiverID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanSendMessage", ctx, senderID, receiverID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanSendMessage indicates an expected call of CanSendMessage.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) CanSendMessage(ctx, senderID, receiverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanSendMessage", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).CanSendMessage), ctx, senderID, receiverID)
+}
+
+// CountAttachmentReferences mocks base method.
+func (m *MockPersonalMessagesRepository) CountAttachmentReferences(ctx context.Context, fileName string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAttachmentReferences", ctx, fileName)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAttachmentReferences indicates an expected call of CountAttachmentReferences.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) CountAttachmentReferences(ctx, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttachmentReferences", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).CountAttachmentReferences), ctx, fileName)
+}
+
+// DeleteConversation mocks base method.
+func (m *MockPersonalMessagesRepository) DeleteConversation(ctx context.Context, conversationID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConversation", ctx, conversationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConversation indicates an expected call of DeleteConversation.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) DeleteConversation(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConversation", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).DeleteConversation), ctx, conversationID)
+}
+
+// DeleteConversationParticipant mocks base method.
+func (m *MockPersonalMessagesRepository) DeleteConversationParticipant(ctx context.Context, conversationID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConversationParticipant", ctx, conversationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConversationParticipant indicates an expected call of DeleteConversationParticipant.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) DeleteConversationParticipant(ctx, conversationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConversationParticipant", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).DeleteConversationParticipant), ctx, conversationID, userID)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockPersonalMessagesRepository) DeleteMessage(ctx context.Context, messageID uint) error {
 	m.ctrl.T.Helper()
@@ -79,6 +137,111 @@ func (mr *MockPersonalMessagesRepositoryMockRecorder) GetAllStickers(ctx interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStickers", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetAllStickers), ctx)
 }
 
+// GetAttachmentByChecksum mocks base method.
+func (m *MockPersonalMessagesRepository) GetAttachmentByChecksum(ctx context.Context, checksum string) (*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByChecksum", ctx, checksum)
+	ret0, _ := ret[0].(*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByChecksum indicates an expected call of GetAttachmentByChecksum.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetAttachmentByChecksum(ctx, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByChecksum", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetAttachmentByChecksum), ctx, checksum)
+}
+
+// GetAttachmentsByFileNames mocks base method.
+func (m *MockPersonalMessagesRepository) GetAttachmentsByFileNames(ctx context.Context, fileNames []string) ([]*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByFileNames", ctx, fileNames)
+	ret0, _ := ret[0].([]*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByFileNames indicates an expected call of GetAttachmentsByFileNames.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetAttachmentsByFileNames(ctx, fileNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByFileNames", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetAttachmentsByFileNames), ctx, fileNames)
+}
+
+// GetConversationByID mocks base method.
+func (m *MockPersonalMessagesRepository) GetConversationByID(ctx context.Context, conversationID uint) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationByID", ctx, conversationID)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationByID indicates an expected call of GetConversationByID.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetConversationByID(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationByID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetConversationByID), ctx, conversationID)
+}
+
+// GetConversationParticipantIDs mocks base method.
+func (m *MockPersonalMessagesRepository) GetConversationParticipantIDs(ctx context.Context, conversationID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationParticipantIDs", ctx, conversationID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationParticipantIDs indicates an expected call of GetConversationParticipantIDs.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetConversationParticipantIDs(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationParticipantIDs", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetConversationParticipantIDs), ctx, conversationID)
+}
+
+// GetConversationParticipantRole mocks base method.
+func (m *MockPersonalMessagesRepository) GetConversationParticipantRole(ctx context.Context, conversationID, userID uint) (domain.ConversationRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationParticipantRole", ctx, conversationID, userID)
+	ret0, _ := ret[0].(domain.ConversationRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationParticipantRole indicates an expected call of GetConversationParticipantRole.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetConversationParticipantRole(ctx, conversationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationParticipantRole", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetConversationParticipantRole), ctx, conversationID, userID)
+}
+
+// GetConversationPeerIDs mocks base method.
+func (m *MockPersonalMessagesRepository) GetConversationPeerIDs(ctx context.Context, userID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationPeerIDs", ctx, userID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationPeerIDs indicates an expected call of GetConversationPeerIDs.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetConversationPeerIDs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationPeerIDs", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetConversationPeerIDs), ctx, userID)
+}
+
+// GetConversationsByUserID mocks base method.
+func (m *MockPersonalMessagesRepository) GetConversationsByUserID(ctx context.Context, userID uint) ([]*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationsByUserID indicates an expected call of GetConversationsByUserID.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetConversationsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationsByUserID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetConversationsByUserID), ctx, userID)
+}
+
 // GetDialogsByUserID mocks base method.
 func (m *MockPersonalMessagesRepository) GetDialogsByUserID(ctx context.Context, userID uint) ([]*domain.Dialog, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +257,21 @@ func (mr *MockPersonalMessagesRepositoryMockRecorder) GetDialogsByUserID(ctx, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDialogsByUserID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetDialogsByUserID), ctx, userID)
 }
 
+// GetLastConversationMessageID mocks base method.
+func (m *MockPersonalMessagesRepository) GetLastConversationMessageID(ctx context.Context, conversationID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastConversationMessageID", ctx, conversationID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastConversationMessageID indicates an expected call of GetLastConversationMessageID.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetLastConversationMessageID(ctx, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastConversationMessageID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetLastConversationMessageID), ctx, conversationID)
+}
+
 // GetLastMessageID mocks base method.
 func (m *MockPersonalMessagesRepository) GetLastMessageID(ctx context.Context, senderID, receiverID uint) (uint, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +302,21 @@ func (mr *MockPersonalMessagesRepositoryMockRecorder) GetMessageByID(ctx, msgID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetMessageByID), ctx, msgID)
 }
 
+// GetMessagesByConversation mocks base method.
+func (m *MockPersonalMessagesRepository) GetMessagesByConversation(ctx context.Context, conversationID, lastMessageID, messagesAmount uint) ([]*domain.PersonalMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByConversation", ctx, conversationID, lastMessageID, messagesAmount)
+	ret0, _ := ret[0].([]*domain.PersonalMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByConversation indicates an expected call of GetMessagesByConversation.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) GetMessagesByConversation(ctx, conversationID, lastMessageID, messagesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByConversation", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetMessagesByConversation), ctx, conversationID, lastMessageID, messagesAmount)
+}
+
 // GetMessagesByDialog mocks base method.
 func (m *MockPersonalMessagesRepository) GetMessagesByDialog(ctx context.Context, senderID, receiverID, lastMessageID, messagesAmount uint) ([]*domain.PersonalMessage, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +362,79 @@ func (mr *MockPersonalMessagesRepositoryMockRecorder) GetStickersByAuthorID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickersByAuthorID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).GetStickersByAuthorID), ctx, authorID)
 }
 
+// IsBlocked mocks base method.
+func (m *MockPersonalMessagesRepository) IsBlocked(ctx context.Context, userID, peerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, userID, peerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) IsBlocked(ctx, userID, peerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).IsBlocked), ctx, userID, peerID)
+}
+
+// StoreAttachment mocks base method.
+func (m *MockPersonalMessagesRepository) StoreAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAttachment", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAttachment indicates an expected call of StoreAttachment.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) StoreAttachment(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAttachment", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).StoreAttachment), ctx, attachment)
+}
+
+// StoreConversation mocks base method.
+func (m *MockPersonalMessagesRepository) StoreConversation(ctx context.Context, conversation *domain.Conversation) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreConversation", ctx, conversation)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreConversation indicates an expected call of StoreConversation.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) StoreConversation(ctx, conversation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreConversation", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).StoreConversation), ctx, conversation)
+}
+
+// StoreConversationParticipants mocks base method.
+func (m *MockPersonalMessagesRepository) StoreConversationParticipants(ctx context.Context, conversationID uint, userIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreConversationParticipants", ctx, conversationID, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreConversationParticipants indicates an expected call of StoreConversationParticipants.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) StoreConversationParticipants(ctx, conversationID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreConversationParticipants", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).StoreConversationParticipants), ctx, conversationID, userIDs)
+}
+
+// StoreConversationStickerMessage mocks base method.
+func (m *MockPersonalMessagesRepository) StoreConversationStickerMessage(ctx context.Context, senderID, conversationID, stickerID uint) (*domain.PersonalMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreConversationStickerMessage", ctx, senderID, conversationID, stickerID)
+	ret0, _ := ret[0].(*domain.PersonalMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreConversationStickerMessage indicates an expected call of StoreConversationStickerMessage.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) StoreConversationStickerMessage(ctx, senderID, conversationID, stickerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreConversationStickerMessage", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).StoreConversationStickerMessage), ctx, senderID, conversationID, stickerID)
+}
+
 // StoreMessage mocks base method.
 func (m *MockPersonalMessagesRepository) StoreMessage(ctx context.Context, message *domain.PersonalMessage) (*domain.PersonalMessage, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +480,49 @@ func (mr *MockPersonalMessagesRepositoryMockRecorder) StoreStickerMessage(ctx, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreStickerMessage", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).StoreStickerMessage), ctx, senderID, receiverID, stickerID)
 }
 
+// UpdateConversationName mocks base method.
+func (m *MockPersonalMessagesRepository) UpdateConversationName(ctx context.Context, conversationID uint, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConversationName", ctx, conversationID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConversationName indicates an expected call of UpdateConversationName.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) UpdateConversationName(ctx, conversationID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConversationName", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).UpdateConversationName), ctx, conversationID, name)
+}
+
+// UpdateConversationParticipantRole mocks base method.
+func (m *MockPersonalMessagesRepository) UpdateConversationParticipantRole(ctx context.Context, conversationID, userID uint, role domain.ConversationRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConversationParticipantRole", ctx, conversationID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConversationParticipantRole indicates an expected call of UpdateConversationParticipantRole.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) UpdateConversationParticipantRole(ctx, conversationID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConversationParticipantRole", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).UpdateConversationParticipantRole), ctx, conversationID, userID, role)
+}
+
+// UpdateLastReadMessageID mocks base method.
+func (m *MockPersonalMessagesRepository) UpdateLastReadMessageID(ctx context.Context, userID, peerID, messageID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastReadMessageID", ctx, userID, peerID, messageID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastReadMessageID indicates an expected call of UpdateLastReadMessageID.
+func (mr *MockPersonalMessagesRepositoryMockRecorder) UpdateLastReadMessageID(ctx, userID, peerID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastReadMessageID", reflect.TypeOf((*MockPersonalMessagesRepository)(nil).UpdateLastReadMessageID), ctx, userID, peerID, messageID)
+}
+
 // UpdateMessage mocks base method.
 func (m *MockPersonalMessagesRepository) UpdateMessage(ctx context.Context, msg *domain.PersonalMessage, attachmentsToDelete []string) (*domain.PersonalMessage, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +561,20 @@ func (m *MockPubSubRepository) EXPECT() *MockPubSubRepositoryMockRecorder {
 	return m.recorder
 }
 
+// PublishAction mocks base method.
+func (m *MockPubSubRepository) PublishAction(ctx context.Context, action *chat.Action, receivers []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishAction", ctx, action, receivers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishAction indicates an expected call of PublishAction.
+func (mr *MockPubSubRepositoryMockRecorder) PublishAction(ctx, action, receivers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAction", reflect.TypeOf((*MockPubSubRepository)(nil).PublishAction), ctx, action, receivers)
+}
+
 // ReadActions mocks base method.
 func (m *MockPubSubRepository) ReadActions(ctx context.Context, userID uint, ch chan *chat.Action) error {
 	m.ctrl.T.Helper()
@@ -266,16 +589,31 @@ func (mr *MockPubSubRepositoryMockRecorder) ReadActions(ctx, userID, ch interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadActions", reflect.TypeOf((*MockPubSubRepository)(nil).ReadActions), ctx, userID, ch)
 }
 
+// ReadActionsAfter mocks base method.
+func (m *MockPubSubRepository) ReadActionsAfter(ctx context.Context, userID uint, lastEventID string) ([]*chat.Action, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadActionsAfter", ctx, userID, lastEventID)
+	ret0, _ := ret[0].([]*chat.Action)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadActionsAfter indicates an expected call of ReadActionsAfter.
+func (mr *MockPubSubRepositoryMockRecorder) ReadActionsAfter(ctx, userID, lastEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadActionsAfter", reflect.TypeOf((*MockPubSubRepository)(nil).ReadActionsAfter), ctx, userID, lastEventID)
+}
+
 // WriteAction mocks base method.
-func (m *MockPubSubRepository) WriteAction(ctx context.Context, action *chat.Action) error {
+func (m *MockPubSubRepository) WriteAction(ctx context.Context, action *chat.Action, receivers []uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteAction", ctx, action)
+	ret := m.ctrl.Call(m, "WriteAction", ctx, action, receivers)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // WriteAction indicates an expected call of WriteAction.
-func (mr *MockPubSubRepositoryMockRecorder) WriteAction(ctx, action interface{}) *gomock.Call {
+func (mr *MockPubSubRepositoryMockRecorder) WriteAction(ctx, action, receivers interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAction", reflect.TypeOf((*MockPubSubRepository)(nil).WriteAction), ctx, action)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAction", reflect.TypeOf((*MockPubSubRepository)(nil).WriteAction), ctx, action, receivers)
 }
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/chat/presence.go
+
+// Package mock_chat is a generated GoMock package.
+package mock_chat
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPresenceStorage is a mock of PresenceStorage interface.
+type MockPresenceStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceStorageMockRecorder
+}
+
+// MockPresenceStorageMockRecorder is the mock recorder for MockPresenceStorage.
+type MockPresenceStorageMockRecorder struct {
+	mock *MockPresenceStorage
+}
+
+// NewMockPresenceStorage creates a new mock instance.
+func NewMockPresenceStorage(ctrl *gomock.Controller) *MockPresenceStorage {
+	mock := &MockPresenceStorage{ctrl: ctrl}
+	mock.recorder = &MockPresenceStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresenceStorage) EXPECT() *MockPresenceStorageMockRecorder {
+	return m.recorder
+}
+
+// GetPresences mocks base method.
+func (m *MockPresenceStorage) GetPresences(ctx context.Context, userIDs []uint) ([]*domain.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresences", ctx, userIDs)
+	ret0, _ := ret[0].([]*domain.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresences indicates an expected call of GetPresences.
+func (mr *MockPresenceStorageMockRecorder) GetPresences(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresences", reflect.TypeOf((*MockPresenceStorage)(nil).GetPresences), ctx, userIDs)
+}
+
+// SetOffline mocks base method.
+func (m *MockPresenceStorage) SetOffline(ctx context.Context, userID uint, seenAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOffline", ctx, userID, seenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOffline indicates an expected call of SetOffline.
+func (mr *MockPresenceStorageMockRecorder) SetOffline(ctx, userID, seenAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOffline", reflect.TypeOf((*MockPresenceStorage)(nil).SetOffline), ctx, userID, seenAt)
+}
+
+// Touch mocks base method.
+func (m *MockPresenceStorage) Touch(ctx context.Context, userID uint, seenAt time.Time, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, userID, seenAt, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockPresenceStorageMockRecorder) Touch(ctx, userID, seenAt, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockPresenceStorage)(nil).Touch), ctx, userID, seenAt, ttl)
+}
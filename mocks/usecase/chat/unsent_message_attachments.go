@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 	domain "socio/domain"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -129,6 +130,21 @@ func (mr *MockMessageAttachmentStorageMockRecorder) Delete(fileName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMessageAttachmentStorage)(nil).Delete), fileName)
 }
 
+// PresignedURL mocks base method.
+func (m *MockMessageAttachmentStorage) PresignedURL(fileName string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedURL", fileName, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedURL indicates an expected call of PresignedURL.
+func (mr *MockMessageAttachmentStorageMockRecorder) PresignedURL(fileName, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedURL", reflect.TypeOf((*MockMessageAttachmentStorage)(nil).PresignedURL), fileName, expiry)
+}
+
 // Store mocks base method.
 func (m *MockMessageAttachmentStorage) Store(fileName, filePath, contentType string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/gc/gc.go
+
+// Package mock_gc is a generated GoMock package.
+package mock_gc
+
+import (
+	context "context"
+	reflect "reflect"
+	gc "socio/usecase/gc"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockObjectStorage is a mock of ObjectStorage interface.
+type MockObjectStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStorageMockRecorder
+}
+
+// MockObjectStorageMockRecorder is the mock recorder for MockObjectStorage.
+type MockObjectStorageMockRecorder struct {
+	mock *MockObjectStorage
+}
+
+// NewMockObjectStorage creates a new mock instance.
+func NewMockObjectStorage(ctrl *gomock.Controller) *MockObjectStorage {
+	mock := &MockObjectStorage{ctrl: ctrl}
+	mock.recorder = &MockObjectStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStorage) EXPECT() *MockObjectStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockObjectStorage) Delete(fileName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", fileName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockObjectStorageMockRecorder) Delete(fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockObjectStorage)(nil).Delete), fileName)
+}
+
+// ListObjects mocks base method.
+func (m *MockObjectStorage) ListObjects() ([]*gc.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects")
+	ret0, _ := ret[0].([]*gc.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockObjectStorageMockRecorder) ListObjects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockObjectStorage)(nil).ListObjects))
+}
+
+// MockReferencesStorage is a mock of ReferencesStorage interface.
+type MockReferencesStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferencesStorageMockRecorder
+}
+
+// MockReferencesStorageMockRecorder is the mock recorder for MockReferencesStorage.
+type MockReferencesStorageMockRecorder struct {
+	mock *MockReferencesStorage
+}
+
+// NewMockReferencesStorage creates a new mock instance.
+func NewMockReferencesStorage(ctrl *gomock.Controller) *MockReferencesStorage {
+	mock := &MockReferencesStorage{ctrl: ctrl}
+	mock.recorder = &MockReferencesStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferencesStorage) EXPECT() *MockReferencesStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttachments mocks base method.
+func (m *MockReferencesStorage) DeleteAttachments(ctx context.Context, fileNames []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachments", ctx, fileNames)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachments indicates an expected call of DeleteAttachments.
+func (mr *MockReferencesStorageMockRecorder) DeleteAttachments(ctx, fileNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachments", reflect.TypeOf((*MockReferencesStorage)(nil).DeleteAttachments), ctx, fileNames)
+}
+
+// GetReferencedFileNames mocks base method.
+func (m *MockReferencesStorage) GetReferencedFileNames(ctx context.Context, bucketName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferencedFileNames", ctx, bucketName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferencedFileNames indicates an expected call of GetReferencedFileNames.
+func (mr *MockReferencesStorageMockRecorder) GetReferencedFileNames(ctx, bucketName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferencedFileNames", reflect.TypeOf((*MockReferencesStorage)(nil).GetReferencedFileNames), ctx, bucketName)
+}
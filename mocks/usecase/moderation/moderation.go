@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/moderation/moderation.go
+
+// Package mock_moderation is a generated GoMock package.
+package mock_moderation
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModerationStorage is a mock of ModerationStorage interface.
+type MockModerationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationStorageMockRecorder
+}
+
+// MockModerationStorageMockRecorder is the mock recorder for MockModerationStorage.
+type MockModerationStorageMockRecorder struct {
+	mock *MockModerationStorage
+}
+
+// NewMockModerationStorage creates a new mock instance.
+func NewMockModerationStorage(ctrl *gomock.Controller) *MockModerationStorage {
+	mock := &MockModerationStorage{ctrl: ctrl}
+	mock.recorder = &MockModerationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationStorage) EXPECT() *MockModerationStorageMockRecorder {
+	return m.recorder
+}
+
+// CheckReportTarget mocks base method.
+func (m *MockModerationStorage) CheckReportTarget(ctx context.Context, reporterID uint, targetType domain.ModerationTargetType, targetID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReportTarget", ctx, reporterID, targetType, targetID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckReportTarget indicates an expected call of CheckReportTarget.
+func (mr *MockModerationStorageMockRecorder) CheckReportTarget(ctx, reporterID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReportTarget", reflect.TypeOf((*MockModerationStorage)(nil).CheckReportTarget), ctx, reporterID, targetType, targetID)
+}
+
+// DismissReportCase mocks base method.
+func (m *MockModerationStorage) DismissReportCase(ctx context.Context, caseID, moderatorID uint) (*domain.ReportCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissReportCase", ctx, caseID, moderatorID)
+	ret0, _ := ret[0].(*domain.ReportCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DismissReportCase indicates an expected call of DismissReportCase.
+func (mr *MockModerationStorageMockRecorder) DismissReportCase(ctx, caseID, moderatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissReportCase", reflect.TypeOf((*MockModerationStorage)(nil).DismissReportCase), ctx, caseID, moderatorID)
+}
+
+// GetModerationLog mocks base method.
+func (m *MockModerationStorage) GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) ([]*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationLog", ctx, lastEntryID, entriesAmount)
+	ret0, _ := ret[0].([]*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationLog indicates an expected call of GetModerationLog.
+func (mr *MockModerationStorageMockRecorder) GetModerationLog(ctx, lastEntryID, entriesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationLog", reflect.TypeOf((*MockModerationStorage)(nil).GetModerationLog), ctx, lastEntryID, entriesAmount)
+}
+
+// GetOpenReportCases mocks base method.
+func (m *MockModerationStorage) GetOpenReportCases(ctx context.Context, lastCaseID, casesAmount uint) ([]*domain.ReportCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReportCases", ctx, lastCaseID, casesAmount)
+	ret0, _ := ret[0].([]*domain.ReportCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReportCases indicates an expected call of GetOpenReportCases.
+func (mr *MockModerationStorageMockRecorder) GetOpenReportCases(ctx, lastCaseID, casesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReportCases", reflect.TypeOf((*MockModerationStorage)(nil).GetOpenReportCases), ctx, lastCaseID, casesAmount)
+}
+
+// HideContent mocks base method.
+func (m *MockModerationStorage) HideContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideContent", ctx, targetType, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideContent indicates an expected call of HideContent.
+func (mr *MockModerationStorageMockRecorder) HideContent(ctx, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideContent", reflect.TypeOf((*MockModerationStorage)(nil).HideContent), ctx, targetType, targetID)
+}
+
+// ResolveReportCases mocks base method.
+func (m *MockModerationStorage) ResolveReportCases(ctx context.Context, targetType domain.ModerationTargetType, targetID, moderatorID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReportCases", ctx, targetType, targetID, moderatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReportCases indicates an expected call of ResolveReportCases.
+func (mr *MockModerationStorageMockRecorder) ResolveReportCases(ctx, targetType, targetID, moderatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReportCases", reflect.TypeOf((*MockModerationStorage)(nil).ResolveReportCases), ctx, targetType, targetID, moderatorID)
+}
+
+// ShowContent mocks base method.
+func (m *MockModerationStorage) ShowContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowContent", ctx, targetType, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShowContent indicates an expected call of ShowContent.
+func (mr *MockModerationStorageMockRecorder) ShowContent(ctx, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowContent", reflect.TypeOf((*MockModerationStorage)(nil).ShowContent), ctx, targetType, targetID)
+}
+
+// StoreModerationLogEntry mocks base method.
+func (m *MockModerationStorage) StoreModerationLogEntry(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreModerationLogEntry", ctx, entry)
+	ret0, _ := ret[0].(*domain.ModerationLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreModerationLogEntry indicates an expected call of StoreModerationLogEntry.
+func (mr *MockModerationStorageMockRecorder) StoreModerationLogEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreModerationLogEntry", reflect.TypeOf((*MockModerationStorage)(nil).StoreModerationLogEntry), ctx, entry)
+}
+
+// StoreReport mocks base method.
+func (m *MockModerationStorage) StoreReport(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreReport", ctx, report)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreReport indicates an expected call of StoreReport.
+func (mr *MockModerationStorageMockRecorder) StoreReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreReport", reflect.TypeOf((*MockModerationStorage)(nil).StoreReport), ctx, report)
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/notifications/notifications.go
+
+// Package mock_notifications is a generated GoMock package.
+package mock_notifications
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+	chat "socio/usecase/chat"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationsStorage is a mock of NotificationsStorage interface.
+type MockNotificationsStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationsStorageMockRecorder
+}
+
+// MockNotificationsStorageMockRecorder is the mock recorder for MockNotificationsStorage.
+type MockNotificationsStorageMockRecorder struct {
+	mock *MockNotificationsStorage
+}
+
+// NewMockNotificationsStorage creates a new mock instance.
+func NewMockNotificationsStorage(ctrl *gomock.Controller) *MockNotificationsStorage {
+	mock := &MockNotificationsStorage{ctrl: ctrl}
+	mock.recorder = &MockNotificationsStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationsStorage) EXPECT() *MockNotificationsStorageMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationsStorage) GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, lastNotificationID, notificationsAmount)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationsStorageMockRecorder) GetNotifications(ctx, userID, lastNotificationID, notificationsAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationsStorage)(nil).GetNotifications), ctx, userID, lastNotificationID, notificationsAmount)
+}
+
+// GetUnreadNotificationsCount mocks base method.
+func (m *MockNotificationsStorage) GetUnreadNotificationsCount(ctx context.Context, userID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadNotificationsCount", ctx, userID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadNotificationsCount indicates an expected call of GetUnreadNotificationsCount.
+func (mr *MockNotificationsStorageMockRecorder) GetUnreadNotificationsCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadNotificationsCount", reflect.TypeOf((*MockNotificationsStorage)(nil).GetUnreadNotificationsCount), ctx, userID)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockNotificationsStorage) MarkAllNotificationsRead(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockNotificationsStorageMockRecorder) MarkAllNotificationsRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockNotificationsStorage)(nil).MarkAllNotificationsRead), ctx, userID)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockNotificationsStorage) MarkNotificationsRead(ctx context.Context, userID uint, notificationIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID, notificationIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockNotificationsStorageMockRecorder) MarkNotificationsRead(ctx, userID, notificationIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockNotificationsStorage)(nil).MarkNotificationsRead), ctx, userID, notificationIDs)
+}
+
+// StoreNotifications mocks base method.
+func (m *MockNotificationsStorage) StoreNotifications(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNotifications", ctx, notificationType, actorID, targetID)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreNotifications indicates an expected call of StoreNotifications.
+func (mr *MockNotificationsStorageMockRecorder) StoreNotifications(ctx, notificationType, actorID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNotifications", reflect.TypeOf((*MockNotificationsStorage)(nil).StoreNotifications), ctx, notificationType, actorID, targetID)
+}
+
+// MockActionWriter is a mock of ActionWriter interface.
+type MockActionWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionWriterMockRecorder
+}
+
+// MockActionWriterMockRecorder is the mock recorder for MockActionWriter.
+type MockActionWriterMockRecorder struct {
+	mock *MockActionWriter
+}
+
+// NewMockActionWriter creates a new mock instance.
+func NewMockActionWriter(ctrl *gomock.Controller) *MockActionWriter {
+	mock := &MockActionWriter{ctrl: ctrl}
+	mock.recorder = &MockActionWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActionWriter) EXPECT() *MockActionWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteAction mocks base method.
+func (m *MockActionWriter) WriteAction(ctx context.Context, action *chat.Action, receivers []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAction", ctx, action, receivers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteAction indicates an expected call of WriteAction.
+func (mr *MockActionWriterMockRecorder) WriteAction(ctx, action, receivers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAction", reflect.TypeOf((*MockActionWriter)(nil).WriteAction), ctx, action, receivers)
+}
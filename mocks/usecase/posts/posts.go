@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 	domain "socio/domain"
 	posts "socio/usecase/posts"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,6 +37,81 @@ func (m *MockPostsStorage) EXPECT() *MockPostsStorageMockRecorder {
 	return m.recorder
 }
 
+// CanViewPost mocks base method.
+func (m *MockPostsStorage) CanViewPost(ctx context.Context, postID, viewerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewPost", ctx, postID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewPost indicates an expected call of CanViewPost.
+func (mr *MockPostsStorageMockRecorder) CanViewPost(ctx, postID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewPost", reflect.TypeOf((*MockPostsStorage)(nil).CanViewPost), ctx, postID, viewerID)
+}
+
+// CountAttachmentReferences mocks base method.
+func (m *MockPostsStorage) CountAttachmentReferences(ctx context.Context, fileName string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAttachmentReferences", ctx, fileName)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAttachmentReferences indicates an expected call of CountAttachmentReferences.
+func (mr *MockPostsStorageMockRecorder) CountAttachmentReferences(ctx, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttachmentReferences", reflect.TypeOf((*MockPostsStorage)(nil).CountAttachmentReferences), ctx, fileName)
+}
+
+// CountSearchComments mocks base method.
+func (m *MockPostsStorage) CountSearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchComments", ctx, viewerID, query, authorIDs)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchComments indicates an expected call of CountSearchComments.
+func (mr *MockPostsStorageMockRecorder) CountSearchComments(ctx, viewerID, query, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchComments", reflect.TypeOf((*MockPostsStorage)(nil).CountSearchComments), ctx, viewerID, query, authorIDs)
+}
+
+// CountSearchHashtags mocks base method.
+func (m *MockPostsStorage) CountSearchHashtags(ctx context.Context, prefix string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchHashtags", ctx, prefix)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchHashtags indicates an expected call of CountSearchHashtags.
+func (mr *MockPostsStorageMockRecorder) CountSearchHashtags(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchHashtags", reflect.TypeOf((*MockPostsStorage)(nil).CountSearchHashtags), ctx, prefix)
+}
+
+// CountSearchPosts mocks base method.
+func (m *MockPostsStorage) CountSearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchPosts", ctx, viewerID, query, authorIDs)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchPosts indicates an expected call of CountSearchPosts.
+func (mr *MockPostsStorageMockRecorder) CountSearchPosts(ctx, viewerID, query, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchPosts", reflect.TypeOf((*MockPostsStorage)(nil).CountSearchPosts), ctx, viewerID, query, authorIDs)
+}
+
 // DeleteComment mocks base method.
 func (m *MockPostsStorage) DeleteComment(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -106,6 +182,51 @@ func (mr *MockPostsStorageMockRecorder) DeletePostLike(ctx, likeData interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePostLike", reflect.TypeOf((*MockPostsStorage)(nil).DeletePostLike), ctx, likeData)
 }
 
+// GetAttachmentByChecksum mocks base method.
+func (m *MockPostsStorage) GetAttachmentByChecksum(ctx context.Context, checksum string) (*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByChecksum", ctx, checksum)
+	ret0, _ := ret[0].(*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByChecksum indicates an expected call of GetAttachmentByChecksum.
+func (mr *MockPostsStorageMockRecorder) GetAttachmentByChecksum(ctx, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByChecksum", reflect.TypeOf((*MockPostsStorage)(nil).GetAttachmentByChecksum), ctx, checksum)
+}
+
+// GetAttachmentsByFileNames mocks base method.
+func (m *MockPostsStorage) GetAttachmentsByFileNames(ctx context.Context, fileNames []string) ([]*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByFileNames", ctx, fileNames)
+	ret0, _ := ret[0].([]*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByFileNames indicates an expected call of GetAttachmentsByFileNames.
+func (mr *MockPostsStorageMockRecorder) GetAttachmentsByFileNames(ctx, fileNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByFileNames", reflect.TypeOf((*MockPostsStorage)(nil).GetAttachmentsByFileNames), ctx, fileNames)
+}
+
+// GetAuthorAffinities mocks base method.
+func (m *MockPostsStorage) GetAuthorAffinities(ctx context.Context, userID uint, authorIDs []uint, before time.Time) ([]posts.AuthorAffinity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorAffinities", ctx, userID, authorIDs, before)
+	ret0, _ := ret[0].([]posts.AuthorAffinity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorAffinities indicates an expected call of GetAuthorAffinities.
+func (mr *MockPostsStorageMockRecorder) GetAuthorAffinities(ctx, userID, authorIDs, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorAffinities", reflect.TypeOf((*MockPostsStorage)(nil).GetAuthorAffinities), ctx, userID, authorIDs, before)
+}
+
 // GetCommentByID mocks base method.
 func (m *MockPostsStorage) GetCommentByID(ctx context.Context, id uint) (*domain.Comment, error) {
 	m.ctrl.T.Helper()
@@ -136,19 +257,79 @@ func (mr *MockPostsStorageMockRecorder) GetCommentLikeByCommentIDAndUserID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentLikeByCommentIDAndUserID", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentLikeByCommentIDAndUserID), ctx, data)
 }
 
+// GetCommentMentions mocks base method.
+func (m *MockPostsStorage) GetCommentMentions(ctx context.Context, commentIDs []uint) ([]posts.Mention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentMentions", ctx, commentIDs)
+	ret0, _ := ret[0].([]posts.Mention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentMentions indicates an expected call of GetCommentMentions.
+func (mr *MockPostsStorageMockRecorder) GetCommentMentions(ctx, commentIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentMentions", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentMentions), ctx, commentIDs)
+}
+
+// GetCommentReplies mocks base method.
+func (m *MockPostsStorage) GetCommentReplies(ctx context.Context, commentID, lastReplyID, repliesAmount uint) ([]*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentReplies", ctx, commentID, lastReplyID, repliesAmount)
+	ret0, _ := ret[0].([]*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentReplies indicates an expected call of GetCommentReplies.
+func (mr *MockPostsStorageMockRecorder) GetCommentReplies(ctx, commentID, lastReplyID, repliesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReplies", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentReplies), ctx, commentID, lastReplyID, repliesAmount)
+}
+
 // GetCommentsByPostID mocks base method.
-func (m *MockPostsStorage) GetCommentsByPostID(ctx context.Context, postID uint) ([]*domain.Comment, error) {
+func (m *MockPostsStorage) GetCommentsByPostID(ctx context.Context, postID, lastCommentID, commentsAmount uint) ([]*domain.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentsByPostID", ctx, postID)
+	ret := m.ctrl.Call(m, "GetCommentsByPostID", ctx, postID, lastCommentID, commentsAmount)
 	ret0, _ := ret[0].([]*domain.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCommentsByPostID indicates an expected call of GetCommentsByPostID.
-func (mr *MockPostsStorageMockRecorder) GetCommentsByPostID(ctx, postID interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetCommentsByPostID(ctx, postID, lastCommentID, commentsAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPostID", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentsByPostID), ctx, postID, lastCommentID, commentsAmount)
+}
+
+// GetCommentsRepliesPreview mocks base method.
+func (m *MockPostsStorage) GetCommentsRepliesPreview(ctx context.Context, commentIDs []uint, repliesAmount uint) ([]*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsRepliesPreview", ctx, commentIDs, repliesAmount)
+	ret0, _ := ret[0].([]*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsRepliesPreview indicates an expected call of GetCommentsRepliesPreview.
+func (mr *MockPostsStorageMockRecorder) GetCommentsRepliesPreview(ctx, commentIDs, repliesAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsRepliesPreview", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentsRepliesPreview), ctx, commentIDs, repliesAmount)
+}
+
+// GetFeedCandidates mocks base method.
+func (m *MockPostsStorage) GetFeedCandidates(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, createdAfter, createdBefore time.Time, limit uint) ([]posts.FeedCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedCandidates", ctx, viewerID, groupSubIDs, userSubIDs, createdAfter, createdBefore, limit)
+	ret0, _ := ret[0].([]posts.FeedCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedCandidates indicates an expected call of GetFeedCandidates.
+func (mr *MockPostsStorageMockRecorder) GetFeedCandidates(ctx, viewerID, groupSubIDs, userSubIDs, createdAfter, createdBefore, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPostID", reflect.TypeOf((*MockPostsStorage)(nil).GetCommentsByPostID), ctx, postID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedCandidates", reflect.TypeOf((*MockPostsStorage)(nil).GetFeedCandidates), ctx, viewerID, groupSubIDs, userSubIDs, createdAfter, createdBefore, limit)
 }
 
 // GetGroupPostByPostID mocks base method.
@@ -167,18 +348,18 @@ func (mr *MockPostsStorageMockRecorder) GetGroupPostByPostID(ctx, postID interfa
 }
 
 // GetGroupPostsBySubscriptionIDs mocks base method.
-func (m *MockPostsStorage) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uint, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+func (m *MockPostsStorage) GetGroupPostsBySubscriptionIDs(ctx context.Context, viewerID uint, subIDs []uint, lastPostID, postsAmount uint) ([]*domain.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupPostsBySubscriptionIDs", ctx, subIDs, lastPostID, postsAmount)
+	ret := m.ctrl.Call(m, "GetGroupPostsBySubscriptionIDs", ctx, viewerID, subIDs, lastPostID, postsAmount)
 	ret0, _ := ret[0].([]*domain.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGroupPostsBySubscriptionIDs indicates an expected call of GetGroupPostsBySubscriptionIDs.
-func (mr *MockPostsStorageMockRecorder) GetGroupPostsBySubscriptionIDs(ctx, subIDs, lastPostID, postsAmount interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetGroupPostsBySubscriptionIDs(ctx, viewerID, subIDs, lastPostID, postsAmount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPostsBySubscriptionIDs", reflect.TypeOf((*MockPostsStorage)(nil).GetGroupPostsBySubscriptionIDs), ctx, subIDs, lastPostID, postsAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPostsBySubscriptionIDs", reflect.TypeOf((*MockPostsStorage)(nil).GetGroupPostsBySubscriptionIDs), ctx, viewerID, subIDs, lastPostID, postsAmount)
 }
 
 // GetLikedPosts mocks base method.
@@ -197,18 +378,18 @@ func (mr *MockPostsStorageMockRecorder) GetLikedPosts(ctx, userID, lastLikeID, l
 }
 
 // GetNewPosts mocks base method.
-func (m *MockPostsStorage) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+func (m *MockPostsStorage) GetNewPosts(ctx context.Context, viewerID, lastPostID, postsAmount uint) ([]*domain.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNewPosts", ctx, lastPostID, postsAmount)
+	ret := m.ctrl.Call(m, "GetNewPosts", ctx, viewerID, lastPostID, postsAmount)
 	ret0, _ := ret[0].([]*domain.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNewPosts indicates an expected call of GetNewPosts.
-func (mr *MockPostsStorageMockRecorder) GetNewPosts(ctx, lastPostID, postsAmount interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetNewPosts(ctx, viewerID, lastPostID, postsAmount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewPosts", reflect.TypeOf((*MockPostsStorage)(nil).GetNewPosts), ctx, lastPostID, postsAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewPosts", reflect.TypeOf((*MockPostsStorage)(nil).GetNewPosts), ctx, viewerID, lastPostID, postsAmount)
 }
 
 // GetPostByID mocks base method.
@@ -241,34 +422,94 @@ func (mr *MockPostsStorageMockRecorder) GetPostLikeByUserIDAndPostID(ctx, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostLikeByUserIDAndPostID", reflect.TypeOf((*MockPostsStorage)(nil).GetPostLikeByUserIDAndPostID), ctx, userID, postID)
 }
 
+// GetPostMentions mocks base method.
+func (m *MockPostsStorage) GetPostMentions(ctx context.Context, postIDs []uint) ([]posts.Mention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostMentions", ctx, postIDs)
+	ret0, _ := ret[0].([]posts.Mention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostMentions indicates an expected call of GetPostMentions.
+func (mr *MockPostsStorageMockRecorder) GetPostMentions(ctx, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostMentions", reflect.TypeOf((*MockPostsStorage)(nil).GetPostMentions), ctx, postIDs)
+}
+
 // GetPostsByGroupSubIDsAndUserSubIDs mocks base method.
-func (m *MockPostsStorage) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+func (m *MockPostsStorage) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) ([]*domain.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostsByGroupSubIDsAndUserSubIDs", ctx, groupSubIDs, userSubIDs, lastPostID, postsAmount)
+	ret := m.ctrl.Call(m, "GetPostsByGroupSubIDsAndUserSubIDs", ctx, viewerID, groupSubIDs, userSubIDs, lastPostID, postsAmount)
 	ret0, _ := ret[0].([]*domain.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPostsByGroupSubIDsAndUserSubIDs indicates an expected call of GetPostsByGroupSubIDsAndUserSubIDs.
-func (mr *MockPostsStorageMockRecorder) GetPostsByGroupSubIDsAndUserSubIDs(ctx, groupSubIDs, userSubIDs, lastPostID, postsAmount interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetPostsByGroupSubIDsAndUserSubIDs(ctx, viewerID, groupSubIDs, userSubIDs, lastPostID, postsAmount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByGroupSubIDsAndUserSubIDs", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsByGroupSubIDsAndUserSubIDs), ctx, groupSubIDs, userSubIDs, lastPostID, postsAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByGroupSubIDsAndUserSubIDs", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsByGroupSubIDsAndUserSubIDs), ctx, viewerID, groupSubIDs, userSubIDs, lastPostID, postsAmount)
+}
+
+// GetPostsByHashtag mocks base method.
+func (m *MockPostsStorage) GetPostsByHashtag(ctx context.Context, viewerID uint, hashtag string, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByHashtag", ctx, viewerID, hashtag, lastPostID, postsAmount)
+	ret0, _ := ret[0].([]*domain.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByHashtag indicates an expected call of GetPostsByHashtag.
+func (mr *MockPostsStorageMockRecorder) GetPostsByHashtag(ctx, viewerID, hashtag, lastPostID, postsAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByHashtag", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsByHashtag), ctx, viewerID, hashtag, lastPostID, postsAmount)
+}
+
+// GetPostsByIDs mocks base method.
+func (m *MockPostsStorage) GetPostsByIDs(ctx context.Context, viewerID uint, postIDs []uint) ([]*domain.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByIDs", ctx, viewerID, postIDs)
+	ret0, _ := ret[0].([]*domain.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByIDs indicates an expected call of GetPostsByIDs.
+func (mr *MockPostsStorageMockRecorder) GetPostsByIDs(ctx, viewerID, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByIDs", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsByIDs), ctx, viewerID, postIDs)
 }
 
 // GetPostsOfGroup mocks base method.
-func (m *MockPostsStorage) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+func (m *MockPostsStorage) GetPostsOfGroup(ctx context.Context, viewerID, groupID, lastPostID, postsAmount uint) ([]*domain.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostsOfGroup", ctx, groupID, lastPostID, postsAmount)
+	ret := m.ctrl.Call(m, "GetPostsOfGroup", ctx, viewerID, groupID, lastPostID, postsAmount)
 	ret0, _ := ret[0].([]*domain.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPostsOfGroup indicates an expected call of GetPostsOfGroup.
-func (mr *MockPostsStorageMockRecorder) GetPostsOfGroup(ctx, groupID, lastPostID, postsAmount interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetPostsOfGroup(ctx, viewerID, groupID, lastPostID, postsAmount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsOfGroup", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsOfGroup), ctx, groupID, lastPostID, postsAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsOfGroup", reflect.TypeOf((*MockPostsStorage)(nil).GetPostsOfGroup), ctx, viewerID, groupID, lastPostID, postsAmount)
+}
+
+// GetTrendingHashtags mocks base method.
+func (m *MockPostsStorage) GetTrendingHashtags(ctx context.Context, since time.Time, limit uint) ([]posts.TrendingHashtag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingHashtags", ctx, since, limit)
+	ret0, _ := ret[0].([]posts.TrendingHashtag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingHashtags indicates an expected call of GetTrendingHashtags.
+func (mr *MockPostsStorageMockRecorder) GetTrendingHashtags(ctx, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingHashtags", reflect.TypeOf((*MockPostsStorage)(nil).GetTrendingHashtags), ctx, since, limit)
 }
 
 // GetUserFriendsPosts mocks base method.
@@ -287,18 +528,92 @@ func (mr *MockPostsStorageMockRecorder) GetUserFriendsPosts(ctx, userID, lastPos
 }
 
 // GetUserPosts mocks base method.
-func (m *MockPostsStorage) GetUserPosts(ctx context.Context, userID, lastPostID, postsAmount uint) ([]*domain.Post, error) {
+func (m *MockPostsStorage) GetUserPosts(ctx context.Context, userID, viewerID, lastPostID, postsAmount uint) ([]*domain.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPosts", ctx, userID, lastPostID, postsAmount)
+	ret := m.ctrl.Call(m, "GetUserPosts", ctx, userID, viewerID, lastPostID, postsAmount)
 	ret0, _ := ret[0].([]*domain.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPosts indicates an expected call of GetUserPosts.
-func (mr *MockPostsStorageMockRecorder) GetUserPosts(ctx, userID, lastPostID, postsAmount interface{}) *gomock.Call {
+func (mr *MockPostsStorageMockRecorder) GetUserPosts(ctx, userID, viewerID, lastPostID, postsAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPosts", reflect.TypeOf((*MockPostsStorage)(nil).GetUserPosts), ctx, userID, viewerID, lastPostID, postsAmount)
+}
+
+// IsBlocked mocks base method.
+func (m *MockPostsStorage) IsBlocked(ctx context.Context, userID, peerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, userID, peerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockPostsStorageMockRecorder) IsBlocked(ctx, userID, peerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockPostsStorage)(nil).IsBlocked), ctx, userID, peerID)
+}
+
+// SearchComments mocks base method.
+func (m *MockPostsStorage) SearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor posts.SearchCursor, limit uint) ([]posts.CommentSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchComments", ctx, viewerID, query, authorIDs, cursor, limit)
+	ret0, _ := ret[0].([]posts.CommentSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchComments indicates an expected call of SearchComments.
+func (mr *MockPostsStorageMockRecorder) SearchComments(ctx, viewerID, query, authorIDs, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchComments", reflect.TypeOf((*MockPostsStorage)(nil).SearchComments), ctx, viewerID, query, authorIDs, cursor, limit)
+}
+
+// SearchHashtags mocks base method.
+func (m *MockPostsStorage) SearchHashtags(ctx context.Context, prefix, lastHashtag string, limit uint) ([]posts.TrendingHashtag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchHashtags", ctx, prefix, lastHashtag, limit)
+	ret0, _ := ret[0].([]posts.TrendingHashtag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHashtags indicates an expected call of SearchHashtags.
+func (mr *MockPostsStorageMockRecorder) SearchHashtags(ctx, prefix, lastHashtag, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHashtags", reflect.TypeOf((*MockPostsStorage)(nil).SearchHashtags), ctx, prefix, lastHashtag, limit)
+}
+
+// SearchPosts mocks base method.
+func (m *MockPostsStorage) SearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor posts.SearchCursor, limit uint) ([]posts.PostSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", ctx, viewerID, query, authorIDs, cursor, limit)
+	ret0, _ := ret[0].([]posts.PostSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockPostsStorageMockRecorder) SearchPosts(ctx, viewerID, query, authorIDs, cursor, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPosts", reflect.TypeOf((*MockPostsStorage)(nil).GetUserPosts), ctx, userID, lastPostID, postsAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockPostsStorage)(nil).SearchPosts), ctx, viewerID, query, authorIDs, cursor, limit)
+}
+
+// StoreAttachment mocks base method.
+func (m *MockPostsStorage) StoreAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAttachment", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAttachment indicates an expected call of StoreAttachment.
+func (mr *MockPostsStorageMockRecorder) StoreAttachment(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAttachment", reflect.TypeOf((*MockPostsStorage)(nil).StoreAttachment), ctx, attachment)
 }
 
 // StoreComment mocks base method.
@@ -316,6 +631,20 @@ func (mr *MockPostsStorageMockRecorder) StoreComment(ctx, comment interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreComment", reflect.TypeOf((*MockPostsStorage)(nil).StoreComment), ctx, comment)
 }
 
+// StoreCommentEntities mocks base method.
+func (m *MockPostsStorage) StoreCommentEntities(ctx context.Context, commentID uint, hashtags []string, mentionedUserIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreCommentEntities", ctx, commentID, hashtags, mentionedUserIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreCommentEntities indicates an expected call of StoreCommentEntities.
+func (mr *MockPostsStorageMockRecorder) StoreCommentEntities(ctx, commentID, hashtags, mentionedUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCommentEntities", reflect.TypeOf((*MockPostsStorage)(nil).StoreCommentEntities), ctx, commentID, hashtags, mentionedUserIDs)
+}
+
 // StoreCommentLike mocks base method.
 func (m *MockPostsStorage) StoreCommentLike(ctx context.Context, commentLike *domain.CommentLike) (*domain.CommentLike, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +690,20 @@ func (mr *MockPostsStorageMockRecorder) StorePost(ctx, post interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePost", reflect.TypeOf((*MockPostsStorage)(nil).StorePost), ctx, post)
 }
 
+// StorePostEntities mocks base method.
+func (m *MockPostsStorage) StorePostEntities(ctx context.Context, postID uint, hashtags []string, mentionedUserIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePostEntities", ctx, postID, hashtags, mentionedUserIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePostEntities indicates an expected call of StorePostEntities.
+func (mr *MockPostsStorageMockRecorder) StorePostEntities(ctx, postID, hashtags, mentionedUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePostEntities", reflect.TypeOf((*MockPostsStorage)(nil).StorePostEntities), ctx, postID, hashtags, mentionedUserIDs)
+}
+
 // StorePostLike mocks base method.
 func (m *MockPostsStorage) StorePostLike(ctx context.Context, likeData *domain.PostLike) (*domain.PostLike, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,21 @@ func (m *MockPublicGroupStorage) EXPECT() *MockPublicGroupStorageMockRecorder {
 	return m.recorder
 }
 
+// CountPublicGroupsByName mocks base method.
+func (m *MockPublicGroupStorage) CountPublicGroupsByName(ctx context.Context, query string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPublicGroupsByName", ctx, query)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPublicGroupsByName indicates an expected call of CountPublicGroupsByName.
+func (mr *MockPublicGroupStorageMockRecorder) CountPublicGroupsByName(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPublicGroupsByName", reflect.TypeOf((*MockPublicGroupStorage)(nil).CountPublicGroupsByName), ctx, query)
+}
+
 // DeletePublicGroup mocks base method.
 func (m *MockPublicGroupStorage) DeletePublicGroup(ctx context.Context, publicGroupID uint) error {
 	m.ctrl.T.Helper()
@@ -125,18 +140,18 @@ func (mr *MockPublicGroupStorageMockRecorder) GetSubscriptionByPublicGroupIDAndS
 }
 
 // SearchPublicGroupsByNameWithInfo mocks base method.
-func (m *MockPublicGroupStorage) SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID uint) ([]*publicgroup.PublicGroupWithInfo, error) {
+func (m *MockPublicGroupStorage) SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID, lastGroupID, limit uint) ([]*publicgroup.PublicGroupWithInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchPublicGroupsByNameWithInfo", ctx, query, userID)
+	ret := m.ctrl.Call(m, "SearchPublicGroupsByNameWithInfo", ctx, query, userID, lastGroupID, limit)
 	ret0, _ := ret[0].([]*publicgroup.PublicGroupWithInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchPublicGroupsByNameWithInfo indicates an expected call of SearchPublicGroupsByNameWithInfo.
-func (mr *MockPublicGroupStorageMockRecorder) SearchPublicGroupsByNameWithInfo(ctx, query, userID interface{}) *gomock.Call {
+func (mr *MockPublicGroupStorageMockRecorder) SearchPublicGroupsByNameWithInfo(ctx, query, userID, lastGroupID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPublicGroupsByNameWithInfo", reflect.TypeOf((*MockPublicGroupStorage)(nil).SearchPublicGroupsByNameWithInfo), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPublicGroupsByNameWithInfo", reflect.TypeOf((*MockPublicGroupStorage)(nil).SearchPublicGroupsByNameWithInfo), ctx, query, userID, lastGroupID, limit)
 }
 
 // StorePublicGroup mocks base method.
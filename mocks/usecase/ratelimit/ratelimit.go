@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/ratelimit/ratelimit.go
+
+// Package mock_ratelimit is a generated GoMock package.
+package mock_ratelimit
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRateLimitStorage is a mock of RateLimitStorage interface.
+type MockRateLimitStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitStorageMockRecorder
+}
+
+// MockRateLimitStorageMockRecorder is the mock recorder for MockRateLimitStorage.
+type MockRateLimitStorageMockRecorder struct {
+	mock *MockRateLimitStorage
+}
+
+// NewMockRateLimitStorage creates a new mock instance.
+func NewMockRateLimitStorage(ctrl *gomock.Controller) *MockRateLimitStorage {
+	mock := &MockRateLimitStorage{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStorage) EXPECT() *MockRateLimitStorageMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitStorage) Allow(ctx context.Context, key string, limit uint, window time.Duration, now time.Time) (bool, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit, window, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitStorageMockRecorder) Allow(ctx, key, limit, window, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitStorage)(nil).Allow), ctx, key, limit, window, now)
+}
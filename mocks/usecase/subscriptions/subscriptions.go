@@ -49,6 +49,35 @@ func (mr *MockSubscriptionsStorageMockRecorder) Delete(ctx, subscriberID, subsci
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubscriptionsStorage)(nil).Delete), ctx, subscriberID, subscibedToID)
 }
 
+// DeleteBlock mocks base method.
+func (m *MockSubscriptionsStorage) DeleteBlock(ctx context.Context, blockerID, blockedID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlock", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlock indicates an expected call of DeleteBlock.
+func (mr *MockSubscriptionsStorageMockRecorder) DeleteBlock(ctx, blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockSubscriptionsStorage)(nil).DeleteBlock), ctx, blockerID, blockedID)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockSubscriptionsStorage) GetBlockedUsers(ctx context.Context, blockerID uint) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", ctx, blockerID)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockSubscriptionsStorageMockRecorder) GetBlockedUsers(ctx, blockerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockSubscriptionsStorage)(nil).GetBlockedUsers), ctx, blockerID)
+}
+
 // GetBySubscriberAndSubscribedToID mocks base method.
 func (m *MockSubscriptionsStorage) GetBySubscriberAndSubscribedToID(ctx context.Context, subscriberID, subscribedToID uint) (*domain.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +138,21 @@ func (mr *MockSubscriptionsStorageMockRecorder) GetSubscriptions(ctx, userID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockSubscriptionsStorage)(nil).GetSubscriptions), ctx, userID)
 }
 
+// IsBlocked mocks base method.
+func (m *MockSubscriptionsStorage) IsBlocked(ctx context.Context, userID, peerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, userID, peerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockSubscriptionsStorageMockRecorder) IsBlocked(ctx, userID, peerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockSubscriptionsStorage)(nil).IsBlocked), ctx, userID, peerID)
+}
+
 // Store mocks base method.
 func (m *MockSubscriptionsStorage) Store(ctx context.Context, sub *domain.Subscription) (*domain.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +168,21 @@ func (mr *MockSubscriptionsStorageMockRecorder) Store(ctx, sub interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockSubscriptionsStorage)(nil).Store), ctx, sub)
 }
 
+// StoreBlock mocks base method.
+func (m *MockSubscriptionsStorage) StoreBlock(ctx context.Context, block *domain.Block) (*domain.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreBlock", ctx, block)
+	ret0, _ := ret[0].(*domain.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreBlock indicates an expected call of StoreBlock.
+func (mr *MockSubscriptionsStorageMockRecorder) StoreBlock(ctx, block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreBlock", reflect.TypeOf((*MockSubscriptionsStorage)(nil).StoreBlock), ctx, block)
+}
+
 // MockUserStorage is a mock of UserStorage interface.
 type MockUserStorage struct {
 	ctrl     *gomock.Controller
@@ -147,6 +206,36 @@ func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
 	return m.recorder
 }
 
+// CanViewSubscriptions mocks base method.
+func (m *MockUserStorage) CanViewSubscriptions(ctx context.Context, userID, viewerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewSubscriptions", ctx, userID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewSubscriptions indicates an expected call of CanViewSubscriptions.
+func (mr *MockUserStorageMockRecorder) CanViewSubscriptions(ctx, userID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewSubscriptions", reflect.TypeOf((*MockUserStorage)(nil).CanViewSubscriptions), ctx, userID, viewerID)
+}
+
+// GetDateOfBirthViewableIDs mocks base method.
+func (m *MockUserStorage) GetDateOfBirthViewableIDs(ctx context.Context, viewerID uint, userIDs []uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDateOfBirthViewableIDs", ctx, viewerID, userIDs)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDateOfBirthViewableIDs indicates an expected call of GetDateOfBirthViewableIDs.
+func (mr *MockUserStorageMockRecorder) GetDateOfBirthViewableIDs(ctx, viewerID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDateOfBirthViewableIDs", reflect.TypeOf((*MockUserStorage)(nil).GetDateOfBirthViewableIDs), ctx, viewerID, userIDs)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserStorage) GetUserByID(ctx context.Context, userID uint) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/uploads/uploads.go
+
+// Package mock_uploads is a generated GoMock package.
+package mock_uploads
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "socio/domain"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadsStorage is a mock of UploadsStorage interface.
+type MockUploadsStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadsStorageMockRecorder
+}
+
+// MockUploadsStorageMockRecorder is the mock recorder for MockUploadsStorage.
+type MockUploadsStorageMockRecorder struct {
+	mock *MockUploadsStorage
+}
+
+// NewMockUploadsStorage creates a new mock instance.
+func NewMockUploadsStorage(ctrl *gomock.Controller) *MockUploadsStorage {
+	mock := &MockUploadsStorage{ctrl: ctrl}
+	mock.recorder = &MockUploadsStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadsStorage) EXPECT() *MockUploadsStorageMockRecorder {
+	return m.recorder
+}
+
+// CloseUpload mocks base method.
+func (m *MockUploadsStorage) CloseUpload(ctx context.Context, userID uint, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseUpload", ctx, userID, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseUpload indicates an expected call of CloseUpload.
+func (mr *MockUploadsStorageMockRecorder) CloseUpload(ctx, userID, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseUpload", reflect.TypeOf((*MockUploadsStorage)(nil).CloseUpload), ctx, userID, uploadID)
+}
+
+// DeleteUpload mocks base method.
+func (m *MockUploadsStorage) DeleteUpload(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *MockUploadsStorageMockRecorder) DeleteUpload(ctx, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*MockUploadsStorage)(nil).DeleteUpload), ctx, uploadID)
+}
+
+// GetUpload mocks base method.
+func (m *MockUploadsStorage) GetUpload(ctx context.Context, uploadID string) (*domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, uploadID)
+	ret0, _ := ret[0].(*domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockUploadsStorageMockRecorder) GetUpload(ctx, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*MockUploadsStorage)(nil).GetUpload), ctx, uploadID)
+}
+
+// LockUpload mocks base method.
+func (m *MockUploadsStorage) LockUpload(ctx context.Context, uploadID string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUpload", ctx, uploadID, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUpload indicates an expected call of LockUpload.
+func (mr *MockUploadsStorageMockRecorder) LockUpload(ctx, uploadID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUpload", reflect.TypeOf((*MockUploadsStorage)(nil).LockUpload), ctx, uploadID, ttl)
+}
+
+// OpenUpload mocks base method.
+func (m *MockUploadsStorage) OpenUpload(ctx context.Context, userID uint, uploadID string, expiresAt time.Time, limit int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenUpload", ctx, userID, uploadID, expiresAt, limit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenUpload indicates an expected call of OpenUpload.
+func (mr *MockUploadsStorageMockRecorder) OpenUpload(ctx, userID, uploadID, expiresAt, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenUpload", reflect.TypeOf((*MockUploadsStorage)(nil).OpenUpload), ctx, userID, uploadID, expiresAt, limit)
+}
+
+// StoreUpload mocks base method.
+func (m *MockUploadsStorage) StoreUpload(ctx context.Context, upload *domain.Upload, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreUpload", ctx, upload, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreUpload indicates an expected call of StoreUpload.
+func (mr *MockUploadsStorageMockRecorder) StoreUpload(ctx, upload, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUpload", reflect.TypeOf((*MockUploadsStorage)(nil).StoreUpload), ctx, upload, ttl)
+}
+
+// UnlockUpload mocks base method.
+func (m *MockUploadsStorage) UnlockUpload(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUpload", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUpload indicates an expected call of UnlockUpload.
+func (mr *MockUploadsStorageMockRecorder) UnlockUpload(ctx, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUpload", reflect.TypeOf((*MockUploadsStorage)(nil).UnlockUpload), ctx, uploadID)
+}
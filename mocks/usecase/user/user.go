@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 	domain "socio/domain"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,36 @@ func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
 	return m.recorder
 }
 
+// CanViewSubscriptions mocks base method.
+func (m *MockUserStorage) CanViewSubscriptions(ctx context.Context, userID, viewerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewSubscriptions", ctx, userID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewSubscriptions indicates an expected call of CanViewSubscriptions.
+func (mr *MockUserStorageMockRecorder) CanViewSubscriptions(ctx, userID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewSubscriptions", reflect.TypeOf((*MockUserStorage)(nil).CanViewSubscriptions), ctx, userID, viewerID)
+}
+
+// CheckIfEmailIsVerified mocks base method.
+func (m *MockUserStorage) CheckIfEmailIsVerified(ctx context.Context, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfEmailIsVerified", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfEmailIsVerified indicates an expected call of CheckIfEmailIsVerified.
+func (mr *MockUserStorageMockRecorder) CheckIfEmailIsVerified(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfEmailIsVerified", reflect.TypeOf((*MockUserStorage)(nil).CheckIfEmailIsVerified), ctx, userID)
+}
+
 // CheckIfUserIsAdmin mocks base method.
 func (m *MockUserStorage) CheckIfUserIsAdmin(ctx context.Context, publicGroupID, userID uint) (bool, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +81,51 @@ func (mr *MockUserStorageMockRecorder) CheckIfUserIsAdmin(ctx, publicGroupID, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsAdmin", reflect.TypeOf((*MockUserStorage)(nil).CheckIfUserIsAdmin), ctx, publicGroupID, userID)
 }
 
+// CheckIfUserIsBanned mocks base method.
+func (m *MockUserStorage) CheckIfUserIsBanned(ctx context.Context, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfUserIsBanned", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsBanned indicates an expected call of CheckIfUserIsBanned.
+func (mr *MockUserStorageMockRecorder) CheckIfUserIsBanned(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsBanned", reflect.TypeOf((*MockUserStorage)(nil).CheckIfUserIsBanned), ctx, userID)
+}
+
+// CheckIfUserIsModerator mocks base method.
+func (m *MockUserStorage) CheckIfUserIsModerator(ctx context.Context, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfUserIsModerator", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfUserIsModerator indicates an expected call of CheckIfUserIsModerator.
+func (mr *MockUserStorageMockRecorder) CheckIfUserIsModerator(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfUserIsModerator", reflect.TypeOf((*MockUserStorage)(nil).CheckIfUserIsModerator), ctx, userID)
+}
+
+// CountByName mocks base method.
+func (m *MockUserStorage) CountByName(ctx context.Context, viewerID uint, query string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByName", ctx, viewerID, query)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByName indicates an expected call of CountByName.
+func (mr *MockUserStorageMockRecorder) CountByName(ctx, viewerID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByName", reflect.TypeOf((*MockUserStorage)(nil).CountByName), ctx, viewerID, query)
+}
+
 // DeletePublicGroupAdmin mocks base method.
 func (m *MockUserStorage) DeletePublicGroupAdmin(ctx context.Context, publicGroupAdmin *domain.PublicGroupAdmin) error {
 	m.ctrl.T.Helper()
@@ -93,6 +169,36 @@ func (mr *MockUserStorageMockRecorder) GetAdminsByPublicGroupID(ctx, publicGroup
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminsByPublicGroupID", reflect.TypeOf((*MockUserStorage)(nil).GetAdminsByPublicGroupID), ctx, publicGroupID)
 }
 
+// GetDateOfBirthViewableIDs mocks base method.
+func (m *MockUserStorage) GetDateOfBirthViewableIDs(ctx context.Context, viewerID uint, userIDs []uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDateOfBirthViewableIDs", ctx, viewerID, userIDs)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDateOfBirthViewableIDs indicates an expected call of GetDateOfBirthViewableIDs.
+func (mr *MockUserStorageMockRecorder) GetDateOfBirthViewableIDs(ctx, viewerID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDateOfBirthViewableIDs", reflect.TypeOf((*MockUserStorage)(nil).GetDateOfBirthViewableIDs), ctx, viewerID, userIDs)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockUserStorage) GetPrivacySettings(ctx context.Context, userID uint) (*domain.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", ctx, userID)
+	ret0, _ := ret[0].(*domain.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockUserStorageMockRecorder) GetPrivacySettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockUserStorage)(nil).GetPrivacySettings), ctx, userID)
+}
+
 // GetSubscriptionIDs mocks base method.
 func (m *MockUserStorage) GetSubscriptionIDs(ctx context.Context, userID uint) ([]uint, error) {
 	m.ctrl.T.Helper()
@@ -156,18 +262,47 @@ func (mr *MockUserStorageMockRecorder) GetUserByIDWithSubsInfo(ctx, userID, auth
 }
 
 // SearchByName mocks base method.
-func (m *MockUserStorage) SearchByName(ctx context.Context, query string) ([]*domain.User, error) {
+func (m *MockUserStorage) SearchByName(ctx context.Context, viewerID uint, query string, lastUserID, limit uint) ([]*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchByName", ctx, query)
+	ret := m.ctrl.Call(m, "SearchByName", ctx, viewerID, query, lastUserID, limit)
 	ret0, _ := ret[0].([]*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchByName indicates an expected call of SearchByName.
-func (mr *MockUserStorageMockRecorder) SearchByName(ctx, query interface{}) *gomock.Call {
+func (mr *MockUserStorageMockRecorder) SearchByName(ctx, viewerID, query, lastUserID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockUserStorage)(nil).SearchByName), ctx, viewerID, query, lastUserID, limit)
+}
+
+// StoreEmailVerificationToken mocks base method.
+func (m *MockUserStorage) StoreEmailVerificationToken(ctx context.Context, userID uint, email, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreEmailVerificationToken", ctx, userID, email, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreEmailVerificationToken indicates an expected call of StoreEmailVerificationToken.
+func (mr *MockUserStorageMockRecorder) StoreEmailVerificationToken(ctx, userID, email, tokenHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreEmailVerificationToken", reflect.TypeOf((*MockUserStorage)(nil).StoreEmailVerificationToken), ctx, userID, email, tokenHash, expiresAt)
+}
+
+// StorePrivacySettings mocks base method.
+func (m *MockUserStorage) StorePrivacySettings(ctx context.Context, settings *domain.PrivacySettings) (*domain.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePrivacySettings", ctx, settings)
+	ret0, _ := ret[0].(*domain.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorePrivacySettings indicates an expected call of StorePrivacySettings.
+func (mr *MockUserStorageMockRecorder) StorePrivacySettings(ctx, settings interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockUserStorage)(nil).SearchByName), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePrivacySettings", reflect.TypeOf((*MockUserStorage)(nil).StorePrivacySettings), ctx, settings)
 }
 
 // StorePublicGroupAdmin mocks base method.
@@ -199,6 +334,21 @@ func (mr *MockUserStorageMockRecorder) StoreUser(ctx, user interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUser", reflect.TypeOf((*MockUserStorage)(nil).StoreUser), ctx, user)
 }
 
+// StoreUserBan mocks base method.
+func (m *MockUserStorage) StoreUserBan(ctx context.Context, ban *domain.UserBan) (*domain.UserBan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreUserBan", ctx, ban)
+	ret0, _ := ret[0].(*domain.UserBan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreUserBan indicates an expected call of StoreUserBan.
+func (mr *MockUserStorageMockRecorder) StoreUserBan(ctx, ban interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUserBan", reflect.TypeOf((*MockUserStorage)(nil).StoreUserBan), ctx, ban)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserStorage) UpdateUser(ctx context.Context, user *domain.User, prevPassword string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +364,21 @@ func (mr *MockUserStorageMockRecorder) UpdateUser(ctx, user, prevPassword interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserStorage)(nil).UpdateUser), ctx, user, prevPassword)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUserStorage) VerifyEmail(ctx context.Context, tokenHash string, now time.Time) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, tokenHash, now)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserStorageMockRecorder) VerifyEmail(ctx, tokenHash, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserStorage)(nil).VerifyEmail), ctx, tokenHash, now)
+}
+
 // MockAvatarStorage is a mock of AvatarStorage interface.
 type MockAvatarStorage struct {
 	ctrl     *gomock.Controller
@@ -264,3 +429,40 @@ func (mr *MockAvatarStorageMockRecorder) Store(fileName, filePath, contentType i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockAvatarStorage)(nil).Store), fileName, filePath, contentType)
 }
+
+// MockEmailOutbox is a mock of EmailOutbox interface.
+type MockEmailOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailOutboxMockRecorder
+}
+
+// MockEmailOutboxMockRecorder is the mock recorder for MockEmailOutbox.
+type MockEmailOutboxMockRecorder struct {
+	mock *MockEmailOutbox
+}
+
+// NewMockEmailOutbox creates a new mock instance.
+func NewMockEmailOutbox(ctrl *gomock.Controller) *MockEmailOutbox {
+	mock := &MockEmailOutbox{ctrl: ctrl}
+	mock.recorder = &MockEmailOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailOutbox) EXPECT() *MockEmailOutboxMockRecorder {
+	return m.recorder
+}
+
+// Push mocks base method.
+func (m *MockEmailOutbox) Push(ctx context.Context, email *domain.Email) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockEmailOutboxMockRecorder) Push(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockEmailOutbox)(nil).Push), ctx, email)
+}
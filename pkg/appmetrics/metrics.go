@@ -134,6 +134,69 @@ var (
 		},
 		[]string{"system"},
 	)
+	GCScannedObjects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_scanned_objects_total",
+			Help: "Count of objects scanned by garbage collector.",
+		},
+		[]string{"bucket"},
+	)
+	GCOrphanedObjects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_orphaned_objects_total",
+			Help: "Count of orphaned objects found by garbage collector.",
+		},
+		[]string{"bucket"},
+	)
+	GCDeletedObjects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_deleted_objects_total",
+			Help: "Count of objects deleted by garbage collector.",
+		},
+		[]string{"bucket"},
+	)
+	GCDeletedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_deleted_bytes_total",
+			Help: "Size of objects deleted by garbage collector.",
+		},
+		[]string{"bucket"},
+	)
+	GCErrorsCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_errors_count",
+			Help: "Count of errors in garbage collector.",
+		},
+		[]string{"bucket"},
+	)
+	GCRunDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_run_duration_ms",
+			Help: "Duration of the last garbage collector run.",
+		},
+		[]string{"bucket"},
+	)
+	ContentFilterRuleDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "content_filter_rule_decisions_total",
+			Help: "Count of decisions made by content filter rules.",
+		},
+		[]string{"kind", "rule", "verdict"},
+	)
+	ContentFilterVerdicts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "content_filter_verdicts_total",
+			Help: "Count of final content filter verdicts.",
+		},
+		[]string{"kind", "verdict"},
+	)
+	RateLimitDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rate_limit_decisions_total",
+			Help: "Count of decisions made by rate limiter.",
+		},
+		[]string{"scope", "decision"},
+	)
 )
 
 func TrackAppExternalServiceMetrics(systemName string, startTime customtime.CustomTime, err error) {
@@ -0,0 +1,29 @@
+package clientip
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// FromRequest returns the IP of the client. The X-Real-IP header set by nginx
+// from the address of the connection is preferred, then the last hop of
+// X-Forwarded-For, the one appended by the proxy, since the hops before it come
+// from the client and can be forged.
+func FromRequest(r *http.Request) string {
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return strings.TrimSpace(realIP)
+	}
+
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		return strings.TrimSpace(hops[len(hops)-1])
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,57 @@
+package clientip
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		remoteAddr string
+		want       string
+	}{
+		{
+			name:       "Real IP preferred",
+			headers:    map[string]string{"X-Forwarded-For": "10.0.0.1, 10.0.0.2", "X-Real-IP": "10.0.0.3"},
+			remoteAddr: "127.0.0.1:1234",
+			want:       "10.0.0.3",
+		},
+		{
+			name:       "Forwarded for last hop",
+			headers:    map[string]string{"X-Forwarded-For": "10.0.0.1, 10.0.0.2"},
+			remoteAddr: "127.0.0.1:1234",
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "Real IP",
+			headers:    map[string]string{"X-Real-IP": " 10.0.0.3 "},
+			remoteAddr: "127.0.0.1:1234",
+			want:       "10.0.0.3",
+		},
+		{
+			name:       "Remote address",
+			remoteAddr: "127.0.0.1:1234",
+			want:       "127.0.0.1",
+		},
+		{
+			name:       "Remote address without port",
+			remoteAddr: "127.0.0.1",
+			want:       "127.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for key, value := range tt.headers {
+				r.Header.Set(key, value)
+			}
+
+			if got := FromRequest(r); got != tt.want {
+				t.Errorf("FromRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
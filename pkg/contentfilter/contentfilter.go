@@ -0,0 +1,155 @@
+package contentfilter
+
+import (
+	"context"
+	"os"
+	"socio/pkg/appmetrics"
+	customtime "socio/pkg/time"
+	"strings"
+	"time"
+)
+
+const (
+	BannedWordsEnv = "CONTENT_FILTER_BANNED_WORDS"
+
+	DefaultMaxLinks          = 3
+	DefaultDuplicateWindow   = 10 * time.Minute
+	DefaultDuplicateLimit    = 3
+	DefaultDuplicateMinRunes = 20
+	DefaultVelocityWindow    = time.Minute
+	DefaultVelocityLimit     = 20
+)
+
+type Kind string
+
+const (
+	PostContent    Kind = "post"
+	CommentContent Kind = "comment"
+	MessageContent Kind = "message"
+)
+
+type Verdict string
+
+const (
+	AcceptVerdict Verdict = "ACCEPT"
+	RejectVerdict Verdict = "REJECT"
+	HoldVerdict   Verdict = "HOLD"
+)
+
+// Content is the text checked before it is stored, CreatedAt is set by the
+// filter.
+type Content struct {
+	Kind      Kind
+	AuthorID  uint
+	Text      string
+	CreatedAt time.Time
+}
+
+// Rule decides whether the content is accepted, rejected or held for
+// moderation, err is the reason of the rejection shown to the author.
+type Rule interface {
+	Name() string
+	Check(ctx context.Context, content *Content) (verdict Verdict, err error)
+}
+
+type Decision struct {
+	Verdict Verdict
+	Rule    string
+	Err     error
+}
+
+type Config struct {
+	BannedWords       []string
+	MaxLinks          int
+	DuplicateWindow   time.Duration
+	DuplicateLimit    int
+	DuplicateMinRunes int
+	VelocityWindow    time.Duration
+	VelocityLimit     int
+}
+
+func DefaultConfig() (config Config) {
+	config = Config{
+		MaxLinks:          DefaultMaxLinks,
+		DuplicateWindow:   DefaultDuplicateWindow,
+		DuplicateLimit:    DefaultDuplicateLimit,
+		DuplicateMinRunes: DefaultDuplicateMinRunes,
+		VelocityWindow:    DefaultVelocityWindow,
+		VelocityLimit:     DefaultVelocityLimit,
+	}
+	return
+}
+
+// ConfigFromEnv returns the default config with the comma separated banned
+// words taken from the environment.
+func ConfigFromEnv() (config Config) {
+	config = DefaultConfig()
+
+	for _, word := range strings.Split(os.Getenv(BannedWordsEnv), ",") {
+		word = strings.TrimSpace(word)
+		if word != "" {
+			config.BannedWords = append(config.BannedWords, word)
+		}
+	}
+
+	return
+}
+
+// Filter runs the rules one by one: the first rejection stops the pipeline,
+// a hold is returned if no rule rejects the content. Every checked content
+// is recorded in the history of the author, so the rules built on the
+// history see the rejected attempts too.
+type Filter struct {
+	Rules        []Rule
+	History      *History
+	TimeProvider customtime.TimeProvider
+}
+
+func NewFilter(config Config, tp customtime.TimeProvider) (filter *Filter) {
+	filter = &Filter{
+		History:      NewHistory(max(config.DuplicateWindow, config.VelocityWindow)),
+		TimeProvider: tp,
+	}
+
+	if len(config.BannedWords) > 0 {
+		filter.Rules = append(filter.Rules, NewBannedWordsRule(config.BannedWords))
+	}
+
+	if config.MaxLinks > 0 {
+		filter.Rules = append(filter.Rules, NewLinksRule(config.MaxLinks))
+	}
+
+	if config.DuplicateLimit > 0 {
+		filter.Rules = append(filter.Rules, NewDuplicateRule(filter.History, config.DuplicateWindow, config.DuplicateLimit, config.DuplicateMinRunes))
+	}
+
+	if config.VelocityLimit > 0 {
+		filter.Rules = append(filter.Rules, NewVelocityRule(filter.History, config.VelocityWindow, config.VelocityLimit))
+	}
+
+	return
+}
+
+func (f *Filter) Check(ctx context.Context, content *Content) (decision Decision) {
+	content.CreatedAt = f.TimeProvider.Now()
+	decision.Verdict = AcceptVerdict
+
+	for _, rule := range f.Rules {
+		verdict, err := rule.Check(ctx, content)
+		appmetrics.ContentFilterRuleDecisions.WithLabelValues(string(content.Kind), rule.Name(), string(verdict)).Inc()
+
+		if verdict == RejectVerdict {
+			decision = Decision{Verdict: RejectVerdict, Rule: rule.Name(), Err: err}
+			break
+		}
+
+		if verdict == HoldVerdict && decision.Verdict == AcceptVerdict {
+			decision = Decision{Verdict: HoldVerdict, Rule: rule.Name()}
+		}
+	}
+
+	f.History.Record(content)
+	appmetrics.ContentFilterVerdicts.WithLabelValues(string(content.Kind), string(decision.Verdict)).Inc()
+
+	return
+}
@@ -0,0 +1,78 @@
+package contentfilter_test
+
+import (
+	"context"
+	"socio/errors"
+	"socio/pkg/contentfilter"
+	customtime "socio/pkg/time"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterCheck(t *testing.T) {
+	t.Parallel()
+
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+	config.VelocityLimit = 4
+
+	tests := []struct {
+		name         string
+		texts        []string
+		wantDecision contentfilter.Decision
+	}{
+		{
+			name:         "Test accept",
+			texts:        []string{"hello"},
+			wantDecision: contentfilter.Decision{Verdict: contentfilter.AcceptVerdict},
+		},
+		{
+			name:         "Test hold",
+			texts:        []string{"https://a.com https://b.com"},
+			wantDecision: contentfilter.Decision{Verdict: contentfilter.HoldVerdict, Rule: contentfilter.LinksRuleName},
+		},
+		{
+			name:         "Test reject wins over hold",
+			texts:        []string{"casino https://a.com https://b.com"},
+			wantDecision: contentfilter.Decision{Verdict: contentfilter.RejectVerdict, Rule: contentfilter.BannedWordsRuleName, Err: errors.ErrBannedContent},
+		},
+		{
+			name: "Test duplicate",
+			texts: []string{
+				"follow my page for the best memes",
+				"follow my page for the best memes",
+				"follow my page for the best memes",
+				"follow my page for the best memes",
+			},
+			wantDecision: contentfilter.Decision{Verdict: contentfilter.RejectVerdict, Rule: contentfilter.DuplicateRuleName, Err: errors.ErrDuplicateContent},
+		},
+		{
+			name:         "Test rejected attempts are counted",
+			texts:        []string{"casino", "casino", "casino", "casino", "hello"},
+			wantDecision: contentfilter.Decision{Verdict: contentfilter.RejectVerdict, Rule: contentfilter.VelocityRuleName, Err: errors.ErrContentTooFrequent},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			var decision contentfilter.Decision
+			for _, text := range tt.texts {
+				decision = filter.Check(context.Background(), &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: text})
+			}
+
+			assert.Equal(t, tt.wantDecision, decision)
+		})
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv(contentfilter.BannedWordsEnv, "casino, spam,,")
+
+	config := contentfilter.ConfigFromEnv()
+	assert.Equal(t, []string{"casino", "spam"}, config.BannedWords)
+	assert.Equal(t, contentfilter.DefaultVelocityLimit, config.VelocityLimit)
+}
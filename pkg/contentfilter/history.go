@@ -0,0 +1,99 @@
+package contentfilter
+
+import (
+	"sync"
+	"time"
+)
+
+type historyKey struct {
+	kind     Kind
+	authorID uint
+}
+
+type historyEntry struct {
+	fingerprint string
+	createdAt   time.Time
+}
+
+// History keeps the recent content of every author in memory, the entries
+// older than the retention are dropped on the next record of the author.
+// Once per retention the record also sweeps the authors who have been idle
+// for longer than the retention, so the history does not grow with every
+// author ever seen. Each process running the filter has its own history.
+type History struct {
+	mu          sync.Mutex
+	entries     map[historyKey][]historyEntry
+	retention   time.Duration
+	lastSweepAt time.Time
+}
+
+func NewHistory(retention time.Duration) (history *History) {
+	history = &History{
+		entries:   make(map[historyKey][]historyEntry),
+		retention: retention,
+	}
+	return
+}
+
+func (h *History) Record(content *Content) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := historyKey{kind: content.Kind, authorID: content.AuthorID}
+	expiredAt := content.CreatedAt.Add(-h.retention)
+
+	if content.CreatedAt.Sub(h.lastSweepAt) >= h.retention {
+		h.sweep(expiredAt)
+		h.lastSweepAt = content.CreatedAt
+	}
+
+	entries := h.entries[key]
+	for len(entries) > 0 && entries[0].createdAt.Before(expiredAt) {
+		entries = entries[1:]
+	}
+
+	h.entries[key] = append(entries, historyEntry{
+		fingerprint: fingerprint(content.Text),
+		createdAt:   content.CreatedAt,
+	})
+}
+
+// sweep deletes the authors whose latest entry expired, the caller must
+// hold the lock.
+func (h *History) sweep(expiredAt time.Time) {
+	for key, entries := range h.entries {
+		if entries[len(entries)-1].createdAt.Before(expiredAt) {
+			delete(h.entries, key)
+		}
+	}
+}
+
+// Len returns the amount of the authors kept in the history.
+func (h *History) Len() (length int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	length = len(h.entries)
+	return
+}
+
+// Count returns the amount of the content of the author created since the
+// given time, only the content with the fingerprint is counted if it is set.
+func (h *History) Count(kind Kind, authorID uint, since time.Time, fingerprint string) (count int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, entry := range h.entries[historyKey{kind: kind, authorID: authorID}] {
+		if entry.createdAt.Before(since) {
+			continue
+		}
+
+		if fingerprint != "" && entry.fingerprint != fingerprint {
+			continue
+		}
+
+		count++
+	}
+
+	return
+}
@@ -0,0 +1,205 @@
+package contentfilter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"socio/errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	BannedWordsRuleName = "banned_words"
+	LinksRuleName       = "links"
+	DuplicateRuleName   = "duplicate"
+	VelocityRuleName    = "velocity"
+)
+
+var (
+	linkRegexp = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+`)
+
+	// homoglyphs maps the cyrillic letters to the latin letters they look
+	// like, so the words written with mixed alphabets match the same word.
+	homoglyphs = map[rune]rune{
+		'а': 'a',
+		'в': 'b',
+		'е': 'e',
+		'ё': 'e',
+		'к': 'k',
+		'м': 'm',
+		'н': 'h',
+		'о': 'o',
+		'р': 'p',
+		'с': 'c',
+		'т': 't',
+		'у': 'y',
+		'х': 'x',
+		'і': 'i',
+		'ї': 'i',
+		'ј': 'j',
+		'ѕ': 's',
+		'ԁ': 'd',
+		'ԛ': 'q',
+		'ԝ': 'w',
+		'һ': 'h',
+		'ү': 'y',
+	}
+)
+
+// Normalize lowers the text and replaces the cyrillic homoglyphs with the
+// latin letters.
+func Normalize(text string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if latin, ok := homoglyphs[r]; ok {
+			return latin
+		}
+		return r
+	}, text)
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(Normalize(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func fingerprint(text string) string {
+	sum := sha256.Sum256([]byte(strings.Join(words(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// BannedWordsRule rejects the content containing any of the banned words.
+type BannedWordsRule struct {
+	bannedWords map[string]struct{}
+}
+
+func NewBannedWordsRule(bannedWords []string) (rule *BannedWordsRule) {
+	rule = &BannedWordsRule{
+		bannedWords: make(map[string]struct{}, len(bannedWords)),
+	}
+
+	for _, word := range bannedWords {
+		rule.bannedWords[Normalize(word)] = struct{}{}
+	}
+
+	return
+}
+
+func (r *BannedWordsRule) Name() string {
+	return BannedWordsRuleName
+}
+
+func (r *BannedWordsRule) Check(ctx context.Context, content *Content) (verdict Verdict, err error) {
+	for _, word := range words(content.Text) {
+		if _, ok := r.bannedWords[word]; ok {
+			verdict = RejectVerdict
+			err = errors.ErrBannedContent
+			return
+		}
+	}
+
+	verdict = AcceptVerdict
+	return
+}
+
+// LinksRule holds the content with more links than allowed for moderation.
+type LinksRule struct {
+	maxLinks int
+}
+
+func NewLinksRule(maxLinks int) (rule *LinksRule) {
+	rule = &LinksRule{
+		maxLinks: maxLinks,
+	}
+	return
+}
+
+func (r *LinksRule) Name() string {
+	return LinksRuleName
+}
+
+func (r *LinksRule) Check(ctx context.Context, content *Content) (verdict Verdict, err error) {
+	if len(linkRegexp.FindAllStringIndex(content.Text, r.maxLinks+1)) > r.maxLinks {
+		verdict = HoldVerdict
+		return
+	}
+
+	verdict = AcceptVerdict
+	return
+}
+
+// DuplicateRule rejects the content the author has already sent limit times
+// within the window. Short texts are not checked, they are repeated
+// naturally.
+type DuplicateRule struct {
+	history  *History
+	window   time.Duration
+	limit    int
+	minRunes int
+}
+
+func NewDuplicateRule(history *History, window time.Duration, limit, minRunes int) (rule *DuplicateRule) {
+	rule = &DuplicateRule{
+		history:  history,
+		window:   window,
+		limit:    limit,
+		minRunes: minRunes,
+	}
+	return
+}
+
+func (r *DuplicateRule) Name() string {
+	return DuplicateRuleName
+}
+
+func (r *DuplicateRule) Check(ctx context.Context, content *Content) (verdict Verdict, err error) {
+	verdict = AcceptVerdict
+
+	if utf8.RuneCountInString(content.Text) < r.minRunes {
+		return
+	}
+
+	if r.history.Count(content.Kind, content.AuthorID, content.CreatedAt.Add(-r.window), fingerprint(content.Text)) >= r.limit {
+		verdict = RejectVerdict
+		err = errors.ErrDuplicateContent
+	}
+
+	return
+}
+
+// VelocityRule rejects the content of the author who has already sent limit
+// pieces of content of the same kind within the window.
+type VelocityRule struct {
+	history *History
+	window  time.Duration
+	limit   int
+}
+
+func NewVelocityRule(history *History, window time.Duration, limit int) (rule *VelocityRule) {
+	rule = &VelocityRule{
+		history: history,
+		window:  window,
+		limit:   limit,
+	}
+	return
+}
+
+func (r *VelocityRule) Name() string {
+	return VelocityRuleName
+}
+
+func (r *VelocityRule) Check(ctx context.Context, content *Content) (verdict Verdict, err error) {
+	verdict = AcceptVerdict
+
+	if r.history.Count(content.Kind, content.AuthorID, content.CreatedAt.Add(-r.window), "") >= r.limit {
+		verdict = RejectVerdict
+		err = errors.ErrContentTooFrequent
+	}
+
+	return
+}
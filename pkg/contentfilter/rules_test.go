@@ -0,0 +1,240 @@
+package contentfilter_test
+
+import (
+	"context"
+	"socio/errors"
+	"socio/pkg/contentfilter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "Test latin",
+			text: "Casino",
+			want: "casino",
+		},
+		{
+			name: "Test mixed alphabets",
+			text: "Саsinо",
+			want: "casino",
+		},
+		{
+			name: "Test cyrillic",
+			text: "КАЗИНО",
+			want: "kaзиho",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, contentfilter.Normalize(tt.text))
+		})
+	}
+}
+
+func TestBannedWordsRule(t *testing.T) {
+	t.Parallel()
+
+	rule := contentfilter.NewBannedWordsRule([]string{"casino", "казино"})
+
+	tests := []struct {
+		name        string
+		text        string
+		wantVerdict contentfilter.Verdict
+		wantErr     error
+	}{
+		{
+			name:        "Test clean text",
+			text:        "see you at the cinema",
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test banned word",
+			text:        "best CASINO in town!",
+			wantVerdict: contentfilter.RejectVerdict,
+			wantErr:     errors.ErrBannedContent,
+		},
+		{
+			name:        "Test homoglyphs",
+			text:        "лучшее kaзинo, заходи",
+			wantVerdict: contentfilter.RejectVerdict,
+			wantErr:     errors.ErrBannedContent,
+		},
+		{
+			name:        "Test part of word",
+			text:        "casinos are closed",
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := rule.Check(context.Background(), &contentfilter.Content{Text: tt.text})
+			assert.Equal(t, tt.wantVerdict, verdict)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestLinksRule(t *testing.T) {
+	t.Parallel()
+
+	rule := contentfilter.NewLinksRule(2)
+
+	tests := []struct {
+		name        string
+		text        string
+		wantVerdict contentfilter.Verdict
+	}{
+		{
+			name:        "Test no links",
+			text:        "hello",
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test allowed links",
+			text:        "https://a.com and www.b.com",
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test too many links",
+			text:        "https://a.com http://b.com WWW.c.com",
+			wantVerdict: contentfilter.HoldVerdict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := rule.Check(context.Background(), &contentfilter.Content{Text: tt.text})
+			assert.Equal(t, tt.wantVerdict, verdict)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestDuplicateRule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	text := "buy the best followers right now"
+
+	history := contentfilter.NewHistory(time.Hour)
+	history.Record(&contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: text, CreatedAt: now.Add(-2 * time.Hour)})
+	history.Record(&contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: "Buy the best followers, right now!", CreatedAt: now.Add(-time.Minute)})
+	history.Record(&contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: text, CreatedAt: now.Add(-time.Second)})
+
+	rule := contentfilter.NewDuplicateRule(history, time.Hour, 2, 10)
+
+	tests := []struct {
+		name        string
+		content     *contentfilter.Content
+		wantVerdict contentfilter.Verdict
+		wantErr     error
+	}{
+		{
+			name:        "Test duplicate",
+			content:     &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: text, CreatedAt: now},
+			wantVerdict: contentfilter.RejectVerdict,
+			wantErr:     errors.ErrDuplicateContent,
+		},
+		{
+			name:        "Test other author",
+			content:     &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 2, Text: text, CreatedAt: now},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test other kind",
+			content:     &contentfilter.Content{Kind: contentfilter.CommentContent, AuthorID: 1, Text: text, CreatedAt: now},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test short text",
+			content:     &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: "buy", CreatedAt: now},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test window passed",
+			content:     &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: text, CreatedAt: now.Add(time.Hour - 30*time.Second)},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := rule.Check(context.Background(), tt.content)
+			assert.Equal(t, tt.wantVerdict, verdict)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestVelocityRule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	history := contentfilter.NewHistory(time.Minute)
+	for i := 0; i < 3; i++ {
+		history.Record(&contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: 1, Text: "hi", CreatedAt: now.Add(-time.Duration(i) * 20 * time.Second)})
+	}
+
+	rule := contentfilter.NewVelocityRule(history, time.Minute, 3)
+
+	tests := []struct {
+		name        string
+		content     *contentfilter.Content
+		wantVerdict contentfilter.Verdict
+		wantErr     error
+	}{
+		{
+			name:        "Test too frequent",
+			content:     &contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: 1, CreatedAt: now},
+			wantVerdict: contentfilter.RejectVerdict,
+			wantErr:     errors.ErrContentTooFrequent,
+		},
+		{
+			name:        "Test oldest left window",
+			content:     &contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: 1, CreatedAt: now.Add(30 * time.Second)},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+		{
+			name:        "Test other author",
+			content:     &contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: 2, CreatedAt: now},
+			wantVerdict: contentfilter.AcceptVerdict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := rule.Check(context.Background(), tt.content)
+			assert.Equal(t, tt.wantVerdict, verdict)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHistorySweep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	history := contentfilter.NewHistory(time.Minute)
+	history.Record(&contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 1, Text: "hi", CreatedAt: now})
+	history.Record(&contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: 2, Text: "hi", CreatedAt: now.Add(30 * time.Second)})
+	assert.Equal(t, 2, history.Len())
+
+	history.Record(&contentfilter.Content{Kind: contentfilter.CommentContent, AuthorID: 3, Text: "hi", CreatedAt: now.Add(80 * time.Second)})
+	assert.Equal(t, 2, history.Len())
+	assert.Equal(t, 0, history.Count(contentfilter.PostContent, 1, now, ""))
+	assert.Equal(t, 1, history.Count(contentfilter.PostContent, 2, now, ""))
+}
@@ -0,0 +1,64 @@
+package hash
+
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	DefaultBcryptCost = 12
+)
+
+type BcryptHasher struct {
+	Cost int
+}
+
+func NewBcryptHasher(cost int) *BcryptHasher {
+	return &BcryptHasher{
+		Cost: cost,
+	}
+}
+
+func (h *BcryptHasher) Hash(password string) (encodedHash string, err error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return
+	}
+
+	encodedHash = string(hashed)
+	return
+}
+
+func (h *BcryptHasher) Verify(encodedHash, password string) (ok bool, err error) {
+	err = bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		err = ErrInvalidHash
+		return
+	}
+
+	ok = true
+	return
+}
+
+func (h *BcryptHasher) NeedsRehash(encodedHash string) bool {
+	if !isBcryptHash(encodedHash) {
+		return true
+	}
+
+	cost, err := bcrypt.Cost([]byte(encodedHash))
+	if err != nil {
+		return true
+	}
+
+	return cost != h.Cost
+}
+
+func isBcryptHash(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$2a$") ||
+		strings.HasPrefix(encodedHash, "$2b$") ||
+		strings.HasPrefix(encodedHash, "$2y$")
+}
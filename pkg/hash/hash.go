@@ -3,8 +3,45 @@ package hash
 import (
 	"crypto/sha512"
 	"encoding/hex"
+	"errors"
+	"strings"
 )
 
+const (
+	Argon2idAlgorithm = "argon2id"
+	BcryptAlgorithm   = "bcrypt"
+
+	legacyHashLength = sha512.Size * 2
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown password hashing algorithm")
+	ErrInvalidHash      = errors.New("invalid encoded password hash")
+)
+
+// PasswordHasher produces self-describing password hashes, which carry
+// their own algorithm parameters and salt.
+type PasswordHasher interface {
+	Hash(password string) (encodedHash string, err error)
+	Verify(encodedHash, password string) (ok bool, err error)
+	NeedsRehash(encodedHash string) bool
+}
+
+func NewPasswordHasher(algorithm string) (hasher PasswordHasher, err error) {
+	switch algorithm {
+	case Argon2idAlgorithm, "":
+		hasher = NewArgon2idHasher(DefaultArgon2idParams)
+	case BcryptAlgorithm:
+		hasher = NewBcryptHasher(DefaultBcryptCost)
+	default:
+		err = ErrUnknownAlgorithm
+	}
+
+	return
+}
+
+// HashPassword is the legacy salted SHA-512 hash. It is kept only to verify
+// passwords stored before the migration to PasswordHasher.
 func HashPassword(password string, salt []byte) (hashedPassword string) {
 	passwordBytes := []byte(password)
 
@@ -20,3 +57,27 @@ func HashPassword(password string, salt []byte) (hashedPassword string) {
 func MatchPasswords(hashedPassword, currPassword string, salt []byte) (ok bool) {
 	return hashedPassword == HashPassword(currPassword, salt)
 }
+
+func IsLegacyHash(encodedHash string) bool {
+	if len(encodedHash) != legacyHashLength {
+		return false
+	}
+
+	_, err := hex.DecodeString(encodedHash)
+	return err == nil
+}
+
+// VerifyPassword checks password against a hash in any supported format.
+// salt is used only for legacy hashes.
+func VerifyPassword(encodedHash, password string, salt []byte) (ok bool, err error) {
+	switch {
+	case strings.HasPrefix(encodedHash, argon2idPrefix):
+		return NewArgon2idHasher(DefaultArgon2idParams).Verify(encodedHash, password)
+	case isBcryptHash(encodedHash):
+		return NewBcryptHasher(DefaultBcryptCost).Verify(encodedHash, password)
+	case IsLegacyHash(encodedHash):
+		return MatchPasswords(encodedHash, password, salt), nil
+	default:
+		return false, ErrInvalidHash
+	}
+}
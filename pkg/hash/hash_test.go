@@ -2,6 +2,7 @@ package hash_test
 
 import (
 	"socio/pkg/hash"
+	"strings"
 	"testing"
 )
 
@@ -33,3 +34,182 @@ func TestHashPassword(t *testing.T) {
 		})
 	}
 }
+
+var testArgon2idParams = hash.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type HasherTestCase struct {
+	Hasher          hash.PasswordHasher
+	Prefix          string
+	OutdatedHasher  hash.PasswordHasher
+	ForeignHashFunc func(password string) string
+}
+
+var HasherTestCases = map[string]HasherTestCase{
+	"argon2id": {
+		Hasher: hash.NewArgon2idHasher(testArgon2idParams),
+		Prefix: "$argon2id$v=19$m=1024,t=1,p=1$",
+		OutdatedHasher: hash.NewArgon2idHasher(hash.Argon2idParams{
+			Memory:      512,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		}),
+	},
+	"bcrypt": {
+		Hasher:         hash.NewBcryptHasher(5),
+		Prefix:         "$2a$05$",
+		OutdatedHasher: hash.NewBcryptHasher(4),
+	},
+}
+
+func TestPasswordHasher(t *testing.T) {
+	for name, tc := range HasherTestCases {
+		t.Run(name, func(t *testing.T) {
+			encodedHash, err := tc.Hasher.Hash("password")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !strings.HasPrefix(encodedHash, tc.Prefix) {
+				t.Errorf("wrong hash format: got %s, expected prefix %s", encodedHash, tc.Prefix)
+			}
+
+			otherHash, err := tc.Hasher.Hash("password")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if otherHash == encodedHash {
+				t.Errorf("hashes of the same password should be salted")
+			}
+
+			ok, err := tc.Hasher.Verify(encodedHash, "password")
+			if err != nil || !ok {
+				t.Errorf("password should match: ok %v, err %v", ok, err)
+			}
+
+			ok, err = tc.Hasher.Verify(encodedHash, "wrong_password")
+			if err != nil || ok {
+				t.Errorf("password should not match: ok %v, err %v", ok, err)
+			}
+
+			ok, err = hash.VerifyPassword(encodedHash, "password", nil)
+			if err != nil || !ok {
+				t.Errorf("VerifyPassword should match: ok %v, err %v", ok, err)
+			}
+
+			if tc.Hasher.NeedsRehash(encodedHash) {
+				t.Errorf("fresh hash should not need rehash")
+			}
+
+			outdatedHash, err := tc.OutdatedHasher.Hash("password")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !tc.Hasher.NeedsRehash(outdatedHash) {
+				t.Errorf("hash with outdated params should need rehash")
+			}
+
+			if !tc.Hasher.NeedsRehash(hash.HashPassword("password", []byte("salt"))) {
+				t.Errorf("legacy hash should need rehash")
+			}
+		})
+	}
+}
+
+type VerifyPasswordTestCase struct {
+	EncodedHash string
+	Password    string
+	Salt        []byte
+	Match       bool
+	WantErr     bool
+}
+
+var VerifyPasswordTestCases = map[string]VerifyPasswordTestCase{
+	"legacy match": {
+		EncodedHash: hash.HashPassword("admin", []byte("salt")),
+		Password:    "admin",
+		Salt:        []byte("salt"),
+		Match:       true,
+	},
+	"legacy wrong salt": {
+		EncodedHash: hash.HashPassword("admin", []byte("salt")),
+		Password:    "admin",
+		Salt:        []byte("pepper"),
+		Match:       false,
+	},
+	"argon2id corrupted": {
+		EncodedHash: "$argon2id$v=19$m=1024,t=1,p=1$not-base64$",
+		Password:    "admin",
+		WantErr:     true,
+	},
+	"argon2id wrong version": {
+		EncodedHash: "$argon2id$v=16$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$c2FsdHNhbHRzYWx0c2FsdA",
+		Password:    "admin",
+		WantErr:     true,
+	},
+	"unknown format": {
+		EncodedHash: "plain",
+		Password:    "plain",
+		WantErr:     true,
+	},
+}
+
+func TestVerifyPassword(t *testing.T) {
+	for name, tc := range VerifyPasswordTestCases {
+		t.Run(name, func(t *testing.T) {
+			ok, err := hash.VerifyPassword(tc.EncodedHash, tc.Password, tc.Salt)
+			if (err != nil) != tc.WantErr {
+				t.Errorf("unexpected error: %v, wantErr %v", err, tc.WantErr)
+				return
+			}
+
+			if ok != tc.Match {
+				t.Errorf("wrong match result: got %v, expected %v", ok, tc.Match)
+			}
+		})
+	}
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	for _, algorithm := range []string{"", hash.Argon2idAlgorithm, hash.BcryptAlgorithm} {
+		if _, err := hash.NewPasswordHasher(algorithm); err != nil {
+			t.Errorf("unexpected error for %q: %v", algorithm, err)
+		}
+	}
+
+	if _, err := hash.NewPasswordHasher("md5"); err != hash.ErrUnknownAlgorithm {
+		t.Errorf("expected ErrUnknownAlgorithm, got %v", err)
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	token, tokenHash, err := hash.NewToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tokenHash != hash.HashToken(token) {
+		t.Errorf("token hash %q does not match the token", tokenHash)
+	}
+
+	otherToken, _, err := hash.NewToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token == otherToken {
+		t.Errorf("expected different tokens, got %q twice", token)
+	}
+}
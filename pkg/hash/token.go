@@ -0,0 +1,32 @@
+package hash
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const tokenLength = 32
+
+// NewToken generates the random URL safe token sent to the user by email.
+// Only the hash of the token is stored, so the leaked storage does not let
+// anyone use the tokens.
+func NewToken() (token string, tokenHash string, err error) {
+	b := make([]byte, tokenLength)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	tokenHash = HashToken(token)
+
+	return
+}
+
+func HashToken(token string) (tokenHash string) {
+	sum := sha256.Sum256([]byte(token))
+	tokenHash = hex.EncodeToString(sum[:])
+
+	return
+}
@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"socio/domain"
+	"sync"
+)
+
+// LogMailer writes the emails to the writer, e.g. stdout or a file, so the
+// links sent to the users can be followed without the SMTP server.
+type LogMailer struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewLogMailer(writer io.Writer) (m *LogMailer) {
+	return &LogMailer{
+		writer: writer,
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, email *domain.Email) (err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err = fmt.Fprintf(m.writer, "To: %s\nSubject: %s\n\n%s\n\n", email.To, email.Subject, email.Body)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,41 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"socio/domain"
+)
+
+const (
+	SMTPKind = "smtp"
+	LogKind  = "log"
+
+	// PublicURLEnv is the URL of the frontend the links in the emails lead to.
+	PublicURLEnv = "APP_PUBLIC_URL"
+)
+
+var (
+	ErrUnknownKind   = errors.New("unknown mailer kind")
+	ErrInvalidHeader = errors.New("invalid email header")
+)
+
+// Mailer delivers the emails to the recipients.
+type Mailer interface {
+	Send(ctx context.Context, email *domain.Email) (err error)
+}
+
+// NewMailer creates the mailer of the kind, the log mailer writes the emails
+// to logWriter instead of sending them and is meant for local development.
+func NewMailer(kind string, smtpConfig SMTPConfig, logWriter io.Writer) (mailer Mailer, err error) {
+	switch kind {
+	case LogKind, "":
+		mailer = NewLogMailer(logWriter)
+	case SMTPKind:
+		mailer = NewSMTPMailer(smtpConfig)
+	default:
+		err = ErrUnknownKind
+	}
+
+	return
+}
@@ -0,0 +1,225 @@
+package mailer_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/smtp"
+	"socio/domain"
+	"socio/pkg/mailer"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMailer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		kind     string
+		expected mailer.Mailer
+		wantErr  error
+	}{
+		{
+			name:     "Test default",
+			kind:     "",
+			expected: &mailer.LogMailer{},
+		},
+		{
+			name:     "Test log",
+			kind:     mailer.LogKind,
+			expected: &mailer.LogMailer{},
+		},
+		{
+			name:     "Test smtp",
+			kind:     mailer.SMTPKind,
+			expected: &mailer.SMTPMailer{},
+		},
+		{
+			name:    "Test unknown",
+			kind:    "pigeon",
+			wantErr: mailer.ErrUnknownKind,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mailer.NewMailer(tt.kind, mailer.SMTPConfig{Host: "localhost", Port: "25"}, &bytes.Buffer{})
+			assert.Equal(t, tt.wantErr, err)
+			assert.IsType(t, tt.expected, got)
+		})
+	}
+}
+
+func TestLogMailerSend(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	m := mailer.NewLogMailer(buf)
+
+	err := m.Send(context.Background(), &domain.Email{
+		To:      "user@mail.ru",
+		Subject: "Subject",
+		Body:    "Body",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "To: user@mail.ru\nSubject: Subject\n\nBody\n\n", buf.String())
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		email       *domain.Email
+		sendErr     error
+		expectedMsg string
+		wantErr     error
+	}{
+		{
+			name: "Test OK",
+			email: &domain.Email{
+				To:      "user@mail.ru",
+				Subject: "Subject",
+				Body:    "first line\nsecond line",
+			},
+			expectedMsg: "From: socio@mail.ru\r\nTo: user@mail.ru\r\nSubject: Subject\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\nfirst line\r\nsecond line\r\n",
+		},
+		{
+			name: "Test header injection",
+			email: &domain.Email{
+				To:      "user@mail.ru",
+				Subject: "Subject\r\nBcc: other@mail.ru",
+				Body:    "Body",
+			},
+			wantErr: mailer.ErrInvalidHeader,
+		},
+		{
+			name: "Test send error",
+			email: &domain.Email{
+				To:      "user@mail.ru",
+				Subject: "Subject",
+				Body:    "Body",
+			},
+			sendErr: errors.New("connection refused"),
+			wantErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sentMsg string
+
+			m := mailer.NewSMTPMailer(mailer.SMTPConfig{Host: "localhost", Port: "25", From: "socio@mail.ru"})
+			m.SendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				assert.Equal(t, "localhost:25", addr)
+				assert.Equal(t, "socio@mail.ru", from)
+				assert.Equal(t, []string{tt.email.To}, to)
+
+				sentMsg = string(msg)
+				return tt.sendErr
+			}
+
+			err := m.Send(context.Background(), tt.email)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expectedMsg, sentMsg)
+			}
+		})
+	}
+}
+
+type fakeOutbox struct {
+	emails []*domain.Email
+	err    error
+}
+
+func (o *fakeOutbox) Pop(ctx context.Context, timeout time.Duration) (email *domain.Email, err error) {
+	if o.err != nil {
+		err = o.err
+		return
+	}
+
+	if len(o.emails) == 0 {
+		return
+	}
+
+	email, o.emails = o.emails[0], o.emails[1:]
+
+	return
+}
+
+type fakeMailer struct {
+	sent []*domain.Email
+	err  error
+}
+
+func (m *fakeMailer) Send(ctx context.Context, email *domain.Email) (err error) {
+	if m.err != nil {
+		return m.err
+	}
+
+	m.sent = append(m.sent, email)
+
+	return
+}
+
+func TestSenderSendNext(t *testing.T) {
+	t.Parallel()
+
+	email := &domain.Email{To: "user@mail.ru", Subject: "Subject", Body: "Body"}
+
+	tests := []struct {
+		name         string
+		outbox       *fakeOutbox
+		mailer       *fakeMailer
+		expectedSent []*domain.Email
+		wantErr      error
+	}{
+		{
+			name:         "Test OK",
+			outbox:       &fakeOutbox{emails: []*domain.Email{email}},
+			mailer:       &fakeMailer{},
+			expectedSent: []*domain.Email{email},
+		},
+		{
+			name:   "Test empty outbox",
+			outbox: &fakeOutbox{},
+			mailer: &fakeMailer{},
+		},
+		{
+			name:    "Test outbox error",
+			outbox:  &fakeOutbox{err: errors.New("outbox error")},
+			mailer:  &fakeMailer{},
+			wantErr: errors.New("outbox error"),
+		},
+		{
+			name:    "Test mailer error",
+			outbox:  &fakeOutbox{emails: []*domain.Email{email}},
+			mailer:  &fakeMailer{err: errors.New("mailer error")},
+			wantErr: errors.New("mailer error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sender := mailer.NewSender(tt.outbox, tt.mailer)
+
+			err := sender.SendNext(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expectedSent, tt.mailer.sent)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package mailer
+
+import (
+	"context"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"time"
+)
+
+const (
+	DefaultPopTimeout = 5 * time.Second
+	DefaultRetryDelay = time.Second
+)
+
+type Outbox interface {
+	Pop(ctx context.Context, timeout time.Duration) (email *domain.Email, err error)
+}
+
+// Sender delivers the emails queued in the outbox by the services. An email
+// the mailer fails to send is logged and dropped, retrying it could block the
+// rest of the queue.
+type Sender struct {
+	Outbox     Outbox
+	Mailer     Mailer
+	PopTimeout time.Duration
+	RetryDelay time.Duration
+}
+
+func NewSender(outbox Outbox, mailer Mailer) (s *Sender) {
+	return &Sender{
+		Outbox:     outbox,
+		Mailer:     mailer,
+		PopTimeout: DefaultPopTimeout,
+		RetryDelay: DefaultRetryDelay,
+	}
+}
+
+// Run sends the emails until the context is done.
+func (s *Sender) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := s.SendNext(ctx); err != nil {
+			contextlogger.LogErr(ctx, err)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(s.RetryDelay):
+			}
+		}
+	}
+}
+
+// SendNext waits up to PopTimeout for the next email and sends it, nothing is
+// sent if the outbox stays empty.
+func (s *Sender) SendNext(ctx context.Context) (err error) {
+	email, err := s.Outbox.Pop(ctx, s.PopTimeout)
+	if err != nil {
+		return
+	}
+
+	if email == nil {
+		return
+	}
+
+	err = s.Mailer.Send(ctx, email)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"socio/domain"
+	"strings"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPMailer sends the plain text emails through the SMTP server, the server
+// is authenticated with PLAIN auth if the username is set.
+type SMTPMailer struct {
+	Addr     string
+	From     string
+	Auth     smtp.Auth
+	SendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPMailer(config SMTPConfig) (m *SMTPMailer) {
+	m = &SMTPMailer{
+		Addr:     net.JoinHostPort(config.Host, config.Port),
+		From:     config.From,
+		SendMail: smtp.SendMail,
+	}
+
+	if config.Username != "" {
+		m.Auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	return
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, email *domain.Email) (err error) {
+	msg, err := m.buildMessage(email)
+	if err != nil {
+		return
+	}
+
+	err = m.SendMail(m.Addr, m.Auth, m.From, []string{email.To}, msg)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// buildMessage refuses the headers with line breaks, otherwise the values
+// could inject the headers of their own.
+func (m *SMTPMailer) buildMessage(email *domain.Email) (msg []byte, err error) {
+	for _, header := range []string{m.From, email.To, email.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			err = ErrInvalidHeader
+			return
+		}
+	}
+
+	body := strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n")
+
+	msg = []byte(fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		m.From, email.To, email.Subject, body,
+	))
+
+	return
+}
@@ -41,6 +41,10 @@ func (s *Sanitizer) SanitizePost(post *domain.Post) {
 	for i := range post.Attachments {
 		post.Attachments[i] = s.Sanitize(post.Attachments[i])
 	}
+
+	for _, attachment := range post.AttachmentsInfo {
+		s.SanitizeAttachment(attachment)
+	}
 }
 
 func (s *Sanitizer) SanitizePostWithAuthor(post *domain.PostWithAuthor) {
@@ -62,6 +66,19 @@ func (s *Sanitizer) SanitizePersonalMessage(message *domain.PersonalMessage) {
 	for i := range message.Attachments {
 		message.Attachments[i] = s.Sanitize(message.Attachments[i])
 	}
+
+	for _, attachment := range message.AttachmentsInfo {
+		s.SanitizeAttachment(attachment)
+	}
+}
+
+func (s *Sanitizer) SanitizeAttachment(attachment *domain.Attachment) {
+	if attachment == nil {
+		return
+	}
+
+	attachment.FileName = s.Sanitize(attachment.FileName)
+	attachment.OriginalName = s.Sanitize(attachment.OriginalName)
 }
 
 func (s *Sanitizer) SanitizeDialog(dialog *domain.Dialog) {
@@ -74,6 +91,20 @@ func (s *Sanitizer) SanitizeDialog(dialog *domain.Dialog) {
 	s.SanitizePersonalMessage(dialog.LastMessage)
 }
 
+func (s *Sanitizer) SanitizeConversation(conversation *domain.Conversation) {
+	if conversation == nil {
+		return
+	}
+
+	conversation.Name = s.Sanitize(conversation.Name)
+
+	for _, participant := range conversation.Participants {
+		s.SanitizeUser(participant.User)
+	}
+
+	s.SanitizePersonalMessage(conversation.LastMessage)
+}
+
 func (s *Sanitizer) SanitizePublicGroup(publicGroup *domain.PublicGroup) {
 	if publicGroup == nil {
 		return
@@ -90,6 +121,10 @@ func (s *Sanitizer) SanitizeComment(comment *domain.Comment) {
 	}
 
 	comment.Content = s.Sanitize(comment.Content)
+
+	for _, reply := range comment.Replies {
+		s.SanitizeComment(reply)
+	}
 }
 
 func (s *Sanitizer) SanitizeComments(comments []*domain.Comment) {
@@ -111,3 +146,13 @@ func (s *Sanitizer) SanitizeStickers(stickers []*domain.Sticker) {
 		s.SanitizeSticker(sticker)
 	}
 }
+
+func (s *Sanitizer) SanitizeSession(session *domain.Session) {
+	if session == nil {
+		return
+	}
+
+	session.Device = s.Sanitize(session.Device)
+	session.IP = s.Sanitize(session.IP)
+	session.UserAgent = s.Sanitize(session.UserAgent)
+}
@@ -54,6 +54,10 @@ func TestSanitizer_SanitizePostWithAuthor(t *testing.T) {
 				post: &domain.PostWithAuthor{
 					Post: &domain.Post{
 						Content: "<script>alert('Hello')</script>",
+						AttachmentsInfo: []*domain.Attachment{
+							{OriginalName: "<script>alert('Hello')</script>"},
+							nil,
+						},
 					},
 					Author: &domain.User{
 						FirstName: "<script>alert('Hello')</script>",
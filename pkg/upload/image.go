@@ -0,0 +1,392 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"socio/errors"
+)
+
+const (
+	// MaxPixels guards against decompression bombs, the dimensions are read
+	// before the image is decoded.
+	MaxPixels = 50_000_000
+
+	JPEGQuality = 90
+)
+
+const (
+	jpegSOS  = 0xDA
+	jpegAPP1 = 0xE1
+	jpegIPTC = 0xED
+	jpegCOM  = 0xFE
+
+	exifOrientationTag = 0x0112
+)
+
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	exifHeader   = []byte("Exif\x00\x00")
+
+	// pngMetadataChunks may carry EXIF, camera, author or time data.
+	pngMetadataChunks = map[string]struct{}{
+		"eXIf": {},
+		"tEXt": {},
+		"zTXt": {},
+		"iTXt": {},
+		"tIME": {},
+	}
+)
+
+type processedImage struct {
+	original []byte
+	image    image.Image
+}
+
+func isImage(contentType string) bool {
+	for _, imageType := range imageTypes {
+		if contentType == imageType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// processImage strips the metadata losslessly where possible, a JPEG is
+// re-encoded only when its EXIF orientation has to be applied to the pixels.
+func processImage(data []byte, contentType string) (img *processedImage, err error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		err = errors.ErrUnsupportedMediaType
+		return
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		err = errors.ErrFileTooLarge
+		return
+	}
+
+	img = &processedImage{}
+	orientation := 1
+
+	switch contentType {
+	case "image/jpeg":
+		img.original, orientation, err = stripJPEG(data)
+	case "image/png":
+		img.original, err = stripPNG(data)
+	default:
+		img.original = data
+	}
+	if err != nil {
+		img = nil
+		return
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(img.original))
+	if err != nil {
+		img = nil
+		err = errors.ErrUnsupportedMediaType
+		return
+	}
+
+	img.image = decoded
+
+	if orientation != 1 {
+		img.image = orient(toRGBA(decoded), orientation)
+
+		buf := new(bytes.Buffer)
+
+		err = jpeg.Encode(buf, img.image, &jpeg.Options{Quality: JPEGQuality})
+		if err != nil {
+			img = nil
+			return
+		}
+
+		img.original = buf.Bytes()
+	}
+
+	return
+}
+
+// stripJPEG drops the APP1 (EXIF, XMP), APP13 (IPTC) and comment segments and
+// returns the EXIF orientation found on the way.
+func stripJPEG(data []byte) (res []byte, orientation int, err error) {
+	orientation = 1
+
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		err = errors.ErrUnsupportedMediaType
+		return
+	}
+
+	res = make([]byte, 0, len(data))
+	res = append(res, data[:2]...)
+
+	for i := 2; i < len(data); {
+		if data[i] != 0xFF || i+1 >= len(data) {
+			err = errors.ErrUnsupportedMediaType
+			return
+		}
+
+		marker := data[i+1]
+		if marker == 0xFF {
+			i++
+			continue
+		}
+
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			res = append(res, data[i:i+2]...)
+			i += 2
+			continue
+		}
+
+		if marker == jpegSOS {
+			res = append(res, data[i:]...)
+			return
+		}
+
+		if i+4 > len(data) {
+			err = errors.ErrUnsupportedMediaType
+			return
+		}
+
+		end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:i+4]))
+		if end > len(data) {
+			err = errors.ErrUnsupportedMediaType
+			return
+		}
+
+		segment := data[i:end]
+
+		switch marker {
+		case jpegAPP1:
+			if o := exifOrientation(segment[4:]); o != 0 {
+				orientation = o
+			}
+		case jpegIPTC, jpegCOM:
+		default:
+			res = append(res, segment...)
+		}
+
+		i = end
+	}
+
+	return
+}
+
+// exifOrientation reads the orientation tag of IFD0, 0 if there is none.
+func exifOrientation(payload []byte) int {
+	if !bytes.HasPrefix(payload, exifHeader) {
+		return 0
+	}
+
+	tiff := payload[len(exifHeader):]
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 0
+	}
+
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for j := 0; j < entries; j++ {
+		entry := ifd + 2 + j*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) == exifOrientationTag {
+			orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+			if orientation < 1 || orientation > 8 {
+				return 0
+			}
+
+			return orientation
+		}
+	}
+
+	return 0
+}
+
+func stripPNG(data []byte) (res []byte, err error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		err = errors.ErrUnsupportedMediaType
+		return
+	}
+
+	res = make([]byte, 0, len(data))
+	res = append(res, pngSignature...)
+
+	for i := len(pngSignature); i < len(data); {
+		if i+8 > len(data) {
+			err = errors.ErrUnsupportedMediaType
+			return
+		}
+
+		end := i + 12 + int(binary.BigEndian.Uint32(data[i:i+4]))
+		if end > len(data) || end < i {
+			err = errors.ErrUnsupportedMediaType
+			return
+		}
+
+		chunkType := string(data[i+4 : i+8])
+		if _, ok := pngMetadataChunks[chunkType]; !ok {
+			res = append(res, data[i:end]...)
+		}
+
+		i = end
+
+		if chunkType == "IEND" {
+			break
+		}
+	}
+
+	return
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	if rgba, ok := src.(*image.RGBA); ok {
+		return rgba
+	}
+
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+
+	return dst
+}
+
+// orient applies the EXIF orientation, so the image is shown upright without
+// the tag.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			default:
+				dx, dy = x, y
+			}
+
+			si := src.PixOffset(src.Rect.Min.X+x, src.Rect.Min.Y+y)
+			di := dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+
+	return dst
+}
+
+// fitSize scales w x h down to fit into maxSide x maxSide keeping the aspect
+// ratio.
+func fitSize(w, h, maxSide int) (dw, dh int) {
+	dw, dh = w, h
+	if w >= h && w > maxSide {
+		dw, dh = maxSide, max(1, h*maxSide/w)
+	} else if h > w && h > maxSide {
+		dw, dh = max(1, w*maxSide/h), maxSide
+	}
+
+	return
+}
+
+// resizeToFit scales the image down so that its longest side is at most
+// maxSide, averaging the covered source pixels. Images are never upscaled.
+func resizeToFit(src image.Image, maxSide int) *image.RGBA {
+	rgba := toRGBA(src)
+	w, h := rgba.Bounds().Dx(), rgba.Bounds().Dy()
+
+	dw, dh := fitSize(w, h, maxSide)
+
+	if dw == w && dh == h {
+		return rgba
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for dy := 0; dy < dh; dy++ {
+		y0, y1 := dy*h/dh, max((dy+1)*h/dh, dy*h/dh+1)
+
+		for dx := 0; dx < dw; dx++ {
+			x0, x1 := dx*w/dw, max((dx+1)*w/dw, dx*w/dw+1)
+
+			var sum [4]int
+			for y := y0; y < y1; y++ {
+				row := rgba.PixOffset(rgba.Rect.Min.X+x0, rgba.Rect.Min.Y+y)
+				for x := x0; x < x1; x++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(rgba.Pix[row+c])
+					}
+					row += 4
+				}
+			}
+
+			count := (y1 - y0) * (x1 - x0)
+			di := dst.PixOffset(dx, dy)
+			for c := 0; c < 4; c++ {
+				dst.Pix[di+c] = uint8(sum[c] / count)
+			}
+		}
+	}
+
+	return dst
+}
+
+// encodeVariant keeps JPEG as JPEG, everything else becomes PNG to preserve
+// transparency. An animated GIF variant shows the first frame.
+func encodeVariant(img image.Image, contentType string) (data []byte, variantType string, err error) {
+	buf := new(bytes.Buffer)
+
+	if contentType == "image/jpeg" {
+		variantType = "image/jpeg"
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: JPEGQuality})
+	} else {
+		variantType = "image/png"
+		err = png.Encode(buf, img)
+	}
+	if err != nil {
+		return
+	}
+
+	data = buf.Bytes()
+	return
+}
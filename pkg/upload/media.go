@@ -0,0 +1,146 @@
+package upload
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+const (
+	mp4BoxHeaderLen = 8
+
+	// maxBoxDepth is enough for moov/mvhd, deeper boxes are never needed.
+	maxBoxDepth = 2
+)
+
+// mediaDuration returns the duration of MP4 video and WAV audio in
+// milliseconds. It is 0 for other types and for files it can not parse.
+func mediaDuration(r io.ReaderAt, size int64, contentType string) uint {
+	switch contentType {
+	case "video/mp4":
+		return mp4Duration(r, 0, size, 0)
+	case "audio/wave":
+		return wavDuration(r, size)
+	default:
+		return 0
+	}
+}
+
+// mp4Duration walks the boxes between start and end looking for moov/mvhd,
+// the movie header holds the duration of the longest track.
+func mp4Duration(r io.ReaderAt, start, end int64, depth int) uint {
+	header := make([]byte, 16)
+
+	for offset := start; offset+mp4BoxHeaderLen <= end; {
+		if _, err := r.ReadAt(header[:mp4BoxHeaderLen], offset); err != nil {
+			return 0
+		}
+
+		boxSize := int64(binary.BigEndian.Uint32(header[:4]))
+		boxType := string(header[4:8])
+		headerLen := int64(mp4BoxHeaderLen)
+
+		switch boxSize {
+		case 0:
+			boxSize = end - offset
+		case 1:
+			if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+				return 0
+			}
+
+			boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerLen += 8
+		}
+
+		if boxSize < headerLen || offset+boxSize > end {
+			return 0
+		}
+
+		switch {
+		case boxType == "moov" && depth < maxBoxDepth:
+			return mp4Duration(r, offset+headerLen, offset+boxSize, depth+1)
+		case boxType == "mvhd":
+			return mvhdDuration(r, offset+headerLen, boxSize-headerLen)
+		}
+
+		offset += boxSize
+	}
+
+	return 0
+}
+
+func mvhdDuration(r io.ReaderAt, offset, size int64) uint {
+	// version 1 has 64-bit times and duration, version 0 has 32-bit ones
+	data := make([]byte, min(size, 32))
+	if _, err := r.ReadAt(data, offset); err != nil || len(data) < 20 {
+		return 0
+	}
+
+	var timescale, duration uint64
+
+	if data[0] == 1 {
+		if len(data) < 32 {
+			return 0
+		}
+
+		timescale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	}
+
+	if timescale == 0 {
+		return 0
+	}
+
+	return uint(duration * 1000 / timescale)
+}
+
+// wavDuration divides the size of the data chunk by the byte rate of the fmt
+// chunk.
+func wavDuration(r io.ReaderAt, size int64) uint {
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return 0
+	}
+
+	if string(header[:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return 0
+	}
+
+	var byteRate uint64
+	chunk := make([]byte, 16)
+
+	for offset := int64(12); offset+8 <= size; {
+		if _, err := r.ReadAt(chunk[:8], offset); err != nil {
+			return 0
+		}
+
+		chunkType := string(chunk[:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+
+		switch chunkType {
+		case "fmt ":
+			if chunkSize < 16 {
+				return 0
+			}
+
+			if _, err := r.ReadAt(chunk, offset+8); err != nil {
+				return 0
+			}
+
+			byteRate = uint64(binary.LittleEndian.Uint32(chunk[8:12]))
+		case "data":
+			if byteRate == 0 {
+				return 0
+			}
+
+			return uint(uint64(min(chunkSize, size-offset-8)) * 1000 / byteRate)
+		}
+
+		// chunks are padded to an even size
+		offset += 8 + chunkSize + chunkSize%2
+	}
+
+	return 0
+}
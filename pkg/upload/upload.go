@@ -0,0 +1,578 @@
+package upload
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	errorsDef "errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"socio/domain"
+	"socio/errors"
+	"strings"
+)
+
+type Kind string
+
+const (
+	AvatarKind            Kind = "avatar"
+	GroupAvatarKind       Kind = "group_avatar"
+	PostAttachmentKind    Kind = "post_attachment"
+	StickerKind           Kind = "sticker"
+	MessageAttachmentKind Kind = "message_attachment"
+)
+
+const (
+	// SniffLen is the amount of bytes the content type is detected by.
+	SniffLen = 512
+	// MaxFormFieldsSize is allowed on top of the files of the multipart
+	// form for its text fields and part headers.
+	MaxFormFieldsSize = 1 << 20
+	// MaxFormMemory is the part of the multipart form kept in memory, the
+	// rest of the files is spooled to temporary files.
+	MaxFormMemory = 32 << 20
+)
+
+type VariantSpec struct {
+	Name    string
+	MaxSide int
+}
+
+type Policy struct {
+	MaxSize      int64
+	MaxFiles     int
+	AllowedTypes []string
+	Variants     []VariantSpec
+}
+
+var (
+	imageTypes = []string{"image/jpeg", "image/png", "image/gif"}
+	videoTypes = []string{"video/mp4", "video/webm"}
+	audioTypes = []string{"audio/mpeg", "audio/wave", "application/ogg"}
+	fileTypes  = []string{"application/pdf", "application/zip", "text/plain"}
+)
+
+// avatarPolicy is shared by the avatars of the users and the groups, they
+// only differ in the service storing them.
+var avatarPolicy = Policy{
+	MaxSize:      5 << 20,
+	MaxFiles:     1,
+	AllowedTypes: imageTypes,
+	Variants: []VariantSpec{
+		{Name: "small", MaxSide: 64},
+		{Name: "medium", MaxSide: 256},
+	},
+}
+
+var Policies = map[Kind]Policy{
+	AvatarKind:      avatarPolicy,
+	GroupAvatarKind: avatarPolicy,
+	PostAttachmentKind: {
+		MaxSize:      50 << 20,
+		MaxFiles:     10,
+		AllowedTypes: concat(imageTypes, videoTypes),
+		Variants: []VariantSpec{
+			{Name: "thumbnail", MaxSide: 320},
+			{Name: "preview", MaxSide: 1080},
+		},
+	},
+	StickerKind: {
+		MaxSize:      2 << 20,
+		MaxFiles:     1,
+		AllowedTypes: imageTypes,
+		Variants: []VariantSpec{
+			{Name: "thumbnail", MaxSide: 128},
+		},
+	},
+	MessageAttachmentKind: {
+		MaxSize:      25 << 20,
+		MaxFiles:     10,
+		AllowedTypes: concat(imageTypes, videoTypes, audioTypes, fileTypes),
+		Variants: []VariantSpec{
+			{Name: "thumbnail", MaxSide: 320},
+			{Name: "preview", MaxSide: 1080},
+		},
+	},
+}
+
+type Storage interface {
+	Store(fileName string, filePath string, contentType string) (err error)
+}
+
+// Index finds the stored file with the content of the given hex encoded
+// SHA-256, it returns errors.ErrNotFound if there is none.
+type Index interface {
+	FindByChecksum(checksum string) (fileName string, err error)
+}
+
+// IndexFunc adapts a function to the Index interface.
+type IndexFunc func(checksum string) (fileName string, err error)
+
+func (f IndexFunc) FindByChecksum(checksum string) (fileName string, err error) {
+	return f(checksum)
+}
+
+//easyjson:json
+type Variant struct {
+	Name     string `json:"name"`
+	FileName string `json:"fileName"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+}
+
+//easyjson:json
+type Metadata struct {
+	FileName    string     `json:"fileName"`
+	ContentType string     `json:"contentType"`
+	Size        int64      `json:"size"`
+	Width       int        `json:"width,omitempty"`
+	Height      int        `json:"height,omitempty"`
+	Duration    uint       `json:"duration,omitempty"`
+	Checksum    string     `json:"checksum"`
+	Variants    []*Variant `json:"variants,omitempty"`
+}
+
+func concat(lists ...[]string) (res []string) {
+	for _, list := range lists {
+		res = append(res, list...)
+	}
+
+	return
+}
+
+func getPolicy(kind Kind) (policy Policy, err error) {
+	policy, ok := Policies[kind]
+	if !ok {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
+
+// CheckSize lets the clients of the upload services reject too large files
+// before streaming them.
+func CheckSize(kind Kind, size int64) (err error) {
+	policy, err := getPolicy(kind)
+	if err != nil {
+		return
+	}
+
+	if size <= 0 {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	if size > policy.MaxSize {
+		err = errors.ErrFileTooLarge
+		return
+	}
+
+	return
+}
+
+// ParseMultipartForm limits the request body to the files allowed by the
+// policy of the kind plus the form fields and parses it. A too large body is
+// reported with errors.ErrFileTooLarge, too many files with
+// errors.ErrTooManyFiles.
+func ParseMultipartForm(w http.ResponseWriter, r *http.Request, kind Kind) (err error) {
+	policy, err := getPolicy(kind)
+	if err != nil {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, int64(policy.MaxFiles)*policy.MaxSize+MaxFormFieldsSize)
+
+	err = r.ParseMultipartForm(MaxFormMemory)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errorsDef.As(err, &maxBytesErr) {
+			err = errors.ErrFileTooLarge
+			return
+		}
+
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	filesAmount := 0
+	for _, fhs := range r.MultipartForm.File {
+		filesAmount += len(fhs)
+	}
+
+	if filesAmount > policy.MaxFiles {
+		_ = r.MultipartForm.RemoveAll()
+		err = errors.ErrTooManyFiles
+		return
+	}
+
+	return
+}
+
+// DetectContentType sniffs the MIME type by the content of the file, the
+// content type provided by the client is never trusted.
+func DetectContentType(r io.Reader) (contentType string, err error) {
+	head := make([]byte, SniffLen)
+
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return
+	}
+	err = nil
+
+	contentType, _, err = mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		err = errors.ErrUnsupportedMediaType
+		return
+	}
+
+	return
+}
+
+// CheckFileHeader validates the size and the sniffed content type of the
+// multipart file before it is sent anywhere.
+func CheckFileHeader(kind Kind, fh *multipart.FileHeader) (contentType string, err error) {
+	if fh == nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = CheckSize(kind, fh.Size)
+	if err != nil {
+		return
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	contentType, err = DetectContentType(file)
+	if err != nil {
+		return
+	}
+
+	err = CheckContentType(kind, contentType)
+	if err != nil {
+		contentType = ""
+		return
+	}
+
+	return
+}
+
+func CheckContentType(kind Kind, contentType string) (err error) {
+	policy, err := getPolicy(kind)
+	if err != nil {
+		return
+	}
+
+	for _, allowedType := range policy.AllowedTypes {
+		if contentType == allowedType {
+			return
+		}
+	}
+
+	err = errors.ErrUnsupportedMediaType
+	return
+}
+
+// VariantFileName puts the variant next to the original: avatar_<uuid>.jpg
+// gets avatar_<uuid>_small.jpg. Variants of non-JPEG images are PNG.
+func VariantFileName(fileName string, variantName string, contentType string) string {
+	ext := ".png"
+	if contentType == "image/jpeg" {
+		ext = ".jpg"
+	}
+
+	return strings.TrimSuffix(fileName, filepath.Ext(fileName)) + "_" + variantName + ext
+}
+
+// Process validates the uploaded file against the policy of its kind and
+// stores it with the sniffed content type. Images are stripped of EXIF and
+// other metadata, turned upright and get their variants stored next to them.
+func Process(storage Storage, kind Kind, fileName string, filePath string) (meta *Metadata, err error) {
+	meta, _, err = ProcessDeduplicated(storage, nil, kind, fileName, filePath)
+	return
+}
+
+// ProcessDeduplicated works as Process, but looks the content up in the index
+// first. If the same file is already stored, nothing is stored again and the
+// metadata of the stored file and its variants is returned with reused set.
+func ProcessDeduplicated(storage Storage, index Index, kind Kind, fileName string, filePath string) (meta *Metadata, reused bool, err error) {
+	file, err := prepare(kind, filePath)
+	if err != nil {
+		return
+	}
+
+	if index != nil {
+		var storedFileName string
+
+		storedFileName, err = index.FindByChecksum(file.meta.Checksum)
+		if err == nil {
+			meta = file.describe(storedFileName)
+			reused = true
+			return
+		}
+		if err != errors.ErrNotFound {
+			return
+		}
+	}
+
+	meta, err = file.store(storage, fileName)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// preparedFile is a validated upload, images are already stripped and
+// oriented, so the checksum is the one of the stored content.
+type preparedFile struct {
+	policy   Policy
+	meta     Metadata
+	img      *processedImage
+	filePath string
+}
+
+func prepare(kind Kind, filePath string) (file *preparedFile, err error) {
+	policy, err := getPolicy(kind)
+	if err != nil {
+		return
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return
+	}
+
+	err = CheckSize(kind, info.Size())
+	if err != nil {
+		return
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	contentType, err := DetectContentType(f)
+	if err != nil {
+		return
+	}
+
+	err = CheckContentType(kind, contentType)
+	if err != nil {
+		return
+	}
+
+	prepared := &preparedFile{
+		policy: policy,
+		meta: Metadata{
+			ContentType: contentType,
+			Size:        info.Size(),
+		},
+		filePath: filePath,
+	}
+
+	if !isImage(contentType) {
+		prepared.meta.Checksum, err = fileChecksum(f)
+		if err != nil {
+			return
+		}
+
+		prepared.meta.Duration = mediaDuration(f, info.Size(), contentType)
+
+		file = prepared
+		return
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return
+	}
+
+	prepared.img, err = processImage(data, contentType)
+	if err != nil {
+		return
+	}
+
+	bounds := prepared.img.image.Bounds()
+	prepared.meta.Width, prepared.meta.Height = bounds.Dx(), bounds.Dy()
+	prepared.meta.Size = int64(len(prepared.img.original))
+	prepared.meta.Checksum = checksum(prepared.img.original)
+
+	file = prepared
+	return
+}
+
+func (f *preparedFile) store(storage Storage, fileName string) (meta *Metadata, err error) {
+	contentType := f.meta.ContentType
+
+	if f.img == nil {
+		err = storage.Store(fileName, f.filePath, contentType)
+		if err != nil {
+			return
+		}
+
+		meta = f.describe(fileName)
+		return
+	}
+
+	err = storeBytes(storage, fileName, f.img.original, contentType)
+	if err != nil {
+		return
+	}
+
+	variants := make([]*Variant, 0, len(f.policy.Variants))
+
+	for _, spec := range f.policy.Variants {
+		variant := resizeToFit(f.img.image, spec.MaxSide)
+
+		var variantData []byte
+		var variantType string
+
+		variantData, variantType, err = encodeVariant(variant, contentType)
+		if err != nil {
+			return
+		}
+
+		variantFileName := VariantFileName(fileName, spec.Name, contentType)
+
+		err = storeBytes(storage, variantFileName, variantData, variantType)
+		if err != nil {
+			return
+		}
+
+		variants = append(variants, &Variant{
+			Name:     spec.Name,
+			FileName: variantFileName,
+			Width:    variant.Bounds().Dx(),
+			Height:   variant.Bounds().Dy(),
+		})
+	}
+
+	meta = f.describe(fileName)
+	meta.Variants = variants
+
+	return
+}
+
+// describe returns the metadata of the file stored as fileName, the variants
+// of an image are named and sized the way store names and sizes them.
+func (f *preparedFile) describe(fileName string) (meta *Metadata) {
+	meta = new(Metadata)
+	*meta = f.meta
+	meta.FileName = fileName
+
+	if f.img == nil {
+		return
+	}
+
+	for _, spec := range f.policy.Variants {
+		width, height := fitSize(meta.Width, meta.Height, spec.MaxSide)
+
+		meta.Variants = append(meta.Variants, &Variant{
+			Name:     spec.Name,
+			FileName: VariantFileName(fileName, spec.Name, meta.ContentType),
+			Width:    width,
+			Height:   height,
+		})
+	}
+
+	return
+}
+
+func storeBytes(storage Storage, fileName string, data []byte, contentType string) (err error) {
+	tmp, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, bytes.NewReader(data))
+	if err != nil {
+		tmp.Close()
+		return
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return
+	}
+
+	err = storage.Store(fileName, tmp.Name(), contentType)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func fileChecksum(file *os.File) (sum string, err error) {
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return
+	}
+
+	hash := sha256.New()
+
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return
+	}
+
+	sum = hex.EncodeToString(hash.Sum(nil))
+	return
+}
+
+// AttachmentKind groups the content types the way clients render them.
+func AttachmentKind(contentType string) domain.AttachmentKind {
+	switch {
+	case isImage(contentType):
+		return domain.ImageAttachment
+	case strings.HasPrefix(contentType, "video/"):
+		return domain.VideoAttachment
+	case strings.HasPrefix(contentType, "audio/"), contentType == "application/ogg":
+		return domain.AudioAttachment
+	default:
+		return domain.FileAttachment
+	}
+}
+
+// ToAttachment describes the processed upload, originalName is the file name
+// the client uploaded the file with.
+func ToAttachment(meta *Metadata, originalName string) *domain.Attachment {
+	if originalName != "" {
+		originalName = filepath.Base(originalName)
+	}
+
+	return &domain.Attachment{
+		FileName:     meta.FileName,
+		Kind:         AttachmentKind(meta.ContentType),
+		MimeType:     meta.ContentType,
+		Size:         meta.Size,
+		Width:        uint(meta.Width),
+		Height:       uint(meta.Height),
+		Duration:     meta.Duration,
+		OriginalName: originalName,
+		Checksum:     meta.Checksum,
+	}
+}
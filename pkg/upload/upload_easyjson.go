@@ -0,0 +1,263 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package upload
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDcaab663DecodeSocioPkgUpload(in *jlexer.Lexer, out *Variant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "fileName":
+			out.FileName = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDcaab663EncodeSocioPkgUpload(out *jwriter.Writer, in Variant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix)
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Variant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDcaab663EncodeSocioPkgUpload(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Variant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDcaab663EncodeSocioPkgUpload(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Variant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDcaab663DecodeSocioPkgUpload(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Variant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDcaab663DecodeSocioPkgUpload(l, v)
+}
+func easyjsonDcaab663DecodeSocioPkgUpload1(in *jlexer.Lexer, out *Metadata) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "fileName":
+			out.FileName = string(in.String())
+		case "contentType":
+			out.ContentType = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "duration":
+			out.Duration = uint(in.Uint())
+		case "checksum":
+			out.Checksum = string(in.String())
+		case "variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]*Variant, 0, 8)
+					} else {
+						out.Variants = []*Variant{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *Variant
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(Variant)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Variants = append(out.Variants, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDcaab663EncodeSocioPkgUpload1(out *jwriter.Writer, in Metadata) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"contentType\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	if in.Width != 0 {
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Duration))
+	}
+	{
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	if len(in.Variants) != 0 {
+		const prefix string = ",\"variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Variants {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Metadata) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDcaab663EncodeSocioPkgUpload1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Metadata) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDcaab663EncodeSocioPkgUpload1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Metadata) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDcaab663DecodeSocioPkgUpload1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Metadata) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDcaab663DecodeSocioPkgUpload1(l, v)
+}
@@ -0,0 +1,563 @@
+package upload_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/upload"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type storedFile struct {
+	contentType string
+	data        []byte
+}
+
+type memStorage struct {
+	files map[string]storedFile
+	err   error
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{files: make(map[string]storedFile)}
+}
+
+func (m *memStorage) Store(fileName string, filePath string, contentType string) (err error) {
+	if m.err != nil {
+		return m.err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return
+	}
+
+	m.files[fileName] = storedFile{contentType: contentType, data: data}
+	return
+}
+
+func newImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	return img
+}
+
+// exifSegment builds an APP1 segment with the only IFD0 entry: orientation.
+func exifSegment(orientation uint16) []byte {
+	tiff := []byte{'I', 'I', 42, 0, 8, 0, 0, 0, 1, 0}
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:], 0x0112)
+	binary.LittleEndian.PutUint16(entry[2:], 3)
+	binary.LittleEndian.PutUint32(entry[4:], 1)
+	binary.LittleEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+
+	return append(segment, payload...)
+}
+
+func jpegWithEXIF(t *testing.T, w, h int, orientation uint16) []byte {
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, newImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+	res := append([]byte{}, data[:2]...)
+	res = append(res, exifSegment(orientation)...)
+	res = append(res, []byte{0xFF, 0xFE, 0, 8, 'G', 'P', 'S', '!', '!', '!'}...)
+
+	return append(res, data[2:]...)
+}
+
+func pngWithText(t *testing.T, w, h int) []byte {
+	buf := new(bytes.Buffer)
+	if err := png.Encode(buf, newImage(w, h)); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+	text := []byte("Author\x00someone")
+	chunk := make([]byte, 8)
+	binary.BigEndian.PutUint32(chunk, uint32(len(text)))
+	copy(chunk[4:], "tEXt")
+	chunk = append(chunk, text...)
+	chunk = append(chunk, 0, 0, 0, 0)
+
+	// IHDR is 8 + 4 + 4 + 13 + 4 bytes long
+	res := append([]byte{}, data[:33]...)
+	res = append(res, chunk...)
+
+	return append(res, data[33:]...)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func box(boxType string, payload ...[]byte) []byte {
+	res := make([]byte, 8)
+	copy(res[4:], boxType)
+	for _, p := range payload {
+		res = append(res, p...)
+	}
+	binary.BigEndian.PutUint32(res, uint32(len(res)))
+
+	return res
+}
+
+// mp4WithDuration builds ftyp, moov/mvhd (version 0) and mdat boxes.
+func mp4WithDuration(timescale, duration uint32) []byte {
+	ftyp := box("ftyp", []byte("isom"), []byte{0, 0, 2, 0}, []byte("isomiso2mp41"))
+
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:], duration)
+
+	moov := box("moov", box("mvhd", mvhd))
+
+	return append(append(ftyp, moov...), box("mdat", make([]byte, 64))...)
+}
+
+func wavWithDuration(seconds int) []byte {
+	const byteRate = 8000
+
+	fmtChunk := make([]byte, 24)
+	copy(fmtChunk, "fmt ")
+	binary.LittleEndian.PutUint32(fmtChunk[4:], 16)
+	binary.LittleEndian.PutUint16(fmtChunk[8:], 1)
+	binary.LittleEndian.PutUint16(fmtChunk[10:], 1)
+	binary.LittleEndian.PutUint32(fmtChunk[12:], byteRate)
+	binary.LittleEndian.PutUint32(fmtChunk[16:], byteRate)
+	binary.LittleEndian.PutUint16(fmtChunk[20:], 1)
+	binary.LittleEndian.PutUint16(fmtChunk[22:], 8)
+
+	dataChunk := make([]byte, 8+seconds*byteRate)
+	copy(dataChunk, "data")
+	binary.LittleEndian.PutUint32(dataChunk[4:], uint32(seconds*byteRate))
+
+	res := []byte("RIFF\x00\x00\x00\x00WAVE")
+	res = append(res, fmtChunk...)
+	res = append(res, dataChunk...)
+	binary.LittleEndian.PutUint32(res[4:], uint32(len(res)-8))
+
+	return res
+}
+
+func writeFile(t *testing.T, data []byte) string {
+	path := filepath.Join(t.TempDir(), "upload")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestCheckSize(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, upload.CheckSize(upload.AvatarKind, 1<<20))
+	assert.Equal(t, errors.ErrFileTooLarge, upload.CheckSize(upload.AvatarKind, 6<<20))
+	assert.Equal(t, errors.ErrInvalidData, upload.CheckSize(upload.AvatarKind, 0))
+	assert.Equal(t, errors.ErrInvalidData, upload.CheckSize(upload.Kind("unknown"), 1))
+}
+
+func TestParseMultipartForm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		files   [][]byte
+		wantErr error
+	}{
+		{
+			name:  "ok",
+			files: [][]byte{[]byte("avatar")},
+		},
+		{
+			name:    "too many files",
+			files:   [][]byte{[]byte("avatar"), []byte("avatar")},
+			wantErr: errors.ErrTooManyFiles,
+		},
+		{
+			name:    "too large body",
+			files:   [][]byte{make([]byte, 7<<20)},
+			wantErr: errors.ErrFileTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			for _, data := range tt.files {
+				part, err := writer.CreateFormFile("avatar", "avatar.png")
+				assert.NoError(t, err)
+				_, err = part.Write(data)
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, writer.Close())
+
+			r := httptest.NewRequest("POST", "/", body)
+			r.Header.Set("Content-Type", writer.FormDataContentType())
+
+			err := upload.ParseMultipartForm(httptest.NewRecorder(), r, upload.AvatarKind)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "png",
+			data: pngWithText(t, 2, 2),
+			want: "image/png",
+		},
+		{
+			name: "jpeg",
+			data: jpegWithEXIF(t, 2, 2, 1),
+			want: "image/jpeg",
+		},
+		{
+			name: "text without parameters",
+			data: []byte("hello"),
+			want: "text/plain",
+		},
+		{
+			name: "html",
+			data: []byte("<html><body>hi</body></html>"),
+			want: "text/html",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upload.DetectContentType(bytes.NewReader(tt.data))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckContentType(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, upload.CheckContentType(upload.MessageAttachmentKind, "application/pdf"))
+	assert.NoError(t, upload.CheckContentType(upload.PostAttachmentKind, "video/mp4"))
+	assert.Equal(t, errors.ErrUnsupportedMediaType, upload.CheckContentType(upload.AvatarKind, "video/mp4"))
+	assert.Equal(t, errors.ErrUnsupportedMediaType, upload.CheckContentType(upload.MessageAttachmentKind, "text/html"))
+}
+
+func TestProcessJPEG(t *testing.T) {
+	t.Parallel()
+
+	storage := newMemStorage()
+
+	// orientation 6: the camera was turned, the stored pixels are rotated
+	meta, err := upload.Process(storage, upload.AvatarKind, "avatar.png", writeFile(t, jpegWithEXIF(t, 400, 200, 6)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", meta.ContentType)
+	assert.Equal(t, 200, meta.Width)
+	assert.Equal(t, 400, meta.Height)
+	assert.Len(t, meta.Variants, 2)
+	assert.Equal(t, &upload.Variant{Name: "small", FileName: "avatar_small.jpg", Width: 32, Height: 64}, meta.Variants[0])
+	assert.Equal(t, &upload.Variant{Name: "medium", FileName: "avatar_medium.jpg", Width: 128, Height: 256}, meta.Variants[1])
+
+	original := storage.files["avatar.png"]
+	assert.Equal(t, "image/jpeg", original.contentType)
+	assert.Equal(t, int64(len(original.data)), meta.Size)
+	assert.Equal(t, sha256Hex(original.data), meta.Checksum)
+	assert.False(t, bytes.Contains(original.data, []byte("Exif")))
+	assert.False(t, bytes.Contains(original.data, []byte("GPS!!!")))
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(original.data))
+	assert.NoError(t, err)
+	assert.Equal(t, 200, cfg.Width)
+	assert.Equal(t, 400, cfg.Height)
+
+	assert.Equal(t, "image/jpeg", storage.files["avatar_small.jpg"].contentType)
+	assert.Equal(t, "image/jpeg", storage.files["avatar_medium.jpg"].contentType)
+}
+
+func TestProcessJPEGWithoutRotation(t *testing.T) {
+	t.Parallel()
+
+	storage := newMemStorage()
+	data := jpegWithEXIF(t, 100, 50, 1)
+
+	meta, err := upload.Process(storage, upload.StickerKind, "sticker.jpg", writeFile(t, data))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, meta.Width)
+	assert.Equal(t, 50, meta.Height)
+	assert.Equal(t, &upload.Variant{Name: "thumbnail", FileName: "sticker_thumbnail.jpg", Width: 100, Height: 50}, meta.Variants[0])
+
+	// the scan data is kept as is, only the metadata segments are dropped
+	original := storage.files["sticker.jpg"].data
+	assert.False(t, bytes.Contains(original, []byte("Exif")))
+	assert.Less(t, len(original), len(data))
+	assert.True(t, bytes.HasSuffix(data, original[len(original)-100:]))
+}
+
+func TestProcessPNG(t *testing.T) {
+	t.Parallel()
+
+	storage := newMemStorage()
+
+	meta, err := upload.Process(storage, upload.PostAttachmentKind, "pic.png", writeFile(t, pngWithText(t, 640, 480)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", meta.ContentType)
+	assert.Equal(t, 640, meta.Width)
+	assert.Equal(t, 480, meta.Height)
+	assert.Equal(t, &upload.Variant{Name: "thumbnail", FileName: "pic_thumbnail.png", Width: 320, Height: 240}, meta.Variants[0])
+	assert.Equal(t, &upload.Variant{Name: "preview", FileName: "pic_preview.png", Width: 640, Height: 480}, meta.Variants[1])
+
+	original := storage.files["pic.png"].data
+	assert.False(t, bytes.Contains(original, []byte("tEXt")))
+
+	_, err = png.Decode(bytes.NewReader(original))
+	assert.NoError(t, err)
+
+	thumbnail, err := png.Decode(bytes.NewReader(storage.files["pic_thumbnail.png"].data))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 320, 240), thumbnail.Bounds())
+}
+
+func TestProcessNotImage(t *testing.T) {
+	t.Parallel()
+
+	storage := newMemStorage()
+
+	meta, err := upload.Process(storage, upload.MessageAttachmentKind, "notes.txt", writeFile(t, []byte("some notes")))
+
+	assert.NoError(t, err)
+	assert.Equal(t, &upload.Metadata{FileName: "notes.txt", ContentType: "text/plain", Size: 10, Checksum: sha256Hex([]byte("some notes"))}, meta)
+	assert.Equal(t, "text/plain", storage.files["notes.txt"].contentType)
+	assert.Len(t, storage.files, 1)
+}
+
+func TestProcessErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		kind    upload.Kind
+		data    []byte
+		storage *memStorage
+		wantErr error
+	}{
+		{
+			name:    "disguised html",
+			kind:    upload.PostAttachmentKind,
+			data:    []byte("<html><script>alert(1)</script></html>"),
+			storage: newMemStorage(),
+			wantErr: errors.ErrUnsupportedMediaType,
+		},
+		{
+			name:    "too large",
+			kind:    upload.StickerKind,
+			data:    make([]byte, 3<<20),
+			storage: newMemStorage(),
+			wantErr: errors.ErrFileTooLarge,
+		},
+		{
+			name:    "empty",
+			kind:    upload.StickerKind,
+			data:    []byte{},
+			storage: newMemStorage(),
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "broken image",
+			kind:    upload.AvatarKind,
+			data:    append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 100)...),
+			storage: newMemStorage(),
+			wantErr: errors.ErrUnsupportedMediaType,
+		},
+		{
+			name:    "storage error",
+			kind:    upload.AvatarKind,
+			data:    pngWithText(t, 10, 10),
+			storage: &memStorage{err: errors.ErrInternal},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, err := upload.Process(tt.storage, tt.kind, "file", writeFile(t, tt.data))
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Nil(t, meta)
+		})
+	}
+}
+
+func TestProcessDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		kind        upload.Kind
+		data        []byte
+		contentType string
+		want        uint
+	}{
+		{
+			name:        "mp4",
+			kind:        upload.PostAttachmentKind,
+			data:        mp4WithDuration(600, 9000),
+			contentType: "video/mp4",
+			want:        15000,
+		},
+		{
+			name:        "wav",
+			kind:        upload.MessageAttachmentKind,
+			data:        wavWithDuration(2),
+			contentType: "audio/wave",
+			want:        2000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, err := upload.Process(newMemStorage(), tt.kind, "media", writeFile(t, tt.data))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.contentType, meta.ContentType)
+			assert.Equal(t, tt.want, meta.Duration)
+			assert.Equal(t, sha256Hex(tt.data), meta.Checksum)
+		})
+	}
+}
+
+func TestProcessDeduplicated(t *testing.T) {
+	t.Parallel()
+
+	data := pngWithText(t, 640, 480)
+
+	stored, err := upload.Process(newMemStorage(), upload.PostAttachmentKind, "first.png", writeFile(t, data))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		index      upload.IndexFunc
+		wantMeta   *upload.Metadata
+		wantReused bool
+		wantStored int
+		wantErr    error
+	}{
+		{
+			name: "reuses the stored file",
+			index: func(checksum string) (fileName string, err error) {
+				assert.Equal(t, stored.Checksum, checksum)
+				return "first.png", nil
+			},
+			wantMeta:   stored,
+			wantReused: true,
+			wantStored: 0,
+		},
+		{
+			name: "stores a new file",
+			index: func(checksum string) (fileName string, err error) {
+				return "", errors.ErrNotFound
+			},
+			wantStored: 3,
+		},
+		{
+			name: "index error",
+			index: func(checksum string) (fileName string, err error) {
+				return "", errors.ErrInternal
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newMemStorage()
+
+			meta, reused, err := upload.ProcessDeduplicated(storage, tt.index, upload.PostAttachmentKind, "second.png", writeFile(t, data))
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, meta)
+				assert.Len(t, storage.files, 0)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReused, reused)
+			assert.Len(t, storage.files, tt.wantStored)
+
+			if tt.wantMeta != nil {
+				assert.Equal(t, tt.wantMeta, meta)
+			} else {
+				assert.Equal(t, "second.png", meta.FileName)
+				assert.Equal(t, stored.Checksum, meta.Checksum)
+			}
+		})
+	}
+}
+
+func TestToAttachment(t *testing.T) {
+	t.Parallel()
+
+	meta := &upload.Metadata{
+		FileName:    "pic_uuid.png",
+		ContentType: "image/png",
+		Size:        100,
+		Width:       640,
+		Height:      480,
+		Checksum:    "abc",
+	}
+
+	assert.Equal(t, &domain.Attachment{
+		FileName:     "pic_uuid.png",
+		Kind:         domain.ImageAttachment,
+		MimeType:     "image/png",
+		Size:         100,
+		Width:        640,
+		Height:       480,
+		OriginalName: "pic.png",
+		Checksum:     "abc",
+	}, upload.ToAttachment(meta, "../../pic.png"))
+
+	assert.Equal(t, domain.VideoAttachment, upload.AttachmentKind("video/webm"))
+	assert.Equal(t, domain.AudioAttachment, upload.AttachmentKind("application/ogg"))
+	assert.Equal(t, domain.FileAttachment, upload.AttachmentKind("application/pdf"))
+}
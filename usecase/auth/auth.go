@@ -2,29 +2,65 @@ package auth
 
 import (
 	"context"
+	"os"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contextlogger"
 	"socio/pkg/hash"
+	"socio/pkg/mailer"
 	"socio/pkg/sanitizer"
+	customtime "socio/pkg/time"
+	"time"
 
 	"github.com/microcosm-cc/bluemonday"
 )
 
 //easyjson:json
 type LoginInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type SessionStorage interface {
-	CreateSession(ctx context.Context, userID uint) (sessionID string, err error)
+	CreateSession(ctx context.Context, session *domain.Session, ttl time.Duration) (sessionID string, err error)
+	GetSession(ctx context.Context, sessionID string) (session *domain.Session, err error)
+	RefreshSession(ctx context.Context, sessionID string, lastSeenAt time.Time, ttl time.Duration) (err error)
 	DeleteSession(ctx context.Context, sessionID string) (err error)
-	GetUserIDBySession(ctx context.Context, sessionID string) (userID uint, err error)
+	GetSessionsByUserID(ctx context.Context, userID uint) (sessions []*domain.Session, err error)
+	DeleteSessionByID(ctx context.Context, userID uint, id string) (err error)
+	DeleteSessionsByUserID(ctx context.Context, userID uint, exceptSessionID string) (err error)
+	GetLoginLockedUntil(ctx context.Context, keys ...string) (lockedUntil time.Time, err error)
+	IncrLoginFailures(ctx context.Context, key string, window time.Duration) (failures uint, err error)
+	LockLogin(ctx context.Context, key string, lockedUntil time.Time, ttl time.Duration) (err error)
+	ResetLoginFailures(ctx context.Context, key string) (err error)
+	StorePasswordResetToken(ctx context.Context, tokenHash string, userID uint, ttl time.Duration) (err error)
+	TakePasswordResetToken(ctx context.Context, tokenHash string) (userID uint, err error)
+}
+
+type UserStorage interface {
+	UpdateUser(ctx context.Context, user *domain.User, prevPassword string) (updatedUser *domain.User, err error)
+	CheckIfUserIsBanned(ctx context.Context, userID uint) (isBanned bool, err error)
+}
+
+type EmailOutbox interface {
+	Push(ctx context.Context, email *domain.Email) (err error)
 }
 
 type Service struct {
-	SessionStorage SessionStorage
-	Sanitizer      *sanitizer.Sanitizer
+	SessionStorage       SessionStorage
+	UserStorage          UserStorage
+	EmailOutbox          EmailOutbox
+	PasswordHasher       hash.PasswordHasher
+	TimeProvider         customtime.TimeProvider
+	SessionAbsoluteTTL   time.Duration
+	SessionIdleTTL       time.Duration
+	AccountLockoutPolicy LockoutPolicy
+	IPLockoutPolicy      LockoutPolicy
+	PasswordResetTTL     time.Duration
+	PublicURL            string
+	Sanitizer            *sanitizer.Sanitizer
 }
 
 //easyjson:json
@@ -37,20 +73,91 @@ type IsAuthorizedResponse struct {
 	IsAuthorized bool `json:"isAuthorized"`
 }
 
-func NewService(sessionStorage SessionStorage) (a *Service) {
+func NewService(sessionStorage SessionStorage, userStorage UserStorage, emailOutbox EmailOutbox, passwordHasher hash.PasswordHasher, tp customtime.TimeProvider) (a *Service) {
 	return &Service{
-		SessionStorage: sessionStorage,
-		Sanitizer:      sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
+		SessionStorage:       sessionStorage,
+		UserStorage:          userStorage,
+		EmailOutbox:          emailOutbox,
+		PasswordHasher:       passwordHasher,
+		TimeProvider:         tp,
+		SessionAbsoluteTTL:   DefaultSessionAbsoluteTTL,
+		SessionIdleTTL:       DefaultSessionIdleTTL,
+		AccountLockoutPolicy: DefaultAccountLockoutPolicy,
+		IPLockoutPolicy:      DefaultIPLockoutPolicy,
+		PasswordResetTTL:     DefaultPasswordResetTTL,
+		PublicURL:            os.Getenv(mailer.PublicURLEnv),
+		Sanitizer:            sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
 	}
 }
 
+// Login creates the session of the user if the password matches. The user is
+// nil if there is no account with the email, the attempt fails the same way
+// as with the wrong password then.
 func (a *Service) Login(ctx context.Context, loginInput LoginInput, user *domain.User) (sessionID string, err error) {
-	if !hash.MatchPasswords(user.Password, loginInput.Password, []byte(user.Salt)) {
-		err = errors.ErrInvalidLoginData
+	locked, err := a.isLoginLocked(ctx, loginInput)
+	if err != nil {
+		return
+	}
+
+	if locked {
+		err = errors.ErrLoginLocked
+		return
+	}
+
+	if user == nil {
+		err = a.failLogin(ctx, loginInput, nil)
+		return
+	}
+
+	ok, err := hash.VerifyPassword(user.Password, loginInput.Password, []byte(user.Salt))
+	if err != nil || !ok {
+		err = a.failLogin(ctx, loginInput, user)
+		return
+	}
+
+	isBanned, err := a.UserStorage.CheckIfUserIsBanned(ctx, user.ID)
+	if err != nil {
 		return
 	}
 
-	sessionID, err = a.SessionStorage.CreateSession(ctx, user.ID)
+	if isBanned {
+		err = errors.ErrForbidden
+		return
+	}
+
+	if a.PasswordHasher.NeedsRehash(user.Password) {
+		// the plain password is known only here, so outdated hashes are upgraded
+		// on login; a failed upgrade must not prevent the user from logging in
+		if rehashErr := a.rehashPassword(ctx, user, loginInput.Password); rehashErr != nil {
+			contextlogger.LogErr(ctx, rehashErr)
+		}
+	}
+
+	sessionID, err = a.createSession(ctx, user.ID, loginInput)
+	if err != nil {
+		return
+	}
+
+	a.resetLoginFailures(ctx, loginInput)
+
+	return
+}
+
+func (a *Service) failLogin(ctx context.Context, loginInput LoginInput, user *domain.User) (err error) {
+	if err = a.registerLoginFailure(ctx, loginInput, user); err != nil {
+		contextlogger.LogErr(ctx, err)
+	}
+
+	err = errors.ErrInvalidLoginData
+
+	return
+}
+
+func (a *Service) rehashPassword(ctx context.Context, user *domain.User, password string) (err error) {
+	upgradedUser := *user
+	upgradedUser.Password = password
+
+	_, err = a.UserStorage.UpdateUser(ctx, &upgradedUser, user.Password)
 	if err != nil {
 		return
 	}
@@ -68,11 +175,32 @@ func (a *Service) Logout(ctx context.Context, sessionID string) (err error) {
 }
 
 func (a *Service) IsAuthorized(ctx context.Context, sessionID string) (userID uint, err error) {
-	userID, err = a.SessionStorage.GetUserIDBySession(ctx, sessionID)
+	session, err := a.SessionStorage.GetSession(ctx, sessionID)
 	if err != nil {
 		err = errors.ErrUnauthorized
 		return
 	}
 
+	now := a.TimeProvider.Now()
+
+	if !now.Before(session.ExpiresAt.Time) {
+		if err = a.SessionStorage.DeleteSession(ctx, sessionID); err != nil {
+			contextlogger.LogErr(ctx, err)
+		}
+
+		err = errors.ErrUnauthorized
+		return
+	}
+
+	if now.Sub(session.LastSeenAt.Time) >= sessionRefreshInterval {
+		err = a.SessionStorage.RefreshSession(ctx, sessionID, now, a.sessionTTL(now, session.ExpiresAt.Time))
+		if err != nil {
+			err = errors.ErrUnauthorized
+			return
+		}
+	}
+
+	userID = session.UserID
+
 	return
 }
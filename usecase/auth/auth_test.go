@@ -10,15 +10,36 @@ import (
 	customtime "socio/pkg/time"
 	"socio/usecase/auth"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 )
 
+var testArgon2idParams = hash.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func mustHash(hasher hash.PasswordHasher, password string) string {
+	encodedHash, err := hasher.Hash(password)
+	if err != nil {
+		panic(err)
+	}
+
+	return encodedHash
+}
+
 func TestService_Login(t *testing.T) {
 	timeProv := customtime.MockTimeProvider{}
+	argon2idHasher := hash.NewArgon2idHasher(testArgon2idParams)
 
 	type fields struct {
 		SessionStorage *mock_auth.MockSessionStorage
+		UserStorage    *mock_auth.MockUserStorage
+		EmailOutbox    *mock_auth.MockEmailOutbox
 	}
 
 	type args struct {
@@ -57,7 +78,175 @@ func TestService_Login(t *testing.T) {
 			wantSession: "session_id",
 			wantErr:     false,
 			prepareMock: func(f *fields) {
-				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return("session_id", nil)
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), hash.HashPassword("password", []byte("salt"))).DoAndReturn(
+					func(ctx context.Context, user *domain.User, prevPassword string) (*domain.User, error) {
+						if user.Password != "password" {
+							t.Errorf("UpdateUser() got password %s, want plain password", user.Password)
+						}
+						return user, nil
+					},
+				)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session_id", nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name: "success argon2id",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "password",
+				},
+				user: &domain.User{
+					FirstName: "John",
+					LastName:  "Doe",
+					Email:     "john@mail.ru",
+					Password:  mustHash(argon2idHasher, "password"),
+					Avatar:    "default_avatar.png",
+				},
+			},
+			wantSession: "session_id",
+			wantErr:     false,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session_id", nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name: "success bcrypt rehash",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "password",
+				},
+				user: &domain.User{
+					FirstName: "John",
+					LastName:  "Doe",
+					Email:     "john@mail.ru",
+					Password:  mustHash(hash.NewBcryptHasher(4), "password"),
+					Avatar:    "default_avatar.png",
+				},
+			},
+			wantSession: "session_id",
+			wantErr:     false,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.User{}, nil)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session_id", nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name: "rehash error does not fail login",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "password",
+				},
+				user: &domain.User{
+					FirstName: "John",
+					LastName:  "Doe",
+					Email:     "john@mail.ru",
+					Password:  hash.HashPassword("password", []byte("salt")),
+					Salt:      "salt",
+					Avatar:    "default_avatar.png",
+				},
+			},
+			wantSession: "session_id",
+			wantErr:     false,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session_id", nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name: "session metadata",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:     "john@mail.ru",
+					Password:  "password",
+					IP:        "127.0.0.1",
+					UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)",
+				},
+				user: &domain.User{
+					ID:       1,
+					Email:    "john@mail.ru",
+					Password: mustHash(argon2idHasher, "password"),
+				},
+			},
+			wantSession: "session_id",
+			wantErr:     false,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru", "ip:127.0.0.1").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), &domain.Session{
+					UserID:     1,
+					Device:     "iPhone",
+					IP:         "127.0.0.1",
+					UserAgent:  "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)",
+					CreatedAt:  customtime.CustomTime{Time: timeProv.Now()},
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now()},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(auth.DefaultSessionAbsoluteTTL)},
+				}, auth.DefaultSessionIdleTTL).Return("session_id", nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name: "invalid password argon2id",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "wrong_password",
+				},
+				user: &domain.User{
+					FirstName: "John",
+					LastName:  "Doe",
+					Email:     "john@mail.ru",
+					Password:  mustHash(argon2idHasher, "password"),
+					Avatar:    "default_avatar.png",
+				},
+			},
+			wantSession: "",
+			wantErr:     true,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", auth.DefaultAccountLockoutPolicy.Window).Return(uint(1), nil)
+			},
+		},
+		{
+			name: "invalid stored hash",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "password",
+				},
+				user: &domain.User{
+					FirstName: "John",
+					LastName:  "Doe",
+					Email:     "john@mail.ru",
+					Password:  "not a hash",
+					Avatar:    "default_avatar.png",
+				},
+			},
+			wantSession: "",
+			wantErr:     true,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", auth.DefaultAccountLockoutPolicy.Window).Return(uint(1), nil)
 			},
 		},
 		{
@@ -82,7 +271,10 @@ func TestService_Login(t *testing.T) {
 			},
 			wantSession: "",
 			wantErr:     true,
-			prepareMock: func(f *fields) {},
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", auth.DefaultAccountLockoutPolicy.Window).Return(uint(1), nil)
+			},
 		},
 		{
 			name: "err internal",
@@ -107,7 +299,31 @@ func TestService_Login(t *testing.T) {
 			wantSession: "",
 			wantErr:     true,
 			prepareMock: func(f *fields) {
-				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return("", errors.ErrInternal)
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), gomock.Any()).Return(false, nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.User{}, nil)
+				f.SessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.ErrInternal)
+			},
+		},
+		{
+			name: "banned user",
+			args: args{
+				ctx: context.Background(),
+				loginInput: auth.LoginInput{
+					Email:    "john@mail.ru",
+					Password: "password",
+				},
+				user: &domain.User{
+					ID:       1,
+					Email:    "john@mail.ru",
+					Password: mustHash(argon2idHasher, "password"),
+				},
+			},
+			wantSession: "",
+			wantErr:     true,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.UserStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), uint(1)).Return(true, nil)
 			},
 		},
 	}
@@ -117,13 +333,15 @@ func TestService_Login(t *testing.T) {
 			defer ctrl.Finish()
 			f := fields{
 				SessionStorage: mock_auth.NewMockSessionStorage(ctrl),
+				UserStorage:    mock_auth.NewMockUserStorage(ctrl),
+				EmailOutbox:    mock_auth.NewMockEmailOutbox(ctrl),
 			}
 
 			if tt.prepareMock != nil {
 				tt.prepareMock(&f)
 			}
 
-			s := auth.NewService(f.SessionStorage)
+			s := auth.NewService(f.SessionStorage, f.UserStorage, f.EmailOutbox, argon2idHasher, timeProv)
 
 			gotSession, err := s.Login(tt.args.ctx, tt.args.loginInput, tt.args.user)
 			if (err != nil) != tt.wantErr {
@@ -171,7 +389,7 @@ func TestLogout(t *testing.T) {
 
 			storage := mock_auth.NewMockSessionStorage(ctrl)
 
-			a := auth.NewService(storage)
+			a := auth.NewService(storage, nil, nil, hash.NewArgon2idHasher(testArgon2idParams), customtime.MockTimeProvider{})
 
 			tt.mock(storage, tt.sessionID)
 
@@ -187,6 +405,8 @@ func TestLogout(t *testing.T) {
 func TestIsAuthorized(t *testing.T) {
 	t.Parallel()
 
+	timeProv := customtime.MockTimeProvider{}
+
 	tests := []struct {
 		name       string
 		sessionID  string
@@ -198,16 +418,76 @@ func TestIsAuthorized(t *testing.T) {
 			name:      "Test OK",
 			sessionID: "testSessionID",
 			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
-				storage.EXPECT().GetUserIDBySession(gomock.Any(), sessionID).Return(uint(1), nil)
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(&domain.Session{
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now()},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+				}, nil)
+			},
+			wantUserID: uint(1),
+			wantErr:    false,
+		},
+		{
+			name:      "Test OK sliding TTL",
+			sessionID: "testSessionID",
+			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(&domain.Session{
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(24 * time.Hour)},
+				}, nil)
+				storage.EXPECT().RefreshSession(gomock.Any(), sessionID, timeProv.Now(), auth.DefaultSessionIdleTTL).Return(nil)
 			},
 			wantUserID: uint(1),
 			wantErr:    false,
 		},
+		{
+			name:      "Test OK sliding TTL capped by absolute expiry",
+			sessionID: "testSessionID",
+			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(&domain.Session{
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+				}, nil)
+				storage.EXPECT().RefreshSession(gomock.Any(), sessionID, timeProv.Now(), time.Hour).Return(nil)
+			},
+			wantUserID: uint(1),
+			wantErr:    false,
+		},
+		{
+			name:      "Test Expired",
+			sessionID: "testSessionID",
+			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(&domain.Session{
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now()},
+				}, nil)
+				storage.EXPECT().DeleteSession(gomock.Any(), sessionID).Return(nil)
+			},
+			wantUserID: uint(0),
+			wantErr:    true,
+		},
+		{
+			name:      "Test Refresh Error",
+			sessionID: "testSessionID",
+			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(&domain.Session{
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+				}, nil)
+				storage.EXPECT().RefreshSession(gomock.Any(), sessionID, gomock.Any(), gomock.Any()).Return(errors.ErrNotFound)
+			},
+			wantUserID: uint(0),
+			wantErr:    true,
+		},
 		{
 			name:      "Test Error",
 			sessionID: "testSessionID",
 			mock: func(storage *mock_auth.MockSessionStorage, sessionID string) {
-				storage.EXPECT().GetUserIDBySession(gomock.Any(), sessionID).Return(uint(0), errors.ErrInternal)
+				storage.EXPECT().GetSession(gomock.Any(), sessionID).Return(nil, errors.ErrNotFound)
 			},
 			wantUserID: uint(0),
 			wantErr:    true,
@@ -221,7 +501,7 @@ func TestIsAuthorized(t *testing.T) {
 
 			storage := mock_auth.NewMockSessionStorage(ctrl)
 
-			a := auth.NewService(storage)
+			a := auth.NewService(storage, nil, nil, hash.NewArgon2idHasher(testArgon2idParams), timeProv)
 
 			tt.mock(storage, tt.sessionID)
 
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"socio/domain"
+	"socio/pkg/contextlogger"
+	"strings"
+	"time"
+)
+
+const (
+	accountLoginKeyPrefix = "account:"
+	ipLoginKeyPrefix      = "ip:"
+
+	lockoutEmailSubject = "Logging in to your account is locked"
+	lockoutEmailBody    = "There were %d failed attempts to log in to your account, so logging in is locked until %s. If it was not you, change your password after the lock ends."
+)
+
+// LockoutPolicy locks the login for BaseLockout after FreeAttempts failures
+// within the Window, every next failure doubles the lockout up to MaxLockout.
+type LockoutPolicy struct {
+	FreeAttempts uint
+	BaseLockout  time.Duration
+	MaxLockout   time.Duration
+	Window       time.Duration
+}
+
+var (
+	DefaultAccountLockoutPolicy = LockoutPolicy{
+		FreeAttempts: 5,
+		BaseLockout:  time.Minute,
+		MaxLockout:   time.Hour,
+		Window:       24 * time.Hour,
+	}
+	// DefaultIPLockoutPolicy allows more attempts, many users may share the
+	// same address.
+	DefaultIPLockoutPolicy = LockoutPolicy{
+		FreeAttempts: 20,
+		BaseLockout:  time.Minute,
+		MaxLockout:   time.Hour,
+		Window:       time.Hour,
+	}
+)
+
+func (p LockoutPolicy) Lockout(failures uint) (lockout time.Duration) {
+	if p.FreeAttempts == 0 || failures < p.FreeAttempts {
+		return
+	}
+
+	lockout = p.BaseLockout
+	for i := p.FreeAttempts; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+
+	lockout = min(lockout, p.MaxLockout)
+
+	return
+}
+
+func getAccountLoginKey(email string) string {
+	return accountLoginKeyPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+func getIPLoginKey(ip string) string {
+	return ipLoginKeyPrefix + ip
+}
+
+func getLoginKeys(loginInput LoginInput) (keys []string) {
+	keys = append(keys, getAccountLoginKey(loginInput.Email))
+	if loginInput.IP != "" {
+		keys = append(keys, getIPLoginKey(loginInput.IP))
+	}
+
+	return
+}
+
+// isLoginLocked checks whether either the account or the IP the login comes
+// from is locked.
+func (a *Service) isLoginLocked(ctx context.Context, loginInput LoginInput) (locked bool, err error) {
+	lockedUntil, err := a.SessionStorage.GetLoginLockedUntil(ctx, getLoginKeys(loginInput)...)
+	if err != nil {
+		return
+	}
+
+	locked = a.TimeProvider.Now().Before(lockedUntil)
+
+	return
+}
+
+// registerLoginFailure counts the failed attempt for the account and the IP
+// and locks them once they run out of the free attempts. The user is nil if
+// there is no account with the email, such attempts are counted too. The owner
+// of the account is notified when the account gets locked for the first time
+// within the window.
+func (a *Service) registerLoginFailure(ctx context.Context, loginInput LoginInput, user *domain.User) (err error) {
+	failures, lockedUntil, err := a.lockOnFailure(ctx, getAccountLoginKey(loginInput.Email), a.AccountLockoutPolicy)
+	if err != nil {
+		return
+	}
+
+	if user != nil && failures == a.AccountLockoutPolicy.FreeAttempts {
+		err = a.EmailOutbox.Push(ctx, &domain.Email{
+			To:      user.Email,
+			Subject: lockoutEmailSubject,
+			Body:    fmt.Sprintf(lockoutEmailBody, failures, lockedUntil.UTC().Format(time.RFC1123)),
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	if loginInput.IP == "" {
+		return
+	}
+
+	_, _, err = a.lockOnFailure(ctx, getIPLoginKey(loginInput.IP), a.IPLockoutPolicy)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (a *Service) lockOnFailure(ctx context.Context, key string, policy LockoutPolicy) (failures uint, lockedUntil time.Time, err error) {
+	failures, err = a.SessionStorage.IncrLoginFailures(ctx, key, policy.Window)
+	if err != nil {
+		return
+	}
+
+	lockout := policy.Lockout(failures)
+	if lockout == 0 {
+		return
+	}
+
+	lockedUntil = a.TimeProvider.Now().Add(lockout)
+
+	err = a.SessionStorage.LockLogin(ctx, key, lockedUntil, max(lockout, policy.Window))
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// resetLoginFailures forgets the failures of the account after the successful
+// login, the failures of the IP are kept, otherwise the attacker could reset
+// them by logging in to an own account.
+func (a *Service) resetLoginFailures(ctx context.Context, loginInput LoginInput) {
+	if err := a.SessionStorage.ResetLoginFailures(ctx, getAccountLoginKey(loginInput.Email)); err != nil {
+		contextlogger.LogErr(ctx, err)
+	}
+}
@@ -0,0 +1,191 @@
+package auth_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_auth "socio/mocks/usecase/auth"
+	"socio/pkg/hash"
+	"socio/usecase/auth"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestLockoutPolicy_Lockout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		failures uint
+		want     time.Duration
+	}{
+		{name: "free attempt", failures: 4, want: 0},
+		{name: "first lockout", failures: 5, want: time.Minute},
+		{name: "second lockout", failures: 6, want: 2 * time.Minute},
+		{name: "third lockout", failures: 7, want: 4 * time.Minute},
+		{name: "max lockout", failures: 20, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, auth.DefaultAccountLockoutPolicy.Lockout(tt.failures))
+		})
+	}
+}
+
+func TestService_LoginLockout(t *testing.T) {
+	argon2idHasher := hash.NewArgon2idHasher(testArgon2idParams)
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	user := &domain.User{ID: 1, Email: "john@mail.ru", Password: mustHash(argon2idHasher, "password")}
+
+	type fields struct {
+		SessionStorage *mock_auth.MockSessionStorage
+		EmailOutbox    *mock_auth.MockEmailOutbox
+	}
+
+	tests := []struct {
+		name        string
+		loginInput  auth.LoginInput
+		user        *domain.User
+		prepareMock func(f *fields)
+		wantErr     error
+	}{
+		{
+			name:       "locked account",
+			loginInput: auth.LoginInput{Email: "John@mail.ru", Password: "password", IP: "127.0.0.1"},
+			user:       user,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru", "ip:127.0.0.1").Return(now.Add(time.Minute), nil)
+			},
+			wantErr: errors.ErrLoginLocked,
+		},
+		{
+			name:       "account gets locked",
+			loginInput: auth.LoginInput{Email: "john@mail.ru", Password: "wrong_password", IP: "127.0.0.1"},
+			user:       user,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru", "ip:127.0.0.1").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", 24*time.Hour).Return(uint(5), nil)
+				f.SessionStorage.EXPECT().LockLogin(gomock.Any(), "account:john@mail.ru", now.Add(time.Minute), 24*time.Hour).Return(nil)
+				f.EmailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email *domain.Email) error {
+					assert.Equal(t, "john@mail.ru", email.To)
+					assert.True(t, strings.Contains(email.Body, "5 failed attempts"), email.Body)
+					return nil
+				})
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "ip:127.0.0.1", time.Hour).Return(uint(5), nil)
+			},
+			wantErr: errors.ErrInvalidLoginData,
+		},
+		{
+			name:       "lockout doubles without notice",
+			loginInput: auth.LoginInput{Email: "john@mail.ru", Password: "wrong_password"},
+			user:       user,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(now.Add(-time.Second), nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", 24*time.Hour).Return(uint(6), nil)
+				f.SessionStorage.EXPECT().LockLogin(gomock.Any(), "account:john@mail.ru", now.Add(2*time.Minute), 24*time.Hour).Return(nil)
+			},
+			wantErr: errors.ErrInvalidLoginData,
+		},
+		{
+			name:       "ip gets locked",
+			loginInput: auth.LoginInput{Email: "jane@mail.ru", Password: "wrong_password", IP: "127.0.0.1"},
+			user:       user,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:jane@mail.ru", "ip:127.0.0.1").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:jane@mail.ru", 24*time.Hour).Return(uint(1), nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "ip:127.0.0.1", time.Hour).Return(uint(20), nil)
+				f.SessionStorage.EXPECT().LockLogin(gomock.Any(), "ip:127.0.0.1", now.Add(time.Minute), time.Hour).Return(nil)
+			},
+			wantErr: errors.ErrInvalidLoginData,
+		},
+		{
+			name:       "unknown email",
+			loginInput: auth.LoginInput{Email: "nobody@mail.ru", Password: "password"},
+			user:       nil,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:nobody@mail.ru").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:nobody@mail.ru", 24*time.Hour).Return(uint(5), nil)
+				f.SessionStorage.EXPECT().LockLogin(gomock.Any(), "account:nobody@mail.ru", now.Add(time.Minute), 24*time.Hour).Return(nil)
+			},
+			wantErr: errors.ErrInvalidLoginData,
+		},
+		{
+			name:       "storage error",
+			loginInput: auth.LoginInput{Email: "john@mail.ru", Password: "wrong_password"},
+			user:       user,
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(time.Time{}, nil)
+				f.SessionStorage.EXPECT().IncrLoginFailures(gomock.Any(), "account:john@mail.ru", 24*time.Hour).Return(uint(0), errors.ErrInternal)
+			},
+			wantErr: errors.ErrInvalidLoginData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				SessionStorage: mock_auth.NewMockSessionStorage(ctrl),
+				EmailOutbox:    mock_auth.NewMockEmailOutbox(ctrl),
+			}
+			tt.prepareMock(&f)
+
+			s := auth.NewService(f.SessionStorage, mock_auth.NewMockUserStorage(ctrl), f.EmailOutbox, argon2idHasher, &fakeClock{now: now})
+
+			_, err := s.Login(context.Background(), tt.loginInput, tt.user)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestService_LoginAfterLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	argon2idHasher := hash.NewArgon2idHasher(testArgon2idParams)
+	clock := &fakeClock{now: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)}
+	lockedUntil := clock.Now().Add(time.Minute)
+	user := &domain.User{ID: 1, Email: "john@mail.ru", Password: mustHash(argon2idHasher, "password")}
+	loginInput := auth.LoginInput{Email: "john@mail.ru", Password: "password"}
+
+	sessionStorage := mock_auth.NewMockSessionStorage(ctrl)
+	userStorage := mock_auth.NewMockUserStorage(ctrl)
+
+	sessionStorage.EXPECT().GetLoginLockedUntil(gomock.Any(), "account:john@mail.ru").Return(lockedUntil, nil).Times(2)
+	userStorage.EXPECT().CheckIfUserIsBanned(gomock.Any(), uint(1)).Return(false, nil)
+	sessionStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session_id", nil)
+	sessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+
+	s := auth.NewService(sessionStorage, userStorage, mock_auth.NewMockEmailOutbox(ctrl), argon2idHasher, clock)
+
+	_, err := s.Login(context.Background(), loginInput, user)
+	assert.Equal(t, errors.ErrLoginLocked, err)
+
+	clock.Advance(time.Minute)
+
+	sessionID, err := s.Login(context.Background(), loginInput, user)
+	assert.NoError(t, err)
+	assert.Equal(t, "session_id", sessionID)
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"socio/domain"
+	"socio/pkg/hash"
+	"socio/pkg/validators"
+	"time"
+)
+
+const (
+	DefaultPasswordResetTTL = time.Hour
+
+	passwordResetPath    = "/reset-password?token="
+	passwordResetSubject = "Reset your password"
+	passwordResetBody    = "Follow the link to set a new password: %s\nThe link is valid for %d minutes. If you did not ask to reset the password, ignore this email."
+)
+
+//easyjson:json
+type RequestPasswordResetInput struct {
+	Email string `json:"email"`
+}
+
+//easyjson:json
+type ResetPasswordInput struct {
+	Token          string `json:"token"`
+	Password       string `json:"password"`
+	RepeatPassword string `json:"repeatPassword"`
+}
+
+// RequestPasswordReset sends the link resetting the password to the owner of
+// the account, the links sent before stop working. The user is nil if there is
+// no account with the email, nothing is sent then, but the request succeeds
+// the same way so the emails of the accounts can not be guessed.
+func (a *Service) RequestPasswordReset(ctx context.Context, user *domain.User) (err error) {
+	if user == nil {
+		return
+	}
+
+	token, tokenHash, err := hash.NewToken()
+	if err != nil {
+		return
+	}
+
+	err = a.SessionStorage.StorePasswordResetToken(ctx, tokenHash, user.ID, a.PasswordResetTTL)
+	if err != nil {
+		return
+	}
+
+	err = a.EmailOutbox.Push(ctx, &domain.Email{
+		To:      user.Email,
+		Subject: passwordResetSubject,
+		Body:    fmt.Sprintf(passwordResetBody, a.PublicURL+passwordResetPath+token, int(a.PasswordResetTTL.Minutes())),
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ResetPassword sets the new password of the user the token was sent to. The
+// token works only once, all the sessions of the user are revoked and the
+// account is unlocked, the lockout may be the reason of the reset.
+func (a *Service) ResetPassword(ctx context.Context, input ResetPasswordInput) (err error) {
+	if err = validators.ValidatePassword(input.Password, input.RepeatPassword); err != nil {
+		return
+	}
+
+	userID, err := a.SessionStorage.TakePasswordResetToken(ctx, hash.HashToken(input.Token))
+	if err != nil {
+		return
+	}
+
+	updatedUser, err := a.UserStorage.UpdateUser(ctx, &domain.User{ID: userID, Password: input.Password}, "")
+	if err != nil {
+		return
+	}
+
+	err = a.SessionStorage.DeleteSessionsByUserID(ctx, userID, "")
+	if err != nil {
+		return
+	}
+
+	a.resetLoginFailures(ctx, LoginInput{Email: updatedUser.Email})
+
+	return
+}
@@ -0,0 +1,165 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package auth
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson5b8efd9bDecodeSocioUsecaseAuth(in *jlexer.Lexer, out *ResetPasswordInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "repeatPassword":
+			out.RepeatPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5b8efd9bEncodeSocioUsecaseAuth(out *jwriter.Writer, in ResetPasswordInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"repeatPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.RepeatPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResetPasswordInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5b8efd9bEncodeSocioUsecaseAuth(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResetPasswordInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5b8efd9bEncodeSocioUsecaseAuth(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResetPasswordInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5b8efd9bDecodeSocioUsecaseAuth(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResetPasswordInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5b8efd9bDecodeSocioUsecaseAuth(l, v)
+}
+func easyjson5b8efd9bDecodeSocioUsecaseAuth1(in *jlexer.Lexer, out *RequestPasswordResetInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5b8efd9bEncodeSocioUsecaseAuth1(out *jwriter.Writer, in RequestPasswordResetInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPasswordResetInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5b8efd9bEncodeSocioUsecaseAuth1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPasswordResetInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5b8efd9bEncodeSocioUsecaseAuth1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPasswordResetInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5b8efd9bDecodeSocioUsecaseAuth1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPasswordResetInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5b8efd9bDecodeSocioUsecaseAuth1(l, v)
+}
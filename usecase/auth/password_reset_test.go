@@ -0,0 +1,171 @@
+package auth_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_auth "socio/mocks/usecase/auth"
+	"socio/pkg/hash"
+	customtime "socio/pkg/time"
+	"socio/usecase/auth"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		SessionStorage *mock_auth.MockSessionStorage
+		EmailOutbox    *mock_auth.MockEmailOutbox
+	}
+
+	tests := []struct {
+		name        string
+		user        *domain.User
+		prepareMock func(f *fields)
+		wantErr     error
+	}{
+		{
+			name: "send",
+			user: &domain.User{ID: 1, Email: "john@mail.ru"},
+			prepareMock: func(f *fields) {
+				var tokenHash string
+
+				f.SessionStorage.EXPECT().StorePasswordResetToken(gomock.Any(), gomock.Any(), uint(1), time.Hour).DoAndReturn(
+					func(ctx context.Context, storedHash string, userID uint, ttl time.Duration) error {
+						tokenHash = storedHash
+						return nil
+					},
+				)
+				f.EmailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email *domain.Email) error {
+					assert.Equal(t, "john@mail.ru", email.To)
+
+					_, token, ok := strings.Cut(email.Body, "https://socio.ru/reset-password?token=")
+					assert.True(t, ok)
+
+					token, _, _ = strings.Cut(token, "\n")
+					assert.Equal(t, tokenHash, hash.HashToken(token))
+
+					return nil
+				})
+			},
+		},
+		{
+			name:        "unknown email",
+			user:        nil,
+			prepareMock: func(f *fields) {},
+		},
+		{
+			name: "storage error",
+			user: &domain.User{ID: 1, Email: "john@mail.ru"},
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().StorePasswordResetToken(gomock.Any(), gomock.Any(), uint(1), time.Hour).Return(errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				SessionStorage: mock_auth.NewMockSessionStorage(ctrl),
+				EmailOutbox:    mock_auth.NewMockEmailOutbox(ctrl),
+			}
+			tt.prepareMock(&f)
+
+			s := auth.NewService(f.SessionStorage, mock_auth.NewMockUserStorage(ctrl), f.EmailOutbox, nil, customtime.MockTimeProvider{})
+			s.PublicURL = "https://socio.ru"
+
+			err := s.RequestPasswordReset(context.Background(), tt.user)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestService_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		SessionStorage *mock_auth.MockSessionStorage
+		UserStorage    *mock_auth.MockUserStorage
+	}
+
+	tests := []struct {
+		name        string
+		input       auth.ResetPasswordInput
+		prepareMock func(f *fields)
+		wantErr     error
+	}{
+		{
+			name:  "reset",
+			input: auth.ResetPasswordInput{Token: "token", Password: "new_password", RepeatPassword: "new_password"},
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().TakePasswordResetToken(gomock.Any(), hash.HashToken("token")).Return(uint(1), nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), &domain.User{ID: 1, Password: "new_password"}, "").Return(&domain.User{ID: 1, Email: "John@mail.ru"}, nil)
+				f.SessionStorage.EXPECT().DeleteSessionsByUserID(gomock.Any(), uint(1), "").Return(nil)
+				f.SessionStorage.EXPECT().ResetLoginFailures(gomock.Any(), "account:john@mail.ru").Return(nil)
+			},
+		},
+		{
+			name:        "not matching passwords",
+			input:       auth.ResetPasswordInput{Token: "token", Password: "new_password", RepeatPassword: "other_password"},
+			prepareMock: func(f *fields) {},
+			wantErr:     errors.ErrNotMatchingPasswords,
+		},
+		{
+			name:        "short password",
+			input:       auth.ResetPasswordInput{Token: "token", Password: "pass", RepeatPassword: "pass"},
+			prepareMock: func(f *fields) {},
+			wantErr:     errors.ErrPasswordMinLength,
+		},
+		{
+			name:  "invalid token",
+			input: auth.ResetPasswordInput{Token: "token", Password: "new_password", RepeatPassword: "new_password"},
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().TakePasswordResetToken(gomock.Any(), hash.HashToken("token")).Return(uint(0), errors.ErrInvalidToken)
+			},
+			wantErr: errors.ErrInvalidToken,
+		},
+		{
+			name:  "update error",
+			input: auth.ResetPasswordInput{Token: "token", Password: "new_password", RepeatPassword: "new_password"},
+			prepareMock: func(f *fields) {
+				f.SessionStorage.EXPECT().TakePasswordResetToken(gomock.Any(), hash.HashToken("token")).Return(uint(1), nil)
+				f.UserStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), "").Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				SessionStorage: mock_auth.NewMockSessionStorage(ctrl),
+				UserStorage:    mock_auth.NewMockUserStorage(ctrl),
+			}
+			tt.prepareMock(&f)
+
+			s := auth.NewService(f.SessionStorage, f.UserStorage, mock_auth.NewMockEmailOutbox(ctrl), nil, customtime.MockTimeProvider{})
+
+			err := s.ResetPassword(context.Background(), tt.input)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultSessionAbsoluteTTL = 7 * 24 * time.Hour
+	DefaultSessionIdleTTL     = 10 * time.Hour
+
+	// sessionRefreshInterval limits how often the sliding expiration is
+	// written back to the storage.
+	sessionRefreshInterval = time.Minute
+
+	unknownDevice = "Unknown device"
+)
+
+var devicesByUserAgent = []struct {
+	marker string
+	device string
+}{
+	{"iPhone", "iPhone"},
+	{"iPad", "iPad"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Macintosh", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+func DescribeDevice(userAgent string) string {
+	for _, d := range devicesByUserAgent {
+		if strings.Contains(userAgent, d.marker) {
+			return d.device
+		}
+	}
+
+	return unknownDevice
+}
+
+func (a *Service) sessionTTL(now, expiresAt time.Time) time.Duration {
+	ttl := expiresAt.Sub(now)
+	if ttl > a.SessionIdleTTL {
+		ttl = a.SessionIdleTTL
+	}
+
+	return ttl
+}
+
+func (a *Service) createSession(ctx context.Context, userID uint, loginInput LoginInput) (sessionID string, err error) {
+	now := a.TimeProvider.Now()
+
+	session := &domain.Session{
+		UserID:    userID,
+		Device:    DescribeDevice(loginInput.UserAgent),
+		IP:        loginInput.IP,
+		UserAgent: loginInput.UserAgent,
+	}
+	session.CreatedAt.Time = now
+	session.LastSeenAt.Time = now
+	session.ExpiresAt.Time = now.Add(a.SessionAbsoluteTTL)
+
+	sessionID, err = a.SessionStorage.CreateSession(ctx, session, a.sessionTTL(now, session.ExpiresAt.Time))
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (a *Service) ListSessions(ctx context.Context, userID uint, currentSessionID string) (sessions []*domain.Session, err error) {
+	sessions, err = a.SessionStorage.GetSessionsByUserID(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	now := a.TimeProvider.Now()
+	activeSessions := make([]*domain.Session, 0, len(sessions))
+
+	for _, session := range sessions {
+		if !now.Before(session.ExpiresAt.Time) {
+			continue
+		}
+
+		session.IsCurrent = session.SessionID == currentSessionID
+		a.Sanitizer.SanitizeSession(session)
+
+		activeSessions = append(activeSessions, session)
+	}
+
+	sort.SliceStable(activeSessions, func(i, j int) bool {
+		return activeSessions[i].LastSeenAt.After(activeSessions[j].LastSeenAt.Time)
+	})
+
+	sessions = activeSessions
+
+	return
+}
+
+func (a *Service) RevokeSession(ctx context.Context, userID uint, id string) (err error) {
+	if len(id) == 0 {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = a.SessionStorage.DeleteSessionByID(ctx, userID, id)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (a *Service) RevokeAllSessions(ctx context.Context, userID uint, exceptSessionID string) (err error) {
+	err = a.SessionStorage.DeleteSessionsByUserID(ctx, userID, exceptSessionID)
+	if err != nil {
+		return
+	}
+
+	return
+}
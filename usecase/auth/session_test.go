@@ -0,0 +1,235 @@
+package auth_test
+
+import (
+	"context"
+	"reflect"
+	"socio/domain"
+	"socio/errors"
+	mock_auth "socio/mocks/usecase/auth"
+	"socio/pkg/hash"
+	customtime "socio/pkg/time"
+	"socio/usecase/auth"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestService_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	timeProv := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name             string
+		userID           uint
+		currentSessionID string
+		mock             func(storage *mock_auth.MockSessionStorage)
+		want             []*domain.Session
+		wantErr          bool
+	}{
+		{
+			name:             "Test OK",
+			userID:           1,
+			currentSessionID: "current",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().GetSessionsByUserID(gomock.Any(), uint(1)).Return([]*domain.Session{
+					{
+						ID:         "1",
+						SessionID:  "other",
+						UserID:     1,
+						LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+						ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+					},
+					{
+						ID:         "2",
+						SessionID:  "current",
+						UserID:     1,
+						LastSeenAt: customtime.CustomTime{Time: timeProv.Now()},
+						ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+					},
+					{
+						ID:         "3",
+						SessionID:  "expired",
+						UserID:     1,
+						LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-2 * time.Hour)},
+						ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					},
+				}, nil)
+			},
+			want: []*domain.Session{
+				{
+					ID:         "2",
+					SessionID:  "current",
+					UserID:     1,
+					IsCurrent:  true,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now()},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+				},
+				{
+					ID:         "1",
+					SessionID:  "other",
+					UserID:     1,
+					LastSeenAt: customtime.CustomTime{Time: timeProv.Now().Add(-time.Hour)},
+					ExpiresAt:  customtime.CustomTime{Time: timeProv.Now().Add(time.Hour)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:             "Test Error",
+			userID:           1,
+			currentSessionID: "current",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().GetSessionsByUserID(gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_auth.NewMockSessionStorage(ctrl)
+
+			a := auth.NewService(storage, nil, nil, hash.NewArgon2idHasher(testArgon2idParams), timeProv)
+
+			tt.mock(storage)
+
+			got, err := a.ListSessions(context.Background(), tt.userID, tt.currentSessionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListSessions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListSessions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  uint
+		id      string
+		mock    func(storage *mock_auth.MockSessionStorage)
+		wantErr bool
+	}{
+		{
+			name:   "Test OK",
+			userID: 1,
+			id:     "1",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().DeleteSessionByID(gomock.Any(), uint(1), "1").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Test Empty ID",
+			userID:  1,
+			id:      "",
+			mock:    func(storage *mock_auth.MockSessionStorage) {},
+			wantErr: true,
+		},
+		{
+			name:   "Test Not Found",
+			userID: 1,
+			id:     "1",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().DeleteSessionByID(gomock.Any(), uint(1), "1").Return(errors.ErrNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_auth.NewMockSessionStorage(ctrl)
+
+			a := auth.NewService(storage, nil, nil, hash.NewArgon2idHasher(testArgon2idParams), customtime.MockTimeProvider{})
+
+			tt.mock(storage)
+
+			err := a.RevokeSession(context.Background(), tt.userID, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RevokeSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_RevokeAllSessions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		userID          uint
+		exceptSessionID string
+		mock            func(storage *mock_auth.MockSessionStorage)
+		wantErr         bool
+	}{
+		{
+			name:            "Test OK",
+			userID:          1,
+			exceptSessionID: "current",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().DeleteSessionsByUserID(gomock.Any(), uint(1), "current").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:            "Test Error",
+			userID:          1,
+			exceptSessionID: "current",
+			mock: func(storage *mock_auth.MockSessionStorage) {
+				storage.EXPECT().DeleteSessionsByUserID(gomock.Any(), uint(1), "current").Return(errors.ErrInternal)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_auth.NewMockSessionStorage(ctrl)
+
+			a := auth.NewService(storage, nil, nil, hash.NewArgon2idHasher(testArgon2idParams), customtime.MockTimeProvider{})
+
+			tt.mock(storage)
+
+			err := a.RevokeAllSessions(context.Background(), tt.userID, tt.exceptSessionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RevokeAllSessions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDescribeDevice(t *testing.T) {
+	tests := map[string]string{
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)": "iPhone",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8)":               "Android",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64)":              "Windows",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)":        "macOS",
+		"Mozilla/5.0 (X11; Linux x86_64)":                        "Linux",
+		"curl/8.4.0":                                             "Unknown device",
+		"":                                                       "Unknown device",
+	}
+
+	for userAgent, want := range tests {
+		if got := auth.DescribeDevice(userAgent); got != want {
+			t.Errorf("DescribeDevice(%q) = %v, want %v", userAgent, got, want)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package chat
+
+import (
+	"context"
+	"slices"
+	"socio/domain"
+	"socio/errors"
+	customtime "socio/pkg/time"
+	"time"
+)
+
+const (
+	AttachmentURLTTL = 15 * time.Minute
+)
+
+// AttachmentURLSigner issues the links to the objects of the private message
+// attachments storage.
+type AttachmentURLSigner interface {
+	PresignedURL(fileName string, expiry time.Duration) (fileURL string, err error)
+}
+
+// AttachmentURLService hands out the short-lived links to the message
+// attachments, only the peers of the dialog or the participants of the
+// conversation of the message get them. The attachments of the messages held
+// by the content filter are only signed for their sender.
+type AttachmentURLService struct {
+	MessagesRepo PersonalMessagesRepository
+	Signer       AttachmentURLSigner
+	TimeProvider customtime.TimeProvider
+}
+
+func NewAttachmentURLService(messagesRepo PersonalMessagesRepository, signer AttachmentURLSigner) (service *AttachmentURLService) {
+	return &AttachmentURLService{
+		MessagesRepo: messagesRepo,
+		Signer:       signer,
+		TimeProvider: customtime.RealTimeProvider{},
+	}
+}
+
+// GetAttachmentURL renews the link to the attachment of the message, the
+// links sent before expire after AttachmentURLTTL.
+func (s *AttachmentURLService) GetAttachmentURL(ctx context.Context, userID, messageID uint, fileName string) (attachmentURL *domain.AttachmentURL, err error) {
+	message, err := s.MessagesRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return
+	}
+
+	allowed, err := s.canAccess(ctx, userID, message, nil)
+	if err != nil {
+		return
+	}
+
+	if !allowed {
+		err = errors.ErrForbidden
+		return
+	}
+
+	if !slices.Contains(message.Attachments, fileName) {
+		err = errors.ErrNotFound
+		return
+	}
+
+	attachmentURL, err = s.sign(fileName)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SignMessages fills AttachmentURLs of the messages the user can access, the
+// other messages are left without the links.
+func (s *AttachmentURLService) SignMessages(ctx context.Context, userID uint, messages []*domain.PersonalMessage) (err error) {
+	participation := make(map[uint]bool)
+
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+
+		message.AttachmentURLs = nil
+
+		if len(message.Attachments) == 0 {
+			continue
+		}
+
+		var allowed bool
+
+		allowed, err = s.canAccess(ctx, userID, message, participation)
+		if err != nil {
+			return
+		}
+
+		if !allowed {
+			continue
+		}
+
+		for _, fileName := range message.Attachments {
+			var attachmentURL *domain.AttachmentURL
+
+			attachmentURL, err = s.sign(fileName)
+			if err != nil {
+				return
+			}
+
+			message.AttachmentURLs = append(message.AttachmentURLs, attachmentURL)
+		}
+	}
+
+	return
+}
+
+// canAccess checks that the user is a peer of the dialog or a participant of
+// the conversation of the message, participation caches the checks by the
+// conversation ID and may be nil. The held messages are only accessible to
+// their sender.
+func (s *AttachmentURLService) canAccess(ctx context.Context, userID uint, message *domain.PersonalMessage, participation map[uint]bool) (allowed bool, err error) {
+	if message.IsHidden {
+		allowed = message.SenderID == userID
+		return
+	}
+
+	if message.ConversationID == 0 {
+		allowed = message.SenderID == userID || message.ReceiverID == userID
+		return
+	}
+
+	allowed, ok := participation[message.ConversationID]
+	if ok {
+		return
+	}
+
+	_, err = s.MessagesRepo.GetConversationParticipantRole(ctx, message.ConversationID, userID)
+	switch err {
+	case nil:
+		allowed = true
+	case errors.ErrNotFound:
+		allowed = false
+		err = nil
+	default:
+		return
+	}
+
+	if participation != nil {
+		participation[message.ConversationID] = allowed
+	}
+
+	return
+}
+
+func (s *AttachmentURLService) sign(fileName string) (attachmentURL *domain.AttachmentURL, err error) {
+	expiresAt := s.TimeProvider.Now().Add(AttachmentURLTTL)
+
+	fileURL, err := s.Signer.PresignedURL(fileName, AttachmentURLTTL)
+	if err != nil {
+		return
+	}
+
+	attachmentURL = &domain.AttachmentURL{
+		FileName:  fileName,
+		URL:       fileURL,
+		ExpiresAt: customtime.CustomTime{Time: expiresAt},
+	}
+	return
+}
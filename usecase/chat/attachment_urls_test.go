@@ -0,0 +1,331 @@
+package chat_test
+
+import (
+	"context"
+	"reflect"
+	"socio/domain"
+	"socio/errors"
+	mock_chat "socio/mocks/usecase/chat"
+	customtime "socio/pkg/time"
+	"socio/usecase/chat"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+type attachmentURLFields struct {
+	PersonalMessagesRepo *mock_chat.MockPersonalMessagesRepository
+	Signer               *mock_chat.MockAttachmentURLSigner
+}
+
+func TestGetAttachmentURL(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name        string
+		userID      uint
+		messageID   uint
+		fileName    string
+		expected    *domain.AttachmentURL
+		expectedErr error
+		prepare     func(f *attachmentURLFields)
+	}{
+		{
+			name:      "TestGetAttachmentURL dialog peer",
+			userID:    2,
+			messageID: 1,
+			fileName:  "pic.png",
+			expected: &domain.AttachmentURL{
+				FileName:  "pic.png",
+				URL:       "http://minio/pic.png?sig",
+				ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)},
+			},
+			expectedErr: nil,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+				}, nil)
+				f.Signer.EXPECT().PresignedURL("pic.png", chat.AttachmentURLTTL).Return("http://minio/pic.png?sig", nil)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL not a dialog peer",
+			userID:      3,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+				}, nil)
+			},
+		},
+		{
+			name:      "TestGetAttachmentURL conversation participant",
+			userID:    3,
+			messageID: 1,
+			fileName:  "pic.png",
+			expected: &domain.AttachmentURL{
+				FileName:  "pic.png",
+				URL:       "http://minio/pic.png?sig",
+				ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)},
+			},
+			expectedErr: nil,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:             1,
+					SenderID:       1,
+					ConversationID: 5,
+					Attachments:    []string{"pic.png"},
+				}, nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(5), uint(3)).Return(domain.ConversationRoleMember, nil)
+				f.Signer.EXPECT().PresignedURL("pic.png", chat.AttachmentURLTTL).Return("http://minio/pic.png?sig", nil)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL not a conversation participant",
+			userID:      3,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:             1,
+					SenderID:       1,
+					ConversationID: 5,
+					Attachments:    []string{"pic.png"},
+				}, nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(5), uint(3)).Return(domain.ConversationRole(""), errors.ErrNotFound)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL held message receiver",
+			userID:      2,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+					IsHidden:    true,
+				}, nil)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL held conversation message participant",
+			userID:      3,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:             1,
+					SenderID:       1,
+					ConversationID: 5,
+					Attachments:    []string{"pic.png"},
+					IsHidden:       true,
+				}, nil)
+			},
+		},
+		{
+			name:      "TestGetAttachmentURL held message sender",
+			userID:    1,
+			messageID: 1,
+			fileName:  "pic.png",
+			expected: &domain.AttachmentURL{
+				FileName:  "pic.png",
+				URL:       "http://minio/pic.png?sig",
+				ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)},
+			},
+			expectedErr: nil,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+					IsHidden:    true,
+				}, nil)
+				f.Signer.EXPECT().PresignedURL("pic.png", chat.AttachmentURLTTL).Return("http://minio/pic.png?sig", nil)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL attachment of another message",
+			userID:      1,
+			messageID:   1,
+			fileName:    "other.png",
+			expected:    nil,
+			expectedErr: errors.ErrNotFound,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+				}, nil)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL message not found",
+			userID:      1,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrNotFound,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
+			},
+		},
+		{
+			name:        "TestGetAttachmentURL signer error",
+			userID:      1,
+			messageID:   1,
+			fileName:    "pic.png",
+			expected:    nil,
+			expectedErr: errors.ErrInternal,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(1)).Return(&domain.PersonalMessage{
+					ID:          1,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"pic.png"},
+				}, nil)
+				f.Signer.EXPECT().PresignedURL("pic.png", chat.AttachmentURLTTL).Return("", errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &attachmentURLFields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+				Signer:               mock_chat.NewMockAttachmentURLSigner(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewAttachmentURLService(fields.PersonalMessagesRepo, fields.Signer)
+			s.TimeProvider = tp
+
+			attachmentURL, err := s.GetAttachmentURL(context.Background(), tt.userID, tt.messageID, tt.fileName)
+			if err != tt.expectedErr {
+				t.Errorf("GetAttachmentURL() error = %v, wantErr %v", err, tt.expectedErr)
+				return
+			}
+
+			if !reflect.DeepEqual(attachmentURL, tt.expected) {
+				t.Errorf("GetAttachmentURL() = %v, want %v", attachmentURL, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSignMessages(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name        string
+		userID      uint
+		messages    []*domain.PersonalMessage
+		expected    [][]*domain.AttachmentURL
+		expectedErr error
+		prepare     func(f *attachmentURLFields)
+	}{
+		{
+			name:   "TestSignMessages signs accessible messages",
+			userID: 1,
+			messages: []*domain.PersonalMessage{
+				{ID: 1, SenderID: 1, ReceiverID: 2, Attachments: []string{"a.png", "b.png"}},
+				{ID: 2, SenderID: 2, ReceiverID: 3, Attachments: []string{"c.png"}},
+				{ID: 3, SenderID: 2, ConversationID: 5, Attachments: []string{"d.png"}},
+				{ID: 4, SenderID: 3, ConversationID: 5, Attachments: []string{"e.png"}},
+				{ID: 5, SenderID: 2, ReceiverID: 1},
+				nil,
+			},
+			expected: [][]*domain.AttachmentURL{
+				{
+					{FileName: "a.png", URL: "url-a.png", ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)}},
+					{FileName: "b.png", URL: "url-b.png", ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)}},
+				},
+				nil,
+				{
+					{FileName: "d.png", URL: "url-d.png", ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)}},
+				},
+				{
+					{FileName: "e.png", URL: "url-e.png", ExpiresAt: customtime.CustomTime{Time: tp.Now().Add(chat.AttachmentURLTTL)}},
+				},
+				nil,
+				nil,
+			},
+			expectedErr: nil,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(5), uint(1)).Return(domain.ConversationRoleMember, nil).Times(1)
+				f.Signer.EXPECT().PresignedURL(gomock.Any(), chat.AttachmentURLTTL).DoAndReturn(func(fileName string, expiry time.Duration) (string, error) {
+					return "url-" + fileName, nil
+				}).Times(4)
+			},
+		},
+		{
+			name:   "TestSignMessages participation error",
+			userID: 1,
+			messages: []*domain.PersonalMessage{
+				{ID: 1, SenderID: 2, ConversationID: 5, Attachments: []string{"a.png"}},
+			},
+			expected:    [][]*domain.AttachmentURL{nil},
+			expectedErr: errors.ErrInternal,
+			prepare: func(f *attachmentURLFields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(5), uint(1)).Return(domain.ConversationRole(""), errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &attachmentURLFields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+				Signer:               mock_chat.NewMockAttachmentURLSigner(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewAttachmentURLService(fields.PersonalMessagesRepo, fields.Signer)
+			s.TimeProvider = tp
+
+			err := s.SignMessages(context.Background(), tt.userID, tt.messages)
+			if err != tt.expectedErr {
+				t.Errorf("SignMessages() error = %v, wantErr %v", err, tt.expectedErr)
+				return
+			}
+
+			for i, message := range tt.messages {
+				if message == nil {
+					continue
+				}
+
+				if !reflect.DeepEqual(message.AttachmentURLs, tt.expected[i]) {
+					t.Errorf("SignMessages() message %d = %v, want %v", message.ID, message.AttachmentURLs, tt.expected[i])
+				}
+			}
+		})
+	}
+}
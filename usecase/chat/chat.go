@@ -6,8 +6,12 @@ import (
 	"path/filepath"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contentfilter"
 	"socio/pkg/sanitizer"
 	"socio/pkg/static"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"socio/usecase/ratelimit"
 	"sync"
 
 	"github.com/google/uuid"
@@ -26,7 +30,25 @@ type Service struct {
 	UnsentMessageAttachmentsStorage UnsentMessageAttachmentsStorage
 	MessageAttachmentStorage        MessageAttachmentStorage
 	StickerStorage                  StickerStorage
+	PresenceStorage                 PresenceStorage
+	AttachmentURLs                  *AttachmentURLService
 	Sanitizer                       *sanitizer.Sanitizer
+	ContentFilter                   *contentfilter.Filter
+	RateLimiter                     RateLimiter
+	EmailVerifier                   EmailVerifier
+	TimeProvider                    customtime.TimeProvider
+}
+
+// RateLimiter limits the actions of the clients, the actions are not limited
+// if it is not set.
+type RateLimiter interface {
+	Allow(ctx context.Context, scope, subject string, limit ratelimit.Limit) (decision ratelimit.Decision)
+}
+
+// EmailVerifier tells whether the user has confirmed the email, the actions
+// are not restricted if it is not set.
+type EmailVerifier interface {
+	CheckIfEmailIsVerified(ctx context.Context, userID uint) (isVerified bool, err error)
 }
 
 type StickerStorage interface {
@@ -34,7 +56,7 @@ type StickerStorage interface {
 	Delete(fileName string) (err error)
 }
 
-func NewChatService(pubSubRepo PubSubRepository, unsentMessageAttachmentsStorage UnsentMessageAttachmentsStorage, messagesRepo PersonalMessagesRepository, stickerStorage StickerStorage, messageAttachmentStorage MessageAttachmentStorage) (chatService *Service) {
+func NewChatService(pubSubRepo PubSubRepository, unsentMessageAttachmentsStorage UnsentMessageAttachmentsStorage, messagesRepo PersonalMessagesRepository, stickerStorage StickerStorage, messageAttachmentStorage MessageAttachmentStorage, presenceStorage PresenceStorage) (chatService *Service) {
 	return &Service{
 		Clients:                         &sync.Map{},
 		PubSubRepository:                pubSubRepo,
@@ -42,7 +64,11 @@ func NewChatService(pubSubRepo PubSubRepository, unsentMessageAttachmentsStorage
 		MessagesRepo:                    messagesRepo,
 		StickerStorage:                  stickerStorage,
 		MessageAttachmentStorage:        messageAttachmentStorage,
+		PresenceStorage:                 presenceStorage,
+		AttachmentURLs:                  NewAttachmentURLService(messagesRepo, messageAttachmentStorage),
 		Sanitizer:                       sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
+		ContentFilter:                   contentfilter.NewFilter(contentfilter.ConfigFromEnv(), customtime.RealTimeProvider{}),
+		TimeProvider:                    customtime.RealTimeProvider{},
 	}
 }
 
@@ -107,6 +133,46 @@ func (s *Service) GetMessagesByDialog(ctx context.Context, userID, peerID, lastM
 		s.Sanitizer.SanitizePersonalMessage(message)
 	}
 
+	err = s.describeAttachments(ctx, messages)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// describeAttachments fills AttachmentsInfo of the messages, the attachments
+// uploaded before they were described are left out.
+func (s *Service) describeAttachments(ctx context.Context, messages []*domain.PersonalMessage) (err error) {
+	fileNames := make([]string, 0)
+	for _, message := range messages {
+		fileNames = append(fileNames, message.Attachments...)
+	}
+
+	if len(fileNames) == 0 {
+		return
+	}
+
+	attachments, err := s.MessagesRepo.GetAttachmentsByFileNames(ctx, fileNames)
+	if err != nil {
+		return
+	}
+
+	attachmentsByFileName := make(map[string]*domain.Attachment, len(attachments))
+	for _, attachment := range attachments {
+		attachmentsByFileName[attachment.FileName] = attachment
+		s.Sanitizer.SanitizeAttachment(attachment)
+	}
+
+	for _, message := range messages {
+		message.AttachmentsInfo = nil
+		for _, fileName := range message.Attachments {
+			if attachment, ok := attachmentsByFileName[fileName]; ok {
+				message.AttachmentsInfo = append(message.AttachmentsInfo, attachment)
+			}
+		}
+	}
+
 	return
 }
 
@@ -162,8 +228,9 @@ func (s *Service) CreateSticker(ctx context.Context, sticker *domain.Sticker, im
 		return
 	}
 
-	err = s.StickerStorage.Store(fileName, "./"+fileName, image.Header.Get("Content-Type"))
+	_, err = upload.Process(s.StickerStorage, upload.StickerKind, fileName, "./"+fileName)
 	if err != nil {
+		_ = static.RemoveFile("./" + fileName)
 		return
 	}
 
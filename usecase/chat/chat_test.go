@@ -111,6 +111,51 @@ func TestGetMessagesByDialog(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name:           "TestGetMessagesByDialog with attachments",
+			userID:         1,
+			peerID:         2,
+			lastMessageID:  5,
+			messagesAmount: 10,
+			expectedErr:    nil,
+			expectedMessage: []*domain.PersonalMessage{
+				{
+					ID:          4,
+					SenderID:    1,
+					ReceiverID:  2,
+					Attachments: []string{"report.pdf", "old.png"},
+					AttachmentsInfo: []*domain.Attachment{
+						{FileName: "report.pdf", Kind: domain.FileAttachment, MimeType: "application/pdf", Size: 100, OriginalName: "report.pdf"},
+					},
+				},
+			},
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessagesByDialog(gomock.Any(), uint(1), uint(2), uint(5), uint(10)).Return([]*domain.PersonalMessage{
+					{
+						ID:          4,
+						SenderID:    1,
+						ReceiverID:  2,
+						Attachments: []string{"report.pdf", "old.png"},
+					},
+				}, nil)
+				f.PersonalMessagesRepo.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"report.pdf", "old.png"}).Return([]*domain.Attachment{
+					{FileName: "report.pdf", Kind: domain.FileAttachment, MimeType: "application/pdf", Size: 100, OriginalName: "report.pdf"},
+				}, nil)
+			},
+		},
+		{
+			name:            "TestGetMessagesByDialog attachments error",
+			userID:          1,
+			peerID:          2,
+			lastMessageID:   5,
+			messagesAmount:  10,
+			expectedErr:     errors.ErrInternal,
+			expectedMessage: []*domain.PersonalMessage{{ID: 4, Attachments: []string{"report.pdf"}}},
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetMessagesByDialog(gomock.Any(), uint(1), uint(2), uint(5), uint(10)).Return([]*domain.PersonalMessage{{ID: 4, Attachments: []string{"report.pdf"}}}, nil)
+				f.PersonalMessagesRepo.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"report.pdf"}).Return(nil, errors.ErrInternal)
+			},
+		},
 		{
 			name:            "TestGetMessagesByDialog",
 			userID:          1,
@@ -149,7 +194,7 @@ func TestGetMessagesByDialog(t *testing.T) {
 
 			tt.prepare(fields)
 
-			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil)
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
 
 			messages, err := s.GetMessagesByDialog(context.Background(), tt.userID, tt.peerID, tt.lastMessageID, tt.messagesAmount)
 
@@ -240,7 +285,7 @@ func TestGetDialogsByUserID(t *testing.T) {
 
 			tt.prepare(fields)
 
-			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil)
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
 
 			dialogs, err := s.GetDialogsByUserID(context.Background(), tt.userID)
 
@@ -290,7 +335,7 @@ func TestGetClient(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := chat.NewChatService(nil, nil, nil, nil, nil)
+			s := chat.NewChatService(nil, nil, nil, nil, nil, nil)
 
 			tt.setup(s)
 
@@ -352,7 +397,7 @@ func TestGetStickersByAuthorID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, nil, mockMessagesRepo, nil, nil)
+			s := chat.NewChatService(nil, nil, mockMessagesRepo, nil, nil, nil)
 
 			got, err := s.GetStickersByAuthorID(context.Background(), tt.authorID)
 			assert.Equal(t, tt.wantErr, err)
@@ -419,7 +464,7 @@ func TestGetAllStickers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, nil, mockMessagesRepo, nil, nil)
+			s := chat.NewChatService(nil, nil, mockMessagesRepo, nil, nil, nil)
 
 			got, err := s.GetAllStickers(context.Background())
 			assert.Equal(t, tt.wantErr, err)
@@ -501,7 +546,7 @@ func TestDeleteSticker(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, nil, mockMessagesRepo, mockStickerStorage, nil)
+			s := chat.NewChatService(nil, nil, mockMessagesRepo, mockStickerStorage, nil, nil)
 
 			err := s.DeleteSticker(context.Background(), tt.stickerID, tt.userID)
 			assert.Equal(t, tt.wantErr, err)
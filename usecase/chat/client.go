@@ -3,9 +3,14 @@ package chat
 import (
 	"context"
 	json "encoding/json"
+	"fmt"
+	"slices"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contentfilter"
+	"socio/usecase/ratelimit"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mailru/easyjson"
@@ -18,13 +23,49 @@ const (
 	UpdateMessageAction      ChatAction = "UPDATE_MESSAGE"
 	DeleteMessageAction      ChatAction = "DELETE_MESSAGE"
 	SendStickerMessageAction ChatAction = "SEND_STICKER_MESSAGE"
+	MarkReadAction           ChatAction = "MARK_READ"
+	TypingStartAction        ChatAction = "TYPING_START"
+	TypingStopAction         ChatAction = "TYPING_STOP"
+	PresenceAction           ChatAction = "PRESENCE"
+	NotificationAction       ChatAction = "NOTIFICATION"
+
+	CreateConversationAction   ChatAction = "CREATE_CONVERSATION"
+	InviteToConversationAction ChatAction = "INVITE_TO_CONVERSATION"
+	KickFromConversationAction ChatAction = "KICK_FROM_CONVERSATION"
+	LeaveConversationAction    ChatAction = "LEAVE_CONVERSATION"
+	RenameConversationAction   ChatAction = "RENAME_CONVERSATION"
+	SetConversationRoleAction  ChatAction = "SET_CONVERSATION_ROLE"
 )
 
+// ActionRateLimits limits the actions sent by the user over all of the user's
+// connections, the actions not listed here are not limited.
+var ActionRateLimits = map[ChatAction]ratelimit.Limit{
+	SendMessageAction:          {Requests: 60, Window: time.Minute},
+	UpdateMessageAction:        {Requests: 30, Window: time.Minute},
+	DeleteMessageAction:        {Requests: 30, Window: time.Minute},
+	SendStickerMessageAction:   {Requests: 30, Window: time.Minute},
+	CreateConversationAction:   {Requests: 10, Window: time.Minute},
+	InviteToConversationAction: {Requests: 30, Window: time.Minute},
+	RenameConversationAction:   {Requests: 10, Window: time.Minute},
+}
+
+// UnverifiedRestrictedActions can not be sent by the users who have not
+// confirmed their email yet.
+var UnverifiedRestrictedActions = map[ChatAction]bool{
+	SendMessageAction:          true,
+	SendStickerMessageAction:   true,
+	CreateConversationAction:   true,
+	InviteToConversationAction: true,
+}
+
 type PersonalMessagesRepository interface {
 	GetMessageByID(ctx context.Context, msgID uint) (msg *domain.PersonalMessage, err error)
 	GetLastMessageID(ctx context.Context, senderID, receiverID uint) (lastMessageID uint, err error)
+	CanSendMessage(ctx context.Context, senderID, receiverID uint) (allowed bool, err error)
+	IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error)
 	GetMessagesByDialog(ctx context.Context, senderID, receiverID, lastMessageID, messagesAmount uint) (messages []*domain.PersonalMessage, err error)
 	GetDialogsByUserID(ctx context.Context, userID uint) (dialogs []*domain.Dialog, err error)
+	UpdateLastReadMessageID(ctx context.Context, userID, peerID, messageID uint) (lastReadMessageID uint, err error)
 	StoreMessage(ctx context.Context, message *domain.PersonalMessage) (newMessage *domain.PersonalMessage, err error)
 	UpdateMessage(ctx context.Context, msg *domain.PersonalMessage, attachmentsToDelete []string) (updatedMsg *domain.PersonalMessage, err error)
 	DeleteMessage(ctx context.Context, messageID uint) (err error)
@@ -34,21 +75,43 @@ type PersonalMessagesRepository interface {
 	StoreSticker(ctx context.Context, sticker *domain.Sticker) (newSticker *domain.Sticker, err error)
 	DeleteSticker(ctx context.Context, stickerID uint) (err error)
 	StoreStickerMessage(ctx context.Context, senderID, receiverID, stickerID uint) (newStickerMessage *domain.PersonalMessage, err error)
+	StoreConversationStickerMessage(ctx context.Context, senderID, conversationID, stickerID uint) (newStickerMessage *domain.PersonalMessage, err error)
+	GetConversationByID(ctx context.Context, conversationID uint) (conversation *domain.Conversation, err error)
+	GetConversationsByUserID(ctx context.Context, userID uint) (conversations []*domain.Conversation, err error)
+	GetConversationParticipantIDs(ctx context.Context, conversationID uint) (userIDs []uint, err error)
+	GetConversationPeerIDs(ctx context.Context, userID uint) (peerIDs []uint, err error)
+	GetConversationParticipantRole(ctx context.Context, conversationID, userID uint) (role domain.ConversationRole, err error)
+	StoreConversation(ctx context.Context, conversation *domain.Conversation) (newConversation *domain.Conversation, err error)
+	StoreConversationParticipants(ctx context.Context, conversationID uint, userIDs []uint) (err error)
+	UpdateConversationName(ctx context.Context, conversationID uint, name string) (err error)
+	UpdateConversationParticipantRole(ctx context.Context, conversationID, userID uint, role domain.ConversationRole) (err error)
+	DeleteConversationParticipant(ctx context.Context, conversationID, userID uint) (err error)
+	DeleteConversation(ctx context.Context, conversationID uint) (err error)
+	GetLastConversationMessageID(ctx context.Context, conversationID uint) (lastMessageID uint, err error)
+	GetMessagesByConversation(ctx context.Context, conversationID, lastMessageID, messagesAmount uint) (messages []*domain.PersonalMessage, err error)
+	StoreAttachment(ctx context.Context, attachment *domain.Attachment) (err error)
+	GetAttachmentsByFileNames(ctx context.Context, fileNames []string) (attachments []*domain.Attachment, err error)
+	GetAttachmentByChecksum(ctx context.Context, checksum string) (attachment *domain.Attachment, err error)
+	CountAttachmentReferences(ctx context.Context, fileName string) (count uint, err error)
 }
 
 type PubSubRepository interface {
 	ReadActions(ctx context.Context, userID uint, ch chan *Action) (err error)
-	WriteAction(ctx context.Context, action *Action) (err error)
+	ReadActionsAfter(ctx context.Context, userID uint, lastEventID string) (actions []*Action, err error)
+	WriteAction(ctx context.Context, action *Action, receivers []uint) (err error)
+	PublishAction(ctx context.Context, action *Action, receivers []uint) (err error)
 }
 
 type ChatAction string
 
 //easyjson:json
 type Action struct {
-	Type      ChatAction      `json:"type"`
-	Receiver  uint            `json:"receiver"`
-	CSRFToken string          `json:"csrfToken"`
-	Payload   json.RawMessage `json:"payload"`
+	EventID        string          `json:"eventId,omitempty"`
+	Type           ChatAction      `json:"type"`
+	Receiver       uint            `json:"receiver"`
+	ConversationID uint            `json:"conversationId,omitempty"`
+	CSRFToken      string          `json:"csrfToken"`
+	Payload        json.RawMessage `json:"payload"`
 }
 
 //easyjson:json
@@ -74,11 +137,69 @@ type SendStickerMessagePayload struct {
 	StickerID uint `json:"stickerId"`
 }
 
+//easyjson:json
+type MarkReadPayload struct {
+	MessageID uint `json:"messageId"`
+}
+
+// ReadReceipt is sent to both peers of a dialog when one of them reads it.
+//
+//easyjson:json
+type ReadReceipt struct {
+	UserID            uint `json:"userId"`
+	PeerID            uint `json:"peerId"`
+	LastReadMessageID uint `json:"lastReadMessageId"`
+}
+
+// TypingPayload is relayed to the peers of a dialog or a conversation when the
+// user starts or stops typing, it is never persisted.
+//
+//easyjson:json
+type TypingPayload struct {
+	UserID uint `json:"userId"`
+}
+
+//easyjson:json
+type CreateConversationPayload struct {
+	Name           string `json:"name"`
+	ParticipantIDs []uint `json:"participantIds"`
+}
+
+//easyjson:json
+type InviteToConversationPayload struct {
+	UserIDs []uint `json:"userIds"`
+}
+
+//easyjson:json
+type KickFromConversationPayload struct {
+	UserID uint `json:"userId"`
+}
+
+//easyjson:json
+type RenameConversationPayload struct {
+	Name string `json:"name"`
+}
+
+//easyjson:json
+type SetConversationRolePayload struct {
+	UserID uint                    `json:"userId"`
+	Role   domain.ConversationRole `json:"role"`
+}
+
 type Client struct {
 	UserID                    uint
 	Send                      chan *Action
 	ChatService               *Service
 	UnsentAttachmentReceivers *sync.Map
+
+	// ConnsMu is held while the actions are written to the user connections
+	// and while a new connection gets its missed actions, so the live actions
+	// follow the replayed ones and no connection is written concurrently.
+	ConnsMu sync.Mutex
+
+	// presenceTouchedAt is the unix nano time of the last presence heartbeat
+	// written to the storage, it throttles heartbeats of all user connections.
+	presenceTouchedAt atomic.Int64
 }
 
 func NewClient(userID uint, chatService *Service) (client *Client, err error) {
@@ -117,6 +238,16 @@ func (c *Client) ReadPump(ctx context.Context) {
 }
 
 func (c *Client) HandleAction(ctx context.Context, action *Action) {
+	if !c.allowAction(ctx, action) {
+		c.replyWithError(ctx, action, errors.ErrTooManyRequests)
+		return
+	}
+
+	if err := c.checkEmailVerified(ctx, action); err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
 	switch action.Type {
 	case SendMessageAction:
 		payload := new(SendMessagePayload)
@@ -149,123 +280,293 @@ func (c *Client) HandleAction(ctx context.Context, action *Action) {
 			return
 		}
 		c.handleSendStickerMessageAction(ctx, action, payload)
-	}
-}
 
-func (c *Client) handleSendMessageAction(ctx context.Context, action *Action, message *SendMessagePayload) {
-	attachments, err := c.ChatService.UnsentMessageAttachmentsStorage.GetAll(ctx, &domain.UnsentMessageAttachment{
-		SenderID:   c.UserID,
-		ReceiverID: action.Receiver,
-	})
-	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
+	case MarkReadAction:
+		payload := new(MarkReadPayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleMarkReadAction(ctx, action, payload)
+
+	case TypingStartAction, TypingStopAction:
+		c.handleTypingAction(ctx, action)
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	case CreateConversationAction:
+		payload := new(CreateConversationPayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleCreateConversationAction(ctx, action, payload)
 
-		return
-	}
-
-	msg := &domain.PersonalMessage{
-		Content:     message.Content,
-		SenderID:    c.UserID,
-		ReceiverID:  action.Receiver,
-		Attachments: attachments,
-	}
-
-	c.ChatService.Sanitizer.SanitizePersonalMessage(msg)
-
-	if len(msg.Content) == 0 && len(attachments) == 0 {
-		action.Payload, err = errors.MarshalError(errors.ErrInvalidData)
+	case InviteToConversationAction:
+		payload := new(InviteToConversationPayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleInviteToConversationAction(ctx, action, payload)
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	case KickFromConversationAction:
+		payload := new(KickFromConversationPayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleKickFromConversationAction(ctx, action, payload)
 
-		return
-	}
+	case LeaveConversationAction:
+		c.handleLeaveConversationAction(ctx, action)
 
-	newMessage, err := c.ChatService.MessagesRepo.StoreMessage(ctx, msg)
-	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
+	case RenameConversationAction:
+		payload := new(RenameConversationPayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleRenameConversationAction(ctx, action, payload)
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	case SetConversationRoleAction:
+		payload := new(SetConversationRolePayload)
+		err := easyjson.Unmarshal(action.Payload, payload)
 		if err != nil {
 			return
 		}
+		c.handleSetConversationRoleAction(ctx, action, payload)
+	}
+}
 
+// receivers returns the users the action is delivered to: both peers of a
+// dialog or every participant of a conversation. For conversations it also
+// checks that the client is one of the participants.
+func (c *Client) receivers(ctx context.Context, action *Action) (receivers []uint, err error) {
+	if action.ConversationID == 0 {
+		receivers = []uint{c.UserID, action.Receiver}
 		return
 	}
 
-	err = c.ChatService.UnsentMessageAttachmentsStorage.DeleteAll(ctx, &domain.UnsentMessageAttachment{
+	receivers, err = c.ChatService.GetConversationParticipantIDs(ctx, action.ConversationID, c.UserID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// checkCanSendMessage enforces the blocks and the messages privacy settings
+// of the receiver of the dialog message, whether the dialog is addressed by the
+// receiver or by its conversation. The group conversations are not restricted.
+func (c *Client) checkCanSendMessage(ctx context.Context, action *Action) (err error) {
+	receiverID, err := c.dialogPeerID(ctx, action)
+	if err != nil {
+		return
+	}
+
+	if receiverID == 0 {
+		return
+	}
+
+	err = c.checkNotBlocked(ctx, receiverID)
+	if err != nil {
+		return
+	}
+
+	allowed, err := c.ChatService.MessagesRepo.CanSendMessage(ctx, c.UserID, receiverID)
+	if err != nil {
+		return
+	}
+
+	if !allowed {
+		err = errors.ErrForbidden
+		return
+	}
+
+	return
+}
+
+// dialogPeerID returns the peer of the dialog the action is addressed to, zero
+// for the group conversations.
+func (c *Client) dialogPeerID(ctx context.Context, action *Action) (peerID uint, err error) {
+	if action.ConversationID == 0 {
+		peerID = action.Receiver
+		return
+	}
+
+	peerID, err = c.ChatService.getDialogPeerID(ctx, action.ConversationID, c.UserID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// checkNotBlocked returns errors.ErrForbidden when the client and the peer
+// have blocked one another.
+func (c *Client) checkNotBlocked(ctx context.Context, peerID uint) (err error) {
+	blocked, err := c.ChatService.MessagesRepo.IsBlocked(ctx, c.UserID, peerID)
+	if err != nil {
+		return
+	}
+
+	if blocked {
+		err = errors.ErrForbidden
+		return
+	}
+
+	return
+}
+
+func (c *Client) allowAction(ctx context.Context, action *Action) bool {
+	limit, ok := ActionRateLimits[action.Type]
+	if !ok || c.ChatService.RateLimiter == nil {
+		return true
+	}
+
+	decision := c.ChatService.RateLimiter.Allow(ctx, "chat/"+string(action.Type), fmt.Sprintf("user:%d", c.UserID), limit)
+
+	return decision.Allowed
+}
+
+// checkEmailVerified returns errors.ErrEmailNotVerified for the actions listed
+// in UnverifiedRestrictedActions until the user verifies the email.
+func (c *Client) checkEmailVerified(ctx context.Context, action *Action) (err error) {
+	if !UnverifiedRestrictedActions[action.Type] || c.ChatService.EmailVerifier == nil {
+		return
+	}
+
+	isVerified, err := c.ChatService.EmailVerifier.CheckIfEmailIsVerified(ctx, c.UserID)
+	if err != nil {
+		return
+	}
+
+	if !isVerified {
+		err = errors.ErrEmailNotVerified
+		return
+	}
+
+	return
+}
+
+// replyWithError sends the error back to the client that issued the action.
+// The error is only published, it is not replayed after a reconnect.
+func (c *Client) replyWithError(ctx context.Context, action *Action, err error) {
+	action.Payload, err = errors.MarshalError(err)
+	if err != nil {
+		return
+	}
+
+	err = c.ChatService.PubSubRepository.PublishAction(ctx, action, []uint{c.UserID})
+	if err != nil {
+		return
+	}
+}
+
+func (c *Client) handleSendMessageAction(ctx context.Context, action *Action, message *SendMessagePayload) {
+	receivers, err := c.receivers(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	err = c.checkCanSendMessage(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	attachments, err := c.ChatService.UnsentMessageAttachmentsStorage.GetAll(ctx, &domain.UnsentMessageAttachment{
 		SenderID:   c.UserID,
 		ReceiverID: action.Receiver,
 	})
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+	msg := &domain.PersonalMessage{
+		Content:        message.Content,
+		SenderID:       c.UserID,
+		ReceiverID:     action.Receiver,
+		ConversationID: action.ConversationID,
+		Attachments:    attachments,
+	}
+
+	c.ChatService.Sanitizer.SanitizePersonalMessage(msg)
+
+	if len(msg.Content) == 0 && len(attachments) == 0 {
+		c.replyWithError(ctx, action, errors.ErrInvalidData)
+		return
+	}
+
+	decision := c.ChatService.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: c.UserID, Text: msg.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		c.replyWithError(ctx, action, decision.Err)
+		return
+	}
+
+	msg.IsHidden = decision.Verdict == contentfilter.HoldVerdict
+
+	newMessage, err := c.ChatService.MessagesRepo.StoreMessage(ctx, msg)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	// The held message is not delivered, the receivers see it in the history
+	// once a moderator shows it.
+	if newMessage.IsHidden {
+		receivers = []uint{c.UserID}
+	}
+
+	err = c.ChatService.UnsentMessageAttachmentsStorage.DeleteAll(ctx, &domain.UnsentMessageAttachment{
+		SenderID:   c.UserID,
+		ReceiverID: action.Receiver,
+	})
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
 	c.ChatService.Sanitizer.SanitizePersonalMessage(newMessage)
 
-	action.Payload, err = easyjson.Marshal(newMessage)
+	err = c.ChatService.describeAttachments(ctx, []*domain.PersonalMessage{newMessage})
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+	err = c.ChatService.AttachmentURLs.SignMessages(ctx, c.UserID, []*domain.PersonalMessage{newMessage})
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	action.Payload, err = easyjson.Marshal(newMessage)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
-	err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, receivers)
 	if err != nil {
 		return
 	}
 }
 
 func (c *Client) handleUpdateMessageAction(ctx context.Context, action *Action, message *UpdateMessagePayload) {
+	receivers, err := c.receivers(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
 	attachments, err := c.ChatService.UnsentMessageAttachmentsStorage.GetAll(ctx, &domain.UnsentMessageAttachment{
 		SenderID:   c.UserID,
 		ReceiverID: action.Receiver,
 	})
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
-
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
-
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
@@ -277,63 +578,51 @@ func (c *Client) handleUpdateMessageAction(ctx context.Context, action *Action,
 
 	oldMessage, err := c.ChatService.MessagesRepo.GetMessageByID(ctx, msg.ID)
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
-
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	if oldMessage.SenderID != c.UserID || (action.ConversationID != 0 && oldMessage.ConversationID != action.ConversationID) {
+		c.replyWithError(ctx, action, errors.ErrForbidden)
 		return
 	}
 
 	if len(message.Content) == 0 && (len(oldMessage.Attachments)+len(attachments)) <= len(message.AttachmentsToDelete) {
-		action.Payload, err = errors.MarshalError(errors.ErrInvalidData)
-		if err != nil {
-			return
-		}
-
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, errors.ErrInvalidData)
+		return
+	}
 
+	decision := c.ChatService.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.MessageContent, AuthorID: c.UserID, Text: msg.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		c.replyWithError(ctx, action, decision.Err)
 		return
 	}
 
-	newMessage, err := c.ChatService.MessagesRepo.UpdateMessage(ctx, msg, message.AttachmentsToDelete)
-	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+	msg.IsHidden = decision.Verdict == contentfilter.HoldVerdict
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
+	attachmentsToDelete := make([]string, 0, len(message.AttachmentsToDelete))
+	for _, attach := range message.AttachmentsToDelete {
+		if slices.Contains(oldMessage.Attachments, attach) {
+			attachmentsToDelete = append(attachmentsToDelete, attach)
 		}
+	}
 
+	newMessage, err := c.ChatService.MessagesRepo.UpdateMessage(ctx, msg, attachmentsToDelete)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
-	for _, attach := range message.AttachmentsToDelete {
-		err = c.ChatService.MessageAttachmentStorage.Delete(attach)
-		if err != nil {
-			action.Payload, err = errors.MarshalError(err)
-			if err != nil {
-				return
-			}
-
-			err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-			if err != nil {
-				return
-			}
+	// The held edit is not delivered either, the receivers see the message
+	// again once a moderator shows it.
+	if newMessage.IsHidden {
+		receivers = []uint{c.UserID}
+	}
 
-			return
-		}
+	err = c.ChatService.deleteUnusedAttachments(ctx, attachmentsToDelete)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
 	}
 
 	err = c.ChatService.UnsentMessageAttachmentsStorage.DeleteAll(ctx, &domain.UnsentMessageAttachment{
@@ -341,111 +630,273 @@ func (c *Client) handleUpdateMessageAction(ctx context.Context, action *Action,
 		ReceiverID: action.Receiver,
 	})
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
-
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
-
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
 	newMessage, err = c.ChatService.MessagesRepo.GetMessageByID(ctx, newMessage.ID)
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
-
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
-
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
 	c.ChatService.Sanitizer.SanitizePersonalMessage(newMessage)
 
-	action.Payload, err = easyjson.Marshal(newMessage)
+	err = c.ChatService.describeAttachments(ctx, []*domain.PersonalMessage{newMessage})
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+	err = c.ChatService.AttachmentURLs.SignMessages(ctx, c.UserID, []*domain.PersonalMessage{newMessage})
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	action.Payload, err = easyjson.Marshal(newMessage)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
-	err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, receivers)
 	if err != nil {
 		return
 	}
 }
 
 func (c *Client) handleDeleteMessageAction(ctx context.Context, action *Action, messageID uint) {
-	err := c.ChatService.MessagesRepo.DeleteMessage(ctx, messageID)
+	receivers, err := c.receivers(ctx, action)
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+	oldMessage, err := c.ChatService.MessagesRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	if oldMessage.SenderID != c.UserID || (action.ConversationID != 0 && oldMessage.ConversationID != action.ConversationID) {
+		c.replyWithError(ctx, action, errors.ErrForbidden)
 		return
 	}
 
-	err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	err = c.ChatService.MessagesRepo.DeleteMessage(ctx, messageID)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, receivers)
 	if err != nil {
 		return
 	}
 }
 
 func (c *Client) handleSendStickerMessageAction(ctx context.Context, action *Action, message *SendStickerMessagePayload) {
-	newStickerMessage, err := c.ChatService.MessagesRepo.StoreStickerMessage(ctx, c.UserID, action.Receiver, message.StickerID)
+	receivers, err := c.receivers(ctx, action)
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
-		if err != nil {
-			return
-		}
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
-		}
+	err = c.checkCanSendMessage(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
 
+	var newStickerMessage *domain.PersonalMessage
+	if action.ConversationID != 0 {
+		newStickerMessage, err = c.ChatService.MessagesRepo.StoreConversationStickerMessage(ctx, c.UserID, action.ConversationID, message.StickerID)
+	} else {
+		newStickerMessage, err = c.ChatService.MessagesRepo.StoreStickerMessage(ctx, c.UserID, action.Receiver, message.StickerID)
+	}
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
 	action.Payload, err = easyjson.Marshal(newStickerMessage)
 	if err != nil {
-		action.Payload, err = errors.MarshalError(err)
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, receivers)
+	if err != nil {
+		return
+	}
+}
+
+func (c *Client) handleMarkReadAction(ctx context.Context, action *Action, payload *MarkReadPayload) {
+	if payload.MessageID == 0 || action.Receiver == 0 {
+		c.replyWithError(ctx, action, errors.ErrInvalidData)
+		return
+	}
+
+	lastReadMessageID, err := c.ChatService.MessagesRepo.UpdateLastReadMessageID(ctx, c.UserID, action.Receiver, payload.MessageID)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	action.Payload, err = easyjson.Marshal(&ReadReceipt{
+		UserID:            c.UserID,
+		PeerID:            action.Receiver,
+		LastReadMessageID: lastReadMessageID,
+	})
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, []uint{c.UserID, action.Receiver})
+	if err != nil {
+		return
+	}
+}
+
+func (c *Client) handleTypingAction(ctx context.Context, action *Action) {
+	if action.ConversationID == 0 && action.Receiver == 0 {
+		c.replyWithError(ctx, action, errors.ErrInvalidData)
+		return
+	}
+
+	peerID, err := c.dialogPeerID(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	// the blocked users are not told about the typing just like they can not
+	// be sent the messages
+	if peerID != 0 {
+		err = c.checkNotBlocked(ctx, peerID)
 		if err != nil {
+			c.replyWithError(ctx, action, err)
 			return
 		}
+	}
 
-		err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
-		if err != nil {
-			return
+	receivers, err := c.receivers(ctx, action)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	action.Payload, err = easyjson.Marshal(&TypingPayload{
+		UserID: c.UserID,
+	})
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	peers := make([]uint, 0, len(receivers))
+	for _, receiver := range receivers {
+		if receiver != c.UserID {
+			peers = append(peers, receiver)
+		}
+	}
+
+	err = c.ChatService.PubSubRepository.PublishAction(ctx, action, peers)
+	if err != nil {
+		return
+	}
+}
+
+func (c *Client) handleCreateConversationAction(ctx context.Context, action *Action, payload *CreateConversationPayload) {
+	conversation, err := c.ChatService.CreateConversation(ctx, c.UserID, payload.Name, payload.ParticipantIDs)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	c.writeConversation(ctx, action, conversation, nil)
+}
+
+func (c *Client) handleInviteToConversationAction(ctx context.Context, action *Action, payload *InviteToConversationPayload) {
+	conversation, err := c.ChatService.InviteToConversation(ctx, c.UserID, action.ConversationID, payload.UserIDs)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	c.writeConversation(ctx, action, conversation, nil)
+}
+
+func (c *Client) handleKickFromConversationAction(ctx context.Context, action *Action, payload *KickFromConversationPayload) {
+	conversation, err := c.ChatService.KickFromConversation(ctx, c.UserID, action.ConversationID, payload.UserID)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	c.writeConversation(ctx, action, conversation, []uint{payload.UserID})
+}
+
+func (c *Client) handleLeaveConversationAction(ctx context.Context, action *Action) {
+	conversation, err := c.ChatService.LeaveConversation(ctx, c.UserID, action.ConversationID)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	if conversation == nil {
+		conversation = &domain.Conversation{
+			ID:      action.ConversationID,
+			IsGroup: true,
 		}
+	}
 
+	c.writeConversation(ctx, action, conversation, []uint{c.UserID})
+}
+
+func (c *Client) handleRenameConversationAction(ctx context.Context, action *Action, payload *RenameConversationPayload) {
+	conversation, err := c.ChatService.RenameConversation(ctx, c.UserID, action.ConversationID, payload.Name)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
 		return
 	}
 
-	err = c.ChatService.PubSubRepository.WriteAction(ctx, action)
+	c.writeConversation(ctx, action, conversation, nil)
+}
+
+func (c *Client) handleSetConversationRoleAction(ctx context.Context, action *Action, payload *SetConversationRolePayload) {
+	conversation, err := c.ChatService.SetConversationRole(ctx, c.UserID, action.ConversationID, payload.UserID, payload.Role)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	c.writeConversation(ctx, action, conversation, nil)
+}
+
+// writeConversation delivers the updated conversation to its participants
+// and to users that have just been removed from it.
+func (c *Client) writeConversation(ctx context.Context, action *Action, conversation *domain.Conversation, removedUserIDs []uint) {
+	receivers := make([]uint, 0, len(conversation.Participants)+len(removedUserIDs))
+	for _, participant := range conversation.Participants {
+		receivers = append(receivers, participant.User.ID)
+	}
+	receivers = append(receivers, removedUserIDs...)
+
+	c.ChatService.Sanitizer.SanitizeConversation(conversation)
+
+	action.ConversationID = conversation.ID
+
+	var err error
+	action.Payload, err = easyjson.Marshal(conversation)
+	if err != nil {
+		c.replyWithError(ctx, action, err)
+		return
+	}
+
+	err = c.ChatService.PubSubRepository.WriteAction(ctx, action, receivers)
 	if err != nil {
 		return
 	}
@@ -463,11 +914,9 @@ func (c *Client) ClearUnsentAttachments(ctx context.Context) {
 			return false
 		}
 
-		for _, fileName := range attachs {
-			err = c.ChatService.MessageAttachmentStorage.Delete(fileName)
-			if err != nil {
-				return false
-			}
+		err = c.ChatService.deleteUnusedAttachments(ctx, attachs)
+		if err != nil {
+			return false
 		}
 
 		err = c.ChatService.UnsentMessageAttachmentsStorage.DeleteAll(ctx, &domain.UnsentMessageAttachment{
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	domain "socio/domain"
 )
 
 // suppress unused package warning
@@ -129,7 +130,146 @@ func (v *UpdateMessagePayload) UnmarshalJSON(data []byte) error {
 func (v *UpdateMessagePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC0e5e3f1DecodeSocioUsecaseChat(l, v)
 }
-func easyjsonC0e5e3f1DecodeSocioUsecaseChat1(in *jlexer.Lexer, out *SendStickerMessagePayload) {
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat1(in *jlexer.Lexer, out *TypingPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat1(out *jwriter.Writer, in TypingPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypingPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypingPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypingPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypingPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat1(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat2(in *jlexer.Lexer, out *SetConversationRolePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "role":
+			out.Role = domain.ConversationRole(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat2(out *jwriter.Writer, in SetConversationRolePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetConversationRolePayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetConversationRolePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetConversationRolePayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetConversationRolePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat2(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat3(in *jlexer.Lexer, out *SendStickerMessagePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -160,7 +300,7 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat1(in *jlexer.Lexer, out *SendStickerM
 		in.Consumed()
 	}
 }
-func easyjsonC0e5e3f1EncodeSocioUsecaseChat1(out *jwriter.Writer, in SendStickerMessagePayload) {
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat3(out *jwriter.Writer, in SendStickerMessagePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -175,27 +315,27 @@ func easyjsonC0e5e3f1EncodeSocioUsecaseChat1(out *jwriter.Writer, in SendSticker
 // MarshalJSON supports json.Marshaler interface
 func (v SendStickerMessagePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat1(&w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SendStickerMessagePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat1(w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SendStickerMessagePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat1(&r, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SendStickerMessagePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat1(l, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat3(l, v)
 }
-func easyjsonC0e5e3f1DecodeSocioUsecaseChat2(in *jlexer.Lexer, out *SendMessagePayload) {
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat4(in *jlexer.Lexer, out *SendMessagePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -249,7 +389,7 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat2(in *jlexer.Lexer, out *SendMessageP
 		in.Consumed()
 	}
 }
-func easyjsonC0e5e3f1EncodeSocioUsecaseChat2(out *jwriter.Writer, in SendMessagePayload) {
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat4(out *jwriter.Writer, in SendMessagePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -280,27 +420,27 @@ func easyjsonC0e5e3f1EncodeSocioUsecaseChat2(out *jwriter.Writer, in SendMessage
 // MarshalJSON supports json.Marshaler interface
 func (v SendMessagePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat2(&w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SendMessagePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat2(w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SendMessagePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat2(&r, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SendMessagePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat2(l, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat4(l, v)
 }
-func easyjsonC0e5e3f1DecodeSocioUsecaseChat3(in *jlexer.Lexer, out *DeleteMessagePayload) {
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat5(in *jlexer.Lexer, out *RenameConversationPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -319,8 +459,8 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat3(in *jlexer.Lexer, out *DeleteMessag
 			continue
 		}
 		switch key {
-		case "messageId":
-			out.MessageID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -331,42 +471,42 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat3(in *jlexer.Lexer, out *DeleteMessag
 		in.Consumed()
 	}
 }
-func easyjsonC0e5e3f1EncodeSocioUsecaseChat3(out *jwriter.Writer, in DeleteMessagePayload) {
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat5(out *jwriter.Writer, in RenameConversationPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"messageId\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.MessageID))
+		out.String(string(in.Name))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteMessagePayload) MarshalJSON() ([]byte, error) {
+func (v RenameConversationPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat3(&w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteMessagePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat3(w, v)
+func (v RenameConversationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteMessagePayload) UnmarshalJSON(data []byte) error {
+func (v *RenameConversationPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat3(&r, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteMessagePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat3(l, v)
+func (v *RenameConversationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat5(l, v)
 }
-func easyjsonC0e5e3f1DecodeSocioUsecaseChat4(in *jlexer.Lexer, out *Action) {
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat6(in *jlexer.Lexer, out *ReadReceipt) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -385,16 +525,12 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat4(in *jlexer.Lexer, out *Action) {
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = ChatAction(in.String())
-		case "receiver":
-			out.Receiver = uint(in.Uint())
-		case "csrfToken":
-			out.CSRFToken = string(in.String())
-		case "payload":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Payload).UnmarshalJSON(data))
-			}
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "peerId":
+			out.PeerID = uint(in.Uint())
+		case "lastReadMessageId":
+			out.LastReadMessageID = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -405,53 +541,558 @@ func easyjsonC0e5e3f1DecodeSocioUsecaseChat4(in *jlexer.Lexer, out *Action) {
 		in.Consumed()
 	}
 }
-func easyjsonC0e5e3f1EncodeSocioUsecaseChat4(out *jwriter.Writer, in Action) {
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat6(out *jwriter.Writer, in ReadReceipt) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Uint(uint(in.UserID))
 	}
 	{
-		const prefix string = ",\"receiver\":"
+		const prefix string = ",\"peerId\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Receiver))
+		out.Uint(uint(in.PeerID))
 	}
 	{
-		const prefix string = ",\"csrfToken\":"
+		const prefix string = ",\"lastReadMessageId\":"
 		out.RawString(prefix)
-		out.String(string(in.CSRFToken))
+		out.Uint(uint(in.LastReadMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadReceipt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadReceipt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadReceipt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadReceipt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat6(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat7(in *jlexer.Lexer, out *MarkReadPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messageId":
+			out.MessageID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat7(out *jwriter.Writer, in MarkReadPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"payload\":"
-		out.RawString(prefix)
-		out.Raw((in.Payload).MarshalJSON())
+		const prefix string = ",\"messageId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.MessageID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Action) MarshalJSON() ([]byte, error) {
+func (v MarkReadPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat4(&w, v)
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Action) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC0e5e3f1EncodeSocioUsecaseChat4(w, v)
+func (v MarkReadPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Action) UnmarshalJSON(data []byte) error {
+func (v *MarkReadPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat4(&r, v)
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Action) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC0e5e3f1DecodeSocioUsecaseChat4(l, v)
+func (v *MarkReadPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat7(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat8(in *jlexer.Lexer, out *KickFromConversationPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			out.UserID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat8(out *jwriter.Writer, in KickFromConversationPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KickFromConversationPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KickFromConversationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KickFromConversationPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KickFromConversationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat8(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat9(in *jlexer.Lexer, out *InviteToConversationPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userIds":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]uint, 0, 8)
+					} else {
+						out.UserIDs = []uint{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uint
+					v7 = uint(in.Uint())
+					out.UserIDs = append(out.UserIDs, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat9(out *jwriter.Writer, in InviteToConversationPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userIds\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.UserIDs {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InviteToConversationPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InviteToConversationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InviteToConversationPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InviteToConversationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat9(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat10(in *jlexer.Lexer, out *DeleteMessagePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messageId":
+			out.MessageID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat10(out *jwriter.Writer, in DeleteMessagePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messageId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.MessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteMessagePayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteMessagePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteMessagePayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteMessagePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat10(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat11(in *jlexer.Lexer, out *CreateConversationPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "participantIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ParticipantIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ParticipantIDs == nil {
+					if !in.IsDelim(']') {
+						out.ParticipantIDs = make([]uint, 0, 8)
+					} else {
+						out.ParticipantIDs = []uint{}
+					}
+				} else {
+					out.ParticipantIDs = (out.ParticipantIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uint
+					v10 = uint(in.Uint())
+					out.ParticipantIDs = append(out.ParticipantIDs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat11(out *jwriter.Writer, in CreateConversationPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"participantIds\":"
+		out.RawString(prefix)
+		if in.ParticipantIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.ParticipantIDs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateConversationPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateConversationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateConversationPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateConversationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat11(l, v)
+}
+func easyjsonC0e5e3f1DecodeSocioUsecaseChat12(in *jlexer.Lexer, out *Action) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "eventId":
+			out.EventID = string(in.String())
+		case "type":
+			out.Type = ChatAction(in.String())
+		case "receiver":
+			out.Receiver = uint(in.Uint())
+		case "conversationId":
+			out.ConversationID = uint(in.Uint())
+		case "csrfToken":
+			out.CSRFToken = string(in.String())
+		case "payload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Payload).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC0e5e3f1EncodeSocioUsecaseChat12(out *jwriter.Writer, in Action) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.EventID != "" {
+		const prefix string = ",\"eventId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.EventID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"receiver\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Receiver))
+	}
+	if in.ConversationID != 0 {
+		const prefix string = ",\"conversationId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ConversationID))
+	}
+	{
+		const prefix string = ",\"csrfToken\":"
+		out.RawString(prefix)
+		out.String(string(in.CSRFToken))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.Raw((in.Payload).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Action) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Action) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC0e5e3f1EncodeSocioUsecaseChat12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Action) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Action) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC0e5e3f1DecodeSocioUsecaseChat12(l, v)
 }
@@ -0,0 +1,553 @@
+package chat_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_chat "socio/mocks/usecase/chat"
+	"socio/pkg/contentfilter"
+	customtime "socio/pkg/time"
+	"socio/usecase/chat"
+	"socio/usecase/ratelimit"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSendMessageRestrictions(t *testing.T) {
+	tests := []struct {
+		name              string
+		action            *chat.Action
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:   "message forbidden by receiver settings",
+			action: &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"hi"}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "sticker message forbidden by receiver settings",
+			action: &chat.Action{Type: chat.SendStickerMessageAction, Receiver: 2, Payload: []byte(`{"stickerId":1}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "sticker message allowed",
+			action: &chat.Action{Type: chat.SendStickerMessageAction, Receiver: 2, Payload: []byte(`{"stickerId":1}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				messagesRepo.EXPECT().StoreStickerMessage(gomock.Any(), uint(1), uint(2), uint(1)).Return(&domain.PersonalMessage{ID: 1, SenderID: 1, ReceiverID: 2}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+		{
+			name:   "message to the blocker",
+			action: &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"hi"}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "sticker message to the blocker",
+			action: &chat.Action{Type: chat.SendStickerMessageAction, Receiver: 2, Payload: []byte(`{"stickerId":1}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "message to the blocker by the dialog conversation",
+			action: &chat.Action{Type: chat.SendMessageAction, ConversationID: 5, Payload: []byte(`{"content":"hi"}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(5)).Return([]uint{1, 2}, nil)
+				messagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(5)).Return(&domain.Conversation{
+					ID: 5,
+					Participants: []*domain.ConversationParticipant{
+						{User: &domain.User{ID: 1}},
+						{User: &domain.User{ID: 2}},
+					},
+				}, nil)
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "sticker message forbidden by receiver settings by the dialog conversation",
+			action: &chat.Action{Type: chat.SendStickerMessageAction, ConversationID: 5, Payload: []byte(`{"stickerId":1}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(5)).Return([]uint{1, 2}, nil)
+				messagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(5)).Return(&domain.Conversation{
+					ID: 5,
+					Participants: []*domain.ConversationParticipant{
+						{User: &domain.User{ID: 1}},
+						{User: &domain.User{ID: 2}},
+					},
+				}, nil)
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "sticker message to the group conversation",
+			action: &chat.Action{Type: chat.SendStickerMessageAction, ConversationID: 5, Payload: []byte(`{"stickerId":1}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(5)).Return([]uint{1, 2}, nil)
+				messagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(5)).Return(&domain.Conversation{ID: 5, IsGroup: true}, nil)
+				messagesRepo.EXPECT().StoreConversationStickerMessage(gomock.Any(), uint(1), uint(5), uint(1)).Return(&domain.PersonalMessage{ID: 1, SenderID: 1, ConversationID: 5}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+		{
+			name:   "privacy check error",
+			action: &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"hi"}`)},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(false, errors.ErrInternal)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			tt.prepare(messagesRepo)
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, nil, messagesRepo, nil, nil, nil)
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), tt.action)
+
+			if len(stream.streams[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.streams[2]))
+			}
+
+			if len(stream.published[1]) != 1 {
+				t.Fatalf("expected 1 action for the sender, got %d", len(stream.published[1]))
+			}
+
+			gotSenderError := strings.Contains(string(stream.published[1][0].Payload), `"error"`)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got payload %s", tt.wantSenderError, stream.published[1][0].Payload)
+			}
+		})
+	}
+}
+
+func TestTypingBlocks(t *testing.T) {
+	tests := []struct {
+		name              string
+		action            *chat.Action
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:   "typing to the blocker",
+			action: &chat.Action{Type: chat.TypingStartAction, Receiver: 2},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "typing to the blocker by the dialog conversation",
+			action: &chat.Action{Type: chat.TypingStopAction, ConversationID: 5},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(5)).Return(&domain.Conversation{
+					ID: 5,
+					Participants: []*domain.ConversationParticipant{
+						{User: &domain.User{ID: 1}},
+						{User: &domain.User{ID: 2}},
+					},
+				}, nil)
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:   "typing allowed",
+			action: &chat.Action{Type: chat.TypingStartAction, Receiver: 2},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+		{
+			name:   "typing to the group conversation",
+			action: &chat.Action{Type: chat.TypingStartAction, ConversationID: 5},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(5)).Return(&domain.Conversation{ID: 5, IsGroup: true}, nil)
+				messagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(5)).Return([]uint{1, 2}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			tt.prepare(messagesRepo)
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, nil, messagesRepo, nil, nil, nil)
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), tt.action)
+
+			if len(stream.published[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.published[2]))
+			}
+
+			gotSenderError := len(stream.published[1]) == 1 && strings.Contains(string(stream.published[1][0].Payload), `"error"`)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got %v", tt.wantSenderError, stream.published[1])
+			}
+		})
+	}
+}
+
+func TestSendMessageContentFilter(t *testing.T) {
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name              string
+		content           string
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:    "banned content",
+			content: "best cаsinо in town",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:    "held for moderation",
+			content: "https://a.com https://b.com",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, msg *domain.PersonalMessage) (*domain.PersonalMessage, error) {
+						if !msg.IsHidden {
+							t.Error("the held message is not stored hidden")
+						}
+
+						return &domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "https://a.com https://b.com", IsHidden: true}, nil
+					})
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 0,
+		},
+		{
+			name:    "accepted",
+			content: "hi",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hi"}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			tt.prepare(messagesRepo)
+
+			unsentAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+			unsentAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+			unsentAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, unsentAttachmentsStorage, messagesRepo, nil, nil, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"` + tt.content + `"}`)})
+
+			if len(stream.streams[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.streams[2]))
+			}
+
+			if len(stream.published[1]) != 1 {
+				t.Fatalf("expected 1 action for the sender, got %d", len(stream.published[1]))
+			}
+
+			gotSenderError := strings.Contains(string(stream.published[1][0].Payload), `"error"`)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got payload %s", tt.wantSenderError, stream.published[1][0].Payload)
+			}
+
+			if gotSenderError && len(stream.streams[1]) != 0 {
+				t.Errorf("expected the error not to be logged for the replay, got %d logged actions", len(stream.streams[1]))
+			}
+		})
+	}
+}
+
+func TestUpdateMessageContentFilter(t *testing.T) {
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name              string
+		content           string
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:    "banned content",
+			content: "best cаsinо in town",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:    "held for moderation",
+			content: "https://a.com https://b.com",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, msg *domain.PersonalMessage, attachmentsToDelete []string) (*domain.PersonalMessage, error) {
+						if !msg.IsHidden {
+							t.Error("the held edit is not stored hidden")
+						}
+
+						return &domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "https://a.com https://b.com", IsHidden: true}, nil
+					})
+				messagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(3)).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "https://a.com https://b.com", IsHidden: true}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 0,
+		},
+		{
+			name:    "accepted",
+			content: "hi",
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hi"}, nil)
+				messagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(3)).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hi"}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			messagesRepo.EXPECT().GetMessageByID(gomock.Any(), uint(3)).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hello"}, nil)
+			tt.prepare(messagesRepo)
+
+			unsentAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+			unsentAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+			unsentAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, unsentAttachmentsStorage, messagesRepo, nil, nil, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), &chat.Action{Type: chat.UpdateMessageAction, Receiver: 2, Payload: []byte(`{"messageId":3,"content":"` + tt.content + `"}`)})
+
+			if len(stream.streams[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.streams[2]))
+			}
+
+			if len(stream.published[1]) != 1 {
+				t.Fatalf("expected 1 action for the sender, got %d", len(stream.published[1]))
+			}
+
+			gotSenderError := strings.Contains(string(stream.published[1][0].Payload), `"error"`)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got payload %s", tt.wantSenderError, stream.published[1][0].Payload)
+			}
+		})
+	}
+}
+
+func TestSendMessageRateLimit(t *testing.T) {
+	tests := []struct {
+		name              string
+		decision          ratelimit.Decision
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:     "limited",
+			decision: ratelimit.Decision{Allowed: false, RetryAfter: time.Second},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:     "allowed",
+			decision: ratelimit.Decision{Allowed: true},
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hi"}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			tt.prepare(messagesRepo)
+
+			unsentAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+			unsentAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			unsentAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			rateLimiter := mock_chat.NewMockRateLimiter(ctrl)
+			rateLimiter.EXPECT().Allow(gomock.Any(), "chat/SEND_MESSAGE", "user:1", chat.ActionRateLimits[chat.SendMessageAction]).Return(tt.decision)
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, unsentAttachmentsStorage, messagesRepo, nil, nil, nil)
+			s.RateLimiter = rateLimiter
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"hi"}`)})
+
+			if len(stream.streams[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.streams[2]))
+			}
+
+			if len(stream.published[1]) != 1 {
+				t.Fatalf("expected 1 action for the sender, got %d", len(stream.published[1]))
+			}
+
+			gotSenderError := strings.Contains(string(stream.published[1][0].Payload), errors.TooManyRequestsMsg)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got payload %s", tt.wantSenderError, stream.published[1][0].Payload)
+			}
+		})
+	}
+}
+
+func TestSendMessageEmailNotVerified(t *testing.T) {
+	tests := []struct {
+		name              string
+		isVerified        bool
+		prepare           func(messagesRepo *mock_chat.MockPersonalMessagesRepository)
+		wantSenderError   bool
+		wantReceiverCount int
+	}{
+		{
+			name:       "not verified",
+			isVerified: false,
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantSenderError:   true,
+			wantReceiverCount: 0,
+		},
+		{
+			name:       "verified",
+			isVerified: true,
+			prepare: func(messagesRepo *mock_chat.MockPersonalMessagesRepository) {
+				messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				messagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				messagesRepo.EXPECT().StoreMessage(gomock.Any(), gomock.Any()).Return(&domain.PersonalMessage{ID: 3, SenderID: 1, ReceiverID: 2, Content: "hi"}, nil)
+			},
+			wantSenderError:   false,
+			wantReceiverCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+			tt.prepare(messagesRepo)
+
+			unsentAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+			unsentAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			unsentAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			emailVerifier := mock_chat.NewMockEmailVerifier(ctrl)
+			emailVerifier.EXPECT().CheckIfEmailIsVerified(gomock.Any(), uint(1)).Return(tt.isVerified, nil)
+
+			stream := newFakeEventStream()
+			s := chat.NewChatService(stream, unsentAttachmentsStorage, messagesRepo, nil, nil, nil)
+			s.EmailVerifier = emailVerifier
+			c := &chat.Client{UserID: 1, ChatService: s}
+
+			c.HandleAction(context.Background(), &chat.Action{Type: chat.SendMessageAction, Receiver: 2, Payload: []byte(`{"content":"hi"}`)})
+
+			if len(stream.streams[2]) != tt.wantReceiverCount {
+				t.Errorf("expected %d actions for the receiver, got %d", tt.wantReceiverCount, len(stream.streams[2]))
+			}
+
+			if len(stream.published[1]) != 1 {
+				t.Fatalf("expected 1 action for the sender, got %d", len(stream.published[1]))
+			}
+
+			gotSenderError := strings.Contains(string(stream.published[1][0].Payload), errors.EmailNotVerifiedMsg)
+			if gotSenderError != tt.wantSenderError {
+				t.Errorf("expected sender error %v, got payload %s", tt.wantSenderError, stream.published[1][0].Payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package chat
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxConversationNameLength   = 100
+	maxConversationParticipants = 200
+)
+
+func (s *Service) CreateConversation(ctx context.Context, ownerID uint, name string, participantIDs []uint) (conversation *domain.Conversation, err error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > maxConversationNameLength {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	participants := []*domain.ConversationParticipant{
+		{
+			User: &domain.User{ID: ownerID},
+			Role: domain.ConversationRoleOwner,
+		},
+	}
+
+	memberIDs := make([]uint, 0, len(participantIDs))
+	seen := map[uint]bool{ownerID: true}
+	for _, participantID := range participantIDs {
+		if participantID == 0 || seen[participantID] {
+			continue
+		}
+		seen[participantID] = true
+		memberIDs = append(memberIDs, participantID)
+
+		participants = append(participants, &domain.ConversationParticipant{
+			User: &domain.User{ID: participantID},
+			Role: domain.ConversationRoleMember,
+		})
+	}
+
+	if len(participants) < 2 || len(participants) > maxConversationParticipants {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = s.checkCanAddParticipants(ctx, ownerID, memberIDs)
+	if err != nil {
+		return
+	}
+
+	newConversation, err := s.MessagesRepo.StoreConversation(ctx, &domain.Conversation{
+		Name:         name,
+		IsGroup:      true,
+		Participants: participants,
+	})
+	if err != nil {
+		return
+	}
+
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, newConversation.ID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetConversation(ctx context.Context, conversationID, userID uint) (conversation *domain.Conversation, err error) {
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	if getParticipant(conversation, userID) == nil {
+		err = errors.ErrForbidden
+		return
+	}
+
+	s.Sanitizer.SanitizeConversation(conversation)
+	return
+}
+
+func (s *Service) GetConversationsByUserID(ctx context.Context, userID uint) (conversations []*domain.Conversation, err error) {
+	conversations, err = s.MessagesRepo.GetConversationsByUserID(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	for _, conversation := range conversations {
+		s.Sanitizer.SanitizeConversation(conversation)
+	}
+
+	return
+}
+
+func (s *Service) GetMessagesByConversation(ctx context.Context, userID, conversationID, lastMessageID, messagesAmount uint) (messages []*domain.PersonalMessage, err error) {
+	_, err = s.MessagesRepo.GetConversationParticipantRole(ctx, conversationID, userID)
+	if err == errors.ErrNotFound {
+		err = errors.ErrForbidden
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	if lastMessageID == 0 {
+		lastMessageID, err = s.MessagesRepo.GetLastConversationMessageID(ctx, conversationID)
+		if err != nil {
+			return
+		}
+		lastMessageID++
+	}
+
+	if messagesAmount == 0 {
+		messagesAmount = defaultMessagesAmount
+	}
+
+	messages, err = s.MessagesRepo.GetMessagesByConversation(ctx, conversationID, lastMessageID, messagesAmount)
+	if err != nil {
+		return
+	}
+
+	for _, message := range messages {
+		s.Sanitizer.SanitizePersonalMessage(message)
+	}
+
+	err = s.describeAttachments(ctx, messages)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetConversationParticipantIDs returns the participants of the conversation,
+// userID has to be one of them.
+func (s *Service) GetConversationParticipantIDs(ctx context.Context, conversationID, userID uint) (userIDs []uint, err error) {
+	userIDs, err = s.MessagesRepo.GetConversationParticipantIDs(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	for _, id := range userIDs {
+		if id == userID {
+			return
+		}
+	}
+
+	userIDs = nil
+	err = errors.ErrForbidden
+	return
+}
+
+func (s *Service) InviteToConversation(ctx context.Context, userID, conversationID uint, userIDs []uint) (conversation *domain.Conversation, err error) {
+	conversation, err = s.getGroupConversation(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	if !canManage(getParticipant(conversation, userID)) {
+		err = errors.ErrForbidden
+		return
+	}
+
+	newUserIDs := make([]uint, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == 0 || getParticipant(conversation, id) != nil {
+			continue
+		}
+
+		newUserIDs = append(newUserIDs, id)
+	}
+
+	if len(newUserIDs) == 0 || len(conversation.Participants)+len(newUserIDs) > maxConversationParticipants {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = s.checkCanAddParticipants(ctx, userID, newUserIDs)
+	if err != nil {
+		return
+	}
+
+	err = s.MessagesRepo.StoreConversationParticipants(ctx, conversationID, newUserIDs)
+	if err != nil {
+		return
+	}
+
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) KickFromConversation(ctx context.Context, userID, conversationID, kickedUserID uint) (conversation *domain.Conversation, err error) {
+	if userID == kickedUserID {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	conversation, err = s.getGroupConversation(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	participant := getParticipant(conversation, userID)
+	if !canManage(participant) {
+		err = errors.ErrForbidden
+		return
+	}
+
+	kicked := getParticipant(conversation, kickedUserID)
+	if kicked == nil {
+		err = errors.ErrNotFound
+		return
+	}
+
+	// admins can only kick members, the owner can kick anyone
+	if kicked.Role == domain.ConversationRoleOwner ||
+		kicked.Role == domain.ConversationRoleAdmin && participant.Role != domain.ConversationRoleOwner {
+		err = errors.ErrForbidden
+		return
+	}
+
+	err = s.MessagesRepo.DeleteConversationParticipant(ctx, conversationID, kickedUserID)
+	if err != nil {
+		return
+	}
+
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// LeaveConversation removes the user from the conversation. The ownership
+// passes to the oldest admin or, if there is none, to the oldest member.
+// The conversation is deleted when its last participant leaves, in that
+// case nil conversation is returned.
+func (s *Service) LeaveConversation(ctx context.Context, userID, conversationID uint) (conversation *domain.Conversation, err error) {
+	conversation, err = s.getGroupConversation(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	participant := getParticipant(conversation, userID)
+	if participant == nil {
+		err = errors.ErrForbidden
+		return
+	}
+
+	if len(conversation.Participants) == 1 {
+		err = s.MessagesRepo.DeleteConversation(ctx, conversationID)
+		if err != nil {
+			return
+		}
+
+		conversation = nil
+		return
+	}
+
+	if participant.Role == domain.ConversationRoleOwner {
+		var heir *domain.ConversationParticipant
+		for _, p := range conversation.Participants {
+			if p.User.ID == userID {
+				continue
+			}
+
+			if heir == nil || p.Role == domain.ConversationRoleAdmin && heir.Role != domain.ConversationRoleAdmin {
+				heir = p
+			}
+		}
+
+		err = s.MessagesRepo.UpdateConversationParticipantRole(ctx, conversationID, heir.User.ID, domain.ConversationRoleOwner)
+		if err != nil {
+			return
+		}
+	}
+
+	err = s.MessagesRepo.DeleteConversationParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return
+	}
+
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) RenameConversation(ctx context.Context, userID, conversationID uint, name string) (conversation *domain.Conversation, err error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > maxConversationNameLength {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	conversation, err = s.getGroupConversation(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	if !canManage(getParticipant(conversation, userID)) {
+		err = errors.ErrForbidden
+		return
+	}
+
+	err = s.MessagesRepo.UpdateConversationName(ctx, conversationID, name)
+	if err != nil {
+		return
+	}
+
+	conversation.Name = name
+	return
+}
+
+// SetConversationRole changes the role of a participant, only the owner can
+// do it. Making someone else the owner transfers the ownership, the previous
+// owner becomes an admin.
+func (s *Service) SetConversationRole(ctx context.Context, userID, conversationID, targetUserID uint, role domain.ConversationRole) (conversation *domain.Conversation, err error) {
+	if userID == targetUserID {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	switch role {
+	case domain.ConversationRoleOwner, domain.ConversationRoleAdmin, domain.ConversationRoleMember:
+	default:
+		err = errors.ErrInvalidData
+		return
+	}
+
+	conversation, err = s.getGroupConversation(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	participant := getParticipant(conversation, userID)
+	if participant == nil || participant.Role != domain.ConversationRoleOwner {
+		err = errors.ErrForbidden
+		return
+	}
+
+	if getParticipant(conversation, targetUserID) == nil {
+		err = errors.ErrNotFound
+		return
+	}
+
+	err = s.MessagesRepo.UpdateConversationParticipantRole(ctx, conversationID, targetUserID, role)
+	if err != nil {
+		return
+	}
+
+	if role == domain.ConversationRoleOwner {
+		err = s.MessagesRepo.UpdateConversationParticipantRole(ctx, conversationID, userID, domain.ConversationRoleAdmin)
+		if err != nil {
+			return
+		}
+	}
+
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) getGroupConversation(ctx context.Context, conversationID uint) (conversation *domain.Conversation, err error) {
+	conversation, err = s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	if !conversation.IsGroup {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
+
+// checkCanAddParticipants forbids the user to put into a conversation the users
+// who blocked the user or were blocked by the user, and the users whose
+// messages privacy settings do not let the user message them.
+func (s *Service) checkCanAddParticipants(ctx context.Context, userID uint, participantIDs []uint) (err error) {
+	for _, participantID := range participantIDs {
+		var blocked bool
+		blocked, err = s.MessagesRepo.IsBlocked(ctx, userID, participantID)
+		if err != nil {
+			return
+		}
+
+		if blocked {
+			err = errors.ErrForbidden
+			return
+		}
+
+		var allowed bool
+		allowed, err = s.MessagesRepo.CanSendMessage(ctx, userID, participantID)
+		if err != nil {
+			return
+		}
+
+		if !allowed {
+			err = errors.ErrForbidden
+			return
+		}
+	}
+
+	return
+}
+
+// getDialogPeerID returns the other participant of the dialog conversation, it
+// returns 0 for the group conversations.
+func (s *Service) getDialogPeerID(ctx context.Context, conversationID, userID uint) (peerID uint, err error) {
+	conversation, err := s.MessagesRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return
+	}
+
+	if conversation.IsGroup {
+		return
+	}
+
+	for _, participant := range conversation.Participants {
+		if participant.User.ID != userID {
+			peerID = participant.User.ID
+			return
+		}
+	}
+
+	return
+}
+
+func getParticipant(conversation *domain.Conversation, userID uint) *domain.ConversationParticipant {
+	for _, participant := range conversation.Participants {
+		if participant.User.ID == userID {
+			return participant
+		}
+	}
+
+	return nil
+}
+
+func canManage(participant *domain.ConversationParticipant) bool {
+	return participant != nil &&
+		(participant.Role == domain.ConversationRoleOwner || participant.Role == domain.ConversationRoleAdmin)
+}
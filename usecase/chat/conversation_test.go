@@ -0,0 +1,784 @@
+package chat_test
+
+import (
+	"context"
+	errorsDef "errors"
+	"reflect"
+	"socio/domain"
+	"socio/errors"
+	mock_chat "socio/mocks/usecase/chat"
+	"socio/usecase/chat"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func newTestConversation(participants ...*domain.ConversationParticipant) *domain.Conversation {
+	return &domain.Conversation{
+		ID:           1,
+		Name:         "Friends",
+		IsGroup:      true,
+		Participants: participants,
+	}
+}
+
+func newTestParticipant(userID uint, role domain.ConversationRole) *domain.ConversationParticipant {
+	return &domain.ConversationParticipant{
+		User: &domain.User{ID: userID},
+		Role: role,
+	}
+}
+
+func TestCreateConversation(t *testing.T) {
+	tests := []struct {
+		name                 string
+		ownerID              uint
+		conversationName     string
+		participantIDs       []uint
+		expectedErr          error
+		expectedConversation *domain.Conversation
+		prepare              func(f *fields)
+	}{
+		{
+			name:             "TestCreateConversation",
+			ownerID:          1,
+			conversationName: "  Friends  ",
+			participantIDs:   []uint{2, 2, 1, 3},
+			expectedErr:      nil,
+			expectedConversation: newTestConversation(
+				newTestParticipant(1, domain.ConversationRoleOwner),
+				newTestParticipant(2, domain.ConversationRoleMember),
+				newTestParticipant(3, domain.ConversationRoleMember),
+			),
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(3)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(3)).Return(true, nil)
+				f.PersonalMessagesRepo.EXPECT().StoreConversation(gomock.Any(), &domain.Conversation{
+					Name:    "Friends",
+					IsGroup: true,
+					Participants: []*domain.ConversationParticipant{
+						newTestParticipant(1, domain.ConversationRoleOwner),
+						newTestParticipant(2, domain.ConversationRoleMember),
+						newTestParticipant(3, domain.ConversationRoleMember),
+					},
+				}).Return(&domain.Conversation{ID: 1}, nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(newTestConversation(
+					newTestParticipant(1, domain.ConversationRoleOwner),
+					newTestParticipant(2, domain.ConversationRoleMember),
+					newTestParticipant(3, domain.ConversationRoleMember),
+				), nil)
+			},
+		},
+		{
+			name:                 "TestCreateConversation empty name",
+			ownerID:              1,
+			conversationName:     "   ",
+			participantIDs:       []uint{2},
+			expectedErr:          errors.ErrInvalidData,
+			expectedConversation: nil,
+			prepare:              func(f *fields) {},
+		},
+		{
+			name:                 "TestCreateConversation no participants",
+			ownerID:              1,
+			conversationName:     "Friends",
+			participantIDs:       []uint{1, 0},
+			expectedErr:          errors.ErrInvalidData,
+			expectedConversation: nil,
+			prepare:              func(f *fields) {},
+		},
+		{
+			name:                 "TestCreateConversation blocked participant",
+			ownerID:              1,
+			conversationName:     "Friends",
+			participantIDs:       []uint{2, 3},
+			expectedErr:          errors.ErrForbidden,
+			expectedConversation: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(3)).Return(true, nil)
+			},
+		},
+		{
+			name:                 "TestCreateConversation forbidden by settings",
+			ownerID:              1,
+			conversationName:     "Friends",
+			participantIDs:       []uint{2},
+			expectedErr:          errors.ErrForbidden,
+			expectedConversation: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+			},
+		},
+		{
+			name:                 "TestCreateConversation store error",
+			ownerID:              1,
+			conversationName:     "Friends",
+			participantIDs:       []uint{2},
+			expectedErr:          errors.ErrInternal,
+			expectedConversation: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				f.PersonalMessagesRepo.EXPECT().StoreConversation(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			conversation, err := s.CreateConversation(context.Background(), tt.ownerID, tt.conversationName, tt.participantIDs)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(conversation, tt.expectedConversation) {
+				t.Errorf("expected conversation %v, got %v", tt.expectedConversation, conversation)
+			}
+		})
+	}
+}
+
+func TestGetConversation(t *testing.T) {
+	tests := []struct {
+		name                 string
+		conversationID       uint
+		userID               uint
+		expectedErr          error
+		expectedConversation *domain.Conversation
+		prepare              func(f *fields)
+	}{
+		{
+			name:                 "TestGetConversation",
+			conversationID:       1,
+			userID:               2,
+			expectedErr:          nil,
+			expectedConversation: newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)),
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:                 "TestGetConversation not a participant",
+			conversationID:       1,
+			userID:               3,
+			expectedErr:          errors.ErrForbidden,
+			expectedConversation: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:                 "TestGetConversation not found",
+			conversationID:       1,
+			userID:               1,
+			expectedErr:          errors.ErrNotFound,
+			expectedConversation: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			conversation, err := s.GetConversation(context.Background(), tt.conversationID, tt.userID)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if tt.expectedErr == nil && !reflect.DeepEqual(conversation, tt.expectedConversation) {
+				t.Errorf("expected conversation %v, got %v", tt.expectedConversation, conversation)
+			}
+		})
+	}
+}
+
+func TestGetMessagesByConversation(t *testing.T) {
+	tests := []struct {
+		name             string
+		userID           uint
+		conversationID   uint
+		lastMessageID    uint
+		messagesAmount   uint
+		expectedErr      error
+		expectedMessages []*domain.PersonalMessage
+		prepare          func(f *fields)
+	}{
+		{
+			name:           "TestGetMessagesByConversation",
+			userID:         1,
+			conversationID: 1,
+			expectedErr:    nil,
+			expectedMessages: []*domain.PersonalMessage{
+				{
+					ID:             1,
+					SenderID:       2,
+					ConversationID: 1,
+					Content:        "Hello",
+				},
+			},
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(1), uint(1)).Return(domain.ConversationRoleMember, nil)
+				f.PersonalMessagesRepo.EXPECT().GetLastConversationMessageID(gomock.Any(), uint(1)).Return(uint(1), nil)
+				f.PersonalMessagesRepo.EXPECT().GetMessagesByConversation(gomock.Any(), uint(1), uint(2), uint(20)).Return([]*domain.PersonalMessage{
+					{
+						ID:             1,
+						SenderID:       2,
+						ConversationID: 1,
+						Content:        "Hello",
+					},
+				}, nil)
+			},
+		},
+		{
+			name:             "TestGetMessagesByConversation not a participant",
+			userID:           1,
+			conversationID:   1,
+			expectedErr:      errors.ErrForbidden,
+			expectedMessages: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(1), uint(1)).Return(domain.ConversationRole(""), errors.ErrNotFound)
+			},
+		},
+		{
+			name:             "TestGetMessagesByConversation error",
+			userID:           1,
+			conversationID:   1,
+			lastMessageID:    10,
+			messagesAmount:   5,
+			expectedErr:      errors.ErrInternal,
+			expectedMessages: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantRole(gomock.Any(), uint(1), uint(1)).Return(domain.ConversationRoleMember, nil)
+				f.PersonalMessagesRepo.EXPECT().GetMessagesByConversation(gomock.Any(), uint(1), uint(10), uint(5)).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			messages, err := s.GetMessagesByConversation(context.Background(), tt.userID, tt.conversationID, tt.lastMessageID, tt.messagesAmount)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(messages, tt.expectedMessages) {
+				t.Errorf("expected messages %v, got %v", tt.expectedMessages, messages)
+			}
+		})
+	}
+}
+
+func TestGetConversationParticipantIDs(t *testing.T) {
+	tests := []struct {
+		name           string
+		conversationID uint
+		userID         uint
+		expectedErr    error
+		expectedIDs    []uint
+		prepare        func(f *fields)
+	}{
+		{
+			name:           "TestGetConversationParticipantIDs",
+			conversationID: 1,
+			userID:         2,
+			expectedErr:    nil,
+			expectedIDs:    []uint{1, 2, 3},
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(1)).Return([]uint{1, 2, 3}, nil)
+			},
+		},
+		{
+			name:           "TestGetConversationParticipantIDs not a participant",
+			conversationID: 1,
+			userID:         4,
+			expectedErr:    errors.ErrForbidden,
+			expectedIDs:    nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationParticipantIDs(gomock.Any(), uint(1)).Return([]uint{1, 2, 3}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			ids, err := s.GetConversationParticipantIDs(context.Background(), tt.conversationID, tt.userID)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("expected ids %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestInviteToConversation(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      uint
+		userIDs     []uint
+		expectedErr error
+		prepare     func(f *fields)
+	}{
+		{
+			name:        "TestInviteToConversation",
+			userID:      1,
+			userIDs:     []uint{2, 3},
+			expectedErr: nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleAdmin), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(3)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(3)).Return(true, nil)
+				f.PersonalMessagesRepo.EXPECT().StoreConversationParticipants(gomock.Any(), uint(1), []uint{3}).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleAdmin), newTestParticipant(2, domain.ConversationRoleMember), newTestParticipant(3, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:        "TestInviteToConversation blocked user",
+			userID:      1,
+			userIDs:     []uint{3},
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(3)).Return(true, nil)
+			},
+		},
+		{
+			name:        "TestInviteToConversation forbidden by settings",
+			userID:      1,
+			userIDs:     []uint{3},
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(3)).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().CanSendMessage(gomock.Any(), uint(1), uint(3)).Return(false, nil)
+			},
+		},
+		{
+			name:        "TestInviteToConversation by member",
+			userID:      2,
+			userIDs:     []uint{3},
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:        "TestInviteToConversation already participants",
+			userID:      1,
+			userIDs:     []uint{2},
+			expectedErr: errors.ErrInvalidData,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:        "TestInviteToConversation dialog",
+			userID:      1,
+			userIDs:     []uint{3},
+			expectedErr: errors.ErrInvalidData,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(&domain.Conversation{
+					ID:           1,
+					Participants: []*domain.ConversationParticipant{newTestParticipant(1, domain.ConversationRoleMember), newTestParticipant(2, domain.ConversationRoleMember)},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			_, err := s.InviteToConversation(context.Background(), tt.userID, 1, tt.userIDs)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestKickFromConversation(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		kickedUserID uint
+		expectedErr  error
+		prepare      func(f *fields)
+	}{
+		{
+			name:         "TestKickFromConversation",
+			userID:       1,
+			kickedUserID: 2,
+			expectedErr:  nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleAdmin)), nil)
+				f.PersonalMessagesRepo.EXPECT().DeleteConversationParticipant(gomock.Any(), uint(1), uint(2)).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner)), nil)
+			},
+		},
+		{
+			name:         "TestKickFromConversation admin kicks admin",
+			userID:       2,
+			kickedUserID: 3,
+			expectedErr:  errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleAdmin), newTestParticipant(3, domain.ConversationRoleAdmin)), nil)
+			},
+		},
+		{
+			name:         "TestKickFromConversation admin kicks owner",
+			userID:       2,
+			kickedUserID: 1,
+			expectedErr:  errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleAdmin)), nil)
+			},
+		},
+		{
+			name:         "TestKickFromConversation not a participant",
+			userID:       1,
+			kickedUserID: 3,
+			expectedErr:  errors.ErrNotFound,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:         "TestKickFromConversation self",
+			userID:       1,
+			kickedUserID: 1,
+			expectedErr:  errors.ErrInvalidData,
+			prepare:      func(f *fields) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			_, err := s.KickFromConversation(context.Background(), tt.userID, 1, tt.kickedUserID)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestLeaveConversation(t *testing.T) {
+	tests := []struct {
+		name            string
+		userID          uint
+		expectedErr     error
+		expectedDeleted bool
+		prepare         func(f *fields)
+	}{
+		{
+			name:            "TestLeaveConversation owner passes ownership to admin",
+			userID:          1,
+			expectedErr:     nil,
+			expectedDeleted: false,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember), newTestParticipant(3, domain.ConversationRoleAdmin)), nil)
+				f.PersonalMessagesRepo.EXPECT().UpdateConversationParticipantRole(gomock.Any(), uint(1), uint(3), domain.ConversationRoleOwner).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().DeleteConversationParticipant(gomock.Any(), uint(1), uint(1)).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(2, domain.ConversationRoleMember), newTestParticipant(3, domain.ConversationRoleOwner)), nil)
+			},
+		},
+		{
+			name:            "TestLeaveConversation member",
+			userID:          2,
+			expectedErr:     nil,
+			expectedDeleted: false,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().DeleteConversationParticipant(gomock.Any(), uint(1), uint(2)).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner)), nil)
+			},
+		},
+		{
+			name:            "TestLeaveConversation last participant",
+			userID:          1,
+			expectedErr:     nil,
+			expectedDeleted: true,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner)), nil)
+				f.PersonalMessagesRepo.EXPECT().DeleteConversation(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+		{
+			name:        "TestLeaveConversation not a participant",
+			userID:      3,
+			expectedErr: errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner)), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			conversation, err := s.LeaveConversation(context.Background(), tt.userID, 1)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if tt.expectedErr == nil && (conversation == nil) != tt.expectedDeleted {
+				t.Errorf("expected deleted %v, got conversation %v", tt.expectedDeleted, conversation)
+			}
+		})
+	}
+}
+
+func TestRenameConversation(t *testing.T) {
+	tests := []struct {
+		name             string
+		userID           uint
+		conversationName string
+		expectedErr      error
+		expectedName     string
+		prepare          func(f *fields)
+	}{
+		{
+			name:             "TestRenameConversation",
+			userID:           1,
+			conversationName: " Family ",
+			expectedErr:      nil,
+			expectedName:     "Family",
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().UpdateConversationName(gomock.Any(), uint(1), "Family").Return(nil)
+			},
+		},
+		{
+			name:             "TestRenameConversation by member",
+			userID:           2,
+			conversationName: "Family",
+			expectedErr:      errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:             "TestRenameConversation empty name",
+			userID:           1,
+			conversationName: "",
+			expectedErr:      errors.ErrInvalidData,
+			prepare:          func(f *fields) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			conversation, err := s.RenameConversation(context.Background(), tt.userID, 1, tt.conversationName)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if tt.expectedErr == nil && conversation.Name != tt.expectedName {
+				t.Errorf("expected name %v, got %v", tt.expectedName, conversation.Name)
+			}
+		})
+	}
+}
+
+func TestSetConversationRole(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		targetUserID uint
+		role         domain.ConversationRole
+		expectedErr  error
+		prepare      func(f *fields)
+	}{
+		{
+			name:         "TestSetConversationRole",
+			userID:       1,
+			targetUserID: 2,
+			role:         domain.ConversationRoleAdmin,
+			expectedErr:  nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().UpdateConversationParticipantRole(gomock.Any(), uint(1), uint(2), domain.ConversationRoleAdmin).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleAdmin)), nil)
+			},
+		},
+		{
+			name:         "TestSetConversationRole transfer ownership",
+			userID:       1,
+			targetUserID: 2,
+			role:         domain.ConversationRoleOwner,
+			expectedErr:  nil,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleOwner), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+				f.PersonalMessagesRepo.EXPECT().UpdateConversationParticipantRole(gomock.Any(), uint(1), uint(2), domain.ConversationRoleOwner).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().UpdateConversationParticipantRole(gomock.Any(), uint(1), uint(1), domain.ConversationRoleAdmin).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleAdmin), newTestParticipant(2, domain.ConversationRoleOwner)), nil)
+			},
+		},
+		{
+			name:         "TestSetConversationRole by admin",
+			userID:       1,
+			targetUserID: 2,
+			role:         domain.ConversationRoleAdmin,
+			expectedErr:  errors.ErrForbidden,
+			prepare: func(f *fields) {
+				f.PersonalMessagesRepo.EXPECT().GetConversationByID(gomock.Any(), uint(1)).Return(
+					newTestConversation(newTestParticipant(1, domain.ConversationRoleAdmin), newTestParticipant(2, domain.ConversationRoleMember)), nil)
+			},
+		},
+		{
+			name:         "TestSetConversationRole unknown role",
+			userID:       1,
+			targetUserID: 2,
+			role:         domain.ConversationRole("moderator"),
+			expectedErr:  errors.ErrInvalidData,
+			prepare:      func(f *fields) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &fields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, fields.PersonalMessagesRepo, nil, nil, nil)
+
+			_, err := s.SetConversationRole(context.Background(), tt.userID, 1, tt.targetUserID, tt.role)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
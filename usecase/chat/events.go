@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"context"
+	"socio/errors"
+	"strconv"
+	"strings"
+)
+
+// GetMissedActions returns the actions delivered to the user after the event
+// with lastEventID, so a reconnected client can catch up. Event IDs have the
+// "<milliseconds>-<sequence>" form and grow monotonically.
+func (s *Service) GetMissedActions(ctx context.Context, userID uint, lastEventID string) (actions []*Action, err error) {
+	if !isValidEventID(lastEventID) {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	actions, err = s.PubSubRepository.ReadActionsAfter(ctx, userID, lastEventID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// EventIDAfter reports whether the event with eventID comes after the one
+// with lastEventID. Malformed IDs are never considered seen.
+func EventIDAfter(eventID, lastEventID string) bool {
+	ms, seq, ok := parseEventID(eventID)
+	if !ok {
+		return true
+	}
+
+	lastMs, lastSeq, ok := parseEventID(lastEventID)
+	if !ok {
+		return true
+	}
+
+	return ms > lastMs || ms == lastMs && seq > lastSeq
+}
+
+func isValidEventID(eventID string) bool {
+	_, _, ok := parseEventID(eventID)
+	return ok
+}
+
+func parseEventID(eventID string) (ms, seq uint64, ok bool) {
+	msData, seqData, ok := strings.Cut(eventID, "-")
+	if !ok {
+		return
+	}
+
+	ms, err := strconv.ParseUint(msData, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	seq, err = strconv.ParseUint(seqData, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return
+}
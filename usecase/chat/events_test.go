@@ -0,0 +1,248 @@
+package chat_test
+
+import (
+	"context"
+	errorsDef "errors"
+	"fmt"
+	"socio/errors"
+	mock_chat "socio/mocks/usecase/chat"
+	"socio/usecase/chat"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+// fakeEventStream is an in-memory PubSubRepository that keeps a stream of
+// logged actions per user, like the redis one does.
+type fakeEventStream struct {
+	mu        sync.Mutex
+	lastID    uint64
+	streams   map[uint][]*chat.Action
+	published map[uint][]*chat.Action
+}
+
+func newFakeEventStream() *fakeEventStream {
+	return &fakeEventStream{
+		streams:   make(map[uint][]*chat.Action),
+		published: make(map[uint][]*chat.Action),
+	}
+}
+
+func (f *fakeEventStream) ReadActions(ctx context.Context, userID uint, ch chan *chat.Action) (err error) {
+	return
+}
+
+func (f *fakeEventStream) ReadActionsAfter(ctx context.Context, userID uint, lastEventID string) (actions []*chat.Action, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	last, err := parseFakeEventID(lastEventID)
+	if err != nil {
+		return
+	}
+
+	for _, action := range f.streams[userID] {
+		id, err := parseFakeEventID(action.EventID)
+		if err != nil {
+			return nil, err
+		}
+
+		if id > last {
+			actions = append(actions, action)
+		}
+	}
+
+	return
+}
+
+func (f *fakeEventStream) WriteAction(ctx context.Context, action *chat.Action, receivers []uint) (err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, receiver := range receivers {
+		f.lastID++
+
+		logged := *action
+		logged.EventID = fmt.Sprintf("%d-0", f.lastID)
+
+		f.streams[receiver] = append(f.streams[receiver], &logged)
+		f.published[receiver] = append(f.published[receiver], &logged)
+	}
+
+	return
+}
+
+func (f *fakeEventStream) PublishAction(ctx context.Context, action *chat.Action, receivers []uint) (err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, receiver := range receivers {
+		published := *action
+		f.published[receiver] = append(f.published[receiver], &published)
+	}
+
+	return
+}
+
+func parseFakeEventID(eventID string) (id uint64, err error) {
+	ms, _, _ := strings.Cut(eventID, "-")
+	return strconv.ParseUint(ms, 10, 64)
+}
+
+func eventIDs(actions []*chat.Action) (ids []string) {
+	for _, action := range actions {
+		ids = append(ids, action.EventID)
+	}
+
+	return
+}
+
+func TestGetMissedActions(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      uint
+		lastEventID string
+		expectedErr error
+		expectedIDs []string
+	}{
+		{
+			name:        "TestGetMissedActions all",
+			userID:      1,
+			lastEventID: "0-0",
+			expectedErr: nil,
+			expectedIDs: []string{"1-0", "3-0", "4-0"},
+		},
+		{
+			name:        "TestGetMissedActions after event",
+			userID:      1,
+			lastEventID: "1-0",
+			expectedErr: nil,
+			expectedIDs: []string{"3-0", "4-0"},
+		},
+		{
+			name:        "TestGetMissedActions nothing missed",
+			userID:      1,
+			lastEventID: "4-0",
+			expectedErr: nil,
+			expectedIDs: nil,
+		},
+		{
+			name:        "TestGetMissedActions other user",
+			userID:      2,
+			lastEventID: "0-0",
+			expectedErr: nil,
+			expectedIDs: []string{"2-0"},
+		},
+		{
+			name:        "TestGetMissedActions invalid event ID",
+			userID:      1,
+			lastEventID: "abc",
+			expectedErr: errors.ErrInvalidData,
+			expectedIDs: nil,
+		},
+		{
+			name:        "TestGetMissedActions invalid sequence",
+			userID:      1,
+			lastEventID: "1-x",
+			expectedErr: errors.ErrInvalidData,
+			expectedIDs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := newFakeEventStream()
+
+			err := stream.WriteAction(context.Background(), &chat.Action{Type: chat.SendMessageAction}, []uint{1, 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = stream.WriteAction(context.Background(), &chat.Action{Type: chat.UpdateMessageAction}, []uint{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = stream.PublishAction(context.Background(), &chat.Action{Type: chat.TypingStartAction}, []uint{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = stream.WriteAction(context.Background(), &chat.Action{Type: chat.DeleteMessageAction}, []uint{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := chat.NewChatService(stream, nil, nil, nil, nil, nil)
+
+			actions, err := s.GetMissedActions(context.Background(), tt.userID, tt.lastEventID)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			ids := eventIDs(actions)
+			if fmt.Sprint(ids) != fmt.Sprint(tt.expectedIDs) {
+				t.Errorf("expected event IDs %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestReplaySkipsEphemeralActions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	messagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
+	messagesRepo.EXPECT().UpdateLastReadMessageID(gomock.Any(), uint(1), uint(2), uint(5)).Return(uint(5), nil)
+	messagesRepo.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil).Times(2)
+
+	stream := newFakeEventStream()
+	s := chat.NewChatService(stream, nil, messagesRepo, nil, nil, nil)
+	c := &chat.Client{UserID: 1, ChatService: s}
+
+	c.HandleAction(context.Background(), &chat.Action{Type: chat.TypingStartAction, Receiver: 2})
+	c.HandleAction(context.Background(), &chat.Action{Type: chat.MarkReadAction, Receiver: 2, Payload: []byte(`{"messageId":5}`)})
+	c.HandleAction(context.Background(), &chat.Action{Type: chat.TypingStopAction, Receiver: 2})
+
+	if len(stream.published[2]) != 3 {
+		t.Errorf("expected 3 published actions, got %d", len(stream.published[2]))
+	}
+
+	actions, err := s.GetMissedActions(context.Background(), 2, "0-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(actions) != 1 || actions[0].Type != chat.MarkReadAction {
+		t.Errorf("expected only %v to be replayed, got %v", chat.MarkReadAction, actions)
+	}
+}
+
+func TestEventIDAfter(t *testing.T) {
+	tests := []struct {
+		name        string
+		eventID     string
+		lastEventID string
+		want        bool
+	}{
+		{name: "same event", eventID: "5-1", lastEventID: "5-1", want: false},
+		{name: "older sequence", eventID: "5-0", lastEventID: "5-1", want: false},
+		{name: "older time", eventID: "4-9", lastEventID: "5-1", want: false},
+		{name: "newer sequence", eventID: "5-2", lastEventID: "5-1", want: true},
+		{name: "newer time", eventID: "10-0", lastEventID: "9-3", want: true},
+		{name: "malformed event", eventID: "", lastEventID: "5-1", want: true},
+		{name: "malformed last event", eventID: "5-1", lastEventID: "x", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chat.EventIDAfter(tt.eventID, tt.lastEventID); got != tt.want {
+				t.Errorf("EventIDAfter(%q, %q) = %v, want %v", tt.eventID, tt.lastEventID, got, tt.want)
+			}
+		})
+	}
+}
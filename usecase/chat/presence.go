@@ -0,0 +1,145 @@
+package chat
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	customtime "socio/pkg/time"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	// presenceTTL is how long the user stays online after the last heartbeat,
+	// it matches the read deadline of a websocket connection.
+	presenceTTL               = 60 * time.Second
+	presenceHeartbeatInterval = 20 * time.Second
+	maxPresenceUserIDs        = 100
+)
+
+type PresenceStorage interface {
+	Touch(ctx context.Context, userID uint, seenAt time.Time, ttl time.Duration) (wasOnline bool, err error)
+	SetOffline(ctx context.Context, userID uint, seenAt time.Time) (err error)
+	GetPresences(ctx context.Context, userIDs []uint) (presences []*domain.Presence, err error)
+}
+
+// Heartbeat keeps the user online. It is called on every pong of any of the
+// user connections, but the storage is refreshed at most once per
+// presenceHeartbeatInterval. Peers are notified when the user comes online.
+func (s *Service) Heartbeat(ctx context.Context, userID uint) (err error) {
+	c, err := s.GetClient(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	now := s.TimeProvider.Now()
+
+	touchedAt := c.presenceTouchedAt.Load()
+	if now.Sub(time.Unix(0, touchedAt)) < presenceHeartbeatInterval {
+		return
+	}
+
+	if !c.presenceTouchedAt.CompareAndSwap(touchedAt, now.UnixNano()) {
+		return
+	}
+
+	wasOnline, err := s.PresenceStorage.Touch(ctx, userID, now, presenceTTL)
+	if err != nil {
+		return
+	}
+
+	if wasOnline {
+		return
+	}
+
+	err = s.broadcastPresence(ctx, &domain.Presence{
+		UserID:   userID,
+		IsOnline: true,
+		LastSeen: customtime.CustomTime{Time: now},
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SetOffline is called when the last connection of the user is closed.
+func (s *Service) SetOffline(ctx context.Context, userID uint) (err error) {
+	cData, ok := s.Clients.Load(userID)
+	if ok {
+		cData.(*Client).presenceTouchedAt.Store(0)
+	}
+
+	now := s.TimeProvider.Now()
+
+	err = s.PresenceStorage.SetOffline(ctx, userID, now)
+	if err != nil {
+		return
+	}
+
+	err = s.broadcastPresence(ctx, &domain.Presence{
+		UserID:   userID,
+		IsOnline: false,
+		LastSeen: customtime.CustomTime{Time: now},
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetPresences(ctx context.Context, userIDs []uint) (presences []*domain.Presence, err error) {
+	uniqueUserIDs := make([]uint, 0, len(userIDs))
+	seen := make(map[uint]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == 0 || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		uniqueUserIDs = append(uniqueUserIDs, userID)
+	}
+
+	if len(uniqueUserIDs) == 0 || len(uniqueUserIDs) > maxPresenceUserIDs {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	presences, err = s.PresenceStorage.GetPresences(ctx, uniqueUserIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// broadcastPresence pushes the presence of the user to everyone who shares a
+// dialog or a conversation with them.
+func (s *Service) broadcastPresence(ctx context.Context, presence *domain.Presence) (err error) {
+	peerIDs, err := s.MessagesRepo.GetConversationPeerIDs(ctx, presence.UserID)
+	if err != nil {
+		return
+	}
+
+	if len(peerIDs) == 0 {
+		return
+	}
+
+	payload, err := easyjson.Marshal(presence)
+	if err != nil {
+		return
+	}
+
+	err = s.PubSubRepository.PublishAction(ctx, &Action{
+		Type:    PresenceAction,
+		Payload: payload,
+	}, peerIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,276 @@
+package chat_test
+
+import (
+	"context"
+	errorsDef "errors"
+	"reflect"
+	"socio/domain"
+	"socio/errors"
+	mock_chat "socio/mocks/usecase/chat"
+	customtime "socio/pkg/time"
+	"socio/usecase/chat"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+type presenceFields struct {
+	PersonalMessagesRepo *mock_chat.MockPersonalMessagesRepository
+	PubSubRepo           *mock_chat.MockPubSubRepository
+	PresenceStorage      *mock_chat.MockPresenceStorage
+}
+
+func TestHeartbeat(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name        string
+		userID      uint
+		registered  bool
+		heartbeats  int
+		expectedErr error
+		prepare     func(f *presenceFields)
+	}{
+		{
+			name:        "TestHeartbeat comes online",
+			userID:      1,
+			registered:  true,
+			heartbeats:  1,
+			expectedErr: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().Touch(gomock.Any(), uint(1), tp.Now(), gomock.Any()).Return(false, nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationPeerIDs(gomock.Any(), uint(1)).Return([]uint{2, 3}, nil)
+				f.PubSubRepo.EXPECT().PublishAction(gomock.Any(), gomock.Any(), []uint{2, 3}).DoAndReturn(
+					func(ctx context.Context, action *chat.Action, receivers []uint) error {
+						if action.Type != chat.PresenceAction {
+							t.Errorf("expected action %v, got %v", chat.PresenceAction, action.Type)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:        "TestHeartbeat already online",
+			userID:      1,
+			registered:  true,
+			heartbeats:  1,
+			expectedErr: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().Touch(gomock.Any(), uint(1), tp.Now(), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name:        "TestHeartbeat throttled",
+			userID:      1,
+			registered:  true,
+			heartbeats:  3,
+			expectedErr: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().Touch(gomock.Any(), uint(1), tp.Now(), gomock.Any()).Return(true, nil).Times(1)
+			},
+		},
+		{
+			name:        "TestHeartbeat no client",
+			userID:      1,
+			registered:  false,
+			heartbeats:  1,
+			expectedErr: errors.ErrNotFound,
+			prepare:     func(f *presenceFields) {},
+		},
+		{
+			name:        "TestHeartbeat storage error",
+			userID:      1,
+			registered:  true,
+			heartbeats:  1,
+			expectedErr: errors.ErrInternal,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().Touch(gomock.Any(), uint(1), tp.Now(), gomock.Any()).Return(false, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &presenceFields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+				PubSubRepo:           mock_chat.NewMockPubSubRepository(ctrl),
+				PresenceStorage:      mock_chat.NewMockPresenceStorage(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(fields.PubSubRepo, nil, fields.PersonalMessagesRepo, nil, nil, fields.PresenceStorage)
+			s.TimeProvider = tp
+
+			if tt.registered {
+				s.Clients.Store(tt.userID, &chat.Client{UserID: tt.userID})
+			}
+
+			var err error
+			for i := 0; i < tt.heartbeats; i++ {
+				err = s.Heartbeat(context.Background(), tt.userID)
+			}
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestSetOffline(t *testing.T) {
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name        string
+		userID      uint
+		expectedErr error
+		prepare     func(f *presenceFields)
+	}{
+		{
+			name:        "TestSetOffline",
+			userID:      1,
+			expectedErr: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().SetOffline(gomock.Any(), uint(1), tp.Now()).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationPeerIDs(gomock.Any(), uint(1)).Return([]uint{2}, nil)
+				f.PubSubRepo.EXPECT().PublishAction(gomock.Any(), gomock.Any(), []uint{2}).Return(nil)
+			},
+		},
+		{
+			name:        "TestSetOffline no peers",
+			userID:      1,
+			expectedErr: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().SetOffline(gomock.Any(), uint(1), tp.Now()).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationPeerIDs(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:        "TestSetOffline storage error",
+			userID:      1,
+			expectedErr: errors.ErrInternal,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().SetOffline(gomock.Any(), uint(1), tp.Now()).Return(errors.ErrInternal)
+			},
+		},
+		{
+			name:        "TestSetOffline peers error",
+			userID:      1,
+			expectedErr: errors.ErrInternal,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().SetOffline(gomock.Any(), uint(1), tp.Now()).Return(nil)
+				f.PersonalMessagesRepo.EXPECT().GetConversationPeerIDs(gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &presenceFields{
+				PersonalMessagesRepo: mock_chat.NewMockPersonalMessagesRepository(ctrl),
+				PubSubRepo:           mock_chat.NewMockPubSubRepository(ctrl),
+				PresenceStorage:      mock_chat.NewMockPresenceStorage(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(fields.PubSubRepo, nil, fields.PersonalMessagesRepo, nil, nil, fields.PresenceStorage)
+			s.TimeProvider = tp
+
+			err := s.SetOffline(context.Background(), tt.userID)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestGetPresences(t *testing.T) {
+	lastSeen := customtime.CustomTime{Time: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)}
+
+	tooManyUserIDs := make([]uint, 0, 101)
+	for i := uint(1); i <= 101; i++ {
+		tooManyUserIDs = append(tooManyUserIDs, i)
+	}
+
+	tests := []struct {
+		name              string
+		userIDs           []uint
+		expectedErr       error
+		expectedPresences []*domain.Presence
+		prepare           func(f *presenceFields)
+	}{
+		{
+			name:        "TestGetPresences",
+			userIDs:     []uint{1, 2, 1, 0},
+			expectedErr: nil,
+			expectedPresences: []*domain.Presence{
+				{UserID: 1, IsOnline: true, LastSeen: lastSeen},
+				{UserID: 2, IsOnline: false},
+			},
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().GetPresences(gomock.Any(), []uint{1, 2}).Return([]*domain.Presence{
+					{UserID: 1, IsOnline: true, LastSeen: lastSeen},
+					{UserID: 2, IsOnline: false},
+				}, nil)
+			},
+		},
+		{
+			name:              "TestGetPresences no users",
+			userIDs:           []uint{0},
+			expectedErr:       errors.ErrInvalidData,
+			expectedPresences: nil,
+			prepare:           func(f *presenceFields) {},
+		},
+		{
+			name:              "TestGetPresences too many users",
+			userIDs:           tooManyUserIDs,
+			expectedErr:       errors.ErrInvalidData,
+			expectedPresences: nil,
+			prepare:           func(f *presenceFields) {},
+		},
+		{
+			name:              "TestGetPresences storage error",
+			userIDs:           []uint{1},
+			expectedErr:       errors.ErrInternal,
+			expectedPresences: nil,
+			prepare: func(f *presenceFields) {
+				f.PresenceStorage.EXPECT().GetPresences(gomock.Any(), []uint{1}).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fields := &presenceFields{
+				PresenceStorage: mock_chat.NewMockPresenceStorage(ctrl),
+			}
+
+			tt.prepare(fields)
+
+			s := chat.NewChatService(nil, nil, nil, nil, nil, fields.PresenceStorage)
+
+			presences, err := s.GetPresences(context.Background(), tt.userIDs)
+
+			if !errorsDef.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(presences, tt.expectedPresences) {
+				t.Errorf("expected presences %v, got %v", tt.expectedPresences, presences)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"mime/multipart"
 	"socio/domain"
 	"socio/pkg/static"
+	"socio/pkg/upload"
+	"time"
 )
 
 type UnsentMessageAttachmentsStorage interface {
@@ -17,6 +19,7 @@ type UnsentMessageAttachmentsStorage interface {
 type MessageAttachmentStorage interface {
 	Store(fileName string, filePath string, contentType string) (err error)
 	Delete(fileName string) (err error)
+	PresignedURL(fileName string, expiry time.Duration) (fileURL string, err error)
 }
 
 func (c *Service) CreateUnsentMessageAttachments(ctx context.Context, attachs *domain.UnsentMessageAttachment, fhs []*multipart.FileHeader) (filenames []string, err error) {
@@ -35,8 +38,12 @@ func (c *Service) CreateUnsentMessageAttachments(ctx context.Context, attachs *d
 			return
 		}
 
-		err = c.MessageAttachmentStorage.Store(fileName, "./"+fileName, fh.Header.Get("Content-Type"))
+		var meta *upload.Metadata
+		var reused bool
+
+		meta, reused, err = upload.ProcessDeduplicated(c.MessageAttachmentStorage, c.attachmentIndex(ctx), upload.MessageAttachmentKind, fileName, "./"+fileName)
 		if err != nil {
+			_ = static.RemoveFile("./" + fileName)
 			return
 		}
 
@@ -45,16 +52,23 @@ func (c *Service) CreateUnsentMessageAttachments(ctx context.Context, attachs *d
 			return
 		}
 
+		if !reused {
+			err = c.MessagesRepo.StoreAttachment(ctx, upload.ToAttachment(meta, fh.Filename))
+			if err != nil {
+				return
+			}
+		}
+
 		err = c.UnsentMessageAttachmentsStorage.Store(ctx, &domain.UnsentMessageAttachment{
 			SenderID:   attachs.SenderID,
 			ReceiverID: attachs.ReceiverID,
-			FileName:   fileName,
+			FileName:   meta.FileName,
 		})
 		if err != nil {
 			return
 		}
 
-		fileName = c.Sanitizer.Sanitize(fileName)
+		fileName = c.Sanitizer.Sanitize(meta.FileName)
 
 		filenames = append(filenames, fileName)
 	}
@@ -81,11 +95,9 @@ func (c *Service) DeleteUnsentMessageAttachments(ctx context.Context, attach *do
 		return
 	}
 
-	for _, fileName := range fileNames {
-		err = c.MessageAttachmentStorage.Delete(fileName)
-		if err != nil {
-			return
-		}
+	err = c.deleteUnusedAttachments(ctx, fileNames)
+	if err != nil {
+		return
 	}
 
 	err = c.UnsentMessageAttachmentsStorage.DeleteAll(ctx, attach)
@@ -97,7 +109,7 @@ func (c *Service) DeleteUnsentMessageAttachments(ctx context.Context, attach *do
 }
 
 func (c *Service) DeleteUnsentMessageAttachment(ctx context.Context, attach *domain.UnsentMessageAttachment) (err error) {
-	err = c.MessageAttachmentStorage.Delete(attach.FileName)
+	err = c.deleteUnusedAttachments(ctx, []string{attach.FileName})
 	if err != nil {
 		return
 	}
@@ -109,3 +121,41 @@ func (c *Service) DeleteUnsentMessageAttachment(ctx context.Context, attach *dom
 
 	return
 }
+
+// attachmentIndex finds the files already attached to sent messages, so an
+// identical upload reuses the stored file.
+func (c *Service) attachmentIndex(ctx context.Context) upload.Index {
+	return upload.IndexFunc(func(checksum string) (fileName string, err error) {
+		attachment, err := c.MessagesRepo.GetAttachmentByChecksum(ctx, checksum)
+		if err != nil {
+			return
+		}
+
+		fileName = attachment.FileName
+		return
+	})
+}
+
+// deleteUnusedAttachments deletes the stored files no sent message is attached
+// to, identical uploads share one stored file.
+func (c *Service) deleteUnusedAttachments(ctx context.Context, fileNames []string) (err error) {
+	for _, fileName := range fileNames {
+		var references uint
+
+		references, err = c.MessagesRepo.CountAttachmentReferences(ctx, fileName)
+		if err != nil {
+			return
+		}
+
+		if references > 0 {
+			continue
+		}
+
+		err = c.MessageAttachmentStorage.Delete(fileName)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
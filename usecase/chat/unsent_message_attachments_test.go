@@ -59,7 +59,7 @@ func TestGetUnsentMessageAttachments(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, nil, nil, nil)
+			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, nil, nil, nil, nil)
 
 			got, err := s.GetUnsentMessageAttachments(context.Background(), tt.attach)
 			assert.Equal(t, tt.wantErr, err)
@@ -76,6 +76,7 @@ func TestDeleteUnsentMessageAttachments(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUnsentMessageAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+	mockMessagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
 	mockMessageAttachmentStorage := mock_chat.NewMockMessageAttachmentStorage(ctrl)
 
 	tests := []struct {
@@ -94,6 +95,7 @@ func TestDeleteUnsentMessageAttachments(t *testing.T) {
 			wantErr: nil,
 			setup: func() {
 				mockUnsentMessageAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]string{"file1.png"}, nil)
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(nil)
 				mockUnsentMessageAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -108,6 +110,7 @@ func TestDeleteUnsentMessageAttachments(t *testing.T) {
 			wantErr: errors.ErrNotFound,
 			setup: func() {
 				mockUnsentMessageAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]string{"file1.png"}, nil)
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(nil)
 				mockUnsentMessageAttachmentsStorage.EXPECT().DeleteAll(gomock.Any(), gomock.Any()).Return(errors.ErrNotFound)
 			},
@@ -122,6 +125,7 @@ func TestDeleteUnsentMessageAttachments(t *testing.T) {
 			wantErr: errors.ErrNotFound,
 			setup: func() {
 				mockUnsentMessageAttachmentsStorage.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]string{"file1.png"}, nil)
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(errors.ErrNotFound)
 			},
 		},
@@ -145,7 +149,7 @@ func TestDeleteUnsentMessageAttachments(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, nil, nil, mockMessageAttachmentStorage)
+			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, mockMessagesRepo, nil, mockMessageAttachmentStorage, nil)
 
 			err := s.DeleteUnsentMessageAttachments(context.Background(), tt.attach)
 			assert.Equal(t, tt.wantErr, err)
@@ -158,6 +162,7 @@ func TestDeleteUnsentMessageAttachment(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUnsentMessageAttachmentsStorage := mock_chat.NewMockUnsentMessageAttachmentsStorage(ctrl)
+	mockMessagesRepo := mock_chat.NewMockPersonalMessagesRepository(ctrl)
 	mockMessageAttachmentStorage := mock_chat.NewMockMessageAttachmentStorage(ctrl)
 
 	tests := []struct {
@@ -173,6 +178,7 @@ func TestDeleteUnsentMessageAttachment(t *testing.T) {
 			},
 			wantErr: nil,
 			setup: func() {
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(nil)
 				mockUnsentMessageAttachmentsStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -184,6 +190,7 @@ func TestDeleteUnsentMessageAttachment(t *testing.T) {
 			},
 			wantErr: errors.ErrNotFound,
 			setup: func() {
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(nil)
 				mockUnsentMessageAttachmentsStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.ErrNotFound)
 			},
@@ -195,16 +202,38 @@ func TestDeleteUnsentMessageAttachment(t *testing.T) {
 			},
 			wantErr: errors.ErrNotFound,
 			setup: func() {
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), nil)
 				mockMessageAttachmentStorage.EXPECT().Delete("file1.png").Return(errors.ErrNotFound)
 			},
 		},
+		{
+			name: "test case 4 - file of a sent message is kept",
+			attach: &domain.UnsentMessageAttachment{
+				FileName: "file1.png",
+			},
+			wantErr: nil,
+			setup: func() {
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(1), nil)
+				mockUnsentMessageAttachmentsStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "test case 5",
+			attach: &domain.UnsentMessageAttachment{
+				FileName: "file1.png",
+			},
+			wantErr: errors.ErrInternal,
+			setup: func() {
+				mockMessagesRepo.EXPECT().CountAttachmentReferences(gomock.Any(), "file1.png").Return(uint(0), errors.ErrInternal)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, nil, nil, mockMessageAttachmentStorage)
+			s := chat.NewChatService(nil, mockUnsentMessageAttachmentsStorage, mockMessagesRepo, nil, mockMessageAttachmentStorage, nil)
 
 			err := s.DeleteUnsentMessageAttachment(context.Background(), tt.attach)
 			assert.Equal(t, tt.wantErr, err)
@@ -0,0 +1,144 @@
+package gc
+
+import (
+	"context"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"time"
+)
+
+// DefaultGracePeriod is longer than the time a resumable upload may take, a
+// stored object is only referenced after the upload is finished.
+const DefaultGracePeriod = 48 * time.Hour
+
+type Object struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+type ObjectStorage interface {
+	ListObjects() (objects []*Object, err error)
+	Delete(fileName string) (err error)
+}
+
+type ReferencesStorage interface {
+	GetReferencedFileNames(ctx context.Context, bucketName string) (fileNames []string, err error)
+	DeleteAttachments(ctx context.Context, fileNames []string) (err error)
+}
+
+// Bucket is the bucket to collect. The variants of the referenced files are
+// kept with them, the files in Keep are never deleted.
+type Bucket struct {
+	Name    string
+	Kind    upload.Kind
+	Storage ObjectStorage
+	Keep    []string
+}
+
+//easyjson:json
+type Report struct {
+	Bucket       string `json:"bucket"`
+	Scanned      int    `json:"scanned"`
+	Orphaned     int    `json:"orphaned"`
+	Deleted      int    `json:"deleted"`
+	Failed       int    `json:"failed"`
+	DeletedBytes int64  `json:"deletedBytes"`
+	DryRun       bool   `json:"dryRun"`
+}
+
+// Service deletes the objects no database row references. The objects
+// younger than GracePeriod are skipped, they may belong to an upload in
+// progress. Nothing is deleted in DryRun mode.
+type Service struct {
+	ReferencesStorage ReferencesStorage
+	GracePeriod       time.Duration
+	DryRun            bool
+	TimeProvider      customtime.TimeProvider
+}
+
+func NewGCService(referencesStorage ReferencesStorage, gracePeriod time.Duration, dryRun bool) (gcService *Service) {
+	return &Service{
+		ReferencesStorage: referencesStorage,
+		GracePeriod:       gracePeriod,
+		DryRun:            dryRun,
+		TimeProvider:      customtime.RealTimeProvider{},
+	}
+}
+
+// Collect deletes the orphaned objects of the bucket. The objects are listed
+// before the references are loaded, so an object stored and referenced in
+// between is never seen as orphaned. A failed deletion is counted in the
+// report and does not stop the collection.
+func (s *Service) Collect(ctx context.Context, bucket Bucket) (report *Report, err error) {
+	report = &Report{
+		Bucket: bucket.Name,
+		DryRun: s.DryRun,
+	}
+
+	objects, err := bucket.Storage.ListObjects()
+	if err != nil {
+		return
+	}
+
+	fileNames, err := s.ReferencesStorage.GetReferencedFileNames(ctx, bucket.Name)
+	if err != nil {
+		return
+	}
+
+	referenced := referencedObjects(bucket, fileNames)
+	deadline := s.TimeProvider.Now().Add(-s.GracePeriod)
+
+	var deleted []string
+
+	for _, object := range objects {
+		report.Scanned++
+
+		if _, ok := referenced[object.Name]; ok || object.LastModified.After(deadline) {
+			continue
+		}
+
+		report.Orphaned++
+
+		if s.DryRun {
+			continue
+		}
+
+		if err = bucket.Storage.Delete(object.Name); err != nil {
+			report.Failed++
+			continue
+		}
+
+		report.Deleted++
+		report.DeletedBytes += object.Size
+		deleted = append(deleted, object.Name)
+	}
+
+	err = s.ReferencesStorage.DeleteAttachments(ctx, deleted)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// referencedObjects is the set of the referenced files, their variants and
+// the kept files. Both extensions of a variant are kept as the content type of
+// the original is not known.
+func referencedObjects(bucket Bucket, fileNames []string) (referenced map[string]struct{}) {
+	variants := upload.Policies[bucket.Kind].Variants
+	referenced = make(map[string]struct{}, len(fileNames)*(1+2*len(variants))+len(bucket.Keep))
+
+	for _, names := range [][]string{fileNames, bucket.Keep} {
+		for _, fileName := range names {
+			referenced[fileName] = struct{}{}
+
+			for _, variant := range variants {
+				referenced[upload.VariantFileName(fileName, variant.Name, "image/jpeg")] = struct{}{}
+				referenced[upload.VariantFileName(fileName, variant.Name, "image/png")] = struct{}{}
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,127 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package gc
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson7c1d9502DecodeSocioUsecaseGc(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bucket":
+			out.Bucket = string(in.String())
+		case "scanned":
+			out.Scanned = int(in.Int())
+		case "orphaned":
+			out.Orphaned = int(in.Int())
+		case "deleted":
+			out.Deleted = int(in.Int())
+		case "failed":
+			out.Failed = int(in.Int())
+		case "deletedBytes":
+			out.DeletedBytes = int64(in.Int64())
+		case "dryRun":
+			out.DryRun = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7c1d9502EncodeSocioUsecaseGc(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bucket\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Bucket))
+	}
+	{
+		const prefix string = ",\"scanned\":"
+		out.RawString(prefix)
+		out.Int(int(in.Scanned))
+	}
+	{
+		const prefix string = ",\"orphaned\":"
+		out.RawString(prefix)
+		out.Int(int(in.Orphaned))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Int(int(in.Deleted))
+	}
+	{
+		const prefix string = ",\"failed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Failed))
+	}
+	{
+		const prefix string = ",\"deletedBytes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeletedBytes))
+	}
+	{
+		const prefix string = ",\"dryRun\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DryRun))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7c1d9502EncodeSocioUsecaseGc(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7c1d9502EncodeSocioUsecaseGc(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7c1d9502DecodeSocioUsecaseGc(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7c1d9502DecodeSocioUsecaseGc(l, v)
+}
@@ -0,0 +1,144 @@
+package gc_test
+
+import (
+	"context"
+	"socio/errors"
+	mock_gc "socio/mocks/usecase/gc"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"socio/usecase/gc"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newService(referencesStorage gc.ReferencesStorage, dryRun bool) *gc.Service {
+	s := gc.NewGCService(referencesStorage, gc.DefaultGracePeriod, dryRun)
+	s.TimeProvider = customtime.MockTimeProvider{}
+
+	return s
+}
+
+func newObjects() []*gc.Object {
+	tp := customtime.MockTimeProvider{}
+	old := tp.Now().Add(-gc.DefaultGracePeriod - time.Hour)
+
+	return []*gc.Object{
+		{Name: "pic.png", Size: 100, LastModified: old},
+		{Name: "pic_thumbnail.png", Size: 10, LastModified: old},
+		{Name: "default.png", Size: 5, LastModified: old},
+		{Name: "orphan.png", Size: 200, LastModified: old},
+		{Name: "orphan_thumbnail.png", Size: 20, LastModified: old},
+		{Name: "fresh.png", Size: 300, LastModified: tp.Now().Add(-time.Hour)},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dryRun   bool
+		mock     func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage)
+		expected *gc.Report
+		wantErr  error
+	}{
+		{
+			name: "Test OK",
+			mock: func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage) {
+				objectStorage.EXPECT().ListObjects().Return(newObjects(), nil)
+				referencesStorage.EXPECT().GetReferencedFileNames(gomock.Any(), "post-attachments").Return([]string{"pic.png"}, nil)
+				objectStorage.EXPECT().Delete("orphan.png").Return(nil)
+				objectStorage.EXPECT().Delete("orphan_thumbnail.png").Return(nil)
+				referencesStorage.EXPECT().DeleteAttachments(gomock.Any(), []string{"orphan.png", "orphan_thumbnail.png"}).Return(nil)
+			},
+			expected: &gc.Report{
+				Bucket:       "post-attachments",
+				Scanned:      6,
+				Orphaned:     2,
+				Deleted:      2,
+				DeletedBytes: 220,
+			},
+		},
+		{
+			name:   "Test dry run",
+			dryRun: true,
+			mock: func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage) {
+				objectStorage.EXPECT().ListObjects().Return(newObjects(), nil)
+				referencesStorage.EXPECT().GetReferencedFileNames(gomock.Any(), "post-attachments").Return([]string{"pic.png"}, nil)
+				referencesStorage.EXPECT().DeleteAttachments(gomock.Any(), nil).Return(nil)
+			},
+			expected: &gc.Report{
+				Bucket:   "post-attachments",
+				Scanned:  6,
+				Orphaned: 2,
+				DryRun:   true,
+			},
+		},
+		{
+			name: "Test delete error",
+			mock: func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage) {
+				objectStorage.EXPECT().ListObjects().Return(newObjects(), nil)
+				referencesStorage.EXPECT().GetReferencedFileNames(gomock.Any(), "post-attachments").Return([]string{"pic.png"}, nil)
+				objectStorage.EXPECT().Delete("orphan.png").Return(errors.ErrInternal)
+				objectStorage.EXPECT().Delete("orphan_thumbnail.png").Return(nil)
+				referencesStorage.EXPECT().DeleteAttachments(gomock.Any(), []string{"orphan_thumbnail.png"}).Return(nil)
+			},
+			expected: &gc.Report{
+				Bucket:       "post-attachments",
+				Scanned:      6,
+				Orphaned:     2,
+				Deleted:      1,
+				Failed:       1,
+				DeletedBytes: 20,
+			},
+		},
+		{
+			name: "Test list error",
+			mock: func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage) {
+				objectStorage.EXPECT().ListObjects().Return(nil, errors.ErrInternal)
+			},
+			expected: &gc.Report{
+				Bucket: "post-attachments",
+			},
+			wantErr: errors.ErrInternal,
+		},
+		{
+			name: "Test references error",
+			mock: func(objectStorage *mock_gc.MockObjectStorage, referencesStorage *mock_gc.MockReferencesStorage) {
+				objectStorage.EXPECT().ListObjects().Return(newObjects(), nil)
+				referencesStorage.EXPECT().GetReferencedFileNames(gomock.Any(), "post-attachments").Return(nil, errors.ErrInternal)
+			},
+			expected: &gc.Report{
+				Bucket: "post-attachments",
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			objectStorage := mock_gc.NewMockObjectStorage(ctrl)
+			referencesStorage := mock_gc.NewMockReferencesStorage(ctrl)
+
+			tt.mock(objectStorage, referencesStorage)
+
+			s := newService(referencesStorage, tt.dryRun)
+
+			report, err := s.Collect(context.Background(), gc.Bucket{
+				Name:    "post-attachments",
+				Kind:    upload.PostAttachmentKind,
+				Storage: objectStorage,
+				Keep:    []string{"default.png"},
+			})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, report)
+		})
+	}
+}
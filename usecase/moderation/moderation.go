@@ -0,0 +1,189 @@
+package moderation
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/sanitizer"
+	"unicode/utf8"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	DefaultReportCasesAmount = uint(20)
+	MaxReportCasesAmount     = uint(100)
+	DefaultLogEntriesAmount  = uint(20)
+	MaxLogEntriesAmount      = uint(100)
+	MaxReportCommentLength   = 1000
+)
+
+type ModerationStorage interface {
+	CheckReportTarget(ctx context.Context, reporterID uint, targetType domain.ModerationTargetType, targetID uint) (exists bool, err error)
+	StoreReport(ctx context.Context, report *domain.Report) (newReport *domain.Report, err error)
+	GetOpenReportCases(ctx context.Context, lastCaseID, casesAmount uint) (cases []*domain.ReportCase, err error)
+	ResolveReportCases(ctx context.Context, targetType domain.ModerationTargetType, targetID, moderatorID uint) (err error)
+	DismissReportCase(ctx context.Context, caseID, moderatorID uint) (reportCase *domain.ReportCase, err error)
+	HideContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) (err error)
+	ShowContent(ctx context.Context, targetType domain.ModerationTargetType, targetID uint) (err error)
+	StoreModerationLogEntry(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error)
+	GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) (entries []*domain.ModerationLogEntry, err error)
+}
+
+type Service struct {
+	ModerationStorage ModerationStorage
+	Sanitizer         *sanitizer.Sanitizer
+}
+
+func NewModerationService(moderationStorage ModerationStorage) (service *Service) {
+	service = &Service{
+		ModerationStorage: moderationStorage,
+		Sanitizer:         sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
+	}
+	return
+}
+
+// Report files the complaint of the reporter about the target, the reports
+// about the same target are gathered in one case of the moderation queue.
+func (s *Service) Report(ctx context.Context, report *domain.Report) (newReport *domain.Report, err error) {
+	if !report.TargetType.IsReportable() || !report.Reason.IsValid() {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	if utf8.RuneCountInString(report.Comment) > MaxReportCommentLength {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	exists, err := s.ModerationStorage.CheckReportTarget(ctx, report.ReporterID, report.TargetType, report.TargetID)
+	if err != nil {
+		return
+	}
+
+	if !exists {
+		err = errors.ErrNotFound
+		return
+	}
+
+	report.Comment = s.Sanitizer.Sanitize(report.Comment)
+
+	newReport, err = s.ModerationStorage.StoreReport(ctx, report)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetReportCases returns the open cases of the moderation queue, the oldest
+// first.
+func (s *Service) GetReportCases(ctx context.Context, lastCaseID, casesAmount uint) (cases []*domain.ReportCase, err error) {
+	if casesAmount == 0 {
+		casesAmount = DefaultReportCasesAmount
+	}
+
+	if casesAmount > MaxReportCasesAmount {
+		casesAmount = MaxReportCasesAmount
+	}
+
+	cases, err = s.ModerationStorage.GetOpenReportCases(ctx, lastCaseID, casesAmount)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// HideContent hides the post, the comment, the message or the sticker from
+// everyone and records the action.
+func (s *Service) HideContent(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error) {
+	if !entry.TargetType.IsHideable() {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = s.ModerationStorage.HideContent(ctx, entry.TargetType, entry.TargetID)
+	if err != nil {
+		return
+	}
+
+	entry.Action = domain.HideContentModerationAction
+
+	newEntry, err = s.RecordAction(ctx, entry)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// RecordAction adds the action of the moderator to the audit log and resolves
+// the open case of the target. The actions taken by the other services, like
+// bans and group deletions, are recorded once they succeed.
+func (s *Service) RecordAction(ctx context.Context, entry *domain.ModerationLogEntry) (newEntry *domain.ModerationLogEntry, err error) {
+	entry.Reason = s.Sanitizer.Sanitize(entry.Reason)
+
+	newEntry, err = s.ModerationStorage.StoreModerationLogEntry(ctx, entry)
+	if err != nil {
+		return
+	}
+
+	if !entry.TargetType.IsReportable() {
+		return
+	}
+
+	err = s.ModerationStorage.ResolveReportCases(ctx, entry.TargetType, entry.TargetID, entry.ModeratorID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DismissReportCase closes the open case without acting on the target, the
+// case is opened again if someone else reports the target. The content held
+// by the content filter is shown.
+func (s *Service) DismissReportCase(ctx context.Context, moderatorID, caseID uint, reason string) (newEntry *domain.ModerationLogEntry, err error) {
+	reportCase, err := s.ModerationStorage.DismissReportCase(ctx, caseID, moderatorID)
+	if err != nil {
+		return
+	}
+
+	if reportCase.TargetType.IsHideable() {
+		err = s.ModerationStorage.ShowContent(ctx, reportCase.TargetType, reportCase.TargetID)
+		if err != nil {
+			return
+		}
+	}
+
+	newEntry, err = s.ModerationStorage.StoreModerationLogEntry(ctx, &domain.ModerationLogEntry{
+		ModeratorID: moderatorID,
+		Action:      domain.DismissReportsModerationAction,
+		TargetType:  reportCase.TargetType,
+		TargetID:    reportCase.TargetID,
+		Reason:      s.Sanitizer.Sanitize(reason),
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetModerationLog(ctx context.Context, lastEntryID, entriesAmount uint) (entries []*domain.ModerationLogEntry, err error) {
+	if entriesAmount == 0 {
+		entriesAmount = DefaultLogEntriesAmount
+	}
+
+	if entriesAmount > MaxLogEntriesAmount {
+		entriesAmount = MaxLogEntriesAmount
+	}
+
+	entries, err = s.ModerationStorage.GetModerationLog(ctx, lastEntryID, entriesAmount)
+	if err != nil {
+		return
+	}
+
+	return
+}
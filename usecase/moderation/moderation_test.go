@@ -0,0 +1,287 @@
+package moderation_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_moderation "socio/mocks/usecase/moderation"
+	"socio/usecase/moderation"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		report     *domain.Report
+		mock       func(storage *mock_moderation.MockModerationStorage)
+		wantReport *domain.Report
+		wantErr    error
+	}{
+		{
+			name:   "Test OK",
+			report: &domain.Report{ReporterID: 1, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: domain.SpamReportReason, Comment: "ads<script>alert(1)</script>"},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().CheckReportTarget(gomock.Any(), uint(1), domain.PostModerationTarget, uint(2)).Return(true, nil)
+				storage.EXPECT().StoreReport(gomock.Any(), &domain.Report{ReporterID: 1, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: domain.SpamReportReason, Comment: "ads"}).
+					Return(&domain.Report{ID: 1, CaseID: 3, ReporterID: 1, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: domain.SpamReportReason, Comment: "ads"}, nil)
+			},
+			wantReport: &domain.Report{ID: 1, CaseID: 3, ReporterID: 1, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: domain.SpamReportReason, Comment: "ads"},
+		},
+		{
+			name:    "Test users are not reported",
+			report:  &domain.Report{ReporterID: 1, TargetType: domain.UserModerationTarget, TargetID: 2, Reason: domain.SpamReportReason},
+			mock:    func(storage *mock_moderation.MockModerationStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "Test unknown reason",
+			report:  &domain.Report{ReporterID: 1, TargetType: domain.GroupModerationTarget, TargetID: 2, Reason: "BORING"},
+			mock:    func(storage *mock_moderation.MockModerationStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "Test comment too long",
+			report:  &domain.Report{ReporterID: 1, TargetType: domain.CommentModerationTarget, TargetID: 2, Reason: domain.OtherReportReason, Comment: strings.Repeat("я", moderation.MaxReportCommentLength+1)},
+			mock:    func(storage *mock_moderation.MockModerationStorage) {},
+			wantErr: errors.ErrInvalidBody,
+		},
+		{
+			name:   "Test target not found",
+			report: &domain.Report{ReporterID: 1, TargetType: domain.MessageModerationTarget, TargetID: 2, Reason: domain.HarassmentReportReason},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().CheckReportTarget(gomock.Any(), uint(1), domain.MessageModerationTarget, uint(2)).Return(false, nil)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name:   "Test already reported",
+			report: &domain.Report{ReporterID: 1, TargetType: domain.StickerModerationTarget, TargetID: 2, Reason: domain.NudityReportReason},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().CheckReportTarget(gomock.Any(), uint(1), domain.StickerModerationTarget, uint(2)).Return(true, nil)
+				storage.EXPECT().StoreReport(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidBody)
+			},
+			wantErr: errors.ErrInvalidBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_moderation.NewMockModerationStorage(ctrl)
+			tt.mock(storage)
+
+			s := moderation.NewModerationService(storage)
+
+			gotReport, err := s.Report(context.Background(), tt.report)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantReport, gotReport)
+		})
+	}
+}
+
+func TestGetReportCases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		casesAmount uint
+		wantAmount  uint
+	}{
+		{
+			name:        "Test default amount",
+			casesAmount: 0,
+			wantAmount:  moderation.DefaultReportCasesAmount,
+		},
+		{
+			name:        "Test max amount",
+			casesAmount: 1000,
+			wantAmount:  moderation.MaxReportCasesAmount,
+		},
+		{
+			name:        "Test given amount",
+			casesAmount: 5,
+			wantAmount:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_moderation.NewMockModerationStorage(ctrl)
+			storage.EXPECT().GetOpenReportCases(gomock.Any(), uint(3), tt.wantAmount).Return(nil, nil)
+
+			s := moderation.NewModerationService(storage)
+
+			_, err := s.GetReportCases(context.Background(), 3, tt.casesAmount)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestHideContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		entry     *domain.ModerationLogEntry
+		mock      func(storage *mock_moderation.MockModerationStorage)
+		wantEntry *domain.ModerationLogEntry
+		wantErr   error
+	}{
+		{
+			name:  "Test OK",
+			entry: &domain.ModerationLogEntry{ModeratorID: 1, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: "spam"},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				entry := &domain.ModerationLogEntry{ModeratorID: 1, Action: domain.HideContentModerationAction, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: "spam"}
+				storage.EXPECT().HideContent(gomock.Any(), domain.PostModerationTarget, uint(2)).Return(nil)
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), entry).Return(&domain.ModerationLogEntry{ID: 5, ModeratorID: 1, Action: domain.HideContentModerationAction, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: "spam"}, nil)
+				storage.EXPECT().ResolveReportCases(gomock.Any(), domain.PostModerationTarget, uint(2), uint(1)).Return(nil)
+			},
+			wantEntry: &domain.ModerationLogEntry{ID: 5, ModeratorID: 1, Action: domain.HideContentModerationAction, TargetType: domain.PostModerationTarget, TargetID: 2, Reason: "spam"},
+		},
+		{
+			name:    "Test groups are not hidden",
+			entry:   &domain.ModerationLogEntry{ModeratorID: 1, TargetType: domain.GroupModerationTarget, TargetID: 2},
+			mock:    func(storage *mock_moderation.MockModerationStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:  "Test content not found",
+			entry: &domain.ModerationLogEntry{ModeratorID: 1, TargetType: domain.CommentModerationTarget, TargetID: 2},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().HideContent(gomock.Any(), domain.CommentModerationTarget, uint(2)).Return(errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_moderation.NewMockModerationStorage(ctrl)
+			tt.mock(storage)
+
+			s := moderation.NewModerationService(storage)
+
+			gotEntry, err := s.HideContent(context.Background(), tt.entry)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantEntry, gotEntry)
+		})
+	}
+}
+
+func TestRecordAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		entry   *domain.ModerationLogEntry
+		mock    func(storage *mock_moderation.MockModerationStorage)
+		wantErr error
+	}{
+		{
+			name:  "Test ban is not reported",
+			entry: &domain.ModerationLogEntry{ModeratorID: 1, Action: domain.BanUserModerationAction, TargetType: domain.UserModerationTarget, TargetID: 2},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), gomock.Any()).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name:  "Test group case is resolved",
+			entry: &domain.ModerationLogEntry{ModeratorID: 1, Action: domain.DeleteGroupModerationAction, TargetType: domain.GroupModerationTarget, TargetID: 2},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), gomock.Any()).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+				storage.EXPECT().ResolveReportCases(gomock.Any(), domain.GroupModerationTarget, uint(2), uint(1)).Return(nil)
+			},
+		},
+		{
+			name:  "Test storage error",
+			entry: &domain.ModerationLogEntry{ModeratorID: 1, Action: domain.DeleteGroupModerationAction, TargetType: domain.GroupModerationTarget, TargetID: 2},
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_moderation.NewMockModerationStorage(ctrl)
+			tt.mock(storage)
+
+			s := moderation.NewModerationService(storage)
+
+			_, err := s.RecordAction(context.Background(), tt.entry)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDismissReportCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(storage *mock_moderation.MockModerationStorage)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().DismissReportCase(gomock.Any(), uint(3), uint(1)).Return(&domain.ReportCase{ID: 3, TargetType: domain.PostModerationTarget, TargetID: 2, Status: domain.DismissedReportCase}, nil)
+				storage.EXPECT().ShowContent(gomock.Any(), domain.PostModerationTarget, uint(2)).Return(nil)
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), &domain.ModerationLogEntry{
+					ModeratorID: 1,
+					Action:      domain.DismissReportsModerationAction,
+					TargetType:  domain.PostModerationTarget,
+					TargetID:    2,
+					Reason:      "fine",
+				}).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name: "Test group case",
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().DismissReportCase(gomock.Any(), uint(3), uint(1)).Return(&domain.ReportCase{ID: 3, TargetType: domain.GroupModerationTarget, TargetID: 2, Status: domain.DismissedReportCase}, nil)
+				storage.EXPECT().StoreModerationLogEntry(gomock.Any(), gomock.Any()).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+			},
+		},
+		{
+			name: "Test case not open",
+			mock: func(storage *mock_moderation.MockModerationStorage) {
+				storage.EXPECT().DismissReportCase(gomock.Any(), uint(3), uint(1)).Return(nil, errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_moderation.NewMockModerationStorage(ctrl)
+			tt.mock(storage)
+
+			s := moderation.NewModerationService(storage)
+
+			_, err := s.DismissReportCase(context.Background(), 1, 3, "fine")
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package notifications
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	"socio/usecase/chat"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	DefaultNotificationsAmount = uint(20)
+	MaxNotificationsAmount     = uint(100)
+)
+
+type NotificationsStorage interface {
+	StoreNotifications(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error)
+	GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) (notifications []*domain.Notification, err error)
+	GetUnreadNotificationsCount(ctx context.Context, userID uint) (count uint, err error)
+	MarkNotificationsRead(ctx context.Context, userID uint, notificationIDs []uint) (err error)
+	MarkAllNotificationsRead(ctx context.Context, userID uint) (err error)
+}
+
+// ActionWriter delivers notifications through the chat websocket, they are
+// logged in the chat event stream, so reconnected clients get them as well.
+type ActionWriter interface {
+	WriteAction(ctx context.Context, action *chat.Action, receivers []uint) (err error)
+}
+
+type Service struct {
+	NotificationsStorage NotificationsStorage
+	ActionWriter         ActionWriter
+}
+
+//easyjson:json
+type MarkReadInput struct {
+	NotificationIDs []uint `json:"notificationIds"`
+}
+
+//easyjson:json
+type UnreadCountResponse struct {
+	Count uint `json:"count"`
+}
+
+func NewNotificationsService(notificationsStorage NotificationsStorage, actionWriter ActionWriter) (service *Service) {
+	service = &Service{
+		NotificationsStorage: notificationsStorage,
+		ActionWriter:         actionWriter,
+	}
+	return
+}
+
+// Notify records the event of the actor about the target for everyone it
+// concerns: the author of the liked or commented post, the user subscribed to
+// or the subscribers of the group, the users mentioned in the post or in the
+// comment for the first time. The actor is never notified about own events. Stored notifications are pushed to the receivers right away.
+func (s *Service) Notify(ctx context.Context, notificationType domain.NotificationType, actorID, targetID uint) (notifications []*domain.Notification, err error) {
+	switch notificationType {
+	case domain.PostLikeNotification, domain.CommentNotification, domain.SubscriptionNotification, domain.GroupPostNotification,
+		domain.PostMentionNotification, domain.CommentMentionNotification:
+	default:
+		err = errors.ErrInvalidData
+		return
+	}
+
+	notifications, err = s.NotificationsStorage.StoreNotifications(ctx, notificationType, actorID, targetID)
+	if err != nil {
+		return
+	}
+
+	for _, notification := range notifications {
+		var payload []byte
+
+		payload, err = easyjson.Marshal(notification)
+		if err != nil {
+			return
+		}
+
+		err = s.ActionWriter.WriteAction(ctx, &chat.Action{
+			Type:     chat.NotificationAction,
+			Receiver: notification.UserID,
+			Payload:  payload,
+		}, []uint{notification.UserID})
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Service) GetNotifications(ctx context.Context, userID, lastNotificationID, notificationsAmount uint) (notifications []*domain.Notification, err error) {
+	if notificationsAmount == 0 {
+		notificationsAmount = DefaultNotificationsAmount
+	}
+
+	if notificationsAmount > MaxNotificationsAmount {
+		notificationsAmount = MaxNotificationsAmount
+	}
+
+	notifications, err = s.NotificationsStorage.GetNotifications(ctx, userID, lastNotificationID, notificationsAmount)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetUnreadCount(ctx context.Context, userID uint) (count uint, err error) {
+	count, err = s.NotificationsStorage.GetUnreadNotificationsCount(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// MarkRead marks the given notifications of the user as read, all of them if
+// no IDs are given. Read notifications are not grouped with the new events.
+func (s *Service) MarkRead(ctx context.Context, userID uint, notificationIDs []uint) (err error) {
+	if len(notificationIDs) == 0 {
+		err = s.NotificationsStorage.MarkAllNotificationsRead(ctx, userID)
+		return
+	}
+
+	err = s.NotificationsStorage.MarkNotificationsRead(ctx, userID, notificationIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
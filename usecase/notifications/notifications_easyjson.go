@@ -0,0 +1,183 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package notifications
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCcad4d1aDecodeSocioUsecaseNotifications(in *jlexer.Lexer, out *UnreadCountResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCcad4d1aEncodeSocioUsecaseNotifications(out *jwriter.Writer, in UnreadCountResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnreadCountResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCcad4d1aEncodeSocioUsecaseNotifications(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnreadCountResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCcad4d1aEncodeSocioUsecaseNotifications(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnreadCountResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCcad4d1aDecodeSocioUsecaseNotifications(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnreadCountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCcad4d1aDecodeSocioUsecaseNotifications(l, v)
+}
+func easyjsonCcad4d1aDecodeSocioUsecaseNotifications1(in *jlexer.Lexer, out *MarkReadInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notificationIds":
+			if in.IsNull() {
+				in.Skip()
+				out.NotificationIDs = nil
+			} else {
+				in.Delim('[')
+				if out.NotificationIDs == nil {
+					if !in.IsDelim(']') {
+						out.NotificationIDs = make([]uint, 0, 8)
+					} else {
+						out.NotificationIDs = []uint{}
+					}
+				} else {
+					out.NotificationIDs = (out.NotificationIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint
+					v1 = uint(in.Uint())
+					out.NotificationIDs = append(out.NotificationIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCcad4d1aEncodeSocioUsecaseNotifications1(out *jwriter.Writer, in MarkReadInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notificationIds\":"
+		out.RawString(prefix[1:])
+		if in.NotificationIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.NotificationIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarkReadInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCcad4d1aEncodeSocioUsecaseNotifications1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarkReadInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCcad4d1aEncodeSocioUsecaseNotifications1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarkReadInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCcad4d1aDecodeSocioUsecaseNotifications1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarkReadInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCcad4d1aDecodeSocioUsecaseNotifications1(l, v)
+}
@@ -0,0 +1,238 @@
+package notifications_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_notifications "socio/mocks/usecase/notifications"
+	"socio/usecase/chat"
+	"socio/usecase/notifications"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	liked := &domain.Notification{ID: 5, UserID: 2, Type: domain.PostLikeNotification, TargetID: 3, LastActorID: 1, ActorsCount: 6, EventsCount: 6}
+	likedPayload, _ := easyjson.Marshal(liked)
+
+	tests := []struct {
+		name              string
+		notificationType  domain.NotificationType
+		mock              func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter)
+		wantNotifications []*domain.Notification
+		wantErr           error
+	}{
+		{
+			name:             "Test OK",
+			notificationType: domain.PostLikeNotification,
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+				storage.EXPECT().StoreNotifications(gomock.Any(), domain.PostLikeNotification, uint(1), uint(3)).Return([]*domain.Notification{liked}, nil)
+				writer.EXPECT().WriteAction(gomock.Any(), &chat.Action{
+					Type:     chat.NotificationAction,
+					Receiver: 2,
+					Payload:  likedPayload,
+				}, []uint{2}).Return(nil)
+			},
+			wantNotifications: []*domain.Notification{liked},
+			wantErr:           nil,
+		},
+		{
+			name:             "Test nobody to notify",
+			notificationType: domain.GroupPostNotification,
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+				storage.EXPECT().StoreNotifications(gomock.Any(), domain.GroupPostNotification, uint(1), uint(3)).Return(nil, nil)
+			},
+			wantNotifications: nil,
+			wantErr:           nil,
+		},
+		{
+			name:             "Test mention notified before",
+			notificationType: domain.PostMentionNotification,
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+				storage.EXPECT().StoreNotifications(gomock.Any(), domain.PostMentionNotification, uint(1), uint(3)).Return(nil, nil)
+			},
+			wantNotifications: nil,
+			wantErr:           nil,
+		},
+		{
+			name:             "Test unknown type",
+			notificationType: domain.NotificationType("UNKNOWN"),
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+			},
+			wantNotifications: nil,
+			wantErr:           errors.ErrInvalidData,
+		},
+		{
+			name:             "Test storage error",
+			notificationType: domain.CommentNotification,
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+				storage.EXPECT().StoreNotifications(gomock.Any(), domain.CommentNotification, uint(1), uint(3)).Return(nil, errors.ErrInternal)
+			},
+			wantNotifications: nil,
+			wantErr:           errors.ErrInternal,
+		},
+		{
+			name:             "Test write error",
+			notificationType: domain.PostLikeNotification,
+			mock: func(storage *mock_notifications.MockNotificationsStorage, writer *mock_notifications.MockActionWriter) {
+				storage.EXPECT().StoreNotifications(gomock.Any(), domain.PostLikeNotification, uint(1), uint(3)).Return([]*domain.Notification{liked}, nil)
+				writer.EXPECT().WriteAction(gomock.Any(), gomock.Any(), []uint{2}).Return(errors.ErrInternal)
+			},
+			wantNotifications: []*domain.Notification{liked},
+			wantErr:           errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_notifications.NewMockNotificationsStorage(ctrl)
+			writer := mock_notifications.NewMockActionWriter(ctrl)
+			tt.mock(storage, writer)
+
+			s := notifications.NewNotificationsService(storage, writer)
+
+			gotNotifications, err := s.Notify(context.Background(), tt.notificationType, 1, 3)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNotifications, gotNotifications)
+		})
+	}
+}
+
+func TestGetNotifications(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		lastNotificationID  uint
+		notificationsAmount uint
+		mock                func(storage *mock_notifications.MockNotificationsStorage)
+		wantNotifications   []*domain.Notification
+		wantErr             error
+	}{
+		{
+			name:                "Test default amount",
+			lastNotificationID:  0,
+			notificationsAmount: 0,
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(0), notifications.DefaultNotificationsAmount).Return([]*domain.Notification{{ID: 1}}, nil)
+			},
+			wantNotifications: []*domain.Notification{{ID: 1}},
+			wantErr:           nil,
+		},
+		{
+			name:                "Test max amount",
+			lastNotificationID:  10,
+			notificationsAmount: 1000,
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(10), notifications.MaxNotificationsAmount).Return(nil, nil)
+			},
+			wantNotifications: nil,
+			wantErr:           nil,
+		},
+		{
+			name:                "Test error",
+			lastNotificationID:  0,
+			notificationsAmount: 5,
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().GetNotifications(gomock.Any(), uint(1), uint(0), uint(5)).Return(nil, errors.ErrInternal)
+			},
+			wantNotifications: nil,
+			wantErr:           errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_notifications.NewMockNotificationsStorage(ctrl)
+			tt.mock(storage)
+
+			s := notifications.NewNotificationsService(storage, nil)
+
+			gotNotifications, err := s.GetNotifications(context.Background(), 1, tt.lastNotificationID, tt.notificationsAmount)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNotifications, gotNotifications)
+		})
+	}
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mock_notifications.NewMockNotificationsStorage(ctrl)
+	storage.EXPECT().GetUnreadNotificationsCount(gomock.Any(), uint(1)).Return(uint(3), nil)
+
+	s := notifications.NewNotificationsService(storage, nil)
+
+	count, err := s.GetUnreadCount(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), count)
+}
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		notificationIDs []uint
+		mock            func(storage *mock_notifications.MockNotificationsStorage)
+		wantErr         error
+	}{
+		{
+			name:            "Test mark some",
+			notificationIDs: []uint{2, 3},
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().MarkNotificationsRead(gomock.Any(), uint(1), []uint{2, 3}).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:            "Test mark all",
+			notificationIDs: nil,
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().MarkAllNotificationsRead(gomock.Any(), uint(1)).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:            "Test error",
+			notificationIDs: []uint{2},
+			mock: func(storage *mock_notifications.MockNotificationsStorage) {
+				storage.EXPECT().MarkNotificationsRead(gomock.Any(), uint(1), []uint{2}).Return(errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_notifications.NewMockNotificationsStorage(ctrl)
+			tt.mock(storage)
+
+			s := notifications.NewNotificationsService(storage, nil)
+
+			err := s.MarkRead(context.Background(), 1, tt.notificationIDs)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package posts
+
+import (
+	"context"
+	"socio/errors"
+)
+
+// checkNotBlocked returns errors.ErrForbidden when the user and the author of
+// the post blocked one another, the user can not comment on or like the post.
+func (s *Service) checkNotBlocked(ctx context.Context, userID, authorID uint) (err error) {
+	if userID == authorID {
+		return
+	}
+
+	blocked, err := s.PostsStorage.IsBlocked(ctx, userID, authorID)
+	if err != nil {
+		return
+	}
+
+	if blocked {
+		err = errors.ErrForbidden
+		return
+	}
+
+	return
+}
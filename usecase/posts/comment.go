@@ -4,26 +4,94 @@ import (
 	"context"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contentfilter"
 )
 
-func (s *Service) GetCommentsByPostID(ctx context.Context, postID uint) (comments []*domain.Comment, err error) {
+// GetCommentsByPostID returns a page of top level comments of the post, each
+// of them with a preview of its first replies.
+func (s *Service) GetCommentsByPostID(ctx context.Context, postID, lastCommentID, commentsAmount uint) (comments []*domain.Comment, err error) {
 	_, err = s.PostsStorage.GetPostByID(ctx, postID)
 	if err != nil {
 		return
 	}
 
-	comments, err = s.PostsStorage.GetCommentsByPostID(ctx, postID)
+	if commentsAmount == 0 || commentsAmount > MaxCommentsAmount {
+		commentsAmount = DefaultCommentsAmount
+	}
+
+	comments, err = s.PostsStorage.GetCommentsByPostID(ctx, postID, lastCommentID, commentsAmount)
+	if err != nil {
+		return
+	}
+
+	commentIDs := make([]uint, 0, len(comments))
+	commentsByID := make(map[uint]*domain.Comment, len(comments))
+	for _, comment := range comments {
+		if comment.RepliesCount == 0 {
+			continue
+		}
+
+		commentIDs = append(commentIDs, comment.ID)
+		commentsByID[comment.ID] = comment
+	}
+
+	replies, err := s.PostsStorage.GetCommentsRepliesPreview(ctx, commentIDs, CommentRepliesPreviewAmount)
 	if err != nil {
 		return
 	}
 
+	for _, reply := range replies {
+		parent, ok := commentsByID[reply.ParentID]
+		if !ok {
+			continue
+		}
+
+		parent.Replies = append(parent.Replies, reply)
+	}
+
 	for _, comment := range comments {
 		s.Sanitizer.SanitizeComment(comment)
 	}
 
+	err = s.attachCommentEntities(ctx, comments)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCommentReplies returns a page of direct replies of the comment.
+func (s *Service) GetCommentReplies(ctx context.Context, commentID, lastReplyID, repliesAmount uint) (replies []*domain.Comment, err error) {
+	_, err = s.PostsStorage.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return
+	}
+
+	if repliesAmount == 0 || repliesAmount > MaxCommentsAmount {
+		repliesAmount = DefaultCommentsAmount
+	}
+
+	replies, err = s.PostsStorage.GetCommentReplies(ctx, commentID, lastReplyID, repliesAmount)
+	if err != nil {
+		return
+	}
+
+	for _, reply := range replies {
+		s.Sanitizer.SanitizeComment(reply)
+	}
+
+	err = s.attachCommentEntities(ctx, replies)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
+// CreateComment stores a top level comment or, if ParentID is set, a reply.
+// Replies deeper than MaxCommentDepth are attached to the parent of the
+// replied comment, so threads never grow deeper.
 func (s *Service) CreateComment(ctx context.Context, comment *domain.Comment) (newComment *domain.Comment, err error) {
 	s.Sanitizer.SanitizeComment(comment)
 
@@ -32,18 +100,64 @@ func (s *Service) CreateComment(ctx context.Context, comment *domain.Comment) (n
 		return
 	}
 
-	_, err = s.PostsStorage.GetPostByID(ctx, comment.PostID)
+	err = s.checkCanViewPost(ctx, comment.PostID, comment.AuthorID)
 	if err != nil {
 		return
 	}
 
+	post, err := s.PostsStorage.GetPostByID(ctx, comment.PostID)
+	if err != nil {
+		return
+	}
+
+	err = s.checkNotBlocked(ctx, comment.AuthorID, post.AuthorID)
+	if err != nil {
+		return
+	}
+
+	decision := s.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.CommentContent, AuthorID: comment.AuthorID, Text: comment.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		err = decision.Err
+		return
+	}
+
+	comment.Depth = 0
+	if comment.ParentID != 0 {
+		parent, err := s.PostsStorage.GetCommentByID(ctx, comment.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.PostID != comment.PostID {
+			return nil, errors.ErrInvalidData
+		}
+
+		comment.Depth = parent.Depth + 1
+		if parent.Depth >= MaxCommentDepth {
+			comment.ParentID = parent.ParentID
+			comment.Depth = parent.Depth
+		}
+	}
+
+	comment.IsHidden = decision.Verdict == contentfilter.HoldVerdict
+
 	newComment, err = s.PostsStorage.StoreComment(ctx, comment)
 	if err != nil {
 		return
 	}
 
+	err = s.storeCommentEntities(ctx, newComment.ID, newComment.Content, "")
+	if err != nil {
+		return
+	}
+
 	s.Sanitizer.SanitizeComment(newComment)
 
+	err = s.attachCommentEntities(ctx, []*domain.Comment{newComment})
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -63,13 +177,31 @@ func (s *Service) UpdateComment(ctx context.Context, comment *domain.Comment) (u
 		return
 	}
 
+	decision := s.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.CommentContent, AuthorID: comment.AuthorID, Text: comment.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		err = decision.Err
+		return
+	}
+
+	comment.IsHidden = decision.Verdict == contentfilter.HoldVerdict
+
 	updatedComment, err = s.PostsStorage.UpdateComment(ctx, comment)
 	if err != nil {
 		return
 	}
 
+	err = s.storeCommentEntities(ctx, updatedComment.ID, updatedComment.Content, oldComment.Content)
+	if err != nil {
+		return
+	}
+
 	s.Sanitizer.SanitizeComment(updatedComment)
 
+	err = s.attachCommentEntities(ctx, []*domain.Comment{updatedComment})
+	if err != nil {
+		return
+	}
+
 	return
 }
 
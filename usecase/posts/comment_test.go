@@ -5,6 +5,7 @@ import (
 	"socio/domain"
 	"socio/errors"
 	mock_posts "socio/mocks/usecase/posts"
+	"socio/pkg/contentfilter"
 	customtime "socio/pkg/time"
 	"socio/usecase/posts"
 	"testing"
@@ -22,24 +23,49 @@ func TestGetCommentsByPostID(t *testing.T) {
 	tp := customtime.MockTimeProvider{}
 
 	tests := []struct {
-		name    string
-		postID  uint
-		want    []*domain.Comment
-		wantErr bool
-		setup   func()
+		name           string
+		postID         uint
+		lastCommentID  uint
+		commentsAmount uint
+		want           []*domain.Comment
+		wantErr        bool
+		setup          func()
 	}{
 		{
-			name:   "test case 1 - successful retrieval",
-			postID: 1,
+			name:           "test case 1 - successful retrieval",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 10,
 			want: []*domain.Comment{
 				{
-					ID:         1,
-					PostID:     1,
-					AuthorID:   1,
-					Content:    "Sanitized comment",
-					LikedByIDs: []uint64{2},
-					CreatedAt:  customtime.CustomTime{Time: tp.Now()},
-					UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+					ID:           1,
+					PostID:       1,
+					AuthorID:     1,
+					Content:      "Sanitized comment",
+					RepliesCount: 1,
+					LikedByIDs:   []uint64{2},
+					CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+					Replies: []*domain.Comment{
+						{
+							ID:        3,
+							PostID:    1,
+							AuthorID:  2,
+							ParentID:  1,
+							Depth:     1,
+							Content:   "Sanitized reply",
+							CreatedAt: customtime.CustomTime{Time: tp.Now()},
+							UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+						},
+					},
+				},
+				{
+					ID:        2,
+					PostID:    1,
+					AuthorID:  1,
+					Content:   "Sanitized comment",
+					CreatedAt: customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt: customtime.CustomTime{Time: tp.Now()},
 				},
 			},
 			wantErr: false,
@@ -50,24 +76,48 @@ func TestGetCommentsByPostID(t *testing.T) {
 					Content:  "Sanitized post",
 				}, nil)
 
-				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1)).Return([]*domain.Comment{
+				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1), uint(0), uint(10)).Return([]*domain.Comment{
 					{
-						ID:         1,
-						PostID:     1,
-						AuthorID:   1,
-						Content:    "Sanitized comment",
-						LikedByIDs: []uint64{2},
-						CreatedAt:  customtime.CustomTime{Time: tp.Now()},
-						UpdatedAt:  customtime.CustomTime{Time: tp.Now()},
+						ID:           1,
+						PostID:       1,
+						AuthorID:     1,
+						Content:      "Sanitized comment",
+						RepliesCount: 1,
+						LikedByIDs:   []uint64{2},
+						CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt:    customtime.CustomTime{Time: tp.Now()},
+					},
+					{
+						ID:        2,
+						PostID:    1,
+						AuthorID:  1,
+						Content:   "Sanitized comment",
+						CreatedAt: customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+					},
+				}, nil)
+
+				mockPostsStorage.EXPECT().GetCommentsRepliesPreview(gomock.Any(), []uint{1}, posts.CommentRepliesPreviewAmount).Return([]*domain.Comment{
+					{
+						ID:        3,
+						PostID:    1,
+						AuthorID:  2,
+						ParentID:  1,
+						Depth:     1,
+						Content:   "Sanitized reply",
+						CreatedAt: customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt: customtime.CustomTime{Time: tp.Now()},
 					},
 				}, nil)
 			},
 		},
 		{
-			name:    "test case 2",
-			postID:  1,
-			want:    nil,
-			wantErr: true,
+			name:           "test case 2 - default amount",
+			postID:         1,
+			lastCommentID:  5,
+			commentsAmount: 0,
+			want:           nil,
+			wantErr:        false,
 			setup: func() {
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
 					ID:       1,
@@ -75,18 +125,58 @@ func TestGetCommentsByPostID(t *testing.T) {
 					Content:  "Sanitized post",
 				}, nil)
 
-				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
+				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1), uint(5), posts.DefaultCommentsAmount).Return(nil, nil)
+				mockPostsStorage.EXPECT().GetCommentsRepliesPreview(gomock.Any(), []uint{}, posts.CommentRepliesPreviewAmount).Return(nil, nil)
 			},
 		},
 		{
-			name:    "test case 3",
-			postID:  1,
-			want:    nil,
-			wantErr: true,
+			name:           "test case 3",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 10,
+			want:           nil,
+			wantErr:        true,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
+					ID:       1,
+					AuthorID: 1,
+					Content:  "Sanitized post",
+				}, nil)
+
+				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1), uint(0), uint(10)).Return(nil, errors.ErrNotFound)
+			},
+		},
+		{
+			name:           "test case 4",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 10,
+			want:           nil,
+			wantErr:        true,
 			setup: func() {
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
 			},
 		},
+		{
+			name:           "test case 5",
+			postID:         1,
+			lastCommentID:  0,
+			commentsAmount: 10,
+			want:           nil,
+			wantErr:        true,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
+					ID:       1,
+					AuthorID: 1,
+					Content:  "Sanitized post",
+				}, nil)
+
+				mockPostsStorage.EXPECT().GetCommentsByPostID(gomock.Any(), uint(1), uint(0), uint(10)).Return([]*domain.Comment{
+					{ID: 1, PostID: 1, RepliesCount: 2},
+				}, nil)
+				mockPostsStorage.EXPECT().GetCommentsRepliesPreview(gomock.Any(), []uint{1}, posts.CommentRepliesPreviewAmount).Return(nil, errors.ErrInternal)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -95,7 +185,7 @@ func TestGetCommentsByPostID(t *testing.T) {
 
 			s := posts.NewPostsService(mockPostsStorage, nil)
 
-			got, err := s.GetCommentsByPostID(context.Background(), tt.postID)
+			got, err := s.GetCommentsByPostID(context.Background(), tt.postID, tt.lastCommentID, tt.commentsAmount)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetCommentsByPostID() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -108,6 +198,102 @@ func TestGetCommentsByPostID(t *testing.T) {
 	}
 }
 
+func TestGetCommentReplies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name          string
+		commentID     uint
+		lastReplyID   uint
+		repliesAmount uint
+		want          []*domain.Comment
+		wantErr       bool
+		setup         func()
+	}{
+		{
+			name:          "test case 1 - successful retrieval",
+			commentID:     1,
+			lastReplyID:   3,
+			repliesAmount: 1000,
+			want: []*domain.Comment{
+				{
+					ID:        4,
+					PostID:    1,
+					AuthorID:  2,
+					ParentID:  1,
+					Depth:     1,
+					Content:   "Sanitized reply",
+					CreatedAt: customtime.CustomTime{Time: tp.Now()},
+					UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+				},
+			},
+			wantErr: false,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(1)).Return(&domain.Comment{ID: 1, PostID: 1}, nil)
+
+				mockPostsStorage.EXPECT().GetCommentReplies(gomock.Any(), uint(1), uint(3), posts.DefaultCommentsAmount).Return([]*domain.Comment{
+					{
+						ID:        4,
+						PostID:    1,
+						AuthorID:  2,
+						ParentID:  1,
+						Depth:     1,
+						Content:   "Sanitized reply",
+						CreatedAt: customtime.CustomTime{Time: tp.Now()},
+						UpdatedAt: customtime.CustomTime{Time: tp.Now()},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:          "test case 2",
+			commentID:     1,
+			lastReplyID:   0,
+			repliesAmount: 10,
+			want:          nil,
+			wantErr:       true,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
+			},
+		},
+		{
+			name:          "test case 3",
+			commentID:     1,
+			lastReplyID:   0,
+			repliesAmount: 10,
+			want:          nil,
+			wantErr:       true,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(1)).Return(&domain.Comment{ID: 1, PostID: 1}, nil)
+				mockPostsStorage.EXPECT().GetCommentReplies(gomock.Any(), uint(1), uint(0), uint(10)).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			s := posts.NewPostsService(mockPostsStorage, nil)
+
+			got, err := s.GetCommentReplies(context.Background(), tt.commentID, tt.lastReplyID, tt.repliesAmount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCommentReplies() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func TestCreateComment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -126,8 +312,9 @@ func TestCreateComment(t *testing.T) {
 		{
 			name: "test case 1 - successful creation",
 			comment: &domain.Comment{
-				PostID:  1,
-				Content: "Sanitized comment",
+				PostID:   1,
+				AuthorID: 2,
+				Content:  "Sanitized comment",
 			},
 			want: &domain.Comment{
 				ID:         1,
@@ -140,10 +327,12 @@ func TestCreateComment(t *testing.T) {
 			},
 			wantErr: false,
 			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
 					ID:       1,
 					AuthorID: 1,
 				}, nil)
+				mockPostsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
 
 				mockPostsStorage.EXPECT().StoreComment(gomock.Any(), gomock.Any()).Return(&domain.Comment{
 					ID:         1,
@@ -159,20 +348,41 @@ func TestCreateComment(t *testing.T) {
 		{
 			name: "test case 2",
 			comment: &domain.Comment{
-				PostID:  1,
-				Content: "Sanitized comment",
+				PostID:   1,
+				AuthorID: 2,
+				Content:  "Sanitized comment",
 			},
 			want:    nil,
 			wantErr: true,
 			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
 					ID:       1,
 					AuthorID: 1,
 				}, nil)
+				mockPostsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
 
 				mockPostsStorage.EXPECT().StoreComment(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotFound)
 			},
 		},
+		{
+			name: "test case - blocked by the post author",
+			comment: &domain.Comment{
+				PostID:   1,
+				AuthorID: 2,
+				Content:  "Sanitized comment",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
+					ID:       1,
+					AuthorID: 1,
+				}, nil)
+				mockPostsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(true, nil)
+			},
+		},
 		{
 			name: "test case 3",
 			comment: &domain.Comment{
@@ -182,9 +392,120 @@ func TestCreateComment(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
 			},
 		},
+		{
+			name: "test case 3 - post hidden from the author",
+			comment: &domain.Comment{
+				PostID:  1,
+				Content: "Sanitized comment",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			name: "test case 4 - reply",
+			comment: &domain.Comment{
+				PostID:   1,
+				ParentID: 2,
+				Content:  "Sanitized reply",
+			},
+			want: &domain.Comment{
+				ID:       3,
+				PostID:   1,
+				ParentID: 2,
+				Depth:    2,
+				Content:  "Sanitized reply",
+			},
+			wantErr: false,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1}, nil)
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(2)).Return(&domain.Comment{ID: 2, PostID: 1, ParentID: 1, Depth: 1}, nil)
+
+				mockPostsStorage.EXPECT().StoreComment(gomock.Any(), &domain.Comment{
+					PostID:   1,
+					ParentID: 2,
+					Depth:    2,
+					Content:  "Sanitized reply",
+				}).Return(&domain.Comment{
+					ID:       3,
+					PostID:   1,
+					ParentID: 2,
+					Depth:    2,
+					Content:  "Sanitized reply",
+				}, nil)
+			},
+		},
+		{
+			name: "test case 5 - reply too deep",
+			comment: &domain.Comment{
+				PostID:   1,
+				ParentID: 6,
+				Content:  "Sanitized reply",
+			},
+			want: &domain.Comment{
+				ID:       7,
+				PostID:   1,
+				ParentID: 5,
+				Depth:    posts.MaxCommentDepth,
+				Content:  "Sanitized reply",
+			},
+			wantErr: false,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1}, nil)
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(6)).Return(&domain.Comment{ID: 6, PostID: 1, ParentID: 5, Depth: posts.MaxCommentDepth}, nil)
+
+				mockPostsStorage.EXPECT().StoreComment(gomock.Any(), &domain.Comment{
+					PostID:   1,
+					ParentID: 5,
+					Depth:    posts.MaxCommentDepth,
+					Content:  "Sanitized reply",
+				}).Return(&domain.Comment{
+					ID:       7,
+					PostID:   1,
+					ParentID: 5,
+					Depth:    posts.MaxCommentDepth,
+					Content:  "Sanitized reply",
+				}, nil)
+			},
+		},
+		{
+			name: "test case 6 - parent from another post",
+			comment: &domain.Comment{
+				PostID:   1,
+				ParentID: 2,
+				Content:  "Sanitized reply",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1}, nil)
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(2)).Return(&domain.Comment{ID: 2, PostID: 3}, nil)
+			},
+		},
+		{
+			name: "test case 7 - parent not found",
+			comment: &domain.Comment{
+				PostID:   1,
+				ParentID: 2,
+				Content:  "Sanitized reply",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func() {
+				mockPostsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1}, nil)
+				mockPostsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(2)).Return(nil, errors.ErrNotFound)
+			},
+		},
 		{
 			name: "test case 3",
 			comment: &domain.Comment{
@@ -218,6 +539,110 @@ func TestCreateComment(t *testing.T) {
 	}
 }
 
+func TestCreateCommentContentFilter(t *testing.T) {
+	t.Parallel()
+
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name    string
+		comment *domain.Comment
+		mock    func(postsStorage *mock_posts.MockPostsStorage)
+		wantErr error
+	}{
+		{
+			name:    "Test banned content",
+			comment: &domain.Comment{PostID: 1, AuthorID: 2, Content: "visit our CASINO"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			},
+			wantErr: errors.ErrBannedContent,
+		},
+		{
+			name:    "Test held for moderation",
+			comment: &domain.Comment{PostID: 1, AuthorID: 2, Content: "https://a.com https://b.com"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+				postsStorage.EXPECT().StoreComment(gomock.Any(), &domain.Comment{PostID: 1, AuthorID: 2, Content: "https://a.com https://b.com", IsHidden: true}).
+					Return(&domain.Comment{ID: 3, PostID: 1, AuthorID: 2, Content: "https://a.com https://b.com", IsHidden: true}, nil)
+				postsStorage.EXPECT().GetCommentMentions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+			tt.mock(postsStorage)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			_, err := s.CreateComment(context.Background(), tt.comment)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestUpdateCommentContentFilter(t *testing.T) {
+	t.Parallel()
+
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name    string
+		comment *domain.Comment
+		mock    func(postsStorage *mock_posts.MockPostsStorage)
+		wantErr error
+	}{
+		{
+			name:    "Test banned content",
+			comment: &domain.Comment{ID: 1, AuthorID: 2, Content: "visit our CASINO"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(1)).Return(&domain.Comment{ID: 1, AuthorID: 2, Content: "Old comment"}, nil)
+			},
+			wantErr: errors.ErrBannedContent,
+		},
+		{
+			name:    "Test held for moderation",
+			comment: &domain.Comment{ID: 1, AuthorID: 2, Content: "https://a.com https://b.com"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetCommentByID(gomock.Any(), uint(1)).Return(&domain.Comment{ID: 1, AuthorID: 2, Content: "Old comment"}, nil)
+				postsStorage.EXPECT().UpdateComment(gomock.Any(), &domain.Comment{ID: 1, AuthorID: 2, Content: "https://a.com https://b.com", IsHidden: true}).
+					Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+			tt.mock(postsStorage)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			_, err := s.UpdateComment(context.Background(), tt.comment)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
 func TestUpdateComment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,354 @@
+package posts
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"socio/domain"
+	"socio/errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	MaxHashtagLength = 64
+
+	DefaultTrendingHashtagsAmount = uint(10)
+	MaxTrendingHashtagsAmount     = uint(50)
+	DefaultTrendingWindow         = 24 * time.Hour
+	MaxTrendingWindow             = 30 * 24 * time.Hour
+)
+
+var (
+	// a hashtag starts the content or follows a character that can not be a
+	// part of a word, "&" keeps the escaped characters like &#39; out
+	hashtagRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&])(#[\p{L}\p{N}_]+)`)
+	// users have no nicknames, so they are mentioned by the page address:
+	// @id42 mentions the user with ID 42
+	mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])(@id([0-9]+))\b`)
+
+	hashtagBodyRegexp = regexp.MustCompile(`^[\p{L}\p{N}_]+$`)
+)
+
+// Mention is the user mentioned in the post or in the comment with TargetID.
+type Mention struct {
+	TargetID uint
+	UserID   uint
+}
+
+//easyjson:json
+type TrendingHashtag struct {
+	Hashtag string `json:"hashtag"`
+	Count   uint   `json:"count"`
+}
+
+// ParseEntities finds the hashtags and the mentions in the content, ordered
+// by their offsets. Hashtags are lowercased, the longer ones than
+// MaxHashtagLength are skipped.
+func ParseEntities(content string) (entities []*domain.Entity) {
+	for _, match := range hashtagRegexp.FindAllStringSubmatchIndex(content, -1) {
+		start, end := match[2], match[3]
+
+		hashtag := strings.ToLower(content[start+1 : end])
+		if utf8.RuneCountInString(hashtag) > MaxHashtagLength {
+			continue
+		}
+
+		entities = append(entities, &domain.Entity{
+			Type:    domain.HashtagEntity,
+			Offset:  utf16Len(content[:start]),
+			Length:  utf16Len(content[start:end]),
+			Hashtag: hashtag,
+		})
+	}
+
+	for _, match := range mentionRegexp.FindAllStringSubmatchIndex(content, -1) {
+		start, end := match[2], match[3]
+
+		userID, err := strconv.ParseUint(content[match[4]:match[5]], 10, 64)
+		if err != nil || userID == 0 {
+			continue
+		}
+
+		entities = append(entities, &domain.Entity{
+			Type:   domain.MentionEntity,
+			Offset: utf16Len(content[:start]),
+			Length: utf16Len(content[start:end]),
+			UserID: uint(userID),
+		})
+	}
+
+	slices.SortFunc(entities, func(a, b *domain.Entity) int {
+		return int(a.Offset) - int(b.Offset)
+	})
+
+	return
+}
+
+// utf16Len returns the length of s in UTF-16 code units, the offsets are
+// counted in them, as the clients index strings this way.
+func utf16Len(s string) uint {
+	return uint(len(utf16.Encode([]rune(s))))
+}
+
+// hashtagsAndMentions returns the distinct hashtags and the distinct IDs of
+// the mentioned users.
+func hashtagsAndMentions(entities []*domain.Entity) (hashtags []string, userIDs []uint) {
+	hashtags = make([]string, 0)
+	userIDs = make([]uint, 0)
+
+	for _, entity := range entities {
+		switch entity.Type {
+		case domain.HashtagEntity:
+			if !slices.Contains(hashtags, entity.Hashtag) {
+				hashtags = append(hashtags, entity.Hashtag)
+			}
+		case domain.MentionEntity:
+			if !slices.Contains(userIDs, entity.UserID) {
+				userIDs = append(userIDs, entity.UserID)
+			}
+		}
+	}
+
+	return
+}
+
+// storePostEntities replaces the stored hashtags and mentions of the post by
+// the ones of the content. The storage is not touched when neither the content
+// nor the previous one has any.
+func (s *Service) storePostEntities(ctx context.Context, postID uint, content, previousContent string) (err error) {
+	entities := ParseEntities(s.Sanitizer.Sanitize(content))
+	if len(entities) == 0 && len(ParseEntities(s.Sanitizer.Sanitize(previousContent))) == 0 {
+		return
+	}
+
+	hashtags, userIDs := hashtagsAndMentions(entities)
+
+	err = s.PostsStorage.StorePostEntities(ctx, postID, hashtags, userIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// storeCommentEntities is storePostEntities for comments.
+func (s *Service) storeCommentEntities(ctx context.Context, commentID uint, content, previousContent string) (err error) {
+	entities := ParseEntities(s.Sanitizer.Sanitize(content))
+	if len(entities) == 0 && len(ParseEntities(s.Sanitizer.Sanitize(previousContent))) == 0 {
+		return
+	}
+
+	hashtags, userIDs := hashtagsAndMentions(entities)
+
+	err = s.PostsStorage.StoreCommentEntities(ctx, commentID, hashtags, userIDs)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// attachEntities fills Entities of the sanitized posts and their originals.
+// Only the mentions of existing users are kept, they are resolved when the
+// content is stored.
+func (s *Service) attachEntities(ctx context.Context, posts []*domain.Post) (err error) {
+	withOriginals := make([]*domain.Post, 0, len(posts))
+	for _, post := range posts {
+		withOriginals = append(withOriginals, post)
+		if post.Original != nil {
+			withOriginals = append(withOriginals, post.Original)
+		}
+	}
+
+	contents := make(map[uint]string, len(withOriginals))
+	for _, post := range withOriginals {
+		contents[post.ID] = post.Content
+	}
+
+	entities, err := s.resolveEntities(ctx, contents, s.PostsStorage.GetPostMentions)
+	if err != nil {
+		return
+	}
+
+	for _, post := range withOriginals {
+		post.Entities = entities[post.ID]
+	}
+
+	return
+}
+
+// attachCommentEntities fills Entities of the sanitized comments and their
+// replies.
+func (s *Service) attachCommentEntities(ctx context.Context, comments []*domain.Comment) (err error) {
+	withReplies := make([]*domain.Comment, 0, len(comments))
+	for _, comment := range comments {
+		withReplies = append(withReplies, comment)
+		withReplies = append(withReplies, comment.Replies...)
+	}
+
+	contents := make(map[uint]string, len(withReplies))
+	for _, comment := range withReplies {
+		contents[comment.ID] = comment.Content
+	}
+
+	entities, err := s.resolveEntities(ctx, contents, s.PostsStorage.GetCommentMentions)
+	if err != nil {
+		return
+	}
+
+	for _, comment := range withReplies {
+		comment.Entities = entities[comment.ID]
+	}
+
+	return
+}
+
+// resolveEntities parses the contents by the IDs of their posts or comments
+// and drops the mentions missing in the storage. getMentions is called only
+// if some content mentions anyone.
+func (s *Service) resolveEntities(ctx context.Context, contents map[uint]string, getMentions func(ctx context.Context, targetIDs []uint) ([]Mention, error)) (entities map[uint][]*domain.Entity, err error) {
+	entities = make(map[uint][]*domain.Entity, len(contents))
+	targetIDs := make([]uint, 0)
+
+	for id, content := range contents {
+		entities[id] = ParseEntities(content)
+
+		_, userIDs := hashtagsAndMentions(entities[id])
+		if len(userIDs) != 0 {
+			targetIDs = append(targetIDs, id)
+		}
+	}
+
+	if len(targetIDs) == 0 {
+		return
+	}
+
+	slices.Sort(targetIDs)
+
+	mentions, err := getMentions(ctx, targetIDs)
+	if err != nil {
+		return
+	}
+
+	mentioned := make(map[Mention]bool, len(mentions))
+	for _, mention := range mentions {
+		mentioned[mention] = true
+	}
+
+	for _, id := range targetIDs {
+		entities[id] = slices.DeleteFunc(entities[id], func(entity *domain.Entity) bool {
+			return entity.Type == domain.MentionEntity && !mentioned[Mention{TargetID: id, UserID: entity.UserID}]
+		})
+	}
+
+	return
+}
+
+// GetPostsByHashtag returns a page of the posts with the hashtag visible to
+// the viewer, the newest first. The hashtag is matched case insensitively,
+// with or without "#".
+func (s *Service) GetPostsByHashtag(ctx context.Context, viewerID uint, hashtag string, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+	hashtag = strings.ToLower(strings.TrimPrefix(hashtag, "#"))
+	if !hashtagBodyRegexp.MatchString(hashtag) || utf8.RuneCountInString(hashtag) > MaxHashtagLength {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	if postsAmount == 0 {
+		postsAmount = DefaultPostsAmount
+	}
+
+	posts, err = s.PostsStorage.GetPostsByHashtag(ctx, viewerID, hashtag, lastPostID, postsAmount)
+	if err != nil {
+		return
+	}
+
+	for _, post := range posts {
+		s.Sanitizer.SanitizePost(post)
+	}
+
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetTrendingHashtags returns the hashtags used in the most posts and comments
+// created during the window before now.
+func (s *Service) GetTrendingHashtags(ctx context.Context, window time.Duration, amount uint) (hashtags []TrendingHashtag, err error) {
+	if window <= 0 {
+		window = DefaultTrendingWindow
+	}
+
+	if window > MaxTrendingWindow {
+		window = MaxTrendingWindow
+	}
+
+	if amount == 0 {
+		amount = DefaultTrendingHashtagsAmount
+	}
+
+	if amount > MaxTrendingHashtagsAmount {
+		amount = MaxTrendingHashtagsAmount
+	}
+
+	hashtags, err = s.PostsStorage.GetTrendingHashtags(ctx, s.TimeProvider.Now().Add(-window), amount)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SearchHashtags returns up to amount hashtags starting with the query after
+// lastHashtag in alphabetical order, each with the amount of the posts and
+// comments using it, and the total amount of the hashtags found. The query is
+// matched case insensitively, with or without "#", the queries no hashtag can
+// start with find nothing.
+func (s *Service) SearchHashtags(ctx context.Context, query, lastHashtag string, amount uint) (hashtags []TrendingHashtag, total uint, err error) {
+	prefix := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(query), "#"))
+	if !hashtagBodyRegexp.MatchString(prefix) || utf8.RuneCountInString(prefix) > MaxHashtagLength {
+		hashtags = []TrendingHashtag{}
+		return
+	}
+
+	if amount == 0 {
+		amount = DefaultSearchAmount
+	}
+
+	if amount > MaxSearchAmount {
+		amount = MaxSearchAmount
+	}
+
+	hashtags, err = s.PostsStorage.SearchHashtags(ctx, prefix, lastHashtag, amount)
+	if err != nil {
+		return
+	}
+
+	// the first page holds every hashtag found when it is not full
+	total = uint(len(hashtags))
+	if lastHashtag != "" || total == amount {
+		total, err = s.PostsStorage.CountSearchHashtags(ctx, prefix)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
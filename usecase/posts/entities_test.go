@@ -0,0 +1,369 @@
+package posts_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_posts "socio/mocks/usecase/posts"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEntities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []*domain.Entity
+	}{
+		{
+			name:    "hashtags and mentions",
+			content: "#Go и @id12, #golang_1",
+			expected: []*domain.Entity{
+				{Type: domain.HashtagEntity, Offset: 0, Length: 3, Hashtag: "go"},
+				{Type: domain.MentionEntity, Offset: 6, Length: 5, UserID: 12},
+				{Type: domain.HashtagEntity, Offset: 13, Length: 9, Hashtag: "golang_1"},
+			},
+		},
+		{
+			name:    "offsets in utf-16 code units",
+			content: "😀 #эмодзи",
+			expected: []*domain.Entity{
+				{Type: domain.HashtagEntity, Offset: 3, Length: 7, Hashtag: "эмодзи"},
+			},
+		},
+		{
+			name:     "parts of words and escaped characters",
+			content:  "a#b mail@id1 I&#39;m @idx @id0 #",
+			expected: nil,
+		},
+		{
+			name:     "too long hashtag",
+			content:  "#" + strings.Repeat("a", posts.MaxHashtagLength+1),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, posts.ParseEntities(tt.content))
+		})
+	}
+}
+
+func TestCreatePostEntities(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+	s := posts.NewPostsService(postsStorage, nil)
+	s.TimeProvider = customtime.MockTimeProvider{}
+
+	content := "#Go @id2 @id3 #go"
+
+	postsStorage.EXPECT().StorePost(gomock.Any(), gomock.Any()).Return(&domain.Post{ID: 1, AuthorID: 1, Content: content}, nil)
+	postsStorage.EXPECT().StorePostEntities(gomock.Any(), uint(1), []string{"go"}, []uint{2, 3}).Return(nil)
+	postsStorage.EXPECT().GetPostMentions(gomock.Any(), []uint{1}).Return([]posts.Mention{{TargetID: 1, UserID: 3}}, nil)
+
+	post, err := s.CreatePost(context.Background(), posts.PostInput{AuthorID: 1, Content: content})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*domain.Entity{
+		{Type: domain.HashtagEntity, Offset: 0, Length: 3, Hashtag: "go"},
+		{Type: domain.MentionEntity, Offset: 9, Length: 4, UserID: 3},
+		{Type: domain.HashtagEntity, Offset: 14, Length: 3, Hashtag: "go"},
+	}, post.Entities)
+}
+
+func TestUpdatePostEntities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(postsStorage *mock_posts.MockPostsStorage)
+		wantErr error
+	}{
+		{
+			name: "removed entities are deleted",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "#go @id2"}, nil)
+				postsStorage.EXPECT().UpdatePost(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				postsStorage.EXPECT().StorePostEntities(gomock.Any(), uint(1), []string{}, []uint{}).Return(nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "text"}, nil)
+			},
+		},
+		{
+			name: "storage error",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "#go"}, nil)
+				postsStorage.EXPECT().UpdatePost(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				postsStorage.EXPECT().StorePostEntities(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(postsStorage)
+
+			_, err := s.UpdatePost(context.Background(), 1, posts.PostUpdateInput{PostID: 1, Content: "text"})
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestCreateCommentEntities(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+	s := posts.NewPostsService(postsStorage, nil)
+	s.TimeProvider = customtime.MockTimeProvider{}
+
+	postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+	postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+	postsStorage.EXPECT().StoreComment(gomock.Any(), gomock.Any()).Return(&domain.Comment{ID: 5, PostID: 1, AuthorID: 1, Content: "@id2 #Go"}, nil)
+	postsStorage.EXPECT().StoreCommentEntities(gomock.Any(), uint(5), []string{"go"}, []uint{2}).Return(nil)
+	postsStorage.EXPECT().GetCommentMentions(gomock.Any(), []uint{5}).Return([]posts.Mention{{TargetID: 5, UserID: 2}}, nil)
+
+	comment, err := s.CreateComment(context.Background(), &domain.Comment{PostID: 1, AuthorID: 1, Content: "@id2 #Go"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*domain.Entity{
+		{Type: domain.MentionEntity, Offset: 0, Length: 4, UserID: 2},
+		{Type: domain.HashtagEntity, Offset: 5, Length: 3, Hashtag: "go"},
+	}, comment.Entities)
+}
+
+func TestGetPostsByHashtag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		hashtag     string
+		mock        func(postsStorage *mock_posts.MockPostsStorage)
+		wantPostIDs []uint
+		wantErr     error
+	}{
+		{
+			name:    "hashtag is normalized",
+			hashtag: "#GoLang",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetPostsByHashtag(gomock.Any(), uint(1), "golang", uint(0), posts.DefaultPostsAmount).Return([]*domain.Post{
+					{ID: 2, Content: "#golang"},
+					{ID: 1, Content: "#GoLang"},
+				}, nil)
+			},
+			wantPostIDs: []uint{2, 1},
+		},
+		{
+			name:    "invalid hashtag",
+			hashtag: "go lang",
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "empty hashtag",
+			hashtag: "#",
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "storage error",
+			hashtag: "go",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetPostsByHashtag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(postsStorage)
+
+			got, err := s.GetPostsByHashtag(context.Background(), 1, tt.hashtag, 0, 0)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotPostIDs := make([]uint, 0, len(got))
+			for _, post := range got {
+				gotPostIDs = append(gotPostIDs, post.ID)
+				assert.NotEmpty(t, post.Entities)
+			}
+
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs)
+		})
+	}
+}
+
+func TestGetTrendingHashtags(t *testing.T) {
+	t.Parallel()
+
+	now := customtime.MockTimeProvider{}.Now()
+
+	tests := []struct {
+		name    string
+		window  time.Duration
+		amount  uint
+		mock    func(postsStorage *mock_posts.MockPostsStorage)
+		want    []posts.TrendingHashtag
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetTrendingHashtags(gomock.Any(), now.Add(-posts.DefaultTrendingWindow), posts.DefaultTrendingHashtagsAmount).Return([]posts.TrendingHashtag{{Hashtag: "go", Count: 2}}, nil)
+			},
+			want: []posts.TrendingHashtag{{Hashtag: "go", Count: 2}},
+		},
+		{
+			name:   "window and amount are limited",
+			window: 365 * 24 * time.Hour,
+			amount: 1000,
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetTrendingHashtags(gomock.Any(), now.Add(-posts.MaxTrendingWindow), posts.MaxTrendingHashtagsAmount).Return(nil, nil)
+			},
+		},
+		{
+			name:   "storage error",
+			window: time.Hour,
+			amount: 5,
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetTrendingHashtags(gomock.Any(), now.Add(-time.Hour), uint(5)).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(postsStorage)
+
+			got, err := s.GetTrendingHashtags(context.Background(), tt.window, tt.amount)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearchHashtags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		lastHashtag string
+		amount      uint
+		mock        func(postsStorage *mock_posts.MockPostsStorage)
+		want        []posts.TrendingHashtag
+		wantTotal   uint
+		wantErr     error
+	}{
+		{
+			name:  "only page",
+			query: " #Go",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchHashtags(gomock.Any(), "go", "", posts.DefaultSearchAmount).Return([]posts.TrendingHashtag{{Hashtag: "go", Count: 2}, {Hashtag: "golang", Count: 1}}, nil)
+			},
+			want:      []posts.TrendingHashtag{{Hashtag: "go", Count: 2}, {Hashtag: "golang", Count: 1}},
+			wantTotal: 2,
+		},
+		{
+			name:        "next page is counted",
+			query:       "go",
+			lastHashtag: "go",
+			amount:      1000,
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchHashtags(gomock.Any(), "go", "go", posts.MaxSearchAmount).Return([]posts.TrendingHashtag{{Hashtag: "golang", Count: 1}}, nil)
+				postsStorage.EXPECT().CountSearchHashtags(gomock.Any(), "go").Return(uint(2), nil)
+			},
+			want:      []posts.TrendingHashtag{{Hashtag: "golang", Count: 1}},
+			wantTotal: 2,
+		},
+		{
+			name:  "not a hashtag",
+			query: "john smith",
+			mock:  func(postsStorage *mock_posts.MockPostsStorage) {},
+			want:  []posts.TrendingHashtag{},
+		},
+		{
+			name:  "storage error",
+			query: "go",
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchHashtags(gomock.Any(), "go", "", posts.DefaultSearchAmount).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+		{
+			name:   "count error",
+			query:  "go",
+			amount: 1,
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchHashtags(gomock.Any(), "go", "", uint(1)).Return([]posts.TrendingHashtag{{Hashtag: "go", Count: 2}}, nil)
+				postsStorage.EXPECT().CountSearchHashtags(gomock.Any(), "go").Return(uint(0), errors.ErrInternal)
+			},
+			want:    []posts.TrendingHashtag{{Hashtag: "go", Count: 2}},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+
+			tt.mock(postsStorage)
+
+			got, gotTotal, err := s.SearchHashtags(context.Background(), tt.query, tt.lastHashtag, tt.amount)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, gotTotal)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package posts
+
+import (
+	"context"
+	"encoding/base64"
+	"math"
+	"socio/domain"
+	"socio/errors"
+	"sort"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	// FeedCandidatesWindow limits ranking to the recent posts, older ones are
+	// left to the chronological endpoints.
+	FeedCandidatesWindow = 7 * 24 * time.Hour
+	FeedCandidatesLimit  = uint(500)
+
+	// FeedHalfLife is the age at which the score of a post is halved.
+	FeedHalfLife = 24 * time.Hour
+
+	feedLikeWeight            = 1.0
+	feedCommentWeight         = 2.0
+	feedMessageAffinityWeight = 0.5
+	feedLikeAffinityWeight    = 1.0
+)
+
+// FeedCandidate is a post that may get into the feed along with the counters
+// not stored in the post itself. The counters are taken at the feed snapshot,
+// unlike the likes of the post.
+type FeedCandidate struct {
+	Post          *domain.Post
+	LikesCount    uint
+	CommentsCount uint
+}
+
+// AuthorAffinity describes how close the feed owner is to the author of a post,
+// as of the feed snapshot.
+type AuthorAffinity struct {
+	AuthorID      uint
+	MessagesCount uint
+	LikesCount    uint
+}
+
+type FeedInput struct {
+	UserID      uint
+	GroupSubIDs []uint
+	UserSubIDs  []uint
+	Cursor      string
+	PostsAmount uint
+}
+
+// FeedCursor points at the last post of the previous page by its score and ID.
+// All pages are ranked at SnapshotAt: the candidates, the age decay and the
+// likes, comments and messages behind the scores are the ones created by then,
+// so the engagement that comes in while paging waits for the next refresh.
+//
+//easyjson:json
+type FeedCursor struct {
+	SnapshotAt int64   `json:"s"`
+	Score      float64 `json:"r"`
+	PostID     uint    `json:"p"`
+}
+
+type rankedPost struct {
+	post  *domain.Post
+	score float64
+}
+
+// FeedScore ranks the candidate: engagement and author affinity are taken
+// logarithmically, so that a single viral post or a chatty friend does not
+// take the whole feed, and the result decays exponentially with the post age.
+func FeedScore(candidate FeedCandidate, affinity AuthorAffinity, now time.Time) (score float64) {
+	engagement := 1 +
+		feedLikeWeight*math.Log1p(float64(candidate.LikesCount)) +
+		feedCommentWeight*math.Log1p(float64(candidate.CommentsCount))
+
+	closeness := 1 +
+		feedMessageAffinityWeight*math.Log1p(float64(affinity.MessagesCount)) +
+		feedLikeAffinityWeight*math.Log1p(float64(affinity.LikesCount))
+
+	age := now.Sub(candidate.Post.CreatedAt.Time)
+	if age < 0 {
+		age = 0
+	}
+
+	decay := math.Exp2(-float64(age) / float64(FeedHalfLife))
+
+	score = engagement * closeness * decay
+	return
+}
+
+func EncodeFeedCursor(cursor FeedCursor) (encoded string, err error) {
+	data, err := easyjson.Marshal(cursor)
+	if err != nil {
+		return
+	}
+
+	encoded = base64.RawURLEncoding.EncodeToString(data)
+	return
+}
+
+func DecodeFeedCursor(encoded string) (cursor FeedCursor, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = easyjson.Unmarshal(data, &cursor)
+	if err != nil || cursor.SnapshotAt == 0 {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
+
+// GetFeed returns the page of the subscriptions feed ordered by FeedScore
+// and the cursor of the next page, which is empty on the last one.
+func (s *Service) GetFeed(ctx context.Context, input FeedInput) (posts []*domain.Post, nextCursor string, err error) {
+	if input.PostsAmount == 0 {
+		input.PostsAmount = DefaultPostsAmount
+	}
+
+	var cursor FeedCursor
+	if input.Cursor != "" {
+		cursor, err = DecodeFeedCursor(input.Cursor)
+		if err != nil {
+			return
+		}
+	} else {
+		cursor.SnapshotAt = s.TimeProvider.Now().UnixNano()
+	}
+
+	snapshot := time.Unix(0, cursor.SnapshotAt).UTC()
+
+	candidates, err := s.PostsStorage.GetFeedCandidates(ctx, input.UserID, input.GroupSubIDs, input.UserSubIDs, snapshot.Add(-FeedCandidatesWindow), snapshot, FeedCandidatesLimit)
+	if err != nil {
+		return
+	}
+
+	posts = make([]*domain.Post, 0)
+	if len(candidates) == 0 {
+		return
+	}
+
+	authorIDs := make([]uint, 0, len(candidates))
+	seenAuthors := make(map[uint]bool, len(candidates))
+	for _, candidate := range candidates {
+		if !seenAuthors[candidate.Post.AuthorID] {
+			seenAuthors[candidate.Post.AuthorID] = true
+			authorIDs = append(authorIDs, candidate.Post.AuthorID)
+		}
+	}
+
+	affinities, err := s.PostsStorage.GetAuthorAffinities(ctx, input.UserID, authorIDs, snapshot)
+	if err != nil {
+		return
+	}
+
+	affinityByAuthor := make(map[uint]AuthorAffinity, len(affinities))
+	for _, affinity := range affinities {
+		affinityByAuthor[affinity.AuthorID] = affinity
+	}
+
+	ranked := make([]rankedPost, 0, len(candidates))
+	for _, candidate := range candidates {
+		score := FeedScore(candidate, affinityByAuthor[candidate.Post.AuthorID], snapshot)
+
+		if input.Cursor != "" && (score > cursor.Score || score == cursor.Score && candidate.Post.ID >= cursor.PostID) {
+			continue
+		}
+
+		ranked = append(ranked, rankedPost{
+			post:  candidate.Post,
+			score: score,
+		})
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+
+		return ranked[i].post.ID > ranked[j].post.ID
+	})
+
+	if uint(len(ranked)) > input.PostsAmount {
+		ranked = ranked[:input.PostsAmount]
+
+		last := ranked[len(ranked)-1]
+		nextCursor, err = EncodeFeedCursor(FeedCursor{
+			SnapshotAt: cursor.SnapshotAt,
+			Score:      last.score,
+			PostID:     last.post.ID,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	for _, rp := range ranked {
+		s.Sanitizer.SanitizePost(rp.post)
+		posts = append(posts, rp.post)
+	}
+
+	err = s.attachOriginals(ctx, input.UserID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	return
+}
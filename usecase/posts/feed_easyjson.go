@@ -0,0 +1,99 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package posts
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD77e0694DecodeSocioUsecasePosts(in *jlexer.Lexer, out *FeedCursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "s":
+			out.SnapshotAt = int64(in.Int64())
+		case "r":
+			out.Score = float64(in.Float64())
+		case "p":
+			out.PostID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD77e0694EncodeSocioUsecasePosts(out *jwriter.Writer, in FeedCursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.SnapshotAt))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.PostID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeedCursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD77e0694EncodeSocioUsecasePosts(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeedCursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD77e0694EncodeSocioUsecasePosts(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeedCursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD77e0694DecodeSocioUsecasePosts(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeedCursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD77e0694DecodeSocioUsecasePosts(l, v)
+}
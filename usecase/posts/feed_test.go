@@ -0,0 +1,229 @@
+package posts_test
+
+import (
+	"context"
+	"math"
+	"socio/domain"
+	"socio/errors"
+	mock_posts "socio/mocks/usecase/posts"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedScore(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	tests := []struct {
+		name      string
+		candidate posts.FeedCandidate
+		affinity  posts.AuthorAffinity
+		wantScore float64
+	}{
+		{
+			name: "fresh post without engagement",
+			candidate: posts.FeedCandidate{
+				Post: &domain.Post{CreatedAt: customtime.CustomTime{Time: tp.Now()}},
+			},
+			wantScore: 1,
+		},
+		{
+			name: "post of the future is not boosted",
+			candidate: posts.FeedCandidate{
+				Post: &domain.Post{CreatedAt: customtime.CustomTime{Time: tp.Now().Add(time.Hour)}},
+			},
+			wantScore: 1,
+		},
+		{
+			name: "post is halved every half life",
+			candidate: posts.FeedCandidate{
+				Post: &domain.Post{CreatedAt: customtime.CustomTime{Time: tp.Now().Add(-2 * posts.FeedHalfLife)}},
+			},
+			wantScore: 0.25,
+		},
+		{
+			name: "likes and comments",
+			candidate: posts.FeedCandidate{
+				Post: &domain.Post{
+					LikedByIDs: []uint64{1, 2, 3, 4},
+					CreatedAt:  customtime.CustomTime{Time: tp.Now()},
+				},
+				LikesCount:    3,
+				CommentsCount: 1,
+			},
+			wantScore: 1 + math.Log(4) + 2*math.Log(2),
+		},
+		{
+			name: "author affinity",
+			candidate: posts.FeedCandidate{
+				Post: &domain.Post{CreatedAt: customtime.CustomTime{Time: tp.Now().Add(-posts.FeedHalfLife)}},
+			},
+			affinity:  posts.AuthorAffinity{MessagesCount: 3, LikesCount: 1},
+			wantScore: (1 + 0.5*math.Log(4) + math.Log(2)) / 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotScore := posts.FeedScore(tt.candidate, tt.affinity, tp.Now())
+
+			assert.InDelta(t, tt.wantScore, gotScore, 1e-9)
+		})
+	}
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+
+	tp := customtime.MockTimeProvider{}
+
+	// 1: old, but liked and commented, author 2 is a stranger
+	// 2: fresh without engagement, author 3 is a stranger
+	// 3: a day old without engagement, author 4 is a close friend
+	// 4: same score as 2, goes first as the newer one
+	newCandidates := func() []posts.FeedCandidate {
+		return []posts.FeedCandidate{
+			{Post: &domain.Post{ID: 1, AuthorID: 2, LikedByIDs: []uint64{5, 6, 7}, CreatedAt: customtime.CustomTime{Time: tp.Now().Add(-48 * time.Hour)}}, LikesCount: 3, CommentsCount: 3},
+			{Post: &domain.Post{ID: 2, AuthorID: 3, CreatedAt: customtime.CustomTime{Time: tp.Now()}}},
+			{Post: &domain.Post{ID: 3, AuthorID: 4, CreatedAt: customtime.CustomTime{Time: tp.Now().Add(-24 * time.Hour)}}},
+			{Post: &domain.Post{ID: 4, AuthorID: 3, CreatedAt: customtime.CustomTime{Time: tp.Now()}}},
+		}
+	}
+	affinities := []posts.AuthorAffinity{
+		{AuthorID: 2},
+		{AuthorID: 3},
+		{AuthorID: 4, MessagesCount: 15, LikesCount: 3},
+	}
+
+	firstPostScore := posts.FeedScore(newCandidates()[0], affinities[0], tp.Now())
+	secondPageCursor, err := posts.EncodeFeedCursor(posts.FeedCursor{
+		SnapshotAt: tp.Now().UnixNano(),
+		Score:      firstPostScore,
+		PostID:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		input          posts.FeedInput
+		mock           func(postsStorage *mock_posts.MockPostsStorage)
+		wantPostIDs    []uint
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name:  "first page",
+			input: posts.FeedInput{UserID: 1, GroupSubIDs: []uint{1}, UserSubIDs: []uint{2, 3, 4}, PostsAmount: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), uint(1), []uint{1}, []uint{2, 3, 4}, tp.Now().Add(-posts.FeedCandidatesWindow), tp.Now(), posts.FeedCandidatesLimit).Return(newCandidates(), nil)
+				postsStorage.EXPECT().GetAuthorAffinities(gomock.Any(), uint(1), []uint{2, 3, 4}, tp.Now()).Return(affinities, nil)
+			},
+			wantPostIDs:    []uint{3, 1},
+			wantNextCursor: secondPageCursor,
+			wantErr:        nil,
+		},
+		{
+			name:  "second page is ranked at the snapshot of the first one",
+			input: posts.FeedInput{UserID: 1, GroupSubIDs: []uint{1}, UserSubIDs: []uint{2, 3, 4}, Cursor: secondPageCursor, PostsAmount: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), uint(1), []uint{1}, []uint{2, 3, 4}, tp.Now().Add(-posts.FeedCandidatesWindow), tp.Now(), posts.FeedCandidatesLimit).Return(newCandidates(), nil)
+				postsStorage.EXPECT().GetAuthorAffinities(gomock.Any(), uint(1), []uint{2, 3, 4}, tp.Now()).Return(affinities, nil)
+			},
+			wantPostIDs:    []uint{4, 2},
+			wantNextCursor: "",
+			wantErr:        nil,
+		},
+		{
+			name:  "like added between the pages does not move the second one",
+			input: posts.FeedInput{UserID: 1, GroupSubIDs: []uint{1}, UserSubIDs: []uint{2, 3, 4}, Cursor: secondPageCursor, PostsAmount: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				candidates := newCandidates()
+				candidates[1].Post.LikedByIDs = []uint64{8, 9}
+				candidates[3].Post.LikedByIDs = []uint64{8}
+
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), uint(1), []uint{1}, []uint{2, 3, 4}, tp.Now().Add(-posts.FeedCandidatesWindow), tp.Now(), posts.FeedCandidatesLimit).Return(candidates, nil)
+				postsStorage.EXPECT().GetAuthorAffinities(gomock.Any(), uint(1), []uint{2, 3, 4}, tp.Now()).Return(affinities, nil)
+			},
+			wantPostIDs:    []uint{4, 2},
+			wantNextCursor: "",
+			wantErr:        nil,
+		},
+		{
+			name:  "no candidates",
+			input: posts.FeedInput{UserID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantPostIDs:    []uint{},
+			wantNextCursor: "",
+			wantErr:        nil,
+		},
+		{
+			name:           "invalid cursor",
+			input:          posts.FeedInput{UserID: 1, Cursor: "not a cursor"},
+			mock:           func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantPostIDs:    nil,
+			wantNextCursor: "",
+			wantErr:        errors.ErrInvalidData,
+		},
+		{
+			name:  "candidates error",
+			input: posts.FeedInput{UserID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantPostIDs:    nil,
+			wantNextCursor: "",
+			wantErr:        errors.ErrInternal,
+		},
+		{
+			name:  "affinities error",
+			input: posts.FeedInput{UserID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().GetFeedCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newCandidates(), nil)
+				postsStorage.EXPECT().GetAuthorAffinities(gomock.Any(), uint(1), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantPostIDs:    nil,
+			wantNextCursor: "",
+			wantErr:        errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = tp
+
+			tt.mock(postsStorage)
+
+			gotPosts, gotNextCursor, err := s.GetFeed(context.Background(), tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNextCursor, gotNextCursor)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotPostIDs := make([]uint, 0, len(gotPosts))
+			for _, post := range gotPosts {
+				gotPostIDs = append(gotPostIDs, post.ID)
+			}
+
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs)
+		})
+	}
+}
@@ -2,9 +2,14 @@ package posts
 
 import (
 	"context"
+	"slices"
 	"socio/domain"
 	"socio/errors"
+	"socio/pkg/contentfilter"
 	"socio/pkg/sanitizer"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"time"
 
 	"github.com/microcosm-cc/bluemonday"
 )
@@ -12,6 +17,11 @@ import (
 const (
 	DefaultPostsAmount      = uint(20)
 	DefaultLikedPostsAmount = uint(20)
+
+	DefaultCommentsAmount       = uint(20)
+	MaxCommentsAmount           = uint(100)
+	CommentRepliesPreviewAmount = uint(3)
+	MaxCommentDepth             = uint(5)
 )
 
 //easyjson:json
@@ -21,6 +31,14 @@ type PostInput struct {
 	Attachments []string `json:"attachments"`
 }
 
+//easyjson:json
+type RepostInput struct {
+	PostID   uint   `json:"postId"`
+	AuthorID uint   `json:"authorId"`
+	Content  string `json:"content"`
+	GroupID  uint   `json:"groupId"`
+}
+
 //easyjson:json
 type ListUserPostsInput struct {
 	UserID      uint `json:"userId"`
@@ -61,23 +79,30 @@ type LikeWithPostAndUser struct {
 
 type PostsStorage interface {
 	GetPostByID(ctx context.Context, postID uint) (post *domain.Post, err error)
-	GetUserPosts(ctx context.Context, userID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error)
+	CanViewPost(ctx context.Context, postID, viewerID uint) (allowed bool, err error)
+	GetPostsByIDs(ctx context.Context, viewerID uint, postIDs []uint) (posts []*domain.Post, err error)
+	GetUserPosts(ctx context.Context, userID, viewerID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error)
 	GetUserFriendsPosts(ctx context.Context, userID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error)
 	StorePost(ctx context.Context, post *domain.Post) (newPost *domain.Post, err error)
 	UpdatePost(ctx context.Context, post *domain.Post, attachmentsToDelete []string) (updatedPost *domain.Post, err error)
 	DeletePost(ctx context.Context, postID uint) (err error)
 	GetPostLikeByUserIDAndPostID(ctx context.Context, userID, postID uint) (like *domain.PostLike, err error)
+	IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error)
 	GetLikedPosts(ctx context.Context, userID uint, lastLikeID uint, limit uint) (likedPosts []LikeWithPost, err error)
 	StorePostLike(ctx context.Context, likeData *domain.PostLike) (like *domain.PostLike, err error)
 	DeletePostLike(ctx context.Context, likeData *domain.PostLike) (err error)
 	StoreGroupPost(ctx context.Context, groupPost *domain.GroupPost) (newGroupPost *domain.GroupPost, err error)
 	GetGroupPostByPostID(ctx context.Context, postID uint) (groupPost *domain.GroupPost, err error)
 	DeleteGroupPost(ctx context.Context, postID uint) (err error)
-	GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
-	GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
-	GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
-	GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
-	GetCommentsByPostID(ctx context.Context, postID uint) (comments []*domain.Comment, err error)
+	GetPostsOfGroup(ctx context.Context, viewerID, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
+	GetGroupPostsBySubscriptionIDs(ctx context.Context, viewerID uint, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
+	GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
+	GetNewPosts(ctx context.Context, viewerID, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
+	GetFeedCandidates(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, createdAfter, createdBefore time.Time, limit uint) (candidates []FeedCandidate, err error)
+	GetAuthorAffinities(ctx context.Context, userID uint, authorIDs []uint, before time.Time) (affinities []AuthorAffinity, err error)
+	GetCommentsByPostID(ctx context.Context, postID, lastCommentID, commentsAmount uint) (comments []*domain.Comment, err error)
+	GetCommentsRepliesPreview(ctx context.Context, commentIDs []uint, repliesAmount uint) (replies []*domain.Comment, err error)
+	GetCommentReplies(ctx context.Context, commentID, lastReplyID, repliesAmount uint) (replies []*domain.Comment, err error)
 	GetCommentByID(ctx context.Context, id uint) (comment *domain.Comment, err error)
 	StoreComment(ctx context.Context, comment *domain.Comment) (newComment *domain.Comment, err error)
 	UpdateComment(ctx context.Context, comment *domain.Comment) (updatedComment *domain.Comment, err error)
@@ -85,6 +110,22 @@ type PostsStorage interface {
 	GetCommentLikeByCommentIDAndUserID(ctx context.Context, data *domain.CommentLike) (commentLike *domain.CommentLike, err error)
 	StoreCommentLike(ctx context.Context, commentLike *domain.CommentLike) (newCommentLike *domain.CommentLike, err error)
 	DeleteCommentLike(ctx context.Context, commentLike *domain.CommentLike) (err error)
+	StoreAttachment(ctx context.Context, attachment *domain.Attachment) (err error)
+	GetAttachmentsByFileNames(ctx context.Context, fileNames []string) (attachments []*domain.Attachment, err error)
+	GetAttachmentByChecksum(ctx context.Context, checksum string) (attachment *domain.Attachment, err error)
+	CountAttachmentReferences(ctx context.Context, fileName string) (count uint, err error)
+	SearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor SearchCursor, limit uint) (hits []PostSearchHit, err error)
+	SearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint, cursor SearchCursor, limit uint) (hits []CommentSearchHit, err error)
+	CountSearchPosts(ctx context.Context, viewerID uint, query string, authorIDs []uint) (count uint, err error)
+	CountSearchComments(ctx context.Context, viewerID uint, query string, authorIDs []uint) (count uint, err error)
+	StorePostEntities(ctx context.Context, postID uint, hashtags []string, mentionedUserIDs []uint) (err error)
+	StoreCommentEntities(ctx context.Context, commentID uint, hashtags []string, mentionedUserIDs []uint) (err error)
+	GetPostMentions(ctx context.Context, postIDs []uint) (mentions []Mention, err error)
+	GetCommentMentions(ctx context.Context, commentIDs []uint) (mentions []Mention, err error)
+	GetPostsByHashtag(ctx context.Context, viewerID uint, hashtag string, lastPostID, postsAmount uint) (posts []*domain.Post, err error)
+	GetTrendingHashtags(ctx context.Context, since time.Time, limit uint) (hashtags []TrendingHashtag, err error)
+	SearchHashtags(ctx context.Context, prefix, lastHashtag string, limit uint) (hashtags []TrendingHashtag, err error)
+	CountSearchHashtags(ctx context.Context, prefix string) (count uint, err error)
 }
 
 type AttachmentStorage interface {
@@ -96,6 +137,8 @@ type Service struct {
 	PostsStorage      PostsStorage
 	AttachmentStorage AttachmentStorage
 	Sanitizer         *sanitizer.Sanitizer
+	ContentFilter     *contentfilter.Filter
+	TimeProvider      customtime.TimeProvider
 }
 
 //easyjson:json
@@ -108,12 +151,21 @@ func NewPostsService(postsStorage PostsStorage, attachmentStorage AttachmentStor
 		PostsStorage:      postsStorage,
 		AttachmentStorage: attachmentStorage,
 		Sanitizer:         sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
+		ContentFilter:     contentfilter.NewFilter(contentfilter.ConfigFromEnv(), customtime.RealTimeProvider{}),
+		TimeProvider:      customtime.RealTimeProvider{},
 	}
 
 	return
 }
 
-func (s *Service) GetPostByID(ctx context.Context, postID uint) (post *domain.Post, err error) {
+// GetPostByID returns the post if the privacy settings and blocks let the
+// viewer see it.
+func (s *Service) GetPostByID(ctx context.Context, viewerID, postID uint) (post *domain.Post, err error) {
+	err = s.checkCanViewPost(ctx, postID, viewerID)
+	if err != nil {
+		return
+	}
+
 	post, err = s.PostsStorage.GetPostByID(ctx, postID)
 	if err != nil {
 		return
@@ -121,15 +173,32 @@ func (s *Service) GetPostByID(ctx context.Context, postID uint) (post *domain.Po
 
 	s.Sanitizer.SanitizePost(post)
 
+	err = s.attachOriginals(ctx, viewerID, []*domain.Post{post})
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, []*domain.Post{post})
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, []*domain.Post{post})
+	if err != nil {
+		return
+	}
+
 	return
 }
 
-func (s *Service) GetUserPosts(ctx context.Context, userID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
+// GetUserPosts returns the posts on the wall of the user, none if their
+// privacy settings hide the posts from the viewer.
+func (s *Service) GetUserPosts(ctx context.Context, userID, viewerID uint, lastPostID uint, postsAmount uint) (posts []*domain.Post, err error) {
 	if postsAmount == 0 {
 		postsAmount = DefaultPostsAmount
 	}
 
-	posts, err = s.PostsStorage.GetUserPosts(ctx, userID, lastPostID, postsAmount)
+	posts, err = s.PostsStorage.GetUserPosts(ctx, userID, viewerID, lastPostID, postsAmount)
 	if err != nil {
 		return
 	}
@@ -138,6 +207,21 @@ func (s *Service) GetUserPosts(ctx context.Context, userID uint, lastPostID uint
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -155,6 +239,21 @@ func (s *Service) GetUserFriendsPosts(ctx context.Context, userID uint, lastPost
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, userID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -164,12 +263,232 @@ func (s *Service) CreatePost(ctx context.Context, input PostInput) (newPost *dom
 		return
 	}
 
-	newPost, err = s.PostsStorage.StorePost(ctx, &domain.Post{AuthorID: input.AuthorID, Content: input.Content, Attachments: input.Attachments})
+	decision := s.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: input.AuthorID, Text: input.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		err = decision.Err
+		return
+	}
+
+	newPost, err = s.PostsStorage.StorePost(ctx, &domain.Post{
+		AuthorID:    input.AuthorID,
+		Content:     input.Content,
+		Attachments: input.Attachments,
+		IsHidden:    decision.Verdict == contentfilter.HoldVerdict,
+	})
+	if err != nil {
+		return
+	}
+
+	err = s.storePostEntities(ctx, newPost.ID, newPost.Content, "")
+	if err != nil {
+		return
+	}
+
+	s.Sanitizer.SanitizePost(newPost)
+
+	err = s.describeAttachments(ctx, []*domain.Post{newPost})
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, []*domain.Post{newPost})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// RepostPost shares the post on the wall of the author, or in the public group
+// when the group is given. Reposting a repost shares its original, so reposts
+// are never nested. Only the posts visible to the author and not written by
+// the users blocked by or blocking the author can be reposted.
+func (s *Service) RepostPost(ctx context.Context, input RepostInput) (newPost *domain.Post, err error) {
+	err = s.checkCanViewPost(ctx, input.PostID, input.AuthorID)
+	if err != nil {
+		return
+	}
+
+	original, err := s.PostsStorage.GetPostByID(ctx, input.PostID)
+	if err != nil {
+		return
+	}
+
+	err = s.checkNotBlocked(ctx, input.AuthorID, original.AuthorID)
+	if err != nil {
+		return
+	}
+
+	if original.IsRepost {
+		if original.RepostOfID == 0 {
+			err = errors.ErrNotFound
+			return
+		}
+
+		err = s.checkCanViewPost(ctx, original.RepostOfID, input.AuthorID)
+		if err != nil {
+			return
+		}
+
+		original, err = s.PostsStorage.GetPostByID(ctx, original.RepostOfID)
+		if err != nil {
+			return
+		}
+
+		err = s.checkNotBlocked(ctx, input.AuthorID, original.AuthorID)
+		if err != nil {
+			return
+		}
+	}
+
+	decision := s.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: input.AuthorID, Text: input.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		err = decision.Err
+		return
+	}
+
+	newPost, err = s.PostsStorage.StorePost(ctx, &domain.Post{
+		AuthorID:   input.AuthorID,
+		Content:    input.Content,
+		RepostOfID: original.ID,
+		IsRepost:   true,
+		IsHidden:   decision.Verdict == contentfilter.HoldVerdict,
+		GroupID:    input.GroupID,
+	})
+	if err != nil {
+		return
+	}
+
+	err = s.storePostEntities(ctx, newPost.ID, newPost.Content, "")
 	if err != nil {
 		return
 	}
 
 	s.Sanitizer.SanitizePost(newPost)
+	s.Sanitizer.SanitizePost(original)
+
+	newPost.Original = original
+
+	err = s.describeAttachments(ctx, []*domain.Post{newPost})
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, []*domain.Post{newPost})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// checkCanViewPost reports the posts hidden from the viewer as missing, so their
+// existence is not disclosed.
+func (s *Service) checkCanViewPost(ctx context.Context, postID, viewerID uint) (err error) {
+	allowed, err := s.PostsStorage.CanViewPost(ctx, postID, viewerID)
+	if err != nil {
+		return
+	}
+
+	if !allowed {
+		err = errors.ErrNotFound
+		return
+	}
+
+	return
+}
+
+// attachOriginals embeds the reposted posts into the reposts. Reposts of
+// deleted posts and of the posts the viewer is blocked from are marked as
+// having the original unavailable.
+func (s *Service) attachOriginals(ctx context.Context, viewerID uint, posts []*domain.Post) (err error) {
+	originalIDs := make([]uint, 0)
+	for _, post := range posts {
+		if !post.IsRepost {
+			continue
+		}
+
+		if post.RepostOfID == 0 {
+			post.OriginalUnavailable = true
+			continue
+		}
+
+		originalIDs = append(originalIDs, post.RepostOfID)
+	}
+
+	if len(originalIDs) == 0 {
+		return
+	}
+
+	originals, err := s.PostsStorage.GetPostsByIDs(ctx, viewerID, originalIDs)
+	if err != nil {
+		return
+	}
+
+	originalsByID := make(map[uint]*domain.Post, len(originals))
+	for _, original := range originals {
+		s.Sanitizer.SanitizePost(original)
+		originalsByID[original.ID] = original
+	}
+
+	for _, post := range posts {
+		if post.RepostOfID == 0 {
+			continue
+		}
+
+		original, ok := originalsByID[post.RepostOfID]
+		if !ok {
+			post.OriginalUnavailable = true
+			continue
+		}
+
+		post.Original = original
+	}
+
+	return
+}
+
+// describeAttachments fills AttachmentsInfo of the posts and their originals,
+// the attachments uploaded before they were described are left out.
+func (s *Service) describeAttachments(ctx context.Context, posts []*domain.Post) (err error) {
+	fileNames := make([]string, 0)
+	for _, post := range posts {
+		fileNames = append(fileNames, post.Attachments...)
+		if post.Original != nil {
+			fileNames = append(fileNames, post.Original.Attachments...)
+		}
+	}
+
+	if len(fileNames) == 0 {
+		return
+	}
+
+	attachments, err := s.PostsStorage.GetAttachmentsByFileNames(ctx, fileNames)
+	if err != nil {
+		return
+	}
+
+	attachmentsByFileName := make(map[string]*domain.Attachment, len(attachments))
+	for _, attachment := range attachments {
+		attachmentsByFileName[attachment.FileName] = attachment
+		s.Sanitizer.SanitizeAttachment(attachment)
+	}
+
+	describe := func(post *domain.Post) {
+		post.AttachmentsInfo = nil
+		for _, fileName := range post.Attachments {
+			if attachment, ok := attachmentsByFileName[fileName]; ok {
+				post.AttachmentsInfo = append(post.AttachmentsInfo, attachment)
+			}
+		}
+	}
+
+	for _, post := range posts {
+		describe(post)
+		if post.Original != nil {
+			describe(post.Original)
+		}
+	}
 
 	return
 }
@@ -190,19 +509,38 @@ func (s *Service) UpdatePost(ctx context.Context, userID uint, input PostUpdateI
 		return
 	}
 
+	decision := s.ContentFilter.Check(ctx, &contentfilter.Content{Kind: contentfilter.PostContent, AuthorID: userID, Text: input.Content})
+	if decision.Verdict == contentfilter.RejectVerdict {
+		err = decision.Err
+		return
+	}
+
+	attachmentsToDelete := make([]string, 0, len(input.AttachmentsToDelete))
+	for _, attachment := range input.AttachmentsToDelete {
+		if slices.Contains(oldPost.Attachments, attachment) {
+			attachmentsToDelete = append(attachmentsToDelete, attachment)
+		}
+	}
+
+	previousContent := oldPost.Content
+
 	oldPost.Content = input.Content
 	oldPost.Attachments = input.AttachmentsToAdd
+	oldPost.IsHidden = decision.Verdict == contentfilter.HoldVerdict
 
-	_, err = s.PostsStorage.UpdatePost(ctx, oldPost, input.AttachmentsToDelete)
+	_, err = s.PostsStorage.UpdatePost(ctx, oldPost, attachmentsToDelete)
 	if err != nil {
 		return
 	}
 
-	for _, attachment := range input.AttachmentsToDelete {
-		err = s.AttachmentStorage.Delete(attachment)
-		if err != nil {
-			return
-		}
+	err = s.storePostEntities(ctx, input.PostID, input.Content, previousContent)
+	if err != nil {
+		return
+	}
+
+	err = s.deleteUnusedAttachments(ctx, attachmentsToDelete)
+	if err != nil {
+		return
 	}
 
 	post, err = s.PostsStorage.GetPostByID(ctx, input.PostID)
@@ -210,6 +548,18 @@ func (s *Service) UpdatePost(ctx context.Context, userID uint, input PostUpdateI
 		return
 	}
 
+	s.Sanitizer.SanitizePost(post)
+
+	err = s.describeAttachments(ctx, []*domain.Post{post})
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, []*domain.Post{post})
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -224,13 +574,6 @@ func (s *Service) DeletePost(ctx context.Context, userID uint, postID uint) (err
 		return
 	}
 
-	for _, attachment := range post.Attachments {
-		err = s.AttachmentStorage.Delete(attachment)
-		if err != nil {
-			return
-		}
-	}
-
 	err = s.PostsStorage.DeleteGroupPost(ctx, postID)
 	if err != nil {
 		return
@@ -241,6 +584,35 @@ func (s *Service) DeletePost(ctx context.Context, userID uint, postID uint) (err
 		return
 	}
 
+	err = s.deleteUnusedAttachments(ctx, post.Attachments)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// deleteUnusedAttachments deletes the stored files no post is attached to
+// anymore, identical uploads share one stored file.
+func (s *Service) deleteUnusedAttachments(ctx context.Context, fileNames []string) (err error) {
+	for _, fileName := range fileNames {
+		var references uint
+
+		references, err = s.PostsStorage.CountAttachmentReferences(ctx, fileName)
+		if err != nil {
+			return
+		}
+
+		if references > 0 {
+			continue
+		}
+
+		err = s.AttachmentStorage.Delete(fileName)
+		if err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -254,14 +626,41 @@ func (s *Service) GetLikedPosts(ctx context.Context, userID uint, lastLikeID uin
 		return
 	}
 
+	posts := make([]*domain.Post, 0, len(likedPosts))
 	for _, likedPost := range likedPosts {
 		s.Sanitizer.SanitizePost(likedPost.Post)
+		posts = append(posts, likedPost.Post)
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
 	}
 
 	return
 }
 
 func (s *Service) LikePost(ctx context.Context, likeData *domain.PostLike) (like *domain.PostLike, err error) {
+	err = s.checkCanViewPost(ctx, likeData.PostID, likeData.UserID)
+	if err != nil {
+		return
+	}
+
+	post, err := s.PostsStorage.GetPostByID(ctx, likeData.PostID)
+	if err != nil {
+		return
+	}
+
+	err = s.checkNotBlocked(ctx, likeData.UserID, post.AuthorID)
+	if err != nil {
+		return
+	}
+
 	_, err = s.PostsStorage.GetPostLikeByUserIDAndPostID(ctx, likeData.UserID, likeData.PostID)
 	if err == nil {
 		err = errors.ErrInvalidBody
@@ -285,9 +684,32 @@ func (s *Service) UnlikePost(ctx context.Context, likeData *domain.PostLike) (er
 	return
 }
 
-func (s *Service) UploadAttachment(fileName string, filePath string, contentType string) (err error) {
-	err = s.AttachmentStorage.Store(fileName, filePath, contentType)
+// UploadAttachment stores the attachment and its description, originalName is
+// the name of the file on the device of the author. A file already attached to
+// some post is not stored again, its stored copy is returned instead.
+func (s *Service) UploadAttachment(ctx context.Context, fileName string, originalName string, filePath string) (meta *upload.Metadata, err error) {
+	index := upload.IndexFunc(func(checksum string) (storedFileName string, err error) {
+		attachment, err := s.PostsStorage.GetAttachmentByChecksum(ctx, checksum)
+		if err != nil {
+			return
+		}
+
+		storedFileName = attachment.FileName
+		return
+	})
+
+	meta, reused, err := upload.ProcessDeduplicated(s.AttachmentStorage, index, upload.PostAttachmentKind, fileName, filePath)
+	if err != nil {
+		return
+	}
+
+	if reused {
+		return
+	}
+
+	err = s.PostsStorage.StoreAttachment(ctx, upload.ToAttachment(meta, originalName))
 	if err != nil {
+		meta = nil
 		return
 	}
 
@@ -317,12 +739,12 @@ func (s *Service) GetGroupPostByPostID(ctx context.Context, postID uint) (groupP
 	return
 }
 
-func (s *Service) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (s *Service) GetPostsOfGroup(ctx context.Context, viewerID, groupID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if postsAmount == 0 {
 		postsAmount = DefaultPostsAmount
 	}
 
-	posts, err = s.PostsStorage.GetPostsOfGroup(ctx, groupID, lastPostID, postsAmount)
+	posts, err = s.PostsStorage.GetPostsOfGroup(ctx, viewerID, groupID, lastPostID, postsAmount)
 	if err != nil {
 		return
 	}
@@ -331,15 +753,30 @@ func (s *Service) GetPostsOfGroup(ctx context.Context, groupID, lastPostID, post
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
-func (s *Service) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (s *Service) GetGroupPostsBySubscriptionIDs(ctx context.Context, viewerID uint, subIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if postsAmount == 0 {
 		postsAmount = DefaultPostsAmount
 	}
 
-	posts, err = s.PostsStorage.GetGroupPostsBySubscriptionIDs(ctx, subIDs, lastPostID, postsAmount)
+	posts, err = s.PostsStorage.GetGroupPostsBySubscriptionIDs(ctx, viewerID, subIDs, lastPostID, postsAmount)
 	if err != nil {
 		return
 	}
@@ -348,15 +785,30 @@ func (s *Service) GetGroupPostsBySubscriptionIDs(ctx context.Context, subIDs []u
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
-func (s *Service) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (s *Service) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, viewerID uint, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if postsAmount == 0 {
 		postsAmount = DefaultPostsAmount
 	}
 
-	posts, err = s.PostsStorage.GetPostsByGroupSubIDsAndUserSubIDs(ctx, groupSubIDs, userSubIDs, lastPostID, postsAmount)
+	posts, err = s.PostsStorage.GetPostsByGroupSubIDsAndUserSubIDs(ctx, viewerID, groupSubIDs, userSubIDs, lastPostID, postsAmount)
 	if err != nil {
 		return
 	}
@@ -365,15 +817,30 @@ func (s *Service) GetPostsByGroupSubIDsAndUserSubIDs(ctx context.Context, groupS
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
-func (s *Service) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
+func (s *Service) GetNewPosts(ctx context.Context, viewerID, lastPostID, postsAmount uint) (posts []*domain.Post, err error) {
 	if postsAmount == 0 {
 		postsAmount = DefaultPostsAmount
 	}
 
-	posts, err = s.PostsStorage.GetNewPosts(ctx, lastPostID, postsAmount)
+	posts, err = s.PostsStorage.GetNewPosts(ctx, viewerID, lastPostID, postsAmount)
 	if err != nil {
 		return
 	}
@@ -382,5 +849,20 @@ func (s *Service) GetNewPosts(ctx context.Context, lastPostID, postsAmount uint)
 		s.Sanitizer.SanitizePost(post)
 	}
 
+	err = s.attachOriginals(ctx, viewerID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
 	return
 }
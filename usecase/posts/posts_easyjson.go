@@ -18,7 +18,94 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonDc9e8747DecodeSocioUsecasePosts(in *jlexer.Lexer, out *PostUpdateInput) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts(in *jlexer.Lexer, out *RepostInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "postId":
+			out.PostID = uint(in.Uint())
+		case "authorId":
+			out.AuthorID = uint(in.Uint())
+		case "content":
+			out.Content = string(in.String())
+		case "groupId":
+			out.GroupID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDc9e8747EncodeSocioUsecasePosts(out *jwriter.Writer, in RepostInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"postId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.PostID))
+	}
+	{
+		const prefix string = ",\"authorId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"groupId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.GroupID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RepostInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDc9e8747EncodeSocioUsecasePosts(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RepostInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDc9e8747EncodeSocioUsecasePosts(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RepostInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDc9e8747DecodeSocioUsecasePosts(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RepostInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDc9e8747DecodeSocioUsecasePosts(l, v)
+}
+func easyjsonDc9e8747DecodeSocioUsecasePosts1(in *jlexer.Lexer, out *PostUpdateInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -97,7 +184,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts(in *jlexer.Lexer, out *PostUpdateIn
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts(out *jwriter.Writer, in PostUpdateInput) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts1(out *jwriter.Writer, in PostUpdateInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -149,27 +236,27 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts(out *jwriter.Writer, in PostUpdateI
 // MarshalJSON supports json.Marshaler interface
 func (v PostUpdateInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostUpdateInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostUpdateInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostUpdateInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts1(l, v)
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts1(in *jlexer.Lexer, out *PostInput) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts2(in *jlexer.Lexer, out *PostInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -225,7 +312,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts1(in *jlexer.Lexer, out *PostInput)
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts1(out *jwriter.Writer, in PostInput) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts2(out *jwriter.Writer, in PostInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -261,27 +348,27 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts1(out *jwriter.Writer, in PostInput)
 // MarshalJSON supports json.Marshaler interface
 func (v PostInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts1(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts1(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts1(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts1(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts2(l, v)
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts2(in *jlexer.Lexer, out *ListUserPostsInput) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts3(in *jlexer.Lexer, out *ListUserPostsInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -316,7 +403,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts2(in *jlexer.Lexer, out *ListUserPos
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts2(out *jwriter.Writer, in ListUserPostsInput) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts3(out *jwriter.Writer, in ListUserPostsInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -341,27 +428,27 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts2(out *jwriter.Writer, in ListUserPo
 // MarshalJSON supports json.Marshaler interface
 func (v ListUserPostsInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts2(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListUserPostsInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts2(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListUserPostsInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts2(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListUserPostsInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts2(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts3(l, v)
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts3(in *jlexer.Lexer, out *ListUserFriendsPostsInput) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts4(in *jlexer.Lexer, out *ListUserFriendsPostsInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -394,7 +481,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts3(in *jlexer.Lexer, out *ListUserFri
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts3(out *jwriter.Writer, in ListUserFriendsPostsInput) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts4(out *jwriter.Writer, in ListUserFriendsPostsInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -414,27 +501,27 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts3(out *jwriter.Writer, in ListUserFr
 // MarshalJSON supports json.Marshaler interface
 func (v ListUserFriendsPostsInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts3(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListUserFriendsPostsInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts3(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListUserFriendsPostsInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts3(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListUserFriendsPostsInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts3(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts4(l, v)
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts4(in *jlexer.Lexer, out *ListPostsResponse) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts5(in *jlexer.Lexer, out *ListPostsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -494,7 +581,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts4(in *jlexer.Lexer, out *ListPostsRe
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts4(out *jwriter.Writer, in ListPostsResponse) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts5(out *jwriter.Writer, in ListPostsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -524,25 +611,25 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts4(out *jwriter.Writer, in ListPostsR
 // MarshalJSON supports json.Marshaler interface
 func (v ListPostsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts4(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListPostsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts4(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListPostsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts4(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListPostsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts4(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts5(l, v)
 }
 func easyjsonDc9e8747DecodeSocioDomain(in *jlexer.Lexer, out *domain.Post) {
 	isTopLevel := in.IsStart()
@@ -617,6 +704,24 @@ func easyjsonDc9e8747DecodeSocioDomain(in *jlexer.Lexer, out *domain.Post) {
 				}
 				in.Delim(']')
 			}
+		case "sharesCount":
+			out.SharesCount = uint(in.Uint())
+		case "isRepost":
+			out.IsRepost = bool(in.Bool())
+		case "repostOfId":
+			out.RepostOfID = uint(in.Uint())
+		case "original":
+			if in.IsNull() {
+				in.Skip()
+				out.Original = nil
+			} else {
+				if out.Original == nil {
+					out.Original = new(domain.Post)
+				}
+				(*out.Original).UnmarshalEasyJSON(in)
+			}
+		case "originalUnavailable":
+			out.OriginalUnavailable = bool(in.Bool())
 		case "createdAt":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -691,6 +796,31 @@ func easyjsonDc9e8747EncodeSocioDomain(out *jwriter.Writer, in domain.Post) {
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"sharesCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.SharesCount))
+	}
+	if in.IsRepost {
+		const prefix string = ",\"isRepost\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRepost))
+	}
+	if in.RepostOfID != 0 {
+		const prefix string = ",\"repostOfId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.RepostOfID))
+	}
+	if in.Original != nil {
+		const prefix string = ",\"original\":"
+		out.RawString(prefix)
+		(*in.Original).MarshalEasyJSON(out)
+	}
+	if in.OriginalUnavailable {
+		const prefix string = ",\"originalUnavailable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OriginalUnavailable))
+	}
 	if true {
 		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
@@ -703,7 +833,7 @@ func easyjsonDc9e8747EncodeSocioDomain(out *jwriter.Writer, in domain.Post) {
 	}
 	out.RawByte('}')
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts5(in *jlexer.Lexer, out *LikeWithPostAndUser) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts6(in *jlexer.Lexer, out *LikeWithPostAndUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -762,7 +892,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts5(in *jlexer.Lexer, out *LikeWithPos
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts5(out *jwriter.Writer, in LikeWithPostAndUser) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts6(out *jwriter.Writer, in LikeWithPostAndUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -799,25 +929,25 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts5(out *jwriter.Writer, in LikeWithPo
 // MarshalJSON supports json.Marshaler interface
 func (v LikeWithPostAndUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts5(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LikeWithPostAndUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts5(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LikeWithPostAndUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts5(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LikeWithPostAndUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts5(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts6(l, v)
 }
 func easyjsonDc9e8747DecodeSocioDomain2(in *jlexer.Lexer, out *domain.PostLike) {
 	isTopLevel := in.IsStart()
@@ -981,7 +1111,7 @@ func easyjsonDc9e8747EncodeSocioDomain1(out *jwriter.Writer, in domain.User) {
 	}
 	out.RawByte('}')
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts6(in *jlexer.Lexer, out *LikeWithPost) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts7(in *jlexer.Lexer, out *LikeWithPost) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1030,7 +1160,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts6(in *jlexer.Lexer, out *LikeWithPos
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts6(out *jwriter.Writer, in LikeWithPost) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts7(out *jwriter.Writer, in LikeWithPost) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1058,27 +1188,27 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts6(out *jwriter.Writer, in LikeWithPo
 // MarshalJSON supports json.Marshaler interface
 func (v LikeWithPost) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts6(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LikeWithPost) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts6(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LikeWithPost) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts6(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LikeWithPost) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts6(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts7(l, v)
 }
-func easyjsonDc9e8747DecodeSocioUsecasePosts7(in *jlexer.Lexer, out *DeletePostInput) {
+func easyjsonDc9e8747DecodeSocioUsecasePosts8(in *jlexer.Lexer, out *DeletePostInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1109,7 +1239,7 @@ func easyjsonDc9e8747DecodeSocioUsecasePosts7(in *jlexer.Lexer, out *DeletePostI
 		in.Consumed()
 	}
 }
-func easyjsonDc9e8747EncodeSocioUsecasePosts7(out *jwriter.Writer, in DeletePostInput) {
+func easyjsonDc9e8747EncodeSocioUsecasePosts8(out *jwriter.Writer, in DeletePostInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1124,23 +1254,23 @@ func easyjsonDc9e8747EncodeSocioUsecasePosts7(out *jwriter.Writer, in DeletePost
 // MarshalJSON supports json.Marshaler interface
 func (v DeletePostInput) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonDc9e8747EncodeSocioUsecasePosts7(&w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeletePostInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonDc9e8747EncodeSocioUsecasePosts7(w, v)
+	easyjsonDc9e8747EncodeSocioUsecasePosts8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DeletePostInput) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonDc9e8747DecodeSocioUsecasePosts7(&r, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DeletePostInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonDc9e8747DecodeSocioUsecasePosts7(l, v)
+	easyjsonDc9e8747DecodeSocioUsecasePosts8(l, v)
 }
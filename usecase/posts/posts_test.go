@@ -1,11 +1,21 @@
 package posts_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
 	"reflect"
 	"socio/domain"
 	"socio/errors"
 	mock_posts "socio/mocks/usecase/posts"
+	"socio/pkg/contentfilter"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"socio/usecase/posts"
 	"testing"
 
@@ -28,15 +38,26 @@ func TestGetPostByID(t *testing.T) {
 			postID: 1,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, postID uint) {
 				post := &domain.Post{ID: postID, Content: "Test Content"}
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), postID, uint(2)).Return(true, nil)
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
 			},
 			wantPost: &domain.Post{ID: 1, Content: "Test Content"},
 			wantErr:  false,
 		},
+		{
+			name:   "Test hidden post",
+			postID: 1,
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, postID uint) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), postID, uint(2)).Return(false, nil)
+			},
+			wantPost: nil,
+			wantErr:  true,
+		},
 		{
 			name:   "Test Error",
 			postID: 1,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, postID uint) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), postID, uint(2)).Return(true, nil)
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(nil, errors.ErrInternal)
 			},
 			wantPost: nil,
@@ -56,7 +77,7 @@ func TestGetPostByID(t *testing.T) {
 
 			tt.mock(postsStorage, attachmentStorage, tt.postID)
 
-			gotPost, err := s.GetPostByID(context.Background(), tt.postID)
+			gotPost, err := s.GetPostByID(context.Background(), 2, tt.postID)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPostByID() error = %v, wantErr %v", err, tt.wantErr)
@@ -88,18 +109,88 @@ func TestGetUserPosts(t *testing.T) {
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, lastPostID uint, postsAmount uint) {
 				testPosts := []*domain.Post{{ID: 1, Content: "Test Content"}}
-				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, lastPostID, posts.DefaultPostsAmount).Return(testPosts, nil)
+				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, userID, lastPostID, posts.DefaultPostsAmount).Return(testPosts, nil)
 			},
 			wantPosts: []*domain.Post{{ID: 1, Content: "Test Content"}},
 			wantErr:   false,
 		},
+		{
+			name:        "Test reposts",
+			userID:      1,
+			lastPostID:  0,
+			postsAmount: 0,
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, lastPostID uint, postsAmount uint) {
+				testPosts := []*domain.Post{
+					{ID: 4, Content: "Shared", IsRepost: true, RepostOfID: 1},
+					{ID: 3, IsRepost: true},
+					{ID: 2, IsRepost: true, RepostOfID: 5},
+				}
+				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, userID, lastPostID, posts.DefaultPostsAmount).Return(testPosts, nil)
+				postsStorage.EXPECT().GetPostsByIDs(gomock.Any(), userID, []uint{1, 5}).Return([]*domain.Post{{ID: 1, Content: "Original"}}, nil)
+			},
+			wantPosts: []*domain.Post{
+				{ID: 4, Content: "Shared", IsRepost: true, RepostOfID: 1, Original: &domain.Post{ID: 1, Content: "Original"}},
+				{ID: 3, IsRepost: true, OriginalUnavailable: true},
+				{ID: 2, IsRepost: true, RepostOfID: 5, OriginalUnavailable: true},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Test attachments",
+			userID:      1,
+			lastPostID:  0,
+			postsAmount: 0,
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, lastPostID uint, postsAmount uint) {
+				testPosts := []*domain.Post{
+					{ID: 3, Attachments: []string{"clip.mp4", "old.png"}},
+					{ID: 2, IsRepost: true, RepostOfID: 1},
+				}
+				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, userID, lastPostID, posts.DefaultPostsAmount).Return(testPosts, nil)
+				postsStorage.EXPECT().GetPostsByIDs(gomock.Any(), userID, []uint{1}).Return([]*domain.Post{{ID: 1, Attachments: []string{"pic.png"}}}, nil)
+				postsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"clip.mp4", "old.png", "pic.png"}).Return([]*domain.Attachment{
+					{FileName: "pic.png", Kind: domain.ImageAttachment, Width: 10, Height: 10},
+					{FileName: "clip.mp4", Kind: domain.VideoAttachment, Duration: 1500},
+				}, nil)
+			},
+			wantPosts: []*domain.Post{
+				{
+					ID:              3,
+					Attachments:     []string{"clip.mp4", "old.png"},
+					AttachmentsInfo: []*domain.Attachment{{FileName: "clip.mp4", Kind: domain.VideoAttachment, Duration: 1500}},
+				},
+				{
+					ID:         2,
+					IsRepost:   true,
+					RepostOfID: 1,
+					Original: &domain.Post{
+						ID:              1,
+						Attachments:     []string{"pic.png"},
+						AttachmentsInfo: []*domain.Attachment{{FileName: "pic.png", Kind: domain.ImageAttachment, Width: 10, Height: 10}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Test originals error",
+			userID:      1,
+			lastPostID:  0,
+			postsAmount: 0,
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, lastPostID uint, postsAmount uint) {
+				testPosts := []*domain.Post{{ID: 2, IsRepost: true, RepostOfID: 1}}
+				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, userID, lastPostID, posts.DefaultPostsAmount).Return(testPosts, nil)
+				postsStorage.EXPECT().GetPostsByIDs(gomock.Any(), userID, []uint{1}).Return(nil, errors.ErrInternal)
+			},
+			wantPosts: []*domain.Post{{ID: 2, IsRepost: true, RepostOfID: 1}},
+			wantErr:   true,
+		},
 		{
 			name:        "Test Error",
 			userID:      1,
 			lastPostID:  0,
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, lastPostID uint, postsAmount uint) {
-				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, lastPostID, posts.DefaultPostsAmount).Return(nil, errors.ErrInternal)
+				postsStorage.EXPECT().GetUserPosts(gomock.Any(), userID, userID, lastPostID, posts.DefaultPostsAmount).Return(nil, errors.ErrInternal)
 			},
 			wantPosts: nil,
 			wantErr:   true,
@@ -118,7 +209,7 @@ func TestGetUserPosts(t *testing.T) {
 
 			tt.mock(postsStorage, attachmentStorage, tt.userID, tt.lastPostID, tt.postsAmount)
 
-			gotPosts, err := s.GetUserPosts(context.Background(), tt.userID, tt.lastPostID, tt.postsAmount)
+			gotPosts, err := s.GetUserPosts(context.Background(), tt.userID, tt.userID, tt.lastPostID, tt.postsAmount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetUserPosts() error = %v, wantErr %v", err, tt.wantErr)
@@ -151,9 +242,34 @@ func TestCreatePost(t *testing.T) {
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, input posts.PostInput) {
 				post := &domain.Post{AuthorID: input.AuthorID, Content: input.Content, Attachments: input.Attachments}
 				postsStorage.EXPECT().StorePost(gomock.Any(), post).Return(post, nil)
+				postsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), input.Attachments).Return([]*domain.Attachment{
+					{FileName: "attachment1", Kind: domain.ImageAttachment, MimeType: "image/png", OriginalName: "<script>alert(1)</script>cat.png"},
+				}, nil)
 			},
-			wantPost: &domain.Post{AuthorID: 1, Content: "Test Content", Attachments: []string{"attachment1", "attachment2"}},
-			wantErr:  false,
+			wantPost: &domain.Post{
+				AuthorID:    1,
+				Content:     "Test Content",
+				Attachments: []string{"attachment1", "attachment2"},
+				AttachmentsInfo: []*domain.Attachment{
+					{FileName: "attachment1", Kind: domain.ImageAttachment, MimeType: "image/png", OriginalName: "cat.png"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test attachments error",
+			input: posts.PostInput{
+				AuthorID:    1,
+				Content:     "Test Content",
+				Attachments: []string{"attachment1"},
+			},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, input posts.PostInput) {
+				post := &domain.Post{AuthorID: input.AuthorID, Content: input.Content, Attachments: input.Attachments}
+				postsStorage.EXPECT().StorePost(gomock.Any(), post).Return(post, nil)
+				postsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), input.Attachments).Return(nil, errors.ErrInternal)
+			},
+			wantPost: &domain.Post{AuthorID: 1, Content: "Test Content", Attachments: []string{"attachment1"}},
+			wantErr:  true,
 		},
 		{
 			name: "Test Error",
@@ -207,6 +323,268 @@ func TestCreatePost(t *testing.T) {
 	}
 }
 
+func TestCreatePostContentFilter(t *testing.T) {
+	t.Parallel()
+
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name    string
+		input   posts.PostInput
+		mock    func(postsStorage *mock_posts.MockPostsStorage, input posts.PostInput)
+		wantErr error
+	}{
+		{
+			name:    "Test banned content",
+			input:   posts.PostInput{AuthorID: 1, Content: "best Саsinо in town"},
+			mock:    func(postsStorage *mock_posts.MockPostsStorage, input posts.PostInput) {},
+			wantErr: errors.ErrBannedContent,
+		},
+		{
+			name:  "Test held for moderation",
+			input: posts.PostInput{AuthorID: 1, Content: "https://a.com https://b.com"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, input posts.PostInput) {
+				postsStorage.EXPECT().StorePost(gomock.Any(), &domain.Post{AuthorID: input.AuthorID, Content: input.Content, IsHidden: true}).
+					Return(&domain.Post{ID: 2, AuthorID: input.AuthorID, Content: input.Content, IsHidden: true}, nil)
+			},
+		},
+		{
+			name:  "Test held store error",
+			input: posts.PostInput{AuthorID: 1, Content: "https://a.com https://b.com"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, input posts.PostInput) {
+				postsStorage.EXPECT().StorePost(gomock.Any(), &domain.Post{AuthorID: input.AuthorID, Content: input.Content, IsHidden: true}).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			tt.mock(postsStorage, tt.input)
+
+			_, err := s.CreatePost(context.Background(), tt.input)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestUpdatePostContentFilter(t *testing.T) {
+	t.Parallel()
+
+	config := contentfilter.DefaultConfig()
+	config.BannedWords = []string{"casino"}
+	config.MaxLinks = 1
+
+	tests := []struct {
+		name    string
+		input   posts.PostUpdateInput
+		mock    func(postsStorage *mock_posts.MockPostsStorage, input posts.PostUpdateInput)
+		wantErr error
+	}{
+		{
+			name:  "Test banned content",
+			input: posts.PostUpdateInput{PostID: 1, Content: "best Саsinо in town"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, input posts.PostUpdateInput) {
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "text"}, nil)
+			},
+			wantErr: errors.ErrBannedContent,
+		},
+		{
+			name:  "Test held for moderation",
+			input: posts.PostUpdateInput{PostID: 1, Content: "https://a.com https://b.com"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, input posts.PostUpdateInput) {
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "text"}, nil)
+				postsStorage.EXPECT().UpdatePost(gomock.Any(), &domain.Post{ID: 1, AuthorID: 1, Content: input.Content, IsHidden: true}, []string{}).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			tt.mock(postsStorage, tt.input)
+
+			_, err := s.UpdatePost(context.Background(), 1, tt.input)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestRepostPost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    posts.RepostInput
+		mock     func(postsStorage *mock_posts.MockPostsStorage)
+		wantPost *domain.Post
+		wantErr  error
+	}{
+		{
+			name:  "Test OK",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2, Content: "Look"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "Original"}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
+				postsStorage.EXPECT().StorePost(gomock.Any(), &domain.Post{AuthorID: 2, Content: "Look", RepostOfID: 1, IsRepost: true}).
+					Return(&domain.Post{ID: 3, AuthorID: 2, Content: "Look", RepostOfID: 1, IsRepost: true}, nil)
+			},
+			wantPost: &domain.Post{
+				ID:         3,
+				AuthorID:   2,
+				Content:    "Look",
+				RepostOfID: 1,
+				IsRepost:   true,
+				Original:   &domain.Post{ID: 1, AuthorID: 1, Content: "Original"},
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Test repost of repost",
+			input: posts.RepostInput{PostID: 3, AuthorID: 4},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(3), uint(4)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(3)).Return(&domain.Post{ID: 3, AuthorID: 2, RepostOfID: 1, IsRepost: true}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(4), uint(2)).Return(false, nil)
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(4)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1, Content: "Original"}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(4), uint(1)).Return(false, nil)
+				postsStorage.EXPECT().StorePost(gomock.Any(), &domain.Post{AuthorID: 4, RepostOfID: 1, IsRepost: true}).
+					Return(&domain.Post{ID: 5, AuthorID: 4, RepostOfID: 1, IsRepost: true}, nil)
+			},
+			wantPost: &domain.Post{
+				ID:         5,
+				AuthorID:   4,
+				RepostOfID: 1,
+				IsRepost:   true,
+				Original:   &domain.Post{ID: 1, AuthorID: 1, Content: "Original"},
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "Test repost of unavailable original",
+			input: posts.RepostInput{PostID: 3, AuthorID: 4},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(3), uint(4)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(3)).Return(&domain.Post{ID: 3, AuthorID: 2, IsRepost: true}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(4), uint(2)).Return(false, nil)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrNotFound,
+		},
+		{
+			name:  "Test not found",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(false, errors.ErrNotFound)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrNotFound,
+		},
+		{
+			name:  "Test hidden original",
+			input: posts.RepostInput{PostID: 3, AuthorID: 4},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(3), uint(4)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(3)).Return(&domain.Post{ID: 3, AuthorID: 2, RepostOfID: 1, IsRepost: true}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(4), uint(2)).Return(false, nil)
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(4)).Return(false, nil)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrNotFound,
+		},
+		{
+			name:  "Test store error",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
+				postsStorage.EXPECT().StorePost(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrInternal,
+		},
+		{
+			name:  "Test blocked author",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(true, nil)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrForbidden,
+		},
+		{
+			name:  "Test banned content",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2, Content: "best casino in town"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrBannedContent,
+		},
+		{
+			name:  "Test repost to group held for moderation",
+			input: posts.RepostInput{PostID: 1, AuthorID: 2, Content: "https://a.com https://b.com", GroupID: 5},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{ID: 1, AuthorID: 1}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), uint(2), uint(1)).Return(false, nil)
+				postsStorage.EXPECT().StorePost(gomock.Any(), &domain.Post{AuthorID: 2, Content: "https://a.com https://b.com", RepostOfID: 1, IsRepost: true, IsHidden: true, GroupID: 5}).
+					Return(nil, errors.ErrInternal)
+			},
+			wantPost: nil,
+			wantErr:  errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			config := contentfilter.DefaultConfig()
+			config.BannedWords = []string{"casino"}
+			config.MaxLinks = 1
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.ContentFilter = contentfilter.NewFilter(config, customtime.MockTimeProvider{})
+
+			tt.mock(postsStorage)
+
+			gotPost, err := s.RepostPost(context.Background(), tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantPost, gotPost)
+		})
+	}
+}
+
 func TestUpdatePost(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -253,7 +631,10 @@ func TestUpdatePost(t *testing.T) {
 					Attachments: []string{"new_attachment.jpg"},
 				}, nil)
 
+				mockPostsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "old_attachment.jpg").Return(uint(0), nil)
 				mockAttachmentStorage.EXPECT().Delete("old_attachment.jpg").Return(nil)
+
+				mockPostsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"new_attachment.jpg"}).Return(nil, nil)
 			},
 		},
 		{
@@ -282,6 +663,7 @@ func TestUpdatePost(t *testing.T) {
 					Attachments: []string{"new_attachment.jpg"},
 				}, nil)
 
+				mockPostsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "old_attachment.jpg").Return(uint(0), nil)
 				mockAttachmentStorage.EXPECT().Delete("old_attachment.jpg").Return(nil)
 
 				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
@@ -313,6 +695,7 @@ func TestUpdatePost(t *testing.T) {
 					Attachments: []string{"new_attachment.jpg"},
 				}, nil)
 
+				mockPostsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "old_attachment.jpg").Return(uint(0), nil)
 				mockAttachmentStorage.EXPECT().Delete("old_attachment.jpg").Return(errors.ErrNotFound)
 			},
 		},
@@ -393,6 +776,41 @@ func TestUpdatePost(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name:   "test case 7 - attachments of other posts are not deleted",
+			userID: 1,
+			input: posts.PostUpdateInput{
+				PostID:              1,
+				Content:             "Updated content",
+				AttachmentsToDelete: []string{"foreign.jpg"},
+			},
+			want: &domain.Post{
+				ID:          1,
+				AuthorID:    1,
+				Content:     "Updated content",
+				Attachments: []string{"old_attachment.jpg"},
+			},
+			wantErr: nil,
+			setup: func() {
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
+					ID:          1,
+					AuthorID:    1,
+					Content:     "Old content",
+					Attachments: []string{"old_attachment.jpg"},
+				}, nil)
+
+				mockPostsStorage.EXPECT().UpdatePost(gomock.Any(), gomock.Any(), []string{}).Return(nil, nil)
+
+				mockPostsStorage.EXPECT().GetPostByID(gomock.Any(), uint(1)).Return(&domain.Post{
+					ID:          1,
+					AuthorID:    1,
+					Content:     "Updated content",
+					Attachments: []string{"old_attachment.jpg"},
+				}, nil)
+
+				mockPostsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"old_attachment.jpg"}).Return(nil, nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -489,9 +907,25 @@ func TestDeletePost(t *testing.T) {
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, postID uint) {
 				post := &domain.Post{ID: postID, AuthorID: userID, Attachments: []string{"attachment1", "attachment2"}}
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
+				postsStorage.EXPECT().DeleteGroupPost(gomock.Any(), postID).Return(nil)
+				postsStorage.EXPECT().DeletePost(gomock.Any(), postID).Return(nil)
+				postsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), gomock.Any()).Return(uint(0), nil).Times(len(post.Attachments))
 				attachmentStorage.EXPECT().Delete(gomock.Any()).Times(len(post.Attachments))
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Test shared attachment is kept",
+			userID: 1,
+			postID: 1,
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, postID uint) {
+				post := &domain.Post{ID: postID, AuthorID: userID, Attachments: []string{"attachment1", "attachment2"}}
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
 				postsStorage.EXPECT().DeleteGroupPost(gomock.Any(), postID).Return(nil)
 				postsStorage.EXPECT().DeletePost(gomock.Any(), postID).Return(nil)
+				postsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "attachment1").Return(uint(1), nil)
+				postsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "attachment2").Return(uint(0), nil)
+				attachmentStorage.EXPECT().Delete("attachment2").Return(nil)
 			},
 			wantErr: false,
 		},
@@ -521,7 +955,10 @@ func TestDeletePost(t *testing.T) {
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, postID uint) {
 				post := &domain.Post{ID: postID, AuthorID: userID, Attachments: []string{"attachment1", "attachment2"}}
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
-				attachmentStorage.EXPECT().Delete(gomock.Any()).Return(errors.ErrInternal)
+				postsStorage.EXPECT().DeleteGroupPost(gomock.Any(), postID).Return(nil)
+				postsStorage.EXPECT().DeletePost(gomock.Any(), postID).Return(nil)
+				postsStorage.EXPECT().CountAttachmentReferences(gomock.Any(), "attachment1").Return(uint(0), nil)
+				attachmentStorage.EXPECT().Delete("attachment1").Return(errors.ErrInternal)
 			},
 			wantErr: true,
 		},
@@ -532,7 +969,6 @@ func TestDeletePost(t *testing.T) {
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, postID uint) {
 				post := &domain.Post{ID: postID, AuthorID: userID, Attachments: []string{"attachment1", "attachment2"}}
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
-				attachmentStorage.EXPECT().Delete(gomock.Any()).Times(len(post.Attachments))
 				postsStorage.EXPECT().DeleteGroupPost(gomock.Any(), postID).Return(errors.ErrInternal)
 			},
 			wantErr: true,
@@ -544,7 +980,6 @@ func TestDeletePost(t *testing.T) {
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, userID uint, postID uint) {
 				post := &domain.Post{ID: postID, AuthorID: userID, Attachments: []string{"attachment1", "attachment2"}}
 				postsStorage.EXPECT().GetPostByID(gomock.Any(), postID).Return(post, nil)
-				attachmentStorage.EXPECT().Delete(gomock.Any()).Times(len(post.Attachments))
 				postsStorage.EXPECT().DeleteGroupPost(gomock.Any(), postID).Return(nil)
 				postsStorage.EXPECT().DeletePost(gomock.Any(), postID).Return(errors.ErrInternal)
 			},
@@ -649,6 +1084,9 @@ func TestLikePost(t *testing.T) {
 			name:     "Test OK",
 			likeData: &domain.PostLike{UserID: 1, PostID: 1},
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), likeData.PostID).Return(&domain.Post{ID: 1, AuthorID: 2}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), likeData.UserID, uint(2)).Return(false, nil)
 				postsStorage.EXPECT().GetPostLikeByUserIDAndPostID(gomock.Any(), likeData.UserID, likeData.PostID).Return(nil, errors.ErrNotFound)
 				postsStorage.EXPECT().StorePostLike(gomock.Any(), likeData).Return(likeData, nil)
 			},
@@ -659,6 +1097,9 @@ func TestLikePost(t *testing.T) {
 			name:     "Test Error",
 			likeData: &domain.PostLike{UserID: 1, PostID: 1},
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), likeData.PostID).Return(&domain.Post{ID: 1, AuthorID: 2}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), likeData.UserID, uint(2)).Return(false, nil)
 				postsStorage.EXPECT().GetPostLikeByUserIDAndPostID(gomock.Any(), likeData.UserID, likeData.PostID).Return(nil, errors.ErrNotFound)
 				postsStorage.EXPECT().StorePostLike(gomock.Any(), likeData).Return(nil, errors.ErrInternal)
 			},
@@ -669,11 +1110,44 @@ func TestLikePost(t *testing.T) {
 			name:     "Test Error",
 			likeData: &domain.PostLike{UserID: 1, PostID: 1},
 			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), likeData.PostID).Return(&domain.Post{ID: 1, AuthorID: 2}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), likeData.UserID, uint(2)).Return(false, nil)
 				postsStorage.EXPECT().GetPostLikeByUserIDAndPostID(gomock.Any(), likeData.UserID, likeData.PostID).Return(nil, nil)
 			},
 			wantLike: nil,
 			wantErr:  true,
 		},
+		{
+			name:     "Test blocked by the post author",
+			likeData: &domain.PostLike{UserID: 1, PostID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), likeData.PostID).Return(&domain.Post{ID: 1, AuthorID: 2}, nil)
+				postsStorage.EXPECT().IsBlocked(gomock.Any(), likeData.UserID, uint(2)).Return(true, nil)
+			},
+			wantLike: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Test post not found",
+			likeData: &domain.PostLike{UserID: 1, PostID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(true, nil)
+				postsStorage.EXPECT().GetPostByID(gomock.Any(), likeData.PostID).Return(nil, errors.ErrNotFound)
+			},
+			wantLike: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Test post hidden from the user",
+			likeData: &domain.PostLike{UserID: 1, PostID: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage, likeData *domain.PostLike) {
+				postsStorage.EXPECT().CanViewPost(gomock.Any(), likeData.PostID, likeData.UserID).Return(false, nil)
+			},
+			wantLike: nil,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -752,33 +1226,113 @@ func TestUnlikePost(t *testing.T) {
 func TestUploadAttachment(t *testing.T) {
 	t.Parallel()
 
+	img := new(bytes.Buffer)
+	if err := png.Encode(img, image.NewRGBA(image.Rect(0, 0, 640, 480))); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(img.Bytes())
+	checksum := hex.EncodeToString(sum[:])
+
+	attachment := &domain.Attachment{
+		FileName:     "test.png",
+		Kind:         domain.ImageAttachment,
+		MimeType:     "image/png",
+		Size:         int64(img.Len()),
+		Width:        640,
+		Height:       480,
+		OriginalName: "my photo.png",
+		Checksum:     checksum,
+	}
+
 	tests := []struct {
-		name        string
-		fileName    string
-		filePath    string
-		contentType string
-		mock        func(attachmentStorage *mock_posts.MockAttachmentStorage, fileName string, filePath string, contentType string)
-		wantErr     bool
+		name     string
+		data     []byte
+		mock     func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage)
+		wantMeta *upload.Metadata
+		wantErr  error
 	}{
 		{
-			name:        "Test OK",
-			fileName:    "test.jpg",
-			filePath:    "/path/to/test.jpg",
-			contentType: "image/jpeg",
-			mock: func(attachmentStorage *mock_posts.MockAttachmentStorage, fileName string, filePath string, contentType string) {
-				attachmentStorage.EXPECT().Store(fileName, filePath, contentType).Return(nil)
+			name: "Test OK",
+			data: img.Bytes(),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+				postsStorage.EXPECT().GetAttachmentByChecksum(gomock.Any(), checksum).Return(nil, errors.ErrNotFound)
+				attachmentStorage.EXPECT().Store("test.png", gomock.Any(), "image/png").Return(nil)
+				attachmentStorage.EXPECT().Store("test_thumbnail.png", gomock.Any(), "image/png").Return(nil)
+				attachmentStorage.EXPECT().Store("test_preview.png", gomock.Any(), "image/png").Return(nil)
+				postsStorage.EXPECT().StoreAttachment(gomock.Any(), attachment).Return(nil)
 			},
-			wantErr: false,
+			wantMeta: &upload.Metadata{
+				FileName:    "test.png",
+				ContentType: "image/png",
+				Size:        int64(img.Len()),
+				Width:       640,
+				Height:      480,
+				Checksum:    checksum,
+				Variants: []*upload.Variant{
+					{Name: "thumbnail", FileName: "test_thumbnail.png", Width: 320, Height: 240},
+					{Name: "preview", FileName: "test_preview.png", Width: 640, Height: 480},
+				},
+			},
+			wantErr: nil,
 		},
 		{
-			name:        "Test Error",
-			fileName:    "test.jpg",
-			filePath:    "/path/to/test.jpg",
-			contentType: "image/jpeg",
-			mock: func(attachmentStorage *mock_posts.MockAttachmentStorage, fileName string, filePath string, contentType string) {
-				attachmentStorage.EXPECT().Store(fileName, filePath, contentType).Return(errors.ErrInternal)
+			name: "Test reused",
+			data: img.Bytes(),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+				postsStorage.EXPECT().GetAttachmentByChecksum(gomock.Any(), checksum).Return(&domain.Attachment{FileName: "stored.png", Checksum: checksum}, nil)
 			},
-			wantErr: true,
+			wantMeta: &upload.Metadata{
+				FileName:    "stored.png",
+				ContentType: "image/png",
+				Size:        int64(img.Len()),
+				Width:       640,
+				Height:      480,
+				Checksum:    checksum,
+				Variants: []*upload.Variant{
+					{Name: "thumbnail", FileName: "stored_thumbnail.png", Width: 320, Height: 240},
+					{Name: "preview", FileName: "stored_preview.png", Width: 640, Height: 480},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test checksum lookup error",
+			data: img.Bytes(),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+				postsStorage.EXPECT().GetAttachmentByChecksum(gomock.Any(), checksum).Return(nil, errors.ErrInternal)
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrInternal,
+		},
+		{
+			name: "Test unsupported media type",
+			data: []byte("<html><script>alert(1)</script></html>"),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrUnsupportedMediaType,
+		},
+		{
+			name: "Test Error",
+			data: img.Bytes(),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+				postsStorage.EXPECT().GetAttachmentByChecksum(gomock.Any(), checksum).Return(nil, errors.ErrNotFound)
+				attachmentStorage.EXPECT().Store("test.png", gomock.Any(), "image/png").Return(errors.ErrInternal)
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrInternal,
+		},
+		{
+			name: "Test store attachment error",
+			data: img.Bytes(),
+			mock: func(postsStorage *mock_posts.MockPostsStorage, attachmentStorage *mock_posts.MockAttachmentStorage) {
+				postsStorage.EXPECT().GetAttachmentByChecksum(gomock.Any(), checksum).Return(nil, errors.ErrNotFound)
+				attachmentStorage.EXPECT().Store(gomock.Any(), gomock.Any(), "image/png").Return(nil).Times(3)
+				postsStorage.EXPECT().StoreAttachment(gomock.Any(), attachment).Return(errors.ErrInternal)
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrInternal,
 		},
 	}
 
@@ -787,17 +1341,27 @@ func TestUploadAttachment(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			filePath := filepath.Join(t.TempDir(), "upload")
+			if err := os.WriteFile(filePath, tt.data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
 			attachmentStorage := mock_posts.NewMockAttachmentStorage(ctrl)
 
-			s := posts.NewPostsService(nil, attachmentStorage)
+			s := posts.NewPostsService(postsStorage, attachmentStorage)
 
-			tt.mock(attachmentStorage, tt.fileName, tt.filePath, tt.contentType)
+			tt.mock(postsStorage, attachmentStorage)
 
-			err := s.UploadAttachment(tt.fileName, tt.filePath, tt.contentType)
+			meta, err := s.UploadAttachment(context.Background(), "test.png", "my photo.png", filePath)
 
-			if (err != nil) != tt.wantErr {
+			if err != tt.wantErr {
 				t.Errorf("UploadAttachment() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			if !reflect.DeepEqual(meta, tt.wantMeta) {
+				t.Errorf("UploadAttachment() meta = %v, want %v", meta, tt.wantMeta)
+			}
 		})
 	}
 }
@@ -874,7 +1438,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, groupID, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetPostsOfGroup(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
+				postsStorage.EXPECT().GetPostsOfGroup(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
 			},
 			wantPosts: []*domain.Post{{ID: 1}},
 			wantErr:   false,
@@ -885,7 +1449,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 10,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, groupID, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetPostsOfGroup(gomock.Any(), groupID, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
+				postsStorage.EXPECT().GetPostsOfGroup(gomock.Any(), uint(1), groupID, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
 			},
 			wantPosts: nil,
 			wantErr:   true,
@@ -903,7 +1467,7 @@ func TestGetPostsOfGroup(t *testing.T) {
 
 			tt.mock(postsStorage, tt.groupID, tt.lastPostID, tt.postsAmount)
 
-			gotPosts, err := s.GetPostsOfGroup(context.Background(), tt.groupID, tt.lastPostID, tt.postsAmount)
+			gotPosts, err := s.GetPostsOfGroup(context.Background(), 1, tt.groupID, tt.lastPostID, tt.postsAmount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPostsOfGroup() error = %v, wantErr %v", err, tt.wantErr)
@@ -934,7 +1498,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, subIDs []uint, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetGroupPostsBySubscriptionIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
+				postsStorage.EXPECT().GetGroupPostsBySubscriptionIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
 			},
 			wantPosts: []*domain.Post{{ID: 1}},
 			wantErr:   false,
@@ -945,7 +1509,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 10,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, subIDs []uint, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetGroupPostsBySubscriptionIDs(gomock.Any(), subIDs, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
+				postsStorage.EXPECT().GetGroupPostsBySubscriptionIDs(gomock.Any(), uint(1), subIDs, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
 			},
 			wantPosts: nil,
 			wantErr:   true,
@@ -963,7 +1527,7 @@ func TestGetGroupPostsBySubscriptionIDs(t *testing.T) {
 
 			tt.mock(postsStorage, tt.subIDs, tt.lastPostID, tt.postsAmount)
 
-			gotPosts, err := s.GetGroupPostsBySubscriptionIDs(context.Background(), tt.subIDs, tt.lastPostID, tt.postsAmount)
+			gotPosts, err := s.GetGroupPostsBySubscriptionIDs(context.Background(), 1, tt.subIDs, tt.lastPostID, tt.postsAmount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetGroupPostsBySubscriptionIDs() error = %v, wantErr %v", err, tt.wantErr)
@@ -996,7 +1560,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetPostsByGroupSubIDsAndUserSubIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
+				postsStorage.EXPECT().GetPostsByGroupSubIDsAndUserSubIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
 			},
 			wantPosts: []*domain.Post{{ID: 1}},
 			wantErr:   false,
@@ -1008,7 +1572,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 10,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, groupSubIDs, userSubIDs []uint, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetPostsByGroupSubIDsAndUserSubIDs(gomock.Any(), groupSubIDs, userSubIDs, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
+				postsStorage.EXPECT().GetPostsByGroupSubIDsAndUserSubIDs(gomock.Any(), uint(1), groupSubIDs, userSubIDs, lastPostID, postsAmount).Return(nil, errors.ErrInternal)
 			},
 			wantPosts: nil,
 			wantErr:   true,
@@ -1026,7 +1590,7 @@ func TestGetPostsByGroupSubIDsAndUserSubIDs(t *testing.T) {
 
 			tt.mock(postsStorage, tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
 
-			gotPosts, err := s.GetPostsByGroupSubIDsAndUserSubIDs(context.Background(), tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
+			gotPosts, err := s.GetPostsByGroupSubIDsAndUserSubIDs(context.Background(), 1, tt.groupSubIDs, tt.userSubIDs, tt.lastPostID, tt.postsAmount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPostsByGroupSubIDsAndUserSubIDs() error = %v, wantErr %v", err, tt.wantErr)
@@ -1055,7 +1619,7 @@ func TestGetNewPosts(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 0,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetNewPosts(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
+				postsStorage.EXPECT().GetNewPosts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.Post{{ID: 1}}, nil)
 			},
 			wantPosts: []*domain.Post{{ID: 1}},
 			wantErr:   false,
@@ -1065,7 +1629,7 @@ func TestGetNewPosts(t *testing.T) {
 			lastPostID:  0,
 			postsAmount: 10,
 			mock: func(postsStorage *mock_posts.MockPostsStorage, lastPostID, postsAmount uint) {
-				postsStorage.EXPECT().GetNewPosts(gomock.Any(), lastPostID, postsAmount).Return(nil, errors.ErrInternal)
+				postsStorage.EXPECT().GetNewPosts(gomock.Any(), uint(1), lastPostID, postsAmount).Return(nil, errors.ErrInternal)
 			},
 			wantPosts: nil,
 			wantErr:   true,
@@ -1083,7 +1647,7 @@ func TestGetNewPosts(t *testing.T) {
 
 			tt.mock(postsStorage, tt.lastPostID, tt.postsAmount)
 
-			gotPosts, err := s.GetNewPosts(context.Background(), tt.lastPostID, tt.postsAmount)
+			gotPosts, err := s.GetNewPosts(context.Background(), 1, tt.lastPostID, tt.postsAmount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetNewPosts() error = %v, wantErr %v", err, tt.wantErr)
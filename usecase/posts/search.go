@@ -0,0 +1,229 @@
+package posts
+
+import (
+	"context"
+	"encoding/base64"
+	"socio/domain"
+	"socio/errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	SearchPostsTarget    = "posts"
+	SearchCommentsTarget = "comments"
+
+	DefaultSearchAmount  = uint(20)
+	MaxSearchAmount      = uint(100)
+	MaxSearchQueryLength = 256
+)
+
+// PostSearchHit is a post matching the search query. Snippet is the part of
+// the content with the matched words wrapped in <mark>.
+type PostSearchHit struct {
+	Post    *domain.Post
+	Snippet string
+	Rank    float64
+}
+
+// CommentSearchHit is a comment matching the search query. Snippet is the
+// part of the content with the matched words wrapped in <mark>.
+type CommentSearchHit struct {
+	Comment *domain.Comment
+	Snippet string
+	Rank    float64
+}
+
+// SearchInput describes the search of the user, only the posts of the user,
+// of UserSubIDs and of the public groups are found. The posts and comments of
+// the users blocked by or blocking the user are never found.
+type SearchInput struct {
+	UserID     uint
+	UserSubIDs []uint
+	Query      string
+	Cursor     string
+	Amount     uint
+}
+
+// SearchCursor points at the last hit of the previous page, the hits are
+// ordered by rank and then by ID, both descending.
+//
+//easyjson:json
+type SearchCursor struct {
+	Rank float64 `json:"r"`
+	ID   uint    `json:"i"`
+}
+
+func EncodeSearchCursor(cursor SearchCursor) (encoded string, err error) {
+	data, err := easyjson.Marshal(cursor)
+	if err != nil {
+		return
+	}
+
+	encoded = base64.RawURLEncoding.EncodeToString(data)
+	return
+}
+
+func DecodeSearchCursor(encoded string) (cursor SearchCursor, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = easyjson.Unmarshal(data, &cursor)
+	if err != nil || cursor.ID == 0 {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	return
+}
+
+// prepareSearch validates the input and returns the search query, the authors
+// whose wall posts are visible, the cursor and the amount of hits to get.
+func prepareSearch(input SearchInput) (query string, authorIDs []uint, cursor SearchCursor, amount uint, err error) {
+	query = strings.TrimSpace(input.Query)
+	if query == "" || utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	if input.Cursor != "" {
+		cursor, err = DecodeSearchCursor(input.Cursor)
+		if err != nil {
+			return
+		}
+	}
+
+	amount = input.Amount
+	if amount == 0 {
+		amount = DefaultSearchAmount
+	}
+
+	if amount > MaxSearchAmount {
+		amount = MaxSearchAmount
+	}
+
+	authorIDs = make([]uint, 0, len(input.UserSubIDs)+1)
+	authorIDs = append(authorIDs, input.UserID)
+	authorIDs = append(authorIDs, input.UserSubIDs...)
+
+	return
+}
+
+// SearchPosts returns the page of the posts matching the query ordered by
+// relevance, the cursor of the next page, which is empty on the last one, and
+// the total amount of the posts found.
+func (s *Service) SearchPosts(ctx context.Context, input SearchInput) (hits []PostSearchHit, nextCursor string, total uint, err error) {
+	query, authorIDs, cursor, amount, err := prepareSearch(input)
+	if err != nil {
+		return
+	}
+
+	hits, err = s.PostsStorage.SearchPosts(ctx, input.UserID, query, authorIDs, cursor, amount+1)
+	if err != nil {
+		return
+	}
+
+	if uint(len(hits)) > amount {
+		hits = hits[:amount]
+
+		last := hits[len(hits)-1]
+		nextCursor, err = EncodeSearchCursor(SearchCursor{
+			Rank: last.Rank,
+			ID:   last.Post.ID,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	// the only page holds every post found
+	total = uint(len(hits))
+	if input.Cursor != "" || nextCursor != "" {
+		total, err = s.PostsStorage.CountSearchPosts(ctx, input.UserID, query, authorIDs)
+		if err != nil {
+			return
+		}
+	}
+
+	posts := make([]*domain.Post, 0, len(hits))
+	for i := range hits {
+		s.Sanitizer.SanitizePost(hits[i].Post)
+		hits[i].Snippet = s.Sanitizer.Sanitize(hits[i].Snippet)
+
+		posts = append(posts, hits[i].Post)
+	}
+
+	err = s.attachOriginals(ctx, input.UserID, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.describeAttachments(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	err = s.attachEntities(ctx, posts)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SearchComments returns the page of the comments matching the query ordered
+// by relevance, the cursor of the next page, which is empty on the last one,
+// and the total amount of the comments found. The comments of the posts the
+// user can not see are not found.
+func (s *Service) SearchComments(ctx context.Context, input SearchInput) (hits []CommentSearchHit, nextCursor string, total uint, err error) {
+	query, authorIDs, cursor, amount, err := prepareSearch(input)
+	if err != nil {
+		return
+	}
+
+	hits, err = s.PostsStorage.SearchComments(ctx, input.UserID, query, authorIDs, cursor, amount+1)
+	if err != nil {
+		return
+	}
+
+	if uint(len(hits)) > amount {
+		hits = hits[:amount]
+
+		last := hits[len(hits)-1]
+		nextCursor, err = EncodeSearchCursor(SearchCursor{
+			Rank: last.Rank,
+			ID:   last.Comment.ID,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	total = uint(len(hits))
+	if input.Cursor != "" || nextCursor != "" {
+		total, err = s.PostsStorage.CountSearchComments(ctx, input.UserID, query, authorIDs)
+		if err != nil {
+			return
+		}
+	}
+
+	comments := make([]*domain.Comment, 0, len(hits))
+	for i := range hits {
+		s.Sanitizer.SanitizeComment(hits[i].Comment)
+		hits[i].Snippet = s.Sanitizer.Sanitize(hits[i].Snippet)
+
+		comments = append(comments, hits[i].Comment)
+	}
+
+	err = s.attachCommentEntities(ctx, comments)
+	if err != nil {
+		return
+	}
+
+	return
+}
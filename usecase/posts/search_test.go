@@ -0,0 +1,272 @@
+package posts_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_posts "socio/mocks/usecase/posts"
+	customtime "socio/pkg/time"
+	"socio/usecase/posts"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := posts.SearchCursor{Rank: 0.0607927, ID: 42}
+
+	encoded, err := posts.EncodeSearchCursor(cursor)
+	assert.NoError(t, err)
+
+	decoded, err := posts.DecodeSearchCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = posts.DecodeSearchCursor("not a cursor")
+	assert.Equal(t, errors.ErrInvalidData, err)
+
+	noID, err := posts.EncodeSearchCursor(posts.SearchCursor{Rank: 1})
+	assert.NoError(t, err)
+
+	_, err = posts.DecodeSearchCursor(noID)
+	assert.Equal(t, errors.ErrInvalidData, err)
+}
+
+func TestSearchPosts(t *testing.T) {
+	t.Parallel()
+
+	newHits := func() []posts.PostSearchHit {
+		return []posts.PostSearchHit{
+			{Post: &domain.Post{ID: 3, Content: "go <script>alert(1)</script>"}, Snippet: "<mark>go</mark> <script>alert(1)</script>", Rank: 0.5},
+			{Post: &domain.Post{ID: 1, Attachments: []string{"pic.png"}}, Snippet: "<mark>go</mark>", Rank: 0.3},
+			{Post: &domain.Post{ID: 2}, Snippet: "<mark>go</mark>", Rank: 0.1},
+		}
+	}
+
+	nextCursor, err := posts.EncodeSearchCursor(posts.SearchCursor{Rank: 0.3, ID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		input          posts.SearchInput
+		mock           func(postsStorage *mock_posts.MockPostsStorage)
+		wantPostIDs    []uint
+		wantSnippet    string
+		wantNextCursor string
+		wantTotal      uint
+		wantErr        error
+	}{
+		{
+			name:  "first page",
+			input: posts.SearchInput{UserID: 1, UserSubIDs: []uint{2, 3}, Query: " go ", Amount: 2},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), uint(1), "go", []uint{1, 2, 3}, posts.SearchCursor{}, uint(3)).Return(newHits(), nil)
+				postsStorage.EXPECT().CountSearchPosts(gomock.Any(), uint(1), "go", []uint{1, 2, 3}).Return(uint(3), nil)
+				postsStorage.EXPECT().GetAttachmentsByFileNames(gomock.Any(), []string{"pic.png"}).Return(nil, nil)
+			},
+			wantPostIDs:    []uint{3, 1},
+			wantSnippet:    "<mark>go</mark> ",
+			wantNextCursor: nextCursor,
+			wantTotal:      3,
+		},
+		{
+			name:  "last page",
+			input: posts.SearchInput{UserID: 1, Query: "go", Cursor: nextCursor},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), uint(1), "go", []uint{1}, posts.SearchCursor{Rank: 0.3, ID: 1}, posts.DefaultSearchAmount+1).Return(newHits()[2:], nil)
+				postsStorage.EXPECT().CountSearchPosts(gomock.Any(), uint(1), "go", []uint{1}).Return(uint(3), nil)
+			},
+			wantPostIDs: []uint{2},
+			wantSnippet: "<mark>go</mark>",
+			wantTotal:   3,
+		},
+		{
+			name:  "only page",
+			input: posts.SearchInput{UserID: 1, Query: "go"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), uint(1), "go", []uint{1}, posts.SearchCursor{}, posts.DefaultSearchAmount+1).Return(newHits()[2:], nil)
+			},
+			wantPostIDs: []uint{2},
+			wantSnippet: "<mark>go</mark>",
+			wantTotal:   1,
+		},
+		{
+			name:  "amount is limited",
+			input: posts.SearchInput{UserID: 1, Query: "go", Amount: 1000},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), uint(1), "go", []uint{1}, posts.SearchCursor{}, posts.MaxSearchAmount+1).Return(nil, nil)
+			},
+			wantPostIDs: []uint{},
+		},
+		{
+			name:    "empty query",
+			input:   posts.SearchInput{UserID: 1, Query: "  "},
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "too long query",
+			input:   posts.SearchInput{UserID: 1, Query: strings.Repeat("я", posts.MaxSearchQueryLength+1)},
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:    "invalid cursor",
+			input:   posts.SearchInput{UserID: 1, Query: "go", Cursor: "not a cursor"},
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:  "storage error",
+			input: posts.SearchInput{UserID: 1, Query: "go"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+		{
+			name:  "count error",
+			input: posts.SearchInput{UserID: 1, Query: "go", Cursor: nextCursor},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchPosts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newHits()[2:], nil)
+				postsStorage.EXPECT().CountSearchPosts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(uint(0), errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(postsStorage)
+
+			hits, gotNextCursor, gotTotal, err := s.SearchPosts(context.Background(), tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNextCursor, gotNextCursor)
+			assert.Equal(t, tt.wantTotal, gotTotal)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotPostIDs := make([]uint, 0, len(hits))
+			for _, hit := range hits {
+				gotPostIDs = append(gotPostIDs, hit.Post.ID)
+			}
+
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs)
+
+			if len(hits) != 0 {
+				assert.Equal(t, tt.wantSnippet, hits[0].Snippet)
+			}
+		})
+	}
+}
+
+func TestSearchComments(t *testing.T) {
+	t.Parallel()
+
+	newHits := func() []posts.CommentSearchHit {
+		return []posts.CommentSearchHit{
+			{Comment: &domain.Comment{ID: 5, Content: "go <script>alert(1)</script>"}, Snippet: "<mark>go</mark>", Rank: 0.5},
+			{Comment: &domain.Comment{ID: 4}, Snippet: "<mark>go</mark>", Rank: 0.5},
+		}
+	}
+
+	nextCursor, err := posts.EncodeSearchCursor(posts.SearchCursor{Rank: 0.5, ID: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		input          posts.SearchInput
+		mock           func(postsStorage *mock_posts.MockPostsStorage)
+		wantCommentIDs []uint
+		wantNextCursor string
+		wantTotal      uint
+		wantErr        error
+	}{
+		{
+			name:  "first page",
+			input: posts.SearchInput{UserID: 1, UserSubIDs: []uint{2}, Query: "go", Amount: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchComments(gomock.Any(), uint(1), "go", []uint{1, 2}, posts.SearchCursor{}, uint(2)).Return(newHits(), nil)
+				postsStorage.EXPECT().CountSearchComments(gomock.Any(), uint(1), "go", []uint{1, 2}).Return(uint(2), nil)
+			},
+			wantCommentIDs: []uint{5},
+			wantNextCursor: nextCursor,
+			wantTotal:      2,
+		},
+		{
+			name:  "last page",
+			input: posts.SearchInput{UserID: 1, Query: "go", Cursor: nextCursor, Amount: 1},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchComments(gomock.Any(), uint(1), "go", []uint{1}, posts.SearchCursor{Rank: 0.5, ID: 5}, uint(2)).Return(newHits()[1:], nil)
+				postsStorage.EXPECT().CountSearchComments(gomock.Any(), uint(1), "go", []uint{1}).Return(uint(2), nil)
+			},
+			wantCommentIDs: []uint{4},
+			wantTotal:      2,
+		},
+		{
+			name:    "empty query",
+			input:   posts.SearchInput{UserID: 1},
+			mock:    func(postsStorage *mock_posts.MockPostsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:  "storage error",
+			input: posts.SearchInput{UserID: 1, Query: "go"},
+			mock: func(postsStorage *mock_posts.MockPostsStorage) {
+				postsStorage.EXPECT().SearchComments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postsStorage := mock_posts.NewMockPostsStorage(ctrl)
+
+			s := posts.NewPostsService(postsStorage, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(postsStorage)
+
+			hits, gotNextCursor, gotTotal, err := s.SearchComments(context.Background(), tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNextCursor, gotNextCursor)
+			assert.Equal(t, tt.wantTotal, gotTotal)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotCommentIDs := make([]uint, 0, len(hits))
+			for _, hit := range hits {
+				gotCommentIDs = append(gotCommentIDs, hit.Comment.ID)
+				assert.NotContains(t, hit.Comment.Content, "<script>")
+			}
+
+			assert.Equal(t, tt.wantCommentIDs, gotCommentIDs)
+		})
+	}
+}
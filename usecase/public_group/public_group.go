@@ -6,10 +6,16 @@ import (
 	"socio/errors"
 	"socio/pkg/sanitizer"
 	"socio/pkg/static"
+	"socio/pkg/upload"
 
 	"github.com/microcosm-cc/bluemonday"
 )
 
+const (
+	DefaultSearchAmount = uint(20)
+	MaxSearchAmount     = uint(100)
+)
+
 //easyjson:json
 type PublicGroupWithInfo struct {
 	PublicGroup  *domain.PublicGroup `json:"publicGroup"`
@@ -18,7 +24,8 @@ type PublicGroupWithInfo struct {
 
 type PublicGroupStorage interface {
 	GetPublicGroupByID(ctx context.Context, groupID uint, userID uint) (publicGroupWithInfo *PublicGroupWithInfo, err error)
-	SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID uint) (publicGroups []*PublicGroupWithInfo, err error)
+	SearchPublicGroupsByNameWithInfo(ctx context.Context, query string, userID uint, lastGroupID uint, limit uint) (publicGroups []*PublicGroupWithInfo, err error)
+	CountPublicGroupsByName(ctx context.Context, query string) (count uint, err error)
 	StorePublicGroup(ctx context.Context, publicGroup *domain.PublicGroup) (newGroup *domain.PublicGroup, err error)
 	UpdatePublicGroup(ctx context.Context, publicGroup *domain.PublicGroup) (updatedGroup *domain.PublicGroup, err error)
 	DeletePublicGroup(ctx context.Context, publicGroupID uint) (err error)
@@ -64,12 +71,32 @@ func (s *Service) GetByID(ctx context.Context, groupID uint, userID uint) (publi
 	return
 }
 
-func (s *Service) SearchByName(ctx context.Context, query string, userID uint) (publicGroups []*PublicGroupWithInfo, err error) {
-	publicGroups, err = s.PublicGroupStorage.SearchPublicGroupsByNameWithInfo(ctx, query, userID)
+// SearchByName returns up to amount groups whose name contains the query and
+// whose ID is greater than lastGroupID, ordered by ID, and the total amount of
+// the groups found.
+func (s *Service) SearchByName(ctx context.Context, query string, userID uint, lastGroupID uint, amount uint) (publicGroups []*PublicGroupWithInfo, total uint, err error) {
+	if amount == 0 {
+		amount = DefaultSearchAmount
+	}
+
+	if amount > MaxSearchAmount {
+		amount = MaxSearchAmount
+	}
+
+	publicGroups, err = s.PublicGroupStorage.SearchPublicGroupsByNameWithInfo(ctx, query, userID, lastGroupID, amount)
 	if err != nil {
 		return
 	}
 
+	// the first page holds every group found when it is not full
+	total = uint(len(publicGroups))
+	if lastGroupID != 0 || total == amount {
+		total, err = s.PublicGroupStorage.CountPublicGroupsByName(ctx, query)
+		if err != nil {
+			return
+		}
+	}
+
 	for _, publicGroup := range publicGroups {
 		s.Sanitizer.SanitizePublicGroup(publicGroup.PublicGroup)
 	}
@@ -212,8 +239,8 @@ func (s *Service) Unsubscribe(ctx context.Context, publicGroupSubscription *doma
 	return
 }
 
-func (s *Service) UploadAvatar(fileName string, filePath string, contentType string) (err error) {
-	err = s.AvatarStorage.Store(fileName, filePath, contentType)
+func (s *Service) UploadAvatar(fileName string, filePath string) (meta *upload.Metadata, err error) {
+	meta, err = upload.Process(s.AvatarStorage, upload.GroupAvatarKind, fileName, filePath)
 	if err != nil {
 		return
 	}
@@ -1,12 +1,20 @@
 package publicgroup_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
 	"reflect"
 	"socio/domain"
 	"socio/errors"
 	mock_publicgroup "socio/mocks/usecase/public_group"
 	"socio/pkg/static"
+	"socio/pkg/upload"
 	publicgroup "socio/usecase/public_group"
 	"testing"
 
@@ -86,8 +94,10 @@ func TestSearchByName(t *testing.T) {
 		name       string
 		query      string
 		userID     uint
+		amount     uint
 		mock       func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint)
 		wantGroups []*publicgroup.PublicGroupWithInfo
+		wantTotal  uint
 		wantErr    bool
 	}{
 		{
@@ -95,21 +105,58 @@ func TestSearchByName(t *testing.T) {
 			query:  "test",
 			userID: 1,
 			mock: func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint) {
-				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID).Return([]*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}}, nil)
+				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID, uint(0), publicgroup.DefaultSearchAmount).Return([]*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}}, nil)
 			},
 			wantGroups: []*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}},
+			wantTotal:  1,
 			wantErr:    false,
 		},
+		{
+			name:   "Test full page is counted",
+			query:  "test",
+			userID: 1,
+			amount: 1,
+			mock: func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint) {
+				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID, uint(0), uint(1)).Return([]*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}}, nil)
+				publicGroupStorage.EXPECT().CountPublicGroupsByName(gomock.Any(), query).Return(uint(4), nil)
+			},
+			wantGroups: []*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}},
+			wantTotal:  4,
+			wantErr:    false,
+		},
+		{
+			name:   "Test count error",
+			query:  "test",
+			userID: 1,
+			amount: 1,
+			mock: func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint) {
+				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID, uint(0), uint(1)).Return([]*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}}, nil)
+				publicGroupStorage.EXPECT().CountPublicGroupsByName(gomock.Any(), query).Return(uint(0), errors.ErrInternal)
+			},
+			wantGroups: []*publicgroup.PublicGroupWithInfo{{PublicGroup: &domain.PublicGroup{ID: 1}}},
+			wantErr:    true,
+		},
 		{
 			name:   "Test Error",
 			query:  "test",
 			userID: 1,
 			mock: func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint) {
-				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID).Return(nil, errors.ErrInternal)
+				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID, uint(0), publicgroup.DefaultSearchAmount).Return(nil, errors.ErrInternal)
 			},
 			wantGroups: nil,
 			wantErr:    true,
 		},
+		{
+			name:   "Test amount is limited",
+			query:  "test",
+			userID: 1,
+			amount: 1000,
+			mock: func(publicGroupStorage *mock_publicgroup.MockPublicGroupStorage, query string, userID uint) {
+				publicGroupStorage.EXPECT().SearchPublicGroupsByNameWithInfo(gomock.Any(), query, userID, uint(0), publicgroup.MaxSearchAmount).Return(nil, nil)
+			},
+			wantGroups: nil,
+			wantErr:    false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -123,7 +170,7 @@ func TestSearchByName(t *testing.T) {
 
 			tt.mock(publicGroupStorage, tt.query, tt.userID)
 
-			gotGroups, err := s.SearchByName(context.Background(), tt.query, tt.userID)
+			gotGroups, gotTotal, err := s.SearchByName(context.Background(), tt.query, tt.userID, 0, tt.amount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SearchByName() error = %v, wantErr %v", err, tt.wantErr)
@@ -132,6 +179,10 @@ func TestSearchByName(t *testing.T) {
 			if !reflect.DeepEqual(gotGroups, tt.wantGroups) {
 				t.Errorf("SearchByName() gotGroups = %v, want %v", gotGroups, tt.wantGroups)
 			}
+
+			if gotTotal != tt.wantTotal {
+				t.Errorf("SearchByName() gotTotal = %v, want %v", gotTotal, tt.wantTotal)
+			}
 		})
 	}
 }
@@ -620,33 +671,58 @@ func TestUnsubscribe(t *testing.T) {
 func TestUploadAvatar(t *testing.T) {
 	t.Parallel()
 
+	img := new(bytes.Buffer)
+	if err := png.Encode(img, image.NewRGBA(image.Rect(0, 0, 640, 480))); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(img.Bytes())
+
 	tests := []struct {
-		name        string
-		fileName    string
-		filePath    string
-		contentType string
-		mock        func(avatarStorage *mock_publicgroup.MockAvatarStorage, fileName string, filePath string, contentType string)
-		wantErr     bool
+		name     string
+		data     []byte
+		mock     func(avatarStorage *mock_publicgroup.MockAvatarStorage)
+		wantMeta *upload.Metadata
+		wantErr  error
 	}{
 		{
-			name:        "Test OK",
-			fileName:    "avatar.jpg",
-			filePath:    "/path/to/avatar.jpg",
-			contentType: "image/jpeg",
-			mock: func(avatarStorage *mock_publicgroup.MockAvatarStorage, fileName string, filePath string, contentType string) {
-				avatarStorage.EXPECT().Store(fileName, filePath, contentType).Return(nil)
+			name: "Test OK",
+			data: img.Bytes(),
+			mock: func(avatarStorage *mock_publicgroup.MockAvatarStorage) {
+				avatarStorage.EXPECT().Store("avatar.png", gomock.Any(), "image/png").Return(nil)
+				avatarStorage.EXPECT().Store("avatar_small.png", gomock.Any(), "image/png").Return(nil)
+				avatarStorage.EXPECT().Store("avatar_medium.png", gomock.Any(), "image/png").Return(nil)
 			},
-			wantErr: false,
+			wantMeta: &upload.Metadata{
+				FileName:    "avatar.png",
+				ContentType: "image/png",
+				Size:        int64(img.Len()),
+				Width:       640,
+				Height:      480,
+				Checksum:    hex.EncodeToString(sum[:]),
+				Variants: []*upload.Variant{
+					{Name: "small", FileName: "avatar_small.png", Width: 64, Height: 48},
+					{Name: "medium", FileName: "avatar_medium.png", Width: 256, Height: 192},
+				},
+			},
+			wantErr: nil,
 		},
 		{
-			name:        "Test Error",
-			fileName:    "avatar.jpg",
-			filePath:    "/path/to/avatar.jpg",
-			contentType: "image/jpeg",
-			mock: func(avatarStorage *mock_publicgroup.MockAvatarStorage, fileName string, filePath string, contentType string) {
-				avatarStorage.EXPECT().Store(fileName, filePath, contentType).Return(errors.ErrInternal)
+			name: "Test unsupported media type",
+			data: []byte("<html><script>alert(1)</script></html>"),
+			mock: func(avatarStorage *mock_publicgroup.MockAvatarStorage) {
 			},
-			wantErr: true,
+			wantMeta: nil,
+			wantErr:  errors.ErrUnsupportedMediaType,
+		},
+		{
+			name: "Test Error",
+			data: img.Bytes(),
+			mock: func(avatarStorage *mock_publicgroup.MockAvatarStorage) {
+				avatarStorage.EXPECT().Store("avatar.png", gomock.Any(), "image/png").Return(errors.ErrInternal)
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrInternal,
 		},
 	}
 
@@ -655,17 +731,26 @@ func TestUploadAvatar(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			filePath := filepath.Join(t.TempDir(), "upload")
+			if err := os.WriteFile(filePath, tt.data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
 			avatarStorage := mock_publicgroup.NewMockAvatarStorage(ctrl)
 
 			s := publicgroup.NewService(nil, avatarStorage)
 
-			tt.mock(avatarStorage, tt.fileName, tt.filePath, tt.contentType)
+			tt.mock(avatarStorage)
 
-			err := s.UploadAvatar(tt.fileName, tt.filePath, tt.contentType)
+			meta, err := s.UploadAvatar("avatar.png", filePath)
 
-			if (err != nil) != tt.wantErr {
+			if err != tt.wantErr {
 				t.Errorf("UploadAvatar() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			if !reflect.DeepEqual(meta, tt.wantMeta) {
+				t.Errorf("UploadAvatar() meta = %v, want %v", meta, tt.wantMeta)
+			}
 		})
 	}
 }
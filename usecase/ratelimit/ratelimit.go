@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"socio/pkg/appmetrics"
+	"socio/pkg/contextlogger"
+	customtime "socio/pkg/time"
+	"time"
+)
+
+const (
+	AllowedDecision = "allowed"
+	LimitedDecision = "limited"
+	FailedDecision  = "failed"
+)
+
+// Limit allows Requests requests of the subject within the sliding Window.
+type Limit struct {
+	Requests uint
+	Window   time.Duration
+}
+
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+type RateLimitStorage interface {
+	Allow(ctx context.Context, key string, limit uint, window time.Duration, now time.Time) (allowed bool, retryAfter time.Duration, err error)
+}
+
+type Service struct {
+	RateLimitStorage RateLimitStorage
+	TimeProvider     customtime.TimeProvider
+}
+
+func NewRateLimitService(rateLimitStorage RateLimitStorage) (service *Service) {
+	service = &Service{
+		RateLimitStorage: rateLimitStorage,
+		TimeProvider:     customtime.RealTimeProvider{},
+	}
+	return
+}
+
+// Allow counts the request of the subject within the scope, the scope is the
+// route, the method or the action the limit is set for. The request is allowed
+// if the storage fails, the limiter must not take the service down with it.
+func (s *Service) Allow(ctx context.Context, scope, subject string, limit Limit) (decision Decision) {
+	allowed, retryAfter, err := s.RateLimitStorage.Allow(ctx, scope+":"+subject, limit.Requests, limit.Window, s.TimeProvider.Now())
+	if err != nil {
+		contextlogger.LogErr(ctx, err)
+		appmetrics.RateLimitDecisions.WithLabelValues(scope, FailedDecision).Inc()
+
+		decision.Allowed = true
+		return
+	}
+
+	decision = Decision{
+		Allowed:    allowed,
+		RetryAfter: retryAfter,
+	}
+
+	if allowed {
+		appmetrics.RateLimitDecisions.WithLabelValues(scope, AllowedDecision).Inc()
+	} else {
+		appmetrics.RateLimitDecisions.WithLabelValues(scope, LimitedDecision).Inc()
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package ratelimit_test
+
+import (
+	"context"
+	"socio/errors"
+	mock_ratelimit "socio/mocks/usecase/ratelimit"
+	customtime "socio/pkg/time"
+	"socio/usecase/ratelimit"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllow(t *testing.T) {
+	t.Parallel()
+
+	limit := ratelimit.Limit{Requests: 10, Window: time.Minute}
+
+	tests := []struct {
+		name         string
+		scope        string
+		subject      string
+		mock         func(storage *mock_ratelimit.MockRateLimitStorage)
+		wantDecision ratelimit.Decision
+	}{
+		{
+			name:    "Test allowed",
+			scope:   "POST /auth/login",
+			subject: "127.0.0.1",
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), "POST /auth/login:127.0.0.1", uint(10), time.Minute, customtime.MockTimeProvider{}.Now()).Return(true, time.Duration(0), nil)
+			},
+			wantDecision: ratelimit.Decision{Allowed: true},
+		},
+		{
+			name:    "Test limited",
+			scope:   "POST /posts/like",
+			subject: "1",
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), "POST /posts/like:1", uint(10), time.Minute, customtime.MockTimeProvider{}.Now()).Return(false, 15*time.Second, nil)
+			},
+			wantDecision: ratelimit.Decision{Allowed: false, RetryAfter: 15 * time.Second},
+		},
+		{
+			name:    "Test storage error",
+			scope:   "SEND_MESSAGE",
+			subject: "1",
+			mock: func(storage *mock_ratelimit.MockRateLimitStorage) {
+				storage.EXPECT().Allow(gomock.Any(), "SEND_MESSAGE:1", uint(10), time.Minute, customtime.MockTimeProvider{}.Now()).Return(false, time.Duration(0), errors.ErrInternal)
+			},
+			wantDecision: ratelimit.Decision{Allowed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_ratelimit.NewMockRateLimitStorage(ctrl)
+			tt.mock(storage)
+
+			service := ratelimit.NewRateLimitService(storage)
+			service.TimeProvider = customtime.MockTimeProvider{}
+
+			decision := service.Allow(context.Background(), tt.scope, tt.subject, limit)
+			assert.Equal(t, tt.wantDecision, decision)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package subscriptions
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+)
+
+//easyjson:json
+type GetBlockedUsersResponse struct {
+	BlockedUsers []*domain.User `json:"blockedUsers"`
+}
+
+// Block blocks BlockedID for BlockerID. The storage removes the subscriptions
+// of the users to each other along with storing the block, they can not be
+// restored while the block is on.
+func (s *Service) Block(ctx context.Context, block *domain.Block) (newBlock *domain.Block, err error) {
+	if block.BlockerID == block.BlockedID {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = s.UserStorage.GetUserByID(ctx, block.BlockerID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = s.UserStorage.GetUserByID(ctx, block.BlockedID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	newBlock, err = s.SubscriptionsStorage.StoreBlock(ctx, block)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) Unblock(ctx context.Context, blockerID, blockedID uint) (err error) {
+	err = s.SubscriptionsStorage.DeleteBlock(ctx, blockerID, blockedID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetBlockedUsers(ctx context.Context, blockerID uint) (blockedUsers []*domain.User, err error) {
+	blockedUsers, err = s.SubscriptionsStorage.GetBlockedUsers(ctx, blockerID)
+	if err != nil {
+		return
+	}
+
+	err = s.hideDatesOfBirth(ctx, blockerID, blockedUsers)
+	if err != nil {
+		return
+	}
+
+	return
+}
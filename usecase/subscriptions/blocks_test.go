@@ -0,0 +1,154 @@
+package subscriptions_test
+
+import (
+	"context"
+	"reflect"
+	"socio/domain"
+	"socio/errors"
+	mock_subscriptions "socio/mocks/usecase/subscriptions"
+	"socio/usecase/subscriptions"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestService_Block(t *testing.T) {
+	tests := []struct {
+		name        string
+		block       *domain.Block
+		wantBlock   *domain.Block
+		wantErr     error
+		prepareMock func(*fields)
+	}{
+		{
+			name:      "success",
+			block:     &domain.Block{BlockerID: 1, BlockedID: 2},
+			wantBlock: &domain.Block{ID: 1, BlockerID: 1, BlockedID: 2},
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{}, nil)
+				f.SubscriptionsStorage.EXPECT().StoreBlock(gomock.Any(), &domain.Block{BlockerID: 1, BlockedID: 2}).Return(&domain.Block{ID: 1, BlockerID: 1, BlockedID: 2}, nil)
+			},
+		},
+		{
+			name:    "error self block",
+			block:   &domain.Block{BlockerID: 1, BlockedID: 1},
+			wantErr: errors.ErrInvalidBody,
+		},
+		{
+			name:    "error blocked user not found",
+			block:   &domain.Block{BlockerID: 1, BlockedID: 2},
+			wantErr: errors.ErrInvalidBody,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(nil, errors.ErrNotFound)
+			},
+		},
+		{
+			name:    "error already blocked",
+			block:   &domain.Block{BlockerID: 1, BlockedID: 2},
+			wantErr: errors.ErrInvalidBody,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&domain.User{}, nil).Times(2)
+				f.SubscriptionsStorage.EXPECT().StoreBlock(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidBody)
+			},
+		},
+		{
+			name:    "error delete subscriptions",
+			block:   &domain.Block{BlockerID: 1, BlockedID: 2},
+			wantErr: errors.ErrInternal,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&domain.User{}, nil).Times(2)
+				f.SubscriptionsStorage.EXPECT().StoreBlock(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f := fields{
+				SubscriptionsStorage: mock_subscriptions.NewMockSubscriptionsStorage(ctrl),
+				UserStorage:          mock_subscriptions.NewMockUserStorage(ctrl),
+			}
+
+			if tt.prepareMock != nil {
+				tt.prepareMock(&f)
+			}
+
+			s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
+
+			gotBlock, err := s.Block(context.Background(), tt.block)
+			if err != tt.wantErr {
+				t.Errorf("Service.Block() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(gotBlock, tt.wantBlock) {
+				t.Errorf("Service.Block() = %v, want %v", gotBlock, tt.wantBlock)
+			}
+		})
+	}
+}
+
+func TestService_Unblock(t *testing.T) {
+	tests := []struct {
+		name        string
+		wantErr     error
+		prepareMock func(*fields)
+	}{
+		{
+			name: "success",
+			prepareMock: func(f *fields) {
+				f.SubscriptionsStorage.EXPECT().DeleteBlock(gomock.Any(), uint(1), uint(2)).Return(nil)
+			},
+		},
+		{
+			name:    "error not blocked",
+			wantErr: errors.ErrNotFound,
+			prepareMock: func(f *fields) {
+				f.SubscriptionsStorage.EXPECT().DeleteBlock(gomock.Any(), uint(1), uint(2)).Return(errors.ErrNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f := fields{
+				SubscriptionsStorage: mock_subscriptions.NewMockSubscriptionsStorage(ctrl),
+				UserStorage:          mock_subscriptions.NewMockUserStorage(ctrl),
+			}
+
+			tt.prepareMock(&f)
+
+			s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
+
+			err := s.Unblock(context.Background(), 1, 2)
+			if err != tt.wantErr {
+				t.Errorf("Service.Unblock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetBlockedUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := fields{
+		SubscriptionsStorage: mock_subscriptions.NewMockSubscriptionsStorage(ctrl),
+		UserStorage:          mock_subscriptions.NewMockUserStorage(ctrl),
+	}
+
+	f.SubscriptionsStorage.EXPECT().GetBlockedUsers(gomock.Any(), uint(1)).Return([]*domain.User{{ID: 2}}, nil)
+	f.UserStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{2}).Return(nil, nil)
+
+	s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
+
+	got, err := s.GetBlockedUsers(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Service.GetBlockedUsers() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, []*domain.User{{ID: 2}}) {
+		t.Errorf("Service.GetBlockedUsers() = %v", got)
+	}
+}
@@ -1,140 +1,229 @@
-package subscriptions
-
-import (
-	"context"
-	"socio/domain"
-	"socio/errors"
-)
-
-type SubscriptionsStorage interface {
-	Store(ctx context.Context, sub *domain.Subscription) (subscription *domain.Subscription, err error)
-	Delete(ctx context.Context, subscriberID uint, subscibedToID uint) (err error)
-	GetBySubscriberAndSubscribedToID(ctx context.Context, subscriberID uint, subscribedToID uint) (subscription *domain.Subscription, err error)
-	GetSubscriptions(ctx context.Context, userID uint) (subscriptions []*domain.User, err error)
-	GetSubscribers(ctx context.Context, userID uint) (subscribers []*domain.User, err error)
-	GetFriends(ctx context.Context, userID uint) (friends []*domain.User, err error)
-}
-
-type UserStorage interface {
-	GetUserByID(ctx context.Context, userID uint) (user *domain.User, err error)
-}
-
-type Service struct {
-	SubscriptionsStorage SubscriptionsStorage
-	UserStorage          UserStorage
-}
-
-//easyjson:json
-type GetSubscriptionsResponse struct {
-	Subscriptions []*domain.User `json:"subscriptions"`
-}
-
-//easyjson:json
-type GetSubscribersResponse struct {
-	Subscribers []*domain.User `json:"subscribers"`
-}
-
-//easyjson:json
-type GetFriendsResponse struct {
-	Friends []*domain.User `json:"friends"`
-}
-
-func NewService(subStorage SubscriptionsStorage, userStorage UserStorage) (service *Service) {
-	service = &Service{
-		SubscriptionsStorage: subStorage,
-		UserStorage:          userStorage,
-	}
-	return
-}
-
-func (s *Service) Subscribe(ctx context.Context, sub *domain.Subscription) (subscription *domain.Subscription, err error) {
-	if sub.SubscriberID == sub.SubscribedToID {
-		err = errors.ErrInvalidBody
-		return
-	}
-
-	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscriberID)
-	if err != nil {
-		err = errors.ErrInvalidBody
-		return
-	}
-
-	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscribedToID)
-	if err != nil {
-		err = errors.ErrInvalidBody
-		return
-	}
-
-	subscription, err = s.SubscriptionsStorage.Store(ctx, sub)
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (s *Service) Unsubscribe(ctx context.Context, sub *domain.Subscription) (err error) {
-	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscriberID)
-	if err != nil {
-		err = errors.ErrInvalidBody
-		return
-	}
-
-	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscribedToID)
-	if err != nil {
-		err = errors.ErrInvalidBody
-		return
-	}
-
-	err = s.SubscriptionsStorage.Delete(ctx, sub.SubscriberID, sub.SubscribedToID)
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (s *Service) GetSubscriptions(ctx context.Context, userID uint) (subscriptions []*domain.User, err error) {
-	_, err = s.UserStorage.GetUserByID(ctx, userID)
-	if err != nil {
-		err = errors.ErrNotFound
-		return
-	}
-
-	subscriptions, err = s.SubscriptionsStorage.GetSubscriptions(ctx, userID)
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (s *Service) GetSubscribers(ctx context.Context, userID uint) (subscribers []*domain.User, err error) {
-	_, err = s.UserStorage.GetUserByID(ctx, userID)
-	if err != nil {
-		err = errors.ErrNotFound
-		return
-	}
-
-	subscribers, err = s.SubscriptionsStorage.GetSubscribers(ctx, userID)
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (s *Service) GetFriends(ctx context.Context, userID uint) (friends []*domain.User, err error) {
-	_, err = s.UserStorage.GetUserByID(ctx, userID)
-	if err != nil {
-		err = errors.ErrNotFound
-		return
-	}
-
-	friends, err = s.SubscriptionsStorage.GetFriends(ctx, userID)
-	if err != nil {
-		return
-	}
-
-	return
-}
+package subscriptions
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+)
+
+type SubscriptionsStorage interface {
+	Store(ctx context.Context, sub *domain.Subscription) (subscription *domain.Subscription, err error)
+	Delete(ctx context.Context, subscriberID uint, subscibedToID uint) (err error)
+	GetBySubscriberAndSubscribedToID(ctx context.Context, subscriberID uint, subscribedToID uint) (subscription *domain.Subscription, err error)
+	GetSubscriptions(ctx context.Context, userID uint) (subscriptions []*domain.User, err error)
+	GetSubscribers(ctx context.Context, userID uint) (subscribers []*domain.User, err error)
+	GetFriends(ctx context.Context, userID uint) (friends []*domain.User, err error)
+	StoreBlock(ctx context.Context, block *domain.Block) (newBlock *domain.Block, err error)
+	DeleteBlock(ctx context.Context, blockerID, blockedID uint) (err error)
+	GetBlockedUsers(ctx context.Context, blockerID uint) (blockedUsers []*domain.User, err error)
+	IsBlocked(ctx context.Context, userID, peerID uint) (blocked bool, err error)
+}
+
+type UserStorage interface {
+	GetUserByID(ctx context.Context, userID uint) (user *domain.User, err error)
+	CanViewSubscriptions(ctx context.Context, userID, viewerID uint) (allowed bool, err error)
+	GetDateOfBirthViewableIDs(ctx context.Context, viewerID uint, userIDs []uint) (viewableIDs []uint, err error)
+}
+
+type Service struct {
+	SubscriptionsStorage SubscriptionsStorage
+	UserStorage          UserStorage
+}
+
+//easyjson:json
+type GetSubscriptionsResponse struct {
+	Subscriptions []*domain.User `json:"subscriptions"`
+}
+
+//easyjson:json
+type GetSubscribersResponse struct {
+	Subscribers []*domain.User `json:"subscribers"`
+}
+
+//easyjson:json
+type GetFriendsResponse struct {
+	Friends []*domain.User `json:"friends"`
+}
+
+func NewService(subStorage SubscriptionsStorage, userStorage UserStorage) (service *Service) {
+	service = &Service{
+		SubscriptionsStorage: subStorage,
+		UserStorage:          userStorage,
+	}
+	return
+}
+
+func (s *Service) Subscribe(ctx context.Context, sub *domain.Subscription) (subscription *domain.Subscription, err error) {
+	if sub.SubscriberID == sub.SubscribedToID {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscriberID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscribedToID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	blocked, err := s.SubscriptionsStorage.IsBlocked(ctx, sub.SubscriberID, sub.SubscribedToID)
+	if err != nil {
+		return
+	}
+
+	if blocked {
+		err = errors.ErrForbidden
+		return
+	}
+
+	subscription, err = s.SubscriptionsStorage.Store(ctx, sub)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) Unsubscribe(ctx context.Context, sub *domain.Subscription) (err error) {
+	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscriberID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = s.UserStorage.GetUserByID(ctx, sub.SubscribedToID)
+	if err != nil {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	err = s.SubscriptionsStorage.Delete(ctx, sub.SubscriberID, sub.SubscribedToID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetSubscriptions(ctx context.Context, userID, viewerID uint) (subscriptions []*domain.User, err error) {
+	_, err = s.UserStorage.GetUserByID(ctx, userID)
+	if err != nil {
+		err = errors.ErrNotFound
+		return
+	}
+
+	err = s.checkSubscriptionsPrivacy(ctx, userID, viewerID)
+	if err != nil {
+		return
+	}
+
+	subscriptions, err = s.SubscriptionsStorage.GetSubscriptions(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	err = s.hideDatesOfBirth(ctx, viewerID, subscriptions)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetSubscribers(ctx context.Context, userID, viewerID uint) (subscribers []*domain.User, err error) {
+	_, err = s.UserStorage.GetUserByID(ctx, userID)
+	if err != nil {
+		err = errors.ErrNotFound
+		return
+	}
+
+	err = s.checkSubscriptionsPrivacy(ctx, userID, viewerID)
+	if err != nil {
+		return
+	}
+
+	subscribers, err = s.SubscriptionsStorage.GetSubscribers(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	err = s.hideDatesOfBirth(ctx, viewerID, subscribers)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Service) GetFriends(ctx context.Context, userID, viewerID uint) (friends []*domain.User, err error) {
+	_, err = s.UserStorage.GetUserByID(ctx, userID)
+	if err != nil {
+		err = errors.ErrNotFound
+		return
+	}
+
+	err = s.checkSubscriptionsPrivacy(ctx, userID, viewerID)
+	if err != nil {
+		return
+	}
+
+	friends, err = s.SubscriptionsStorage.GetFriends(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	err = s.hideDatesOfBirth(ctx, viewerID, friends)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// checkSubscriptionsPrivacy returns errors.ErrForbidden when the privacy
+// settings of the user hide their subscriptions, subscribers and friends from
+// the viewer.
+func (s *Service) checkSubscriptionsPrivacy(ctx context.Context, userID, viewerID uint) (err error) {
+	if userID == viewerID {
+		return
+	}
+
+	allowed, err := s.UserStorage.CanViewSubscriptions(ctx, userID, viewerID)
+	if err != nil {
+		return
+	}
+
+	if !allowed {
+		err = errors.ErrForbidden
+		return
+	}
+
+	return
+}
+
+// hideDatesOfBirth blanks the dates of birth that the privacy settings of the
+// users hide from the viewer.
+func (s *Service) hideDatesOfBirth(ctx context.Context, viewerID uint, users []*domain.User) (err error) {
+	if len(users) == 0 {
+		return
+	}
+
+	userIDs := make([]uint, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	viewableIDs, err := s.UserStorage.GetDateOfBirthViewableIDs(ctx, viewerID, userIDs)
+	if err != nil {
+		return
+	}
+
+	domain.HideDatesOfBirth(users, viewableIDs)
+
+	return
+}
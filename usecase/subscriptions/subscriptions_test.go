@@ -56,6 +56,7 @@ func TestService_Subscribe(t *testing.T) {
 			prepareMock: func(f *fields) {
 				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
 				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{}, nil)
+				f.SubscriptionsStorage.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
 				f.SubscriptionsStorage.EXPECT().Store(gomock.Any(), gomock.Any()).Return(&domain.Subscription{
 					ID:             1,
 					SubscriberID:   1,
@@ -114,6 +115,23 @@ func TestService_Subscribe(t *testing.T) {
 				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(nil, errors.ErrNotFound)
 			},
 		},
+		{
+			name: "error blocked",
+			args: args{
+				ctx: context.Background(),
+				sub: &domain.Subscription{
+					SubscriberID:   1,
+					SubscribedToID: 2,
+				},
+			},
+			wantSubscription: nil,
+			wantErr:          true,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{}, nil)
+				f.SubscriptionsStorage.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(true, nil)
+			},
+		},
 		{
 			name: "error store",
 			args: args{
@@ -128,6 +146,7 @@ func TestService_Subscribe(t *testing.T) {
 			prepareMock: func(f *fields) {
 				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
 				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{}, nil)
+				f.SubscriptionsStorage.EXPECT().IsBlocked(gomock.Any(), uint(1), uint(2)).Return(false, nil)
 				f.SubscriptionsStorage.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInternal)
 			},
 		},
@@ -256,8 +275,9 @@ func TestService_Unsubscribe(t *testing.T) {
 
 func TestService_GetSubscriptions(t *testing.T) {
 	type args struct {
-		ctx    context.Context
-		userID uint
+		ctx      context.Context
+		userID   uint
+		viewerID uint
 	}
 
 	tests := []struct {
@@ -270,8 +290,9 @@ func TestService_GetSubscriptions(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscriptions: []*domain.User{
 				{
@@ -286,13 +307,15 @@ func TestService_GetSubscriptions(t *testing.T) {
 						ID: 2,
 					},
 				}, nil)
+				f.UserStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{2}).Return([]uint{2}, nil)
 			},
 		},
 		{
 			name: "error user",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscriptions: nil,
 			wantErr:           true,
@@ -303,8 +326,9 @@ func TestService_GetSubscriptions(t *testing.T) {
 		{
 			name: "error subscriptions",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscriptions: nil,
 			wantErr:           true,
@@ -313,6 +337,44 @@ func TestService_GetSubscriptions(t *testing.T) {
 				f.SubscriptionsStorage.EXPECT().GetSubscriptions(gomock.Any(), uint(1)).Return(nil, errors.ErrInternal)
 			},
 		},
+		{
+			name: "success friend",
+			args: args{
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 3,
+			},
+			wantSubscriptions: []*domain.User{
+				{
+					ID: 3,
+				},
+			},
+			wantErr: false,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
+				f.UserStorage.EXPECT().CanViewSubscriptions(gomock.Any(), uint(1), uint(3)).Return(true, nil)
+				f.SubscriptionsStorage.EXPECT().GetSubscriptions(gomock.Any(), uint(1)).Return([]*domain.User{
+					{
+						ID: 3,
+					},
+				}, nil)
+				f.UserStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(3), []uint{3}).Return([]uint{3}, nil)
+			},
+		},
+		{
+			name: "hidden by privacy settings",
+			args: args{
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 3,
+			},
+			wantSubscriptions: nil,
+			wantErr:           true,
+			prepareMock: func(f *fields) {
+				f.UserStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{}, nil)
+				f.UserStorage.EXPECT().CanViewSubscriptions(gomock.Any(), uint(1), uint(3)).Return(false, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -329,7 +391,7 @@ func TestService_GetSubscriptions(t *testing.T) {
 
 			s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
 
-			gotSubscriptions, err := s.GetSubscriptions(tt.args.ctx, tt.args.userID)
+			gotSubscriptions, err := s.GetSubscriptions(tt.args.ctx, tt.args.userID, tt.args.viewerID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.GetSubscriptions() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -343,8 +405,9 @@ func TestService_GetSubscriptions(t *testing.T) {
 
 func TestService_GetSubscribers(t *testing.T) {
 	type args struct {
-		ctx    context.Context
-		userID uint
+		ctx      context.Context
+		userID   uint
+		viewerID uint
 	}
 	tests := []struct {
 		name            string
@@ -356,8 +419,9 @@ func TestService_GetSubscribers(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscribers: []*domain.User{
 				{
@@ -372,13 +436,15 @@ func TestService_GetSubscribers(t *testing.T) {
 						ID: 2,
 					},
 				}, nil)
+				f.UserStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{2}).Return([]uint{2}, nil)
 			},
 		},
 		{
 			name: "error user",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscribers: nil,
 			wantErr:         true,
@@ -389,8 +455,9 @@ func TestService_GetSubscribers(t *testing.T) {
 		{
 			name: "error subscribers",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantSubscribers: nil,
 			wantErr:         true,
@@ -415,7 +482,7 @@ func TestService_GetSubscribers(t *testing.T) {
 
 			s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
 
-			gotSubscribers, err := s.GetSubscribers(tt.args.ctx, tt.args.userID)
+			gotSubscribers, err := s.GetSubscribers(tt.args.ctx, tt.args.userID, tt.args.viewerID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.GetSubscribers() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -429,8 +496,9 @@ func TestService_GetSubscribers(t *testing.T) {
 
 func TestService_GetFriends(t *testing.T) {
 	type args struct {
-		ctx    context.Context
-		userID uint
+		ctx      context.Context
+		userID   uint
+		viewerID uint
 	}
 
 	tests := []struct {
@@ -443,8 +511,9 @@ func TestService_GetFriends(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantFriends: []*domain.User{
 				{
@@ -459,13 +528,15 @@ func TestService_GetFriends(t *testing.T) {
 						ID: 2,
 					},
 				}, nil)
+				f.UserStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{2}).Return([]uint{2}, nil)
 			},
 		},
 		{
 			name: "error user",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantFriends: nil,
 			wantErr:     true,
@@ -476,8 +547,9 @@ func TestService_GetFriends(t *testing.T) {
 		{
 			name: "error friends",
 			args: args{
-				ctx:    context.Background(),
-				userID: 1,
+				ctx:      context.Background(),
+				userID:   1,
+				viewerID: 1,
 			},
 			wantFriends: nil,
 			wantErr:     true,
@@ -502,7 +574,7 @@ func TestService_GetFriends(t *testing.T) {
 
 			s := subscriptions.NewService(f.SubscriptionsStorage, f.UserStorage)
 
-			gotFriends, err := s.GetFriends(tt.args.ctx, tt.args.userID)
+			gotFriends, err := s.GetFriends(tt.args.ctx, tt.args.userID, tt.args.viewerID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.GetFriends() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,444 @@
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/static"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	UploadTTL = 24 * time.Hour
+
+	// SweepInterval is how often the files of the expired uploads are
+	// removed from Dir.
+	SweepInterval = time.Hour
+
+	// MaxOpenUploads bounds the uploads a user may have started and not yet
+	// completed, each of them keeps a file in Dir until it expires.
+	MaxOpenUploads = 10
+
+	// lockTTL bounds the time a chunk may take, a crashed writer can not
+	// block the upload longer than that.
+	lockTTL = 10 * time.Minute
+)
+
+// ResumableKinds are the kinds of files that can be uploaded in chunks.
+var ResumableKinds = []upload.Kind{upload.PostAttachmentKind, upload.AvatarKind, upload.GroupAvatarKind}
+
+type UploadsStorage interface {
+	StoreUpload(ctx context.Context, upload *domain.Upload, ttl time.Duration) (err error)
+	GetUpload(ctx context.Context, uploadID string) (upload *domain.Upload, err error)
+	DeleteUpload(ctx context.Context, uploadID string) (err error)
+	LockUpload(ctx context.Context, uploadID string, ttl time.Duration) (locked bool, err error)
+	UnlockUpload(ctx context.Context, uploadID string) (err error)
+	OpenUpload(ctx context.Context, userID uint, uploadID string, expiresAt time.Time, limit int) (opened bool, err error)
+	CloseUpload(ctx context.Context, userID uint, uploadID string) (err error)
+}
+
+//easyjson:json
+type UploadInput struct {
+	Kind         upload.Kind `json:"kind"`
+	OriginalName string      `json:"originalName"`
+	Length       int64       `json:"length"`
+	Checksum     string      `json:"checksum"`
+}
+
+// Service keeps the resumable uploads. The received chunks are written to a
+// file in Dir, the state of the upload is kept in UploadsStorage.
+type Service struct {
+	UploadsStorage UploadsStorage
+	Dir            string
+	TimeProvider   customtime.TimeProvider
+}
+
+func NewUploadsService(uploadsStorage UploadsStorage, dir string) (uploadsService *Service) {
+	return &Service{
+		UploadsStorage: uploadsStorage,
+		Dir:            dir,
+		TimeProvider:   customtime.RealTimeProvider{},
+	}
+}
+
+func (s *Service) filePath(uploadID string) string {
+	return filepath.Join(s.Dir, uploadID)
+}
+
+func isChecksum(checksum string) bool {
+	sum, err := hex.DecodeString(checksum)
+	return err == nil && len(sum) == sha256.Size
+}
+
+// CreateUpload starts the upload of the file of the declared length, the
+// checksum is the hex encoded SHA-256 the whole file is verified against.
+// The user may have at most MaxOpenUploads uploads open at a time.
+func (s *Service) CreateUpload(ctx context.Context, userID uint, input UploadInput) (newUpload *domain.Upload, err error) {
+	if !slices.Contains(ResumableKinds, input.Kind) || !isChecksum(input.Checksum) {
+		err = errors.ErrInvalidData
+		return
+	}
+
+	err = upload.CheckSize(input.Kind, input.Length)
+	if err != nil {
+		return
+	}
+
+	now := s.TimeProvider.Now()
+	originalName := filepath.Base(input.OriginalName)
+
+	newUpload = &domain.Upload{
+		ID:           uuid.NewString(),
+		UserID:       userID,
+		Kind:         string(input.Kind),
+		FileName:     static.GetUniqueFileName(originalName),
+		OriginalName: originalName,
+		Length:       input.Length,
+		Checksum:     input.Checksum,
+		CreatedAt:    customtime.CustomTime{Time: now},
+		ExpiresAt:    customtime.CustomTime{Time: now.Add(UploadTTL)},
+	}
+
+	opened, err := s.UploadsStorage.OpenUpload(ctx, userID, newUpload.ID, newUpload.ExpiresAt.Time, MaxOpenUploads)
+	if err != nil {
+		newUpload = nil
+		return
+	}
+
+	if !opened {
+		newUpload = nil
+		err = errors.ErrTooManyRequests
+		return
+	}
+
+	file, err := os.Create(s.filePath(newUpload.ID))
+	if err != nil {
+		_ = s.UploadsStorage.CloseUpload(ctx, userID, newUpload.ID)
+		newUpload = nil
+		return
+	}
+
+	err = file.Close()
+	if err != nil {
+		_ = os.Remove(file.Name())
+		_ = s.UploadsStorage.CloseUpload(ctx, userID, newUpload.ID)
+		newUpload = nil
+		return
+	}
+
+	err = s.UploadsStorage.StoreUpload(ctx, newUpload, UploadTTL)
+	if err != nil {
+		_ = os.Remove(file.Name())
+		_ = s.UploadsStorage.CloseUpload(ctx, userID, newUpload.ID)
+		newUpload = nil
+		return
+	}
+
+	return
+}
+
+// GetUpload returns the upload of the user, the uploads of other users are
+// not found.
+func (s *Service) GetUpload(ctx context.Context, userID uint, uploadID string) (userUpload *domain.Upload, err error) {
+	userUpload, err = s.UploadsStorage.GetUpload(ctx, uploadID)
+	if err != nil {
+		return
+	}
+
+	if userUpload.UserID != userID {
+		userUpload = nil
+		err = errors.ErrNotFound
+		return
+	}
+
+	return
+}
+
+// WriteChunk writes the chunk read from r at offset, which must be the offset
+// the upload stopped at. chunkChecksum is the SHA-256 of the chunk, it is not
+// verified if nil. After the last chunk the whole file is verified against
+// the checksum of the upload, the mismatching upload is deleted.
+func (s *Service) WriteChunk(ctx context.Context, userID uint, uploadID string, offset int64, r io.Reader, chunkChecksum []byte) (userUpload *domain.Upload, err error) {
+	locked, err := s.UploadsStorage.LockUpload(ctx, uploadID, lockTTL)
+	if err != nil {
+		return
+	}
+
+	if !locked {
+		err = errors.ErrUploadOffsetMismatch
+		return
+	}
+
+	defer func() {
+		unlockErr := s.UploadsStorage.UnlockUpload(ctx, uploadID)
+		if err == nil && unlockErr != nil {
+			userUpload = nil
+			err = unlockErr
+		}
+	}()
+
+	userUpload, err = s.GetUpload(ctx, userID, uploadID)
+	if err != nil {
+		return
+	}
+
+	if userUpload.Completed || offset != userUpload.Offset {
+		userUpload = nil
+		err = errors.ErrUploadOffsetMismatch
+		return
+	}
+
+	written, err := s.appendChunk(userUpload, r, chunkChecksum)
+	if err != nil {
+		userUpload = nil
+		return
+	}
+
+	userUpload.Offset += written
+
+	if userUpload.Offset == userUpload.Length {
+		var sum string
+
+		sum, err = fileChecksum(s.filePath(uploadID))
+		if err != nil {
+			userUpload = nil
+			return
+		}
+
+		if sum != userUpload.Checksum {
+			err = s.deleteUpload(ctx, userUpload)
+			if err != nil {
+				userUpload = nil
+				return
+			}
+
+			userUpload = nil
+			err = errors.ErrChecksumMismatch
+			return
+		}
+	}
+
+	err = s.storeUpload(ctx, userUpload)
+	if err != nil {
+		userUpload = nil
+		return
+	}
+
+	return
+}
+
+// appendChunk writes the chunk after the received part of the file. The file
+// is truncated back if the chunk is broken, so the client can resend it.
+func (s *Service) appendChunk(userUpload *domain.Upload, r io.Reader, chunkChecksum []byte) (written int64, err error) {
+	file, err := os.OpenFile(s.filePath(userUpload.ID), os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = file.Seek(userUpload.Offset, io.SeekStart)
+	if err != nil {
+		return
+	}
+
+	hash := sha256.New()
+
+	// one byte more than expected tells the too long chunks apart
+	written, err = io.Copy(io.MultiWriter(file, hash), io.LimitReader(r, userUpload.Length-userUpload.Offset+1))
+
+	switch {
+	case err != nil:
+	case userUpload.Offset+written > userUpload.Length:
+		err = errors.ErrFileTooLarge
+	case chunkChecksum != nil && !bytes.Equal(hash.Sum(nil), chunkChecksum):
+		err = errors.ErrChecksumMismatch
+	default:
+		return
+	}
+
+	written = 0
+
+	if truncateErr := file.Truncate(userUpload.Offset); truncateErr != nil {
+		err = truncateErr
+	}
+
+	return
+}
+
+func fileChecksum(filePath string) (sum string, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return
+	}
+
+	sum = hex.EncodeToString(hash.Sum(nil))
+	return
+}
+
+// storeUpload keeps the upload until it expires, the expiration time is set
+// once when the upload is created.
+func (s *Service) storeUpload(ctx context.Context, userUpload *domain.Upload) (err error) {
+	ttl := userUpload.ExpiresAt.Sub(s.TimeProvider.Now())
+	if ttl <= 0 {
+		err = errors.ErrNotFound
+		return
+	}
+
+	err = s.UploadsStorage.StoreUpload(ctx, userUpload, ttl)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// OpenFile opens the received file of the upload for reading.
+func (s *Service) OpenFile(userUpload *domain.Upload) (file *os.File, err error) {
+	return os.Open(s.filePath(userUpload.ID))
+}
+
+// CompleteUpload marks the received upload as stored under fileName and
+// removes the received file. fileName differs from the one of the upload if
+// the same file was stored before.
+func (s *Service) CompleteUpload(ctx context.Context, userUpload *domain.Upload, fileName string) (err error) {
+	if userUpload.Offset != userUpload.Length {
+		err = errors.ErrUploadOffsetMismatch
+		return
+	}
+
+	userUpload.FileName = fileName
+	userUpload.Completed = true
+
+	err = s.storeUpload(ctx, userUpload)
+	if err != nil {
+		return
+	}
+
+	err = os.Remove(s.filePath(userUpload.ID))
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+
+	err = s.UploadsStorage.CloseUpload(ctx, userUpload.UserID, userUpload.ID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetUploadedFileNames returns the stored file names of the completed uploads
+// of the kind, the uploads can be attached until they expire.
+func (s *Service) GetUploadedFileNames(ctx context.Context, userID uint, kind upload.Kind, uploadIDs []string) (fileNames []string, err error) {
+	for _, uploadID := range uploadIDs {
+		var userUpload *domain.Upload
+
+		userUpload, err = s.GetUpload(ctx, userID, uploadID)
+		if err != nil {
+			fileNames = nil
+			return
+		}
+
+		if !userUpload.Completed || userUpload.Kind != string(kind) {
+			fileNames = nil
+			err = errors.ErrInvalidData
+			return
+		}
+
+		fileNames = append(fileNames, userUpload.FileName)
+	}
+
+	return
+}
+
+// DeleteUpload terminates the upload of the user and removes the received
+// part of the file.
+func (s *Service) DeleteUpload(ctx context.Context, userID uint, uploadID string) (err error) {
+	userUpload, err := s.GetUpload(ctx, userID, uploadID)
+	if err != nil {
+		return
+	}
+
+	err = s.deleteUpload(ctx, userUpload)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SweepExpiredFiles removes the files left in Dir by the uploads which were
+// never completed nor terminated. The upload expires UploadTTL after it is
+// created and every chunk touches the file, so the file not modified for
+// UploadTTL belongs to an expired upload.
+func (s *Service) SweepExpiredFiles() (removed int, err error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return
+	}
+
+	expiredAt := s.TimeProvider.Now().Add(-UploadTTL)
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			continue
+		}
+
+		if !info.ModTime().Before(expiredAt) {
+			continue
+		}
+
+		removeErr := os.Remove(filepath.Join(s.Dir, entry.Name()))
+		if removeErr != nil && !os.IsNotExist(removeErr) {
+			err = removeErr
+			continue
+		}
+
+		removed++
+	}
+
+	return
+}
+
+func (s *Service) deleteUpload(ctx context.Context, userUpload *domain.Upload) (err error) {
+	err = s.UploadsStorage.DeleteUpload(ctx, userUpload.ID)
+	if err != nil {
+		return
+	}
+
+	err = os.Remove(s.filePath(userUpload.ID))
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+
+	err = s.UploadsStorage.CloseUpload(ctx, userUpload.UserID, userUpload.ID)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package uploads
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	upload "socio/pkg/upload"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1913922cDecodeSocioUsecaseUploads(in *jlexer.Lexer, out *UploadInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = upload.Kind(in.String())
+		case "originalName":
+			out.OriginalName = string(in.String())
+		case "length":
+			out.Length = int64(in.Int64())
+		case "checksum":
+			out.Checksum = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1913922cEncodeSocioUsecaseUploads(out *jwriter.Writer, in UploadInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"originalName\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalName))
+	}
+	{
+		const prefix string = ",\"length\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Length))
+	}
+	{
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UploadInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1913922cEncodeSocioUsecaseUploads(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UploadInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1913922cEncodeSocioUsecaseUploads(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UploadInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1913922cDecodeSocioUsecaseUploads(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UploadInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1913922cDecodeSocioUsecaseUploads(l, v)
+}
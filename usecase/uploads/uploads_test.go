@@ -0,0 +1,529 @@
+package uploads_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"socio/domain"
+	"socio/errors"
+	mock_uploads "socio/mocks/usecase/uploads"
+	customtime "socio/pkg/time"
+	"socio/pkg/upload"
+	"socio/usecase/uploads"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fileData = []byte("0123456789")
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func sha256Sum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+func newService(t *testing.T, storage uploads.UploadsStorage) *uploads.Service {
+	s := uploads.NewUploadsService(storage, t.TempDir())
+	s.TimeProvider = customtime.MockTimeProvider{}
+
+	return s
+}
+
+func newUpload(offset int64) *domain.Upload {
+	tp := customtime.MockTimeProvider{}
+
+	return &domain.Upload{
+		ID:           "upload",
+		UserID:       1,
+		Kind:         string(upload.PostAttachmentKind),
+		FileName:     "pic_uuid.png",
+		OriginalName: "pic.png",
+		Length:       int64(len(fileData)),
+		Offset:       offset,
+		Checksum:     sha256Hex(fileData),
+		CreatedAt:    customtime.CustomTime{Time: tp.Now()},
+		ExpiresAt:    customtime.CustomTime{Time: tp.Now().Add(uploads.UploadTTL)},
+	}
+}
+
+func TestCreateUpload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   uploads.UploadInput
+		mock    func(storage *mock_uploads.MockUploadsStorage)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			input: uploads.UploadInput{
+				Kind:         upload.PostAttachmentKind,
+				OriginalName: "../pic.png",
+				Length:       10,
+				Checksum:     sha256Hex(fileData),
+			},
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().OpenUpload(gomock.Any(), uint(1), gomock.Any(), gomock.Any(), uploads.MaxOpenUploads).Return(true, nil)
+				storage.EXPECT().StoreUpload(gomock.Any(), gomock.Any(), uploads.UploadTTL).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test not resumable kind",
+			input: uploads.UploadInput{
+				Kind:     upload.StickerKind,
+				Length:   10,
+				Checksum: sha256Hex(fileData),
+			},
+			mock:    func(storage *mock_uploads.MockUploadsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name: "Test too many open uploads",
+			input: uploads.UploadInput{
+				Kind:     upload.PostAttachmentKind,
+				Length:   10,
+				Checksum: sha256Hex(fileData),
+			},
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().OpenUpload(gomock.Any(), uint(1), gomock.Any(), gomock.Any(), uploads.MaxOpenUploads).Return(false, nil)
+			},
+			wantErr: errors.ErrTooManyRequests,
+		},
+		{
+			name: "Test invalid checksum",
+			input: uploads.UploadInput{
+				Kind:     upload.PostAttachmentKind,
+				Length:   10,
+				Checksum: "abc",
+			},
+			mock:    func(storage *mock_uploads.MockUploadsStorage) {},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name: "Test too large",
+			input: uploads.UploadInput{
+				Kind:     upload.PostAttachmentKind,
+				Length:   1 << 40,
+				Checksum: sha256Hex(fileData),
+			},
+			mock:    func(storage *mock_uploads.MockUploadsStorage) {},
+			wantErr: errors.ErrFileTooLarge,
+		},
+		{
+			name: "Test storage error",
+			input: uploads.UploadInput{
+				Kind:     upload.PostAttachmentKind,
+				Length:   10,
+				Checksum: sha256Hex(fileData),
+			},
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().OpenUpload(gomock.Any(), uint(1), gomock.Any(), gomock.Any(), uploads.MaxOpenUploads).Return(true, nil)
+				storage.EXPECT().StoreUpload(gomock.Any(), gomock.Any(), uploads.UploadTTL).Return(errors.ErrInternal)
+				storage.EXPECT().CloseUpload(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_uploads.NewMockUploadsStorage(ctrl)
+			tt.mock(storage)
+
+			s := newService(t, storage)
+
+			got, err := s.CreateUpload(context.Background(), 1, tt.input)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			entries, readErr := os.ReadDir(s.Dir)
+			assert.NoError(t, readErr)
+
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				assert.Empty(t, entries)
+				return
+			}
+
+			assert.Equal(t, uint(1), got.UserID)
+			assert.Equal(t, "pic.png", got.OriginalName)
+			assert.Equal(t, int64(10), got.Length)
+			assert.Equal(t, int64(0), got.Offset)
+			assert.Equal(t, ".png", filepath.Ext(got.FileName))
+			assert.Len(t, entries, 1)
+		})
+	}
+}
+
+func TestWriteChunk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		received      []byte
+		offset        int64
+		chunk         []byte
+		chunkChecksum []byte
+		mock          func(storage *mock_uploads.MockUploadsStorage)
+		wantOffset    int64
+		wantReceived  []byte
+		wantErr       error
+	}{
+		{
+			name:          "Test first chunk",
+			offset:        0,
+			chunk:         fileData[:4],
+			chunkChecksum: sha256Sum(fileData[:4]),
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(0), nil)
+				storage.EXPECT().StoreUpload(gomock.Any(), newUpload(4), uploads.UploadTTL).Return(nil)
+			},
+			wantOffset:   4,
+			wantReceived: fileData[:4],
+		},
+		{
+			name:     "Test last chunk",
+			received: fileData[:4],
+			offset:   4,
+			chunk:    fileData[4:],
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+				storage.EXPECT().StoreUpload(gomock.Any(), newUpload(10), uploads.UploadTTL).Return(nil)
+			},
+			wantOffset:   10,
+			wantReceived: fileData,
+		},
+		{
+			name:     "Test offset mismatch",
+			received: fileData[:4],
+			offset:   0,
+			chunk:    fileData,
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+			},
+			wantReceived: fileData[:4],
+			wantErr:      errors.ErrUploadOffsetMismatch,
+		},
+		{
+			name:          "Test chunk checksum mismatch",
+			received:      fileData[:4],
+			offset:        4,
+			chunk:         fileData[4:],
+			chunkChecksum: sha256Sum([]byte("other")),
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+			},
+			wantReceived: fileData[:4],
+			wantErr:      errors.ErrChecksumMismatch,
+		},
+		{
+			name:     "Test too long chunk",
+			received: fileData[:4],
+			offset:   4,
+			chunk:    append(bytes.Clone(fileData[4:]), '!'),
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+			},
+			wantReceived: fileData[:4],
+			wantErr:      errors.ErrFileTooLarge,
+		},
+		{
+			name:     "Test file checksum mismatch",
+			received: fileData[:4],
+			offset:   4,
+			chunk:    []byte("xxxxxx"),
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+				storage.EXPECT().DeleteUpload(gomock.Any(), "upload").Return(nil)
+				storage.EXPECT().CloseUpload(gomock.Any(), uint(1), "upload").Return(nil)
+			},
+			wantReceived: nil,
+			wantErr:      errors.ErrChecksumMismatch,
+		},
+		{
+			name:     "Test upload of other user",
+			received: fileData[:4],
+			offset:   4,
+			chunk:    fileData[4:],
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				otherUpload := newUpload(4)
+				otherUpload.UserID = 2
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(otherUpload, nil)
+			},
+			wantReceived: fileData[:4],
+			wantErr:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_uploads.NewMockUploadsStorage(ctrl)
+			storage.EXPECT().LockUpload(gomock.Any(), "upload", gomock.Any()).Return(true, nil)
+			storage.EXPECT().UnlockUpload(gomock.Any(), "upload").Return(nil)
+			tt.mock(storage)
+
+			s := newService(t, storage)
+
+			filePath := filepath.Join(s.Dir, "upload")
+			if err := os.WriteFile(filePath, tt.received, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := s.WriteChunk(context.Background(), 1, "upload", tt.offset, bytes.NewReader(tt.chunk), tt.chunkChecksum)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantOffset, got.Offset)
+			} else {
+				assert.Nil(t, got)
+			}
+
+			received, readErr := os.ReadFile(filePath)
+			if tt.wantReceived == nil {
+				assert.True(t, os.IsNotExist(readErr))
+				return
+			}
+
+			assert.NoError(t, readErr)
+			assert.Equal(t, tt.wantReceived, received)
+		})
+	}
+}
+
+func TestWriteChunkLocked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mock_uploads.NewMockUploadsStorage(ctrl)
+	storage.EXPECT().LockUpload(gomock.Any(), "upload", gomock.Any()).Return(false, nil)
+
+	s := newService(t, storage)
+
+	got, err := s.WriteChunk(context.Background(), 1, "upload", 0, bytes.NewReader(fileData), nil)
+
+	assert.Equal(t, errors.ErrUploadOffsetMismatch, err)
+	assert.Nil(t, got)
+}
+
+func TestCompleteUpload(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	completed := newUpload(10)
+	completed.FileName = "stored.png"
+	completed.Completed = true
+
+	storage := mock_uploads.NewMockUploadsStorage(ctrl)
+	storage.EXPECT().StoreUpload(gomock.Any(), completed, uploads.UploadTTL).Return(nil)
+	storage.EXPECT().CloseUpload(gomock.Any(), uint(1), "upload").Return(nil)
+
+	s := newService(t, storage)
+
+	filePath := filepath.Join(s.Dir, "upload")
+	if err := os.WriteFile(filePath, fileData, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := s.CompleteUpload(context.Background(), newUpload(10), "stored.png")
+	assert.NoError(t, err)
+
+	_, err = os.Stat(filePath)
+	assert.True(t, os.IsNotExist(err))
+
+	err = s.CompleteUpload(context.Background(), newUpload(4), "stored.png")
+	assert.Equal(t, errors.ErrUploadOffsetMismatch, err)
+}
+
+func TestCompleteExpiredUpload(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := newService(t, mock_uploads.NewMockUploadsStorage(ctrl))
+
+	expired := newUpload(10)
+	expired.ExpiresAt.Time = expired.CreatedAt.Add(-time.Second)
+
+	err := s.CompleteUpload(context.Background(), expired, "stored.png")
+	assert.Equal(t, errors.ErrNotFound, err)
+}
+
+func TestGetUploadedFileNames(t *testing.T) {
+	t.Parallel()
+
+	completed := newUpload(10)
+	completed.FileName = "stored.png"
+	completed.Completed = true
+
+	tests := []struct {
+		name          string
+		kind          upload.Kind
+		mock          func(storage *mock_uploads.MockUploadsStorage)
+		wantFileNames []string
+		wantErr       error
+	}{
+		{
+			name: "Test OK",
+			kind: upload.PostAttachmentKind,
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(completed, nil)
+			},
+			wantFileNames: []string{"stored.png"},
+		},
+		{
+			name: "Test not completed",
+			kind: upload.PostAttachmentKind,
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+			},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name: "Test other kind",
+			kind: upload.MessageAttachmentKind,
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(completed, nil)
+			},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name: "Test expired",
+			kind: upload.PostAttachmentKind,
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(nil, errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_uploads.NewMockUploadsStorage(ctrl)
+			tt.mock(storage)
+
+			s := newService(t, storage)
+
+			fileNames, err := s.GetUploadedFileNames(context.Background(), 1, tt.kind, []string{"upload"})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantFileNames, fileNames)
+		})
+	}
+}
+
+func TestDeleteUpload(t *testing.T) {
+	t.Parallel()
+
+	otherUpload := newUpload(4)
+	otherUpload.UserID = 2
+
+	tests := []struct {
+		name    string
+		mock    func(storage *mock_uploads.MockUploadsStorage)
+		wantErr error
+	}{
+		{
+			name: "Test OK",
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+				storage.EXPECT().DeleteUpload(gomock.Any(), "upload").Return(nil)
+				storage.EXPECT().CloseUpload(gomock.Any(), uint(1), "upload").Return(nil)
+			},
+		},
+		{
+			name: "Test upload of other user",
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(otherUpload, nil)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name: "Test storage error",
+			mock: func(storage *mock_uploads.MockUploadsStorage) {
+				storage.EXPECT().GetUpload(gomock.Any(), "upload").Return(newUpload(4), nil)
+				storage.EXPECT().DeleteUpload(gomock.Any(), "upload").Return(errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_uploads.NewMockUploadsStorage(ctrl)
+			tt.mock(storage)
+
+			s := newService(t, storage)
+
+			filePath := filepath.Join(s.Dir, "upload")
+			if err := os.WriteFile(filePath, fileData[:4], 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			err := s.DeleteUpload(context.Background(), 1, "upload")
+			assert.Equal(t, tt.wantErr, err)
+
+			_, statErr := os.Stat(filePath)
+			assert.Equal(t, tt.wantErr == nil, os.IsNotExist(statErr))
+		})
+	}
+}
+
+func TestSweepExpiredFiles(t *testing.T) {
+	t.Parallel()
+
+	s := newService(t, nil)
+	now := customtime.MockTimeProvider{}.Now()
+
+	files := map[string]time.Time{
+		"expired": now.Add(-uploads.UploadTTL - time.Minute),
+		"active":  now.Add(-time.Minute),
+	}
+
+	for name, modTime := range files {
+		filePath := filepath.Join(s.Dir, name)
+		assert.NoError(t, os.WriteFile(filePath, fileData, 0o600))
+		assert.NoError(t, os.Chtimes(filePath, modTime, modTime))
+	}
+
+	removed, err := s.SweepExpiredFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	_, err = os.Stat(filepath.Join(s.Dir, "expired"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(s.Dir, "active"))
+	assert.NoError(t, err)
+}
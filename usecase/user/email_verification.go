@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"socio/domain"
+	"socio/errors"
+	"socio/pkg/hash"
+	"time"
+)
+
+const (
+	DefaultEmailVerificationTTL = 24 * time.Hour
+
+	emailVerificationPath    = "/verify-email?token="
+	emailVerificationSubject = "Confirm your email"
+	emailVerificationBody    = "Follow the link to confirm your email: %s\nThe link is valid for %d hours. Until the email is confirmed, you can not post, comment, subscribe or send messages."
+)
+
+//easyjson:json
+type VerifyEmailInput struct {
+	Token string `json:"token"`
+}
+
+// RequestEmailVerification sends another link confirming the email of the
+// user, the links sent before stop working.
+func (p *Service) RequestEmailVerification(ctx context.Context, userID uint) (err error) {
+	isVerified, err := p.UserStorage.CheckIfEmailIsVerified(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	if isVerified {
+		err = errors.ErrEmailAlreadyVerified
+		return
+	}
+
+	user, err := p.UserStorage.GetUserByID(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	err = p.sendEmailVerification(ctx, user)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// VerifyEmail confirms the email the token was sent to, the token works only
+// once.
+func (p *Service) VerifyEmail(ctx context.Context, token string) (userID uint, err error) {
+	if token == "" {
+		err = errors.ErrInvalidToken
+		return
+	}
+
+	userID, err = p.UserStorage.VerifyEmail(ctx, hash.HashToken(token), p.TimeProvider.Now())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Service) CheckIfEmailIsVerified(ctx context.Context, userID uint) (isVerified bool, err error) {
+	isVerified, err = p.UserStorage.CheckIfEmailIsVerified(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Service) sendEmailVerification(ctx context.Context, user *domain.User) (err error) {
+	token, tokenHash, err := hash.NewToken()
+	if err != nil {
+		return
+	}
+
+	expiresAt := p.TimeProvider.Now().Add(p.EmailVerificationTTL)
+
+	err = p.UserStorage.StoreEmailVerificationToken(ctx, user.ID, user.Email, tokenHash, expiresAt)
+	if err != nil {
+		return
+	}
+
+	err = p.EmailOutbox.Push(ctx, &domain.Email{
+		To:      user.Email,
+		Subject: emailVerificationSubject,
+		Body:    fmt.Sprintf(emailVerificationBody, p.PublicURL+emailVerificationPath+token, int(p.EmailVerificationTTL.Hours())),
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
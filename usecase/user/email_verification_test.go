@@ -0,0 +1,151 @@
+package user_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_user "socio/mocks/usecase/user"
+	"socio/pkg/hash"
+	customtime "socio/pkg/time"
+	"socio/usecase/user"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := customtime.MockTimeProvider{}.Now().Add(user.DefaultEmailVerificationTTL)
+
+	tests := []struct {
+		name    string
+		mock    func(userStorage *mock_user.MockUserStorage, emailOutbox *mock_user.MockEmailOutbox)
+		wantErr error
+	}{
+		{
+			name: "send",
+			mock: func(userStorage *mock_user.MockUserStorage, emailOutbox *mock_user.MockEmailOutbox) {
+				var tokenHash string
+
+				userStorage.EXPECT().CheckIfEmailIsVerified(gomock.Any(), uint(1)).Return(false, nil)
+				userStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{ID: 1, Email: "user@mail.ru"}, nil)
+				userStorage.EXPECT().StoreEmailVerificationToken(gomock.Any(), uint(1), "user@mail.ru", gomock.Any(), expiresAt).DoAndReturn(
+					func(ctx context.Context, userID uint, email string, storedHash string, expiresAt time.Time) error {
+						tokenHash = storedHash
+						return nil
+					},
+				)
+				emailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email *domain.Email) error {
+					assert.Equal(t, "user@mail.ru", email.To)
+
+					_, token, ok := strings.Cut(email.Body, "https://socio.ru/verify-email?token=")
+					assert.True(t, ok)
+
+					token, _, _ = strings.Cut(token, "\n")
+					assert.Equal(t, tokenHash, hash.HashToken(token))
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "already verified",
+			mock: func(userStorage *mock_user.MockUserStorage, emailOutbox *mock_user.MockEmailOutbox) {
+				userStorage.EXPECT().CheckIfEmailIsVerified(gomock.Any(), uint(1)).Return(true, nil)
+			},
+			wantErr: errors.ErrEmailAlreadyVerified,
+		},
+		{
+			name: "user not found",
+			mock: func(userStorage *mock_user.MockUserStorage, emailOutbox *mock_user.MockEmailOutbox) {
+				userStorage.EXPECT().CheckIfEmailIsVerified(gomock.Any(), uint(1)).Return(false, errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name: "outbox error",
+			mock: func(userStorage *mock_user.MockUserStorage, emailOutbox *mock_user.MockEmailOutbox) {
+				userStorage.EXPECT().CheckIfEmailIsVerified(gomock.Any(), uint(1)).Return(false, nil)
+				userStorage.EXPECT().GetUserByID(gomock.Any(), uint(1)).Return(&domain.User{ID: 1, Email: "user@mail.ru"}, nil)
+				userStorage.EXPECT().StoreEmailVerificationToken(gomock.Any(), uint(1), "user@mail.ru", gomock.Any(), expiresAt).Return(nil)
+				emailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).Return(errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userStorage := mock_user.NewMockUserStorage(ctrl)
+			emailOutbox := mock_user.NewMockEmailOutbox(ctrl)
+
+			s := user.NewUserService(userStorage, nil, emailOutbox)
+			s.TimeProvider = customtime.MockTimeProvider{}
+			s.PublicURL = "https://socio.ru"
+
+			tt.mock(userStorage, emailOutbox)
+
+			err := s.RequestEmailVerification(context.Background(), 1)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		token    string
+		mock     func(userStorage *mock_user.MockUserStorage)
+		expected uint
+		wantErr  error
+	}{
+		{
+			name:  "verify",
+			token: "token",
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().VerifyEmail(gomock.Any(), hash.HashToken("token"), customtime.MockTimeProvider{}.Now()).Return(uint(1), nil)
+			},
+			expected: 1,
+		},
+		{
+			name:    "empty token",
+			mock:    func(userStorage *mock_user.MockUserStorage) {},
+			wantErr: errors.ErrInvalidToken,
+		},
+		{
+			name:  "invalid token",
+			token: "token",
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().VerifyEmail(gomock.Any(), hash.HashToken("token"), gomock.Any()).Return(uint(0), errors.ErrInvalidToken)
+			},
+			wantErr: errors.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userStorage := mock_user.NewMockUserStorage(ctrl)
+
+			s := user.NewUserService(userStorage, nil, nil)
+			s.TimeProvider = customtime.MockTimeProvider{}
+
+			tt.mock(userStorage)
+
+			got, err := s.VerifyEmail(context.Background(), tt.token)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
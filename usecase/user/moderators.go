@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+)
+
+func (p *Service) CheckIfUserIsModerator(ctx context.Context, userID uint) (isModerator bool, err error) {
+	isModerator, err = p.UserStorage.CheckIfUserIsModerator(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// BanUser forbids the user to log in, the moderators can not ban themselves
+// or each other.
+func (p *Service) BanUser(ctx context.Context, ban *domain.UserBan) (newBan *domain.UserBan, err error) {
+	if ban.UserID == ban.ModeratorID {
+		err = errors.ErrInvalidBody
+		return
+	}
+
+	_, err = p.UserStorage.GetUserByID(ctx, ban.UserID)
+	if err != nil {
+		return
+	}
+
+	isModerator, err := p.UserStorage.CheckIfUserIsModerator(ctx, ban.UserID)
+	if err != nil {
+		return
+	}
+
+	if isModerator {
+		err = errors.ErrForbidden
+		return
+	}
+
+	ban.Reason = p.Sanitizer.Sanitize(ban.Reason)
+
+	newBan, err = p.UserStorage.StoreUserBan(ctx, ban)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *Service) CheckIfUserIsBanned(ctx context.Context, userID uint) (isBanned bool, err error) {
+	isBanned, err = p.UserStorage.CheckIfUserIsBanned(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package user_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_user "socio/mocks/usecase/user"
+	"socio/usecase/user"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBanUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   *domain.UserBan
+		mock    func(userStorage *mock_user.MockUserStorage)
+		want    *domain.UserBan
+		wantErr error
+	}{
+		{
+			name:  "ban",
+			input: &domain.UserBan{UserID: 2, ModeratorID: 1, Reason: "<b>spam</b>"},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{ID: 2}, nil)
+				userStorage.EXPECT().CheckIfUserIsModerator(gomock.Any(), uint(2)).Return(false, nil)
+				userStorage.EXPECT().StoreUserBan(gomock.Any(), &domain.UserBan{UserID: 2, ModeratorID: 1, Reason: "<b>spam</b>"}).Return(&domain.UserBan{ID: 1, UserID: 2, ModeratorID: 1, Reason: "<b>spam</b>"}, nil)
+			},
+			want: &domain.UserBan{ID: 1, UserID: 2, ModeratorID: 1, Reason: "<b>spam</b>"},
+		},
+		{
+			name:    "self ban",
+			input:   &domain.UserBan{UserID: 1, ModeratorID: 1},
+			mock:    func(userStorage *mock_user.MockUserStorage) {},
+			wantErr: errors.ErrInvalidBody,
+		},
+		{
+			name:  "user not found",
+			input: &domain.UserBan{UserID: 2, ModeratorID: 1},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(nil, errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name:  "moderator can not be banned",
+			input: &domain.UserBan{UserID: 2, ModeratorID: 1},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().GetUserByID(gomock.Any(), uint(2)).Return(&domain.User{ID: 2}, nil)
+				userStorage.EXPECT().CheckIfUserIsModerator(gomock.Any(), uint(2)).Return(true, nil)
+			},
+			wantErr: errors.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userStorage := mock_user.NewMockUserStorage(ctrl)
+
+			s := user.NewUserService(userStorage, nil, nil)
+
+			tt.mock(userStorage)
+
+			got, err := s.BanUser(context.Background(), tt.input)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
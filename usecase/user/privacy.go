@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+)
+
+func (p *Service) GetPrivacySettings(ctx context.Context, userID uint) (settings *domain.PrivacySettings, err error) {
+	settings, err = p.UserStorage.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdatePrivacySettings replaces the settings of the user, the empty levels
+// keep their current values.
+func (p *Service) UpdatePrivacySettings(ctx context.Context, input *domain.PrivacySettings) (settings *domain.PrivacySettings, err error) {
+	settings, err = p.UserStorage.GetPrivacySettings(ctx, input.UserID)
+	if err != nil {
+		return
+	}
+
+	levels := []struct {
+		input   domain.PrivacyLevel
+		current *domain.PrivacyLevel
+	}{
+		{input.Posts, &settings.Posts},
+		{input.Messages, &settings.Messages},
+		{input.Subscriptions, &settings.Subscriptions},
+		{input.DateOfBirth, &settings.DateOfBirth},
+	}
+
+	for _, level := range levels {
+		if level.input == "" {
+			continue
+		}
+
+		if !level.input.IsValid() {
+			err = errors.ErrInvalidData
+			return nil, err
+		}
+
+		*level.current = level.input
+	}
+
+	settings, err = p.UserStorage.StorePrivacySettings(ctx, settings)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// hideDatesOfBirth blanks the dates of birth that the privacy settings of the
+// users hide from the viewer.
+func (p *Service) hideDatesOfBirth(ctx context.Context, viewerID uint, users []*domain.User) (err error) {
+	if len(users) == 0 {
+		return
+	}
+
+	userIDs := make([]uint, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	viewableIDs, err := p.UserStorage.GetDateOfBirthViewableIDs(ctx, viewerID, userIDs)
+	if err != nil {
+		return
+	}
+
+	domain.HideDatesOfBirth(users, viewableIDs)
+
+	return
+}
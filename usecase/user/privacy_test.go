@@ -0,0 +1,84 @@
+package user_test
+
+import (
+	"context"
+	"socio/domain"
+	"socio/errors"
+	mock_user "socio/mocks/usecase/user"
+	"socio/usecase/user"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   *domain.PrivacySettings
+		mock    func(userStorage *mock_user.MockUserStorage)
+		want    *domain.PrivacySettings
+		wantErr error
+	}{
+		{
+			name:  "empty levels are kept",
+			input: &domain.PrivacySettings{UserID: 1, Posts: domain.FriendsPrivacy, DateOfBirth: domain.OnlyMePrivacy},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				current := domain.DefaultPrivacySettings(1)
+				current.Messages = domain.SubscribersPrivacy
+				userStorage.EXPECT().GetPrivacySettings(gomock.Any(), uint(1)).Return(current, nil)
+
+				expected := &domain.PrivacySettings{
+					UserID:        1,
+					Posts:         domain.FriendsPrivacy,
+					Messages:      domain.SubscribersPrivacy,
+					Subscriptions: domain.EveryonePrivacy,
+					DateOfBirth:   domain.OnlyMePrivacy,
+				}
+				userStorage.EXPECT().StorePrivacySettings(gomock.Any(), expected).Return(expected, nil)
+			},
+			want: &domain.PrivacySettings{
+				UserID:        1,
+				Posts:         domain.FriendsPrivacy,
+				Messages:      domain.SubscribersPrivacy,
+				Subscriptions: domain.EveryonePrivacy,
+				DateOfBirth:   domain.OnlyMePrivacy,
+			},
+		},
+		{
+			name:  "invalid level",
+			input: &domain.PrivacySettings{UserID: 1, Messages: "NOBODY"},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().GetPrivacySettings(gomock.Any(), uint(1)).Return(domain.DefaultPrivacySettings(1), nil)
+			},
+			wantErr: errors.ErrInvalidData,
+		},
+		{
+			name:  "user not found",
+			input: &domain.PrivacySettings{UserID: 1},
+			mock: func(userStorage *mock_user.MockUserStorage) {
+				userStorage.EXPECT().GetPrivacySettings(gomock.Any(), uint(1)).Return(nil, errors.ErrNotFound)
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userStorage := mock_user.NewMockUserStorage(ctrl)
+
+			s := user.NewUserService(userStorage, nil, nil)
+
+			tt.mock(userStorage)
+
+			got, err := s.UpdatePrivacySettings(context.Background(), tt.input)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
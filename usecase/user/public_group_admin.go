@@ -28,12 +28,17 @@ func (p *Service) DeletePublicGroupAdmin(ctx context.Context, publicGroupAdmin *
 	return
 }
 
-func (p *Service) GetAdminsByPublicGroupID(ctx context.Context, publicGroupID uint) (admins []*domain.User, err error) {
+func (p *Service) GetAdminsByPublicGroupID(ctx context.Context, publicGroupID, viewerID uint) (admins []*domain.User, err error) {
 	admins, err = p.UserStorage.GetAdminsByPublicGroupID(ctx, publicGroupID)
 	if err != nil {
 		return
 	}
 
+	err = p.hideDatesOfBirth(ctx, viewerID, admins)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -61,7 +61,7 @@ func TestCreatePublicGroupAdmin(t *testing.T) {
 
 			userStorage := mock_users.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.publicGroupAdmin)
 
@@ -112,7 +112,7 @@ func TestDeletePublicGroupAdmin(t *testing.T) {
 
 			userStorage := mock_users.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.publicGroupAdmin)
 
@@ -144,6 +144,7 @@ func TestGetAdminsByPublicGroupID(t *testing.T) {
 					{ID: 2},
 				}
 				userStorage.EXPECT().GetAdminsByPublicGroupID(gomock.Any(), publicGroupID).Return(admins, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(3), []uint{1, 2}).Return([]uint{1, 2}, nil)
 			},
 			wantAdmins: []*domain.User{
 				{ID: 1},
@@ -171,11 +172,11 @@ func TestGetAdminsByPublicGroupID(t *testing.T) {
 
 			userStorage := mock_users.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.publicGroupID)
 
-			gotAdmins, err := s.GetAdminsByPublicGroupID(context.Background(), tt.publicGroupID)
+			gotAdmins, err := s.GetAdminsByPublicGroupID(context.Background(), tt.publicGroupID, 3)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetAdminsByPublicGroupID() error = %v, wantErr %v", err, tt.wantErr)
@@ -230,7 +231,7 @@ func TestCheckIfUserIsAdmin(t *testing.T) {
 
 			userStorage := mock_users.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.publicGroupID, tt.userID)
 
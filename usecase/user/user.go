@@ -2,14 +2,23 @@ package user
 
 import (
 	"context"
+	"os"
 	"socio/domain"
+	"socio/pkg/contextlogger"
+	"socio/pkg/mailer"
 	"socio/pkg/sanitizer"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"time"
 
 	"github.com/microcosm-cc/bluemonday"
 )
 
+const (
+	DefaultSearchAmount = uint(20)
+	MaxSearchAmount     = uint(100)
+)
+
 type UserStorage interface {
 	GetUserByID(ctx context.Context, userID uint) (user *domain.User, err error)
 	GetUserByEmail(ctx context.Context, email string) (user *domain.User, err error)
@@ -17,12 +26,23 @@ type UserStorage interface {
 	StoreUser(ctx context.Context, user *domain.User) (err error)
 	UpdateUser(ctx context.Context, user *domain.User, prevPassword string) (updatedUser *domain.User, err error)
 	DeleteUser(ctx context.Context, userID uint) (err error)
-	SearchByName(ctx context.Context, query string) (users []*domain.User, err error)
+	SearchByName(ctx context.Context, viewerID uint, query string, lastUserID uint, limit uint) (users []*domain.User, err error)
+	CountByName(ctx context.Context, viewerID uint, query string) (count uint, err error)
 	GetSubscriptionIDs(ctx context.Context, userID uint) (subscribedToIDs []uint, err error)
 	StorePublicGroupAdmin(ctx context.Context, publicGroupAdmin *domain.PublicGroupAdmin) (newPublicGroupAdmin *domain.PublicGroupAdmin, err error)
 	DeletePublicGroupAdmin(ctx context.Context, publicGroupAdmin *domain.PublicGroupAdmin) (err error)
 	GetAdminsByPublicGroupID(ctx context.Context, publicGroupID uint) (admins []*domain.User, err error)
 	CheckIfUserIsAdmin(ctx context.Context, publicGroupID, userID uint) (isAdmin bool, err error)
+	GetPrivacySettings(ctx context.Context, userID uint) (settings *domain.PrivacySettings, err error)
+	GetDateOfBirthViewableIDs(ctx context.Context, viewerID uint, userIDs []uint) (viewableIDs []uint, err error)
+	CanViewSubscriptions(ctx context.Context, userID, viewerID uint) (allowed bool, err error)
+	StorePrivacySettings(ctx context.Context, settings *domain.PrivacySettings) (newSettings *domain.PrivacySettings, err error)
+	CheckIfUserIsModerator(ctx context.Context, userID uint) (isModerator bool, err error)
+	StoreUserBan(ctx context.Context, ban *domain.UserBan) (newBan *domain.UserBan, err error)
+	CheckIfUserIsBanned(ctx context.Context, userID uint) (isBanned bool, err error)
+	StoreEmailVerificationToken(ctx context.Context, userID uint, email string, tokenHash string, expiresAt time.Time) (err error)
+	VerifyEmail(ctx context.Context, tokenHash string, now time.Time) (userID uint, err error)
+	CheckIfEmailIsVerified(ctx context.Context, userID uint) (isVerified bool, err error)
 }
 
 type AvatarStorage interface {
@@ -30,6 +50,10 @@ type AvatarStorage interface {
 	Delete(fileName string) (err error)
 }
 
+type EmailOutbox interface {
+	Push(ctx context.Context, email *domain.Email) (err error)
+}
+
 //easyjson:json
 type UserWithSubsInfo struct {
 	User           *domain.User
@@ -59,25 +83,40 @@ type UpdateUserInput struct {
 }
 
 type Service struct {
-	UserStorage   UserStorage
-	AvatarStorage AvatarStorage
-	Sanitizer     *sanitizer.Sanitizer
+	UserStorage          UserStorage
+	AvatarStorage        AvatarStorage
+	EmailOutbox          EmailOutbox
+	TimeProvider         customtime.TimeProvider
+	EmailVerificationTTL time.Duration
+	PublicURL            string
+	Sanitizer            *sanitizer.Sanitizer
 }
 
-func NewUserService(userStorage UserStorage, avatarStorage AvatarStorage) (p *Service) {
+func NewUserService(userStorage UserStorage, avatarStorage AvatarStorage, emailOutbox EmailOutbox) (p *Service) {
 	return &Service{
-		UserStorage:   userStorage,
-		AvatarStorage: avatarStorage,
-		Sanitizer:     sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
+		UserStorage:          userStorage,
+		AvatarStorage:        avatarStorage,
+		EmailOutbox:          emailOutbox,
+		TimeProvider:         customtime.RealTimeProvider{},
+		EmailVerificationTTL: DefaultEmailVerificationTTL,
+		PublicURL:            os.Getenv(mailer.PublicURLEnv),
+		Sanitizer:            sanitizer.NewSanitizer(bluemonday.UGCPolicy()),
 	}
 }
 
-func (p *Service) GetUserByID(ctx context.Context, userID uint) (user *domain.User, err error) {
+// GetUserByID returns the user as seen by the viewer, the date of birth is
+// blank unless the privacy settings of the user let the viewer see it.
+func (p *Service) GetUserByID(ctx context.Context, userID, viewerID uint) (user *domain.User, err error) {
 	user, err = p.UserStorage.GetUserByID(ctx, userID)
 	if err != nil {
 		return
 	}
 
+	err = p.hideDatesOfBirth(ctx, viewerID, []*domain.User{user})
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -90,8 +129,8 @@ func (p *Service) GetUserByEmail(ctx context.Context, email string) (user *domai
 	return
 }
 
-func (p *Service) UploadAvatar(fileName string, filePath string, contentType string) (err error) {
-	err = p.AvatarStorage.Store(fileName, filePath, contentType)
+func (p *Service) UploadAvatar(fileName string, filePath string) (meta *upload.Metadata, err error) {
+	meta, err = upload.Process(p.AvatarStorage, upload.AvatarKind, fileName, filePath)
 	if err != nil {
 		return
 	}
@@ -124,6 +163,11 @@ func (p *Service) CreateUser(ctx context.Context, userInput CreateUserInput) (us
 		return nil, err
 	}
 
+	// the account is created anyway, the user can request another link
+	if verificationErr := p.sendEmailVerification(ctx, user); verificationErr != nil {
+		contextlogger.LogErr(ctx, verificationErr)
+	}
+
 	return
 }
 
@@ -139,6 +183,11 @@ func (p *Service) GetUserByIDWithSubsInfo(ctx context.Context, userID uint, auth
 		return
 	}
 
+	err = p.hideDatesOfBirth(ctx, authorizedUserID, []*domain.User{userWithInfo.User})
+	if err != nil {
+		return
+	}
+
 	p.Sanitizer.SanitizeUser(userWithInfo.User)
 
 	return
@@ -151,6 +200,7 @@ func (p *Service) UpdateUser(ctx context.Context, input UpdateUserInput) (update
 	}
 
 	prevPassword := oldUser.Password
+	prevEmail := oldUser.Email
 
 	updatedUser = oldUser
 
@@ -193,6 +243,13 @@ func (p *Service) UpdateUser(ctx context.Context, input UpdateUserInput) (update
 		return nil, err
 	}
 
+	// the storage unverifies the changed email, the new one has to be confirmed
+	if updatedUser.Email != prevEmail {
+		if verificationErr := p.sendEmailVerification(ctx, updatedUser); verificationErr != nil {
+			contextlogger.LogErr(ctx, verificationErr)
+		}
+	}
+
 	p.Sanitizer.SanitizeUser(updatedUser)
 
 	return
@@ -217,8 +274,34 @@ func (p *Service) DeleteUser(ctx context.Context, userID uint) (err error) {
 	return
 }
 
-func (p *Service) SearchByName(ctx context.Context, query string) (users []*domain.User, err error) {
-	users, err = p.UserStorage.SearchByName(ctx, query)
+// SearchByName returns up to amount users whose full name contains the query
+// and whose ID is greater than lastUserID, ordered by ID, and the total amount
+// of the users found. The users blocked by or blocking the viewer are not
+// found.
+func (p *Service) SearchByName(ctx context.Context, viewerID uint, query string, lastUserID uint, amount uint) (users []*domain.User, total uint, err error) {
+	if amount == 0 {
+		amount = DefaultSearchAmount
+	}
+
+	if amount > MaxSearchAmount {
+		amount = MaxSearchAmount
+	}
+
+	users, err = p.UserStorage.SearchByName(ctx, viewerID, query, lastUserID, amount)
+	if err != nil {
+		return
+	}
+
+	// the first page holds every user found when it is not full
+	total = uint(len(users))
+	if lastUserID != 0 || total == amount {
+		total, err = p.UserStorage.CountByName(ctx, viewerID, query)
+		if err != nil {
+			return
+		}
+	}
+
+	err = p.hideDatesOfBirth(ctx, viewerID, users)
 	if err != nil {
 		return
 	}
@@ -1,11 +1,19 @@
 package user_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
 	"reflect"
 	"socio/domain"
 	"socio/errors"
 	customtime "socio/pkg/time"
+	"socio/pkg/upload"
 	"socio/usecase/user"
 	"testing"
 	"time"
@@ -29,7 +37,20 @@ func TestGetUserByID(t *testing.T) {
 			name:   "Test OK",
 			userID: 1,
 			mock: func(userStorage *mock_user.MockUserStorage, userID uint) {
-				userStorage.EXPECT().GetUserByID(gomock.Any(), userID).Return(&domain.User{ID: 1}, nil)
+				dateOfBirth := customtime.CustomTime{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+				userStorage.EXPECT().GetUserByID(gomock.Any(), userID).Return(&domain.User{ID: 1, DateOfBirth: dateOfBirth}, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(2), []uint{userID}).Return([]uint{userID}, nil)
+			},
+			wantUser: &domain.User{ID: 1, DateOfBirth: customtime.CustomTime{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}},
+			wantErr:  false,
+		},
+		{
+			name:   "Test date of birth is hidden",
+			userID: 1,
+			mock: func(userStorage *mock_user.MockUserStorage, userID uint) {
+				dateOfBirth := customtime.CustomTime{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+				userStorage.EXPECT().GetUserByID(gomock.Any(), userID).Return(&domain.User{ID: 1, DateOfBirth: dateOfBirth}, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(2), []uint{userID}).Return(nil, nil)
 			},
 			wantUser: &domain.User{ID: 1},
 			wantErr:  false,
@@ -53,11 +74,11 @@ func TestGetUserByID(t *testing.T) {
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, nil)
 
 			tt.mock(userStorage, tt.userID)
 
-			gotUser, err := s.GetUserByID(context.Background(), tt.userID)
+			gotUser, err := s.GetUserByID(context.Background(), tt.userID, 2)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetUserByID() error = %v, wantErr %v", err, tt.wantErr)
@@ -108,7 +129,7 @@ func TestGetUserByEmail(t *testing.T) {
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, nil)
 
 			tt.mock(userStorage, tt.email)
 
@@ -128,33 +149,58 @@ func TestGetUserByEmail(t *testing.T) {
 func TestUploadAvatar(t *testing.T) {
 	t.Parallel()
 
+	img := new(bytes.Buffer)
+	if err := png.Encode(img, image.NewRGBA(image.Rect(0, 0, 640, 480))); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(img.Bytes())
+
 	tests := []struct {
-		name        string
-		fileName    string
-		filePath    string
-		contentType string
-		mock        func(avatarStorage *mock_user.MockAvatarStorage, fileName, filePath, contentType string)
-		wantErr     bool
+		name     string
+		data     []byte
+		mock     func(avatarStorage *mock_user.MockAvatarStorage)
+		wantMeta *upload.Metadata
+		wantErr  error
 	}{
 		{
-			name:        "Test OK",
-			fileName:    "avatar.jpg",
-			filePath:    "/path/to/avatar.jpg",
-			contentType: "image/jpeg",
-			mock: func(avatarStorage *mock_user.MockAvatarStorage, fileName, filePath, contentType string) {
-				avatarStorage.EXPECT().Store(fileName, filePath, contentType).Return(nil)
+			name: "Test OK",
+			data: img.Bytes(),
+			mock: func(avatarStorage *mock_user.MockAvatarStorage) {
+				avatarStorage.EXPECT().Store("avatar.png", gomock.Any(), "image/png").Return(nil)
+				avatarStorage.EXPECT().Store("avatar_small.png", gomock.Any(), "image/png").Return(nil)
+				avatarStorage.EXPECT().Store("avatar_medium.png", gomock.Any(), "image/png").Return(nil)
+			},
+			wantMeta: &upload.Metadata{
+				FileName:    "avatar.png",
+				ContentType: "image/png",
+				Size:        int64(img.Len()),
+				Width:       640,
+				Height:      480,
+				Checksum:    hex.EncodeToString(sum[:]),
+				Variants: []*upload.Variant{
+					{Name: "small", FileName: "avatar_small.png", Width: 64, Height: 48},
+					{Name: "medium", FileName: "avatar_medium.png", Width: 256, Height: 192},
+				},
 			},
-			wantErr: false,
+			wantErr: nil,
 		},
 		{
-			name:        "Test Error",
-			fileName:    "avatar.jpg",
-			filePath:    "/path/to/avatar.jpg",
-			contentType: "image/jpeg",
-			mock: func(avatarStorage *mock_user.MockAvatarStorage, fileName, filePath, contentType string) {
-				avatarStorage.EXPECT().Store(fileName, filePath, contentType).Return(errors.ErrInternal)
+			name: "Test unsupported media type",
+			data: []byte("<html><script>alert(1)</script></html>"),
+			mock: func(avatarStorage *mock_user.MockAvatarStorage) {
 			},
-			wantErr: true,
+			wantMeta: nil,
+			wantErr:  errors.ErrUnsupportedMediaType,
+		},
+		{
+			name: "Test Error",
+			data: img.Bytes(),
+			mock: func(avatarStorage *mock_user.MockAvatarStorage) {
+				avatarStorage.EXPECT().Store("avatar.png", gomock.Any(), "image/png").Return(errors.ErrInternal)
+			},
+			wantMeta: nil,
+			wantErr:  errors.ErrInternal,
 		},
 	}
 
@@ -163,18 +209,27 @@ func TestUploadAvatar(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			filePath := filepath.Join(t.TempDir(), "upload")
+			if err := os.WriteFile(filePath, tt.data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, nil)
 
-			tt.mock(avatarStorage, tt.fileName, tt.filePath, tt.contentType)
+			tt.mock(avatarStorage)
 
-			err := s.UploadAvatar(tt.fileName, tt.filePath, tt.contentType)
+			meta, err := s.UploadAvatar("avatar.png", filePath)
 
-			if (err != nil) != tt.wantErr {
+			if err != tt.wantErr {
 				t.Errorf("UploadAvatar() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			if !reflect.DeepEqual(meta, tt.wantMeta) {
+				t.Errorf("UploadAvatar() meta = %v, want %v", meta, tt.wantMeta)
+			}
 		})
 	}
 }
@@ -214,6 +269,7 @@ func TestCreateUser(t *testing.T) {
 				}
 				userStorage.EXPECT().GetUserByEmail(gomock.Any(), userInput.Email).Return(nil, errors.ErrNotFound)
 				userStorage.EXPECT().StoreUser(gomock.Any(), user).Return(nil)
+				userStorage.EXPECT().StoreEmailVerificationToken(gomock.Any(), uint(0), userInput.Email, gomock.Any(), gomock.Any()).Return(nil)
 			},
 			wantUser: &domain.User{
 				FirstName: "John",
@@ -280,8 +336,11 @@ func TestCreateUser(t *testing.T) {
 
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
+			emailOutbox := mock_user.NewMockEmailOutbox(ctrl)
+			emailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, emailOutbox)
+			s.TimeProvider = customtime.MockTimeProvider{}
 
 			tt.mock(userStorage, tt.userInput)
 
@@ -316,6 +375,7 @@ func TestGetUserByIDWithSubsInfo(t *testing.T) {
 			mock: func(userStorage *mock_user.MockUserStorage, userID uint, authorizedUserID uint) {
 				user := &domain.User{ID: 1}
 				userStorage.EXPECT().GetUserByIDWithSubsInfo(gomock.Any(), userID, authorizedUserID).Return(user, false, true, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), authorizedUserID, []uint{userID}).Return([]uint{userID}, nil)
 			},
 			wantUserWithInfo: user.UserWithSubsInfo{
 				User:           &domain.User{ID: 1},
@@ -324,6 +384,36 @@ func TestGetUserByIDWithSubsInfo(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:             "Test date of birth hidden from not friends",
+			userID:           1,
+			authorizedUserID: 2,
+			mock: func(userStorage *mock_user.MockUserStorage, userID uint, authorizedUserID uint) {
+				user := &domain.User{ID: 1, DateOfBirth: customtime.CustomTime{Time: customtime.MockTimeProvider{}.Now()}}
+				userStorage.EXPECT().GetUserByIDWithSubsInfo(gomock.Any(), userID, authorizedUserID).Return(user, true, false, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), authorizedUserID, []uint{userID}).Return(nil, nil)
+			},
+			wantUserWithInfo: user.UserWithSubsInfo{
+				User:           &domain.User{ID: 1},
+				IsSubscriber:   false,
+				IsSubscribedTo: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:             "Test own date of birth",
+			userID:           1,
+			authorizedUserID: 1,
+			mock: func(userStorage *mock_user.MockUserStorage, userID uint, authorizedUserID uint) {
+				user := &domain.User{ID: 1, DateOfBirth: customtime.CustomTime{Time: customtime.MockTimeProvider{}.Now()}}
+				userStorage.EXPECT().GetUserByIDWithSubsInfo(gomock.Any(), userID, authorizedUserID).Return(user, false, false, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), authorizedUserID, []uint{userID}).Return([]uint{userID}, nil)
+			},
+			wantUserWithInfo: user.UserWithSubsInfo{
+				User: &domain.User{ID: 1, DateOfBirth: customtime.CustomTime{Time: customtime.MockTimeProvider{}.Now()}},
+			},
+			wantErr: false,
+		},
 		{
 			name:             "Test Error",
 			userID:           0,
@@ -334,6 +424,19 @@ func TestGetUserByIDWithSubsInfo(t *testing.T) {
 			wantUserWithInfo: user.UserWithSubsInfo{},
 			wantErr:          true,
 		},
+		{
+			name:             "Test date of birth privacy error",
+			userID:           1,
+			authorizedUserID: 2,
+			mock: func(userStorage *mock_user.MockUserStorage, userID uint, authorizedUserID uint) {
+				userStorage.EXPECT().GetUserByIDWithSubsInfo(gomock.Any(), userID, authorizedUserID).Return(&domain.User{ID: 1}, false, false, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), authorizedUserID, []uint{userID}).Return(nil, errors.ErrInternal)
+			},
+			wantUserWithInfo: user.UserWithSubsInfo{
+				User: &domain.User{ID: 1},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -343,7 +446,7 @@ func TestGetUserByIDWithSubsInfo(t *testing.T) {
 
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.userID, tt.authorizedUserID)
 
@@ -388,6 +491,7 @@ func TestUpdateUser(t *testing.T) {
 				userStorage.EXPECT().GetUserByEmail(gomock.Any(), input.Email).Return(nil, errors.ErrNotFound)
 				avatarStorage.EXPECT().Delete(oldUser.Avatar).Return(nil)
 				userStorage.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), oldUser.Password).Return(&domain.User{ID: 1, FirstName: "John", LastName: "Doe", Password: "password", Email: "john.doe@example.com", Avatar: "avatar.jpg"}, nil)
+				userStorage.EXPECT().StoreEmailVerificationToken(gomock.Any(), uint(1), input.Email, gomock.Any(), gomock.Any()).Return(nil)
 			},
 			wantUser: &domain.User{
 				ID:        1,
@@ -506,8 +610,10 @@ func TestUpdateUser(t *testing.T) {
 
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
+			emailOutbox := mock_user.NewMockEmailOutbox(ctrl)
+			emailOutbox.EXPECT().Push(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, emailOutbox)
 
 			tt.mock(userStorage, avatarStorage, tt.input)
 
@@ -583,7 +689,7 @@ func TestDeleteUser(t *testing.T) {
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 			avatarStorage := mock_user.NewMockAvatarStorage(ctrl)
 
-			s := user.NewUserService(userStorage, avatarStorage)
+			s := user.NewUserService(userStorage, avatarStorage, nil)
 
 			tt.mock(userStorage, avatarStorage, tt.userID)
 
@@ -602,8 +708,10 @@ func TestSearchByName(t *testing.T) {
 	tests := []struct {
 		name      string
 		query     string
+		amount    uint
 		mock      func(userStorage *mock_user.MockUserStorage, query string)
 		wantUsers []*domain.User
+		wantTotal uint
 		wantErr   bool
 	}{
 		{
@@ -614,23 +722,66 @@ func TestSearchByName(t *testing.T) {
 					{ID: 1},
 					{ID: 2},
 				}
-				userStorage.EXPECT().SearchByName(gomock.Any(), query).Return(users, nil)
+				userStorage.EXPECT().SearchByName(gomock.Any(), uint(1), query, uint(0), user.DefaultSearchAmount).Return(users, nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{1, 2}).Return([]uint{1, 2}, nil)
 			},
 			wantUsers: []*domain.User{
 				{ID: 1},
 				{ID: 2},
 			},
-			wantErr: false,
+			wantTotal: 2,
+			wantErr:   false,
+		},
+		{
+			name:   "Test full page is counted",
+			query:  "John",
+			amount: 2,
+			mock: func(userStorage *mock_user.MockUserStorage, query string) {
+				users := []*domain.User{
+					{ID: 1},
+					{ID: 2},
+				}
+				userStorage.EXPECT().SearchByName(gomock.Any(), uint(1), query, uint(0), uint(2)).Return(users, nil)
+				userStorage.EXPECT().CountByName(gomock.Any(), uint(1), query).Return(uint(7), nil)
+				userStorage.EXPECT().GetDateOfBirthViewableIDs(gomock.Any(), uint(1), []uint{1, 2}).Return([]uint{1, 2}, nil)
+			},
+			wantUsers: []*domain.User{
+				{ID: 1},
+				{ID: 2},
+			},
+			wantTotal: 7,
+			wantErr:   false,
 		},
 		{
 			name:  "Test Error",
 			query: "John",
 			mock: func(userStorage *mock_user.MockUserStorage, query string) {
-				userStorage.EXPECT().SearchByName(gomock.Any(), query).Return(nil, errors.ErrInternal)
+				userStorage.EXPECT().SearchByName(gomock.Any(), uint(1), query, uint(0), user.DefaultSearchAmount).Return(nil, errors.ErrInternal)
 			},
 			wantUsers: nil,
 			wantErr:   true,
 		},
+		{
+			name:   "Test count error",
+			query:  "John",
+			amount: 1,
+			mock: func(userStorage *mock_user.MockUserStorage, query string) {
+				userStorage.EXPECT().SearchByName(gomock.Any(), uint(1), query, uint(0), uint(1)).Return([]*domain.User{{ID: 1}}, nil)
+				userStorage.EXPECT().CountByName(gomock.Any(), uint(1), query).Return(uint(0), errors.ErrInternal)
+			},
+			wantUsers: []*domain.User{{ID: 1}},
+			wantErr:   true,
+		},
+		{
+			name:   "Test amount is limited",
+			query:  "John",
+			amount: 1000,
+			mock: func(userStorage *mock_user.MockUserStorage, query string) {
+				userStorage.EXPECT().SearchByName(gomock.Any(), uint(1), query, uint(0), user.MaxSearchAmount).Return(nil, nil)
+			},
+			wantUsers: nil,
+			wantErr:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -640,11 +791,11 @@ func TestSearchByName(t *testing.T) {
 
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.query)
 
-			gotUsers, err := s.SearchByName(context.Background(), tt.query)
+			gotUsers, gotTotal, err := s.SearchByName(context.Background(), 1, tt.query, 0, tt.amount)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SearchByName() error = %v, wantErr %v", err, tt.wantErr)
@@ -653,6 +804,10 @@ func TestSearchByName(t *testing.T) {
 			if !reflect.DeepEqual(gotUsers, tt.wantUsers) {
 				t.Errorf("SearchByName() gotUsers = %v, want %v", gotUsers, tt.wantUsers)
 			}
+
+			if gotTotal != tt.wantTotal {
+				t.Errorf("SearchByName() gotTotal = %v, want %v", gotTotal, tt.wantTotal)
+			}
 		})
 	}
 }
@@ -710,7 +865,7 @@ func TestGetSubscriptionIDs(t *testing.T) {
 
 			userStorage := mock_user.NewMockUserStorage(ctrl)
 
-			s := user.NewUserService(userStorage, nil)
+			s := user.NewUserService(userStorage, nil, nil)
 
 			tt.mock(userStorage, tt.userID)
 